@@ -60,6 +60,7 @@ type adapter struct {
 
 	socketPath string
 	timeout    cli.DurationFlag
+	output     cli.OutputFlag
 	flags      *flag.FlagSet
 }
 
@@ -76,6 +77,7 @@ func adaptCommand(env *cli.Env, clientsMaker workloadClientMaker, cmd command) *
 	fs.SetOutput(env.Stderr)
 	fs.StringVar(&a.socketPath, "socketPath", common.DefaultSocketPath, "Path to Workload API socket")
 	fs.Var(&a.timeout, "timeout", "Time to wait for a response")
+	cli.AddOutputFlag(fs, &a.output)
 	a.cmd.appendFlags(fs)
 	a.flags = fs
 
@@ -96,7 +98,7 @@ func (a *adapter) Run(args []string) int {
 		return 1
 	}
 
-	if err := a.cmd.run(ctx, a.env, clients); err != nil {
+	if err := a.cmd.run(ctx, a.env.WithOutput(a.output), clients); err != nil {
 		_ = a.env.ErrPrintln(err)
 		return 1
 	}
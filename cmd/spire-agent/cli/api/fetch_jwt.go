@@ -2,6 +2,7 @@ package api
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
@@ -24,6 +25,17 @@ type fetchJWTCommand struct {
 	spiffeID string
 }
 
+// fetchJWTOutput is the JSON output of the command
+type fetchJWTOutput struct {
+	SVIDs   []jwtSVIDOutput            `json:"svids"`
+	Bundles map[string]json.RawMessage `json:"bundles"`
+}
+
+type jwtSVIDOutput struct {
+	SPIFFEID string `json:"spiffe_id"`
+	SVID     string `json:"svid"`
+}
+
 func (c *fetchJWTCommand) name() string {
 	return "fetch jwt"
 }
@@ -46,6 +58,23 @@ func (c *fetchJWTCommand) run(ctx context.Context, env *common_cli.Env, client *
 		return err
 	}
 
+	if env.JSONOutput() {
+		out := fetchJWTOutput{
+			SVIDs:   make([]jwtSVIDOutput, 0, len(svidResp.Svids)),
+			Bundles: make(map[string]json.RawMessage, len(bundlesResp.Bundles)),
+		}
+		for _, svid := range svidResp.Svids {
+			out.SVIDs = append(out.SVIDs, jwtSVIDOutput{
+				SPIFFEID: svid.SpiffeId,
+				SVID:     svid.Svid,
+			})
+		}
+		for trustDomainID, jwksJSON := range bundlesResp.Bundles {
+			out.Bundles[trustDomainID] = jwksJSON
+		}
+		return env.PrintJSON(out)
+	}
+
 	for _, svid := range svidResp.Svids {
 		fmt.Printf("token(%s):\n\t%s\n", svid.SpiffeId, svid.Svid)
 	}
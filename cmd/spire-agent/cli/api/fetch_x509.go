@@ -55,11 +55,17 @@ func (c *fetchX509Command) run(ctx context.Context, env *common_cli.Env, client
 	}
 
 	if !c.silent {
-		printX509SVIDResponse(svids, respTime)
+		if env.JSONOutput() {
+			if err := printX509SVIDResponseJSON(env, svids); err != nil {
+				return err
+			}
+		} else {
+			printX509SVIDResponse(svids, respTime)
+		}
 	}
 
 	if c.writePath != "" {
-		if err := c.writeResponse(svids); err != nil {
+		if err := c.writeResponse(env, svids); err != nil {
 			return err
 		}
 	}
@@ -84,25 +90,32 @@ func (c *fetchX509Command) fetchX509SVID(ctx context.Context, client *workloadCl
 	return stream.Recv()
 }
 
-func (c *fetchX509Command) writeResponse(svids []*X509SVID) error {
+func (c *fetchX509Command) writeResponse(env *common_cli.Env, svids []*X509SVID) error {
+	// Progress messages would break the JSON document printed to stdout
+	printf := func(format string, args ...interface{}) {
+		if !env.JSONOutput() {
+			_ = env.Printf(format, args...)
+		}
+	}
+
 	for i, svid := range svids {
 		svidPath := path.Join(c.writePath, fmt.Sprintf("svid.%v.pem", i))
 		keyPath := path.Join(c.writePath, fmt.Sprintf("svid.%v.key", i))
 		bundlePath := path.Join(c.writePath, fmt.Sprintf("bundle.%v.pem", i))
 
-		fmt.Printf("Writing SVID #%d to file %s.\n", i, svidPath)
+		printf("Writing SVID #%d to file %s.\n", i, svidPath)
 		err := c.writeCerts(svidPath, svid.Certificates)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("Writing key #%d to file %s.\n", i, keyPath)
+		printf("Writing key #%d to file %s.\n", i, keyPath)
 		err = c.writeKey(keyPath, svid.PrivateKey)
 		if err != nil {
 			return err
 		}
 
-		fmt.Printf("Writing bundle #%d to file %s.\n", i, bundlePath)
+		printf("Writing bundle #%d to file %s.\n", i, bundlePath)
 		err = c.writeCerts(bundlePath, svid.Bundle)
 		if err != nil {
 			return err
@@ -116,7 +129,7 @@ func (c *fetchX509Command) writeResponse(svids []*X509SVID) error {
 
 		for j, trustDomain := range federatedDomains {
 			bundlePath := path.Join(c.writePath, fmt.Sprintf("federated_bundle.%d.%d.pem", i, j))
-			fmt.Printf("Writing federated bundle #%d for trust domain %s to file %s.\n", j, trustDomain, bundlePath)
+			printf("Writing federated bundle #%d for trust domain %s to file %s.\n", j, trustDomain, bundlePath)
 			err = c.writeCerts(bundlePath, svid.FederatedBundles[trustDomain])
 			if err != nil {
 				return err
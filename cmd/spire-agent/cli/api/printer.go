@@ -4,6 +4,9 @@ import (
 	"crypto/x509"
 	"fmt"
 	"time"
+
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/pemutil"
 )
 
 func printX509SVIDResponse(svids []*X509SVID, respTime time.Duration) {
@@ -51,3 +54,35 @@ func printX509FederatedBundle(trustDomain string, bundle []*x509.Certificate) {
 		fmt.Printf("[%s] CA #%v Valid Until:\t%v\n", trustDomain, num, ca.NotAfter)
 	}
 }
+
+// x509SVIDResponseJSON is the JSON output of the commands that print X509-SVIDs.
+// Certificates are PEM encoded. Private keys are never printed.
+type x509SVIDResponseJSON struct {
+	SVIDs []x509SVIDJSON `json:"svids"`
+}
+
+type x509SVIDJSON struct {
+	SPIFFEID         string            `json:"spiffe_id"`
+	X509SVID         string            `json:"x509_svid"`
+	Bundle           string            `json:"bundle"`
+	FederatedBundles map[string]string `json:"federated_bundles"`
+}
+
+func printX509SVIDResponseJSON(env *common_cli.Env, svids []*X509SVID) error {
+	resp := x509SVIDResponseJSON{
+		SVIDs: make([]x509SVIDJSON, 0, len(svids)),
+	}
+	for _, svid := range svids {
+		federatedBundles := make(map[string]string, len(svid.FederatedBundles))
+		for trustDomain, bundle := range svid.FederatedBundles {
+			federatedBundles[trustDomain] = string(pemutil.EncodeCertificates(bundle))
+		}
+		resp.SVIDs = append(resp.SVIDs, x509SVIDJSON{
+			SPIFFEID:         svid.SPIFFEID,
+			X509SVID:         string(pemutil.EncodeCertificates(svid.Certificates)),
+			Bundle:           string(pemutil.EncodeCertificates(svid.Bundle)),
+			FederatedBundles: federatedBundles,
+		})
+	}
+	return env.PrintJSON(resp)
+}
@@ -53,6 +53,10 @@ func (c *validateJWTCommand) run(ctx context.Context, env *common_cli.Env, clien
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
 	if err := env.Println("SVID is valid."); err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package cli
 
 import (
+	"context"
 	stdlog "log"
 
 	"github.com/mitchellh/cli"
@@ -10,16 +11,28 @@ import (
 	"github.com/spiffe/spire/cmd/spire-agent/cli/validate"
 	"github.com/spiffe/spire/pkg/common/log"
 	"github.com/spiffe/spire/pkg/common/version"
+	"github.com/spiffe/spire/pkg/common/winsvc"
 )
 
+// ServiceName is the default name of the Windows service
+const ServiceName = "spire-agent"
+
 type CLI struct {
 	LogOptions         []log.Option
 	AllowUnknownConfig bool
+
+	// Context stops the run command when canceled. Defaults to
+	// context.Background().
+	Context context.Context
 }
 
 func (cc *CLI) Run(args []string) int {
 	c := cli.NewCLI("spire-agent", version.Version())
 	c.Args = args
+	ctx := cc.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	c.Commands = map[string]cli.CommandFactory{
 		"api fetch": func() (cli.Command, error) {
 			return api.NewFetchX509Command(), nil
@@ -37,11 +50,21 @@ func (cc *CLI) Run(args []string) int {
 			return &api.WatchCLI{}, nil
 		},
 		"run": func() (cli.Command, error) {
-			return run.NewRunCommand(cc.LogOptions, cc.AllowUnknownConfig), nil
+			return run.NewRunCommand(ctx, cc.LogOptions, cc.AllowUnknownConfig), nil
 		},
 		"healthcheck": func() (cli.Command, error) {
 			return healthcheck.NewHealthCheckCommand(), nil
 		},
+		"service install": func() (cli.Command, error) {
+			return winsvc.NewInstallCommand(winsvc.ServiceConfig{
+				Name:        ServiceName,
+				DisplayName: "SPIRE Agent",
+				Description: "Attests workloads and delivers their SPIFFE identities",
+			}), nil
+		},
+		"service uninstall": func() (cli.Command, error) {
+			return winsvc.NewUninstallCommand(ServiceName), nil
+		},
 		"validate": func() (cli.Command, error) {
 			return validate.NewValidateCommand(), nil
 		},
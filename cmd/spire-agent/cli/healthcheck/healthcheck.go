@@ -1,8 +1,10 @@
 package healthcheck
 
 import (
+	"context"
 	"errors"
 	"flag"
+	"fmt"
 	"net"
 	"time"
 
@@ -10,6 +12,7 @@ import (
 	api_workload "github.com/spiffe/spire/api/workload"
 	"github.com/spiffe/spire/cmd/spire-agent/cli/common"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/health"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -29,9 +32,17 @@ type healthCheckCommand struct {
 	env *common_cli.Env
 
 	socketPath string
+	endpoint   string
 	timeout    common_cli.DurationFlag
 	shallow    bool
 	verbose    bool
+	output     common_cli.OutputFlag
+}
+
+// healthResult is the JSON output of the command
+type healthResult struct {
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
 }
 
 func (c *healthCheckCommand) Help() string {
@@ -48,12 +59,18 @@ func (c *healthCheckCommand) Run(args []string) int {
 	if err := c.parseFlags(args); err != nil {
 		return 1
 	}
+	if c.output == common_cli.OutputJSON {
+		return c.runJSON()
+	}
 	if err := c.run(); err != nil {
 		// Ignore error since a failure to write to stderr cannot very well
 		// be reported
 		_ = c.env.ErrPrintln(err)
 		return 1
 	}
+	if err := c.env.Println("Agent is healthy."); err != nil {
+		return 1
+	}
 	return 0
 }
 
@@ -61,12 +78,48 @@ func (c *healthCheckCommand) parseFlags(args []string) error {
 	fs := flag.NewFlagSet("health", flag.ContinueOnError)
 	fs.SetOutput(c.env.Stderr)
 	fs.StringVar(&c.socketPath, "socketPath", common.DefaultSocketPath, "Path to Workload API socket")
+	fs.StringVar(&c.endpoint, "endpoint", "", "URL of the health check endpoint to query instead of the Workload API (e.g. http://localhost:80/ready)")
+	fs.Var(&c.timeout, "timeout", "Time to wait for the agent to respond")
 	fs.BoolVar(&c.shallow, "shallow", false, "Perform a less stringent health check")
 	fs.BoolVar(&c.verbose, "verbose", false, "Print verbose information")
+	common_cli.AddOutputFlag(fs, &c.output)
 	return fs.Parse(args)
 }
 
+// runJSON runs the health check and prints the result as JSON. Verbose
+// information is printed to stderr so stdout only holds the result.
+func (c *healthCheckCommand) runJSON() int {
+	env := c.env
+	c.env = &common_cli.Env{
+		Stdin:  env.Stdin,
+		Stdout: env.Stderr,
+		Stderr: env.Stderr,
+	}
+	err := c.run()
+	c.env = env
+
+	result := healthResult{Healthy: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	if err := c.env.PrintJSON(result); err != nil {
+		_ = c.env.ErrPrintln(err)
+		return 1
+	}
+	if !result.Healthy {
+		return 1
+	}
+	return 0
+}
+
 func (c *healthCheckCommand) run() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(c.timeout))
+	defer cancel()
+
+	if c.endpoint != "" {
+		return c.probeEndpoint(ctx)
+	}
+
 	addr := &net.UnixAddr{
 		Name: c.socketPath,
 		Net:  "unix",
@@ -95,6 +148,11 @@ func (c *healthCheckCommand) run() error {
 		if status.Code(err) == codes.Unavailable {
 			return errors.New("Agent is unavailable.") //nolint: golint // error is (ab)used for CLI output
 		}
+	case <-ctx.Done():
+		if c.verbose {
+			c.env.Printf("Timed out waiting for the Workload API\n")
+		}
+		return errors.New("Agent is unavailable.") //nolint: golint // error is (ab)used for CLI output
 	case <-client.UpdateChan():
 		if c.verbose {
 			if err := c.env.Println("SVID received over Workload API."); err != nil {
@@ -103,8 +161,17 @@ func (c *healthCheckCommand) run() error {
 		}
 	}
 
-	if err := c.env.Println("Agent is healthy."); err != nil {
-		return err
+	return nil
+}
+
+func (c *healthCheckCommand) probeEndpoint(ctx context.Context) error {
+	if c.verbose {
+		c.env.Printf("Querying health check endpoint %s...\n", c.endpoint)
 	}
+
+	if err := health.Probe(ctx, c.endpoint); err != nil {
+		return fmt.Errorf("Agent is unhealthy: %v", err) //nolint: golint // error is (ab)used for CLI output
+	}
+
 	return nil
 }
@@ -2,6 +2,8 @@ package healthcheck
 
 import (
 	"bytes"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/mitchellh/cli"
@@ -46,10 +48,16 @@ func (s *HealthCheckSuite) TestSynopsis() {
 func (s *HealthCheckSuite) TestHelp() {
 	s.Equal("", s.cmd.Help())
 	s.Equal(`Usage of health:
+  -endpoint string
+    	URL of the health check endpoint to query instead of the Workload API (e.g. http://localhost:80/ready)
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -shallow
     	Perform a less stringent health check
   -socketPath string
     	Path to Workload API socket (default "/tmp/agent.sock")
+  -timeout value
+    	Time to wait for the agent to respond (default 5s)
   -verbose
     	Print verbose information
 `, s.stderr.String(), "stderr")
@@ -61,10 +69,16 @@ func (s *HealthCheckSuite) TestBadFlags() {
 	s.Equal("", s.stdout.String(), "stdout")
 	s.Equal(`flag provided but not defined: -badflag
 Usage of health:
+  -endpoint string
+    	URL of the health check endpoint to query instead of the Workload API (e.g. http://localhost:80/ready)
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -shallow
     	Perform a less stringent health check
   -socketPath string
     	Path to Workload API socket (default "/tmp/agent.sock")
+  -timeout value
+    	Time to wait for the agent to respond (default 5s)
   -verbose
     	Print verbose information
 `, s.stderr.String(), "stderr")
@@ -121,6 +135,48 @@ Agent is healthy.
 	s.Equal("", s.stderr.String(), "stderr")
 }
 
+func (s *HealthCheckSuite) TestSucceedsIfEndpointHealthy() {
+	endpoint := s.startHealthEndpoint(http.StatusOK)
+	code := s.cmd.Run([]string{"--endpoint", endpoint, "--verbose"})
+	s.Equal(0, code, "exit code")
+	s.Equal(`Querying health check endpoint `+endpoint+`...
+Agent is healthy.
+`, s.stdout.String(), "stdout")
+	s.Equal("", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestFailsIfEndpointUnhealthy() {
+	endpoint := s.startHealthEndpoint(http.StatusInternalServerError)
+	code := s.cmd.Run([]string{"--endpoint", endpoint})
+	s.NotEqual(0, code, "exit code")
+	s.Equal("", s.stdout.String(), "stdout")
+	s.Equal("Agent is unhealthy: health endpoint returned \"500 Internal Server Error\"\n", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestSucceedsOnGoodResponseJSON() {
+	w := s.makeGoodWorkloadAPI()
+	code := s.cmd.Run([]string{"--socketPath", w.Addr().Name, "--output", "json"})
+	s.Equal(0, code, "exit code")
+	s.Equal(`{"healthy":true}`+"\n", s.stdout.String(), "stdout")
+	s.Equal("", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestFailsIfEndpointUnhealthyJSON() {
+	endpoint := s.startHealthEndpoint(http.StatusInternalServerError)
+	code := s.cmd.Run([]string{"--endpoint", endpoint, "--output", "json"})
+	s.NotEqual(0, code, "exit code")
+	s.Equal(`{"healthy":false,"error":"Agent is unhealthy: health endpoint returned \"500 Internal Server Error\""}`+"\n", s.stdout.String(), "stdout")
+	s.Equal("", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) startHealthEndpoint(statusCode int) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	s.T().Cleanup(server.Close)
+	return server.URL + "/ready"
+}
+
 func (s *HealthCheckSuite) makeFailedWorkloadAPI(err error) *fakeworkloadapi.WorkloadAPI {
 	return fakeworkloadapi.New(s.T(), fakeworkloadapi.FetchX509SVIDErrorOnce(err))
 }
@@ -23,14 +23,22 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/cmd/spire-agent/cli/common"
 	"github.com/spiffe/spire/pkg/agent"
+	agent_catalog "github.com/spiffe/spire/pkg/agent/catalog"
+	"github.com/spiffe/spire/pkg/agent/client"
+	"github.com/spiffe/spire/pkg/agent/endpoints"
+	"github.com/spiffe/spire/pkg/agent/manager/hooks"
 	"github.com/spiffe/spire/pkg/common/catalog"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/log"
 	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/common/profiling"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/util"
+	"google.golang.org/grpc/keepalive"
 )
 
 const (
@@ -39,10 +47,11 @@ const (
 	defaultConfigPath = "conf/agent/agent.conf"
 
 	// TODO: Make my defaults sane
-	defaultDataDir           = "."
-	defaultLogLevel          = "INFO"
-	defaultDefaultSVIDName   = "default"
-	defaultDefaultBundleName = "ROOTCA"
+	defaultDataDir               = "."
+	defaultLogLevel              = "INFO"
+	defaultDefaultSVIDName       = "default"
+	defaultDefaultBundleName     = "ROOTCA"
+	defaultDefaultAllBundlesName = "ALL"
 )
 
 // Config contains all available configurables, arranged by section
@@ -55,57 +64,119 @@ type Config struct {
 }
 
 type agentConfig struct {
-	DataDir           string    `hcl:"data_dir"`
-	AdminSocketPath   string    `hcl:"admin_socket_path"`
-	InsecureBootstrap bool      `hcl:"insecure_bootstrap"`
-	JoinToken         string    `hcl:"join_token"`
-	LogFile           string    `hcl:"log_file"`
-	LogFormat         string    `hcl:"log_format"`
-	LogLevel          string    `hcl:"log_level"`
-	SDS               sdsConfig `hcl:"sds"`
-	ServerAddress     string    `hcl:"server_address"`
-	ServerPort        int       `hcl:"server_port"`
-	SocketPath        string    `hcl:"socket_path"`
-	TrustBundlePath   string    `hcl:"trust_bundle_path"`
-	TrustBundleURL    string    `hcl:"trust_bundle_url"`
-	TrustDomain       string    `hcl:"trust_domain"`
+	DataDir                string             `hcl:"data_dir"`
+	AdminSocketPath        string             `hcl:"admin_socket_path"`
+	AuditLog               *auditLogConfig    `hcl:"audit_log"`
+	GRPC                   *grpcConfig        `hcl:"grpc"`
+	InsecureBootstrap      bool               `hcl:"insecure_bootstrap"`
+	JoinToken              string             `hcl:"join_token"`
+	LogFile                string             `hcl:"log_file"`
+	LogFormat              string             `hcl:"log_format"`
+	LogLevel               string             `hcl:"log_level"`
+	LogRotation            *logRotationConfig `hcl:"log_rotation"`
+	RequireFIPS            bool               `hcl:"require_fips"`
+	RequirePluginChecksums bool               `hcl:"require_plugin_checksums"`
+	ReuseWorkloadKeys      bool               `hcl:"reuse_workload_keys"`
+	RotationHooks          []rotationHook     `hcl:"rotation_hooks"`
+	SDS                    sdsConfig          `hcl:"sds"`
+	ServerAddress          string             `hcl:"server_address"`
+	ServerAddresses        []string           `hcl:"server_addresses"`
+	ServerPort             int                `hcl:"server_port"`
+	SocketGroup            string             `hcl:"socket_group"`
+	SocketMode             string             `hcl:"socket_mode"`
+	SocketOwner            string             `hcl:"socket_owner"`
+	SocketPath             string             `hcl:"socket_path"`
+	SubsystemLogLevels     map[string]string  `hcl:"subsystem_log_levels"`
+	TrustBundlePath        string             `hcl:"trust_bundle_path"`
+	TrustBundleURL         string             `hcl:"trust_bundle_url"`
+	TrustDomain            string             `hcl:"trust_domain"`
+	WorkloadAPI            *workloadAPIConfig `hcl:"workload_api"`
 
 	ConfigPath string
 	ExpandEnv  bool
 
+	// Profiling configurables
+	ProfilingEnabled bool     `hcl:"profiling_enabled"`
+	ProfilingPort    int      `hcl:"profiling_port"`
+	ProfilingFreq    int      `hcl:"profiling_freq"`
+	ProfilingNames   []string `hcl:"profiling_names"`
+	ProfilingDir     string   `hcl:"profiling_dir"`
+
 	// Undocumented configurables
-	ProfilingEnabled bool               `hcl:"profiling_enabled"`
-	ProfilingPort    int                `hcl:"profiling_port"`
-	ProfilingFreq    int                `hcl:"profiling_freq"`
-	ProfilingNames   []string           `hcl:"profiling_names"`
-	Experimental     experimentalConfig `hcl:"experimental"`
+	Experimental experimentalConfig `hcl:"experimental"`
 
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
 
 type sdsConfig struct {
-	DefaultSVIDName   string `hcl:"default_svid_name"`
-	DefaultBundleName string `hcl:"default_bundle_name"`
+	DefaultSVIDName             string `hcl:"default_svid_name"`
+	DefaultBundleName           string `hcl:"default_bundle_name"`
+	DefaultAllBundlesName       string `hcl:"default_all_bundles_name"`
+	DisableSPIFFECertValidation bool   `hcl:"disable_spiffe_cert_validation"`
+}
+
+type workloadAPIConfig struct {
+	CallerMetrics          bool           `hcl:"caller_metrics"`
+	MaxStreamsPerUID       int            `hcl:"max_streams_per_uid"`
+	MaxConnectionsPerUID   int            `hcl:"max_connections_per_uid"`
+	ConnectionLimitsPerUID map[string]int `hcl:"connection_limits_per_uid"`
+	UnusedKeys             []string       `hcl:",unusedKeys"`
+}
+
+type rotationHook struct {
+	Command    []string `hcl:"command"`
+	Timeout    string   `hcl:"timeout"`
+	Events     []string `hcl:"events"`
+	SPIFFEIDs  []string `hcl:"spiffe_ids"`
+	UnusedKeys []string `hcl:",unusedKeys"`
+}
+
+type grpcConfig struct {
+	KeepaliveTime                string   `hcl:"keepalive_time"`
+	KeepaliveTimeout             string   `hcl:"keepalive_timeout"`
+	PermitKeepaliveWithoutStream bool     `hcl:"permit_keepalive_without_stream"`
+	UnusedKeys                   []string `hcl:",unusedKeys"`
+}
+
+type auditLogConfig struct {
+	Destinations []string `hcl:"destinations"`
+	LogFormat    string   `hcl:"log_format"`
+	UnusedKeys   []string `hcl:",unusedKeys"`
+}
+
+type logRotationConfig struct {
+	MaxSizeMB  int      `hcl:"max_size_mb"`
+	MaxAgeDays int      `hcl:"max_age_days"`
+	MaxBackups int      `hcl:"max_backups"`
+	Compress   bool     `hcl:"compress"`
+	UnusedKeys []string `hcl:",unusedKeys"`
 }
 
 type experimentalConfig struct {
-	SyncInterval string `hcl:"sync_interval"`
+	FeatureFlags []string `hcl:"feature_flags"`
+	SyncInterval string   `hcl:"sync_interval"`
 
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
 
 type Command struct {
+	ctx                context.Context
 	logOptions         []log.Option
 	env                *common_cli.Env
 	allowUnknownConfig bool
 }
 
-func NewRunCommand(logOptions []log.Option, allowUnknownConfig bool) cli.Command {
-	return newRunCommand(common_cli.DefaultEnv, logOptions, allowUnknownConfig)
+// NewRunCommand returns the run command. It stops when the process is
+// signaled or the given context is canceled.
+func NewRunCommand(ctx context.Context, logOptions []log.Option, allowUnknownConfig bool) cli.Command {
+	cmd := newRunCommand(common_cli.DefaultEnv, logOptions, allowUnknownConfig)
+	cmd.ctx = ctx
+	return cmd
 }
 
 func newRunCommand(env *common_cli.Env, logOptions []log.Option, allowUnknownConfig bool) *Command {
 	return &Command{
+		ctx:                context.Background(),
 		env:                env,
 		logOptions:         logOptions,
 		allowUnknownConfig: allowUnknownConfig,
@@ -126,6 +197,34 @@ func Help(name string, writer io.Writer) string {
 }
 
 func LoadConfig(name string, args []string, logOptions []log.Option, output io.Writer, allowUnknownConfig bool) (*agent.Config, error) {
+	input, err := loadInput(name, args, output)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAgentConfig(input, logOptions, allowUnknownConfig)
+}
+
+// ValidateConfig loads the configuration in the same way as LoadConfig and
+// validates it, including the plugin configuration, without starting the
+// agent or loading any plugin. All of the problems found are returned.
+func ValidateConfig(name string, args []string, output io.Writer) []error {
+	input, err := loadInput(name, args, output)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	if _, err := NewAgentConfig(input, nil, false); err != nil {
+		errs = append(errs, err)
+	}
+	if input.Plugins != nil {
+		errs = append(errs, agent_catalog.Validate(*input.Plugins)...)
+	}
+	return errs
+}
+
+func loadInput(name string, args []string, output io.Writer) (*Config, error) {
 	// First parse the CLI flags so we can get the config
 	// file path, if set
 	cliInput, err := parseFlags(name, args, output)
@@ -140,12 +239,7 @@ func LoadConfig(name string, args []string, logOptions []log.Option, output io.W
 		return nil, err
 	}
 
-	input, err := mergeInput(fileInput, cliInput)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewAgentConfig(input, logOptions, allowUnknownConfig)
+	return mergeInput(fileInput, cliInput)
 }
 
 func (cmd *Command) Run(args []string) int {
@@ -168,6 +262,14 @@ func (cmd *Command) Run(args []string) int {
 	// Set umask before starting up the agent
 	common_cli.SetUmask(c.Log)
 
+	if err := fflag.Load(c.FeatureFlags); err != nil {
+		c.Log.WithError(err).Error("Failed to load feature flags")
+		return 1
+	}
+	if enabled := fflag.Enabled(); len(enabled) > 0 {
+		c.Log.WithField(telemetry.FeatureFlags, enabled).Warn("Experimental features are enabled")
+	}
+
 	if c.AdminBindAddress != nil {
 		// Create uds dir and parents if not exists
 		adminDir := filepath.Dir(c.AdminBindAddress.String())
@@ -182,9 +284,17 @@ func (cmd *Command) Run(args []string) int {
 
 	a := agent.New(c)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(cmd.ctx)
 	defer cancel()
 	util.SignalListener(ctx, cancel)
+	util.ReloadListener(ctx, func() {
+		c.Log.Info("Reloading configuration")
+		if err := cmd.reload(ctx, args, c, a); err != nil {
+			c.Log.WithError(err).Error("Failed to reload configuration")
+			return
+		}
+		c.Log.Info("Configuration reloaded")
+	})
 
 	err = a.Run(ctx)
 	if err != nil {
@@ -196,6 +306,34 @@ func (cmd *Command) Run(args []string) int {
 	return 0
 }
 
+// reload loads the configuration again and applies the settings that can be
+// changed while the agent is running. The logger of the running agent is
+// kept; only its levels are changed.
+func (cmd *Command) reload(ctx context.Context, args []string, current *agent.Config, a *agent.Agent) error {
+	input, err := loadInput(commandName, args, cmd.env.Stderr)
+	if err != nil {
+		return err
+	}
+
+	c, err := NewAgentConfig(input, cmd.logOptions, cmd.allowUnknownConfig)
+	if err != nil {
+		return err
+	}
+	for _, logger := range []logrus.FieldLogger{c.Log, c.AuditLog} {
+		if closer, ok := logger.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+
+	if logger, ok := current.Log.(*log.Logger); ok {
+		if err := logger.SetLevels(input.Agent.LogLevel, input.Agent.SubsystemLogLevels); err != nil {
+			return err
+		}
+	}
+
+	return a.Reload(ctx, c)
+}
+
 func (*Command) Synopsis() string {
 	return "Runs the agent"
 }
@@ -351,8 +489,57 @@ func NewAgentConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool)
 		}
 	}
 
-	serverHostPort := net.JoinHostPort(c.Agent.ServerAddress, strconv.Itoa(c.Agent.ServerPort))
-	ac.ServerAddress = fmt.Sprintf("dns:///%s", serverHostPort)
+	if err := fflag.Validate(c.Agent.Experimental.FeatureFlags); err != nil {
+		return nil, err
+	}
+	ac.FeatureFlags = c.Agent.Experimental.FeatureFlags
+
+	if len(c.Agent.ServerAddresses) > 0 {
+		var serverHostPorts []string
+		for _, serverAddress := range c.Agent.ServerAddresses {
+			if _, _, err := net.SplitHostPort(serverAddress); err != nil {
+				serverAddress = net.JoinHostPort(serverAddress, strconv.Itoa(c.Agent.ServerPort))
+			}
+			serverHostPorts = append(serverHostPorts, serverAddress)
+		}
+		ac.ServerAddress = client.FailoverTarget(serverHostPorts)
+	} else {
+		serverHostPort := net.JoinHostPort(c.Agent.ServerAddress, strconv.Itoa(c.Agent.ServerPort))
+		ac.ServerAddress = fmt.Sprintf("dns:///%s", serverHostPort)
+	}
+
+	if c.Agent.GRPC != nil {
+		serverKeepalive, err := serverKeepaliveFromConfig(c.Agent.GRPC)
+		if err != nil {
+			return nil, err
+		}
+		ac.ServerKeepalive = serverKeepalive
+	}
+
+	for i, hook := range c.Agent.RotationHooks {
+		rotationHook, err := rotationHookFromConfig(hook)
+		if err != nil {
+			return nil, fmt.Errorf("invalid rotation hook %d: %w", i, err)
+		}
+		ac.RotationHooks = append(ac.RotationHooks, rotationHook)
+	}
+
+	if c.Agent.WorkloadAPI != nil {
+		if c.Agent.WorkloadAPI.MaxStreamsPerUID < 0 {
+			return nil, fmt.Errorf("workload_api max_streams_per_uid must not be negative; got %d", c.Agent.WorkloadAPI.MaxStreamsPerUID)
+		}
+		ac.WorkloadAPIMaxStreamsPerUID = c.Agent.WorkloadAPI.MaxStreamsPerUID
+		if c.Agent.WorkloadAPI.MaxConnectionsPerUID < 0 {
+			return nil, fmt.Errorf("workload_api max_connections_per_uid must not be negative; got %d", c.Agent.WorkloadAPI.MaxConnectionsPerUID)
+		}
+		ac.WorkloadAPIMaxConnectionsPerUID = c.Agent.WorkloadAPI.MaxConnectionsPerUID
+		connLimits, err := connectionLimitsFromConfig(c.Agent.WorkloadAPI.ConnectionLimitsPerUID)
+		if err != nil {
+			return nil, err
+		}
+		ac.WorkloadAPIConnectionLimitsPerUID = connLimits
+		ac.WorkloadAPICallerMetrics = c.Agent.WorkloadAPI.CallerMetrics
+	}
 
 	td, err := idutil.ParseSpiffeID("spiffe://"+c.Agent.TrustDomain, idutil.AllowAnyTrustDomain())
 	if err != nil {
@@ -364,6 +551,11 @@ func NewAgentConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool)
 		Name: c.Agent.SocketPath,
 		Net:  "unix",
 	}
+	socketPermissions, err := diskutil.NewSocketPermissions(c.Agent.SocketMode, c.Agent.SocketOwner, c.Agent.SocketGroup, endpoints.DefaultSocketMode)
+	if err != nil {
+		return nil, fmt.Errorf("could not configure socket permissions: %w", err)
+	}
+	ac.BindPermissions = socketPermissions
 
 	if c.Agent.AdminSocketPath != "" {
 		socketPathAbs, err := filepath.Abs(c.Agent.SocketPath)
@@ -388,11 +580,15 @@ func NewAgentConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool)
 	ac.DataDir = c.Agent.DataDir
 	ac.DefaultSVIDName = c.Agent.SDS.DefaultSVIDName
 	ac.DefaultBundleName = c.Agent.SDS.DefaultBundleName
+	ac.DefaultAllBundlesName = c.Agent.SDS.DefaultAllBundlesName
+	ac.DisableSPIFFECertValidation = c.Agent.SDS.DisableSPIFFECertValidation
 
 	logOptions = append(logOptions,
 		log.WithLevel(c.Agent.LogLevel),
+		log.WithSubsystemLevels(c.Agent.SubsystemLogLevels),
 		log.WithFormat(c.Agent.LogFormat),
-		log.WithOutputFile(c.Agent.LogFile))
+		logOutputOption(c.Agent.LogFile, c.Agent.LogRotation),
+		log.WithFields(logrus.Fields{telemetry.TrustDomain: td.Host}))
 
 	logger, err := log.NewLogger(logOptions...)
 	if err != nil {
@@ -400,17 +596,32 @@ func NewAgentConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool)
 	}
 	ac.Log = logger
 
+	if c.Agent.AuditLog != nil {
+		auditLogger, err := newAuditLogger(c.Agent.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		ac.AuditLog = auditLogger
+	}
+
 	err = setupTrustBundle(ac, c)
 	if err != nil {
 		return nil, err
 	}
 
+	if err := profiling.ValidateProfiles(c.Agent.ProfilingNames); err != nil {
+		return nil, fmt.Errorf("invalid profiling_names: %v", err)
+	}
 	ac.ProfilingEnabled = c.Agent.ProfilingEnabled
 	ac.ProfilingPort = c.Agent.ProfilingPort
 	ac.ProfilingFreq = c.Agent.ProfilingFreq
 	ac.ProfilingNames = c.Agent.ProfilingNames
+	ac.ProfilingDir = c.Agent.ProfilingDir
 
 	ac.PluginConfigs = *c.Plugins
+	ac.RequirePluginChecksums = c.Agent.RequirePluginChecksums
+	ac.RequireFIPS = c.Agent.RequireFIPS
+	ac.ReuseWorkloadKeys = c.Agent.ReuseWorkloadKeys
 	ac.Telemetry = c.Telemetry
 	ac.HealthChecks = c.HealthChecks
 
@@ -428,8 +639,16 @@ func validateConfig(c *Config) error {
 		return errors.New("agent section must be configured")
 	}
 
-	if c.Agent.ServerAddress == "" {
-		return errors.New("server_address must be configured")
+	switch {
+	case c.Agent.ServerAddress == "" && len(c.Agent.ServerAddresses) == 0:
+		return errors.New("server_address or server_addresses must be configured")
+	case c.Agent.ServerAddress != "" && len(c.Agent.ServerAddresses) > 0:
+		return errors.New("server_address and server_addresses cannot both be configured")
+	}
+	for _, serverAddress := range c.Agent.ServerAddresses {
+		if serverAddress == "" || strings.Contains(serverAddress, ",") {
+			return fmt.Errorf("invalid server address %q", serverAddress)
+		}
 	}
 
 	if c.Agent.ServerPort == 0 {
@@ -486,6 +705,30 @@ func checkForUnknownConfig(c *Config, l logrus.FieldLogger) (err error) {
 		detectedUnknown("agent", a.UnusedKeys)
 	}
 
+	if a := c.Agent; a != nil && a.LogRotation != nil && len(a.LogRotation.UnusedKeys) != 0 {
+		detectedUnknown("log_rotation", a.LogRotation.UnusedKeys)
+	}
+
+	if a := c.Agent; a != nil && a.AuditLog != nil && len(a.AuditLog.UnusedKeys) != 0 {
+		detectedUnknown("audit_log", a.AuditLog.UnusedKeys)
+	}
+
+	if a := c.Agent; a != nil && a.GRPC != nil && len(a.GRPC.UnusedKeys) != 0 {
+		detectedUnknown("grpc", a.GRPC.UnusedKeys)
+	}
+
+	if a := c.Agent; a != nil && a.WorkloadAPI != nil && len(a.WorkloadAPI.UnusedKeys) != 0 {
+		detectedUnknown("workload_api", a.WorkloadAPI.UnusedKeys)
+	}
+
+	if a := c.Agent; a != nil {
+		for _, hook := range a.RotationHooks {
+			if len(hook.UnusedKeys) != 0 {
+				detectedUnknown("rotation_hooks", hook.UnusedKeys)
+			}
+		}
+	}
+
 	// TODO: Re-enable unused key detection for telemetry. See
 	// https://github.com/spiffe/spire/issues/1101 for more information
 	//
@@ -519,6 +762,10 @@ func checkForUnknownConfig(c *Config, l logrus.FieldLogger) (err error) {
 		detectedUnknown("InMem", p.UnusedKeys)
 	}
 
+	if p := c.Telemetry.Tracing; p != nil && len(p.UnusedKeys) != 0 {
+		detectedUnknown("Tracing", p.UnusedKeys)
+	}
+
 	if len(c.HealthChecks.UnusedKeys) != 0 {
 		detectedUnknown("health check", c.HealthChecks.UnusedKeys)
 	}
@@ -534,8 +781,9 @@ func defaultConfig() *Config {
 			LogFormat:  log.DefaultFormat,
 			SocketPath: common.DefaultSocketPath,
 			SDS: sdsConfig{
-				DefaultBundleName: defaultDefaultBundleName,
-				DefaultSVIDName:   defaultDefaultSVIDName,
+				DefaultBundleName:     defaultDefaultBundleName,
+				DefaultAllBundlesName: defaultDefaultAllBundlesName,
+				DefaultSVIDName:       defaultDefaultSVIDName,
 			},
 		},
 	}
@@ -553,3 +801,124 @@ func parseTrustBundle(path string) ([]*x509.Certificate, error) {
 
 	return bundle, nil
 }
+
+// newAuditLogger creates the logger that SVID delivery audit records are
+// written to. Records are written as JSON unless another format is configured.
+func newAuditLogger(c *auditLogConfig) (*log.Logger, error) {
+	format := c.LogFormat
+	if format == log.DefaultFormat {
+		format = log.JSONFormat
+	}
+	auditLogger, err := log.NewLogger(
+		log.WithFormat(format),
+		log.WithOutputs(c.Destinations))
+	if err != nil {
+		return nil, fmt.Errorf("could not start audit logger: %v", err)
+	}
+	return auditLogger, nil
+}
+
+// logOutputOption returns the option that sets the output of the logger. The
+// log file is rotated when log rotation is configured.
+func logOutputOption(logFile string, c *logRotationConfig) log.Option {
+	if c == nil {
+		return log.WithOutputFile(logFile)
+	}
+	return log.WithRotatingOutputFile(logFile, log.RotationConfig{
+		MaxSize:    c.MaxSizeMB,
+		MaxAge:     c.MaxAgeDays,
+		MaxBackups: c.MaxBackups,
+		Compress:   c.Compress,
+	})
+}
+
+// serverKeepaliveFromConfig returns the keepalive parameters of the
+// connection to the server. Keepalive pings are only sent if keepalive_time
+// is set.
+func serverKeepaliveFromConfig(c *grpcConfig) (*keepalive.ClientParameters, error) {
+	if c.KeepaliveTime == "" {
+		if c.KeepaliveTimeout != "" || c.PermitKeepaliveWithoutStream {
+			return nil, errors.New("grpc keepalive_time must be set to configure keepalive pings")
+		}
+		return nil, nil
+	}
+
+	params := &keepalive.ClientParameters{
+		PermitWithoutStream: c.PermitKeepaliveWithoutStream,
+	}
+
+	var err error
+	params.Time, err = time.ParseDuration(c.KeepaliveTime)
+	if err != nil {
+		return nil, fmt.Errorf("could not parse grpc keepalive_time %q: %v", c.KeepaliveTime, err)
+	}
+	if params.Time <= 0 {
+		return nil, fmt.Errorf("grpc keepalive_time must be positive; got %q", c.KeepaliveTime)
+	}
+
+	if c.KeepaliveTimeout != "" {
+		params.Timeout, err = time.ParseDuration(c.KeepaliveTimeout)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse grpc keepalive_timeout %q: %v", c.KeepaliveTimeout, err)
+		}
+		if params.Timeout <= 0 {
+			return nil, fmt.Errorf("grpc keepalive_timeout must be positive; got %q", c.KeepaliveTimeout)
+		}
+	}
+	return params, nil
+}
+
+// rotationHookFromConfig returns the hook for the rotation_hooks entry.
+func rotationHookFromConfig(c rotationHook) (hooks.Hook, error) {
+	if len(c.Command) == 0 || c.Command[0] == "" {
+		return hooks.Hook{}, errors.New("command is required")
+	}
+
+	hook := hooks.Hook{
+		Command:   c.Command,
+		SPIFFEIDs: c.SPIFFEIDs,
+	}
+	if c.Timeout != "" {
+		timeout, err := time.ParseDuration(c.Timeout)
+		if err != nil {
+			return hooks.Hook{}, fmt.Errorf("could not parse timeout %q: %v", c.Timeout, err)
+		}
+		if timeout <= 0 {
+			return hooks.Hook{}, fmt.Errorf("timeout must be positive; got %q", c.Timeout)
+		}
+		hook.Timeout = timeout
+	}
+	for _, name := range c.Events {
+		event, err := hooks.ParseEvent(name)
+		if err != nil {
+			return hooks.Hook{}, err
+		}
+		hook.Events = append(hook.Events, event)
+	}
+	for _, spiffeID := range c.SPIFFEIDs {
+		if _, err := idutil.ParseSpiffeID(spiffeID, idutil.AllowAny()); err != nil {
+			return hooks.Hook{}, fmt.Errorf("invalid SPIFFE ID %q: %v", spiffeID, err)
+		}
+	}
+	return hook, nil
+}
+
+// connectionLimitsFromConfig parses the connection_limits_per_uid map of
+// the workload_api section, which is keyed by UID.
+func connectionLimitsFromConfig(c map[string]int) (map[uint32]int, error) {
+	if len(c) == 0 {
+		return nil, nil
+	}
+	limits := make(map[uint32]int, len(c))
+	for key, limit := range c {
+		uid, err := strconv.ParseUint(key, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("workload_api connection_limits_per_uid has an invalid UID %q", key)
+		}
+		if limit < 0 {
+			return nil, fmt.Errorf("workload_api connection_limits_per_uid for UID %d must not be negative; got %d", uid, limit)
+		}
+		limits[uint32(uid)] = limit
+	}
+	return limits, nil
+}
@@ -2,6 +2,7 @@ package run
 
 import (
 	"bytes"
+	"context"
 	"io"
 	"io/ioutil"
 	"net/http"
@@ -10,17 +11,24 @@ import (
 	"path"
 	"path/filepath"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/hcl/hcl/printer"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/spiffe/spire/pkg/agent"
+	"github.com/spiffe/spire/pkg/agent/manager/hooks"
 	"github.com/spiffe/spire/pkg/common/catalog"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/log"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/spiffe/spire/test/util"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/keepalive"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 func TestDownloadTrustBundle(t *testing.T) {
@@ -91,6 +99,7 @@ func TestParseConfigGood(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, c.Agent.DataDir, ".")
 	assert.Equal(t, c.Agent.LogLevel, "INFO")
+	assert.Equal(t, c.Agent.SubsystemLogLevels, map[string]string{"attestor": "DEBUG"})
 	assert.Equal(t, c.Agent.ServerAddress, "127.0.0.1")
 	assert.Equal(t, c.Agent.ServerPort, 8081)
 	assert.Equal(t, c.Agent.SocketPath, "/tmp/agent.sock")
@@ -232,6 +241,38 @@ func TestMergeInput(t *testing.T) {
 				require.Equal(t, "foo", c.Agent.SDS.DefaultBundleName)
 			},
 		},
+		{
+			msg:       "default_all_bundles_name should default value of ALL",
+			fileInput: func(c *Config) {},
+			cliInput:  func(c *agentConfig) {},
+			test: func(t *testing.T, c *Config) {
+				require.Equal(t, "ALL", c.Agent.SDS.DefaultAllBundlesName)
+			},
+		},
+		{
+			msg: "default_all_bundles_name should be configurable by file",
+			fileInput: func(c *Config) {
+				c.Agent.SDS = sdsConfig{
+					DefaultAllBundlesName: "foo",
+				}
+			},
+			cliInput: func(c *agentConfig) {},
+			test: func(t *testing.T, c *Config) {
+				require.Equal(t, "foo", c.Agent.SDS.DefaultAllBundlesName)
+			},
+		},
+		{
+			msg: "disable_spiffe_cert_validation should be configurable by file",
+			fileInput: func(c *Config) {
+				c.Agent.SDS = sdsConfig{
+					DisableSPIFFECertValidation: true,
+				}
+			},
+			cliInput: func(c *agentConfig) {},
+			test: func(t *testing.T, c *Config) {
+				require.True(t, c.Agent.SDS.DisableSPIFFECertValidation)
+			},
+		},
 		{
 			msg: "insecure_bootstrap should be configurable by file",
 			fileInput: func(c *Config) {
@@ -628,6 +669,70 @@ func TestNewAgentConfig(t *testing.T) {
 				require.Equal(t, "dns:///192.168.1.1:1337", c.ServerAddress)
 			},
 		},
+		{
+			msg: "server_addresses should be correctly parsed",
+			input: func(c *Config) {
+				c.Agent.ServerAddress = ""
+				c.Agent.ServerAddresses = []string{"192.168.1.1", "spire-server:8443", "::1"}
+				c.Agent.ServerPort = 1337
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, "spire-failover:///192.168.1.1:1337,spire-server:8443,[::1]:1337", c.ServerAddress)
+			},
+		},
+		{
+			msg:         "server_address and server_addresses cannot both be configured",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.ServerAddresses = []string{"192.168.1.2"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "server_address or server_addresses must be configured",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.ServerAddress = ""
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "server_addresses cannot contain commas",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.ServerAddress = ""
+				c.Agent.ServerAddresses = []string{"192.168.1.1,192.168.1.2"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "sds resource names should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.SDS.DefaultSVIDName = "svid"
+				c.Agent.SDS.DefaultBundleName = "bundle"
+				c.Agent.SDS.DefaultAllBundlesName = "all"
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, "svid", c.DefaultSVIDName)
+				require.Equal(t, "bundle", c.DefaultBundleName)
+				require.Equal(t, "all", c.DefaultAllBundlesName)
+			},
+		},
+		{
+			msg: "disable_spiffe_cert_validation should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.SDS.DisableSPIFFECertValidation = true
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.DisableSPIFFECertValidation)
+			},
+		},
 		{
 			msg: "trust_domain should be correctly parsed",
 			input: func(c *Config) {
@@ -657,6 +762,78 @@ func TestNewAgentConfig(t *testing.T) {
 				require.Equal(t, "unix", c.BindAddress.Net)
 			},
 		},
+		{
+			msg:   "server keepalive is disabled by default",
+			input: func(c *Config) {},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c.ServerKeepalive)
+			},
+		},
+		{
+			msg: "grpc keepalive settings should be correctly parsed",
+			input: func(c *Config) {
+				c.Agent.GRPC = &grpcConfig{
+					KeepaliveTime:                "30s",
+					KeepaliveTimeout:             "5s",
+					PermitKeepaliveWithoutStream: true,
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, &keepalive.ClientParameters{
+					Time:                30 * time.Second,
+					Timeout:             5 * time.Second,
+					PermitWithoutStream: true,
+				}, c.ServerKeepalive)
+			},
+		},
+		{
+			msg:         "grpc keepalive_timeout without keepalive_time should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.GRPC = &grpcConfig{KeepaliveTimeout: "5s"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "invalid grpc keepalive_time should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.GRPC = &grpcConfig{KeepaliveTime: "-1s"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:   "socket permissions should default to world accessible",
+			input: func(c *Config) {},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, &diskutil.SocketPermissions{Mode: 0777, UID: -1, GID: -1}, c.BindPermissions)
+			},
+		},
+		{
+			msg: "socket_mode, socket_owner and socket_group should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.SocketMode = "0660"
+				c.Agent.SocketOwner = "1000"
+				c.Agent.SocketGroup = "2000"
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, &diskutil.SocketPermissions{Mode: 0660, UID: 1000, GID: 2000}, c.BindPermissions)
+			},
+		},
+		{
+			msg:         "invalid socket_mode should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.SocketMode = "rw-rw----"
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
 		{
 			msg: "insecure_bootsrap should be correctly set to false",
 			input: func(c *Config) {
@@ -675,6 +852,163 @@ func TestNewAgentConfig(t *testing.T) {
 				require.True(t, c.InsecureBootstrap)
 			},
 		},
+		{
+			msg: "require_plugin_checksums should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.RequirePluginChecksums = true
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.RequirePluginChecksums)
+			},
+		},
+		{
+			msg: "workload_api max_streams_per_uid should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{MaxStreamsPerUID: 10}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, 10, c.WorkloadAPIMaxStreamsPerUID)
+			},
+		},
+		{
+			msg: "workload_api caller_metrics should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{CallerMetrics: true}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.WorkloadAPICallerMetrics)
+			},
+		},
+		{
+			msg:         "workload_api max_streams_per_uid should not be negative",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{MaxStreamsPerUID: -1}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "workload_api connection limits should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{
+					MaxConnectionsPerUID:   10,
+					ConnectionLimitsPerUID: map[string]int{"0": 0, "1000": 50},
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, 10, c.WorkloadAPIMaxConnectionsPerUID)
+				require.Equal(t, map[uint32]int{0: 0, 1000: 50}, c.WorkloadAPIConnectionLimitsPerUID)
+			},
+		},
+		{
+			msg:         "workload_api max_connections_per_uid should not be negative",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{MaxConnectionsPerUID: -1}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "workload_api connection_limits_per_uid should be keyed by UID",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{ConnectionLimitsPerUID: map[string]int{"root": 0}}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "workload_api connection_limits_per_uid should not be negative",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.WorkloadAPI = &workloadAPIConfig{ConnectionLimitsPerUID: map[string]int{"1000": -1}}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "require_fips should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.RequireFIPS = true
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.RequireFIPS)
+			},
+		},
+		{
+			msg: "reuse_workload_keys should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.ReuseWorkloadKeys = true
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.ReuseWorkloadKeys)
+			},
+		},
+		{
+			msg: "rotation_hooks should be correctly configured",
+			input: func(c *Config) {
+				c.Agent.RotationHooks = []rotationHook{
+					{
+						Command:   []string{"/usr/sbin/nginx", "-s", "reload"},
+						Timeout:   "10s",
+						Events:    []string{"x509_svid"},
+						SPIFFEIDs: []string{"spiffe://example.org/nginx"},
+					},
+					{
+						Command: []string{"/usr/local/bin/reload-bundle"},
+					},
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, []hooks.Hook{
+					{
+						Command:   []string{"/usr/sbin/nginx", "-s", "reload"},
+						Timeout:   10 * time.Second,
+						Events:    []hooks.Event{hooks.X509SVIDRotated},
+						SPIFFEIDs: []string{"spiffe://example.org/nginx"},
+					},
+					{
+						Command: []string{"/usr/local/bin/reload-bundle"},
+					},
+				}, c.RotationHooks)
+			},
+		},
+		{
+			msg:         "rotation_hooks command is required",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.RotationHooks = []rotationHook{{Timeout: "10s"}}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "rotation_hooks events must be known",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.RotationHooks = []rotationHook{{Command: []string{"true"}, Events: []string{"jwt_svid"}}}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "rotation_hooks timeout must be positive",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.RotationHooks = []rotationHook{{Command: []string{"true"}, Timeout: "0s"}}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
 		{
 			msg: "join_token should be correctly configured",
 			input: func(c *Config) {
@@ -782,6 +1116,25 @@ func TestNewAgentConfig(t *testing.T) {
 				require.Nil(t, c)
 			},
 		},
+		{
+			msg: "feature_flags are configured correctly",
+			input: func(c *Config) {
+				c.Agent.Experimental.FeatureFlags = []string{"i_am_a_test_flag"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, fflag.RawConfig{"i_am_a_test_flag"}, c.FeatureFlags)
+			},
+		},
+		{
+			msg:         "unknown feature_flags return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.Experimental.FeatureFlags = []string{"foo"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
 		{
 			msg: "admin_socket_path should be correctly configured",
 			input: func(c *Config) {
@@ -858,6 +1211,115 @@ func TestNewAgentConfig(t *testing.T) {
 				require.Nil(t, c)
 			},
 		},
+		{
+			msg: "audit log is disabled by default",
+			input: func(c *Config) {
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c.AuditLog)
+			},
+		},
+		{
+			msg: "audit log is written as JSON by default",
+			input: func(c *Config) {
+				c.Agent.AuditLog = &auditLogConfig{
+					Destinations: []string{"stdout"},
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.NotNil(t, c.AuditLog)
+				require.IsType(t, &logrus.JSONFormatter{}, c.AuditLog.(*log.Logger).Formatter)
+			},
+		},
+		{
+			msg:         "audit log with an invalid format",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.AuditLog = &auditLogConfig{
+					LogFormat: "foo",
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "subsystem log levels are applied",
+			input: func(c *Config) {
+				c.Agent.SubsystemLogLevels = map[string]string{"ca": "DEBUG"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, logrus.DebugLevel, c.Log.(*log.Logger).Level)
+			},
+		},
+		{
+			msg:         "invalid subsystem log level",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.SubsystemLogLevels = map[string]string{"ca": "foo"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "profiling is configured",
+			input: func(c *Config) {
+				c.Agent.ProfilingEnabled = true
+				c.Agent.ProfilingPort = 6060
+				c.Agent.ProfilingFreq = 60
+				c.Agent.ProfilingNames = []string{"cpu", "heap"}
+				c.Agent.ProfilingDir = "/tmp/profiles"
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.True(t, c.ProfilingEnabled)
+				require.Equal(t, 6060, c.ProfilingPort)
+				require.Equal(t, 60, c.ProfilingFreq)
+				require.Equal(t, []string{"cpu", "heap"}, c.ProfilingNames)
+				require.Equal(t, "/tmp/profiles", c.ProfilingDir)
+			},
+		},
+		{
+			msg:         "unknown profiling name",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.ProfilingNames = []string{"cpu", "foo"}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "log rotation requires a log file",
+			expectError: true,
+			input: func(c *Config) {
+				c.Agent.LogRotation = &logRotationConfig{MaxSizeMB: 10}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "log file is rotated when log rotation is configured",
+			input: func(c *Config) {
+				c.Agent.LogFile = filepath.Join(os.TempDir(), "spire-agent-test.log")
+				c.Agent.LogRotation = &logRotationConfig{
+					MaxSizeMB:  10,
+					MaxAgeDays: 7,
+					MaxBackups: 3,
+					Compress:   true,
+				}
+			},
+			test: func(t *testing.T, c *agent.Config) {
+				require.Equal(t, &lumberjack.Logger{
+					Filename:   filepath.Join(os.TempDir(), "spire-agent-test.log"),
+					MaxSize:    10,
+					MaxAge:     7,
+					MaxBackups: 3,
+					Compress:   true,
+				}, c.Log.(*log.Logger).Out)
+			},
+		},
 	}
 
 	for _, testCase := range cases {
@@ -929,6 +1391,26 @@ func TestWarnOnUnknownConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg:      "in audit_log block",
+			confFile: "agent_bad_audit_log_block.conf",
+			expectedLogEntries: []logEntry{
+				{
+					section: "audit_log",
+					keys:    "unknown_option1,unknown_option2",
+				},
+			},
+		},
+		{
+			msg:      "in log_rotation block",
+			confFile: "agent_bad_log_rotation_block.conf",
+			expectedLogEntries: []logEntry{
+				{
+					section: "log_rotation",
+					keys:    "unknown_option1,unknown_option2",
+				},
+			},
+		},
 		// TODO: Re-enable unused key detection for telemetry. See
 		// https://github.com/spiffe/spire/issues/1101 for more information
 		//
@@ -1093,3 +1575,87 @@ func TestExpandEnv(t *testing.T) {
 		assert.Equal(t, testCase.expectedValue, c.Agent.TrustDomain)
 	}
 }
+
+func TestValidateConfigReportsAllErrors(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	configPath := filepath.Join(dir, "agent.conf")
+	writeConfig := func(agentBlock, pluginsBlock string) {
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`
+			agent {
+				data_dir = "`+dir+`"
+				server_address = "127.0.0.1"
+				server_port = "8081"
+				trust_domain = "example.org"
+				insecure_bootstrap = true
+				`+agentBlock+`
+			}
+			plugins {
+				`+pluginsBlock+`
+			}
+		`), 0600))
+	}
+	validate := func() []string {
+		var errs []string
+		for _, err := range ValidateConfig("validate", []string{"-config", configPath}, ioutil.Discard) {
+			errs = append(errs, err.Error())
+		}
+		return errs
+	}
+
+	writeConfig("", `
+		KeyManager "memory" {}
+		NodeAttestor "join_token" {}
+		WorkloadAttestor "unix" {}
+	`)
+	assert.Empty(t, validate())
+
+	writeConfig(`trust_domain = "Invalid Trust Domain"`, `
+		KeyManager "memory" {}
+		NodeAttestor "unknown" {}
+	`)
+	errs := validate()
+	require.Len(t, errs, 3)
+	assert.Contains(t, errs[0], "could not parse trust_domain")
+	assert.Equal(t, []string{
+		"expecting at least one WorkloadAttestor plugin",
+		`no such NodeAttestor builtin "unknown"`,
+	}, errs[1:])
+}
+
+func TestReloadChangesLogLevels(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	configPath := filepath.Join(dir, "agent.conf")
+	writeConfig := func(logLevel string) {
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`
+			agent {
+				data_dir = "`+dir+`"
+				log_level = "`+logLevel+`"
+				server_address = "127.0.0.1"
+				server_port = 8081
+				trust_domain = "example.org"
+				insecure_bootstrap = true
+			}
+			plugins {}
+		`), 0600))
+	}
+
+	logger, err := log.NewLogger(log.WithLevel("INFO"))
+	require.NoError(t, err)
+	current := &agent.Config{Log: logger}
+
+	cmd := newRunCommand(&common_cli.Env{Stderr: new(bytes.Buffer)}, nil, false)
+	args := []string{"-config", configPath}
+
+	// The log levels are changed even though there is no running agent
+	// whose plugins could be reconfigured
+	writeConfig("DEBUG")
+	err = cmd.reload(context.Background(), args, current, agent.New(current))
+	require.EqualError(t, err, "agent is not running")
+	require.Equal(t, logrus.DebugLevel, logger.Level)
+
+	// Invalid configuration leaves the log levels untouched
+	writeConfig("NOT-A-LEVEL")
+	err = cmd.reload(context.Background(), args, current, agent.New(current))
+	require.Error(t, err)
+	require.Equal(t, logrus.DebugLevel, logger.Level)
+}
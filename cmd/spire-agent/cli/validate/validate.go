@@ -32,9 +32,12 @@ func (c *validateCommand) Synopsis() string {
 }
 
 func (c *validateCommand) Run(args []string) int {
-	if _, err := run.LoadConfig(commandName, args, nil, c.env.Stderr, false); err != nil {
-		// Ignore error since a failure to write to stderr cannot very well be reported
-		_ = c.env.ErrPrintf("SPIRE agent configuration file is invalid: %v\n", err)
+	if errs := run.ValidateConfig(commandName, args, c.env.Stderr); len(errs) > 0 {
+		// Ignore errors since a failure to write to stderr cannot very well be reported
+		_ = c.env.ErrPrintln("SPIRE agent configuration file is invalid:")
+		for _, err := range errs {
+			_ = c.env.ErrPrintf("  - %v\n", err)
+		}
 		return 1
 	}
 	_ = c.env.Println("SPIRE agent configuration file is valid.")
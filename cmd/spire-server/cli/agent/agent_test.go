@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"testing"
+	"time"
 
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -20,7 +21,17 @@ import (
 )
 
 var (
-	testAgents = []*types.Agent{{Id: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/agent1"}}}
+	testAgents              = []*types.Agent{{Id: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/agent1"}}}
+	testAgentsWithSelectors = []*types.Agent{
+		{
+			Id:              &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/agent1"},
+			AttestationType: "k8s_psat",
+			Selectors: []*types.Selector{
+				{Type: "k8s_psat", Value: "agent_ns:spire"},
+				{Type: "k8s_psat", Value: "agent_sa:spire-agent"},
+			},
+		},
+	}
 )
 
 type agentTest struct {
@@ -46,6 +57,8 @@ func TestEvictHelp(t *testing.T) {
 
 	test.client.Help()
 	require.Equal(t, `Usage of agent evict:
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -spiffeID string
@@ -106,6 +119,8 @@ func TestListHelp(t *testing.T) {
 
 	test.client.Help()
 	require.Equal(t, `Usage of agent list:
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -162,6 +177,8 @@ func TestShowHelp(t *testing.T) {
 
 	test.client.Help()
 	require.Equal(t, `Usage of agent show:
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -spiffeID string
@@ -186,6 +203,19 @@ func TestShow(t *testing.T) {
 			existentAgents:     testAgents,
 			expectedStdout:     "Found an attested agent given its SPIFFE ID\n\nSPIFFE ID         : spiffe://example.org/spire/agent/agent1",
 		},
+		{
+			name:               "success with selectors",
+			args:               []string{"-spiffeID", "spiffe://example.org/spire/agent/agent1"},
+			expectedReturnCode: 0,
+			existentAgents:     testAgentsWithSelectors,
+			expectedStdout: "Found an attested agent given its SPIFFE ID\n\n" +
+				"SPIFFE ID         : spiffe://example.org/spire/agent/agent1\n" +
+				"Attestation type  : k8s_psat\n" +
+				"Expiration time   : " + time.Unix(0, 0).String() + "\n" +
+				"Serial number     : \n" +
+				"Selector          : k8s_psat:agent_ns:spire\n" +
+				"Selector          : k8s_psat:agent_sa:spire-agent\n\n",
+		},
 		{
 			name:               "no spiffe id",
 			expectedReturnCode: 1,
@@ -51,11 +51,15 @@ func (c *evictCommand) Run(ctx context.Context, env *common_cli.Env, serverClien
 	}
 
 	agentClient := serverClient.NewAgentClient()
-	_, err = agentClient.DeleteAgent(ctx, &agent.DeleteAgentRequest{Id: api.ProtoFromID(id)})
+	resp, err := agentClient.DeleteAgent(ctx, &agent.DeleteAgentRequest{Id: api.ProtoFromID(id)})
 	if err != nil {
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
 	return env.Println("Agent evicted successfully")
 }
 
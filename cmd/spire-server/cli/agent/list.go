@@ -40,11 +40,23 @@ func (listCommand) Synopsis() string {
 //Run lists attested agents
 func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
 	agentClient := serverClient.NewAgentClient()
-	listResponse, err := agentClient.ListAgents(ctx, &agent.ListAgentsRequest{})
+	listResponse, err := agentClient.ListAgents(ctx, &agent.ListAgentsRequest{
+		// Selectors are only displayed by "agent show"
+		OutputMask: &types.AgentMask{
+			AttestationType:      true,
+			X509SvidSerialNumber: true,
+			X509SvidExpiresAt:    true,
+			Banned:               true,
+		},
+	})
 	if err != nil {
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(listResponse)
+	}
+
 	if len(listResponse.Agents) == 0 {
 		return env.Printf("No attested agents found\n")
 	}
@@ -78,6 +90,11 @@ func printAgents(env *common_cli.Env, agents ...*types.Agent) error {
 		if err := env.Printf("Serial number     : %s\n", agent.X509SvidSerialNumber); err != nil {
 			return err
 		}
+		for _, selector := range agent.Selectors {
+			if err := env.Printf("Selector          : %s:%s\n", selector.Type, selector.Value); err != nil {
+				return err
+			}
+		}
 		if err := env.Println(); err != nil {
 			return err
 		}
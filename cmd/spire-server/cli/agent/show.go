@@ -56,6 +56,10 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(agent)
+	}
+
 	env.Printf("Found an attested agent given its SPIFFE ID\n\n")
 
 	if err := printAgents(env, agent); err != nil {
@@ -3,6 +3,7 @@ package bundle
 import (
 	"crypto/x509"
 	"errors"
+	"fmt"
 	"io/ioutil"
 	"path/filepath"
 	"testing"
@@ -14,6 +15,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestShowHelp(t *testing.T) {
@@ -23,6 +25,8 @@ func TestShowHelp(t *testing.T) {
 	require.Equal(t, `Usage of bundle show:
   -format string
     	The format to show the bundle. Either "pem" or "spiffe". (default "pem")
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -35,11 +39,12 @@ func TestShowSynopsis(t *testing.T) {
 
 func TestShow(t *testing.T) {
 	for _, tt := range []struct {
-		name          string
-		args          []string
-		expectedOut   string
-		serverErr     error
-		expectedError string
+		name           string
+		args           []string
+		jwtAuthorities bool
+		expectedOut    string
+		serverErr      error
+		expectedError  string
 	}{
 		{
 			name:        "default",
@@ -55,6 +60,12 @@ func TestShow(t *testing.T) {
 			args:        []string{"-format", formatSPIFFE},
 			expectedOut: cert1JWKS,
 		},
+		{
+			name:           "spiffe with JWT authorities",
+			args:           []string{"-format", formatSPIFFE},
+			jwtAuthorities: true,
+			expectedOut:    cert1JWTKeysJWKS,
+		},
 		{
 			name:          "server fails",
 			serverErr:     errors.New("some error"),
@@ -73,6 +84,15 @@ func TestShow(t *testing.T) {
 				RefreshHint: 60,
 			},
 			}
+			if tt.jwtAuthorities {
+				key2Pkix, err := x509.MarshalPKIXPublicKey(test.cert2.PublicKey)
+				require.NoError(t, err)
+				test.server.bundles[0].JwtAuthorities = []*types.JWTKey{
+					{KeyId: "KID2", PublicKey: key2Pkix},
+					{KeyId: "KID1", PublicKey: test.key1Pkix},
+				}
+				test.server.bundles[0].SequenceNumber = 3
+			}
 
 			args := append(test.args, tt.args...)
 			rc := test.client.Run(args)
@@ -88,6 +108,29 @@ func TestShow(t *testing.T) {
 	}
 }
 
+func TestShowJSON(t *testing.T) {
+	test := setupTest(t, newShowCommand)
+	test.server.bundles = []*types.Bundle{{
+		TrustDomain: "spiffe://example.test",
+		X509Authorities: []*types.X509Certificate{
+			{Asn1: test.cert1.Raw},
+		},
+		JwtAuthorities: []*types.JWTKey{
+			{KeyId: "KID1", PublicKey: test.key1Pkix},
+		},
+		RefreshHint:    60,
+		SequenceNumber: 3,
+	}}
+
+	rc := test.client.Run(append(test.args, "-output", "json", "-format", formatSPIFFE))
+	require.Equal(t, 0, rc)
+
+	// The output is the bundle as returned by the API, whatever the format
+	out := new(types.Bundle)
+	require.NoError(t, protojson.Unmarshal(test.stdout.Bytes(), out))
+	spiretest.RequireProtoEqual(t, test.server.bundles[0], out)
+}
+
 func TestSetHelp(t *testing.T) {
 	test := setupTest(t, newSetCommand)
 	test.client.Help()
@@ -96,6 +139,8 @@ func TestSetHelp(t *testing.T) {
     	The format of the bundle data. Either "pem" or "spiffe". (default "pem")
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -path string
     	Path to the bundle data
   -registrationUDSPath string
@@ -115,6 +160,12 @@ func TestSet(t *testing.T) {
 	key1Pkix, err := x509.MarshalPKIXPublicKey(cert1.PublicKey)
 	require.NoError(t, err)
 
+	cert2, err := pemutil.ParseCertificate([]byte(cert2PEM))
+	require.NoError(t, err)
+
+	key2Pkix, err := x509.MarshalPKIXPublicKey(cert2.PublicKey)
+	require.NoError(t, err)
+
 	for _, tt := range []struct {
 		name           string
 		args           []string
@@ -266,6 +317,41 @@ func TestSet(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:  "set bundle with JWT authorities (jwks)",
+			stdin: cert1JWTKeysJWKS,
+			args:  []string{"-id", "spiffe://otherdomain.test", "-format", formatSPIFFE},
+			toSet: &types.Bundle{
+				TrustDomain: "otherdomain.test",
+				X509Authorities: []*types.X509Certificate{
+					{
+						Asn1: cert1.Raw,
+					},
+				},
+				JwtAuthorities: []*types.JWTKey{
+					{
+						KeyId:     "KID1",
+						PublicKey: key1Pkix,
+					},
+					{
+						KeyId:     "KID2",
+						PublicKey: key2Pkix,
+					},
+				},
+				RefreshHint:    60,
+				SequenceNumber: 3,
+			},
+			setResponse: &bundle.BatchSetFederatedBundleResponse{
+				Results: []*bundle.BatchSetFederatedBundleResponse_Result{
+					{
+						Status: &types.Status{Code: int32(codes.OK)},
+						Bundle: &types.Bundle{
+							TrustDomain: "spiffe://otherdomain.test",
+						},
+					},
+				},
+			},
+		},
 		{
 			name:           "invalid file name",
 			expectedStderr: "Error: unable to load bundle data: open /not/a/real/path/to/a/bundle: no such file or directory\n",
@@ -387,8 +473,12 @@ func TestListHelp(t *testing.T) {
     	The format to list federated bundles. Either "pem" or "spiffe". (default "pem")
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
+  -summary
+    	Print the authority counts, sequence number and last refresh time of each federated bundle instead of its contents
 `, test.stderr.String())
 }
 
@@ -498,6 +588,131 @@ func TestList(t *testing.T) {
 	}
 }
 
+func TestListSummary(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		bundles        int
+		expectedStdout string
+		expectedStderr string
+		serverErr      error
+	}{
+		{
+			name:    "all bundles",
+			bundles: 2,
+			expectedStdout: `Found 2 federated bundles
+
+Trust domain      : domain1.test
+X.509 authorities : 1
+JWT authorities   : 1
+Sequence number   : 3
+Last refreshed    : 2020-09-13T12:26:40Z
+
+Trust domain      : domain2.test
+X.509 authorities : 1
+JWT authorities   : 0
+Sequence number   : 0
+Last refreshed    : never
+`,
+		},
+		{
+			name:           "no bundles",
+			expectedStdout: "No federated bundles found\n",
+		},
+		{
+			name:    "one bundle",
+			args:    []string{"-id", "spiffe://domain1.test"},
+			bundles: 2,
+			expectedStdout: `Found 1 federated bundle
+
+Trust domain      : domain1.test
+X.509 authorities : 1
+JWT authorities   : 1
+Sequence number   : 3
+Last refreshed    : 2020-09-13T12:26:40Z
+`,
+		},
+		{
+			name:           "one bundle not found",
+			args:           []string{"-id", "spiffe://domain3.test"},
+			bundles:        2,
+			expectedStderr: "Error: no federated bundle found for \"domain3.test\"\n",
+		},
+		{
+			name:           "one bundle invalid id",
+			args:           []string{"-id", "spiffe://domain2.test/host"},
+			expectedStderr: "Error: \"spiffe://domain2.test/host\" is not a valid trust domain SPIFFE ID: path is not empty\n",
+		},
+		{
+			name:           "server fails",
+			expectedStderr: "Error: rpc error: code = Internal desc = some error\n",
+			serverErr:      status.New(codes.Internal, "some error").Err(),
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newListCommand)
+			test.server.err = tt.serverErr
+			test.server.bundles = []*types.Bundle{
+				{
+					TrustDomain: "domain1.test",
+					X509Authorities: []*types.X509Certificate{
+						{Asn1: test.cert1.Raw},
+					},
+					JwtAuthorities: []*types.JWTKey{
+						{KeyId: "KID", PublicKey: test.key1Pkix},
+					},
+					SequenceNumber: 3,
+				},
+				{
+					TrustDomain: "domain2.test",
+					X509Authorities: []*types.X509Certificate{
+						{Asn1: test.cert2.Raw},
+					},
+				},
+			}[:tt.bundles]
+			test.server.lastRefreshedAt = map[string]int64{
+				"domain1.test": 1600000000,
+			}
+
+			args := append(test.args, "-summary")
+			rc := test.client.Run(append(args, tt.args...))
+			if tt.expectedStderr != "" {
+				require.Equal(t, tt.expectedStderr, test.stderr.String())
+				require.Equal(t, 1, rc)
+				return
+			}
+
+			require.Equal(t, 0, rc)
+			require.Empty(t, test.stderr.String())
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+		})
+	}
+}
+
+func TestListPagesThroughBundles(t *testing.T) {
+	test := setupTest(t, newListCommand)
+	for i := 0; i < 1500; i++ {
+		test.server.bundles = append(test.server.bundles, &types.Bundle{
+			TrustDomain: fmt.Sprintf("domain%d.test", i),
+		})
+	}
+	test.server.lastRefreshedAt = map[string]int64{
+		"domain0.test":    1600000000,
+		"domain1499.test": 1600000001,
+	}
+
+	rc := test.client.Run(append(test.args, "-summary", "-output", "json"))
+	require.Equal(t, 0, rc)
+	require.Empty(t, test.stderr.String())
+	require.Equal(t, []int32{1000, 1000}, test.server.listPageSizes)
+
+	resp := new(bundle.ListFederatedBundlesResponse)
+	require.NoError(t, protojson.Unmarshal(test.stdout.Bytes(), resp))
+	require.Len(t, resp.Bundles, 1500)
+	require.Equal(t, test.server.lastRefreshedAt, resp.LastRefreshedAt)
+}
+
 func TestDeleteHelp(t *testing.T) {
 	test := setupTest(t, newDeleteCommand)
 	test.client.Help()
@@ -506,6 +721,8 @@ func TestDeleteHelp(t *testing.T) {
     	SPIFFE ID of the trust domain
   -mode string
     	Deletion mode: one of restrict, delete, or dissociate (default "restrict")
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -654,3 +871,167 @@ func TestDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestCountHelp(t *testing.T) {
+	test := setupTest(t, newCountCommand)
+	test.client.Help()
+	require.Equal(t, `Usage of bundle count:
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
+  -registrationUDSPath string
+    	Registration API UDS path (default "/tmp/spire-registration.sock")
+`, test.stderr.String())
+}
+
+func TestCountSynopsis(t *testing.T) {
+	test := setupTest(t, newCountCommand)
+	require.Equal(t, "Prints the number of authorities in the server CA bundle", test.client.Synopsis())
+}
+
+func TestCount(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		jwtAuthorities bool
+		serverErr      error
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "X.509 authorities only",
+			expectedStdout: "X.509 authorities: 1\nJWT authorities: 0\n",
+		},
+		{
+			name:           "X.509 and JWT authorities",
+			jwtAuthorities: true,
+			expectedStdout: "X.509 authorities: 1\nJWT authorities: 2\n",
+		},
+		{
+			name:           "json output",
+			args:           []string{"-output", "json"},
+			jwtAuthorities: true,
+			expectedStdout: `{"x509_authorities":1,"jwt_authorities":2}` + "\n",
+		},
+		{
+			name:           "server fails",
+			serverErr:      status.New(codes.Internal, "some error").Err(),
+			expectedStderr: "Error: rpc error: code = Internal desc = some error\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newCountCommand)
+			test.server.err = tt.serverErr
+			test.server.bundles = []*types.Bundle{{
+				TrustDomain: "spiffe://example.test",
+				X509Authorities: []*types.X509Certificate{
+					{Asn1: test.cert1.Raw},
+				},
+			}}
+			if tt.jwtAuthorities {
+				test.server.bundles[0].JwtAuthorities = []*types.JWTKey{
+					{KeyId: "KID1", PublicKey: test.key1Pkix},
+					{KeyId: "KID2", PublicKey: test.key1Pkix},
+				}
+			}
+
+			rc := test.client.Run(append(test.args, tt.args...))
+			if tt.expectedStderr != "" {
+				require.Equal(t, 1, rc)
+				require.Equal(t, tt.expectedStderr, test.stderr.String())
+				return
+			}
+
+			require.Empty(t, test.stderr.String())
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+		})
+	}
+}
+
+func TestPruneHelp(t *testing.T) {
+	test := setupTest(t, newPruneCommand)
+	test.client.Help()
+	require.Equal(t, `Usage of bundle prune:
+  -expiresBefore string
+    	Prune authorities that expired before this time, in RFC 3339 format (e.g. 2021-01-01T00:00:00Z)
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
+  -registrationUDSPath string
+    	Registration API UDS path (default "/tmp/spire-registration.sock")
+`, test.stderr.String())
+}
+
+func TestPruneSynopsis(t *testing.T) {
+	test := setupTest(t, newPruneCommand)
+	require.Equal(t, "Prunes expired authorities from the server CA bundle", test.client.Synopsis())
+}
+
+func TestPrune(t *testing.T) {
+	for _, tt := range []struct {
+		name                string
+		args                []string
+		bundleChanged       bool
+		serverErr           error
+		expectExpiresBefore int64
+		expectedStdout      string
+		expectedStderr      string
+	}{
+		{
+			name:                "bundle pruned",
+			args:                []string{"-expiresBefore", "2021-01-01T00:00:00Z"},
+			bundleChanged:       true,
+			expectExpiresBefore: 1609459200,
+			expectedStdout:      "bundle pruned.\n",
+		},
+		{
+			name:                "nothing to prune",
+			args:                []string{"-expiresBefore", "2021-01-01T01:00:00+01:00"},
+			expectExpiresBefore: 1609459200,
+			expectedStdout:      "nothing to prune.\n",
+		},
+		{
+			name:                "json output",
+			args:                []string{"-expiresBefore", "2021-01-01T00:00:00Z", "-output", "json"},
+			bundleChanged:       true,
+			expectExpiresBefore: 1609459200,
+			expectedStdout:      `{"bundle_changed":true}` + "\n",
+		},
+		{
+			name:           "no expiresBefore",
+			expectedStderr: "Error: expiresBefore is required\n",
+		},
+		{
+			name:           "invalid expiresBefore",
+			args:           []string{"-expiresBefore", "yesterday"},
+			expectedStderr: "Error: invalid expiresBefore: parsing time \"yesterday\" as \"2006-01-02T15:04:05Z07:00\": cannot parse \"yesterday\" as \"2006\"\n",
+		},
+		{
+			name:                "server fails",
+			args:                []string{"-expiresBefore", "2021-01-01T00:00:00Z"},
+			serverErr:           status.New(codes.FailedPrecondition, "unable to prune bundle: would prune all certificates").Err(),
+			expectExpiresBefore: 1609459200,
+			expectedStderr:      "Error: failed to prune bundle: rpc error: code = FailedPrecondition desc = unable to prune bundle: would prune all certificates\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newPruneCommand)
+			test.server.err = tt.serverErr
+			test.server.bundleChanged = tt.bundleChanged
+
+			args := append(test.args, tt.args...)
+			rc := test.client.Run(args)
+			require.Equal(t, tt.expectExpiresBefore, test.server.expiresBefore)
+			if tt.expectedStderr != "" {
+				require.Equal(t, 1, rc)
+				require.Equal(t, tt.expectedStderr, test.stderr.String())
+				return
+			}
+
+			require.Empty(t, test.stderr.String())
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+		})
+	}
+}
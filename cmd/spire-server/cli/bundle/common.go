@@ -1,21 +1,20 @@
 package bundle
 
 import (
-	"bytes"
 	"crypto"
 	"crypto/x509"
-	"encoding/json"
 	"encoding/pem"
 	"errors"
 	"fmt"
 	"io"
 	"io/ioutil"
 	"os"
+	"sort"
 	"strings"
-	"time"
 
 	"github.com/spiffe/go-spiffe/v2/bundle/spiffebundle"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/proto/spire/types"
 	"github.com/zeebo/errs"
 )
@@ -71,81 +70,34 @@ func printCACertsPEM(out io.Writer, caCerts []byte) error {
 	return nil
 }
 
-// printBundle marshals and prints the bundle using the provided writer
+// printBundle marshals and prints the bundle using the provided writer. The
+// keys are emitted in the order they are stored by the server so the output
+// is stable across invocations.
 func printBundle(out io.Writer, bundle *types.Bundle) error {
-	b, err := bundleFromProto(bundle)
-	if err != nil {
+	if _, err := spiffeid.TrustDomainFromString(bundle.TrustDomain); err != nil {
 		return err
 	}
 
-	docBytes, err := b.Marshal()
+	commonBundle, err := bundleutil.CommonBundleFromProto(bundle)
 	if err != nil {
-		return errs.Wrap(err)
-	}
-
-	var o bytes.Buffer
-	if err := json.Indent(&o, docBytes, "", "    "); err != nil {
 		return err
 	}
 
-	if _, err := fmt.Fprintln(out, o.String()); err != nil {
-		return errs.Wrap(err)
-	}
-
-	return nil
-}
-
-// bundleFromProto converts a bundle from the given *types.Bundle to *spiffebundle.Bundle
-func bundleFromProto(bundleProto *types.Bundle) (*spiffebundle.Bundle, error) {
-	td, err := spiffeid.TrustDomainFromString(bundleProto.TrustDomain)
-	if err != nil {
-		return nil, err
-	}
-	x509Authorities, err := x509CertificatesFromProto(bundleProto.X509Authorities)
+	b, err := bundleutil.BundleFromProto(commonBundle)
 	if err != nil {
-		return nil, err
+		return err
 	}
-	jwtAuthorities, err := jwtKeysFromProto(bundleProto.JwtAuthorities)
+
+	docBytes, err := bundleutil.Marshal(b)
 	if err != nil {
-		return nil, err
-	}
-	bundle := spiffebundle.New(td)
-	bundle.SetX509Authorities(x509Authorities)
-	bundle.SetJWTAuthorities(jwtAuthorities)
-	if bundleProto.RefreshHint > 0 {
-		bundle.SetRefreshHint(time.Duration(bundleProto.RefreshHint) * time.Second)
-	}
-	if bundleProto.SequenceNumber > 0 {
-		bundle.SetSequenceNumber(bundleProto.SequenceNumber)
+		return errs.Wrap(err)
 	}
-	return bundle, nil
-}
 
-// x509CertificatesFromProto converts X.509 certificates from the given []*types.X509Certificate to []*x509.Certificate
-func x509CertificatesFromProto(proto []*types.X509Certificate) ([]*x509.Certificate, error) {
-	var certs []*x509.Certificate
-	for i, auth := range proto {
-		cert, err := x509.ParseCertificate(auth.Asn1)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse root CA %d: %v", i, err)
-		}
-		certs = append(certs, cert)
+	if _, err := fmt.Fprintln(out, string(docBytes)); err != nil {
+		return errs.Wrap(err)
 	}
-	return certs, nil
-}
 
-// jwtKeysFromProto converts JWT keys from the given []*types.JWTKey to map[string]crypto.PublicKey.
-// The key ID of the public key is used as the key in the returned map.
-func jwtKeysFromProto(proto []*types.JWTKey) (map[string]crypto.PublicKey, error) {
-	keys := make(map[string]crypto.PublicKey)
-	for i, publicKey := range proto {
-		jwtSigningKey, err := x509.ParsePKIXPublicKey(publicKey.PublicKey)
-		if err != nil {
-			return nil, fmt.Errorf("unable to parse JWT signing key %d: %v", i, err)
-		}
-		keys[publicKey.KeyId] = jwtSigningKey
-	}
-	return keys, nil
+	return nil
 }
 
 func bundleProtoFromX509Authorities(trustDomain string, rootCAs []*x509.Certificate) *types.Bundle {
@@ -196,11 +148,18 @@ func protoFromX509Certificates(certs []*x509.Certificate) []*types.X509Certifica
 	return resp
 }
 
-// protoFromJWTKeys converts JWT keys from the given map[string]crypto.PublicKey to []*types.JWTKey
+// protoFromJWTKeys converts JWT keys from the given map[string]crypto.PublicKey to []*types.JWTKey.
+// The keys are sorted by key ID.
 func protoFromJWTKeys(keys map[string]crypto.PublicKey) ([]*types.JWTKey, error) {
-	var resp []*types.JWTKey
+	kids := make([]string, 0, len(keys))
+	for kid := range keys {
+		kids = append(kids, kid)
+	}
+	sort.Strings(kids)
 
-	for kid, key := range keys {
+	var resp []*types.JWTKey
+	for _, kid := range kids {
+		key := keys[kid]
 		pkixBytes, err := x509.MarshalPKIXPublicKey(key)
 		if err != nil {
 			return nil, err
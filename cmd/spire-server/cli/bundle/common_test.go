@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"context"
 	"crypto/x509"
+	"strconv"
 	"testing"
 
 	"github.com/mitchellh/cli"
@@ -80,6 +81,40 @@ diIqWtxAqBLFrx8zNS4=
     ],
     "spiffe_refresh_hint": 60
 }
+`
+
+	cert1JWTKeysJWKS = `{
+    "keys": [
+        {
+            "use": "x509-svid",
+            "kty": "EC",
+            "crv": "P-256",
+            "x": "fK-wKTnKL7KFLM27lqq5DC-bxrVaH6rDV-IcCSEOeL4",
+            "y": "wq-g3TQWxYlV51TCPH030yXsRxvujD4hUUaIQrXk4KI",
+            "x5c": [
+                "MIIBKjCB0aADAgECAgEBMAoGCCqGSM49BAMCMAAwIhgPMDAwMTAxMDEwMDAwMDBaGA85OTk5MTIzMTIzNTk1OVowADBZMBMGByqGSM49AgEGCCqGSM49AwEHA0IABHyvsCk5yi+yhSzNu5aquQwvm8a1Wh+qw1fiHAkhDni+wq+g3TQWxYlV51TCPH030yXsRxvujD4hUUaIQrXk4KKjODA2MA8GA1UdEwEB/wQFMAMBAf8wIwYDVR0RAQH/BBkwF4YVc3BpZmZlOi8vZG9tYWluMS50ZXN0MAoGCCqGSM49BAMCA0gAMEUCIA2dO09Xmakw2ekuHKWC4hBhCkpr5qY4bI8YUcXfxg/1AiEA67kMyH7bQnr7OVLUrL+b9ylAdZglS5kKnYigmwDh+/U="
+            ]
+        },
+        {
+            "use": "jwt-svid",
+            "kty": "EC",
+            "kid": "KID2",
+            "crv": "P-256",
+            "x": "HxVuaUnxgi431G5D3g9hqeaQhEbsyQZXmaas7qsUC_c",
+            "y": "SFd_uVlwYNkXrh0219eHUSD4o-4RGXoiMFJKysw5GK4"
+        },
+        {
+            "use": "jwt-svid",
+            "kty": "EC",
+            "kid": "KID1",
+            "crv": "P-256",
+            "x": "fK-wKTnKL7KFLM27lqq5DC-bxrVaH6rDV-IcCSEOeL4",
+            "y": "wq-g3TQWxYlV51TCPH030yXsRxvujD4hUUaIQrXk4KI"
+        }
+    ],
+    "spiffe_sequence": 3,
+    "spiffe_refresh_hint": 60
+}
 `
 
 	cert2JWKS = `{
@@ -249,9 +284,13 @@ type fakeBundleServer struct {
 
 	t                 testing.TB
 	bundles           []*types.Bundle
+	bundleChanged     bool
 	deleteResults     []*bundle.BatchDeleteFederatedBundleResponse_Result
 	err               error
 	expectedSetBundle *types.Bundle
+	expiresBefore     int64
+	lastRefreshedAt   map[string]int64
+	listPageSizes     []int32
 	mode              bundle.BatchDeleteFederatedBundleRequest_Mode
 	setResponse       *bundle.BatchSetFederatedBundleResponse
 	toDelete          []string
@@ -266,6 +305,17 @@ func (f *fakeBundleServer) GetBundle(ctx context.Context, in *bundle.GetBundleRe
 	return f.bundles[0], nil
 }
 
+func (f *fakeBundleServer) PruneBundle(ctx context.Context, req *bundle.PruneBundleRequest) (*bundle.PruneBundleResponse, error) {
+	f.expiresBefore = req.ExpiresBefore
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	return &bundle.PruneBundleResponse{
+		BundleChanged: f.bundleChanged,
+	}, nil
+}
+
 func (f *fakeBundleServer) BatchSetFederatedBundle(ctx context.Context, req *bundle.BatchSetFederatedBundleRequest) (*bundle.BatchSetFederatedBundleResponse, error) {
 	if f.err != nil {
 		return nil, f.err
@@ -275,13 +325,43 @@ func (f *fakeBundleServer) BatchSetFederatedBundle(ctx context.Context, req *bun
 	return f.setResponse, nil
 }
 
-func (f *fakeBundleServer) ListFederatedBundles(context.Context, *bundle.ListFederatedBundlesRequest) (*bundle.ListFederatedBundlesResponse, error) {
+func (f *fakeBundleServer) ListFederatedBundles(ctx context.Context, req *bundle.ListFederatedBundlesRequest) (*bundle.ListFederatedBundlesResponse, error) {
 	if f.err != nil {
 		return nil, f.err
 	}
-	return &bundle.ListFederatedBundlesResponse{
-		Bundles: f.bundles,
-	}, nil
+	f.listPageSizes = append(f.listPageSizes, req.PageSize)
+
+	bundles := f.bundles
+	nextPageToken := ""
+	if req.PageSize > 0 {
+		start := 0
+		if req.PageToken != "" {
+			var err error
+			start, err = strconv.Atoi(req.PageToken)
+			require.NoError(f.t, err)
+		}
+		end := start + int(req.PageSize)
+		if end < len(bundles) {
+			nextPageToken = strconv.Itoa(end)
+		} else {
+			end = len(bundles)
+		}
+		bundles = bundles[start:end]
+	}
+
+	resp := &bundle.ListFederatedBundlesResponse{
+		Bundles:       bundles,
+		NextPageToken: nextPageToken,
+	}
+	for _, b := range bundles {
+		if refreshedAt, ok := f.lastRefreshedAt[b.TrustDomain]; ok {
+			if resp.LastRefreshedAt == nil {
+				resp.LastRefreshedAt = make(map[string]int64)
+			}
+			resp.LastRefreshedAt[b.TrustDomain] = refreshedAt
+		}
+	}
+	return resp, nil
 }
 
 func (f *fakeBundleServer) GetFederatedBundle(ctx context.Context, req *bundle.GetFederatedBundleRequest) (*types.Bundle, error) {
@@ -0,0 +1,65 @@
+package bundle
+
+import (
+	"context"
+	"flag"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+)
+
+// NewCountCommand creates a new "count" subcommand for "bundle" command.
+func NewCountCommand() cli.Command {
+	return newCountCommand(common_cli.DefaultEnv)
+}
+
+func newCountCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(countCommand))
+}
+
+type countCommand struct{}
+
+// authorityCount is the JSON output of the command
+type authorityCount struct {
+	X509Authorities int `json:"x509_authorities"`
+	JWTAuthorities  int `json:"jwt_authorities"`
+}
+
+func (c *countCommand) Name() string {
+	return "bundle count"
+}
+
+func (c *countCommand) Synopsis() string {
+	return "Prints the number of authorities in the server CA bundle"
+}
+
+func (c *countCommand) AppendFlags(fs *flag.FlagSet) {
+}
+
+func (c *countCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	bundleClient := serverClient.NewBundleClient()
+	resp, err := bundleClient.GetBundle(ctx, &bundle.GetBundleRequest{
+		OutputMask: &types.BundleMask{
+			X509Authorities: true,
+			JwtAuthorities:  true,
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(authorityCount{
+			X509Authorities: len(resp.X509Authorities),
+			JWTAuthorities:  len(resp.JwtAuthorities),
+		})
+	}
+
+	if err := env.Printf("X.509 authorities: %d\n", len(resp.X509Authorities)); err != nil {
+		return err
+	}
+	return env.Printf("JWT authorities: %d\n", len(resp.JwtAuthorities))
+}
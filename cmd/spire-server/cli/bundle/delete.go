@@ -78,6 +78,9 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 	result := resp.Results[0]
 	switch result.Status.Code {
 	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
 		env.Println("bundle deleted.")
 		return nil
 	default:
@@ -16,6 +16,8 @@ func TestExperimentalShowHelp(t *testing.T) {
 	test := setupTest(t, newExperimentalShowCommand)
 	test.client.Help()
 	require.Equal(t, `Usage of experimental bundle show (deprecated - please use "bundle show" instead):
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -49,6 +51,8 @@ func TestExperientalSetHelp(t *testing.T) {
 	require.Equal(t, `Usage of experimental bundle set (deprecated - please use "bundle set" instead):
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -path string
     	Path to the bundle data
   -registrationUDSPath string
@@ -151,6 +155,8 @@ func TestExperientalListHelp(t *testing.T) {
 	require.Equal(t, `Usage of experimental bundle list (deprecated - please use "bundle list" instead):
   -id string
     	SPIFFE ID of the trust domain
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -4,12 +4,15 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"time"
 
 	"github.com/mitchellh/cli"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/cmd/spire-server/util"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
 	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
+	"github.com/spiffe/spire/proto/spire/types"
 )
 
 // NewListCommand creates a new "list" subcommand for "bundle" command.
@@ -22,8 +25,9 @@ func newListCommand(env *common_cli.Env) cli.Command {
 }
 
 type listCommand struct {
-	id     string // SPIFFE ID of the trust bundle
-	format string
+	id      string // SPIFFE ID of the trust bundle
+	format  string
+	summary bool
 }
 
 func (c *listCommand) Name() string {
@@ -37,29 +41,47 @@ func (c *listCommand) Synopsis() string {
 func (c *listCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.id, "id", "", "SPIFFE ID of the trust domain")
 	fs.StringVar(&c.format, "format", formatPEM, fmt.Sprintf("The format to list federated bundles. Either %q or %q.", formatPEM, formatSPIFFE))
+	fs.BoolVar(&c.summary, "summary", false, "Print the authority counts, sequence number and last refresh time of each federated bundle instead of its contents")
 }
 
 func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
 	bundleClient := serverClient.NewBundleClient()
+
+	var id string
 	if c.id != "" {
-		id, err := idutil.NormalizeSpiffeID(c.id, idutil.AllowAnyTrustDomain())
+		var err error
+		id, err = idutil.NormalizeSpiffeID(c.id, idutil.AllowAnyTrustDomain())
 		if err != nil {
 			return err
 		}
+	}
+
+	if c.summary {
+		return c.printSummary(ctx, env, bundleClient, id)
+	}
+
+	if id != "" {
 		resp, err := bundleClient.GetFederatedBundle(ctx, &bundle.GetFederatedBundleRequest{
 			TrustDomain: id,
 		})
 		if err != nil {
 			return err
 		}
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
 		return printBundleWithFormat(env.Stdout, resp, c.format, false)
 	}
 
-	resp, err := bundleClient.ListFederatedBundles(ctx, &bundle.ListFederatedBundlesRequest{})
+	resp, err := listFederatedBundles(ctx, bundleClient)
 	if err != nil {
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
 	for i, b := range resp.Bundles {
 		if i != 0 {
 			if err := env.Println(); err != nil {
@@ -73,3 +95,99 @@ func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	}
 	return nil
 }
+
+func (c *listCommand) printSummary(ctx context.Context, env *common_cli.Env, bundleClient bundle.BundleClient, id string) error {
+	resp, err := listFederatedBundles(ctx, bundleClient)
+	if err != nil {
+		return err
+	}
+
+	if id != "" {
+		td, err := spiffeid.TrustDomainFromString(id)
+		if err != nil {
+			return err
+		}
+		resp = filterFederatedBundles(resp, td.String())
+		if len(resp.Bundles) == 0 {
+			return fmt.Errorf("no federated bundle found for %q", td)
+		}
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
+	if len(resp.Bundles) == 0 {
+		return env.Println("No federated bundles found")
+	}
+
+	msg := fmt.Sprintf("Found %d federated ", len(resp.Bundles))
+	msg = util.Pluralizer(msg, "bundle", "bundles", len(resp.Bundles))
+	if err := env.Println(msg); err != nil {
+		return err
+	}
+
+	for _, b := range resp.Bundles {
+		lastRefreshed := "never"
+		if refreshedAt, ok := resp.LastRefreshedAt[b.TrustDomain]; ok {
+			lastRefreshed = time.Unix(refreshedAt, 0).UTC().Format(time.RFC3339)
+		}
+		if err := env.Printf("\nTrust domain      : %s\n", b.TrustDomain); err != nil {
+			return err
+		}
+		if err := env.Printf("X.509 authorities : %d\n", len(b.X509Authorities)); err != nil {
+			return err
+		}
+		if err := env.Printf("JWT authorities   : %d\n", len(b.JwtAuthorities)); err != nil {
+			return err
+		}
+		if err := env.Printf("Sequence number   : %d\n", b.SequenceNumber); err != nil {
+			return err
+		}
+		if err := env.Printf("Last refreshed    : %s\n", lastRefreshed); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// listFederatedBundles pages through all of the federated bundles and returns
+// them as a single response.
+func listFederatedBundles(ctx context.Context, bundleClient bundle.BundleClient) (*bundle.ListFederatedBundlesResponse, error) {
+	result := &bundle.ListFederatedBundlesResponse{}
+	pageToken := ""
+	for {
+		resp, err := bundleClient.ListFederatedBundles(ctx, &bundle.ListFederatedBundlesRequest{
+			PageSize:  1000,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return nil, err
+		}
+		result.Bundles = append(result.Bundles, resp.Bundles...)
+		for trustDomain, refreshedAt := range resp.LastRefreshedAt {
+			if result.LastRefreshedAt == nil {
+				result.LastRefreshedAt = make(map[string]int64)
+			}
+			result.LastRefreshedAt[trustDomain] = refreshedAt
+		}
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+	return result, nil
+}
+
+func filterFederatedBundles(resp *bundle.ListFederatedBundlesResponse, trustDomain string) *bundle.ListFederatedBundlesResponse {
+	filtered := &bundle.ListFederatedBundlesResponse{}
+	for _, b := range resp.Bundles {
+		if b.TrustDomain != trustDomain {
+			continue
+		}
+		filtered.Bundles = []*types.Bundle{b}
+		if refreshedAt, ok := resp.LastRefreshedAt[trustDomain]; ok {
+			filtered.LastRefreshedAt = map[string]int64{trustDomain: refreshedAt}
+		}
+	}
+	return filtered
+}
@@ -0,0 +1,67 @@
+package bundle
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
+)
+
+// NewPruneCommand creates a new "prune" subcommand for "bundle" command.
+func NewPruneCommand() cli.Command {
+	return newPruneCommand(common_cli.DefaultEnv)
+}
+
+func newPruneCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(pruneCommand))
+}
+
+type pruneCommand struct {
+	// Authorities that expired before this time are pruned (RFC 3339)
+	expiresBefore string
+}
+
+func (c *pruneCommand) Name() string {
+	return "bundle prune"
+}
+
+func (c *pruneCommand) Synopsis() string {
+	return "Prunes expired authorities from the server CA bundle"
+}
+
+func (c *pruneCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.expiresBefore, "expiresBefore", "", "Prune authorities that expired before this time, in RFC 3339 format (e.g. 2021-01-01T00:00:00Z)")
+}
+
+func (c *pruneCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.expiresBefore == "" {
+		return errors.New("expiresBefore is required")
+	}
+	expiresBefore, err := time.Parse(time.RFC3339, c.expiresBefore)
+	if err != nil {
+		return fmt.Errorf("invalid expiresBefore: %w", err)
+	}
+
+	bundleClient := serverClient.NewBundleClient()
+	resp, err := bundleClient.PruneBundle(ctx, &bundle.PruneBundleRequest{
+		ExpiresBefore: expiresBefore.Unix(),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to prune bundle: %w", err)
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
+	if !resp.BundleChanged {
+		return env.Println("nothing to prune.")
+	}
+	return env.Println("bundle pruned.")
+}
@@ -111,6 +111,9 @@ func (c *setCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	result := resp.Results[0]
 	switch result.Status.Code {
 	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
 		env.Println("bundle set.")
 		return nil
 	default:
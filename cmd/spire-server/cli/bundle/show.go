@@ -43,5 +43,8 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
 	return printBundleWithFormat(env.Stdout, resp, c.format, false)
 }
@@ -0,0 +1,243 @@
+package ca_test
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/cli/ca"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	debugpb "github.com/spiffe/spire/proto/spire/api/server/debug/v1"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var problems = []*debugpb.CAJournalProblem{
+	{
+		Kind:     debugpb.CAJournalProblem_X509_CA,
+		SlotId:   "A",
+		IssuedAt: 1552410266,
+		Problem:  "no key manager key",
+		Repair:   debugpb.CAJournalProblem_DROP_ENTRY,
+	},
+	{
+		Kind:     debugpb.CAJournalProblem_JWT_KEY,
+		SlotId:   "B",
+		IssuedAt: 1552410266,
+		Problem:  "public key is not in the trust domain bundle",
+		Repair:   debugpb.CAJournalProblem_APPEND_BUNDLE,
+	},
+}
+
+const problemsOutput = `Kind         : x509_ca
+Slot ID      : A
+Issued at    : 2019-03-12T17:04:26Z
+Problem      : no key manager key
+Repair       : drop_entry
+
+Kind         : jwt_key
+Slot ID      : B
+Issued at    : 2019-03-12T17:04:26Z
+Problem      : public key is not in the trust domain bundle
+Repair       : append_bundle
+
+`
+
+type caTest struct {
+	stdin  *bytes.Buffer
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+
+	args   []string
+	server *fakeDebugServer
+
+	client cli.Command
+}
+
+func (s *caTest) afterTest(t *testing.T) {
+	t.Logf("TEST:%s", t.Name())
+	t.Logf("STDOUT:\n%s", s.stdout.String())
+	t.Logf("STDIN:\n%s", s.stdin.String())
+	t.Logf("STDERR:\n%s", s.stderr.String())
+}
+
+func TestRepairHelp(t *testing.T) {
+	test := setupTest(t, ca.NewRepairCommandWithEnv)
+
+	test.client.Help()
+	require.Equal(t, `Usage of ca journal repair:
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
+  -registrationUDSPath string
+    	Registration API UDS path (default "/tmp/spire-registration.sock")
+  -reinitialize
+    	Drop every journal entry and prepare new keys, instead of repairing the problems found
+`, test.stderr.String())
+}
+
+func TestVerify(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		problems       []*debugpb.CAJournalProblem
+		serverErr      error
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "no problems",
+			expectedStdout: "CA journal verified.\n",
+		},
+		{
+			name:           "problems",
+			problems:       problems,
+			expectedCode:   1,
+			expectedStdout: "Found 2 problems in the CA journal:\n\n" + problemsOutput,
+			expectedStderr: "Error: CA journal is inconsistent; run \"spire-server ca journal repair\" to repair it\n",
+		},
+		{
+			name:           "no problems with json output",
+			args:           []string{"-output", "json"},
+			expectedStdout: `{"problems":[]}` + "\n",
+		},
+		{
+			name:         "problems with json output",
+			args:         []string{"-output", "json"},
+			problems:     problems,
+			expectedCode: 1,
+			expectedStdout: `{"problems":[` +
+				`{"kind":"X509_CA","slot_id":"A","issued_at":"1552410266","problem":"no key manager key","repair":"DROP_ENTRY"},` +
+				`{"kind":"JWT_KEY","slot_id":"B","issued_at":"1552410266","problem":"public key is not in the trust domain bundle","repair":"APPEND_BUNDLE"}` +
+				`]}` + "\n",
+			expectedStderr: "Error: CA journal is inconsistent; run \"spire-server ca journal repair\" to repair it\n",
+		},
+		{
+			name:           "server error",
+			serverErr:      status.Error(codes.Internal, "oh no"),
+			expectedCode:   1,
+			expectedStderr: "Error: failed to verify CA journal: rpc error: code = Internal desc = oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, ca.NewVerifyCommandWithEnv)
+			test.server.problems = tt.problems
+			test.server.err = tt.serverErr
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+		})
+	}
+}
+
+func TestRepair(t *testing.T) {
+	for _, tt := range []struct {
+		name                 string
+		args                 []string
+		problems             []*debugpb.CAJournalProblem
+		serverErr            error
+		expectedReinitialize bool
+		expectedCode         int
+		expectedStdout       string
+		expectedStderr       string
+	}{
+		{
+			name:           "no problems",
+			expectedStdout: "CA journal verified; nothing to repair.\n",
+		},
+		{
+			name:           "problems",
+			problems:       problems,
+			expectedStdout: "Repaired 2 problems in the CA journal:\n\n" + problemsOutput,
+		},
+		{
+			name:                 "reinitialize",
+			args:                 []string{"-reinitialize"},
+			problems:             problems,
+			expectedReinitialize: true,
+			expectedStdout:       "CA journal reinitialized.\n",
+		},
+		{
+			name:           "server error",
+			serverErr:      status.Error(codes.Internal, "oh no"),
+			expectedCode:   1,
+			expectedStderr: "Error: failed to repair CA journal: rpc error: code = Internal desc = oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, ca.NewRepairCommandWithEnv)
+			test.server.problems = tt.problems
+			test.server.err = tt.serverErr
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+			require.Equal(t, tt.expectedReinitialize, test.server.reinitialize)
+		})
+	}
+}
+
+func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *caTest {
+	server := &fakeDebugServer{}
+
+	socketPath := spiretest.StartGRPCSocketServerOnTempSocket(t, func(s *grpc.Server) {
+		debugpb.RegisterDebugServer(s, server)
+	})
+
+	stdin := new(bytes.Buffer)
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	client := newClient(&common_cli.Env{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+
+	test := &caTest{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+		args:   []string{"-registrationUDSPath", socketPath},
+		server: server,
+		client: client,
+	}
+
+	t.Cleanup(func() {
+		test.afterTest(t)
+	})
+
+	return test
+}
+
+type fakeDebugServer struct {
+	debugpb.UnimplementedDebugServer
+
+	problems     []*debugpb.CAJournalProblem
+	reinitialize bool
+	err          error
+}
+
+func (s *fakeDebugServer) VerifyCAJournal(ctx context.Context, req *debugpb.VerifyCAJournalRequest) (*debugpb.VerifyCAJournalResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &debugpb.VerifyCAJournalResponse{Problems: s.problems}, nil
+}
+
+func (s *fakeDebugServer) RepairCAJournal(ctx context.Context, req *debugpb.RepairCAJournalRequest) (*debugpb.RepairCAJournalResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.reinitialize = req.Reinitialize
+	return &debugpb.RepairCAJournalResponse{Problems: s.problems}, nil
+}
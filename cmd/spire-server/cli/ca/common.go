@@ -0,0 +1,33 @@
+package ca
+
+import (
+	"strings"
+	"time"
+
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/debug/v1"
+)
+
+func printProblems(env *common_cli.Env, problems []*debug.CAJournalProblem) error {
+	for _, problem := range problems {
+		if err := env.Printf("Kind         : %s\n", strings.ToLower(problem.Kind.String())); err != nil {
+			return err
+		}
+		if err := env.Printf("Slot ID      : %s\n", problem.SlotId); err != nil {
+			return err
+		}
+		if err := env.Printf("Issued at    : %s\n", time.Unix(problem.IssuedAt, 0).UTC().Format(time.RFC3339)); err != nil {
+			return err
+		}
+		if err := env.Printf("Problem      : %s\n", problem.Problem); err != nil {
+			return err
+		}
+		if err := env.Printf("Repair       : %s\n", strings.ToLower(problem.Repair.String())); err != nil {
+			return err
+		}
+		if err := env.Println(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package ca
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/debug/v1"
+)
+
+// NewRepairCommand creates a new "repair" subcommand for "ca journal" command.
+func NewRepairCommand() cli.Command {
+	return NewRepairCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewRepairCommandWithEnv creates a new "repair" subcommand for "ca journal"
+// command using the environment specified.
+func NewRepairCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(repairCommand))
+}
+
+type repairCommand struct {
+	// Drop every journal entry instead of repairing the problems found
+	reinitialize bool
+}
+
+func (*repairCommand) Name() string {
+	return "ca journal repair"
+}
+
+func (*repairCommand) Synopsis() string {
+	return "Repairs the problems found in the CA journal"
+}
+
+func (c *repairCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.BoolVar(&c.reinitialize, "reinitialize", false, "Drop every journal entry and prepare new keys, instead of repairing the problems found")
+}
+
+func (c *repairCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	client := serverClient.NewDebugClient()
+	resp, err := client.RepairCAJournal(ctx, &debug.RepairCAJournalRequest{
+		Reinitialize: c.reinitialize,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to repair CA journal: %w", err)
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
+	if c.reinitialize {
+		return env.Println("CA journal reinitialized.")
+	}
+	if len(resp.Problems) == 0 {
+		return env.Println("CA journal verified; nothing to repair.")
+	}
+
+	msg := fmt.Sprintf("Repaired %v ", len(resp.Problems))
+	msg = util.Pluralizer(msg, "problem", "problems", len(resp.Problems))
+	if err := env.Printf("%s in the CA journal:\n\n", msg); err != nil {
+		return err
+	}
+	return printProblems(env, resp.Problems)
+}
@@ -0,0 +1,66 @@
+package ca
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/debug/v1"
+)
+
+// NewVerifyCommand creates a new "verify" subcommand for "ca journal" command.
+func NewVerifyCommand() cli.Command {
+	return NewVerifyCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewVerifyCommandWithEnv creates a new "verify" subcommand for "ca journal"
+// command using the environment specified.
+func NewVerifyCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(verifyCommand))
+}
+
+type verifyCommand struct{}
+
+func (*verifyCommand) Name() string {
+	return "ca journal verify"
+}
+
+func (*verifyCommand) Synopsis() string {
+	return "Verifies the CA journal against the KeyManager and the trust domain bundle"
+}
+
+func (*verifyCommand) AppendFlags(fs *flag.FlagSet) {}
+
+func (*verifyCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	client := serverClient.NewDebugClient()
+	resp, err := client.VerifyCAJournal(ctx, &debug.VerifyCAJournalRequest{})
+	if err != nil {
+		return fmt.Errorf("failed to verify CA journal: %w", err)
+	}
+
+	switch {
+	case env.JSONOutput():
+		if err := env.PrintJSON(resp); err != nil {
+			return err
+		}
+		if len(resp.Problems) == 0 {
+			return nil
+		}
+	case len(resp.Problems) == 0:
+		return env.Println("CA journal verified.")
+	default:
+		msg := fmt.Sprintf("Found %v ", len(resp.Problems))
+		msg = util.Pluralizer(msg, "problem", "problems", len(resp.Problems))
+		if err := env.Printf("%s in the CA journal:\n\n", msg); err != nil {
+			return err
+		}
+		if err := printProblems(env, resp.Problems); err != nil {
+			return err
+		}
+	}
+	return errors.New(`CA journal is inconsistent; run "spire-server ca journal repair" to repair it`)
+}
@@ -1,12 +1,15 @@
 package cli
 
 import (
+	"context"
 	stdlog "log"
 
 	"github.com/mitchellh/cli"
 	"github.com/spiffe/spire/cmd/spire-server/cli/agent"
 	"github.com/spiffe/spire/cmd/spire-server/cli/bundle"
+	"github.com/spiffe/spire/cmd/spire-server/cli/ca"
 	"github.com/spiffe/spire/cmd/spire-server/cli/entry"
+	"github.com/spiffe/spire/cmd/spire-server/cli/federation"
 	"github.com/spiffe/spire/cmd/spire-server/cli/healthcheck"
 	"github.com/spiffe/spire/cmd/spire-server/cli/jwt"
 	"github.com/spiffe/spire/cmd/spire-server/cli/run"
@@ -15,16 +18,28 @@ import (
 	"github.com/spiffe/spire/cmd/spire-server/cli/x509"
 	"github.com/spiffe/spire/pkg/common/log"
 	"github.com/spiffe/spire/pkg/common/version"
+	"github.com/spiffe/spire/pkg/common/winsvc"
 )
 
+// ServiceName is the default name of the Windows service
+const ServiceName = "spire-server"
+
 type CLI struct {
 	LogOptions         []log.Option
 	AllowUnknownConfig bool
+
+	// Context stops the run command when canceled. Defaults to
+	// context.Background().
+	Context context.Context
 }
 
 func (cc *CLI) Run(args []string) int {
 	c := cli.NewCLI("spire-server", version.Version())
 	c.Args = args
+	ctx := cc.Context
+	if ctx == nil {
+		ctx = context.Background()
+	}
 	c.Commands = map[string]cli.CommandFactory{
 		"agent evict": func() (cli.Command, error) {
 			return agent.NewEvictCommand(), nil
@@ -47,6 +62,12 @@ func (cc *CLI) Run(args []string) int {
 		"bundle delete": func() (cli.Command, error) {
 			return bundle.NewDeleteCommand(), nil
 		},
+		"bundle count": func() (cli.Command, error) {
+			return bundle.NewCountCommand(), nil
+		},
+		"bundle prune": func() (cli.Command, error) {
+			return bundle.NewPruneCommand(), nil
+		},
 		"experimental bundle show": func() (cli.Command, error) {
 			return bundle.NewExperimentalShowCommand(), nil
 		},
@@ -56,6 +77,12 @@ func (cc *CLI) Run(args []string) int {
 		"experimental bundle set": func() (cli.Command, error) {
 			return bundle.NewExperimentalSetCommand(), nil
 		},
+		"ca journal verify": func() (cli.Command, error) {
+			return ca.NewVerifyCommand(), nil
+		},
+		"ca journal repair": func() (cli.Command, error) {
+			return ca.NewRepairCommand(), nil
+		},
 		"entry create": func() (cli.Command, error) {
 			return entry.NewCreateCommand(), nil
 		},
@@ -68,12 +95,36 @@ func (cc *CLI) Run(args []string) int {
 		"entry show": func() (cli.Command, error) {
 			return entry.NewShowCommand(), nil
 		},
+		"federation create": func() (cli.Command, error) {
+			return federation.NewCreateCommand(), nil
+		},
+		"federation delete": func() (cli.Command, error) {
+			return federation.NewDeleteCommand(), nil
+		},
+		"federation list": func() (cli.Command, error) {
+			return federation.NewListCommand(), nil
+		},
+		"federation refresh": func() (cli.Command, error) {
+			return federation.NewRefreshCommand(), nil
+		},
+		"federation show": func() (cli.Command, error) {
+			return federation.NewShowCommand(), nil
+		},
+		"federation update": func() (cli.Command, error) {
+			return federation.NewUpdateCommand(), nil
+		},
 		"run": func() (cli.Command, error) {
-			return run.NewRunCommand(cc.LogOptions, cc.AllowUnknownConfig), nil
+			return run.NewRunCommand(ctx, cc.LogOptions, cc.AllowUnknownConfig), nil
 		},
 		"token generate": func() (cli.Command, error) {
 			return token.NewGenerateCommand(), nil
 		},
+		"token list": func() (cli.Command, error) {
+			return token.NewListCommand(), nil
+		},
+		"token revoke": func() (cli.Command, error) {
+			return token.NewRevokeCommand(), nil
+		},
 		"healthcheck": func() (cli.Command, error) {
 			return healthcheck.NewHealthCheckCommand(), nil
 		},
@@ -83,6 +134,16 @@ func (cc *CLI) Run(args []string) int {
 		"jwt mint": func() (cli.Command, error) {
 			return jwt.NewMintCommand(), nil
 		},
+		"service install": func() (cli.Command, error) {
+			return winsvc.NewInstallCommand(winsvc.ServiceConfig{
+				Name:        ServiceName,
+				DisplayName: "SPIRE Server",
+				Description: "Manages the identities of a SPIFFE trust domain",
+			}), nil
+		},
+		"service uninstall": func() (cli.Command, error) {
+			return winsvc.NewUninstallCommand(ServiceName), nil
+		},
 		"validate": func() (cli.Command, error) {
 			return validate.NewValidateCommand(), nil
 		},
@@ -59,6 +59,9 @@ type createCommand struct {
 
 	// DNSNames entries for SVIDs based on this entry
 	dnsNames StringsFlag
+
+	// Audiences JWT-SVIDs based on this entry can be minted for
+	allowedAudiences StringsFlag
 }
 
 func (*createCommand) Name() string {
@@ -81,6 +84,7 @@ func (c *createCommand) AppendFlags(f *flag.FlagSet) {
 	f.BoolVar(&c.downstream, "downstream", false, "A boolean value that, when set, indicates that the entry describes a downstream SPIRE server")
 	f.Int64Var(&c.entryExpiry, "entryExpiry", 0, "An expiry, from epoch in seconds, for the resulting registration entry to be pruned")
 	f.Var(&c.dnsNames, "dns", "A DNS name that will be included in SVIDs issued based on this entry, where appropriate. Can be used more than once")
+	f.Var(&c.allowedAudiences, "allowedAudience", "An audience JWT-SVIDs issued based on this entry can be minted for. If not set, any audience is allowed. Can be used more than once")
 }
 
 func (c *createCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
@@ -99,25 +103,39 @@ func (c *createCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 		return err
 	}
 
-	succeeded, failed, err := createEntries(ctx, serverClient.NewEntryClient(), entries)
+	resp, err := createEntries(ctx, serverClient.NewEntryClient(), entries)
 	if err != nil {
 		return err
 	}
 
-	// Print entries that succeeded to be created
-	for _, r := range succeeded {
-		printEntry(r.Entry, env.Printf)
+	if env.JSONOutput() {
+		if err := env.PrintJSON(resp); err != nil {
+			return err
+		}
 	}
 
-	// Print entries that failed to be created
-	for _, r := range failed {
-		env.ErrPrintf("Failed to create the following entry (code: %s, msg: %q):\n",
-			codes.Code(r.Status.Code),
-			r.Status.Message)
-		printEntry(r.Entry, env.ErrPrintf)
+	failed := false
+	for _, r := range resp.Results {
+		if r.Status.Code != int32(codes.OK) {
+			failed = true
+		}
+		if env.JSONOutput() {
+			continue
+		}
+
+		if r.Status.Code == int32(codes.OK) {
+			// Print entries that succeeded to be created
+			printEntry(r.Entry, env.Printf)
+		} else {
+			// Print entries that failed to be created
+			env.ErrPrintf("Failed to create the following entry (code: %s, msg: %q):\n",
+				codes.Code(r.Status.Code),
+				r.Status.Message)
+			printEntry(r.Entry, env.ErrPrintf)
+		}
 	}
 
-	if len(failed) > 0 {
+	if failed {
 		return errors.New("failed to create one or more entries")
 	}
 
@@ -194,6 +212,8 @@ func (c *createCommand) parseConfig() ([]*types.Entry, error) {
 		Downstream: c.downstream,
 		ExpiresAt:  c.entryExpiry,
 		DnsNames:   c.dnsNames,
+
+		AllowedAudiences: c.allowedAudiences,
 	}
 
 	selectors := []*types.Selector{}
@@ -212,25 +232,21 @@ func (c *createCommand) parseConfig() ([]*types.Entry, error) {
 	return []*types.Entry{e}, nil
 }
 
-func createEntries(ctx context.Context, c entry.EntryClient, entries []*types.Entry) (succeeded, failed []*entry.BatchCreateEntryResponse_Result, err error) {
+func createEntries(ctx context.Context, c entry.EntryClient, entries []*types.Entry) (*entry.BatchCreateEntryResponse, error) {
 	resp, err := c.BatchCreateEntry(ctx, &entry.BatchCreateEntryRequest{Entries: entries})
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	for i, r := range resp.Results {
-		switch r.Status.Code {
-		case int32(codes.OK):
-			succeeded = append(succeeded, r)
-		default:
+		if r.Status.Code != int32(codes.OK) {
 			// The Entry API does not include in the results the entries that
 			// failed to be created, so we populate them from the request data.
 			r.Entry = entries[i]
-			failed = append(failed, r)
 		}
 	}
 
-	return succeeded, failed, nil
+	return resp, nil
 }
 
 func getParentID(config *createCommand, td string) (*types.SPIFFEID, error) {
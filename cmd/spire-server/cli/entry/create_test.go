@@ -8,8 +8,10 @@ import (
 
 	"github.com/spiffe/spire/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestCreateHelp(t *testing.T) {
@@ -19,6 +21,8 @@ func TestCreateHelp(t *testing.T) {
 	require.Equal(t, `Usage of entry create:
   -admin
     	If set, the SPIFFE ID in this entry will be granted access to the Registration API
+  -allowedAudience value
+    	An audience JWT-SVIDs issued based on this entry can be minted for. If not set, any audience is allowed. Can be used more than once
   -data string
     	Path to a file containing registration JSON (optional). If set to '-', read the JSON from stdin.
   -dns value
@@ -31,6 +35,8 @@ func TestCreateHelp(t *testing.T) {
     	SPIFFE ID of a trust domain to federate with. Can be used more than once
   -node
     	If set, this entry will be applied to matching nodes rather than workloads
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -parentID string
     	The SPIFFE ID of this record's parent
   -registrationUDSPath string
@@ -49,6 +55,46 @@ func TestCreateSynopsis(t *testing.T) {
 	require.Equal(t, "Creates registration entries", test.client.Synopsis())
 }
 
+func TestCreateJSON(t *testing.T) {
+	fakeResp := &entry.BatchCreateEntryResponse{
+		Results: []*entry.BatchCreateEntryResponse_Result{
+			{
+				Entry: &types.Entry{
+					Id:        "entry-id",
+					SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+					ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+				},
+				Status: &types.Status{
+					Code:    int32(codes.OK),
+					Message: "OK",
+				},
+			},
+		},
+	}
+
+	test := setupTest(t, newCreateCommand)
+	test.server.expBatchCreateEntryReq = &entry.BatchCreateEntryRequest{Entries: []*types.Entry{
+		{
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+			ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+		},
+	}}
+	test.server.batchCreateEntryResp = fakeResp
+
+	rc := test.client.Run(append(test.args,
+		"-spiffeID", "spiffe://example.org/workload",
+		"-parentID", "spiffe://example.org/parent",
+		"-selector", "unix:uid:1",
+		"-output", "json"))
+	require.Equal(t, 0, rc)
+
+	resp := new(entry.BatchCreateEntryResponse)
+	require.NoError(t, protojson.Unmarshal(test.stdout.Bytes(), resp))
+	spiretest.RequireProtoEqual(t, fakeResp, resp)
+}
+
 func TestCreate(t *testing.T) {
 	fakeRespOKFromCmd := &entry.BatchCreateEntryResponse{
 		Results: []*entry.BatchCreateEntryResponse_Result{
@@ -67,6 +113,8 @@ func TestCreate(t *testing.T) {
 					ExpiresAt:     1552410266,
 					DnsNames:      []string{"unu1000", "ung1000"},
 					Downstream:    true,
+
+					AllowedAudiences: []string{"audience1"},
 				},
 				Status: &types.Status{
 					Code:    int32(codes.OK),
@@ -202,6 +250,7 @@ func TestCreate(t *testing.T) {
 				"-dns", "unu1000",
 				"-dns", "ung1000",
 				"-downstream",
+				"-allowedAudience", "audience1",
 			},
 			expReq: &entry.BatchCreateEntryRequest{
 				Entries: []*types.Entry{
@@ -218,6 +267,8 @@ func TestCreate(t *testing.T) {
 						ExpiresAt:     1552410266,
 						DnsNames:      []string{"unu1000", "ung1000"},
 						Downstream:    true,
+
+						AllowedAudiences: []string{"audience1"},
 					},
 				},
 			},
@@ -235,6 +286,7 @@ FederatesWith    : spiffe://domaina.test
 FederatesWith    : spiffe://domainb.test
 DNS name         : unu1000
 DNS name         : ung1000
+Allowed audience : audience1
 Admin            : true
 
 `, time.Unix(1552410266, 0).UTC()),
@@ -301,6 +353,20 @@ Selector         : unix:uid:1
 Error: failed to create one or more entries
 `,
 		},
+		{
+			name: "Entry already exist with json output",
+			args: []string{"-spiffeID", "spiffe://example.org/already-exist", "-node", "-selector", "unix:uid:1", "-output", "json"},
+			expReq: &entry.BatchCreateEntryRequest{Entries: []*types.Entry{
+				{
+					SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/already-exist"},
+					ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/server"},
+					Selectors: []*types.Selector{{Type: "unix", Value: "uid:1"}},
+				},
+			}},
+			fakeResp: fakeRespErr,
+			// The results are printed to stdout, failures included
+			expErr: "Error: failed to create one or more entries\n",
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
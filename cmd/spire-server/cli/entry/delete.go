@@ -54,6 +54,9 @@ func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClie
 	sts := resp.Results[0].Status
 	switch sts.Code {
 	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
 		env.Printf("Deleted entry with ID: %s\n", c.entryID)
 		return nil
 	default:
@@ -17,6 +17,8 @@ func TestDeleteHelp(t *testing.T) {
 	require.Equal(t, `Usage of entry delete:
   -entryID string
     	The Registration Entry ID of the record to delete
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
 `, test.stderr.String())
@@ -88,6 +90,13 @@ func TestDelete(t *testing.T) {
 			fakeResp: fakeRespOK,
 			expOut:   "Deleted entry with ID: entry-id\n",
 		},
+		{
+			name:     "Delete succeeds with json output",
+			args:     []string{"-entryID", "entry-id", "-output", "json"},
+			expReq:   &entry.BatchDeleteEntryRequest{Ids: []string{"entry-id"}},
+			fakeResp: fakeRespOK,
+			expOut:   `{"results":[{"status":{"code":0,"message":"OK"},"id":"entry-id"}]}` + "\n",
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -43,6 +43,9 @@ type showCommand struct {
 
 	// Whether or not the entry is for a downstream SPIRE server
 	downstream bool
+
+	// SPIFFE ID of an agent to show the entries delivered to
+	matchAgent string
 }
 
 func (c *showCommand) Name() string {
@@ -60,6 +63,7 @@ func (c *showCommand) AppendFlags(f *flag.FlagSet) {
 	f.BoolVar(&c.downstream, "downstream", false, "A boolean value that, when set, indicates that the entry describes a downstream SPIRE server")
 	f.Var(&c.selectors, "selector", "A colon-delimited type:value selector. Can be used more than once")
 	f.Var(&c.federatesWith, "federatesWith", "SPIFFE ID of a trust domain an entry is federate with. Can be used more than once")
+	f.StringVar(&c.matchAgent, "matchAgent", "", "The SPIFFE ID of an agent. Shows the entries delivered to the agent, including those obtained through node alias entries")
 }
 
 // Run executes all logic associated with a single invocation of the
@@ -76,6 +80,11 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 
 	filteredEntries := c.filterByFederatedWith(entries)
 	commonutil.SortTypesEntries(filteredEntries)
+	if env.JSONOutput() {
+		// The entries are printed as a ListEntries response, whichever way
+		// they were fetched
+		return env.PrintJSON(&entry.ListEntriesResponse{Entries: filteredEntries})
+	}
 	printEntries(filteredEntries, env)
 	return nil
 }
@@ -84,11 +93,19 @@ func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 func (c *showCommand) validate() error {
 	// If entryID is given, it should be the only constraint
 	if c.entryID != "" {
-		if c.parentID != "" || c.spiffeID != "" || len(c.selectors) > 0 {
+		if c.parentID != "" || c.spiffeID != "" || len(c.selectors) > 0 || c.matchAgent != "" {
 			return errors.New("the -entryID flag can't be combined with others")
 		}
 	}
 
+	// The entries delivered to an agent can only be filtered by the
+	// trust domains they federate with
+	if c.matchAgent != "" {
+		if c.parentID != "" || c.spiffeID != "" || len(c.selectors) > 0 {
+			return errors.New("the -matchAgent flag can only be combined with -federatesWith")
+		}
+	}
+
 	return nil
 }
 
@@ -102,6 +119,14 @@ func (c *showCommand) fetchEntries(ctx context.Context, client entry.EntryClient
 		return []*types.Entry{entry}, nil
 	}
 
+	if c.matchAgent != "" {
+		entries, err := c.fetchByAgentID(ctx, c.matchAgent, client)
+		if err != nil {
+			return nil, fmt.Errorf("error fetching entries for agent %s: %s", c.matchAgent, err)
+		}
+		return entries, nil
+	}
+
 	filter := &entry.ListEntriesRequest_Filter{}
 	if c.parentID != "" {
 		id, err := idStringToProto(c.parentID)
@@ -154,6 +179,21 @@ func (c *showCommand) fetchByEntryID(ctx context.Context, id string, client entr
 	return entry, nil
 }
 
+// fetchByAgentID fetches the entries the given agent is authorized for
+func (c *showCommand) fetchByAgentID(ctx context.Context, agentID string, client entry.EntryClient) ([]*types.Entry, error) {
+	id, err := idStringToProto(agentID)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.GetAgentAuthorizedEntries(ctx, &entry.GetAgentAuthorizedEntriesRequest{AgentId: id})
+	if err != nil {
+		return nil, err
+	}
+
+	return resp.Entries, nil
+}
+
 // filterByFederatedWith evicts any value from the given entries slice that does
 // not contain at least one of the federated trust domains specified in the
 // federatesWith slice.
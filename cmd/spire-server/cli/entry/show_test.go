@@ -5,11 +5,14 @@ import (
 	"testing"
 	"time"
 
+	commonutil "github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
 )
 
 func TestShowHelp(t *testing.T) {
@@ -23,6 +26,10 @@ func TestShowHelp(t *testing.T) {
     	The Entry ID of the records to show
   -federatesWith value
     	SPIFFE ID of a trust domain an entry is federate with. Can be used more than once
+  -matchAgent string
+    	The SPIFFE ID of an agent. Shows the entries delivered to the agent, including those obtained through node alias entries
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -parentID string
     	The Parent ID of the records to show
   -registrationUDSPath string
@@ -62,6 +69,9 @@ func TestShow(t *testing.T) {
 		expGetReq    *entry.GetEntryRequest
 		fakeGetResp  *types.Entry
 
+		expGetAgentEntriesReq   *entry.GetAgentAuthorizedEntriesRequest
+		fakeGetAgentEntriesResp *entry.GetAgentAuthorizedEntriesResponse
+
 		serverErr error
 
 		expOut string
@@ -184,6 +194,49 @@ func TestShow(t *testing.T) {
 				getPrintedEntry(2),
 			),
 		},
+		{
+			name: "List by agent",
+			args: []string{"-matchAgent", "spiffe://example.org/spire/agent/join_token/token"},
+			expGetAgentEntriesReq: &entry.GetAgentAuthorizedEntriesRequest{
+				AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/join_token/token"},
+			},
+			fakeGetAgentEntriesResp: &entry.GetAgentAuthorizedEntriesResponse{
+				Entries: getEntries(2),
+			},
+			expOut: fmt.Sprintf("Found 2 entries\n%s%s",
+				getPrintedEntry(1),
+				getPrintedEntry(0),
+			),
+		},
+		{
+			name: "List by agent and federates with",
+			args: []string{"-matchAgent", "spiffe://example.org/spire/agent/join_token/token", "-federatesWith", "spiffe://domain.test"},
+			expGetAgentEntriesReq: &entry.GetAgentAuthorizedEntriesRequest{
+				AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/join_token/token"},
+			},
+			fakeGetAgentEntriesResp: &entry.GetAgentAuthorizedEntriesResponse{
+				Entries: getEntries(4),
+			},
+			expOut: fmt.Sprintf("Found 1 entry\n%s",
+				getPrintedEntry(2),
+			),
+		},
+		{
+			name:   "List by agent and other fields",
+			args:   []string{"-matchAgent", "spiffe://example.org/spire/agent/join_token/token", "-parentID", "spiffe://example.org/father"},
+			expErr: "Error: the -matchAgent flag can only be combined with -federatesWith\n",
+		},
+		{
+			name:   "List by agent using invalid ID",
+			args:   []string{"-matchAgent", "invalid-id"},
+			expErr: "Error: error fetching entries for agent invalid-id: spiffeid: invalid scheme\n",
+		},
+		{
+			name:      "List by agent not found",
+			args:      []string{"-matchAgent", "spiffe://example.org/spire/agent/join_token/token"},
+			serverErr: status.Error(codes.NotFound, "agent not found"),
+			expErr:    "Error: error fetching entries for agent spiffe://example.org/spire/agent/join_token/token: rpc error: code = NotFound desc = agent not found\n",
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -193,6 +246,8 @@ func TestShow(t *testing.T) {
 			test.server.listEntriesResp = tt.fakeListResp
 			test.server.expGetEntryReq = tt.expGetReq
 			test.server.getEntryResp = tt.fakeGetResp
+			test.server.expGetAgentEntriesReq = tt.expGetAgentEntriesReq
+			test.server.getAgentEntriesResp = tt.fakeGetAgentEntriesResp
 
 			args := append(test.args, tt.args...)
 			rc := test.client.Run(args)
@@ -208,6 +263,27 @@ func TestShow(t *testing.T) {
 	}
 }
 
+func TestShowJSON(t *testing.T) {
+	test := setupTest(t, newShowCommand)
+	test.server.expGetAgentEntriesReq = &entry.GetAgentAuthorizedEntriesRequest{
+		AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/agent/join_token/token"},
+	}
+	test.server.getAgentEntriesResp = &entry.GetAgentAuthorizedEntriesResponse{
+		Entries: getEntries(2),
+	}
+
+	rc := test.client.Run(append(test.args, "-matchAgent", "spiffe://example.org/spire/agent/join_token/token", "-output", "json"))
+	require.Equal(t, 0, rc)
+
+	// Entries are printed as a ListEntries response, sorted as in the
+	// pretty output
+	resp := new(entry.ListEntriesResponse)
+	require.NoError(t, protojson.Unmarshal(test.stdout.Bytes(), resp))
+	entries := getEntries(2)
+	commonutil.SortTypesEntries(entries)
+	spiretest.RequireProtoEqual(t, &entry.ListEntriesResponse{Entries: entries}, resp)
+}
+
 // registrationEntries returns `count` registration entry records. At most 4.
 func getEntries(count int) []*types.Entry {
 	selectors := []*types.Selector{
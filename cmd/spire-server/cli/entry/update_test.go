@@ -19,6 +19,8 @@ func TestUpdateHelp(t *testing.T) {
 	require.Equal(t, `Usage of entry update:
   -admin
     	If true, the SPIFFE ID in this entry will be granted access to the Registration API
+  -allowedAudience value
+    	An audience JWT-SVIDs issued based on this entry can be minted for. If not set, any audience is allowed. Can be used more than once
   -data string
     	Path to a file containing registration JSON (optional). If set to '-', read the JSON from stdin.
   -dns value
@@ -31,6 +33,8 @@ func TestUpdateHelp(t *testing.T) {
     	The Registration Entry ID of the record to update
   -federatesWith value
     	SPIFFE ID of a trust domain to federate with. Can be used more than once
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -parentID string
     	The SPIFFE ID of this record's parent
   -registrationUDSPath string
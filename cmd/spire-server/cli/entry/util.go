@@ -60,6 +60,9 @@ func printEntry(e *types.Entry, printf func(string, ...interface{}) error) {
 	for _, dnsName := range e.DnsNames {
 		printf("DNS name         : %s\n", dnsName)
 	}
+	for _, audience := range e.AllowedAudiences {
+		printf("Allowed audience : %s\n", audience)
+	}
 
 	// admin is rare, so only show admin if true to keep
 	// from muddying the output.
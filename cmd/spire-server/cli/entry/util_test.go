@@ -141,12 +141,14 @@ type fakeEntryServer struct {
 	expBatchDeleteEntryReq *entry.BatchDeleteEntryRequest
 	expBatchCreateEntryReq *entry.BatchCreateEntryRequest
 	expBatchUpdateEntryReq *entry.BatchUpdateEntryRequest
+	expGetAgentEntriesReq  *entry.GetAgentAuthorizedEntriesRequest
 
 	getEntryResp         *types.Entry
 	listEntriesResp      *entry.ListEntriesResponse
 	batchDeleteEntryResp *entry.BatchDeleteEntryResponse
 	batchCreateEntryResp *entry.BatchCreateEntryResponse
 	batchUpdateEntryResp *entry.BatchUpdateEntryResponse
+	getAgentEntriesResp  *entry.GetAgentAuthorizedEntriesResponse
 }
 
 func (f fakeEntryServer) ListEntries(ctx context.Context, req *entry.ListEntriesRequest) (*entry.ListEntriesResponse, error) {
@@ -189,6 +191,14 @@ func (f fakeEntryServer) BatchUpdateEntry(ctx context.Context, req *entry.BatchU
 	return f.batchUpdateEntryResp, nil
 }
 
+func (f fakeEntryServer) GetAgentAuthorizedEntries(ctx context.Context, req *entry.GetAgentAuthorizedEntriesRequest) (*entry.GetAgentAuthorizedEntriesResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	spiretest.RequireProtoEqual(f.t, f.expGetAgentEntriesReq, req)
+	return f.getAgentEntriesResp, nil
+}
+
 func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *entryTest {
 	stdin := new(bytes.Buffer)
 	stdout := new(bytes.Buffer)
@@ -0,0 +1,150 @@
+package federation
+
+import (
+	"crypto/x509"
+	"errors"
+	"flag"
+	"fmt"
+
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/proto/spire/types"
+)
+
+const (
+	profileHTTPSWeb    = "https_web"
+	profileHTTPSSPIFFE = "https_spiffe"
+)
+
+// relationshipConfig holds the flags shared by the commands that create or
+// update federation relationships.
+type relationshipConfig struct {
+	// Trust domain name of the federated trust domain
+	trustDomain string
+
+	// URL of the bundle endpoint of the federated trust domain
+	bundleEndpointURL string
+
+	// Bundle endpoint profile, either https_web or https_spiffe
+	bundleEndpointProfile string
+
+	// SPIFFE ID of the bundle endpoint server (https_spiffe profile only)
+	endpointSpiffeID string
+
+	// Path to the root CAs trusted to authenticate the bundle endpoint
+	// server (https_web profile only)
+	webPKIRootCAsPath string
+
+	// Whether the bundle endpoint server is authenticated with the root CAs
+	// from webPKIRootCAsPath only (https_web profile only)
+	pinWebPKIRootCAs bool
+}
+
+func (c *relationshipConfig) appendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.trustDomain, "trustDomain", "", "Name of the trust domain to federate with (e.g., example.org)")
+	fs.StringVar(&c.bundleEndpointURL, "bundleEndpointURL", "", "URL of the SPIFFE bundle endpoint that provides the trust bundle (must use the HTTPS protocol)")
+	fs.StringVar(&c.bundleEndpointProfile, "bundleEndpointProfile", profileHTTPSWeb, fmt.Sprintf("Endpoint profile type (either %q or %q)", profileHTTPSWeb, profileHTTPSSPIFFE))
+	fs.StringVar(&c.endpointSpiffeID, "endpointSpiffeID", "", fmt.Sprintf("SPIFFE ID of the SPIFFE bundle endpoint server. Only used for %q profile.", profileHTTPSSPIFFE))
+	fs.StringVar(&c.webPKIRootCAsPath, "webPKIRootCAsPath", "", fmt.Sprintf("Path to a PEM file with root CA certificates trusted to authenticate the SPIFFE bundle endpoint server, in addition to the system roots. Only used for %q profile.", profileHTTPSWeb))
+	fs.BoolVar(&c.pinWebPKIRootCAs, "pinWebPKIRootCAs", false, fmt.Sprintf("Authenticate the SPIFFE bundle endpoint server with the root CAs from -webPKIRootCAsPath only, ignoring the system roots. Only used for %q profile.", profileHTTPSWeb))
+}
+
+func (c *relationshipConfig) toProto() (*types.FederationRelationship, error) {
+	if c.trustDomain == "" {
+		return nil, errors.New("trust domain is required")
+	}
+	if c.bundleEndpointURL == "" {
+		return nil, errors.New("bundle endpoint URL is required")
+	}
+
+	fr := &types.FederationRelationship{
+		TrustDomain:       c.trustDomain,
+		BundleEndpointUrl: c.bundleEndpointURL,
+	}
+
+	switch c.bundleEndpointProfile {
+	case profileHTTPSWeb:
+		if c.endpointSpiffeID != "" {
+			return nil, fmt.Errorf("the %q profile does not accept an endpoint SPIFFE ID", profileHTTPSWeb)
+		}
+		if c.pinWebPKIRootCAs && c.webPKIRootCAsPath == "" {
+			return nil, errors.New("a web PKI root CAs path is required to pin the web PKI root CAs")
+		}
+		httpsWeb := &types.HTTPSWebProfile{
+			PinRootCas: c.pinWebPKIRootCAs,
+		}
+		if c.webPKIRootCAsPath != "" {
+			rootCAs, err := pemutil.LoadCertificates(c.webPKIRootCAsPath)
+			if err != nil {
+				return nil, fmt.Errorf("unable to load web PKI root CAs: %w", err)
+			}
+			for _, rootCA := range rootCAs {
+				httpsWeb.RootCas = append(httpsWeb.RootCas, rootCA.Raw)
+			}
+		}
+		fr.BundleEndpointProfile = &types.FederationRelationship_HttpsWeb{
+			HttpsWeb: httpsWeb,
+		}
+	case profileHTTPSSPIFFE:
+		if c.endpointSpiffeID == "" {
+			return nil, fmt.Errorf("an endpoint SPIFFE ID is required for the %q profile", profileHTTPSSPIFFE)
+		}
+		if c.webPKIRootCAsPath != "" || c.pinWebPKIRootCAs {
+			return nil, fmt.Errorf("the %q profile does not accept web PKI root CAs", profileHTTPSSPIFFE)
+		}
+		fr.BundleEndpointProfile = &types.FederationRelationship_HttpsSpiffe{
+			HttpsSpiffe: &types.HTTPSSPIFFEProfile{
+				EndpointSpiffeId: c.endpointSpiffeID,
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown bundle endpoint profile type: %q", c.bundleEndpointProfile)
+	}
+
+	return fr, nil
+}
+
+func printFederationRelationships(env *common_cli.Env, relationships ...*types.FederationRelationship) error {
+	for _, fr := range relationships {
+		if err := env.Printf("Trust domain              : %s\n", fr.TrustDomain); err != nil {
+			return err
+		}
+		if err := env.Printf("Bundle endpoint URL       : %s\n", fr.BundleEndpointUrl); err != nil {
+			return err
+		}
+
+		switch profile := fr.BundleEndpointProfile.(type) {
+		case *types.FederationRelationship_HttpsWeb:
+			if err := env.Printf("Bundle endpoint profile   : %s\n", profileHTTPSWeb); err != nil {
+				return err
+			}
+			for _, rootCA := range profile.HttpsWeb.GetRootCas() {
+				subject := "<unparseable>"
+				if cert, err := x509.ParseCertificate(rootCA); err == nil {
+					subject = cert.Subject.String()
+				}
+				if err := env.Printf("Web PKI root CA           : %s\n", subject); err != nil {
+					return err
+				}
+			}
+			if profile.HttpsWeb.GetPinRootCas() {
+				if err := env.Printf("Web PKI root CAs pinned   : true\n"); err != nil {
+					return err
+				}
+			}
+		case *types.FederationRelationship_HttpsSpiffe:
+			if err := env.Printf("Bundle endpoint profile   : %s\n", profileHTTPSSPIFFE); err != nil {
+				return err
+			}
+			if err := env.Printf("Endpoint SPIFFE ID        : %s\n", profile.HttpsSpiffe.EndpointSpiffeId); err != nil {
+				return err
+			}
+		}
+
+		if err := env.Println(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
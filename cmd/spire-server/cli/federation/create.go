@@ -0,0 +1,68 @@
+package federation
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"google.golang.org/grpc/codes"
+)
+
+// NewCreateCommand creates a new "create" subcommand for "federation" command.
+func NewCreateCommand() cli.Command {
+	return NewCreateCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewCreateCommandWithEnv creates a new "create" subcommand for "federation"
+// command using the environment specified.
+func NewCreateCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(createCommand))
+}
+
+type createCommand struct {
+	config relationshipConfig
+}
+
+func (*createCommand) Name() string {
+	return "federation create"
+}
+
+func (*createCommand) Synopsis() string {
+	return "Creates a federation relationship with a foreign trust domain"
+}
+
+func (c *createCommand) AppendFlags(fs *flag.FlagSet) {
+	c.config.appendFlags(fs)
+}
+
+func (c *createCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	fr, err := c.config.toProto()
+	if err != nil {
+		return err
+	}
+
+	client := serverClient.NewTrustDomainClient()
+	resp, err := client.BatchCreateFederationRelationship(ctx, &trustdomain.BatchCreateFederationRelationshipRequest{
+		FederationRelationships: []*types.FederationRelationship{fr},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to create federation relationship: %w", err)
+	}
+
+	result := resp.Results[0]
+	switch result.Status.Code {
+	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
+		env.Printf("Federation relationship created.\n\n")
+		return printFederationRelationships(env, result.FederationRelationship)
+	default:
+		return fmt.Errorf("failed to create federation relationship with %q: %s", fr.TrustDomain, result.Status.Message)
+	}
+}
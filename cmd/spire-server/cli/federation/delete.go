@@ -0,0 +1,67 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"google.golang.org/grpc/codes"
+)
+
+// NewDeleteCommand creates a new "delete" subcommand for "federation" command.
+func NewDeleteCommand() cli.Command {
+	return NewDeleteCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewDeleteCommandWithEnv creates a new "delete" subcommand for "federation"
+// command using the environment specified.
+func NewDeleteCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(deleteCommand))
+}
+
+type deleteCommand struct {
+	// Trust domain name of the federation relationship to delete
+	trustDomain string
+}
+
+func (*deleteCommand) Name() string {
+	return "federation delete"
+}
+
+func (*deleteCommand) Synopsis() string {
+	return "Deletes a federation relationship"
+}
+
+func (c *deleteCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.trustDomain, "trustDomain", "", "The trust domain name of the federation relationship to delete")
+}
+
+func (c *deleteCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.trustDomain == "" {
+		return errors.New("a trust domain name is required")
+	}
+
+	client := serverClient.NewTrustDomainClient()
+	resp, err := client.BatchDeleteFederationRelationship(ctx, &trustdomain.BatchDeleteFederationRelationshipRequest{
+		TrustDomains: []string{c.trustDomain},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to delete federation relationship: %w", err)
+	}
+
+	result := resp.Results[0]
+	switch result.Status.Code {
+	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
+		return env.Println("Federation relationship deleted.")
+	default:
+		return fmt.Errorf("failed to delete federation relationship %q: %s", result.TrustDomain, result.Status.Message)
+	}
+}
@@ -0,0 +1,530 @@
+package federation_test
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/cli/federation"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/server/api"
+	trustdomainpb "github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+var (
+	webRelationship = &types.FederationRelationship{
+		TrustDomain:           "domain1.org",
+		BundleEndpointUrl:     "https://domain1.org/bundle",
+		BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+	}
+	pinnedWebRelationship = &types.FederationRelationship{
+		TrustDomain:       "domain1.org",
+		BundleEndpointUrl: "https://domain1.org/bundle",
+		BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{
+			HttpsWeb: &types.HTTPSWebProfile{RootCas: [][]byte{loadRootCA().Raw}, PinRootCas: true},
+		},
+	}
+	spiffeRelationship = &types.FederationRelationship{
+		TrustDomain:       "domain2.org",
+		BundleEndpointUrl: "https://domain2.org/bundle",
+		BundleEndpointProfile: &types.FederationRelationship_HttpsSpiffe{
+			HttpsSpiffe: &types.HTTPSSPIFFEProfile{EndpointSpiffeId: "spiffe://domain2.org/bundle-server"},
+		},
+	}
+)
+
+const (
+	webRelationshipOutput = `Trust domain              : domain1.org
+Bundle endpoint URL       : https://domain1.org/bundle
+Bundle endpoint profile   : https_web
+
+`
+	pinnedWebRelationshipOutput = `Trust domain              : domain1.org
+Bundle endpoint URL       : https://domain1.org/bundle
+Bundle endpoint profile   : https_web
+Web PKI root CA           : CN=test
+Web PKI root CAs pinned   : true
+
+`
+	spiffeRelationshipOutput = `Trust domain              : domain2.org
+Bundle endpoint URL       : https://domain2.org/bundle
+Bundle endpoint profile   : https_spiffe
+Endpoint SPIFFE ID        : spiffe://domain2.org/bundle-server
+
+`
+)
+
+const rootCAPath = "../../../../test/fixture/certs/base_cert.pem"
+
+func loadRootCA() *x509.Certificate {
+	rootCA, err := pemutil.LoadCertificate(rootCAPath)
+	if err != nil {
+		panic(err)
+	}
+	return rootCA
+}
+
+type federationTest struct {
+	stdin  *bytes.Buffer
+	stdout *bytes.Buffer
+	stderr *bytes.Buffer
+
+	args   []string
+	server *fakeTrustDomainServer
+
+	client cli.Command
+}
+
+func (s *federationTest) afterTest(t *testing.T) {
+	t.Logf("TEST:%s", t.Name())
+	t.Logf("STDOUT:\n%s", s.stdout.String())
+	t.Logf("STDIN:\n%s", s.stdin.String())
+	t.Logf("STDERR:\n%s", s.stderr.String())
+}
+
+func TestCreateHelp(t *testing.T) {
+	test := setupTest(t, federation.NewCreateCommandWithEnv)
+
+	test.client.Help()
+	require.Equal(t, `Usage of federation create:
+  -bundleEndpointProfile string
+    	Endpoint profile type (either "https_web" or "https_spiffe") (default "https_web")
+  -bundleEndpointURL string
+    	URL of the SPIFFE bundle endpoint that provides the trust bundle (must use the HTTPS protocol)
+  -endpointSpiffeID string
+    	SPIFFE ID of the SPIFFE bundle endpoint server. Only used for "https_spiffe" profile.
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
+  -pinWebPKIRootCAs
+    	Authenticate the SPIFFE bundle endpoint server with the root CAs from -webPKIRootCAsPath only, ignoring the system roots. Only used for "https_web" profile.
+  -registrationUDSPath string
+    	Registration API UDS path (default "/tmp/spire-registration.sock")
+  -trustDomain string
+    	Name of the trust domain to federate with (e.g., example.org)
+  -webPKIRootCAsPath string
+    	Path to a PEM file with root CA certificates trusted to authenticate the SPIFFE bundle endpoint server, in addition to the system roots. Only used for "https_web" profile.
+`, test.stderr.String())
+}
+
+func TestCreate(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		result         *trustdomainpb.BatchCreateFederationRelationshipResponse_Result
+		serverErr      error
+		expectedFR     *types.FederationRelationship
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "https_web profile",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle"},
+			result:         &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{Status: api.OK(), FederationRelationship: webRelationship},
+			expectedFR:     webRelationship,
+			expectedStdout: "Federation relationship created.\n\n" + webRelationshipOutput,
+		},
+		{
+			name: "https_spiffe profile",
+			args: []string{
+				"-trustDomain", "domain2.org",
+				"-bundleEndpointURL", "https://domain2.org/bundle",
+				"-bundleEndpointProfile", "https_spiffe",
+				"-endpointSpiffeID", "spiffe://domain2.org/bundle-server",
+			},
+			result:         &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{Status: api.OK(), FederationRelationship: spiffeRelationship},
+			expectedFR:     spiffeRelationship,
+			expectedStdout: "Federation relationship created.\n\n" + spiffeRelationshipOutput,
+		},
+		{
+			name: "https_web profile with pinned root CAs",
+			args: []string{
+				"-trustDomain", "domain1.org",
+				"-bundleEndpointURL", "https://domain1.org/bundle",
+				"-webPKIRootCAsPath", rootCAPath,
+				"-pinWebPKIRootCAs",
+			},
+			result:         &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{Status: api.OK(), FederationRelationship: pinnedWebRelationship},
+			expectedFR:     pinnedWebRelationship,
+			expectedStdout: "Federation relationship created.\n\n" + pinnedWebRelationshipOutput,
+		},
+		{
+			name:           "pinned root CAs without root CAs path",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle", "-pinWebPKIRootCAs"},
+			expectedCode:   1,
+			expectedStderr: "Error: a web PKI root CAs path is required to pin the web PKI root CAs\n",
+		},
+		{
+			name:           "root CAs path that does not exist",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle", "-webPKIRootCAsPath", "/does/not/exist.pem"},
+			expectedCode:   1,
+			expectedStderr: "Error: unable to load web PKI root CAs: open /does/not/exist.pem: no such file or directory\n",
+		},
+		{
+			name: "root CAs with https_spiffe profile",
+			args: []string{
+				"-trustDomain", "domain2.org",
+				"-bundleEndpointURL", "https://domain2.org/bundle",
+				"-bundleEndpointProfile", "https_spiffe",
+				"-endpointSpiffeID", "spiffe://domain2.org/bundle-server",
+				"-webPKIRootCAsPath", rootCAPath,
+			},
+			expectedCode:   1,
+			expectedStderr: "Error: the \"https_spiffe\" profile does not accept web PKI root CAs\n",
+		},
+		{
+			name:           "missing trust domain",
+			args:           []string{"-bundleEndpointURL", "https://domain1.org/bundle"},
+			expectedCode:   1,
+			expectedStderr: "Error: trust domain is required\n",
+		},
+		{
+			name:           "missing bundle endpoint URL",
+			args:           []string{"-trustDomain", "domain1.org"},
+			expectedCode:   1,
+			expectedStderr: "Error: bundle endpoint URL is required\n",
+		},
+		{
+			name:           "missing endpoint SPIFFE ID",
+			args:           []string{"-trustDomain", "domain2.org", "-bundleEndpointURL", "https://domain2.org/bundle", "-bundleEndpointProfile", "https_spiffe"},
+			expectedCode:   1,
+			expectedStderr: "Error: an endpoint SPIFFE ID is required for the \"https_spiffe\" profile\n",
+		},
+		{
+			name:           "endpoint SPIFFE ID with https_web profile",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle", "-endpointSpiffeID", "spiffe://domain1.org/bundle-server"},
+			expectedCode:   1,
+			expectedStderr: "Error: the \"https_web\" profile does not accept an endpoint SPIFFE ID\n",
+		},
+		{
+			name:           "unknown profile",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle", "-bundleEndpointProfile", "bad"},
+			expectedCode:   1,
+			expectedStderr: "Error: unknown bundle endpoint profile type: \"bad\"\n",
+		},
+		{
+			name:           "failed result",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle"},
+			result:         &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{Status: api.CreateStatus(codes.AlreadyExists, "federation relationship already exists")},
+			expectedFR:     webRelationship,
+			expectedCode:   1,
+			expectedStderr: "Error: failed to create federation relationship with \"domain1.org\": federation relationship already exists\n",
+		},
+		{
+			name:           "server error",
+			args:           []string{"-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle"},
+			serverErr:      status.Error(codes.Internal, "oh no"),
+			expectedFR:     webRelationship,
+			expectedCode:   1,
+			expectedStderr: "Error: failed to create federation relationship: rpc error: code = Internal desc = oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, federation.NewCreateCommandWithEnv)
+			test.server.createResult = tt.result
+			test.server.err = tt.serverErr
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+			if tt.expectedFR != nil {
+				require.Len(t, test.server.receivedFRs, 1)
+				spiretest.AssertProtoEqual(t, tt.expectedFR, test.server.receivedFRs[0])
+			} else {
+				require.Empty(t, test.server.receivedFRs)
+			}
+		})
+	}
+}
+
+func TestUpdate(t *testing.T) {
+	test := setupTest(t, federation.NewUpdateCommandWithEnv)
+	test.server.updateResult = &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{Status: api.OK(), FederationRelationship: webRelationship}
+
+	code := test.client.Run(append(test.args, "-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle"))
+	require.Equal(t, 0, code, test.stderr.String())
+	require.Equal(t, "Federation relationship updated.\n\n"+webRelationshipOutput, test.stdout.String())
+	require.Len(t, test.server.receivedFRs, 1)
+	spiretest.AssertProtoEqual(t, webRelationship, test.server.receivedFRs[0])
+
+	test = setupTest(t, federation.NewUpdateCommandWithEnv)
+	test.server.updateResult = &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{Status: api.CreateStatus(codes.NotFound, "federation relationship does not exist")}
+
+	code = test.client.Run(append(test.args, "-trustDomain", "domain1.org", "-bundleEndpointURL", "https://domain1.org/bundle"))
+	require.Equal(t, 1, code)
+	require.Equal(t, "Error: failed to update federation relationship with \"domain1.org\": federation relationship does not exist\n", test.stderr.String())
+}
+
+func TestList(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		relationships  []*types.FederationRelationship
+		serverErr      error
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "no relationships",
+			expectedStdout: "No federation relationships found\n",
+		},
+		{
+			name:           "one relationship",
+			relationships:  []*types.FederationRelationship{webRelationship},
+			expectedStdout: "Found 1 federation relationship:\n\n" + webRelationshipOutput,
+		},
+		{
+			name:           "multiple relationships",
+			relationships:  []*types.FederationRelationship{webRelationship, spiffeRelationship},
+			expectedStdout: "Found 2 federation relationships:\n\n" + webRelationshipOutput + spiffeRelationshipOutput,
+		},
+		{
+			name:           "json output",
+			args:           []string{"-output", "json"},
+			relationships:  []*types.FederationRelationship{webRelationship},
+			expectedStdout: `{"federation_relationships":[{"trust_domain":"domain1.org","bundle_endpoint_url":"https://domain1.org/bundle","https_web":{"root_cas":[],"pin_root_cas":false}}],"next_page_token":""}` + "\n",
+		},
+		{
+			name:           "no relationships with json output",
+			args:           []string{"-output", "json"},
+			expectedStdout: `{"federation_relationships":[],"next_page_token":""}` + "\n",
+		},
+		{
+			name:           "server error",
+			serverErr:      status.Error(codes.Internal, "oh no"),
+			expectedCode:   1,
+			expectedStderr: "Error: failed to list federation relationships: rpc error: code = Internal desc = oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, federation.NewListCommandWithEnv)
+			test.server.relationships = tt.relationships
+			test.server.err = tt.serverErr
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+		})
+	}
+}
+
+func TestShow(t *testing.T) {
+	test := setupTest(t, federation.NewShowCommandWithEnv)
+	test.server.relationships = []*types.FederationRelationship{spiffeRelationship}
+
+	code := test.client.Run(append(test.args, "-trustDomain", "domain2.org"))
+	require.Equal(t, 0, code, test.stderr.String())
+	require.Equal(t, spiffeRelationshipOutput, test.stdout.String())
+
+	test = setupTest(t, federation.NewShowCommandWithEnv)
+	code = test.client.Run(append(test.args, "-trustDomain", "domain2.org"))
+	require.Equal(t, 1, code)
+	require.Equal(t, "Error: rpc error: code = NotFound desc = federation relationship does not exist\n", test.stderr.String())
+
+	test = setupTest(t, federation.NewShowCommandWithEnv)
+	code = test.client.Run(test.args)
+	require.Equal(t, 1, code)
+	require.Equal(t, "Error: a trust domain name is required\n", test.stderr.String())
+}
+
+func TestDelete(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		status         *types.Status
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "success",
+			args:           []string{"-trustDomain", "domain1.org"},
+			status:         api.OK(),
+			expectedStdout: "Federation relationship deleted.\n",
+		},
+		{
+			name:           "success with json output",
+			args:           []string{"-trustDomain", "domain1.org", "-output", "json"},
+			status:         api.OK(),
+			expectedStdout: `{"results":[{"status":{"code":0,"message":"OK"},"trust_domain":"domain1.org"}]}` + "\n",
+		},
+		{
+			name:           "missing trust domain",
+			expectedCode:   1,
+			expectedStderr: "Error: a trust domain name is required\n",
+		},
+		{
+			name:           "failed result",
+			args:           []string{"-trustDomain", "domain1.org"},
+			status:         api.CreateStatus(codes.NotFound, "federation relationship does not exist"),
+			expectedCode:   1,
+			expectedStderr: "Error: failed to delete federation relationship \"domain1.org\": federation relationship does not exist\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, federation.NewDeleteCommandWithEnv)
+			test.server.deleteStatus = tt.status
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+		})
+	}
+}
+
+func TestRefresh(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		serverErr      error
+		expectedCode   int
+		expectedStdout string
+		expectedStderr string
+	}{
+		{
+			name:           "success",
+			args:           []string{"-trustDomain", "domain1.org"},
+			expectedStdout: "Bundle refreshed.\n",
+		},
+		{
+			name:           "missing trust domain",
+			expectedCode:   1,
+			expectedStderr: "Error: a trust domain name is required\n",
+		},
+		{
+			name:           "server error",
+			args:           []string{"-trustDomain", "domain1.org"},
+			serverErr:      status.Error(codes.NotFound, "no relationship with trust domain"),
+			expectedCode:   1,
+			expectedStderr: "Error: failed to refresh bundle: rpc error: code = NotFound desc = no relationship with trust domain\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, federation.NewRefreshCommandWithEnv)
+			test.server.err = tt.serverErr
+
+			code := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+			require.Equal(t, tt.expectedStderr, test.stderr.String())
+			require.Equal(t, tt.expectedCode, code)
+		})
+	}
+}
+
+func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *federationTest {
+	server := &fakeTrustDomainServer{}
+
+	socketPath := spiretest.StartGRPCSocketServerOnTempSocket(t, func(s *grpc.Server) {
+		trustdomainpb.RegisterTrustDomainServer(s, server)
+	})
+
+	stdin := new(bytes.Buffer)
+	stdout := new(bytes.Buffer)
+	stderr := new(bytes.Buffer)
+
+	client := newClient(&common_cli.Env{
+		Stdin:  stdin,
+		Stdout: stdout,
+		Stderr: stderr,
+	})
+
+	test := &federationTest{
+		stdin:  stdin,
+		stdout: stdout,
+		stderr: stderr,
+		args:   []string{"-registrationUDSPath", socketPath},
+		server: server,
+		client: client,
+	}
+
+	t.Cleanup(func() {
+		test.afterTest(t)
+	})
+
+	return test
+}
+
+type fakeTrustDomainServer struct {
+	trustdomainpb.UnimplementedTrustDomainServer
+
+	relationships []*types.FederationRelationship
+	receivedFRs   []*types.FederationRelationship
+	createResult  *trustdomainpb.BatchCreateFederationRelationshipResponse_Result
+	updateResult  *trustdomainpb.BatchUpdateFederationRelationshipResponse_Result
+	deleteStatus  *types.Status
+	err           error
+}
+
+func (s *fakeTrustDomainServer) ListFederationRelationships(ctx context.Context, req *trustdomainpb.ListFederationRelationshipsRequest) (*trustdomainpb.ListFederationRelationshipsResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &trustdomainpb.ListFederationRelationshipsResponse{
+		FederationRelationships: s.relationships,
+	}, nil
+}
+
+func (s *fakeTrustDomainServer) GetFederationRelationship(ctx context.Context, req *trustdomainpb.GetFederationRelationshipRequest) (*types.FederationRelationship, error) {
+	for _, fr := range s.relationships {
+		if fr.TrustDomain == req.TrustDomain {
+			return fr, nil
+		}
+	}
+	return nil, status.Error(codes.NotFound, "federation relationship does not exist")
+}
+
+func (s *fakeTrustDomainServer) BatchCreateFederationRelationship(ctx context.Context, req *trustdomainpb.BatchCreateFederationRelationshipRequest) (*trustdomainpb.BatchCreateFederationRelationshipResponse, error) {
+	s.receivedFRs = req.FederationRelationships
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &trustdomainpb.BatchCreateFederationRelationshipResponse{
+		Results: []*trustdomainpb.BatchCreateFederationRelationshipResponse_Result{s.createResult},
+	}, nil
+}
+
+func (s *fakeTrustDomainServer) BatchUpdateFederationRelationship(ctx context.Context, req *trustdomainpb.BatchUpdateFederationRelationshipRequest) (*trustdomainpb.BatchUpdateFederationRelationshipResponse, error) {
+	s.receivedFRs = req.FederationRelationships
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &trustdomainpb.BatchUpdateFederationRelationshipResponse{
+		Results: []*trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{s.updateResult},
+	}, nil
+}
+
+func (s *fakeTrustDomainServer) BatchDeleteFederationRelationship(ctx context.Context, req *trustdomainpb.BatchDeleteFederationRelationshipRequest) (*trustdomainpb.BatchDeleteFederationRelationshipResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &trustdomainpb.BatchDeleteFederationRelationshipResponse{
+		Results: []*trustdomainpb.BatchDeleteFederationRelationshipResponse_Result{
+			{Status: s.deleteStatus, TrustDomain: req.TrustDomains[0]},
+		},
+	}, nil
+}
+
+func (s *fakeTrustDomainServer) RefreshBundle(ctx context.Context, req *trustdomainpb.RefreshBundleRequest) (*emptypb.Empty, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	return &emptypb.Empty{}, nil
+}
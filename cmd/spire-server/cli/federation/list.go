@@ -0,0 +1,74 @@
+package federation
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+)
+
+// NewListCommand creates a new "list" subcommand for "federation" command.
+func NewListCommand() cli.Command {
+	return NewListCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewListCommandWithEnv creates a new "list" subcommand for "federation"
+// command using the environment specified.
+func NewListCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(listCommand))
+}
+
+type listCommand struct{}
+
+func (*listCommand) Name() string {
+	return "federation list"
+}
+
+func (*listCommand) Synopsis() string {
+	return "Lists all federation relationships"
+}
+
+func (*listCommand) AppendFlags(fs *flag.FlagSet) {
+}
+
+func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	client := serverClient.NewTrustDomainClient()
+
+	var relationships []*types.FederationRelationship
+	pageToken := ""
+	for {
+		resp, err := client.ListFederationRelationships(ctx, &trustdomain.ListFederationRelationshipsRequest{
+			PageSize:  1000,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to list federation relationships: %w", err)
+		}
+		relationships = append(relationships, resp.FederationRelationships...)
+		if pageToken = resp.NextPageToken; pageToken == "" {
+			break
+		}
+	}
+
+	if env.JSONOutput() {
+		// Every page is printed as a single response
+		return env.PrintJSON(&trustdomain.ListFederationRelationshipsResponse{
+			FederationRelationships: relationships,
+		})
+	}
+
+	if len(relationships) == 0 {
+		return env.Println("No federation relationships found")
+	}
+
+	msg := fmt.Sprintf("Found %d federation ", len(relationships))
+	msg = util.Pluralizer(msg, "relationship", "relationships", len(relationships))
+	env.Printf(msg + ":\n\n")
+
+	return printFederationRelationships(env, relationships...)
+}
@@ -0,0 +1,61 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+)
+
+// NewRefreshCommand creates a new "refresh" subcommand for "federation" command.
+func NewRefreshCommand() cli.Command {
+	return NewRefreshCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewRefreshCommandWithEnv creates a new "refresh" subcommand for "federation"
+// command using the environment specified.
+func NewRefreshCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(refreshCommand))
+}
+
+type refreshCommand struct {
+	// Trust domain name of the federation relationship to refresh
+	trustDomain string
+}
+
+func (*refreshCommand) Name() string {
+	return "federation refresh"
+}
+
+func (*refreshCommand) Synopsis() string {
+	return "Refreshes the bundle from the specified federated trust domain"
+}
+
+func (c *refreshCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.trustDomain, "trustDomain", "", "The trust domain name of the federation relationship to refresh")
+}
+
+func (c *refreshCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.trustDomain == "" {
+		return errors.New("a trust domain name is required")
+	}
+
+	client := serverClient.NewTrustDomainClient()
+	resp, err := client.RefreshBundle(ctx, &trustdomain.RefreshBundleRequest{
+		TrustDomain: c.trustDomain,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to refresh bundle: %w", err)
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
+	return env.Println("Bundle refreshed.")
+}
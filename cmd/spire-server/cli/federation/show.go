@@ -0,0 +1,59 @@
+package federation
+
+import (
+	"context"
+	"errors"
+	"flag"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+)
+
+// NewShowCommand creates a new "show" subcommand for "federation" command.
+func NewShowCommand() cli.Command {
+	return NewShowCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewShowCommandWithEnv creates a new "show" subcommand for "federation"
+// command using the environment specified.
+func NewShowCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(showCommand))
+}
+
+type showCommand struct {
+	// Trust domain name of the federation relationship to show
+	trustDomain string
+}
+
+func (*showCommand) Name() string {
+	return "federation show"
+}
+
+func (*showCommand) Synopsis() string {
+	return "Shows a federation relationship"
+}
+
+func (c *showCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.trustDomain, "trustDomain", "", "The trust domain name of the federation relationship to show")
+}
+
+func (c *showCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.trustDomain == "" {
+		return errors.New("a trust domain name is required")
+	}
+
+	client := serverClient.NewTrustDomainClient()
+	fr, err := client.GetFederationRelationship(ctx, &trustdomain.GetFederationRelationshipRequest{
+		TrustDomain: c.trustDomain,
+	})
+	if err != nil {
+		return err
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(fr)
+	}
+	return printFederationRelationships(env, fr)
+}
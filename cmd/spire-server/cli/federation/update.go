@@ -0,0 +1,68 @@
+package federation
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"google.golang.org/grpc/codes"
+)
+
+// NewUpdateCommand creates a new "update" subcommand for "federation" command.
+func NewUpdateCommand() cli.Command {
+	return NewUpdateCommandWithEnv(common_cli.DefaultEnv)
+}
+
+// NewUpdateCommandWithEnv creates a new "update" subcommand for "federation"
+// command using the environment specified.
+func NewUpdateCommandWithEnv(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(updateCommand))
+}
+
+type updateCommand struct {
+	config relationshipConfig
+}
+
+func (*updateCommand) Name() string {
+	return "federation update"
+}
+
+func (*updateCommand) Synopsis() string {
+	return "Updates a federation relationship with a foreign trust domain"
+}
+
+func (c *updateCommand) AppendFlags(fs *flag.FlagSet) {
+	c.config.appendFlags(fs)
+}
+
+func (c *updateCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	fr, err := c.config.toProto()
+	if err != nil {
+		return err
+	}
+
+	client := serverClient.NewTrustDomainClient()
+	resp, err := client.BatchUpdateFederationRelationship(ctx, &trustdomain.BatchUpdateFederationRelationshipRequest{
+		FederationRelationships: []*types.FederationRelationship{fr},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update federation relationship: %w", err)
+	}
+
+	result := resp.Results[0]
+	switch result.Status.Code {
+	case int32(codes.OK):
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
+		env.Printf("Federation relationship updated.\n\n")
+		return printFederationRelationships(env, result.FederationRelationship)
+	default:
+		return fmt.Errorf("failed to update federation relationship with %q: %s", fr.TrustDomain, result.Status.Message)
+	}
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/spiffe/spire/cmd/spire-server/util"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
 )
 
@@ -27,9 +28,17 @@ type healthCheckCommand struct {
 	env *common_cli.Env
 
 	socketPath string
+	endpoint   string
 	timeout    common_cli.DurationFlag
 	shallow    bool
 	verbose    bool
+	output     common_cli.OutputFlag
+}
+
+// healthResult is the JSON output of the command
+type healthResult struct {
+	Healthy bool   `json:"healthy"`
+	Error   string `json:"error,omitempty"`
 }
 
 func (c *healthCheckCommand) Help() string {
@@ -46,6 +55,9 @@ func (c *healthCheckCommand) Run(args []string) int {
 	if err := c.parseFlags(args); err != nil {
 		return 1
 	}
+	if c.output == common_cli.OutputJSON {
+		return c.runJSON()
+	}
 	if err := c.run(); err != nil {
 		// Ignore error since a failure to write to stderr cannot very well be
 		// reported
@@ -62,12 +74,48 @@ func (c *healthCheckCommand) parseFlags(args []string) error {
 	fs := flag.NewFlagSet("health", flag.ContinueOnError)
 	fs.SetOutput(c.env.Stderr)
 	fs.StringVar(&c.socketPath, "registrationUDSPath", util.DefaultSocketPath, "Registration API UDS path")
+	fs.StringVar(&c.endpoint, "endpoint", "", "URL of the health check endpoint to query instead of the API (e.g. http://localhost:80/ready)")
+	fs.Var(&c.timeout, "timeout", "Time to wait for the server to respond")
 	fs.BoolVar(&c.shallow, "shallow", false, "Perform a less stringent health check")
 	fs.BoolVar(&c.verbose, "verbose", false, "Print verbose information")
+	common_cli.AddOutputFlag(fs, &c.output)
 	return fs.Parse(args)
 }
 
+// runJSON runs the health check and prints the result as JSON. Verbose
+// information is printed to stderr so stdout only holds the result.
+func (c *healthCheckCommand) runJSON() int {
+	env := c.env
+	c.env = &common_cli.Env{
+		Stdin:  env.Stdin,
+		Stdout: env.Stderr,
+		Stderr: env.Stderr,
+	}
+	err := c.run()
+	c.env = env
+
+	result := healthResult{Healthy: err == nil}
+	if err != nil {
+		result.Error = err.Error()
+	}
+	if err := c.env.PrintJSON(result); err != nil {
+		_ = c.env.ErrPrintln(err)
+		return 1
+	}
+	if !result.Healthy {
+		return 1
+	}
+	return 0
+}
+
 func (c *healthCheckCommand) run() error {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Duration(c.timeout))
+	defer cancel()
+
+	if c.endpoint != "" {
+		return c.probeEndpoint(ctx)
+	}
+
 	if c.verbose {
 		if err := c.env.Println("Fetching bundle via Bundle API..."); err != nil {
 			return err
@@ -89,7 +137,7 @@ func (c *healthCheckCommand) run() error {
 	// **could** be problematic if the Upstream CA signing process is lengthy.
 	// As currently coded however, the registration API isn't served until after
 	// the server CA has been signed by upstream.
-	if _, err := bundleClient.GetBundle(context.Background(), &bundle.GetBundleRequest{}); err != nil {
+	if _, err := bundleClient.GetBundle(ctx, &bundle.GetBundleRequest{}); err != nil {
 		if c.verbose {
 			// Ignore error since a failure to write to stderr cannot very well
 			// be reported
@@ -105,3 +153,22 @@ func (c *healthCheckCommand) run() error {
 
 	return nil
 }
+
+func (c *healthCheckCommand) probeEndpoint(ctx context.Context) error {
+	if c.verbose {
+		if err := c.env.Printf("Querying health check endpoint %s...\n", c.endpoint); err != nil {
+			return err
+		}
+	}
+
+	if err := health.Probe(ctx, c.endpoint); err != nil {
+		return err
+	}
+	if c.verbose {
+		if err := c.env.Println("Health check endpoint reported success."); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -3,6 +3,8 @@ package healthcheck
 import (
 	"bytes"
 	"context"
+	"net/http"
+	"net/http/httptest"
 	"testing"
 
 	"github.com/mitchellh/cli"
@@ -47,10 +49,16 @@ func (s *HealthCheckSuite) TestSynopsis() {
 func (s *HealthCheckSuite) TestHelp() {
 	s.Equal("", s.cmd.Help())
 	s.Equal(`Usage of health:
+  -endpoint string
+    	URL of the health check endpoint to query instead of the API (e.g. http://localhost:80/ready)
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -shallow
     	Perform a less stringent health check
+  -timeout value
+    	Time to wait for the server to respond (default 5s)
   -verbose
     	Print verbose information
 `, s.stderr.String(), "stderr")
@@ -62,10 +70,16 @@ func (s *HealthCheckSuite) TestBadFlags() {
 	s.Equal("", s.stdout.String(), "stdout")
 	s.Equal(`flag provided but not defined: -badflag
 Usage of health:
+  -endpoint string
+    	URL of the health check endpoint to query instead of the API (e.g. http://localhost:80/ready)
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -shallow
     	Perform a less stringent health check
+  -timeout value
+    	Time to wait for the server to respond (default 5s)
   -verbose
     	Print verbose information
 `, s.stderr.String(), "stderr")
@@ -111,6 +125,51 @@ Server is healthy.
 	s.Equal("", s.stderr.String(), "stderr")
 }
 
+func (s *HealthCheckSuite) TestSucceedsIfEndpointHealthy() {
+	endpoint := s.startHealthEndpoint(http.StatusOK)
+	code := s.cmd.Run([]string{"--endpoint", endpoint, "--verbose"})
+	s.Equal(0, code, "exit code")
+	s.Equal(`Querying health check endpoint `+endpoint+`...
+Health check endpoint reported success.
+Server is healthy.
+`, s.stdout.String(), "stdout")
+	s.Equal("", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestFailsIfEndpointUnhealthy() {
+	endpoint := s.startHealthEndpoint(http.StatusInternalServerError)
+	code := s.cmd.Run([]string{"--endpoint", endpoint})
+	s.NotEqual(0, code, "exit code")
+	s.Equal("", s.stdout.String(), "stdout")
+	s.Equal("Server is unhealthy: health endpoint returned \"500 Internal Server Error\"\n", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestSucceedsIfEndpointHealthyJSON() {
+	endpoint := s.startHealthEndpoint(http.StatusOK)
+	code := s.cmd.Run([]string{"--endpoint", endpoint, "--verbose", "--output", "json"})
+	s.Equal(0, code, "exit code")
+	s.Equal(`{"healthy":true}`+"\n", s.stdout.String(), "stdout")
+	s.Equal(`Querying health check endpoint `+endpoint+`...
+Health check endpoint reported success.
+`, s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) TestFailsIfEndpointUnhealthyJSON() {
+	endpoint := s.startHealthEndpoint(http.StatusInternalServerError)
+	code := s.cmd.Run([]string{"--endpoint", endpoint, "--output", "json"})
+	s.NotEqual(0, code, "exit code")
+	s.Equal(`{"healthy":false,"error":"health endpoint returned \"500 Internal Server Error\""}`+"\n", s.stdout.String(), "stdout")
+	s.Equal("", s.stderr.String(), "stderr")
+}
+
+func (s *HealthCheckSuite) startHealthEndpoint(statusCode int) string {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		w.WriteHeader(statusCode)
+	}))
+	s.T().Cleanup(server.Close)
+	return server.URL + "/ready"
+}
+
 type withBundle struct {
 	bundle.BundleServer
 }
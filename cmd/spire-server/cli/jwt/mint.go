@@ -46,6 +46,12 @@ func (c *mintCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.write, "write", "", "File to write token to instead of stdout")
 }
 
+// writtenFiles is the JSON output of the command when the JWT-SVID is
+// written to disk
+type writtenFiles struct {
+	JWTSVIDPath string `json:"jwt_svid_path"`
+}
+
 func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
 	if c.spiffeID == "" {
 		return errors.New("spiffeID must be specified")
@@ -76,6 +82,9 @@ func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 
 	// Print in stdout
 	if c.write == "" {
+		if env.JSONOutput() {
+			return env.PrintJSON(resp)
+		}
 		if err := env.Println(token); err != nil {
 			return err
 		}
@@ -87,6 +96,9 @@ func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	if err := ioutil.WriteFile(tokenPath, []byte(token), 0600); err != nil {
 		return fmt.Errorf("unable to write token: %v", err)
 	}
+	if env.JSONOutput() {
+		return env.PrintJSON(writtenFiles{JWTSVIDPath: tokenPath})
+	}
 	if err := env.Printf("JWT-SVID written to %s\n", tokenPath); err != nil {
 		return err
 	}
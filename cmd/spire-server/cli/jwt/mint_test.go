@@ -38,6 +38,8 @@ const (
 	expectedUsage = `Usage of jwt mint:
   -audience value
     	Audience claim that will be included in the SVID. Can be used more than once.
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -spiffeID string
@@ -2,6 +2,8 @@ package run
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
 	"flag"
@@ -12,6 +14,8 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"regexp"
+	"strconv"
 	"strings"
 	"time"
 
@@ -20,15 +24,25 @@ import (
 	"github.com/mitchellh/cli"
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/catalog"
 	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
+	"github.com/spiffe/spire/pkg/common/fips"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/log"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/common/profiling"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/pkg/server"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	bundleClient "github.com/spiffe/spire/pkg/server/bundle/client"
 	"github.com/spiffe/spire/pkg/server/ca"
+	server_catalog "github.com/spiffe/spire/pkg/server/catalog"
+	"github.com/spiffe/spire/pkg/server/endpoints"
 	"github.com/spiffe/spire/pkg/server/endpoints/bundle"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 )
@@ -40,6 +54,15 @@ const (
 	defaultSocketPath         = "/tmp/spire-registration.sock"
 	defaultLogLevel           = "INFO"
 	defaultBundleEndpointPort = 443
+
+	// defaultBundleEndpointACMECacheDir is the directory, relative to the
+	// data directory, where ACME account and certificate state is cached
+	// when the cache_dir configurable is unset.
+	defaultBundleEndpointACMECacheDir = "bundle-acme"
+
+	// defaultAttestationBanDuration is how long IP addresses are banned from
+	// attesting after too many failures, when attestation bans are enabled.
+	defaultAttestationBanDuration = 5 * time.Minute
 )
 
 var (
@@ -49,6 +72,10 @@ var (
 	}
 
 	defaultRateLimitAttestation = true
+
+	// serverIDRegexp matches the server ids that can be part of a key
+	// manager key id
+	serverIDRegexp = regexp.MustCompile(`^[a-zA-Z0-9_-]+$`)
 )
 
 // Config contains all available configurables, arranged by section
@@ -61,37 +88,59 @@ type Config struct {
 }
 
 type serverConfig struct {
-	BindAddress         string             `hcl:"bind_address"`
-	BindPort            int                `hcl:"bind_port"`
-	CAKeyType           string             `hcl:"ca_key_type"`
-	CASubject           *caSubjectConfig   `hcl:"ca_subject"`
-	CATTL               string             `hcl:"ca_ttl"`
-	DataDir             string             `hcl:"data_dir"`
-	Experimental        experimentalConfig `hcl:"experimental"`
-	Federation          *federationConfig  `hcl:"federation"`
-	JWTIssuer           string             `hcl:"jwt_issuer"`
-	LogFile             string             `hcl:"log_file"`
-	LogLevel            string             `hcl:"log_level"`
-	LogFormat           string             `hcl:"log_format"`
-	RateLimit           rateLimitConfig    `hcl:"ratelimit"`
-	RegistrationUDSPath string             `hcl:"registration_uds_path"`
-	DefaultSVIDTTL      string             `hcl:"default_svid_ttl"`
-	TrustDomain         string             `hcl:"trust_domain"`
+	AdditionalListeners    []listenerConfig     `hcl:"additional_listeners"`
+	AdminBindAddress       string               `hcl:"admin_bind_address"`
+	AdminBindPort          int                  `hcl:"admin_bind_port"`
+	AdminIDs               []string             `hcl:"admin_ids"`
+	AuditLog               *auditLogConfig      `hcl:"audit_log"`
+	AuthPolicy             *authPolicyConfig    `hcl:"auth_opa_policy_engine"`
+	Backdate               string               `hcl:"backdate"`
+	BindAddress            string               `hcl:"bind_address"`
+	BindPort               int                  `hcl:"bind_port"`
+	CAKeyType              string               `hcl:"ca_key_type"`
+	CASubject              *caSubjectConfig     `hcl:"ca_subject"`
+	CATTL                  string               `hcl:"ca_ttl"`
+	DataDir                string               `hcl:"data_dir"`
+	DNSNamePolicy          *dnsNamePolicyConfig `hcl:"dns_name_policy"`
+	Experimental           experimentalConfig   `hcl:"experimental"`
+	Federation             *federationConfig    `hcl:"federation"`
+	GRPC                   *grpcConfig          `hcl:"grpc"`
+	JWTIssuer              string               `hcl:"jwt_issuer"`
+	LogFile                string               `hcl:"log_file"`
+	LogLevel               string               `hcl:"log_level"`
+	LogFormat              string               `hcl:"log_format"`
+	LogRotation            *logRotationConfig   `hcl:"log_rotation"`
+	Maintenance            *maintenanceConfig   `hcl:"maintenance"`
+	MaxJWTSVIDTTL          string               `hcl:"max_jwt_svid_ttl"`
+	RateLimit              rateLimitConfig      `hcl:"ratelimit"`
+	RegistrationUDSGroup   string               `hcl:"registration_uds_group"`
+	RegistrationUDSMode    string               `hcl:"registration_uds_mode"`
+	RegistrationUDSOwner   string               `hcl:"registration_uds_owner"`
+	RegistrationUDSPath    string               `hcl:"registration_uds_path"`
+	RequireFIPS            bool                 `hcl:"require_fips"`
+	RequirePluginChecksums bool                 `hcl:"require_plugin_checksums"`
+	DefaultSVIDTTL         string               `hcl:"default_svid_ttl"`
+	ServerID               string               `hcl:"server_id"`
+	SubsystemLogLevels     map[string]string    `hcl:"subsystem_log_levels"`
+	TLS                    *listenerTLSConfig   `hcl:"tls"`
+	TrustDomain            string               `hcl:"trust_domain"`
 
 	ConfigPath string
 	ExpandEnv  bool
 
-	// Undocumented configurables
+	// Profiling configurables
 	ProfilingEnabled bool     `hcl:"profiling_enabled"`
 	ProfilingPort    int      `hcl:"profiling_port"`
 	ProfilingFreq    int      `hcl:"profiling_freq"`
 	ProfilingNames   []string `hcl:"profiling_names"`
+	ProfilingDir     string   `hcl:"profiling_dir"`
 
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
 
 type experimentalConfig struct {
-	AllowAgentlessNodeAttestors bool `hcl:"allow_agentless_node_attestors"`
+	AllowAgentlessNodeAttestors bool     `hcl:"allow_agentless_node_attestors"`
+	FeatureFlags                []string `hcl:"feature_flags"`
 
 	DeprecatedBundleEndpointEnabled bool                                     `hcl:"bundle_endpoint_enabled"`
 	DeprecatedBundleEndpointAddress string                                   `hcl:"bundle_endpoint_address"`
@@ -116,13 +165,48 @@ type federationConfig struct {
 }
 
 type bundleEndpointConfig struct {
-	Address    string                    `hcl:"address"`
-	Port       int                       `hcl:"port"`
-	ACME       *bundleEndpointACMEConfig `hcl:"acme"`
-	UnusedKeys []string                  `hcl:",unusedKeys"`
+	Address             string                         `hcl:"address"`
+	Port                int                            `hcl:"port"`
+	ACME                *bundleEndpointACMEConfig      `hcl:"acme"`
+	AdditionalListeners []bundleEndpointListenerConfig `hcl:"additional_listeners"`
+	AdvertisedAddress   string                         `hcl:"advertised_address"`
+	AdvertisedPort      int                            `hcl:"advertised_port"`
+	ProxyProtocol       bool                           `hcl:"proxy_protocol"`
+	RefreshHint         string                         `hcl:"refresh_hint"`
+	TLS                 *bundleEndpointTLSConfig       `hcl:"tls"`
+	TrustedProxies      []string                       `hcl:"trusted_proxies"`
+	UnusedKeys          []string                       `hcl:",unusedKeys"`
+}
+
+type bundleEndpointListenerConfig struct {
+	Address    string                   `hcl:"address"`
+	Port       int                      `hcl:"port"`
+	TLS        *bundleEndpointTLSConfig `hcl:"tls"`
+	UnusedKeys []string                 `hcl:",unusedKeys"`
+}
+
+type listenerConfig struct {
+	Address    string             `hcl:"address"`
+	Port       int                `hcl:"port"`
+	TLS        *listenerTLSConfig `hcl:"tls"`
+	UnusedKeys []string           `hcl:",unusedKeys"`
+}
+
+type listenerTLSConfig struct {
+	MinVersion   string   `hcl:"min_version"`
+	CipherSuites []string `hcl:"cipher_suites"`
+	UnusedKeys   []string `hcl:",unusedKeys"`
+}
+
+type bundleEndpointTLSConfig struct {
+	MinVersion   string   `hcl:"min_version"`
+	CipherSuites []string `hcl:"cipher_suites"`
+	ClientAuth   string   `hcl:"client_auth"`
+	UnusedKeys   []string `hcl:",unusedKeys"`
 }
 
 type bundleEndpointACMEConfig struct {
+	CacheDir     string   `hcl:"cache_dir"`
 	DirectoryURL string   `hcl:"directory_url"`
 	DomainName   string   `hcl:"domain_name"`
 	Email        string   `hcl:"email"`
@@ -144,24 +228,88 @@ type federatesWithConfig struct {
 }
 
 type federatesWithBundleEndpointConfig struct {
-	Address    string   `hcl:"address"`
-	Port       int      `hcl:"port"`
-	SpiffeID   string   `hcl:"spiffe_id"`
-	UseWebPKI  bool     `hcl:"use_web_pki"`
+	Address             string   `hcl:"address"`
+	Port                int      `hcl:"port"`
+	SpiffeID            string   `hcl:"spiffe_id"`
+	UseWebPKI           bool     `hcl:"use_web_pki"`
+	BootstrapBundlePath string   `hcl:"bootstrap_bundle_path"`
+	WebPKIRootCAsPath   string   `hcl:"web_pki_root_cas_path"`
+	PinWebPKIRootCAs    bool     `hcl:"pin_web_pki_root_cas"`
+	UnusedKeys          []string `hcl:",unusedKeys"`
+}
+
+type auditLogConfig struct {
+	Destinations []string `hcl:"destinations"`
+	LogFormat    string   `hcl:"log_format"`
+	UnusedKeys   []string `hcl:",unusedKeys"`
+}
+
+type dnsNamePolicyConfig struct {
+	AllowedDomains  []string `hcl:"allowed_domains"`
+	AllowedPatterns []string `hcl:"allowed_patterns"`
+	UnusedKeys      []string `hcl:",unusedKeys"`
+}
+
+type maintenanceConfig struct {
+	PruneInterval          string   `hcl:"prune_interval"`
+	OrphanedEntryRetention string   `hcl:"orphaned_entry_retention"`
+	UnusedKeys             []string `hcl:",unusedKeys"`
+}
+
+type logRotationConfig struct {
+	MaxSizeMB  int      `hcl:"max_size_mb"`
+	MaxAgeDays int      `hcl:"max_age_days"`
+	MaxBackups int      `hcl:"max_backups"`
+	Compress   bool     `hcl:"compress"`
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
 
+type grpcConfig struct {
+	KeepaliveMinTime             string   `hcl:"keepalive_min_time"`
+	KeepaliveTime                string   `hcl:"keepalive_time"`
+	KeepaliveTimeout             string   `hcl:"keepalive_timeout"`
+	MaxConcurrentStreams         int      `hcl:"max_concurrent_streams"`
+	MaxConnectionAge             string   `hcl:"max_connection_age"`
+	PermitKeepaliveWithoutStream bool     `hcl:"permit_keepalive_without_stream"`
+	ReflectionEnabled            bool     `hcl:"reflection_enabled"`
+	UnusedKeys                   []string `hcl:",unusedKeys"`
+}
+
 type rateLimitConfig struct {
-	Attestation *bool    `hcl:"attestation"`
-	UnusedKeys  []string `hcl:",unusedKeys"`
+	Attestation             *bool    `hcl:"attestation"`
+	AttestationLimit        int      `hcl:"attestation_limit"`
+	AttestationPerTypeLimit int      `hcl:"attestation_per_type_limit"`
+	AttestationBanThreshold int      `hcl:"attestation_ban_threshold"`
+	AttestationBanDuration  string   `hcl:"attestation_ban_duration"`
+	CSRLimit                int      `hcl:"csr_limit"`
+	CSRPerCallerLimit       int      `hcl:"csr_per_caller_limit"`
+	JWTLimit                int      `hcl:"jwt_limit"`
+	JWTPerCallerLimit       int      `hcl:"jwt_per_caller_limit"`
+	UnusedKeys              []string `hcl:",unusedKeys"`
+}
+
+type authPolicyConfig struct {
+	Local      *localAuthPolicyConfig `hcl:"local"`
+	UnusedKeys []string               `hcl:",unusedKeys"`
 }
 
-func NewRunCommand(logOptions []log.Option, allowUnknownConfig bool) cli.Command {
-	return newRunCommand(common_cli.DefaultEnv, logOptions, allowUnknownConfig)
+type localAuthPolicyConfig struct {
+	RegoPath       string   `hcl:"rego_path"`
+	PolicyDataPath string   `hcl:"policy_data_path"`
+	UnusedKeys     []string `hcl:",unusedKeys"`
+}
+
+// NewRunCommand returns the run command. It stops when the process is
+// signaled or the given context is canceled.
+func NewRunCommand(ctx context.Context, logOptions []log.Option, allowUnknownConfig bool) cli.Command {
+	cmd := newRunCommand(common_cli.DefaultEnv, logOptions, allowUnknownConfig)
+	cmd.ctx = ctx
+	return cmd
 }
 
 func newRunCommand(env *common_cli.Env, logOptions []log.Option, allowUnknownConfig bool) *Command {
 	return &Command{
+		ctx:                context.Background(),
 		env:                env,
 		logOptions:         logOptions,
 		allowUnknownConfig: allowUnknownConfig,
@@ -170,6 +318,7 @@ func newRunCommand(env *common_cli.Env, logOptions []log.Option, allowUnknownCon
 
 // Run Command struct
 type Command struct {
+	ctx                context.Context
 	logOptions         []log.Option
 	env                *common_cli.Env
 	allowUnknownConfig bool
@@ -189,6 +338,34 @@ func Help(name string, writer io.Writer) string {
 }
 
 func LoadConfig(name string, args []string, logOptions []log.Option, output io.Writer, allowUnknownConfig bool) (*server.Config, error) {
+	input, err := loadInput(name, args, output)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewServerConfig(input, logOptions, allowUnknownConfig)
+}
+
+// ValidateConfig loads the configuration in the same way as LoadConfig and
+// validates it, including the plugin configuration, without starting the
+// server or loading any plugin. All of the problems found are returned.
+func ValidateConfig(name string, args []string, output io.Writer) []error {
+	input, err := loadInput(name, args, output)
+	if err != nil {
+		return []error{err}
+	}
+
+	var errs []error
+	if _, err := NewServerConfig(input, nil, false); err != nil {
+		errs = append(errs, err)
+	}
+	if input.Plugins != nil {
+		errs = append(errs, server_catalog.Validate(*input.Plugins)...)
+	}
+	return errs
+}
+
+func loadInput(name string, args []string, output io.Writer) (*Config, error) {
 	// First parse the CLI flags so we can get the config
 	// file path, if set
 	cliInput, err := parseFlags(name, args, output)
@@ -203,12 +380,7 @@ func LoadConfig(name string, args []string, logOptions []log.Option, output io.W
 		return nil, err
 	}
 
-	input, err := mergeInput(fileInput, cliInput)
-	if err != nil {
-		return nil, err
-	}
-
-	return NewServerConfig(input, logOptions, allowUnknownConfig)
+	return mergeInput(fileInput, cliInput)
 }
 
 // Run the SPIFFE Server
@@ -222,11 +394,27 @@ func (cmd *Command) Run(args []string) int {
 	// Set umask before starting up the server
 	common_cli.SetUmask(c.Log)
 
+	if err := fflag.Load(c.Experimental.FeatureFlags); err != nil {
+		c.Log.WithError(err).Error("Failed to load feature flags")
+		return 1
+	}
+	if enabled := fflag.Enabled(); len(enabled) > 0 {
+		c.Log.WithField(telemetry.FeatureFlags, enabled).Warn("Experimental features are enabled")
+	}
+
 	s := server.New(*c)
 
-	ctx, cancel := context.WithCancel(context.Background())
+	ctx, cancel := context.WithCancel(cmd.ctx)
 	defer cancel()
 	util.SignalListener(ctx, cancel)
+	util.ReloadListener(ctx, func() {
+		c.Log.Info("Reloading configuration")
+		if err := cmd.reload(ctx, args, c, s); err != nil {
+			c.Log.WithError(err).Error("Failed to reload configuration")
+			return
+		}
+		c.Log.Info("Configuration reloaded")
+	})
 
 	err = s.Run(ctx)
 	if err != nil {
@@ -238,6 +426,34 @@ func (cmd *Command) Run(args []string) int {
 	return 0
 }
 
+// reload loads the configuration again and applies the settings that can be
+// changed while the server is running. The loggers of the running server are
+// kept; only their levels are changed.
+func (cmd *Command) reload(ctx context.Context, args []string, current *server.Config, s *server.Server) error {
+	input, err := loadInput(commandName, args, cmd.env.Stderr)
+	if err != nil {
+		return err
+	}
+
+	c, err := NewServerConfig(input, cmd.logOptions, cmd.allowUnknownConfig)
+	if err != nil {
+		return err
+	}
+	for _, logger := range []logrus.FieldLogger{c.Log, c.AuditLog} {
+		if closer, ok := logger.(io.Closer); ok {
+			_ = closer.Close()
+		}
+	}
+
+	if logger, ok := current.Log.(*log.Logger); ok {
+		if err := logger.SetLevels(input.Server.LogLevel, input.Server.SubsystemLogLevels); err != nil {
+			return err
+		}
+	}
+
+	return s.Reload(ctx, *c)
+}
+
 //Synopsis of the command
 func (*Command) Synopsis() string {
 	return "Runs the server"
@@ -341,10 +557,61 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 		Port: c.Server.BindPort,
 	}
 
+	if c.Server.TLS != nil {
+		tlsPolicy, err := listenerTLSPolicyFromConfig("server", c.Server.TLS)
+		if err != nil {
+			return nil, err
+		}
+		sc.TLSPolicy = tlsPolicy
+	}
+
+	for _, listener := range c.Server.AdditionalListeners {
+		addr, err := listenerAddressFromConfig(listener.Address, listener.Port, c.Server.BindPort)
+		if err != nil {
+			return nil, err
+		}
+		tcpListener := endpoints.TCPListener{Address: addr, TLSPolicy: sc.TLSPolicy}
+		if listener.TLS != nil {
+			tcpListener.TLSPolicy, err = listenerTLSPolicyFromConfig(fmt.Sprintf("additional listener %q", addr), listener.TLS)
+			if err != nil {
+				return nil, err
+			}
+		}
+		sc.AdditionalBindAddresses = append(sc.AdditionalBindAddresses, tcpListener)
+	}
+
+	if c.Server.AdminBindPort != 0 {
+		adminBindAddress := c.Server.AdminBindAddress
+		if adminBindAddress == "" {
+			adminBindAddress = c.Server.BindAddress
+		}
+		ip := net.ParseIP(adminBindAddress)
+		if ip == nil {
+			return nil, fmt.Errorf("could not parse admin_bind_address %q", adminBindAddress)
+		}
+		sc.AdminBindAddress = &net.TCPAddr{
+			IP:   ip,
+			Port: c.Server.AdminBindPort,
+		}
+	}
+
+	if c.Server.GRPC != nil {
+		grpcConfig, err := grpcConfigFromConfig(c.Server.GRPC)
+		if err != nil {
+			return nil, err
+		}
+		sc.GRPC = grpcConfig
+	}
+
 	sc.BindUDSAddress = &net.UnixAddr{
 		Name: c.Server.RegistrationUDSPath,
 		Net:  "unix",
 	}
+	udsPermissions, err := diskutil.NewSocketPermissions(c.Server.RegistrationUDSMode, c.Server.RegistrationUDSOwner, c.Server.RegistrationUDSGroup, endpoints.DefaultUDSMode)
+	if err != nil {
+		return nil, fmt.Errorf("could not configure registration UDS permissions: %w", err)
+	}
+	sc.BindUDSPermissions = udsPermissions
 
 	sc.DataDir = c.Server.DataDir
 
@@ -354,10 +621,20 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 	}
 	sc.TrustDomain = trustDomain
 
+	for _, adminID := range c.Server.AdminIDs {
+		id, err := spiffeid.FromString(adminID)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse admin ID %q: %v", adminID, err)
+		}
+		sc.AdminIDs = append(sc.AdminIDs, id)
+	}
+
 	logOptions = append(logOptions,
 		log.WithLevel(c.Server.LogLevel),
+		log.WithSubsystemLevels(c.Server.SubsystemLogLevels),
 		log.WithFormat(c.Server.LogFormat),
-		log.WithOutputFile(c.Server.LogFile))
+		logOutputOption(c.Server.LogFile, c.Server.LogRotation),
+		log.WithFields(logrus.Fields{telemetry.TrustDomain: trustDomain.String()}))
 
 	logger, err := log.NewLogger(logOptions...)
 	if err != nil {
@@ -365,12 +642,77 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 	}
 	sc.Log = logger
 
+	if c.Server.AuditLog != nil {
+		auditLogger, err := newAuditLogger(c.Server.AuditLog)
+		if err != nil {
+			return nil, err
+		}
+		sc.AuditLog = auditLogger
+	}
+
+	if p := c.Server.DNSNamePolicy; p != nil {
+		sc.DNSNamePolicy, err = api.NewDNSNamePolicy(p.AllowedDomains, p.AllowedPatterns)
+		if err != nil {
+			return nil, fmt.Errorf("invalid dns_name_policy: %w", err)
+		}
+	}
+
 	if c.Server.RateLimit.Attestation == nil {
 		c.Server.RateLimit.Attestation = &defaultRateLimitAttestation
 	}
 	sc.RateLimit.Attestation = *c.Server.RateLimit.Attestation
 
+	for _, limit := range []struct {
+		name  string
+		value int
+	}{
+		{name: "attestation_limit", value: c.Server.RateLimit.AttestationLimit},
+		{name: "attestation_per_type_limit", value: c.Server.RateLimit.AttestationPerTypeLimit},
+		{name: "attestation_ban_threshold", value: c.Server.RateLimit.AttestationBanThreshold},
+		{name: "csr_limit", value: c.Server.RateLimit.CSRLimit},
+		{name: "csr_per_caller_limit", value: c.Server.RateLimit.CSRPerCallerLimit},
+		{name: "jwt_limit", value: c.Server.RateLimit.JWTLimit},
+		{name: "jwt_per_caller_limit", value: c.Server.RateLimit.JWTPerCallerLimit},
+	} {
+		if limit.value < 0 {
+			return nil, fmt.Errorf("ratelimit %s must not be negative", limit.name)
+		}
+	}
+	sc.RateLimit.AttestationLimit = c.Server.RateLimit.AttestationLimit
+	sc.RateLimit.AttestationPerTypeLimit = c.Server.RateLimit.AttestationPerTypeLimit
+	sc.RateLimit.AttestationBanThreshold = c.Server.RateLimit.AttestationBanThreshold
+	sc.RateLimit.AttestationBanDuration = defaultAttestationBanDuration
+	if banDuration := c.Server.RateLimit.AttestationBanDuration; banDuration != "" {
+		sc.RateLimit.AttestationBanDuration, err = time.ParseDuration(banDuration)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse ratelimit attestation_ban_duration %q: %v", banDuration, err)
+		}
+		if sc.RateLimit.AttestationBanDuration <= 0 {
+			return nil, fmt.Errorf("ratelimit attestation_ban_duration %q must be positive", banDuration)
+		}
+	}
+	sc.RateLimit.CSRLimit = c.Server.RateLimit.CSRLimit
+	sc.RateLimit.CSRPerCallerLimit = c.Server.RateLimit.CSRPerCallerLimit
+	sc.RateLimit.JWTLimit = c.Server.RateLimit.JWTLimit
+	sc.RateLimit.JWTPerCallerLimit = c.Server.RateLimit.JWTPerCallerLimit
+
+	if ap := c.Server.AuthPolicy; ap != nil && ap.Local != nil {
+		if ap.Local.RegoPath == "" {
+			return nil, errors.New("auth_opa_policy_engine.local.rego_path must be set")
+		}
+		sc.AuthOpaPolicyEngineConfig = &authpolicy.OpaEngineConfig{
+			Local: &authpolicy.LocalOpaProviderConfig{
+				RegoPath:       ap.Local.RegoPath,
+				PolicyDataPath: ap.Local.PolicyDataPath,
+			},
+		}
+	}
+
 	sc.Experimental.AllowAgentlessNodeAttestors = c.Server.Experimental.AllowAgentlessNodeAttestors
+	if err := fflag.Validate(c.Server.Experimental.FeatureFlags); err != nil {
+		return nil, err
+	}
+	sc.Experimental.FeatureFlags = c.Server.Experimental.FeatureFlags
 	if c.Server.Federation != nil {
 		if c.Server.Federation.BundleEndpoint != nil {
 			sc.Federation.BundleEndpoint = &bundle.EndpointConfig{
@@ -380,11 +722,78 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 				},
 			}
 
+			if refreshHint := c.Server.Federation.BundleEndpoint.RefreshHint; refreshHint != "" {
+				value, err := time.ParseDuration(refreshHint)
+				if err != nil {
+					return nil, fmt.Errorf("could not parse bundle endpoint refresh hint %q: %v", refreshHint, err)
+				}
+				if value < bundleutil.MinimumRefreshHint {
+					return nil, fmt.Errorf("bundle endpoint refresh hint must be at least %s", bundleutil.MinimumRefreshHint)
+				}
+				sc.Federation.BundleEndpoint.RefreshHint = value
+			}
+
+			if tlsConfig := c.Server.Federation.BundleEndpoint.TLS; tlsConfig != nil {
+				tlsPolicy, err := bundleEndpointTLSPolicyFromConfig(tlsConfig)
+				if err != nil {
+					return nil, err
+				}
+				sc.Federation.BundleEndpoint.TLSPolicy = tlsPolicy
+			} else {
+				// Without its own policy, the bundle endpoint follows the
+				// server-wide TLS policy.
+				sc.Federation.BundleEndpoint.TLSPolicy = bundle.TLSPolicy{
+					MinVersion:   sc.TLSPolicy.MinVersion,
+					CipherSuites: sc.TLSPolicy.CipherSuites,
+				}
+			}
+
+			for _, listener := range c.Server.Federation.BundleEndpoint.AdditionalListeners {
+				addr, err := listenerAddressFromConfig(listener.Address, listener.Port, c.Server.Federation.BundleEndpoint.Port)
+				if err != nil {
+					return nil, fmt.Errorf("invalid bundle endpoint listener: %w", err)
+				}
+				listenerConfig := bundle.ListenerConfig{Address: addr, TLSPolicy: sc.Federation.BundleEndpoint.TLSPolicy}
+				if listener.TLS != nil {
+					listenerConfig.TLSPolicy, err = bundleEndpointTLSPolicyFromConfig(listener.TLS)
+					if err != nil {
+						return nil, err
+					}
+				}
+				sc.Federation.BundleEndpoint.AdditionalListeners = append(sc.Federation.BundleEndpoint.AdditionalListeners, listenerConfig)
+			}
+
+			if advertisedAddress := c.Server.Federation.BundleEndpoint.AdvertisedAddress; advertisedAddress != "" {
+				port := c.Server.Federation.BundleEndpoint.AdvertisedPort
+				if port == 0 {
+					port = c.Server.Federation.BundleEndpoint.Port
+				}
+				sc.Federation.BundleEndpoint.AdvertisedAddress = net.JoinHostPort(advertisedAddress, strconv.Itoa(port))
+			} else if c.Server.Federation.BundleEndpoint.AdvertisedPort != 0 {
+				return nil, errors.New("bundle endpoint advertised_port requires advertised_address")
+			}
+
+			for _, trustedProxy := range c.Server.Federation.BundleEndpoint.TrustedProxies {
+				network, err := trustedProxyFromConfig(trustedProxy)
+				if err != nil {
+					return nil, err
+				}
+				sc.Federation.BundleEndpoint.TrustedProxies = append(sc.Federation.BundleEndpoint.TrustedProxies, network)
+			}
+			if c.Server.Federation.BundleEndpoint.ProxyProtocol && len(sc.Federation.BundleEndpoint.TrustedProxies) == 0 {
+				return nil, errors.New("bundle endpoint proxy_protocol requires trusted_proxies")
+			}
+			sc.Federation.BundleEndpoint.ProxyProtocol = c.Server.Federation.BundleEndpoint.ProxyProtocol
+
 			if acme := c.Server.Federation.BundleEndpoint.ACME; acme != nil {
+				cacheDir := acme.CacheDir
+				if cacheDir == "" {
+					cacheDir = filepath.Join(sc.DataDir, defaultBundleEndpointACMECacheDir)
+				}
 				sc.Federation.BundleEndpoint.ACME = &bundle.ACMEConfig{
 					DirectoryURL: acme.DirectoryURL,
 					DomainName:   acme.DomainName,
-					CacheDir:     filepath.Join(sc.DataDir, "bundle-acme"),
+					CacheDir:     cacheDir,
 					Email:        acme.Email,
 					ToSAccepted:  acme.ToSAccepted,
 				}
@@ -400,19 +809,43 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 			if config.BundleEndpoint.UseWebPKI && config.BundleEndpoint.SpiffeID != "" {
 				return nil, errors.New("usage of `bundle_endpoint.spiffe_id` is not allowed when authenticating with Web PKI")
 			}
+			if config.BundleEndpoint.UseWebPKI && config.BundleEndpoint.BootstrapBundlePath != "" {
+				return nil, errors.New("usage of `bundle_endpoint.bootstrap_bundle_path` is not allowed when authenticating with Web PKI")
+			}
+			if !config.BundleEndpoint.UseWebPKI && (config.BundleEndpoint.WebPKIRootCAsPath != "" || config.BundleEndpoint.PinWebPKIRootCAs) {
+				return nil, errors.New("usage of `bundle_endpoint.web_pki_root_cas_path` and `bundle_endpoint.pin_web_pki_root_cas` is only allowed when authenticating with Web PKI")
+			}
+			if config.BundleEndpoint.PinWebPKIRootCAs && config.BundleEndpoint.WebPKIRootCAsPath == "" {
+				return nil, errors.New("usage of `bundle_endpoint.pin_web_pki_root_cas` requires `bundle_endpoint.web_pki_root_cas_path`")
+			}
+			var webPKIRootCAs []*x509.Certificate
+			if config.BundleEndpoint.WebPKIRootCAsPath != "" {
+				var err error
+				webPKIRootCAs, err = pemutil.LoadCertificates(config.BundleEndpoint.WebPKIRootCAsPath)
+				if err != nil {
+					return nil, fmt.Errorf("could not load web PKI root CAs for trust domain %q: %v", trustDomain, err)
+				}
+			}
 			federatesWith[trustDomain] = bundleClient.TrustDomainConfig{
-				EndpointAddress:  fmt.Sprintf("%s:%d", config.BundleEndpoint.Address, port),
-				EndpointSpiffeID: config.BundleEndpoint.SpiffeID,
-				UseWebPKI:        config.BundleEndpoint.UseWebPKI,
+				EndpointURL:         fmt.Sprintf("https://%s:%d", config.BundleEndpoint.Address, port),
+				EndpointSpiffeID:    config.BundleEndpoint.SpiffeID,
+				UseWebPKI:           config.BundleEndpoint.UseWebPKI,
+				BootstrapBundlePath: config.BundleEndpoint.BootstrapBundlePath,
+				WebPKIRootCAs:       webPKIRootCAs,
+				PinWebPKIRootCAs:    config.BundleEndpoint.PinWebPKIRootCAs,
 			}
 		}
 		sc.Federation.FederatesWith = federatesWith
 	}
 
+	if err := profiling.ValidateProfiles(c.Server.ProfilingNames); err != nil {
+		return nil, fmt.Errorf("invalid profiling_names: %v", err)
+	}
 	sc.ProfilingEnabled = c.Server.ProfilingEnabled
 	sc.ProfilingPort = c.Server.ProfilingPort
 	sc.ProfilingFreq = c.Server.ProfilingFreq
 	sc.ProfilingNames = c.Server.ProfilingNames
+	sc.ProfilingDir = c.Server.ProfilingDir
 
 	if c.Server.DefaultSVIDTTL != "" {
 		ttl, err := time.ParseDuration(c.Server.DefaultSVIDTTL)
@@ -430,6 +863,51 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 		sc.CATTL = ttl
 	}
 
+	if c.Server.Backdate != "" {
+		backdate, err := time.ParseDuration(c.Server.Backdate)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse backdate %q: %v", c.Server.Backdate, err)
+		}
+		if backdate < 0 {
+			return nil, fmt.Errorf("backdate %q cannot be negative", c.Server.Backdate)
+		}
+		sc.Backdate = backdate
+	}
+
+	if c.Server.MaxJWTSVIDTTL != "" {
+		ttl, err := time.ParseDuration(c.Server.MaxJWTSVIDTTL)
+		if err != nil {
+			return nil, fmt.Errorf("could not parse max JWT-SVID ttl %q: %v", c.Server.MaxJWTSVIDTTL, err)
+		}
+		if ttl <= 0 {
+			return nil, fmt.Errorf("max JWT-SVID ttl %q must be positive", c.Server.MaxJWTSVIDTTL)
+		}
+		sc.MaxJWTSVIDTTL = ttl
+	}
+
+	if mc := c.Server.Maintenance; mc != nil {
+		if mc.PruneInterval != "" {
+			interval, err := time.ParseDuration(mc.PruneInterval)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse maintenance prune_interval %q: %v", mc.PruneInterval, err)
+			}
+			if interval <= 0 {
+				return nil, fmt.Errorf("maintenance prune_interval %q must be positive", mc.PruneInterval)
+			}
+			sc.PruneInterval = interval
+		}
+		if mc.OrphanedEntryRetention != "" {
+			retention, err := time.ParseDuration(mc.OrphanedEntryRetention)
+			if err != nil {
+				return nil, fmt.Errorf("could not parse maintenance orphaned_entry_retention %q: %v", mc.OrphanedEntryRetention, err)
+			}
+			if retention < 0 {
+				return nil, fmt.Errorf("maintenance orphaned_entry_retention %q cannot be negative", mc.OrphanedEntryRetention)
+			}
+			sc.OrphanedEntryRetention = retention
+		}
+	}
+
 	if !hasExpectedTTLs(sc.CATTL, sc.SVIDTTL) {
 		sc.Log.Warnf("The configured SVID TTL cannot be guaranteed in all cases - SVIDs with shorter TTLs may be issued if the signing key is expiring soon. Set a CA TTL of at least 6x or reduce SVID TTL below 6x to avoid issuing SVIDs with a smaller TTL than specified")
 	}
@@ -441,6 +919,11 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 		}
 	}
 
+	if c.Server.ServerID != "" && !serverIDRegexp.MatchString(c.Server.ServerID) {
+		return nil, fmt.Errorf("invalid server_id %q: only letters, digits, '-' and '_' are allowed", c.Server.ServerID)
+	}
+	sc.ServerID = c.Server.ServerID
+
 	sc.JWTIssuer = c.Server.JWTIssuer
 
 	if subject := c.Server.CASubject; subject != nil {
@@ -459,6 +942,8 @@ func NewServerConfig(c *Config, logOptions []log.Option, allowUnknownConfig bool
 	}
 
 	sc.PluginConfigs = *c.Plugins
+	sc.RequirePluginChecksums = c.Server.RequirePluginChecksums
+	sc.RequireFIPS = c.Server.RequireFIPS
 	sc.Telemetry = c.Telemetry
 	sc.HealthChecks = c.HealthChecks
 
@@ -620,6 +1105,48 @@ func checkForUnknownConfig(c *Config, l logrus.FieldLogger) (err error) {
 			detectedUnknown("ratelimit", rl.UnusedKeys)
 		}
 
+		if ap := c.Server.AuthPolicy; ap != nil {
+			if len(ap.UnusedKeys) != 0 {
+				detectedUnknown("auth_opa_policy_engine", ap.UnusedKeys)
+			}
+			if ap.Local != nil && len(ap.Local.UnusedKeys) != 0 {
+				detectedUnknown("auth_opa_policy_engine.local", ap.Local.UnusedKeys)
+			}
+		}
+
+		for _, l := range c.Server.AdditionalListeners {
+			if len(l.UnusedKeys) != 0 {
+				detectedUnknown("additional listener", l.UnusedKeys)
+			}
+			if l.TLS != nil && len(l.TLS.UnusedKeys) != 0 {
+				detectedUnknown("additional listener TLS", l.TLS.UnusedKeys)
+			}
+		}
+
+		if t := c.Server.TLS; t != nil && len(t.UnusedKeys) != 0 {
+			detectedUnknown("tls", t.UnusedKeys)
+		}
+
+		if g := c.Server.GRPC; g != nil && len(g.UnusedKeys) != 0 {
+			detectedUnknown("grpc", g.UnusedKeys)
+		}
+
+		if al := c.Server.AuditLog; al != nil && len(al.UnusedKeys) != 0 {
+			detectedUnknown("audit_log", al.UnusedKeys)
+		}
+
+		if p := c.Server.DNSNamePolicy; p != nil && len(p.UnusedKeys) != 0 {
+			detectedUnknown("dns_name_policy", p.UnusedKeys)
+		}
+
+		if lr := c.Server.LogRotation; lr != nil && len(lr.UnusedKeys) != 0 {
+			detectedUnknown("log_rotation", lr.UnusedKeys)
+		}
+
+		if mc := c.Server.Maintenance; mc != nil && len(mc.UnusedKeys) != 0 {
+			detectedUnknown("maintenance", mc.UnusedKeys)
+		}
+
 		// TODO: Re-enable unused key detection for experimental config. See
 		// https://github.com/spiffe/spire/issues/1101 for more information
 		//
@@ -643,6 +1170,19 @@ func checkForUnknownConfig(c *Config, l logrus.FieldLogger) (err error) {
 				if bea := c.Server.Federation.BundleEndpoint.ACME; bea != nil && len(bea.UnusedKeys) != 0 {
 					detectedUnknown("bundle endpoint ACME", bea.UnusedKeys)
 				}
+
+				if bet := c.Server.Federation.BundleEndpoint.TLS; bet != nil && len(bet.UnusedKeys) != 0 {
+					detectedUnknown("bundle endpoint TLS", bet.UnusedKeys)
+				}
+
+				for _, l := range c.Server.Federation.BundleEndpoint.AdditionalListeners {
+					if len(l.UnusedKeys) != 0 {
+						detectedUnknown("bundle endpoint additional listener", l.UnusedKeys)
+					}
+					if l.TLS != nil && len(l.TLS.UnusedKeys) != 0 {
+						detectedUnknown("bundle endpoint additional listener TLS", l.TLS.UnusedKeys)
+					}
+				}
 			}
 
 			for k, v := range c.Server.Federation.FederatesWith {
@@ -686,6 +1226,10 @@ func checkForUnknownConfig(c *Config, l logrus.FieldLogger) (err error) {
 		detectedUnknown("InMem", p.UnusedKeys)
 	}
 
+	if p := c.Telemetry.Tracing; p != nil && len(p.UnusedKeys) != 0 {
+		detectedUnknown("Tracing", p.UnusedKeys)
+	}
+
 	if len(c.HealthChecks.UnusedKeys) != 0 {
 		detectedUnknown("health check", c.HealthChecks.UnusedKeys)
 	}
@@ -721,6 +1265,151 @@ func caKeyTypeFromString(s string) (keymanager.KeyType, error) {
 	}
 }
 
+func bundleEndpointTLSPolicyFromConfig(c *bundleEndpointTLSConfig) (bundle.TLSPolicy, error) {
+	var policy bundle.TLSPolicy
+
+	var err error
+	policy.MinVersion, policy.CipherSuites, err = tlsVersionAndCipherSuitesFromConfig("bundle endpoint", c.MinVersion, c.CipherSuites)
+	if err != nil {
+		return bundle.TLSPolicy{}, err
+	}
+
+	switch strings.ToLower(c.ClientAuth) {
+	case "", "none":
+		policy.ClientAuth = tls.NoClientCert
+	case "request":
+		policy.ClientAuth = tls.RequestClientCert
+	case "require":
+		policy.ClientAuth = tls.RequireAnyClientCert
+	case "verify_if_given":
+		policy.ClientAuth = tls.VerifyClientCertIfGiven
+	case "require_and_verify":
+		policy.ClientAuth = tls.RequireAndVerifyClientCert
+	default:
+		return bundle.TLSPolicy{}, fmt.Errorf("bundle endpoint TLS client auth %q is unknown; must be one of [none, request, require, verify_if_given, require_and_verify]", c.ClientAuth)
+	}
+
+	return policy, nil
+}
+
+func listenerTLSPolicyFromConfig(name string, c *listenerTLSConfig) (endpoints.TLSPolicy, error) {
+	minVersion, cipherSuites, err := tlsVersionAndCipherSuitesFromConfig(name, c.MinVersion, c.CipherSuites)
+	if err != nil {
+		return endpoints.TLSPolicy{}, err
+	}
+	return endpoints.TLSPolicy{
+		MinVersion:   minVersion,
+		CipherSuites: cipherSuites,
+	}, nil
+}
+
+// tlsVersionAndCipherSuitesFromConfig parses the minimum TLS version and the
+// cipher suites of a TLS listener. The name of the listener is used to
+// qualify error messages.
+func tlsVersionAndCipherSuitesFromConfig(name, minVersion string, cipherSuiteNames []string) (uint16, []uint16, error) {
+	var version uint16
+	switch minVersion {
+	case "", "1.2":
+		version = tls.VersionTLS12
+	case "1.3":
+		version = tls.VersionTLS13
+	default:
+		return 0, nil, fmt.Errorf("%s TLS minimum version %q is unsupported; must be one of [1.2, 1.3]", name, minVersion)
+	}
+
+	if len(cipherSuiteNames) == 0 {
+		return version, nil, nil
+	}
+	if version == tls.VersionTLS13 {
+		return 0, nil, fmt.Errorf("%s TLS cipher suites cannot be configured when the minimum version is 1.3", name)
+	}
+	suites := make(map[string]uint16)
+	for _, suite := range tls.CipherSuites() {
+		suites[suite.Name] = suite.ID
+	}
+	var cipherSuites []uint16
+	for _, suiteName := range cipherSuiteNames {
+		id, ok := suites[suiteName]
+		if !ok {
+			return 0, nil, fmt.Errorf("%s TLS cipher suite %q is unknown or insecure", name, suiteName)
+		}
+		cipherSuites = append(cipherSuites, id)
+	}
+	if fips.Enabled() {
+		if err := fips.CheckCipherSuites(cipherSuites); err != nil {
+			return 0, nil, fmt.Errorf("%s TLS %w", name, err)
+		}
+	}
+	return version, cipherSuites, nil
+}
+
+// listenerAddressFromConfig parses the address of an additional listener,
+// which defaults to the given port if unset.
+func listenerAddressFromConfig(address string, port int, defaultPort int) (*net.TCPAddr, error) {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return nil, fmt.Errorf("could not parse additional listener address %q", address)
+	}
+	if port == 0 {
+		port = defaultPort
+	}
+	return &net.TCPAddr{IP: ip, Port: port}, nil
+}
+
+// trustedProxyFromConfig parses a trusted proxy, which is either a CIDR or a
+// single IP address.
+func trustedProxyFromConfig(trustedProxy string) (*net.IPNet, error) {
+	if _, network, err := net.ParseCIDR(trustedProxy); err == nil {
+		return network, nil
+	}
+	ip := net.ParseIP(trustedProxy)
+	if ip == nil {
+		return nil, fmt.Errorf("could not parse bundle endpoint trusted proxy %q", trustedProxy)
+	}
+	bits := 8 * net.IPv6len
+	if ip.To4() != nil {
+		ip = ip.To4()
+		bits = 8 * net.IPv4len
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)}, nil
+}
+
+func grpcConfigFromConfig(c *grpcConfig) (endpoints.GRPCConfig, error) {
+	config := endpoints.GRPCConfig{
+		PermitKeepaliveWithoutStream: c.PermitKeepaliveWithoutStream,
+		ReflectionEnabled:            c.ReflectionEnabled,
+	}
+
+	for _, d := range []struct {
+		name  string
+		value string
+		out   *time.Duration
+	}{
+		{name: "keepalive_min_time", value: c.KeepaliveMinTime, out: &config.KeepaliveMinTime},
+		{name: "keepalive_time", value: c.KeepaliveTime, out: &config.KeepaliveTime},
+		{name: "keepalive_timeout", value: c.KeepaliveTimeout, out: &config.KeepaliveTimeout},
+		{name: "max_connection_age", value: c.MaxConnectionAge, out: &config.MaxConnectionAge},
+	} {
+		if d.value == "" {
+			continue
+		}
+		value, err := time.ParseDuration(d.value)
+		if err != nil {
+			return endpoints.GRPCConfig{}, fmt.Errorf("could not parse grpc %s %q: %v", d.name, d.value, err)
+		}
+		if value <= 0 {
+			return endpoints.GRPCConfig{}, fmt.Errorf("grpc %s must be positive; got %q", d.name, d.value)
+		}
+		*d.out = value
+	}
+
+	if c.MaxConcurrentStreams < 0 {
+		return endpoints.GRPCConfig{}, fmt.Errorf("grpc max_concurrent_streams cannot be negative; got %d", c.MaxConcurrentStreams)
+	}
+	config.MaxConcurrentStreams = uint32(c.MaxConcurrentStreams)
+	return config, nil
+}
+
 // hasExpectedTTLs is a function that checks if ca_ttl is less than default_svid_ttl * 6. SPIRE Server prepares a new CA certificate when 1/2 of the CA lifetime has elapsed in order to give ample time for the new trust bundle to propagate. However, it does not start using it until 5/6th of the CA lifetime. So its normal for an SVID TTL to be capped to 1/6th of the CA TTL. In order to get the expected lifetime on SVID TTLs, the CA TTL should be 6x.
 func hasExpectedTTLs(caTTL, svidTTL time.Duration) bool {
 	if caTTL == 0 {
@@ -741,3 +1430,33 @@ func isPKIXNameEmpty(name pkix.Name) bool {
 	// use.
 	return reflect.DeepEqual(name, pkix.Name{})
 }
+
+// newAuditLogger creates the logger that API audit records are written to.
+// Records are written as JSON unless another format is configured.
+func newAuditLogger(c *auditLogConfig) (*log.Logger, error) {
+	format := c.LogFormat
+	if format == log.DefaultFormat {
+		format = log.JSONFormat
+	}
+	auditLogger, err := log.NewLogger(
+		log.WithFormat(format),
+		log.WithOutputs(c.Destinations))
+	if err != nil {
+		return nil, fmt.Errorf("could not start audit logger: %v", err)
+	}
+	return auditLogger, nil
+}
+
+// logOutputOption returns the option that sets the output of the logger. The
+// log file is rotated when log rotation is configured.
+func logOutputOption(logFile string, c *logRotationConfig) log.Option {
+	if c == nil {
+		return log.WithOutputFile(logFile)
+	}
+	return log.WithRotatingOutputFile(logFile, log.RotationConfig{
+		MaxSize:    c.MaxSizeMB,
+		MaxAge:     c.MaxAgeDays,
+		MaxBackups: c.MaxBackups,
+		Compress:   c.Compress,
+	})
+}
@@ -2,8 +2,10 @@ package run
 
 import (
 	"bytes"
+	"crypto/tls"
 	"crypto/x509/pkix"
 	"io/ioutil"
+	"net"
 	"os"
 	"path/filepath"
 	"testing"
@@ -12,14 +14,22 @@ import (
 	"github.com/hashicorp/hcl/hcl/printer"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/log"
+	"github.com/spiffe/spire/pkg/common/pemutil"
 	"github.com/spiffe/spire/pkg/server"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	bundleClient "github.com/spiffe/spire/pkg/server/bundle/client"
+	"github.com/spiffe/spire/pkg/server/endpoints"
+	"github.com/spiffe/spire/pkg/server/endpoints/bundle"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 func TestParseConfigGood(t *testing.T) {
@@ -29,13 +39,19 @@ func TestParseConfigGood(t *testing.T) {
 	// Check for server configurations
 	assert.Equal(t, c.Server.BindAddress, "127.0.0.1")
 	assert.Equal(t, c.Server.BindPort, 8081)
+	assert.Equal(t, []listenerConfig{
+		{Address: "::"},
+		{Address: "10.0.0.1", Port: 8082, TLS: &listenerTLSConfig{MinVersion: "1.3"}},
+	}, c.Server.AdditionalListeners)
 	assert.Equal(t, c.Server.RegistrationUDSPath, "/tmp/server.sock")
 	assert.Equal(t, c.Server.TrustDomain, "example.org")
 	assert.Equal(t, c.Server.LogLevel, "INFO")
+	assert.Equal(t, c.Server.SubsystemLogLevels, map[string]string{"ca": "DEBUG", "datastore": "WARN"})
 	assert.Equal(t, c.Server.Experimental.AllowAgentlessNodeAttestors, true)
 	assert.Equal(t, c.Server.Federation.BundleEndpoint.Address, "0.0.0.0")
 	assert.Equal(t, c.Server.Federation.BundleEndpoint.Port, 8443)
 	assert.Equal(t, c.Server.Federation.BundleEndpoint.ACME.DomainName, "example.org")
+	assert.Equal(t, []bundleEndpointListenerConfig{{Address: "::"}}, c.Server.Federation.BundleEndpoint.AdditionalListeners)
 	assert.Equal(t, len(c.Server.Federation.FederatesWith), 2)
 	assert.Equal(t, c.Server.Federation.FederatesWith["domain1.test"].BundleEndpoint.Address, "1.2.3.4")
 	assert.True(t, c.Server.Federation.FederatesWith["domain1.test"].BundleEndpoint.UseWebPKI)
@@ -501,338 +517,1437 @@ func TestNewServerConfig(t *testing.T) {
 				require.Equal(t, 1337, c.BindAddress.Port)
 			},
 		},
+		{
+			msg: "additional listeners should be correctly parsed",
+			input: func(c *Config) {
+				c.Server.BindPort = 1337
+				c.Server.AdditionalListeners = []listenerConfig{
+					{Address: "::"},
+					{
+						Address: "10.0.0.1",
+						Port:    1338,
+						TLS: &listenerTLSConfig{
+							CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, []endpoints.TCPListener{
+					{
+						Address:   &net.TCPAddr{IP: net.ParseIP("::"), Port: 1337},
+						TLSPolicy: endpoints.TLSPolicy{},
+					},
+					{
+						Address: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1338},
+						TLSPolicy: endpoints.TLSPolicy{
+							MinVersion:   tls.VersionTLS12,
+							CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+						},
+					},
+				}, c.AdditionalBindAddresses)
+			},
+		},
+		{
+			msg:         "invalid additional listener address should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.AdditionalListeners = []listenerConfig{{Address: "not-an-ip"}}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "invalid additional listener TLS policy should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.AdditionalListeners = []listenerConfig{{Address: "::", TLS: &listenerTLSConfig{MinVersion: "1.1"}}}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "server TLS policy applies to listeners without their own",
+			input: func(c *Config) {
+				c.Server.BindPort = 1337
+				c.Server.TLS = &listenerTLSConfig{MinVersion: "1.3"}
+				c.Server.AdditionalListeners = []listenerConfig{
+					{Address: "::"},
+					{Address: "10.0.0.1", TLS: &listenerTLSConfig{MinVersion: "1.2"}},
+				}
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1338,
+						AdditionalListeners: []bundleEndpointListenerConfig{
+							{Address: "::"},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, endpoints.TLSPolicy{MinVersion: tls.VersionTLS13}, c.TLSPolicy)
+				require.Equal(t, []endpoints.TCPListener{
+					{
+						Address:   &net.TCPAddr{IP: net.ParseIP("::"), Port: 1337},
+						TLSPolicy: endpoints.TLSPolicy{MinVersion: tls.VersionTLS13},
+					},
+					{
+						Address:   &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1337},
+						TLSPolicy: endpoints.TLSPolicy{MinVersion: tls.VersionTLS12},
+					},
+				}, c.AdditionalBindAddresses)
+				require.Equal(t, bundle.TLSPolicy{MinVersion: tls.VersionTLS13}, c.Federation.BundleEndpoint.TLSPolicy)
+				require.Equal(t, []bundle.ListenerConfig{
+					{
+						Address:   &net.TCPAddr{IP: net.ParseIP("::"), Port: 1338},
+						TLSPolicy: bundle.TLSPolicy{MinVersion: tls.VersionTLS13},
+					},
+				}, c.Federation.BundleEndpoint.AdditionalListeners)
+			},
+		},
+		{
+			msg:         "invalid server TLS policy should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.TLS = &listenerTLSConfig{CipherSuites: []string{"TLS_NOT_A_SUITE"}}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "grpc connection settings should be correctly parsed",
+			input: func(c *Config) {
+				c.Server.GRPC = &grpcConfig{
+					KeepaliveMinTime:             "10s",
+					KeepaliveTime:                "30s",
+					KeepaliveTimeout:             "5s",
+					MaxConcurrentStreams:         100,
+					MaxConnectionAge:             "10m",
+					PermitKeepaliveWithoutStream: true,
+					ReflectionEnabled:            true,
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, endpoints.GRPCConfig{
+					KeepaliveMinTime:             10 * time.Second,
+					KeepaliveTime:                30 * time.Second,
+					KeepaliveTimeout:             5 * time.Second,
+					MaxConcurrentStreams:         100,
+					MaxConnectionAge:             10 * time.Minute,
+					PermitKeepaliveWithoutStream: true,
+					ReflectionEnabled:            true,
+				}, c.GRPC)
+			},
+		},
+		{
+			msg:         "invalid grpc duration should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.GRPC = &grpcConfig{KeepaliveTime: "forever"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "non-positive grpc duration should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.GRPC = &grpcConfig{MaxConnectionAge: "0s"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "negative grpc max_concurrent_streams should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.GRPC = &grpcConfig{MaxConcurrentStreams: -1}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
 		{
 			msg:         "invalid bind_address should return an error",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.BindAddress = "this-is-not-an-ip-address"
+				c.Server.BindAddress = "this-is-not-an-ip-address"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "admin TCP listener is disabled if admin_bind_port is not set",
+			input: func(c *Config) {
+				c.Server.AdminBindAddress = "192.168.1.2"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c.AdminBindAddress)
+			},
+		},
+		{
+			msg: "admin_bind_address and admin_bind_port should be correctly parsed",
+			input: func(c *Config) {
+				c.Server.AdminBindAddress = "192.168.1.2"
+				c.Server.AdminBindPort = 1338
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "192.168.1.2", c.AdminBindAddress.IP.String())
+				require.Equal(t, 1338, c.AdminBindAddress.Port)
+			},
+		},
+		{
+			msg: "admin_bind_address should default to bind_address",
+			input: func(c *Config) {
+				c.Server.BindAddress = "192.168.1.1"
+				c.Server.AdminBindPort = 1338
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "192.168.1.1", c.AdminBindAddress.IP.String())
+				require.Equal(t, 1338, c.AdminBindAddress.Port)
+			},
+		},
+		{
+			msg:         "invalid admin_bind_address should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.AdminBindAddress = "this-is-not-an-ip-address"
+				c.Server.AdminBindPort = 1338
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "registration_uds_path should be correctly configured",
+			input: func(c *Config) {
+				c.Server.RegistrationUDSPath = "foo"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "foo", c.BindUDSAddress.Name)
+				require.Equal(t, "unix", c.BindUDSAddress.Net)
+			},
+		},
+		{
+			msg:   "registration UDS permissions should default to owner and group access",
+			input: func(c *Config) {},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, &diskutil.SocketPermissions{Mode: 0770, UID: -1, GID: -1}, c.BindUDSPermissions)
+			},
+		},
+		{
+			msg: "registration_uds_mode, registration_uds_owner and registration_uds_group should be correctly configured",
+			input: func(c *Config) {
+				c.Server.RegistrationUDSMode = "0660"
+				c.Server.RegistrationUDSOwner = "1000"
+				c.Server.RegistrationUDSGroup = "2000"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, &diskutil.SocketPermissions{Mode: 0660, UID: 1000, GID: 2000}, c.BindUDSPermissions)
+			},
+		},
+		{
+			msg:         "invalid registration_uds_mode should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.RegistrationUDSMode = "rw-rw----"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "data_dir should be correctly configured",
+			input: func(c *Config) {
+				c.Server.DataDir = "foo"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "foo", c.DataDir)
+			},
+		},
+		{
+			msg: "trust_domain should be correctly parsed",
+			input: func(c *Config) {
+				c.Server.TrustDomain = "foo"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "spiffe://foo", c.TrustDomain.IDString())
+			},
+		},
+		{
+			msg:         "invalid trust_domain should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.TrustDomain = "i'm invalid"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "admin_ids should be correctly parsed",
+			input: func(c *Config) {
+				c.Server.AdminIDs = []string{"spiffe://example.org/admin", "spiffe://federated.test/admin"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, []spiffeid.ID{
+					spiffeid.Must("example.org", "admin"),
+					spiffeid.Must("federated.test", "admin"),
+				}, c.AdminIDs)
+			},
+		},
+		{
+			msg:         "invalid admin_ids should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.AdminIDs = []string{"not-a-spiffe-id"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "server_id is correctly configured",
+			input: func(c *Config) {
+				c.Server.ServerID = "server-1"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "server-1", c.ServerID)
+			},
+		},
+		{
+			msg:         "invalid server_id should return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.ServerID = "server/1"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "jwt_issuer is correctly configured",
+			input: func(c *Config) {
+				c.Server.JWTIssuer = "ISSUER"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "ISSUER", c.JWTIssuer)
+			},
+		},
+		{
+			msg: "logger gets set correctly",
+			input: func(c *Config) {
+				c.Server.LogLevel = "WARN"
+				c.Server.LogFormat = "TEXT"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.NotNil(t, c.Log)
+
+				l := c.Log.(*log.Logger)
+				require.Equal(t, logrus.WarnLevel, l.Level)
+				require.Equal(t, &logrus.TextFormatter{}, l.Formatter)
+			},
+		},
+		{
+			msg: "log_level and log_format are case insensitive",
+			input: func(c *Config) {
+				c.Server.LogLevel = "wArN"
+				c.Server.LogFormat = "TeXt"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.NotNil(t, c.Log)
+
+				l := c.Log.(*log.Logger)
+				require.Equal(t, logrus.WarnLevel, l.Level)
+				require.Equal(t, &logrus.TextFormatter{}, l.Formatter)
+			},
+		},
+		{
+			msg:         "invalid log_level returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.LogLevel = "not-a-valid-level"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "invalid log_format returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.LogFormat = "not-a-valid-format"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "allow_agentless_node_attestors is configured correctly",
+			input: func(c *Config) {
+				c.Server.Experimental.AllowAgentlessNodeAttestors = true
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.True(t, c.Experimental.AllowAgentlessNodeAttestors)
+			},
+		},
+		{
+			msg: "feature_flags are configured correctly",
+			input: func(c *Config) {
+				c.Server.Experimental.FeatureFlags = []string{"i_am_a_test_flag"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, fflag.RawConfig{"i_am_a_test_flag"}, c.Experimental.FeatureFlags)
+			},
+		},
+		{
+			msg:         "unknown feature_flags return an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Experimental.FeatureFlags = []string{"foo"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "require_plugin_checksums is configured correctly",
+			input: func(c *Config) {
+				c.Server.RequirePluginChecksums = true
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.True(t, c.RequirePluginChecksums)
+			},
+		},
+		{
+			msg: "require_fips is configured correctly",
+			input: func(c *Config) {
+				c.Server.RequireFIPS = true
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.True(t, c.RequireFIPS)
+			},
+		},
+		{
+			msg: "bundle endpoint is parsed and configured correctly",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "192.168.1.1", c.Federation.BundleEndpoint.Address.IP.String())
+				require.Equal(t, 1337, c.Federation.BundleEndpoint.Address.Port)
+			},
+		},
+		{
+			msg: "bundle endpoint ACME cache directory defaults to the data directory",
+			input: func(c *Config) {
+				c.Server.DataDir = "/some/data/dir"
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						ACME: &bundleEndpointACMEConfig{
+							DomainName: "example.org",
+							Email:      "admin@example.org",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "/some/data/dir/bundle-acme", c.Federation.BundleEndpoint.ACME.CacheDir)
+			},
+		},
+		{
+			msg: "bundle endpoint ACME cache directory is configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						ACME: &bundleEndpointACMEConfig{
+							CacheDir:   "/some/cache/dir",
+							DomainName: "example.org",
+							Email:      "admin@example.org",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "/some/cache/dir", c.Federation.BundleEndpoint.ACME.CacheDir)
+			},
+		},
+		{
+			msg: "bundle endpoint refresh hint is configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:     "192.168.1.1",
+						Port:        1337,
+						RefreshHint: "10m",
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, 10*time.Minute, c.Federation.BundleEndpoint.RefreshHint)
+			},
+		},
+		{
+			msg:         "invalid bundle endpoint refresh hint returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:     "192.168.1.1",
+						Port:        1337,
+						RefreshHint: "forever",
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle endpoint refresh hint below the minimum returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:     "192.168.1.1",
+						Port:        1337,
+						RefreshHint: "10s",
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle endpoint advertised address defaults to the bundle endpoint port",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:           "192.168.1.1",
+						Port:              1337,
+						AdvertisedAddress: "spire.example.org",
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "spire.example.org:1337", c.Federation.BundleEndpoint.AdvertisedAddress)
+				require.Equal(t, "https://spire.example.org:1337", c.Federation.BundleEndpoint.AdvertisedURL())
+			},
+		},
+		{
+			msg: "bundle endpoint advertised port is configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:           "192.168.1.1",
+						Port:              1337,
+						AdvertisedAddress: "spire.example.org",
+						AdvertisedPort:    443,
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, "spire.example.org:443", c.Federation.BundleEndpoint.AdvertisedAddress)
+			},
+		},
+		{
+			msg:         "bundle endpoint advertised port without advertised address returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:        "192.168.1.1",
+						Port:           1337,
+						AdvertisedPort: 443,
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle endpoint trusted proxies and PROXY protocol are configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:        "192.168.1.1",
+						Port:           1337,
+						TrustedProxies: []string{"10.0.0.0/8", "192.168.2.1", "2001:db8::1"},
+						ProxyProtocol:  true,
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, []*net.IPNet{
+					{IP: net.IP{10, 0, 0, 0}, Mask: net.CIDRMask(8, 32)},
+					{IP: net.IP{192, 168, 2, 1}, Mask: net.CIDRMask(32, 32)},
+					{IP: net.ParseIP("2001:db8::1"), Mask: net.CIDRMask(128, 128)},
+				}, c.Federation.BundleEndpoint.TrustedProxies)
+				require.True(t, c.Federation.BundleEndpoint.ProxyProtocol)
+			},
+		},
+		{
+			msg:         "invalid bundle endpoint trusted proxy returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:        "192.168.1.1",
+						Port:           1337,
+						TrustedProxies: []string{"proxy.example.org"},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle endpoint PROXY protocol without trusted proxies returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:       "192.168.1.1",
+						Port:          1337,
+						ProxyProtocol: true,
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle endpoint TLS policy is configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+							ClientAuth:   "require_and_verify",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, bundle.TLSPolicy{
+					MinVersion:   tls.VersionTLS12,
+					CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+					ClientAuth:   tls.RequireAndVerifyClientCert,
+				}, c.Federation.BundleEndpoint.TLSPolicy)
+			},
+		},
+		{
+			msg: "bundle endpoint TLS minimum version can be raised to 1.3",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							MinVersion: "1.3",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, bundle.TLSPolicy{
+					MinVersion: tls.VersionTLS13,
+				}, c.Federation.BundleEndpoint.TLSPolicy)
+			},
+		},
+		{
+			msg:         "unsupported bundle endpoint TLS minimum version returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							MinVersion: "1.0",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "insecure bundle endpoint TLS cipher suite returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							CipherSuites: []string{"TLS_RSA_WITH_RC4_128_SHA"},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle endpoint TLS cipher suites with minimum version 1.3 returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							MinVersion:   "1.3",
+							CipherSuites: []string{"TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256"},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle endpoint additional listeners are configurable",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						AdditionalListeners: []bundleEndpointListenerConfig{
+							{Address: "::"},
+							{
+								Address: "10.0.0.1",
+								Port:    1338,
+								TLS: &bundleEndpointTLSConfig{
+									MinVersion: "1.3",
+									ClientAuth: "require_and_verify",
+								},
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, []bundle.ListenerConfig{
+					{
+						Address: &net.TCPAddr{IP: net.ParseIP("::"), Port: 1337},
+					},
+					{
+						Address: &net.TCPAddr{IP: net.ParseIP("10.0.0.1"), Port: 1338},
+						TLSPolicy: bundle.TLSPolicy{
+							MinVersion: tls.VersionTLS13,
+							ClientAuth: tls.RequireAndVerifyClientCert,
+						},
+					},
+				}, c.Federation.BundleEndpoint.AdditionalListeners)
+			},
+		},
+		{
+			msg:         "invalid bundle endpoint additional listener returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address:             "192.168.1.1",
+						Port:                1337,
+						AdditionalListeners: []bundleEndpointListenerConfig{{Address: "not-an-ip"}},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "unknown bundle endpoint TLS client auth returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					BundleEndpoint: &bundleEndpointConfig{
+						Address: "192.168.1.1",
+						Port:    1337,
+						TLS: &bundleEndpointTLSConfig{
+							ClientAuth: "always",
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle federates with section is parsed and configured correctly",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:             "192.168.1.1",
+								Port:                1337,
+								SpiffeID:            "spiffe://domain1.test/bundle/endpoint",
+								UseWebPKI:           false,
+								BootstrapBundlePath: "/some/bundle.pem",
+							},
+						},
+						"domain2.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:   "192.168.1.1",
+								Port:      1337,
+								UseWebPKI: true,
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, map[string]bundleClient.TrustDomainConfig{
+					"domain1.test": {
+						EndpointURL:         "https://192.168.1.1:1337",
+						EndpointSpiffeID:    "spiffe://domain1.test/bundle/endpoint",
+						UseWebPKI:           false,
+						BootstrapBundlePath: "/some/bundle.pem",
+					},
+					"domain2.test": {
+						EndpointURL: "https://192.168.1.1:1337",
+						UseWebPKI:   true,
+					},
+				}, c.Federation.FederatesWith)
+			},
+		},
+		{
+			msg:         "bundle federates with section uses Web PKI and SpiffeID",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:   "192.168.1.1",
+								SpiffeID:  "spiffe://domain1.test/bundle/endpoint",
+								Port:      1337,
+								UseWebPKI: true,
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle federates with section uses Web PKI and bootstrap bundle",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:             "192.168.1.1",
+								Port:                1337,
+								UseWebPKI:           true,
+								BootstrapBundlePath: "/some/bundle.pem",
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "bundle federates with section loads web PKI root CAs",
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:           "192.168.1.1",
+								Port:              1337,
+								UseWebPKI:         true,
+								WebPKIRootCAsPath: "../../../../test/fixture/certs/base_cert.pem",
+								PinWebPKIRootCAs:  true,
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				rootCAs, err := pemutil.LoadCertificates("../../../../test/fixture/certs/base_cert.pem")
+				require.NoError(t, err)
+				require.Equal(t, rootCAs, c.Federation.FederatesWith["domain1.test"].WebPKIRootCAs)
+				require.True(t, c.Federation.FederatesWith["domain1.test"].PinWebPKIRootCAs)
+			},
+		},
+		{
+			msg:         "bundle federates with section uses web PKI root CAs without Web PKI",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:           "192.168.1.1",
+								Port:              1337,
+								WebPKIRootCAsPath: "../../../../test/fixture/certs/base_cert.pem",
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle federates with section pins web PKI root CAs without root CAs",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:          "192.168.1.1",
+								Port:             1337,
+								UseWebPKI:        true,
+								PinWebPKIRootCAs: true,
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "bundle federates with section fails to load web PKI root CAs",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Federation = &federationConfig{
+					FederatesWith: map[string]federatesWithConfig{
+						"domain1.test": {
+							BundleEndpoint: federatesWithBundleEndpointConfig{
+								Address:           "192.168.1.1",
+								Port:              1337,
+								UseWebPKI:         true,
+								WebPKIRootCAsPath: "/non/existent/path.pem",
+							},
+						},
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "default_svid_ttl is correctly parsed",
+			input: func(c *Config) {
+				c.Server.DefaultSVIDTTL = "1m"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, time.Minute, c.SVIDTTL)
+			},
+		},
+		{
+			msg:         "invalid default_svid_ttl returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.DefaultSVIDTTL = "b"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "rsa-2048 ca_key_type is correctly parsed",
+			input: func(c *Config) {
+				c.Server.CAKeyType = "rsa-2048"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, keymanager.KeyType_RSA_2048, c.CAKeyType)
+			},
+		},
+		{
+			msg: "rsa-4096 ca_key_type is correctly parsed",
+			input: func(c *Config) {
+				c.Server.CAKeyType = "rsa-4096"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, keymanager.KeyType_RSA_4096, c.CAKeyType)
+			},
+		},
+		{
+			msg: "ec-p256 ca_key_type is correctly parsed",
+			input: func(c *Config) {
+				c.Server.CAKeyType = "ec-p256"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, keymanager.KeyType_EC_P256, c.CAKeyType)
+			},
+		},
+		{
+			msg: "ec-p384 ca_key_type is correctly parsed",
+			input: func(c *Config) {
+				c.Server.CAKeyType = "ec-p384"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, keymanager.KeyType_EC_P384, c.CAKeyType)
+			},
+		},
+		{
+			msg:         "unsupported ca_key_type is rejected",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.CAKeyType = "rsa-1024"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "ca_ttl is correctly parsed",
+			input: func(c *Config) {
+				c.Server.CATTL = "1h"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, time.Hour, c.CATTL)
+			},
+		},
+		{
+			msg:         "invalid ca_ttl returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.CATTL = "b"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "backdate is correctly parsed",
+			input: func(c *Config) {
+				c.Server.Backdate = "30s"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, 30*time.Second, c.Backdate)
+			},
+		},
+		{
+			msg:         "invalid backdate returns an error",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.Backdate = "b"
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "registration_uds_path should be correctly configured",
+			msg:         "negative backdate returns an error",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.RegistrationUDSPath = "foo"
+				c.Server.Backdate = "-1s"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, "foo", c.BindUDSAddress.Name)
-				require.Equal(t, "unix", c.BindUDSAddress.Net)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "data_dir should be correctly configured",
+			msg: "dns_name_policy is correctly parsed",
 			input: func(c *Config) {
-				c.Server.DataDir = "foo"
+				c.Server.DNSNamePolicy = &dnsNamePolicyConfig{
+					AllowedDomains:  []string{"example.org"},
+					AllowedPatterns: []string{`web-[0-9]+\.internal`},
+				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, "foo", c.DataDir)
+				require.NotNil(t, c.DNSNamePolicy)
+				require.NoError(t, c.DNSNamePolicy.Check([]string{"foo.example.org", "web-1.internal"}))
+				require.Error(t, c.DNSNamePolicy.Check([]string{"foo.example.com"}))
 			},
 		},
 		{
-			msg: "trust_domain should be correctly parsed",
+			msg:         "dns_name_policy without allowed domains or patterns returns an error",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.TrustDomain = "foo"
+				c.Server.DNSNamePolicy = &dnsNamePolicyConfig{}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, "spiffe://foo", c.TrustDomain.IDString())
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg:         "invalid trust_domain should return an error",
+			msg: "max_jwt_svid_ttl is correctly parsed",
+			input: func(c *Config) {
+				c.Server.MaxJWTSVIDTTL = "10m"
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, 10*time.Minute, c.MaxJWTSVIDTTL)
+			},
+		},
+		{
+			msg:         "invalid max_jwt_svid_ttl returns an error",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.TrustDomain = "i'm invalid"
+				c.Server.MaxJWTSVIDTTL = "b"
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "jwt_issuer is correctly configured",
+			msg:         "non-positive max_jwt_svid_ttl returns an error",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.JWTIssuer = "ISSUER"
+				c.Server.MaxJWTSVIDTTL = "0s"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, "ISSUER", c.JWTIssuer)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "logger gets set correctly",
+			msg: "maintenance is correctly parsed",
 			input: func(c *Config) {
-				c.Server.LogLevel = "WARN"
-				c.Server.LogFormat = "TEXT"
+				c.Server.Maintenance = &maintenanceConfig{
+					PruneInterval:          "1m",
+					OrphanedEntryRetention: "720h",
+				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.NotNil(t, c.Log)
-
-				l := c.Log.(*log.Logger)
-				require.Equal(t, logrus.WarnLevel, l.Level)
-				require.Equal(t, &logrus.TextFormatter{}, l.Formatter)
+				require.Equal(t, time.Minute, c.PruneInterval)
+				require.Equal(t, 720*time.Hour, c.OrphanedEntryRetention)
 			},
 		},
 		{
-			msg: "log_level and log_format are case insensitive",
+			msg:         "invalid maintenance prune_interval returns an error",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.LogLevel = "wArN"
-				c.Server.LogFormat = "TeXt"
+				c.Server.Maintenance = &maintenanceConfig{PruneInterval: "b"}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.NotNil(t, c.Log)
-
-				l := c.Log.(*log.Logger)
-				require.Equal(t, logrus.WarnLevel, l.Level)
-				require.Equal(t, &logrus.TextFormatter{}, l.Formatter)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg:         "invalid log_level returns an error",
+			msg:         "non-positive maintenance prune_interval returns an error",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.LogLevel = "not-a-valid-level"
+				c.Server.Maintenance = &maintenanceConfig{PruneInterval: "0s"}
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg:         "invalid log_format returns an error",
+			msg:         "negative maintenance orphaned_entry_retention returns an error",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.LogFormat = "not-a-valid-format"
+				c.Server.Maintenance = &maintenanceConfig{OrphanedEntryRetention: "-1h"}
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "allow_agentless_node_attestors is configured correctly",
+			msg: "ca_subject is defaulted when unset",
 			input: func(c *Config) {
-				c.Server.Experimental.AllowAgentlessNodeAttestors = true
+				c.Server.CASubject = nil
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.True(t, c.Experimental.AllowAgentlessNodeAttestors)
+				require.Equal(t, defaultCASubject, c.CASubject)
 			},
 		},
 		{
-			msg: "bundle endpoint is parsed and configured correctly",
+			msg: "ca_subject is defaulted when set but empty",
 			input: func(c *Config) {
-				c.Server.Federation = &federationConfig{
-					BundleEndpoint: &bundleEndpointConfig{
-						Address: "192.168.1.1",
-						Port:    1337,
-					},
-				}
+				c.Server.CASubject = &caSubjectConfig{}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, "192.168.1.1", c.Federation.BundleEndpoint.Address.IP.String())
-				require.Equal(t, 1337, c.Federation.BundleEndpoint.Address.Port)
+				require.Equal(t, defaultCASubject, c.CASubject)
 			},
 		},
 		{
-			msg: "bundle federates with section is parsed and configured correctly",
+			msg: "ca_subject is overridable",
 			input: func(c *Config) {
-				c.Server.Federation = &federationConfig{
-					FederatesWith: map[string]federatesWithConfig{
-						"domain1.test": {
-							BundleEndpoint: federatesWithBundleEndpointConfig{
-								Address:   "192.168.1.1",
-								Port:      1337,
-								SpiffeID:  "spiffe://domain1.test/bundle/endpoint",
-								UseWebPKI: false,
-							},
-						},
-						"domain2.test": {
-							BundleEndpoint: federatesWithBundleEndpointConfig{
-								Address:   "192.168.1.1",
-								Port:      1337,
-								UseWebPKI: true,
-							},
-						},
-					},
+				c.Server.CASubject = &caSubjectConfig{
+					Organization: []string{"foo"},
+					Country:      []string{"us"},
+					CommonName:   "bar",
 				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, map[string]bundleClient.TrustDomainConfig{
-					"domain1.test": {
-						EndpointAddress:  "192.168.1.1:1337",
-						EndpointSpiffeID: "spiffe://domain1.test/bundle/endpoint",
-						UseWebPKI:        false,
-					},
-					"domain2.test": {
-						EndpointAddress: "192.168.1.1:1337",
-						UseWebPKI:       true,
-					},
-				}, c.Federation.FederatesWith)
+				require.Equal(t, pkix.Name{
+					Organization: []string{"foo"},
+					Country:      []string{"us"},
+					CommonName:   "bar",
+				}, c.CASubject)
 			},
 		},
 		{
-			msg:         "bundle federates with section uses Web PKI and SpiffeID",
-			expectError: true,
+			msg: "attestation rate limit is on by default",
 			input: func(c *Config) {
-				c.Server.Federation = &federationConfig{
-					FederatesWith: map[string]federatesWithConfig{
-						"domain1.test": {
-							BundleEndpoint: federatesWithBundleEndpointConfig{
-								Address:   "192.168.1.1",
-								SpiffeID:  "spiffe://domain1.test/bundle/endpoint",
-								Port:      1337,
-								UseWebPKI: true,
-							},
-						},
-					},
-				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Nil(t, c)
+				require.True(t, c.RateLimit.Attestation)
 			},
 		},
 		{
-			msg: "default_svid_ttl is correctly parsed",
+			msg: "attestation rate limits can be explicitly disabled",
 			input: func(c *Config) {
-				c.Server.DefaultSVIDTTL = "1m"
+				value := false
+				c.Server.RateLimit.Attestation = &value
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, time.Minute, c.SVIDTTL)
+				require.False(t, c.RateLimit.Attestation)
 			},
 		},
 		{
-			msg:         "invalid default_svid_ttl returns an error",
-			expectError: true,
+			msg: "attestation rate limits can be explicitly enabled",
 			input: func(c *Config) {
-				c.Server.DefaultSVIDTTL = "b"
+				value := true
+				c.Server.RateLimit.Attestation = &value
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Nil(t, c)
+				require.True(t, c.RateLimit.Attestation)
 			},
 		},
 		{
-			msg: "rsa-2048 ca_key_type is correctly parsed",
+			msg: "rate limits are passed through",
 			input: func(c *Config) {
-				c.Server.CAKeyType = "rsa-2048"
+				c.Server.RateLimit.AttestationLimit = 2
+				c.Server.RateLimit.CSRLimit = 100
+				c.Server.RateLimit.CSRPerCallerLimit = 10
+				c.Server.RateLimit.JWTLimit = 200
+				c.Server.RateLimit.JWTPerCallerLimit = 20
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, keymanager.KeyType_RSA_2048, c.CAKeyType)
+				require.Equal(t, 2, c.RateLimit.AttestationLimit)
+				require.Equal(t, 100, c.RateLimit.CSRLimit)
+				require.Equal(t, 10, c.RateLimit.CSRPerCallerLimit)
+				require.Equal(t, 200, c.RateLimit.JWTLimit)
+				require.Equal(t, 20, c.RateLimit.JWTPerCallerLimit)
 			},
 		},
 		{
-			msg: "rsa-4096 ca_key_type is correctly parsed",
+			msg: "attestation bans use a default duration",
 			input: func(c *Config) {
-				c.Server.CAKeyType = "rsa-4096"
+				c.Server.RateLimit.AttestationPerTypeLimit = 5
+				c.Server.RateLimit.AttestationBanThreshold = 10
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, keymanager.KeyType_RSA_4096, c.CAKeyType)
+				require.Equal(t, 5, c.RateLimit.AttestationPerTypeLimit)
+				require.Equal(t, 10, c.RateLimit.AttestationBanThreshold)
+				require.Equal(t, 5*time.Minute, c.RateLimit.AttestationBanDuration)
 			},
 		},
 		{
-			msg: "ec-p256 ca_key_type is correctly parsed",
+			msg: "attestation ban duration is configurable",
 			input: func(c *Config) {
-				c.Server.CAKeyType = "ec-p256"
+				c.Server.RateLimit.AttestationBanThreshold = 10
+				c.Server.RateLimit.AttestationBanDuration = "1h"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, keymanager.KeyType_EC_P256, c.CAKeyType)
+				require.Equal(t, time.Hour, c.RateLimit.AttestationBanDuration)
 			},
 		},
 		{
-			msg: "ec-p384 ca_key_type is correctly parsed",
+			msg:         "invalid attestation ban duration is rejected",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.CAKeyType = "ec-p384"
+				c.Server.RateLimit.AttestationBanDuration = "0s"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, keymanager.KeyType_EC_P384, c.CAKeyType)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg:         "unsupported ca_key_type is rejected",
+			msg:         "negative rate limits are rejected",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.CAKeyType = "rsa-1024"
+				c.Server.RateLimit.CSRPerCallerLimit = -1
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "ca_ttl is correctly parsed",
+			msg: "default authorization policy is used by default",
 			input: func(c *Config) {
-				c.Server.CATTL = "1h"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, time.Hour, c.CATTL)
+				require.Nil(t, c.AuthOpaPolicyEngineConfig)
 			},
 		},
 		{
-			msg:         "invalid ca_ttl returns an error",
+			msg: "local authorization policy is configured",
+			input: func(c *Config) {
+				c.Server.AuthPolicy = &authPolicyConfig{
+					Local: &localAuthPolicyConfig{
+						RegoPath:       "/policy.rego",
+						PolicyDataPath: "/policy_data.json",
+					},
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, &authpolicy.OpaEngineConfig{
+					Local: &authpolicy.LocalOpaProviderConfig{
+						RegoPath:       "/policy.rego",
+						PolicyDataPath: "/policy_data.json",
+					},
+				}, c.AuthOpaPolicyEngineConfig)
+			},
+		},
+		{
+			msg:         "local authorization policy requires a rego path",
 			expectError: true,
 			input: func(c *Config) {
-				c.Server.CATTL = "b"
+				c.Server.AuthPolicy = &authPolicyConfig{
+					Local: &localAuthPolicyConfig{
+						PolicyDataPath: "/policy_data.json",
+					},
+				}
 			},
 			test: func(t *testing.T, c *server.Config) {
 				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "ca_subject is defaulted when unset",
+			msg: "audit log is disabled by default",
 			input: func(c *Config) {
-				c.Server.CASubject = nil
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, defaultCASubject, c.CASubject)
+				require.Nil(t, c.AuditLog)
 			},
 		},
 		{
-			msg: "ca_subject is defaulted when set but empty",
+			msg: "audit log is written as JSON by default",
 			input: func(c *Config) {
-				c.Server.CASubject = &caSubjectConfig{}
+				c.Server.AuditLog = &auditLogConfig{
+					Destinations: []string{"stdout"},
+				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, defaultCASubject, c.CASubject)
+				require.NotNil(t, c.AuditLog)
+				require.IsType(t, &logrus.JSONFormatter{}, c.AuditLog.(*log.Logger).Formatter)
 			},
 		},
 		{
-			msg: "ca_subject is overridable",
+			msg:         "audit log with an invalid format",
+			expectError: true,
 			input: func(c *Config) {
-				c.Server.CASubject = &caSubjectConfig{
-					Organization: []string{"foo"},
-					Country:      []string{"us"},
-					CommonName:   "bar",
+				c.Server.AuditLog = &auditLogConfig{
+					LogFormat: "foo",
 				}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.Equal(t, pkix.Name{
-					Organization: []string{"foo"},
-					Country:      []string{"us"},
-					CommonName:   "bar",
-				}, c.CASubject)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "attestation rate limit is on by default",
+			msg: "subsystem log levels are applied",
 			input: func(c *Config) {
+				c.Server.SubsystemLogLevels = map[string]string{"ca": "DEBUG"}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.True(t, c.RateLimit.Attestation)
+				require.Equal(t, logrus.DebugLevel, c.Log.(*log.Logger).Level)
 			},
 		},
 		{
-			msg: "attestation rate limits can be explicitly disabled",
+			msg:         "invalid subsystem log level",
+			expectError: true,
 			input: func(c *Config) {
-				value := false
-				c.Server.RateLimit.Attestation = &value
+				c.Server.SubsystemLogLevels = map[string]string{"ca": "foo"}
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.False(t, c.RateLimit.Attestation)
+				require.Nil(t, c)
 			},
 		},
 		{
-			msg: "attestation rate limits can be explicitly enabled",
+			msg: "profiling is configured",
 			input: func(c *Config) {
-				value := true
-				c.Server.RateLimit.Attestation = &value
+				c.Server.ProfilingEnabled = true
+				c.Server.ProfilingPort = 6060
+				c.Server.ProfilingFreq = 60
+				c.Server.ProfilingNames = []string{"cpu", "heap"}
+				c.Server.ProfilingDir = "/tmp/profiles"
 			},
 			test: func(t *testing.T, c *server.Config) {
-				require.True(t, c.RateLimit.Attestation)
+				require.True(t, c.ProfilingEnabled)
+				require.Equal(t, 6060, c.ProfilingPort)
+				require.Equal(t, 60, c.ProfilingFreq)
+				require.Equal(t, []string{"cpu", "heap"}, c.ProfilingNames)
+				require.Equal(t, "/tmp/profiles", c.ProfilingDir)
+			},
+		},
+		{
+			msg:         "unknown profiling name",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.ProfilingNames = []string{"cpu", "foo"}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg:         "log rotation requires a log file",
+			expectError: true,
+			input: func(c *Config) {
+				c.Server.LogRotation = &logRotationConfig{MaxSizeMB: 10}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Nil(t, c)
+			},
+		},
+		{
+			msg: "log file is rotated when log rotation is configured",
+			input: func(c *Config) {
+				c.Server.LogFile = filepath.Join(os.TempDir(), "spire-server-test.log")
+				c.Server.LogRotation = &logRotationConfig{
+					MaxSizeMB:  10,
+					MaxAgeDays: 7,
+					MaxBackups: 3,
+					Compress:   true,
+				}
+			},
+			test: func(t *testing.T, c *server.Config) {
+				require.Equal(t, &lumberjack.Logger{
+					Filename:   filepath.Join(os.TempDir(), "spire-server-test.log"),
+					MaxSize:    10,
+					MaxAge:     7,
+					MaxBackups: 3,
+					Compress:   true,
+				}, c.Log.(*log.Logger).Out)
 			},
 		},
 	}
@@ -1017,6 +2132,26 @@ func TestWarnOnUnknownConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			msg:      "in audit_log block",
+			confFile: "server_bad_audit_log_block.conf",
+			expectedLogEntries: []logEntry{
+				{
+					section: "audit_log",
+					keys:    "unknown_option1,unknown_option2",
+				},
+			},
+		},
+		{
+			msg:      "in log_rotation block",
+			confFile: "server_bad_log_rotation_block.conf",
+			expectedLogEntries: []logEntry{
+				{
+					section: "log_rotation",
+					keys:    "unknown_option1,unknown_option2",
+				},
+			},
+		},
 		// TODO: Re-enable unused key detection for experimental config. See
 		// https://github.com/spiffe/spire/issues/1101 for more information
 		//
@@ -1300,3 +2435,52 @@ func TestExpandEnv(t *testing.T) {
 		assert.Equal(t, testCase.expectedValue, c.Server.TrustDomain)
 	}
 }
+
+func TestValidateConfigReportsAllErrors(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	configPath := filepath.Join(dir, "server.conf")
+	writeConfig := func(serverBlock, pluginsBlock string) {
+		require.NoError(t, ioutil.WriteFile(configPath, []byte(`
+			server {
+				bind_address = "127.0.0.1"
+				bind_port = "8081"
+				registration_uds_path = "/tmp/server.sock"
+				trust_domain = "example.org"
+				data_dir = "`+dir+`"
+				`+serverBlock+`
+			}
+			plugins {
+				`+pluginsBlock+`
+			}
+		`), 0600))
+	}
+	validate := func(configPath string) []string {
+		var errs []string
+		for _, err := range ValidateConfig("validate", []string{"-config", configPath}, ioutil.Discard) {
+			errs = append(errs, err.Error())
+		}
+		return errs
+	}
+
+	writeConfig("", `
+		DataStore "sql" {}
+		KeyManager "memory" {}
+		NodeAttestor "join_token" {}
+	`)
+	assert.Empty(t, validate(configPath))
+
+	writeConfig(`ca_key_type = "rsa-1024"`, `
+		DataStore "sql" {}
+		NodeAttestor "unknown" {}
+	`)
+	assert.Equal(t, []string{
+		`CA key type "rsa-1024" is unknown; must be one of [rsa-2048, rsa-4096, ec-p256, ec-p384]`,
+		"expecting exactly one KeyManager plugin; got 0",
+		`no such NodeAttestor builtin "unknown"`,
+	}, validate(configPath))
+
+	missingPath := filepath.Join(dir, "missing.conf")
+	assert.Equal(t, []string{
+		"could not find config file " + missingPath + ": please use the -config flag",
+	}, validate(missingPath))
+}
@@ -52,6 +52,10 @@ func (g *generateCommand) Run(ctx context.Context, env *common_cli.Env, serverCl
 		return err
 	}
 
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
 	if err := env.Printf("Token: %s\n", resp.Value); err != nil {
 		return err
 	}
@@ -2,6 +2,7 @@ package token
 
 import (
 	"bytes"
+	"strconv"
 	"testing"
 
 	"github.com/mitchellh/cli"
@@ -14,6 +15,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 func TestSynopsis(t *testing.T) {
@@ -52,6 +54,19 @@ func TestCreateToken(t *testing.T) {
 			},
 			token: "token",
 		},
+		{
+			name: "json output",
+			args: []string{
+				"-spiffeID", "spiffe://example.org/agent",
+				"-output", "json",
+			},
+			expectedReq: &agent.CreateJoinTokenRequest{
+				AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent"},
+				Ttl:     600,
+			},
+			expectedStdout: `{"value":"token","expires_at":"0","created_at":"0","agent_id":null}` + "\n",
+			token:          "token",
+		},
 		{
 			name: "malformed spiffe ID",
 			args: []string{
@@ -75,7 +90,7 @@ func TestCreateToken(t *testing.T) {
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			test := setupTest(t)
+			test := setupTest(t, newGenerateCommand)
 			args := append(test.args, tt.args...)
 			test.server.token = tt.token
 			test.server.expectReq = tt.expectedReq
@@ -106,7 +121,7 @@ type tokenTest struct {
 	client cli.Command
 }
 
-func setupTest(t *testing.T) *tokenTest {
+func setupTest(t *testing.T, newClient func(*common_cli.Env) cli.Command) *tokenTest {
 	server := &fakeAgentServer{t: t}
 
 	socketPath := spiretest.StartGRPCSocketServerOnTempSocket(t, func(s *grpc.Server) {
@@ -117,7 +132,7 @@ func setupTest(t *testing.T) *tokenTest {
 	stdout := new(bytes.Buffer)
 	stderr := new(bytes.Buffer)
 
-	client := newGenerateCommand(&common_cli.Env{
+	client := newClient(&common_cli.Env{
 		Stdin:  stdin,
 		Stdout: stdout,
 		Stderr: stderr,
@@ -140,6 +155,9 @@ type fakeAgentServer struct {
 	expectReq *agent.CreateJoinTokenRequest
 	err       error
 	token     string
+
+	tokens        []*types.JoinToken
+	revokedTokens []string
 }
 
 func (f *fakeAgentServer) CreateJoinToken(ctx context.Context, req *agent.CreateJoinTokenRequest) (*types.JoinToken, error) {
@@ -152,3 +170,28 @@ func (f *fakeAgentServer) CreateJoinToken(ctx context.Context, req *agent.Create
 		Value: f.token,
 	}, nil
 }
+
+func (f *fakeAgentServer) ListJoinTokens(ctx context.Context, req *agent.ListJoinTokensRequest) (*agent.ListJoinTokensResponse, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+
+	// Serve one token per page to exercise pagination
+	resp := &agent.ListJoinTokensResponse{}
+	start, _ := strconv.Atoi(req.PageToken)
+	if start < len(f.tokens) {
+		resp.JoinTokens = f.tokens[start : start+1]
+		if start+1 < len(f.tokens) {
+			resp.NextPageToken = strconv.Itoa(start + 1)
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeAgentServer) RevokeJoinToken(ctx context.Context, req *agent.RevokeJoinTokenRequest) (*emptypb.Empty, error) {
+	if f.err != nil {
+		return nil, f.err
+	}
+	f.revokedTokens = append(f.revokedTokens, req.Value)
+	return &emptypb.Empty{}, nil
+}
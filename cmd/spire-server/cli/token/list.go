@@ -0,0 +1,104 @@
+package token
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"time"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/agent/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+)
+
+// NewListCommand creates a new "list" subcommand for "token" command.
+func NewListCommand() cli.Command {
+	return newListCommand(common_cli.DefaultEnv)
+}
+
+func newListCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(listCommand))
+}
+
+type listCommand struct{}
+
+func (c *listCommand) Name() string {
+	return "token list"
+}
+
+func (c *listCommand) Synopsis() string {
+	return "Lists outstanding join tokens"
+}
+
+func (c *listCommand) AppendFlags(fs *flag.FlagSet) {
+}
+
+func (c *listCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	agentClient := serverClient.NewAgentClient()
+
+	var tokens []*types.JoinToken
+	var pageToken string
+	for {
+		resp, err := agentClient.ListJoinTokens(ctx, &agent.ListJoinTokensRequest{
+			PageSize:  1000,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			return err
+		}
+		tokens = append(tokens, resp.JoinTokens...)
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	if env.JSONOutput() {
+		// Every page is printed as a single response
+		return env.PrintJSON(&agent.ListJoinTokensResponse{JoinTokens: tokens})
+	}
+
+	if len(tokens) == 0 {
+		return env.Printf("No join tokens found\n")
+	}
+
+	msg := fmt.Sprintf("Found %d join ", len(tokens))
+	msg = util.Pluralizer(msg, "token", "tokens", len(tokens))
+	if err := env.Printf(msg + ":\n\n"); err != nil {
+		return err
+	}
+
+	return printTokens(env, tokens)
+}
+
+func printTokens(env *common_cli.Env, tokens []*types.JoinToken) error {
+	for _, token := range tokens {
+		if err := env.Printf("Token         : %s\n", token.Value); err != nil {
+			return err
+		}
+		if token.AgentId != nil {
+			id, err := spiffeid.New(token.AgentId.TrustDomain, token.AgentId.Path)
+			if err != nil {
+				return err
+			}
+			if err := env.Printf("SPIFFE ID     : %s\n", id); err != nil {
+				return err
+			}
+		}
+		if token.CreatedAt != 0 {
+			if err := env.Printf("Created at    : %s\n", time.Unix(token.CreatedAt, 0).UTC().Format(time.RFC3339)); err != nil {
+				return err
+			}
+		}
+		if err := env.Printf("Expires at    : %s\n", time.Unix(token.ExpiresAt, 0).UTC().Format(time.RFC3339)); err != nil {
+			return err
+		}
+		if err := env.Println(); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,94 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestListSynopsis(t *testing.T) {
+	require.Equal(t, "Lists outstanding join tokens", NewListCommand().Synopsis())
+}
+
+func TestListTokens(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		tokens         []*types.JoinToken
+		serverErr      error
+		expectedStderr string
+		expectedStdout string
+	}{
+		{
+			name:           "no tokens",
+			expectedStdout: "No join tokens found\n",
+		},
+		{
+			name: "multiple pages",
+			tokens: []*types.JoinToken{
+				{
+					Value:     "token1",
+					CreatedAt: 1600000000,
+					ExpiresAt: 1600000600,
+				},
+				{
+					Value:     "token2",
+					AgentId:   &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent"},
+					CreatedAt: 1600000000,
+					ExpiresAt: 1600001200,
+				},
+			},
+			expectedStdout: `Found 2 join tokens:
+
+Token         : token1
+Created at    : 2020-09-13T12:26:40Z
+Expires at    : 2020-09-13T12:36:40Z
+
+Token         : token2
+SPIFFE ID     : spiffe://example.org/agent
+Created at    : 2020-09-13T12:26:40Z
+Expires at    : 2020-09-13T12:46:40Z
+
+`,
+		},
+		{
+			name: "json output",
+			args: []string{"-output", "json"},
+			tokens: []*types.JoinToken{
+				{
+					Value:     "token2",
+					AgentId:   &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent"},
+					CreatedAt: 1600000000,
+					ExpiresAt: 1600001200,
+				},
+			},
+			expectedStdout: `{"join_tokens":[{"value":"token2","expires_at":"1600001200","created_at":"1600000000","agent_id":{"trust_domain":"example.org","path":"/agent"}}],"next_page_token":""}` + "\n",
+		},
+		{
+			name:           "server fails to list tokens",
+			serverErr:      status.Error(codes.Internal, "server error"),
+			expectedStderr: "Error: rpc error: code = Internal desc = server error\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newListCommand)
+			test.server.tokens = tt.tokens
+			test.server.err = tt.serverErr
+
+			rc := test.client.Run(append(test.args, tt.args...))
+			if tt.expectedStderr != "" {
+				require.Equal(t, tt.expectedStderr, test.stderr.String())
+				require.Equal(t, 1, rc)
+				return
+			}
+
+			require.Empty(t, test.stderr.String())
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+		})
+	}
+}
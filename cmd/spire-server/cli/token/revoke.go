@@ -0,0 +1,58 @@
+package token
+
+import (
+	"context"
+	"errors"
+	"flag"
+
+	"github.com/mitchellh/cli"
+	"github.com/spiffe/spire/cmd/spire-server/util"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/spiffe/spire/proto/spire/api/server/agent/v1"
+)
+
+// NewRevokeCommand creates a new "revoke" subcommand for "token" command.
+func NewRevokeCommand() cli.Command {
+	return newRevokeCommand(common_cli.DefaultEnv)
+}
+
+func newRevokeCommand(env *common_cli.Env) cli.Command {
+	return util.AdaptCommand(env, new(revokeCommand))
+}
+
+type revokeCommand struct {
+	// Value of the join token to revoke
+	token string
+}
+
+func (c *revokeCommand) Name() string {
+	return "token revoke"
+}
+
+func (c *revokeCommand) Synopsis() string {
+	return "Revokes an unused join token"
+}
+
+func (c *revokeCommand) AppendFlags(fs *flag.FlagSet) {
+	fs.StringVar(&c.token, "token", "", "The join token to revoke")
+}
+
+func (c *revokeCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
+	if c.token == "" {
+		return errors.New("a join token is required")
+	}
+
+	agentClient := serverClient.NewAgentClient()
+	resp, err := agentClient.RevokeJoinToken(ctx, &agent.RevokeJoinTokenRequest{
+		Value: c.token,
+	})
+	if err != nil {
+		return err
+	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(resp)
+	}
+
+	return env.Println("Join token revoked")
+}
@@ -0,0 +1,59 @@
+package token
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestRevokeSynopsis(t *testing.T) {
+	require.Equal(t, "Revokes an unused join token", NewRevokeCommand().Synopsis())
+}
+
+func TestRevokeToken(t *testing.T) {
+	for _, tt := range []struct {
+		name           string
+		args           []string
+		serverErr      error
+		expectedStderr string
+		expectedStdout string
+		expectRevoked  []string
+	}{
+		{
+			name:           "revoke token",
+			args:           []string{"-token", "token"},
+			expectedStdout: "Join token revoked\n",
+			expectRevoked:  []string{"token"},
+		},
+		{
+			name:           "missing token",
+			expectedStderr: "Error: a join token is required\n",
+		},
+		{
+			name:           "server fails to revoke token",
+			args:           []string{"-token", "token"},
+			serverErr:      status.Error(codes.NotFound, "join token not found"),
+			expectedStderr: "Error: rpc error: code = NotFound desc = join token not found\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupTest(t, newRevokeCommand)
+			test.server.err = tt.serverErr
+
+			rc := test.client.Run(append(test.args, tt.args...))
+			require.Equal(t, tt.expectRevoked, test.server.revokedTokens)
+			if tt.expectedStderr != "" {
+				require.Equal(t, tt.expectedStderr, test.stderr.String())
+				require.Equal(t, 1, rc)
+				return
+			}
+
+			require.Empty(t, test.stderr.String())
+			require.Equal(t, 0, rc)
+			require.Equal(t, tt.expectedStdout, test.stdout.String())
+		})
+	}
+}
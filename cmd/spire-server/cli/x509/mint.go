@@ -65,6 +65,21 @@ func (c *mintCommand) AppendFlags(fs *flag.FlagSet) {
 	fs.StringVar(&c.write, "write", "", "Directory to write output to instead of stdout")
 }
 
+// mintedSVID is the JSON output of the command, with PEM encoded values
+type mintedSVID struct {
+	X509SVID   string `json:"x509_svid"`
+	PrivateKey string `json:"private_key"`
+	RootCAs    string `json:"root_cas"`
+}
+
+// writtenFiles is the JSON output of the command when the X509-SVID is
+// written to disk
+type writtenFiles struct {
+	X509SVIDPath   string `json:"x509_svid_path"`
+	PrivateKeyPath string `json:"private_key_path"`
+	RootCAsPath    string `json:"root_cas_path"`
+}
+
 func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient util.ServerClient) error {
 	if c.spiffeID == "" {
 		return errors.New("spiffeID must be specified")
@@ -144,6 +159,13 @@ func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	}
 
 	if c.write == "" {
+		if env.JSONOutput() {
+			return env.PrintJSON(mintedSVID{
+				X509SVID:   svidPEM.String(),
+				PrivateKey: keyPEM.String(),
+				RootCAs:    bundlePEM.String(),
+			})
+		}
 		if err := env.Printf("X509-SVID:\n%s\n", svidPEM.String()); err != nil {
 			return err
 		}
@@ -163,20 +185,26 @@ func (c *mintCommand) Run(ctx context.Context, env *common_cli.Env, serverClient
 	if err := ioutil.WriteFile(svidPath, svidPEM.Bytes(), 0644); err != nil { // nolint: gosec // expected permission
 		return fmt.Errorf("unable to write SVID: %v", err)
 	}
-	if err := env.Printf("X509-SVID written to %s\n", svidPath); err != nil {
-		return err
-	}
-
 	if err := ioutil.WriteFile(keyPath, keyPEM.Bytes(), 0600); err != nil {
 		return fmt.Errorf("unable to write key: %v", err)
 	}
-	if err := env.Printf("Private key written to %s\n", keyPath); err != nil {
-		return err
-	}
-
 	if err := ioutil.WriteFile(bundlePath, bundlePEM.Bytes(), 0644); err != nil { // nolint: gosec // expected permission
 		return fmt.Errorf("unable to write bundle: %v", err)
 	}
+
+	if env.JSONOutput() {
+		return env.PrintJSON(writtenFiles{
+			X509SVIDPath:   svidPath,
+			PrivateKeyPath: keyPath,
+			RootCAsPath:    bundlePath,
+		})
+	}
+	if err := env.Printf("X509-SVID written to %s\n", svidPath); err != nil {
+		return err
+	}
+	if err := env.Printf("Private key written to %s\n", keyPath); err != nil {
+		return err
+	}
 	if err := env.Printf("Root CAs written to %s\n", bundlePath); err != nil {
 		return err
 	}
@@ -34,6 +34,8 @@ const (
 	expectedUsage = `Usage of x509 mint:
   -dns value
     	DNS name that will be included in SVID. Can be used more than once.
+  -output format
+    	Desired format of the output (pretty, json) (default pretty)
   -registrationUDSPath string
     	Registration API UDS path (default "/tmp/spire-registration.sock")
   -spiffeID string
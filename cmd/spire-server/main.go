@@ -1,11 +1,22 @@
 package main
 
 import (
+	"context"
 	"os"
 
 	"github.com/spiffe/spire/cmd/spire-server/cli"
+	"github.com/spiffe/spire/pkg/common/log"
+	"github.com/spiffe/spire/pkg/common/winsvc"
 )
 
 func main() {
-	os.Exit(new(cli.CLI).Run(os.Args[1:]))
+	os.Exit(winsvc.Run(cli.ServiceName, func(ctx context.Context) int {
+		c := &cli.CLI{Context: ctx}
+		if winsvc.IsService() {
+			// The output of services is discarded, so the logs are also
+			// written to the event log
+			c.LogOptions = []log.Option{winsvc.WithEventLog(cli.ServiceName)}
+		}
+		return c.Run(os.Args[1:])
+	}))
 }
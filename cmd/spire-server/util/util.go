@@ -10,8 +10,10 @@ import (
 	"github.com/spiffe/spire/proto/spire/api/registration"
 	"github.com/spiffe/spire/proto/spire/api/server/agent/v1"
 	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
+	"github.com/spiffe/spire/proto/spire/api/server/debug/v1"
 	"github.com/spiffe/spire/proto/spire/api/server/entry/v1"
 	"github.com/spiffe/spire/proto/spire/api/server/svid/v1"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
 	"google.golang.org/grpc"
 )
 
@@ -47,8 +49,10 @@ type ServerClient interface {
 	Release()
 	NewAgentClient() agent.AgentClient
 	NewBundleClient() bundle.BundleClient
+	NewDebugClient() debug.DebugClient
 	NewEntryClient() entry.EntryClient
 	NewSVIDClient() svid.SVIDClient
+	NewTrustDomainClient() trustdomain.TrustDomainClient
 }
 
 func NewServerClient(socketPath string) (ServerClient, error) {
@@ -75,6 +79,10 @@ func (c *serverClient) NewBundleClient() bundle.BundleClient {
 	return bundle.NewBundleClient(c.conn)
 }
 
+func (c *serverClient) NewDebugClient() debug.DebugClient {
+	return debug.NewDebugClient(c.conn)
+}
+
 func (c *serverClient) NewEntryClient() entry.EntryClient {
 	return entry.NewEntryClient(c.conn)
 }
@@ -83,6 +91,10 @@ func (c *serverClient) NewSVIDClient() svid.SVIDClient {
 	return svid.NewSVIDClient(c.conn)
 }
 
+func (c *serverClient) NewTrustDomainClient() trustdomain.TrustDomainClient {
+	return trustdomain.NewTrustDomainClient(c.conn)
+}
+
 // Pluralizer concatenates `singular` to `msg` when `val` is one, and
 // `plural` on all other occasions. It is meant to facilitate friendlier
 // CLI output.
@@ -112,6 +124,7 @@ type Adapter struct {
 
 	flags               *flag.FlagSet
 	registrationUDSPath string
+	output              common_cli.OutputFlag
 }
 
 // AdaptCommand converts a command into one conforming to the Command interface from github.com/mitchellh/cli
@@ -124,6 +137,7 @@ func AdaptCommand(env *common_cli.Env, cmd Command) *Adapter {
 	f := flag.NewFlagSet(cmd.Name(), flag.ContinueOnError)
 	f.SetOutput(env.Stderr)
 	f.StringVar(&a.registrationUDSPath, "registrationUDSPath", DefaultSocketPath, "Registration API UDS path")
+	common_cli.AddOutputFlag(f, &a.output)
 	a.cmd.AppendFlags(f)
 	a.flags = f
 
@@ -145,7 +159,7 @@ func (a *Adapter) Run(args []string) int {
 	}
 	defer client.Release()
 
-	if err := a.cmd.Run(ctx, a.env, client); err != nil {
+	if err := a.cmd.Run(ctx, a.env.WithOutput(a.output), client); err != nil {
 		fmt.Fprintln(a.env.Stderr, "Error: "+err.Error())
 		return 1
 	}
@@ -171,5 +171,5 @@ func (p *Plugin) loadAttestationData(ctx context.Context, config *Config) ([]byt
 // BuiltIn() function in this package to load the plugin and this function can
 // be removed.
 func main() {
-	catalog.PluginMain(BuiltIn())
+	nodeattestor.Serve(pluginName, New())
 }
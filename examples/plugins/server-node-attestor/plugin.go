@@ -187,5 +187,5 @@ func (p *Plugin) attest(ctx context.Context, config *Config, stream nodeattestor
 // BuiltIn() function in this package to load the plugin and this function can
 // be removed.
 func main() {
-	catalog.PluginMain(BuiltIn())
+	nodeattestor.Serve(pluginName, New())
 }
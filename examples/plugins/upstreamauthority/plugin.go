@@ -155,5 +155,5 @@ func (p *Plugin) setConfig(c *Config) {
 // the BuiltIn() function in this package to load the plugin and this function
 // can be removed.
 func main() {
-	catalog.PluginMain(BuiltIn())
+	upstreamauthority.Serve(pluginName, New())
 }
@@ -2,6 +2,7 @@ package agent
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof" //nolint: gosec // import registers routes on DefaultServeMux
@@ -17,13 +18,17 @@ import (
 	workload_attestor "github.com/spiffe/spire/pkg/agent/attestor/workload"
 	"github.com/spiffe/spire/pkg/agent/catalog"
 	"github.com/spiffe/spire/pkg/agent/endpoints"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/manager"
+	"github.com/spiffe/spire/pkg/agent/svid/store"
 	common_catalog "github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/fips"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/hostservices/metricsservice"
 	common_services "github.com/spiffe/spire/pkg/common/plugin/hostservices"
 	"github.com/spiffe/spire/pkg/common/profiling"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/common/tracing"
 	"github.com/spiffe/spire/pkg/common/uptime"
 	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/proto/spire/api/server/agent/v1"
@@ -34,12 +39,28 @@ import (
 
 type Agent struct {
 	c *Config
+
+	// healthChecks is the health checker of the running agent. It is nil
+	// while the agent is not running.
+	healthMtx    sync.RWMutex
+	healthChecks *health.Checker
+
+	// catalog holds the plugins of the running agent, which can be
+	// reconfigured while the agent is running. It is nil while the agent is
+	// not running.
+	reloadMtx sync.Mutex
+	catalog   *catalog.Repository
 }
 
 // Run the agent
 // This method initializes the agent, including its plugins,
 // and then blocks on the main event loop.
 func (a *Agent) Run(ctx context.Context) error {
+	if err := fips.Require(a.c.RequireFIPS); err != nil {
+		return err
+	}
+	a.c.Log.WithField(telemetry.FIPSEnabled, fips.Enabled()).Info("FIPS 140 mode status")
+
 	a.c.Log.Infof("Starting agent with data directory: %q", a.c.DataDir)
 	if err := os.MkdirAll(a.c.DataDir, 0755); err != nil {
 		return err
@@ -67,12 +88,26 @@ func (a *Agent) Run(ctx context.Context) error {
 
 	telemetry.EmitVersion(metrics)
 
+	tracingLog := a.c.Log.WithField(telemetry.SubsystemName, telemetry.Telemetry)
+	stopTracing, err := tracing.Setup(ctx, a.c.Telemetry.Tracing, telemetry.SpireAgent, tracingLog)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The run context is done by now, so flush with a fresh one
+		if err := stopTracing(context.Background()); err != nil {
+			tracingLog.WithError(err).Warn("Failed to stop tracing")
+		}
+	}()
+
 	cat, err := catalog.Load(ctx, catalog.Config{
 		Log: a.c.Log.WithField(telemetry.SubsystemName, telemetry.Catalog),
 		GlobalConfig: &catalog.GlobalConfig{
 			TrustDomain: a.c.TrustDomain.Host,
 		},
-		PluginConfig: a.c.PluginConfigs,
+		PluginConfig:           a.c.PluginConfigs,
+		BuiltIns:               a.c.BuiltInPlugins,
+		RequirePluginChecksums: a.c.RequirePluginChecksums,
 		HostServices: []common_catalog.HostServiceServer{
 			common_services.MetricsServiceHostServiceServer(metricsService),
 		},
@@ -83,6 +118,9 @@ func (a *Agent) Run(ctx context.Context) error {
 	}
 	defer cat.Close()
 
+	a.setCatalog(cat)
+	defer a.setCatalog(nil)
+
 	healthChecks := health.NewChecker(a.c.HealthChecks, a.c.Log)
 
 	as, err := a.attest(ctx, cat, metrics)
@@ -95,21 +133,36 @@ func (a *Agent) Run(ctx context.Context) error {
 		return err
 	}
 
-	endpoints := a.newEndpoints(cat, metrics, manager)
+	conns := conntrack.New(nil)
+	endpoints := a.newEndpoints(cat, metrics, manager, conns)
 
 	if err := healthChecks.AddCheck("agent", a, time.Minute); err != nil {
 		return fmt.Errorf("failed adding healthcheck: %v", err)
 	}
+	a.setHealthChecks(healthChecks)
+	defer a.setHealthChecks(nil)
 
 	tasks := []func(context.Context) error{
 		manager.Run,
 		endpoints.ListenAndServe,
 		metrics.ListenAndServe,
+		func(ctx context.Context) error {
+			return uptime.ReportMetrics(ctx, metrics)
+		},
 		healthChecks.ListenAndServe,
 	}
 
+	if svidStores := cat.GetSVIDStores(); len(svidStores) > 0 {
+		storeService := store.New(&store.Config{
+			Log:     a.c.Log.WithField(telemetry.SubsystemName, telemetry.SVIDStore),
+			Manager: manager,
+			Stores:  svidStores,
+		})
+		tasks = append(tasks, storeService.Run)
+	}
+
 	if a.c.AdminBindAddress != nil {
-		adminEndpoints, err := a.newAdminEndpoints(manager)
+		adminEndpoints, err := a.newAdminEndpoints(manager, conns)
 		if err != nil {
 			return fmt.Errorf("failed to create debug endpoints: %v", err)
 		}
@@ -123,6 +176,44 @@ func (a *Agent) Run(ctx context.Context) error {
 	return err
 }
 
+// Healthy returns true if the agent is running and its health checks are
+// passing. It can be used by applications embedding the agent to monitor it
+// without enabling the health check listener.
+func (a *Agent) Healthy() bool {
+	a.healthMtx.RLock()
+	defer a.healthMtx.RUnlock()
+	return a.healthChecks != nil && !a.healthChecks.Failed()
+}
+
+// Reload applies the plugin configuration of the given configuration while
+// the agent is running. Only the plugin_data of the loaded plugins can be
+// changed; changes to the rest of the settings require a restart.
+func (a *Agent) Reload(ctx context.Context, config *Config) error {
+	a.reloadMtx.Lock()
+	defer a.reloadMtx.Unlock()
+
+	if a.catalog == nil {
+		return errors.New("agent is not running")
+	}
+
+	if err := a.catalog.Reconfigure(ctx, config.PluginConfigs); err != nil {
+		return fmt.Errorf("unable to reconfigure plugins: %w", err)
+	}
+	return nil
+}
+
+func (a *Agent) setCatalog(cat *catalog.Repository) {
+	a.reloadMtx.Lock()
+	defer a.reloadMtx.Unlock()
+	a.catalog = cat
+}
+
+func (a *Agent) setHealthChecks(healthChecks *health.Checker) {
+	a.healthMtx.Lock()
+	defer a.healthMtx.Unlock()
+	a.healthChecks = healthChecks
+}
+
 func (a *Agent) setupProfiling(ctx context.Context) (stop func()) {
 	var wg sync.WaitGroup
 	ctx, cancel := context.WithCancel(ctx)
@@ -163,6 +254,7 @@ func (a *Agent) setupProfiling(ctx context.Context) (stop func()) {
 			DebugLevel:             0,
 			RunGCBeforeHeapProfile: true,
 			Profiles:               a.c.ProfilingNames,
+			Dir:                    a.c.ProfilingDir,
 		}
 		wg.Add(1)
 		go func() {
@@ -205,11 +297,15 @@ func (a *Agent) newManager(ctx context.Context, cat catalog.Catalog, metrics tel
 		Catalog:         cat,
 		TrustDomain:     a.c.TrustDomain,
 		ServerAddr:      a.c.ServerAddress,
+		ServerKeepalive: a.c.ServerKeepalive,
 		Log:             a.c.Log.WithField(telemetry.SubsystemName, telemetry.Manager),
 		Metrics:         metrics,
 		BundleCachePath: a.bundleCachePath(),
 		SVIDCachePath:   a.agentSVIDPath(),
 		SyncInterval:    a.c.SyncInterval,
+
+		ReuseWorkloadKeys: a.c.ReuseWorkloadKeys,
+		RotationHooks:     a.c.RotationHooks,
 	}
 
 	mgr := manager.New(config)
@@ -220,9 +316,10 @@ func (a *Agent) newManager(ctx context.Context, cat catalog.Catalog, metrics tel
 	return mgr, nil
 }
 
-func (a *Agent) newEndpoints(cat catalog.Catalog, metrics telemetry.Metrics, mgr manager.Manager) endpoints.Server {
+func (a *Agent) newEndpoints(cat catalog.Catalog, metrics telemetry.Metrics, mgr manager.Manager, conns *conntrack.Tracker) endpoints.Server {
 	return endpoints.New(endpoints.Config{
-		BindAddr: a.c.BindAddress,
+		BindAddr:        a.c.BindAddress,
+		BindPermissions: a.c.BindPermissions,
 		Attestor: workload_attestor.New(&workload_attestor.Config{
 			Catalog: cat,
 			Log:     a.c.Log.WithField(telemetry.SubsystemName, telemetry.WorkloadAttestor),
@@ -230,13 +327,22 @@ func (a *Agent) newEndpoints(cat catalog.Catalog, metrics telemetry.Metrics, mgr
 		}),
 		Manager:           mgr,
 		Log:               a.c.Log.WithField(telemetry.SubsystemName, telemetry.Endpoints),
+		AuditLog:          a.c.AuditLog,
 		Metrics:           metrics,
 		DefaultSVIDName:   a.c.DefaultSVIDName,
 		DefaultBundleName: a.c.DefaultBundleName,
+
+		DefaultAllBundlesName:       a.c.DefaultAllBundlesName,
+		DisableSPIFFECertValidation: a.c.DisableSPIFFECertValidation,
+		MaxStreamsPerUID:            a.c.WorkloadAPIMaxStreamsPerUID,
+		MaxConnectionsPerUID:        a.c.WorkloadAPIMaxConnectionsPerUID,
+		ConnectionLimitsPerUID:      a.c.WorkloadAPIConnectionLimitsPerUID,
+		Connections:                 conns,
+		CallerMetrics:               a.c.WorkloadAPICallerMetrics,
 	})
 }
 
-func (a *Agent) newAdminEndpoints(mgr manager.Manager) (admin_api.Server, error) {
+func (a *Agent) newAdminEndpoints(mgr manager.Manager, conns *conntrack.Tracker) (admin_api.Server, error) {
 	td, err := spiffeid.TrustDomainFromURI(&a.c.TrustDomain)
 	if err != nil {
 		return nil, err
@@ -244,6 +350,7 @@ func (a *Agent) newAdminEndpoints(mgr manager.Manager) (admin_api.Server, error)
 	config := &admin_api.Config{
 		BindAddr:    a.c.AdminBindAddress,
 		Manager:     mgr,
+		Connections: conns,
 		Log:         a.c.Log.WithField(telemetry.SubsystemName, telemetry.DebugAPI),
 		TrustDomain: td,
 		Uptime:      uptime.Uptime,
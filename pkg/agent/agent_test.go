@@ -0,0 +1,75 @@
+package agent
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/hcl"
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/agent/catalog"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReloadWhenNotRunning(t *testing.T) {
+	a := New(&Config{})
+	err := a.Reload(context.Background(), &Config{})
+	require.EqualError(t, err, "agent is not running")
+}
+
+func TestReloadReconfiguresPlugins(t *testing.T) {
+	log, hook := test.NewNullLogger()
+
+	pluginConfig := func(data string) catalog.HCLPluginConfigMap {
+		var pluginConfig catalog.HCLPluginConfigMap
+		require.NoError(t, hcl.Decode(&pluginConfig, `
+			KeyManager "memory" {}
+			NodeAttestor "join_token" {}
+			WorkloadAttestor "unix" {
+				plugin_data {`+data+`}
+			}
+		`))
+		return pluginConfig
+	}
+
+	cat, err := catalog.Load(context.Background(), catalog.Config{
+		Log:          log,
+		GlobalConfig: &catalog.GlobalConfig{TrustDomain: "example.org"},
+		PluginConfig: pluginConfig(""),
+	})
+	require.NoError(t, err)
+	defer cat.Close()
+
+	a := New(&Config{})
+	a.setCatalog(cat)
+
+	reconfigured := func() int {
+		count := 0
+		for _, entry := range hook.AllEntries() {
+			if entry.Message == "Plugin reconfigured" {
+				count++
+			}
+		}
+		return count
+	}
+
+	// Plugins whose data did not change are not configured again
+	require.NoError(t, a.Reload(context.Background(), &Config{PluginConfigs: pluginConfig("")}))
+	require.Equal(t, 0, reconfigured())
+
+	// Plugins are configured again with the changed data
+	require.NoError(t, a.Reload(context.Background(), &Config{PluginConfigs: pluginConfig("discover_workload_path = true")}))
+	require.Equal(t, 1, reconfigured())
+	entry := hook.LastEntry()
+	require.Equal(t, logrus.InfoLevel, entry.Level)
+	require.Equal(t, "Plugin reconfigured", entry.Message)
+	require.Equal(t, "unix", entry.Data[telemetry.PluginName])
+	require.Equal(t, "WorkloadAttestor", entry.Data[telemetry.PluginType])
+
+	// Failures to configure are returned
+	err = a.Reload(context.Background(), &Config{PluginConfigs: pluginConfig(`workload_size_limit = "NOT-A-NUMBER"`)})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `unable to reconfigure plugins: unable to reconfigure plugin "unix"`)
+	require.Equal(t, 1, reconfigured())
+}
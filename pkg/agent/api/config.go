@@ -6,6 +6,7 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/manager"
 	"github.com/spiffe/spire/pkg/common/peertracker"
 )
@@ -15,6 +16,9 @@ type Config struct {
 
 	Manager manager.Manager
 
+	// Connections tracks the Workload API and SDS calls in progress
+	Connections *conntrack.Tracker
+
 	Log logrus.FieldLogger
 
 	// Agent trust domain
@@ -10,6 +10,7 @@ import (
 	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/manager"
 	"github.com/spiffe/spire/proto/spire/api/agent/debug/v1"
 	"github.com/spiffe/spire/proto/spire/types"
@@ -31,6 +32,7 @@ func RegisterService(s *grpc.Server, service *Service) {
 // Config configurations for debug service
 type Config struct {
 	Clock       clock.Clock
+	Connections *conntrack.Tracker
 	Log         logrus.FieldLogger
 	Manager     manager.Manager
 	TrustDomain spiffeid.TrustDomain
@@ -41,6 +43,7 @@ type Config struct {
 func New(config Config) *Service {
 	return &Service{
 		clock:  config.Clock,
+		conns:  config.Connections,
 		log:    config.Log,
 		m:      config.Manager,
 		td:     config.TrustDomain,
@@ -53,6 +56,7 @@ type Service struct {
 	debug.UnsafeDebugServer
 
 	clock  clock.Clock
+	conns  *conntrack.Tracker
 	log    logrus.FieldLogger
 	m      manager.Manager
 	td     spiffeid.TrustDomain
@@ -105,6 +109,34 @@ func (s *Service) GetInfo(ctx context.Context, req *debug.GetInfoRequest) (*debu
 	return s.getInfoResp.resp, nil
 }
 
+// ListConnections lists the Workload API and SDS calls in progress
+func (s *Service) ListConnections(ctx context.Context, req *debug.ListConnectionsRequest) (*debug.ListConnectionsResponse, error) {
+	resp := &debug.ListConnectionsResponse{}
+	if s.conns == nil {
+		return resp, nil
+	}
+
+	for _, conn := range s.conns.List() {
+		var selectors []*types.Selector
+		for _, selector := range conn.Selectors {
+			selectors = append(selectors, &types.Selector{
+				Type:  selector.Type,
+				Value: selector.Value,
+			})
+		}
+		resp.Connections = append(resp.Connections, &debug.ListConnectionsResponse_Connection{
+			Method:      conn.Method,
+			Pid:         conn.Caller.PID,
+			Uid:         conn.Caller.UID,
+			Gid:         conn.Caller.GID,
+			ConnectedAt: conn.ConnectedAt.Unix(),
+			Selectors:   selectors,
+			UpdatesSent: conn.UpdatesSent,
+		})
+	}
+	return resp, nil
+}
+
 // spiffeIDFromCert gets types SPIFFE ID from certificate, it can be nil
 func spiffeIDFromCert(cert *x509.Certificate) *types.SPIFFEID {
 	id, err := x509svid.IDFromCert(cert)
@@ -11,11 +11,14 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/agent/api/debug/v1"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/manager"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
 	"github.com/spiffe/spire/pkg/agent/svid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/peertracker"
 	debugpb "github.com/spiffe/spire/proto/spire/api/agent/debug/v1"
+	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/proto/spire/types"
 	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/spiretest"
@@ -23,6 +26,7 @@ import (
 	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
 )
 
 var (
@@ -208,11 +212,59 @@ func TestGetInfo(t *testing.T) {
 	}
 }
 
+func TestListConnections(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	resp, err := test.client.ListConnections(ctx, &debugpb.ListConnectionsRequest{})
+	require.NoError(t, err)
+	spiretest.RequireProtoEqual(t, &debugpb.ListConnectionsResponse{}, resp)
+
+	connectedAt := test.clk.Now()
+	callerCtx := peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: peertracker.AuthInfo{
+			Caller: peertracker.CallerInfo{PID: 1, UID: 1000, GID: 1001},
+		},
+	})
+	attestedCtx := test.conns.Track(callerCtx, "/SpiffeWorkloadAPI/FetchX509SVID")
+	conntrack.SetSelectors(attestedCtx, []*common.Selector{{Type: "unix", Value: "uid:1000"}})
+	conntrack.IncrUpdatesSent(attestedCtx)
+	unattestedCtx := test.conns.Track(ctx, "/SpiffeWorkloadAPI/FetchJWTSVID")
+
+	resp, err = test.client.ListConnections(ctx, &debugpb.ListConnectionsRequest{})
+	require.NoError(t, err)
+	spiretest.RequireProtoEqual(t, &debugpb.ListConnectionsResponse{
+		Connections: []*debugpb.ListConnectionsResponse_Connection{
+			{
+				Method:      "/SpiffeWorkloadAPI/FetchX509SVID",
+				Pid:         1,
+				Uid:         1000,
+				Gid:         1001,
+				ConnectedAt: connectedAt.Unix(),
+				Selectors:   []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				UpdatesSent: 1,
+			},
+			{
+				Method:      "/SpiffeWorkloadAPI/FetchJWTSVID",
+				ConnectedAt: connectedAt.Unix(),
+			},
+		},
+	}, resp)
+
+	test.conns.Untrack(attestedCtx)
+	test.conns.Untrack(unattestedCtx)
+
+	resp, err = test.client.ListConnections(ctx, &debugpb.ListConnectionsRequest{})
+	require.NoError(t, err)
+	spiretest.RequireProtoEqual(t, &debugpb.ListConnectionsResponse{}, resp)
+}
+
 type serviceTest struct {
 	client debugpb.DebugClient
 	done   func()
 
 	clk     *clock.Mock
+	conns   *conntrack.Tracker
 	logHook *test.Hook
 	m       *fakeManager
 	uptime  *fakeUptime
@@ -233,8 +285,11 @@ func setupServiceTest(t *testing.T) *serviceTest {
 		clk:   clk,
 	}
 
+	conns := conntrack.New(clk)
+
 	service := debug.New(debug.Config{
 		Clock:       clk,
+		Connections: conns,
 		Log:         log,
 		Manager:     manager,
 		TrustDomain: td,
@@ -243,6 +298,7 @@ func setupServiceTest(t *testing.T) *serviceTest {
 
 	test := &serviceTest{
 		clk:     clk,
+		conns:   conns,
 		logHook: logHook,
 		m:       manager,
 		uptime:  fakeUptime,
@@ -54,6 +54,7 @@ func (e *Endpoints) registerDebugAPI(server *grpc.Server) {
 	clk := clock.New()
 	service := debug.New(debug.Config{
 		Clock:       clk,
+		Connections: e.c.Connections,
 		Log:         e.c.Log.WithField(telemetry.SubsystemName, telemetry.DebugAPI),
 		Manager:     e.c.Manager,
 		Uptime:      e.c.Uptime,
@@ -27,7 +27,6 @@ import (
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/zeebo/errs"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/balancer/roundrobin"
 	"google.golang.org/grpc/credentials"
 )
 
@@ -330,9 +329,8 @@ func (a *attestor) serverConn(ctx context.Context, bundle *bundleutil.Bundle) (*
 		},
 	}
 
-	return grpc.DialContext(ctx, a.c.ServerAddress,
-		grpc.WithBalancerName(roundrobin.Name), //nolint:staticcheck
+	return grpc.DialContext(ctx, a.c.ServerAddress, append(client.TargetDialOptions(a.c.ServerAddress),
 		grpc.FailOnNonTempDialError(true),
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
-	)
+	)...)
 }
@@ -2,22 +2,32 @@ package catalog
 
 import (
 	"context"
+	"errors"
+	"fmt"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/agent/plugin/keymanager"
 	km_disk "github.com/spiffe/spire/pkg/agent/plugin/keymanager/disk"
+	km_keychain "github.com/spiffe/spire/pkg/agent/plugin/keymanager/keychain"
 	km_memory "github.com/spiffe/spire/pkg/agent/plugin/keymanager/memory"
 	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
 	na_aws_iid "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/aws"
+	na_aws_ecs "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/awsecs"
 	na_azure_msi "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/azure"
 	na_gcp_iit "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/gcp"
 	na_join_token "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/jointoken"
 	na_k8s_psat "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/k8s/psat"
 	na_k8s_sat "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/k8s/sat"
 	na_sshpop "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/sshpop"
+	na_vsphere "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/vsphere"
 	na_x509pop "github.com/spiffe/spire/pkg/agent/plugin/nodeattestor/x509pop"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	ss_aws_secretsmanager "github.com/spiffe/spire/pkg/agent/plugin/svidstore/awssecretsmanager"
+	ss_disk "github.com/spiffe/spire/pkg/agent/plugin/svidstore/disk"
+	ss_gcp_secretmanager "github.com/spiffe/spire/pkg/agent/plugin/svidstore/gcpsecretmanager"
 	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
 	wa_docker "github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/docker"
+	wa_ecs "github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/ecs"
 	wa_k8s "github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/k8s"
 	wa_unix "github.com/spiffe/spire/pkg/agent/plugin/workloadattestor/unix"
 	"github.com/spiffe/spire/pkg/common/catalog"
@@ -28,6 +38,7 @@ import (
 type Catalog interface {
 	GetKeyManager() KeyManager
 	GetNodeAttestor() NodeAttestor
+	GetSVIDStores() []SVIDStore
 	GetWorkloadAttestors() []WorkloadAttestor
 }
 
@@ -39,6 +50,7 @@ func KnownPlugins() []catalog.PluginClient {
 	return []catalog.PluginClient{
 		keymanager.PluginClient,
 		nodeattestor.PluginClient,
+		svidstore.PluginClient,
 		workloadattestor.PluginClient,
 	}
 }
@@ -50,8 +62,10 @@ func KnownServices() []catalog.ServiceClient {
 func BuiltIns() []catalog.Plugin {
 	return []catalog.Plugin{
 		km_disk.BuiltIn(),
+		km_keychain.BuiltIn(),
 		km_memory.BuiltIn(),
 		na_aws_iid.BuiltIn(),
+		na_aws_ecs.BuiltIn(),
 		na_join_token.BuiltIn(),
 		na_gcp_iit.BuiltIn(),
 		na_x509pop.BuiltIn(),
@@ -59,9 +73,14 @@ func BuiltIns() []catalog.Plugin {
 		na_azure_msi.BuiltIn(),
 		na_k8s_sat.BuiltIn(),
 		na_k8s_psat.BuiltIn(),
+		na_vsphere.BuiltIn(),
+		ss_aws_secretsmanager.BuiltIn(),
+		ss_disk.BuiltIn(),
+		ss_gcp_secretmanager.BuiltIn(),
 		wa_k8s.BuiltIn(),
 		wa_unix.BuiltIn(),
 		wa_docker.BuiltIn(),
+		wa_ecs.BuiltIn(),
 	}
 }
 
@@ -74,6 +93,11 @@ type NodeAttestor struct {
 	nodeattestor.NodeAttestor
 }
 
+type SVIDStore struct {
+	catalog.PluginInfo
+	svidstore.SVIDStore
+}
+
 type WorkloadAttestor struct {
 	catalog.PluginInfo
 	workloadattestor.WorkloadAttestor
@@ -82,6 +106,7 @@ type WorkloadAttestor struct {
 type Plugins struct {
 	KeyManager        KeyManager
 	NodeAttestor      NodeAttestor
+	SVIDStores        []SVIDStore
 	WorkloadAttestors []WorkloadAttestor `catalog:"min=1"`
 }
 
@@ -95,6 +120,10 @@ func (p *Plugins) GetNodeAttestor() NodeAttestor {
 	return p.NodeAttestor
 }
 
+func (p *Plugins) GetSVIDStores() []SVIDStore {
+	return p.SVIDStores
+}
+
 func (p *Plugins) GetWorkloadAttestors() []WorkloadAttestor {
 	return p.WorkloadAttestors
 }
@@ -105,11 +134,22 @@ type Config struct {
 	PluginConfig HCLPluginConfigMap
 	HostServices []catalog.HostServiceServer
 	Metrics      *telemetry.MetricsImpl
+
+	// BuiltIns are additional built-in plugins made available to the catalog
+	// alongside the ones shipped with SPIRE. They are loaded in-process and,
+	// like any other built-in, are only used if configured in PluginConfig.
+	BuiltIns []catalog.Plugin
+
+	// RequirePluginChecksums, if true, fails loading external plugins that
+	// do not have a checksum configured.
+	RequirePluginChecksums bool
 }
 
 type Repository struct {
 	Catalog
 	catalog.Closer
+
+	catalog catalog.Catalog
 }
 
 func Load(ctx context.Context, config Config) (*Repository, error) {
@@ -119,14 +159,15 @@ func Load(ctx context.Context, config Config) (*Repository, error) {
 	}
 
 	p := new(Plugins)
-	closer, err := catalog.Fill(ctx, catalog.Config{
-		Log:           config.Log,
-		GlobalConfig:  config.GlobalConfig,
-		PluginConfig:  pluginConfig,
-		KnownPlugins:  KnownPlugins(),
-		KnownServices: KnownServices(),
-		BuiltIns:      BuiltIns(),
-		HostServices:  config.HostServices,
+	cat, err := catalog.Fill(ctx, catalog.Config{
+		Log:              config.Log,
+		GlobalConfig:     config.GlobalConfig,
+		PluginConfig:     pluginConfig,
+		KnownPlugins:     KnownPlugins(),
+		KnownServices:    KnownServices(),
+		BuiltIns:         append(BuiltIns(), config.BuiltIns...),
+		RequireChecksums: config.RequirePluginChecksums,
+		HostServices:     config.HostServices,
 	}, p)
 	if err != nil {
 		return nil, err
@@ -136,6 +177,39 @@ func Load(ctx context.Context, config Config) (*Repository, error) {
 
 	return &Repository{
 		Catalog: p,
-		Closer:  closer,
+		Closer:  cat,
+		catalog: cat,
 	}, nil
 }
+
+// Reconfigure configures the loaded plugins with the given plugin
+// configuration. Only the plugins whose configuration data changed are
+// configured again.
+func (r *Repository) Reconfigure(ctx context.Context, pluginConfig HCLPluginConfigMap) error {
+	pluginConfigs, err := catalog.PluginConfigsFromHCL(pluginConfig)
+	if err != nil {
+		return err
+	}
+	return r.catalog.Reconfigure(ctx, pluginConfigs)
+}
+
+// Validate checks the plugin configuration without loading any plugin. All
+// of the problems found are returned.
+func Validate(pluginConfig HCLPluginConfigMap) []error {
+	var errs []error
+	if n := pluginConfig.EnabledCount(keymanager.Type); n != 1 {
+		errs = append(errs, fmt.Errorf("expecting exactly one KeyManager plugin; got %d", n))
+	}
+	if n := pluginConfig.EnabledCount(nodeattestor.Type); n != 1 {
+		errs = append(errs, fmt.Errorf("expecting exactly one NodeAttestor plugin; got %d", n))
+	}
+	if n := pluginConfig.EnabledCount(workloadattestor.Type); n < 1 {
+		errs = append(errs, errors.New("expecting at least one WorkloadAttestor plugin"))
+	}
+
+	pluginConfigs, err := catalog.PluginConfigsFromHCL(pluginConfig)
+	if err != nil {
+		return append(errs, err)
+	}
+	return append(errs, catalog.ValidatePluginConfigs(pluginConfigs, KnownPlugins(), BuiltIns())...)
+}
@@ -11,9 +11,11 @@ import (
 	"sync"
 	"time"
 
+	"github.com/andres-erbsen/clock"
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/proto/spire/api/node"
 	agentpb "github.com/spiffe/spire/proto/spire/api/server/agent/v1"
@@ -24,6 +26,7 @@ import (
 	"github.com/spiffe/spire/proto/spire/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/keepalive"
 	"google.golang.org/grpc/status"
 )
 
@@ -60,12 +63,21 @@ type Config struct {
 
 	// RotMtx is used to prevent the creation of new connections during SVID rotations
 	RotMtx *sync.RWMutex
+
+	// Keepalive holds the keepalive parameters of the connection to the
+	// server. If nil, keepalive pings are not sent.
+	Keepalive *keepalive.ClientParameters
+
+	// Metrics is used to report the clock skew between the agent and the
+	// server.
+	Metrics telemetry.Metrics
 }
 
 type client struct {
 	c           *Config
 	connections *nodeConn
 	m           sync.Mutex
+	clockSkew   *clockSkewChecker
 
 	// Constructor used for testing purposes.
 	createNewEntryClient  func(grpc.ClientConnInterface) entrypb.EntryClient
@@ -83,8 +95,17 @@ func New(c *Config) Client {
 }
 
 func newClient(c *Config) *client {
+	metrics := c.Metrics
+	if metrics == nil {
+		metrics = telemetry.Blackhole{}
+	}
 	return &client{
-		c:                     c,
+		c: c,
+		clockSkew: &clockSkewChecker{
+			log:     c.Log,
+			metrics: metrics,
+			clk:     clock.New(),
+		},
 		createNewEntryClient:  entrypb.NewEntryClient,
 		createNewBundleClient: bundlepb.NewBundleClient,
 		createNewSVIDClient:   svidpb.NewSVIDClient,
@@ -191,11 +212,20 @@ func (c *client) NewX509SVIDs(ctx context.Context, csrs map[string][]byte) (map[
 
 	svids := make(map[string]*node.X509SVID)
 	var params []*svidpb.NewX509SVIDParams
+	var entryIDs []string
 	for entryID, csr := range csrs {
+		// SVIDs for entries derived from a SPIFFE ID template are requested
+		// for the template entry. The server signs them for the SPIFFE ID in
+		// the CSR.
+		paramsEntryID := entryID
+		if templateEntryID, ok := idutil.TemplateEntryID(entryID); ok {
+			paramsEntryID = templateEntryID
+		}
 		params = append(params, &svidpb.NewX509SVIDParams{
-			EntryId: entryID,
+			EntryId: paramsEntryID,
 			Csr:     csr,
 		})
+		entryIDs = append(entryIDs, entryID)
 	}
 
 	protoSVIDs, err := c.fetchSVIDs(ctx, params)
@@ -204,7 +234,7 @@ func (c *client) NewX509SVIDs(ctx context.Context, csrs map[string][]byte) (map[
 	}
 
 	for i, s := range protoSVIDs {
-		entryID := params[i].EntryId
+		entryID := entryIDs[i]
 		if s == nil {
 			c.c.Log.WithField(telemetry.RegistrationID, entryID).Debug("Entry not found")
 			continue
@@ -297,7 +327,9 @@ func (c *client) dial(ctx context.Context) (*grpc.ClientConn, error) {
 			}
 			return agentCert
 		},
-		dialContext: c.dialContext,
+		Keepalive:        c.c.Keepalive,
+		UnaryInterceptor: c.clockSkew.unaryInterceptor,
+		dialContext:      c.dialContext,
 	})
 }
 
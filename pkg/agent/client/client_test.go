@@ -11,6 +11,7 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/proto/spire/api/node"
 	agentpb "github.com/spiffe/spire/proto/spire/api/server/agent/v1"
 	bundlepb "github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
@@ -286,6 +287,28 @@ func TestNewX509SVIDs(t *testing.T) {
 	assertConnectionIsNotNil(t, client)
 }
 
+func TestNewX509SVIDsForDerivedEntry(t *testing.T) {
+	client, tc := createClient()
+
+	// SVIDs for entries derived from a template are requested for the
+	// template entry, but returned keyed by the derived entry ID
+	derivedEntryID := idutil.DerivedEntryID("entry-id", "spiffe://example.org/ns/default")
+	tc.svidClient.x509SVIDs = map[string]*types.X509SVID{
+		"entry-id": {
+			Id:        &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/default"},
+			CertChain: [][]byte{{11, 22, 33}},
+		},
+	}
+
+	svids, err := client.NewX509SVIDs(context.Background(), map[string][]byte{
+		derivedEntryID: {1, 2, 3, 4},
+	})
+	require.NoError(t, err)
+	assert.Equal(t, map[string]*node.X509SVID{
+		derivedEntryID: {CertChain: []byte{11, 22, 33}},
+	}, svids)
+}
+
 func newTestCSRs() map[string][]byte {
 	return map[string][]byte{
 		"entry-id": {1, 2, 3, 4},
@@ -0,0 +1,74 @@
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	telemetry_agent "github.com/spiffe/spire/pkg/common/telemetry/agent"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// clockSkewThreshold is how far apart the clocks of the agent and the server
+// can be before a warning is logged. It matches the default backdate of the
+// certificates the server issues; beyond it, SVIDs fetched from the server
+// may not be valid yet according to the local clock.
+const clockSkewThreshold = 10 * time.Second
+
+// clockSkewChecker compares the time the server reports in the response
+// headers of each RPC against the local clock.
+type clockSkewChecker struct {
+	log     logrus.FieldLogger
+	metrics telemetry.Metrics
+	clk     clock.Clock
+
+	mu     sync.Mutex
+	skewed bool
+}
+
+func (c *clockSkewChecker) unaryInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	var header metadata.MD
+	sentAt := c.clk.Now()
+	err := invoker(ctx, method, req, reply, cc, append(opts, grpc.Header(&header))...)
+	c.check(header, sentAt, c.clk.Now())
+	return err
+}
+
+func (c *clockSkewChecker) check(header metadata.MD, sentAt, receivedAt time.Time) {
+	values := header.Get(middleware.ServerTimeKey)
+	if len(values) == 0 {
+		// Servers before the header was introduced do not send it
+		return
+	}
+	serverTime, err := time.Parse(time.RFC3339Nano, values[0])
+	if err != nil {
+		c.log.WithError(err).Debug("Unable to parse server time")
+		return
+	}
+
+	// The server time was taken at some point while the RPC was in flight,
+	// so it is compared against the middle of the round trip.
+	localTime := sentAt.Add(receivedAt.Sub(sentAt) / 2)
+	skew := serverTime.Sub(localTime)
+	telemetry_agent.SetClockSkewGauge(c.metrics, skew)
+
+	exceeded := skew > clockSkewThreshold || skew < -clockSkewThreshold
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	switch {
+	case exceeded && !c.skewed:
+		c.log.WithFields(logrus.Fields{
+			telemetry.ClockSkew: skew,
+			telemetry.Threshold: clockSkewThreshold,
+		}).Warn("Clock skew between agent and server exceeds threshold; SVIDs may be rejected as not yet valid or expired")
+	case !exceeded && c.skewed:
+		c.log.WithField(telemetry.ClockSkew, skew).Info("Clock skew between agent and server is back within threshold")
+	}
+	c.skewed = exceeded
+}
@@ -0,0 +1,76 @@
+package client
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestClockSkewChecker(t *testing.T) {
+	log, logHook := test.NewNullLogger()
+	metrics := fakemetrics.New()
+	clk := clock.NewMock(t)
+	checker := &clockSkewChecker{
+		log:     log,
+		metrics: metrics,
+		clk:     clk,
+	}
+
+	sentAt := clk.Now()
+	receivedAt := sentAt.Add(2 * time.Second)
+	serverTimeHeader := func(skew time.Duration) metadata.MD {
+		// The server time is compared against the middle of the round trip
+		serverTime := sentAt.Add(time.Second + skew)
+		return metadata.Pairs(middleware.ServerTimeKey, serverTime.Format(time.RFC3339Nano))
+	}
+
+	// Within the threshold, nothing is logged
+	checker.check(serverTimeHeader(time.Second), sentAt, receivedAt)
+	assert.Empty(t, logHook.AllEntries())
+
+	// Crossing the threshold logs a warning once
+	checker.check(serverTimeHeader(-time.Minute), sentAt, receivedAt)
+	checker.check(serverTimeHeader(-time.Minute), sentAt, receivedAt)
+	spiretest.AssertLogs(t, logHook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Clock skew between agent and server exceeds threshold; SVIDs may be rejected as not yet valid or expired",
+			Data: logrus.Fields{
+				telemetry.ClockSkew: "-1m0s",
+				telemetry.Threshold: "10s",
+			},
+		},
+	})
+	logHook.Reset()
+
+	// Going back within the threshold logs that the skew recovered
+	checker.check(serverTimeHeader(0), sentAt, receivedAt)
+	spiretest.AssertLogs(t, logHook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.InfoLevel,
+			Message: "Clock skew between agent and server is back within threshold",
+			Data: logrus.Fields{
+				telemetry.ClockSkew: "0s",
+			},
+		},
+	})
+
+	// Responses without the header, like those of older servers, are ignored
+	checker.check(metadata.MD{}, sentAt, receivedAt)
+
+	assert.Equal(t, []fakemetrics.MetricItem{
+		{Type: fakemetrics.SetGaugeType, Key: []string{telemetry.ClockSkew}, Val: 1},
+		{Type: fakemetrics.SetGaugeType, Key: []string{telemetry.ClockSkew}, Val: -60},
+		{Type: fakemetrics.SetGaugeType, Key: []string{telemetry.ClockSkew}, Val: -60},
+		{Type: fakemetrics.SetGaugeType, Key: []string{telemetry.ClockSkew}, Val: 0},
+	}, metrics.AllMetrics())
+}
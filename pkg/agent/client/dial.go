@@ -16,8 +16,8 @@ import (
 	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/balancer/roundrobin"
 	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
 )
 
 const (
@@ -39,6 +39,14 @@ type DialServerConfig struct {
 	// certificate to present to the server during the TLS handshake.
 	GetAgentCertificate func() *tls.Certificate
 
+	// Keepalive is an optional set of keepalive parameters for the
+	// connection. If nil, keepalive pings are not sent.
+	Keepalive *keepalive.ClientParameters
+
+	// UnaryInterceptor is an optional interceptor for the unary RPCs made
+	// over the connection.
+	UnaryInterceptor grpc.UnaryClientInterceptor
+
 	// dialContext is an optional constructor for the grpc client connection.
 	dialContext func(ctx context.Context, target string, opts ...grpc.DialOption) (*grpc.ClientConn, error)
 }
@@ -65,13 +73,19 @@ func DialServer(ctx context.Context, config DialServerConfig) (*grpc.ClientConn,
 	if config.dialContext == nil {
 		config.dialContext = grpc.DialContext
 	}
-	client, err := config.dialContext(ctx, config.Address,
-		grpc.WithBalancerName(roundrobin.Name), //nolint:staticcheck
+	opts := append(TargetDialOptions(config.Address),
 		grpc.FailOnNonTempDialError(true),
 		grpc.WithBlock(),
 		grpc.WithReturnConnectionError(),
 		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
 	)
+	if config.Keepalive != nil {
+		opts = append(opts, grpc.WithKeepaliveParams(*config.Keepalive))
+	}
+	if config.UnaryInterceptor != nil {
+		opts = append(opts, grpc.WithUnaryInterceptor(config.UnaryInterceptor))
+	}
+	client, err := config.dialContext(ctx, config.Address, opts...)
 	switch {
 	case err == nil:
 	case errors.Is(err, context.Canceled):
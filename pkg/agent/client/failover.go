@@ -0,0 +1,225 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"strings"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/balancer/roundrobin"
+	"google.golang.org/grpc/resolver"
+)
+
+const (
+	// FailoverScheme is the scheme of the targets that list several server
+	// addresses. The agent connects to the first server it can reach and
+	// stays connected to it until it becomes unreachable, and only then fails
+	// over to the next server.
+	FailoverScheme = "spire-failover"
+
+	// failoverLookupInterval is the minimum time between two lookups of the
+	// server addresses. It is also how often lookups are retried when no
+	// server address could be resolved.
+	failoverLookupInterval = 30 * time.Second
+)
+
+// FailoverTarget returns the target of a connection that fails over between
+// the given server addresses, in order. The addresses are host:port pairs,
+// where the host can be a DNS name that resolves to several servers.
+func FailoverTarget(addresses []string) string {
+	return FailoverScheme + ":///" + strings.Join(addresses, ",")
+}
+
+// TargetDialOptions returns the options needed to dial the given server
+// target. Failover targets connect to a single server at a time, preferring
+// the server the agent last connected to. Other targets spread the RPCs
+// between the resolved servers.
+func TargetDialOptions(target string) []grpc.DialOption {
+	if !strings.HasPrefix(target, FailoverScheme+":") {
+		return []grpc.DialOption{
+			grpc.WithBalancerName(roundrobin.Name), //nolint:staticcheck
+		}
+	}
+
+	sticky := new(stickyAddress)
+	return []grpc.DialOption{
+		grpc.WithBalancerName(grpc.PickFirstBalancerName), //nolint:staticcheck
+		grpc.WithResolvers(&failoverBuilder{
+			sticky:     sticky,
+			lookupHost: net.DefaultResolver.LookupHost,
+		}),
+		grpc.WithContextDialer(sticky.dialContext),
+	}
+}
+
+// stickyAddress remembers the last server address a connection was made to,
+// so that the agent reconnects to the same server first.
+type stickyAddress struct {
+	mu   sync.Mutex
+	addr string
+}
+
+func (s *stickyAddress) dialContext(ctx context.Context, addr string) (net.Conn, error) {
+	conn, err := new(net.Dialer).DialContext(ctx, "tcp", addr)
+	if err == nil {
+		s.mu.Lock()
+		s.addr = addr
+		s.mu.Unlock()
+	}
+	return conn, err
+}
+
+// order returns the addresses with the sticky address, if any, moved first.
+func (s *stickyAddress) order(addrs []string) []resolver.Address {
+	s.mu.Lock()
+	sticky := s.addr
+	s.mu.Unlock()
+
+	ordered := make([]resolver.Address, 0, len(addrs))
+	for _, addr := range addrs {
+		if addr == sticky {
+			ordered = append(ordered, resolver.Address{Addr: addr})
+		}
+	}
+	for _, addr := range addrs {
+		if addr != sticky {
+			ordered = append(ordered, resolver.Address{Addr: addr})
+		}
+	}
+	return ordered
+}
+
+type failoverBuilder struct {
+	sticky     *stickyAddress
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+}
+
+func (b *failoverBuilder) Build(target resolver.Target, cc resolver.ClientConn, opts resolver.BuildOptions) (resolver.Resolver, error) {
+	var addresses []string
+	for _, address := range strings.Split(target.Endpoint, ",") {
+		if _, _, err := net.SplitHostPort(address); err != nil {
+			return nil, fmt.Errorf("invalid server address %q: %w", address, err)
+		}
+		addresses = append(addresses, address)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	r := &failoverResolver{
+		cc:         cc,
+		addresses:  addresses,
+		sticky:     b.sticky,
+		lookupHost: b.lookupHost,
+		cancel:     cancel,
+		resolveNow: make(chan struct{}, 1),
+	}
+	r.wg.Add(1)
+	go r.run(ctx)
+	return r, nil
+}
+
+func (b *failoverBuilder) Scheme() string {
+	return FailoverScheme
+}
+
+// failoverResolver resolves the server addresses of a failover target, in
+// the order they were configured, with the sticky address first.
+type failoverResolver struct {
+	cc         resolver.ClientConn
+	addresses  []string
+	sticky     *stickyAddress
+	lookupHost func(ctx context.Context, host string) ([]string, error)
+
+	cancel     context.CancelFunc
+	resolveNow chan struct{}
+	wg         sync.WaitGroup
+}
+
+func (r *failoverResolver) ResolveNow(resolver.ResolveNowOptions) {
+	select {
+	case r.resolveNow <- struct{}{}:
+	default:
+	}
+}
+
+func (r *failoverResolver) Close() {
+	r.cancel()
+	r.wg.Wait()
+}
+
+func (r *failoverResolver) run(ctx context.Context) {
+	defer r.wg.Done()
+
+	var addrs []string
+	var lastLookup time.Time
+	for {
+		if lastLookup.IsZero() || time.Since(lastLookup) >= failoverLookupInterval {
+			resolved, err := r.lookup(ctx)
+			lastLookup = time.Now()
+			switch {
+			case err == nil:
+				addrs = resolved
+			case len(addrs) == 0:
+				r.cc.ReportError(err)
+			}
+		}
+
+		var retry <-chan time.Time
+		if len(addrs) > 0 {
+			// Addresses are pushed again even if the lookup was skipped, so
+			// that the sticky address is tried first on reconnection.
+			r.cc.UpdateState(resolver.State{Addresses: r.sticky.order(addrs)})
+		} else {
+			retry = time.After(failoverLookupInterval)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-r.resolveNow:
+		case <-retry:
+		}
+	}
+}
+
+// lookup resolves the server addresses into the addresses of the servers, in
+// order. Addresses that cannot be resolved are skipped, unless none can be.
+func (r *failoverResolver) lookup(ctx context.Context) ([]string, error) {
+	var addrs []string
+	var firstErr error
+	seen := make(map[string]bool)
+	for _, address := range r.addresses {
+		host, port, _ := net.SplitHostPort(address)
+
+		hosts := []string{host}
+		if net.ParseIP(host) == nil {
+			var err error
+			hosts, err = r.lookupHost(ctx, host)
+			if err != nil {
+				if firstErr == nil {
+					firstErr = err
+				}
+				continue
+			}
+		}
+
+		for _, host := range hosts {
+			addr := net.JoinHostPort(host, port)
+			if !seen[addr] {
+				seen[addr] = true
+				addrs = append(addrs, addr)
+			}
+		}
+	}
+	switch {
+	case len(addrs) > 0:
+	case firstErr != nil:
+		return nil, fmt.Errorf("unable to resolve any server address: %w", firstErr)
+	default:
+		return nil, errors.New("no server address resolved")
+	}
+	return addrs, nil
+}
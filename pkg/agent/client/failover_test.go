@@ -0,0 +1,178 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/resolver"
+)
+
+func TestFailoverTarget(t *testing.T) {
+	assert.Equal(t, "spire-failover:///10.0.0.1:8081,spire-server:8081", FailoverTarget([]string{"10.0.0.1:8081", "spire-server:8081"}))
+}
+
+func TestFailoverResolver(t *testing.T) {
+	sticky := new(stickyAddress)
+	builder := &failoverBuilder{
+		sticky: sticky,
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			switch host {
+			case "servers":
+				return []string{"10.0.0.2", "10.0.0.3"}, nil
+			case "same":
+				return []string{"10.0.0.1"}, nil
+			default:
+				return nil, errors.New("no such host")
+			}
+		},
+	}
+
+	cc := newFakeResolverClientConn()
+	r, err := builder.Build(resolver.Target{
+		Scheme:   FailoverScheme,
+		Endpoint: "10.0.0.1:8081,unknown:8081,servers:8081,same:8081",
+	}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	// Addresses are resolved in order, skipping the ones that cannot be
+	// resolved and the duplicates
+	assert.Equal(t, []resolver.Address{
+		{Addr: "10.0.0.1:8081"},
+		{Addr: "10.0.0.2:8081"},
+		{Addr: "10.0.0.3:8081"},
+	}, cc.nextState(t).Addresses)
+
+	// The sticky address is moved first
+	sticky.addr = "10.0.0.2:8081"
+	r.ResolveNow(resolver.ResolveNowOptions{})
+	assert.Equal(t, []resolver.Address{
+		{Addr: "10.0.0.2:8081"},
+		{Addr: "10.0.0.1:8081"},
+		{Addr: "10.0.0.3:8081"},
+	}, cc.nextState(t).Addresses)
+}
+
+func TestFailoverResolverReportsErrorWhenNothingResolves(t *testing.T) {
+	builder := &failoverBuilder{
+		sticky: new(stickyAddress),
+		lookupHost: func(ctx context.Context, host string) ([]string, error) {
+			return nil, errors.New("no such host")
+		},
+	}
+
+	cc := newFakeResolverClientConn()
+	r, err := builder.Build(resolver.Target{
+		Scheme:   FailoverScheme,
+		Endpoint: "unknown:8081",
+	}, cc, resolver.BuildOptions{})
+	require.NoError(t, err)
+	defer r.Close()
+
+	select {
+	case err := <-cc.errs:
+		assert.EqualError(t, err, "unable to resolve any server address: no such host")
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for resolver error")
+	}
+}
+
+func TestFailoverResolverRejectsInvalidAddress(t *testing.T) {
+	builder := &failoverBuilder{sticky: new(stickyAddress)}
+	_, err := builder.Build(resolver.Target{
+		Scheme:   FailoverScheme,
+		Endpoint: "10.0.0.1:8081,10.0.0.2",
+	}, newFakeResolverClientConn(), resolver.BuildOptions{})
+	assert.EqualError(t, err, `invalid server address "10.0.0.2": address 10.0.0.2: missing port in address`)
+}
+
+func TestFailoverDialing(t *testing.T) {
+	addrA, stopA := startHealthServer(t)
+	addrB, stopB := startHealthServer(t)
+	defer stopA()
+	defer stopB()
+
+	opts := append(TargetDialOptions(FailoverTarget([]string{addrA, addrB})), grpc.WithInsecure())
+	conn, err := grpc.Dial(FailoverTarget([]string{addrA, addrB}), opts...)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	// The first server is used while it is reachable
+	for i := 0; i < 3; i++ {
+		addr, err := checkHealth(conn)
+		require.NoError(t, err)
+		assert.Equal(t, addrA, addr)
+	}
+
+	// The connection fails over to the second server when the first one
+	// goes away. RPCs in flight on the closing transport may fail meanwhile.
+	stopA()
+	require.Eventually(t, func() bool {
+		addr, err := checkHealth(conn)
+		return err == nil && addr == addrB
+	}, time.Minute, 10*time.Millisecond)
+}
+
+type fakeResolverClientConn struct {
+	resolver.ClientConn
+	states chan resolver.State
+	errs   chan error
+}
+
+func newFakeResolverClientConn() *fakeResolverClientConn {
+	return &fakeResolverClientConn{
+		states: make(chan resolver.State, 10),
+		errs:   make(chan error, 10),
+	}
+}
+
+func (cc *fakeResolverClientConn) UpdateState(state resolver.State) {
+	cc.states <- state
+}
+
+func (cc *fakeResolverClientConn) ReportError(err error) {
+	cc.errs <- err
+}
+
+func (cc *fakeResolverClientConn) nextState(t *testing.T) resolver.State {
+	select {
+	case state := <-cc.states:
+		return state
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for resolver state")
+		return resolver.State{}
+	}
+}
+
+func startHealthServer(t *testing.T) (string, func()) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+
+	server := grpc.NewServer()
+	grpc_health_v1.RegisterHealthServer(server, health.NewServer())
+	go func() { _ = server.Serve(listener) }()
+
+	return listener.Addr().String(), server.Stop
+}
+
+// checkHealth makes an RPC over the connection and returns the address of the
+// server that handled it.
+func checkHealth(conn *grpc.ClientConn) (string, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	var p peer.Peer
+	if _, err := grpc_health_v1.NewHealthClient(conn).Check(ctx, &grpc_health_v1.HealthCheckRequest{}, grpc.WaitForReady(true), grpc.Peer(&p)); err != nil {
+		return "", err
+	}
+	return p.Addr.String(), nil
+}
@@ -7,15 +7,23 @@ import (
 	"time"
 
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/agent/manager/hooks"
 	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"google.golang.org/grpc/keepalive"
 )
 
 type Config struct {
 	// Address to bind the workload api to
 	BindAddress *net.UnixAddr
 
+	// File mode and ownership applied to the workload api socket. If nil,
+	// the socket is accessible to all users.
+	BindPermissions *diskutil.SocketPermissions
+
 	// Directory to store runtime data
 	DataDir string
 
@@ -25,9 +33,35 @@ type Config struct {
 	// The Validation Context resource name to use for the default X.509 bundle with Envoy SDS
 	DefaultBundleName string
 
+	// The Validation Context resource name to use for all bundles (including federated) with Envoy SDS
+	DefaultAllBundlesName string
+
 	// The TLS Certificate resource name to use for the default X509-SVID with Envoy SDS
 	DefaultSVIDName string
 
+	// If true, the Envoy SDS validation contexts won't use the SPIFFE
+	// certificate validator
+	DisableSPIFFECertValidation bool
+
+	// WorkloadAPIMaxStreamsPerUID is the maximum number of Workload API and
+	// SDS streams that callers running as the same UID can have open at
+	// once. If zero, streams are not limited.
+	WorkloadAPIMaxStreamsPerUID int
+
+	// WorkloadAPIMaxConnectionsPerUID is the maximum number of Workload API
+	// and SDS connections that callers running as the same UID can have open
+	// at once. If zero, connections are not limited.
+	WorkloadAPIMaxConnectionsPerUID int
+
+	// WorkloadAPIConnectionLimitsPerUID overrides
+	// WorkloadAPIMaxConnectionsPerUID for specific UIDs. A limit of zero
+	// means unlimited.
+	WorkloadAPIConnectionLimitsPerUID map[uint32]int
+
+	// WorkloadAPICallerMetrics, if true, emits Workload API metrics for each
+	// caller, labeled with the selectors of the caller.
+	WorkloadAPICallerMetrics bool
+
 	// If true, the agent will bootstrap insecurely with the server
 	InsecureBootstrap bool
 
@@ -37,14 +71,48 @@ type Config struct {
 	// Configurations for agent plugins
 	PluginConfigs catalog.HCLPluginConfigMap
 
+	// BuiltInPlugins are additional plugins that run in-process with the
+	// agent, alongside the built-in plugins shipped with SPIRE. This allows
+	// applications embedding the agent to provide their own plugins without
+	// an external plugin binary. They must still be enabled in PluginConfigs
+	// by name, without a plugin_cmd.
+	BuiltInPlugins []catalog.Plugin
+
+	// RequirePluginChecksums, if true, requires a checksum to be configured
+	// for every external plugin.
+	RequirePluginChecksums bool
+
+	// RequireFIPS, if true, fails startup unless the Go cryptographic
+	// libraries run in FIPS 140 mode.
+	RequireFIPS bool
+
+	// ReuseWorkloadKeys, if true, keeps the private key of workload SVIDs
+	// across renewals instead of generating a new key for each renewal.
+	ReuseWorkloadKeys bool
+
+	// RotationHooks are commands run when workload SVIDs or bundles rotate.
+	RotationHooks []hooks.Hook
+
 	Log logrus.FieldLogger
 
+	// AuditLog, if set, receives a record for each SVID delivered over the
+	// Workload API. It is kept apart from Log so audit records can be sent
+	// to their own destinations.
+	AuditLog logrus.FieldLogger
+
 	// Address of SPIRE server
 	ServerAddress string
 
+	// Keepalive parameters of the connection to the SPIRE server. If nil,
+	// keepalive pings are not sent.
+	ServerKeepalive *keepalive.ClientParameters
+
 	// SyncInterval controls how often the agent sync synchronizer waits
 	SyncInterval time.Duration
 
+	// FeatureFlags are the names of the feature flags to enable
+	FeatureFlags fflag.RawConfig
+
 	// Trust domain and associated CA bundle
 	TrustDomain url.URL
 	TrustBundle []*x509.Certificate
@@ -64,6 +132,9 @@ type Config struct {
 	// Array of profiles names that will be generated on each profiling tick.
 	ProfilingNames []string
 
+	// Directory the profiles generated on each profiling tick are written to.
+	ProfilingDir string
+
 	// Telemetry provides the configuration for metrics exporting
 	Telemetry telemetry.FileConfig
 }
@@ -0,0 +1,107 @@
+package endpoints
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	workloadAPITelemetry "github.com/spiffe/spire/pkg/common/telemetry/agent/workloadapi"
+	"github.com/spiffe/spire/proto/spire/common"
+	"google.golang.org/grpc"
+)
+
+// callerTracking tracks the calls in progress and, if callerMetrics is set,
+// emits the connections, attestation latency and updates of each caller,
+// labeled with the selectors of the caller. The selectors are only known once
+// the handler of the call has attested the caller (see peerTrackerAttestor),
+// so calls that fail before are only tracked.
+type callerTracking struct {
+	tracker       *conntrack.Tracker
+	metrics       telemetry.Metrics
+	callerMetrics bool
+
+	mu sync.Mutex
+	// connections is the number of calls in progress by selectors label
+	connections map[string]int
+}
+
+func newCallerTracking(tracker *conntrack.Tracker, metrics telemetry.Metrics, callerMetrics bool) *callerTracking {
+	return &callerTracking{
+		tracker:       tracker,
+		metrics:       metrics,
+		callerMetrics: callerMetrics,
+		connections:   make(map[string]int),
+	}
+}
+
+func (c *callerTracking) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
+	return c.tracker.Track(ctx, fullMethod), nil
+}
+
+func (c *callerTracking) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
+	if selectors, ok := conntrack.Selectors(ctx); ok && c.callerMetrics {
+		c.addConnections(selectorsLabel(selectors), -1)
+	}
+	c.tracker.Untrack(ctx)
+}
+
+// attested records the selectors of the caller of the call.
+func (c *callerTracking) attested(ctx context.Context, selectors []*common.Selector, start time.Time) {
+	if !conntrack.SetSelectors(ctx, selectors) || !c.callerMetrics {
+		return
+	}
+	label := selectorsLabel(selectors)
+	workloadAPITelemetry.MeasureCallerAttestation(c.metrics, label, start)
+	c.addConnections(label, 1)
+}
+
+func (c *callerTracking) addConnections(label string, delta int) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.connections[label] += delta
+	workloadAPITelemetry.SetCallerConnectionsGauge(c.metrics, label, c.connections[label])
+	if c.connections[label] <= 0 {
+		delete(c.connections, label)
+	}
+}
+
+// streamInterceptor counts the responses sent on streams. It must be chained
+// after the middleware interceptor so the stream context carries the
+// tracked call.
+func (c *callerTracking) streamInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+	return handler(srv, updateCountingStream{ServerStream: ss, c: c})
+}
+
+type updateCountingStream struct {
+	grpc.ServerStream
+	c *callerTracking
+}
+
+func (s updateCountingStream) SendMsg(m interface{}) error {
+	if err := s.ServerStream.SendMsg(m); err != nil {
+		return err
+	}
+
+	ctx := s.Context()
+	conntrack.IncrUpdatesSent(ctx)
+	if selectors, ok := conntrack.Selectors(ctx); ok && s.c.callerMetrics {
+		workloadAPITelemetry.IncrCallerUpdateCounter(s.c.metrics, selectorsLabel(selectors))
+	}
+	return nil
+}
+
+// selectorsLabel returns the metric label value for a set of selectors, e.g.
+// "k8s:ns:default,unix:uid:1000".
+func selectorsLabel(selectors []*common.Selector) string {
+	values := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		values = append(values, selector.Type+":"+selector.Value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
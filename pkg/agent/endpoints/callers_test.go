@@ -0,0 +1,120 @@
+package endpoints
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestCallerTracking(t *testing.T) {
+	selectors := []*common.Selector{
+		{Type: "unix", Value: "uid:1000"},
+		{Type: "k8s", Value: "ns:default"},
+	}
+	// Label values are sanitized by the sink
+	label := []telemetry.Label{{Name: telemetry.Selectors, Value: "k8s_ns_default_unix_uid_1000"}}
+
+	t.Run("without caller metrics", func(t *testing.T) {
+		metrics := fakemetrics.New()
+		tracker := conntrack.New(nil)
+		callers := newCallerTracking(tracker, metrics, false)
+
+		ctx, err := callers.Preprocess(WithFakeWatcher(true), "/Method")
+		require.NoError(t, err)
+		require.Len(t, tracker.List(), 1)
+
+		callers.attested(ctx, selectors, callers.tracker.List()[0].ConnectedAt)
+		assert.Equal(t, selectors, tracker.List()[0].Selectors)
+
+		callers.Postprocess(ctx, "/Method", true, nil)
+		assert.Empty(t, tracker.List())
+		assert.Empty(t, metrics.AllMetrics())
+	})
+
+	t.Run("with caller metrics", func(t *testing.T) {
+		metrics := fakemetrics.New()
+		tracker := conntrack.New(nil)
+		callers := newCallerTracking(tracker, metrics, true)
+
+		ctx1, err := callers.Preprocess(WithFakeWatcher(true), "/Method")
+		require.NoError(t, err)
+		ctx2, err := callers.Preprocess(WithFakeWatcher(true), "/Method")
+		require.NoError(t, err)
+
+		// Calls that fail before attestation only count while tracked
+		ctx3, err := callers.Preprocess(WithFakeWatcher(true), "/Method")
+		require.NoError(t, err)
+		callers.Postprocess(ctx3, "/Method", true, errors.New("oh no"))
+		assert.Empty(t, metrics.AllMetrics())
+
+		start := tracker.List()[0].ConnectedAt
+		callers.attested(ctx1, selectors, start)
+		callers.attested(ctx2, selectors, start)
+		// Attesting the same call again is not counted
+		callers.attested(ctx2, selectors, start)
+
+		stream := updateCountingStream{ServerStream: fakeServerStream{ctx: ctx1}, c: callers}
+		require.NoError(t, stream.SendMsg(nil))
+
+		callers.Postprocess(ctx1, "/Method", true, nil)
+		callers.Postprocess(ctx2, "/Method", true, nil)
+		assert.Empty(t, tracker.List())
+
+		assert.Equal(t, []fakemetrics.MetricItem{
+			{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.WorkloadAttestation}, Labels: label},
+			{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Connections}, Val: 1, Labels: label},
+			{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.WorkloadAttestation}, Labels: label},
+			{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Connections}, Val: 2, Labels: label},
+			{Type: fakemetrics.IncrCounterWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Update}, Val: 1, Labels: label},
+			{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Connections}, Val: 1, Labels: label},
+			{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Connections}, Val: 0, Labels: label},
+		}, metrics.AllMetrics())
+		assert.Empty(t, callers.connections)
+	})
+}
+
+func TestUpdateCountingStream(t *testing.T) {
+	tracker := conntrack.New(nil)
+	callers := newCallerTracking(tracker, fakemetrics.New(), false)
+
+	ctx := tracker.Track(context.Background(), "/Method")
+	stream := updateCountingStream{ServerStream: fakeServerStream{ctx: ctx}, c: callers}
+	require.NoError(t, stream.SendMsg(nil))
+	require.NoError(t, stream.SendMsg(nil))
+
+	failing := updateCountingStream{ServerStream: fakeServerStream{ctx: ctx, err: errors.New("oh no")}, c: callers}
+	require.EqualError(t, failing.SendMsg(nil), "oh no")
+
+	assert.Equal(t, int64(2), tracker.List()[0].UpdatesSent)
+}
+
+func TestSelectorsLabel(t *testing.T) {
+	assert.Equal(t, "", selectorsLabel(nil))
+	assert.Equal(t, "a:1,a:2,b:1", selectorsLabel([]*common.Selector{
+		{Type: "b", Value: "1"},
+		{Type: "a", Value: "2"},
+		{Type: "a", Value: "1"},
+	}))
+}
+
+type fakeServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	err error
+}
+
+func (s fakeServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s fakeServerStream) SendMsg(interface{}) error {
+	return s.err
+}
@@ -8,22 +8,32 @@ import (
 	"github.com/sirupsen/logrus"
 	workload_pb "github.com/spiffe/go-spiffe/v2/proto/spiffe/workload"
 	attestor "github.com/spiffe/spire/pkg/agent/attestor/workload"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/endpoints/sdsv2"
 	"github.com/spiffe/spire/pkg/agent/endpoints/sdsv3"
 	"github.com/spiffe/spire/pkg/agent/endpoints/workload"
 	"github.com/spiffe/spire/pkg/agent/manager"
+	"github.com/spiffe/spire/pkg/common/diskutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 )
 
 type Config struct {
 	BindAddr *net.UnixAddr
 
+	// BindPermissions are the file mode and ownership applied to the
+	// socket. If nil, the socket is accessible to all users.
+	BindPermissions *diskutil.SocketPermissions
+
 	Attestor attestor.Attestor
 
 	Manager manager.Manager
 
 	Log logrus.FieldLogger
 
+	// AuditLog, if set, receives a record for each SVID delivered over the
+	// Workload API
+	AuditLog logrus.FieldLogger
+
 	Metrics telemetry.Metrics
 
 	// The TLS Certificate resource name to use for the default X509-SVID with Envoy SDS
@@ -32,6 +42,35 @@ type Config struct {
 	// The Validation Context resource name to use for the default X.509 bundle with Envoy SDS
 	DefaultBundleName string
 
+	// The Validation Context resource name to use for all bundles (including federated) with Envoy SDS
+	DefaultAllBundlesName string
+
+	// If true, the Envoy SDS v3 validation contexts won't use the SPIFFE
+	// certificate validator
+	DisableSPIFFECertValidation bool
+
+	// MaxStreamsPerUID is the maximum number of streams that callers running
+	// as the same UID can have open at once. If zero, streams are not
+	// limited.
+	MaxStreamsPerUID int
+
+	// MaxConnectionsPerUID is the maximum number of connections that callers
+	// running as the same UID can have open at once. If zero, connections
+	// are not limited.
+	MaxConnectionsPerUID int
+
+	// ConnectionLimitsPerUID overrides MaxConnectionsPerUID for specific
+	// UIDs (e.g. those of system services). A limit of zero means unlimited.
+	ConnectionLimitsPerUID map[uint32]int
+
+	// Connections tracks the calls in progress. If nil, calls are tracked
+	// by a tracker of the endpoints only.
+	Connections *conntrack.Tracker
+
+	// CallerMetrics, if true, emits the connections, attestation latency
+	// and updates of each caller, labeled with the selectors of the caller.
+	CallerMetrics bool
+
 	// Hooks used by the unit tests to assert that the configuration provided
 	// to each handler is correct and return fake handlers.
 	newWorkloadAPIHandler func(workload.Config) workload_pb.SpiffeWorkloadAPIServer
@@ -0,0 +1,175 @@
+// Package conntrack keeps track of the Workload API and SDS calls in progress
+// and of the workloads that made them, so they can be listed for debugging.
+package conntrack
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/spiffe/spire/pkg/common/peertracker"
+	"github.com/spiffe/spire/proto/spire/common"
+)
+
+// Connection describes a call in progress.
+type Connection struct {
+	// ID identifies the call among the ones tracked by the tracker
+	ID uint64
+
+	// Method is the full gRPC method of the call
+	Method string
+
+	// Caller is the process that made the call. It is zero if the caller
+	// is unknown.
+	Caller peertracker.CallerInfo
+
+	// ConnectedAt is when the call started
+	ConnectedAt time.Time
+
+	// Selectors are the selectors of the caller. They are nil until the
+	// caller has been attested.
+	Selectors []*common.Selector
+
+	// UpdatesSent is the number of responses sent to the caller
+	UpdatesSent int64
+}
+
+// Tracker tracks the calls in progress.
+type Tracker struct {
+	clk clock.Clock
+
+	mu     sync.RWMutex
+	nextID uint64
+	conns  map[uint64]*conn
+}
+
+type conn struct {
+	// updatesSent is accessed atomically; it is kept first for alignment
+	updatesSent int64
+
+	id          uint64
+	method      string
+	caller      peertracker.CallerInfo
+	connectedAt time.Time
+
+	mu        sync.RWMutex
+	selectors []*common.Selector
+}
+
+type connKey struct{}
+
+// New returns a new tracker.
+func New(clk clock.Clock) *Tracker {
+	if clk == nil {
+		clk = clock.New()
+	}
+	return &Tracker{
+		clk:   clk,
+		conns: make(map[uint64]*conn),
+	}
+}
+
+// Track starts tracking a call. The returned context must be used for the
+// rest of the call, and passed to Untrack when the call is done.
+func (t *Tracker) Track(ctx context.Context, method string) context.Context {
+	caller, _ := peertracker.CallerFromContext(ctx)
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	t.nextID++
+	c := &conn{
+		id:          t.nextID,
+		method:      method,
+		caller:      caller,
+		connectedAt: t.clk.Now(),
+	}
+	t.conns[c.id] = c
+	return context.WithValue(ctx, connKey{}, c)
+}
+
+// Untrack stops tracking the call of the given context.
+func (t *Tracker) Untrack(ctx context.Context) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return
+	}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.conns, c.id)
+}
+
+// List returns the calls in progress, oldest first.
+func (t *Tracker) List() []Connection {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+
+	conns := make([]Connection, 0, len(t.conns))
+	for _, c := range t.conns {
+		conns = append(conns, Connection{
+			ID:          c.id,
+			Method:      c.method,
+			Caller:      c.caller,
+			ConnectedAt: c.connectedAt,
+			Selectors:   c.getSelectors(),
+			UpdatesSent: atomic.LoadInt64(&c.updatesSent),
+		})
+	}
+	sort.Slice(conns, func(i, j int) bool {
+		return conns[i].ID < conns[j].ID
+	})
+	return conns
+}
+
+// SetSelectors records the selectors of the caller of the call of the given
+// context, once it has been attested. It returns true if the call is tracked
+// and its caller had not been attested before.
+func SetSelectors(ctx context.Context, selectors []*common.Selector) bool {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return false
+	}
+	if selectors == nil {
+		selectors = []*common.Selector{}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	first := c.selectors == nil
+	c.selectors = selectors
+	return first
+}
+
+// Selectors returns the selectors of the caller of the call of the given
+// context. It returns false if the call is not tracked or the caller has not
+// been attested yet.
+func Selectors(ctx context.Context) ([]*common.Selector, bool) {
+	c, ok := fromContext(ctx)
+	if !ok {
+		return nil, false
+	}
+	selectors := c.getSelectors()
+	return selectors, selectors != nil
+}
+
+// IncrUpdatesSent counts a response sent on the call of the given context.
+func IncrUpdatesSent(ctx context.Context) {
+	if c, ok := fromContext(ctx); ok {
+		atomic.AddInt64(&c.updatesSent, 1)
+	}
+}
+
+func (c *conn) getSelectors() []*common.Selector {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.selectors
+}
+
+func fromContext(ctx context.Context) (*conn, bool) {
+	c, ok := ctx.Value(connKey{}).(*conn)
+	return c, ok
+}
@@ -0,0 +1,83 @@
+package conntrack
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spiffe/spire/pkg/common/peertracker"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/peer"
+)
+
+func TestTracker(t *testing.T) {
+	clk := clock.NewMock(t)
+	tracker := New(clk)
+	assert.Empty(t, tracker.List())
+
+	caller := peertracker.CallerInfo{PID: 1, UID: 2, GID: 3}
+	ctx1 := tracker.Track(withCaller(caller), "/Method1")
+	connectedAt1 := clk.Now()
+	clk.Add(time.Second)
+	ctx2 := tracker.Track(context.Background(), "/Method2")
+	connectedAt2 := clk.Now()
+
+	assert.Equal(t, []Connection{
+		{ID: 1, Method: "/Method1", Caller: caller, ConnectedAt: connectedAt1},
+		{ID: 2, Method: "/Method2", ConnectedAt: connectedAt2},
+	}, tracker.List())
+
+	// Selectors are only known once the caller is attested
+	_, ok := Selectors(ctx1)
+	assert.False(t, ok)
+
+	selectors := []*common.Selector{{Type: "unix", Value: "uid:2"}}
+	assert.True(t, SetSelectors(ctx1, selectors))
+	assert.False(t, SetSelectors(ctx1, selectors), "caller was already attested")
+	assert.True(t, SetSelectors(ctx2, nil))
+
+	got, ok := Selectors(ctx1)
+	require.True(t, ok)
+	assert.Equal(t, selectors, got)
+	got, ok = Selectors(ctx2)
+	require.True(t, ok)
+	assert.Empty(t, got)
+
+	IncrUpdatesSent(ctx1)
+	IncrUpdatesSent(ctx1)
+
+	assert.Equal(t, []Connection{
+		{ID: 1, Method: "/Method1", Caller: caller, ConnectedAt: connectedAt1, Selectors: selectors, UpdatesSent: 2},
+		{ID: 2, Method: "/Method2", ConnectedAt: connectedAt2, Selectors: []*common.Selector{}},
+	}, tracker.List())
+
+	tracker.Untrack(ctx1)
+	list := tracker.List()
+	require.Len(t, list, 1)
+	assert.Equal(t, uint64(2), list[0].ID)
+
+	tracker.Untrack(ctx2)
+	assert.Empty(t, tracker.List())
+}
+
+func TestUntrackedContext(t *testing.T) {
+	ctx := context.Background()
+	tracker := New(nil)
+
+	// None of these should panic or track anything
+	tracker.Untrack(ctx)
+	IncrUpdatesSent(ctx)
+	assert.False(t, SetSelectors(ctx, []*common.Selector{{Type: "unix", Value: "uid:0"}}))
+	_, ok := Selectors(ctx)
+	assert.False(t, ok)
+	assert.Empty(t, tracker.List())
+}
+
+func withCaller(caller peertracker.CallerInfo) context.Context {
+	return peer.NewContext(context.Background(), &peer.Peer{
+		AuthInfo: peertracker.AuthInfo{Caller: caller},
+	})
+}
@@ -10,31 +10,46 @@ import (
 	secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	"github.com/sirupsen/logrus"
 	workload_pb "github.com/spiffe/go-spiffe/v2/proto/spiffe/workload"
+	"github.com/spiffe/spire/pkg/agent/endpoints/conntrack"
 	"github.com/spiffe/spire/pkg/agent/endpoints/sdsv2"
 	"github.com/spiffe/spire/pkg/agent/endpoints/sdsv3"
 	"github.com/spiffe/spire/pkg/agent/endpoints/workload"
 	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/diskutil"
 	"github.com/spiffe/spire/pkg/common/peertracker"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 
 	"google.golang.org/grpc"
 )
 
+// DefaultSocketMode is the file mode of the Workload API socket unless
+// configured otherwise.
+const DefaultSocketMode = os.ModePerm
+
 type Server interface {
 	ListenAndServe(ctx context.Context) error
 }
 
 type Endpoints struct {
 	addr              *net.UnixAddr
+	permissions       *diskutil.SocketPermissions
 	log               logrus.FieldLogger
 	metrics           telemetry.Metrics
+	maxStreamsPerUID  int
+	maxConnsPerUID    int
+	connLimits        map[uint32]int
+	callers           *callerTracking
 	workloadAPIServer workload_pb.SpiffeWorkloadAPIServer
 	sdsv2Server       discovery_v2.SecretDiscoveryServiceServer
 	sdsv3Server       secret_v3.SecretDiscoveryServiceServer
 }
 
 func New(c Config) *Endpoints {
-	attestor := peerTrackerAttestor{Attestor: c.Attestor}
+	if c.Connections == nil {
+		c.Connections = conntrack.New(nil)
+	}
+	callers := newCallerTracking(c.Connections, c.Metrics, c.CallerMetrics)
+	attestor := peerTrackerAttestor{Attestor: c.Attestor, callers: callers}
 
 	if c.newWorkloadAPIHandler == nil {
 		c.newWorkloadAPIHandler = func(c workload.Config) workload_pb.SpiffeWorkloadAPIServer {
@@ -55,6 +70,7 @@ func New(c Config) *Endpoints {
 	workloadAPIServer := c.newWorkloadAPIHandler(workload.Config{
 		Manager:  c.Manager,
 		Attestor: attestor,
+		AuditLog: c.AuditLog,
 	})
 
 	sdsv2Server := c.newSDSv2Handler(sdsv2.Config{
@@ -65,16 +81,23 @@ func New(c Config) *Endpoints {
 	})
 
 	sdsv3Server := c.newSDSv3Handler(sdsv3.Config{
-		Attestor:          attestor,
-		Manager:           c.Manager,
-		DefaultSVIDName:   c.DefaultSVIDName,
-		DefaultBundleName: c.DefaultBundleName,
+		Attestor:                    attestor,
+		Manager:                     c.Manager,
+		DefaultSVIDName:             c.DefaultSVIDName,
+		DefaultBundleName:           c.DefaultBundleName,
+		DefaultAllBundlesName:       c.DefaultAllBundlesName,
+		DisableSPIFFECertValidation: c.DisableSPIFFECertValidation,
 	})
 
 	return &Endpoints{
 		addr:              c.BindAddr,
+		permissions:       c.BindPermissions,
 		log:               c.Log,
 		metrics:           c.Metrics,
+		maxStreamsPerUID:  c.MaxStreamsPerUID,
+		maxConnsPerUID:    c.MaxConnectionsPerUID,
+		connLimits:        c.ConnectionLimitsPerUID,
+		callers:           callers,
 		workloadAPIServer: workloadAPIServer,
 		sdsv2Server:       sdsv2Server,
 		sdsv3Server:       sdsv3Server,
@@ -82,14 +105,22 @@ func New(c Config) *Endpoints {
 }
 
 func (e *Endpoints) ListenAndServe(ctx context.Context) error {
-	unaryInterceptor, streamInterceptor := middleware.Interceptors(
-		Middleware(e.log, e.metrics),
-	)
+	m := Middleware(e.log, e.metrics)
 
 	server := grpc.NewServer(
 		grpc.Creds(peertracker.NewCredentials()),
-		grpc.UnaryInterceptor(unaryInterceptor),
-		grpc.StreamInterceptor(streamInterceptor),
+		grpc.UnaryInterceptor(middleware.UnaryInterceptor(middleware.Chain(
+			m,
+			e.callers,
+		))),
+		grpc.ChainStreamInterceptor(
+			middleware.StreamInterceptor(middleware.Chain(
+				m,
+				withStreamLimits(e.maxStreamsPerUID),
+				e.callers,
+			)),
+			e.callers.streamInterceptor,
+		),
 	)
 
 	workload_pb.RegisterSpiffeWorkloadAPIServer(server, e.workloadAPIServer)
@@ -101,6 +132,7 @@ func (e *Endpoints) ListenAndServe(ctx context.Context) error {
 		return err
 	}
 	defer l.Close()
+	l = withConnLimits(l, e.log, e.maxConnsPerUID, e.connLimits)
 
 	e.log.Info("Starting Workload and SDS APIs")
 	errChan := make(chan error)
@@ -132,7 +164,12 @@ func (e *Endpoints) createUDSListener() (net.Listener, error) {
 		return nil, fmt.Errorf("create UDS listener: %s", err)
 	}
 
-	if err := os.Chmod(e.addr.String(), os.ModePerm); err != nil {
+	permissions := e.permissions
+	if permissions == nil {
+		permissions = &diskutil.SocketPermissions{Mode: DefaultSocketMode, UID: -1, GID: -1}
+	}
+	if err := permissions.Apply(e.addr.String()); err != nil {
+		l.Close()
 		return nil, fmt.Errorf("unable to change UDS permissions: %v", err)
 	}
 	return l, nil
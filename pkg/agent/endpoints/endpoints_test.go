@@ -52,6 +52,11 @@ func TestEndpoints(t *testing.T) {
 				spiretest.AssertGRPCStatus(t, err, codes.InvalidArgument, "security header missing from request")
 			},
 			expectedMetrics: []fakemetrics.MetricItem{
+				// Calls in flight for the method
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+				}},
 				// Global connection counter and then the increment/decrement of the connection gauge
 				{Type: fakemetrics.IncrCounterType, Key: []string{"workload_api", "connection"}, Val: 1},
 				{Type: fakemetrics.SetGaugeType, Key: []string{"workload_api", "connections"}, Val: 1},
@@ -63,6 +68,21 @@ func TestEndpoints(t *testing.T) {
 				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "workload_api", "fetch_jwtsvid", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
 					{Name: "status", Value: "InvalidArgument"},
 				}},
+				// Call counter labeled with the method
+				{Type: fakemetrics.IncrCounterWithLabelsType, Key: []string{"rpc", "handled"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+					{Name: "status", Value: "InvalidArgument"},
+				}},
+				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "handled", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+					{Name: "status", Value: "InvalidArgument"},
+				}},
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+				}},
 			},
 		},
 		{
@@ -80,6 +100,11 @@ func TestEndpoints(t *testing.T) {
 				),
 			},
 			expectedMetrics: []fakemetrics.MetricItem{
+				// Calls in flight for the method
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+				}},
 				// Global connection counter and then the increment/decrement of the connection gauge
 				{Type: fakemetrics.IncrCounterType, Key: []string{"workload_api", "connection"}, Val: 1},
 				{Type: fakemetrics.SetGaugeType, Key: []string{"workload_api", "connections"}, Val: 1},
@@ -91,6 +116,21 @@ func TestEndpoints(t *testing.T) {
 				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "workload_api", "fetch_jwtsvid", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
 					{Name: "status", Value: "OK"},
 				}},
+				// Call counter labeled with the method
+				{Type: fakemetrics.IncrCounterWithLabelsType, Key: []string{"rpc", "handled"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "handled", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "WorkloadAPI"},
+					{Name: "method", Value: "FetchJWTSVID"},
+				}},
 			},
 		},
 		{
@@ -107,6 +147,11 @@ func TestEndpoints(t *testing.T) {
 				),
 			},
 			expectedMetrics: []fakemetrics.MetricItem{
+				// Calls in flight for the method
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v2"},
+					{Name: "method", Value: "FetchSecrets"},
+				}},
 				// Global connection counter and then the increment/decrement of the connection gauge
 				{Type: fakemetrics.IncrCounterType, Key: []string{"sds_api", "connection"}, Val: 1},
 				{Type: fakemetrics.SetGaugeType, Key: []string{"sds_api", "connections"}, Val: 1},
@@ -118,6 +163,21 @@ func TestEndpoints(t *testing.T) {
 				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "sds", "v2", "fetch_secrets", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
 					{Name: "status", Value: "OK"},
 				}},
+				// Call counter labeled with the method
+				{Type: fakemetrics.IncrCounterWithLabelsType, Key: []string{"rpc", "handled"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v2"},
+					{Name: "method", Value: "FetchSecrets"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "handled", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v2"},
+					{Name: "method", Value: "FetchSecrets"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v2"},
+					{Name: "method", Value: "FetchSecrets"},
+				}},
 			},
 		},
 		{
@@ -134,6 +194,11 @@ func TestEndpoints(t *testing.T) {
 				),
 			},
 			expectedMetrics: []fakemetrics.MetricItem{
+				// Calls in flight for the method
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v3"},
+					{Name: "method", Value: "FetchSecrets"},
+				}},
 				// Global connection counter and then the increment/decrement of the connection gauge
 				{Type: fakemetrics.IncrCounterType, Key: []string{"sds_api", "connection"}, Val: 1},
 				{Type: fakemetrics.SetGaugeType, Key: []string{"sds_api", "connections"}, Val: 1},
@@ -145,6 +210,21 @@ func TestEndpoints(t *testing.T) {
 				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "sds", "v3", "fetch_secrets", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
 					{Name: "status", Value: "OK"},
 				}},
+				// Call counter labeled with the method
+				{Type: fakemetrics.IncrCounterWithLabelsType, Key: []string{"rpc", "handled"}, Val: 1, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v3"},
+					{Name: "method", Value: "FetchSecrets"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.MeasureSinceWithLabelsType, Key: []string{"rpc", "handled", "elapsed_time"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v3"},
+					{Name: "method", Value: "FetchSecrets"},
+					{Name: "status", Value: "OK"},
+				}},
+				{Type: fakemetrics.SetGaugeWithLabelsType, Key: []string{"rpc", "in_flight"}, Val: 0, Labels: []metrics.Label{
+					{Name: "service", Value: "SDS_v3"},
+					{Name: "method", Value: "FetchSecrets"},
+				}},
 			},
 		},
 	} {
@@ -167,6 +247,9 @@ func TestEndpoints(t *testing.T) {
 				DefaultSVIDName:   "DefaultSVIDName",
 				DefaultBundleName: "DefaultBundleName",
 
+				DefaultAllBundlesName:       "DefaultAllBundlesName",
+				DisableSPIFFECertValidation: true,
+
 				// Assert the provided config and return a fake Workload API handler
 				newWorkloadAPIHandler: func(c workload.Config) workload_pb.SpiffeWorkloadAPIServer {
 					attestor, ok := c.Attestor.(peerTrackerAttestor)
@@ -192,6 +275,8 @@ func TestEndpoints(t *testing.T) {
 					assert.Equal(t, FakeManager{}, c.Manager)
 					assert.Equal(t, "DefaultSVIDName", c.DefaultSVIDName)
 					assert.Equal(t, "DefaultBundleName", c.DefaultBundleName)
+					assert.Equal(t, "DefaultAllBundlesName", c.DefaultAllBundlesName)
+					assert.True(t, c.DisableSPIFFECertValidation)
 					return FakeSDSv3Server{Attestor: attestor}
 				},
 			})
@@ -232,6 +317,75 @@ func TestEndpoints(t *testing.T) {
 	}
 }
 
+func TestEndpointsWithConnectionLimits(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	udsPath := filepath.Join(spiretest.TempDir(t), "agent.sock")
+	log, hook := test.NewNullLogger()
+	endpoints := New(Config{
+		BindAddr: &net.UnixAddr{
+			Net:  "unix",
+			Name: udsPath,
+		},
+		Log:                  log,
+		Metrics:              fakemetrics.New(),
+		Attestor:             FakeAttestor{},
+		Manager:              FakeManager{},
+		MaxConnectionsPerUID: 1,
+		newWorkloadAPIHandler: func(c workload.Config) workload_pb.SpiffeWorkloadAPIServer {
+			return FakeWorkloadAPIServer{Attestor: c.Attestor.(peerTrackerAttestor)}
+		},
+		newSDSv2Handler: func(c sdsv2.Config) discovery_v2.SecretDiscoveryServiceServer {
+			return FakeSDSv2Server{Attestor: c.Attestor.(peerTrackerAttestor)}
+		},
+		newSDSv3Handler: func(c sdsv3.Config) secret_v3.SecretDiscoveryServiceServer {
+			return FakeSDSv3Server{Attestor: c.Attestor.(peerTrackerAttestor)}
+		},
+	})
+
+	ctx, cancelServe := context.WithCancel(ctx)
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- endpoints.ListenAndServe(ctx)
+	}()
+	defer func() {
+		cancelServe()
+		assert.NoError(t, <-errCh)
+	}()
+
+	connectParams := grpc.ConnectParams{
+		Backoff: backoff.DefaultConfig,
+	}
+	connectParams.Backoff.BaseDelay = 5 * time.Millisecond
+	dial := func(opts ...grpc.DialOption) *grpc.ClientConn {
+		opts = append(opts, grpc.WithConnectParams(connectParams), grpc.WithInsecure())
+		conn, err := grpc.DialContext(ctx, "unix:///"+udsPath, opts...)
+		require.NoError(t, err)
+		return conn
+	}
+	fetchJWTSVID := func(conn *grpc.ClientConn) error {
+		ctx := metadata.NewOutgoingContext(ctx, metadata.Pairs("workload.spiffe.io", "true"))
+		_, err := workload_pb.NewSpiffeWorkloadAPIClient(conn).FetchJWTSVID(ctx, &workload_pb.JWTSVIDRequest{})
+		return err
+	}
+
+	// Connections within the limit are authenticated and served
+	conn1 := dial(grpc.WithReturnConnectionError())
+	defer conn1.Close()
+	require.NoError(t, fetchJWTSVID(conn1))
+	sdsClient := secret_v3.NewSecretDiscoveryServiceClient(conn1)
+	_, err := sdsClient.FetchSecrets(ctx, &discovery_v3.DiscoveryRequest{})
+	require.NoError(t, err)
+
+	// Connections over the limit are closed. The dial does not block since
+	// the connection never becomes ready.
+	conn2 := dial()
+	defer conn2.Close()
+	require.Equal(t, codes.Unavailable, status.Code(fetchJWTSVID(conn2)))
+	require.Equal(t, "Caller has too many open connections", hook.LastEntry().Message)
+}
+
 type FakeManager struct {
 	manager.Manager
 }
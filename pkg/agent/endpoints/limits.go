@@ -0,0 +1,174 @@
+package endpoints
+
+import (
+	"context"
+	"net"
+	"sync"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/api/rpccontext"
+	"github.com/spiffe/spire/pkg/common/peertracker"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// withStreamLimits limits the number of streams (e.g. FetchX509SVID or
+// StreamSecrets) that callers running as the same UID can have open at once.
+// Each stream holds a cache subscription and a goroutine for as long as the
+// caller keeps it open. Updates are coalesced by the subscription, so a slow
+// stream only ever holds the latest update, but nothing else stops a single
+// workload from opening enough streams to starve the others on the node.
+//
+// The middleware must only be installed on the stream interceptor. Streams
+// are not limited if maxPerUID is zero or if the caller is unknown.
+func withStreamLimits(maxPerUID int) middleware.Middleware {
+	return &streamLimits{
+		maxPerUID: maxPerUID,
+		streams:   make(map[uint32]int),
+	}
+}
+
+type streamLimits struct {
+	maxPerUID int
+
+	mu      sync.Mutex
+	streams map[uint32]int
+}
+
+type streamLimitKey struct{}
+
+func (l *streamLimits) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
+	if l.maxPerUID <= 0 {
+		return ctx, nil
+	}
+	caller, ok := peertracker.CallerFromContext(ctx)
+	if !ok {
+		return ctx, nil
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.streams[caller.UID] >= l.maxPerUID {
+		rpccontext.Logger(ctx).WithField(telemetry.CallerUID, caller.UID).Warn("Caller has too many open streams")
+		return nil, status.Errorf(codes.ResourceExhausted, "too many open streams; the limit is %d per user", l.maxPerUID)
+	}
+	l.streams[caller.UID]++
+
+	// Remember which UID the stream was counted against so Postprocess
+	// releases it even if the caller info is not available anymore.
+	return context.WithValue(ctx, streamLimitKey{}, caller.UID), nil
+}
+
+func (l *streamLimits) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
+	uid, ok := ctx.Value(streamLimitKey{}).(uint32)
+	if !ok {
+		return
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.streams[uid]--; l.streams[uid] <= 0 {
+		delete(l.streams, uid)
+	}
+}
+
+// withConnLimits limits the number of connections that callers running as
+// the same UID can have open at once. Every connection holds a file
+// descriptor in the agent (two on Linux, where the caller process is watched
+// through its /proc directory), so a compromised workload could otherwise exhaust the descriptors
+// of the agent and lock every other workload out of the Workload API.
+//
+// Connections over the limit are closed as soon as they are accepted. The
+// limit of the UIDs in overrides replaces maxPerUID; a limit of zero means
+// unlimited. The listener is returned as is if nothing is limited.
+func withConnLimits(l net.Listener, log logrus.FieldLogger, maxPerUID int, overrides map[uint32]int) net.Listener {
+	if maxPerUID <= 0 && len(overrides) == 0 {
+		return l
+	}
+	return &connLimitListener{
+		Listener:  l,
+		log:       log,
+		maxPerUID: maxPerUID,
+		overrides: overrides,
+		conns:     make(map[uint32]int),
+	}
+}
+
+type connLimitListener struct {
+	net.Listener
+	log       logrus.FieldLogger
+	maxPerUID int
+	overrides map[uint32]int
+
+	mu    sync.Mutex
+	conns map[uint32]int
+}
+
+func (l *connLimitListener) Accept() (net.Conn, error) {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			return nil, err
+		}
+
+		// Only connections tracked by the peertracker have a known caller
+		ptConn, ok := conn.(*peertracker.Conn)
+		if !ok {
+			return conn, nil
+		}
+
+		uid := ptConn.Info.Caller.UID
+		if !l.acquire(uid) {
+			l.log.WithField(telemetry.CallerUID, uid).Warn("Caller has too many open connections")
+			conn.Close()
+			continue
+		}
+		// The connection must remain a *peertracker.Conn, which the gRPC
+		// transport credentials require to authenticate the caller.
+		return &peertracker.Conn{
+			Conn: &limitedConn{Conn: ptConn.Conn, release: func() { l.release(uid) }},
+			Info: ptConn.Info,
+		}, nil
+	}
+}
+
+func (l *connLimitListener) limitFor(uid uint32) int {
+	if limit, ok := l.overrides[uid]; ok {
+		return limit
+	}
+	return l.maxPerUID
+}
+
+func (l *connLimitListener) acquire(uid uint32) bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if limit := l.limitFor(uid); limit > 0 && l.conns[uid] >= limit {
+		return false
+	}
+	l.conns[uid]++
+	return true
+}
+
+func (l *connLimitListener) release(uid uint32) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.conns[uid]--; l.conns[uid] <= 0 {
+		delete(l.conns, uid)
+	}
+}
+
+// limitedConn releases its slot in the connection limits the first time it
+// is closed.
+type limitedConn struct {
+	net.Conn
+	once    sync.Once
+	release func()
+}
+
+func (c *limitedConn) Close() error {
+	err := c.Conn.Close()
+	c.once.Do(c.release)
+	return err
+}
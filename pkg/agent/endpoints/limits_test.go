@@ -0,0 +1,169 @@
+package endpoints
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/api/rpccontext"
+	"github.com/spiffe/spire/pkg/common/peertracker"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/peer"
+)
+
+const fetchX509SVIDMethod = "/SpiffeWorkloadAPI/FetchX509SVID"
+
+func TestStreamLimits(t *testing.T) {
+	log, hook := test.NewNullLogger()
+	withCallerUID := func(uid uint32) context.Context {
+		return withCaller(log, uid)
+	}
+	m := withStreamLimits(2)
+
+	// Two streams are allowed for UID 1000
+	ctx1, err := m.Preprocess(withCallerUID(1000), fetchX509SVIDMethod)
+	require.NoError(t, err)
+	ctx2, err := m.Preprocess(withCallerUID(1000), fetchX509SVIDMethod)
+	require.NoError(t, err)
+
+	// The third one is not
+	_, err = m.Preprocess(withCallerUID(1000), fetchX509SVIDMethod)
+	spiretest.RequireGRPCStatus(t, err, codes.ResourceExhausted, "too many open streams; the limit is 2 per user")
+	spiretest.AssertLogs(t, hook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Caller has too many open streams",
+			Data:    logrus.Fields{"caller_uid": "1000"},
+		},
+	})
+
+	// Other users are counted on their own
+	ctx3, err := m.Preprocess(withCallerUID(1001), fetchX509SVIDMethod)
+	require.NoError(t, err)
+
+	// Callers that are not known are not limited
+	_, err = m.Preprocess(rpccontext.WithLogger(context.Background(), log), fetchX509SVIDMethod)
+	require.NoError(t, err)
+
+	// Closing a stream makes room for another one
+	m.Postprocess(ctx1, fetchX509SVIDMethod, true, nil)
+	ctx4, err := m.Preprocess(withCallerUID(1000), fetchX509SVIDMethod)
+	require.NoError(t, err)
+
+	for _, ctx := range []context.Context{ctx2, ctx3, ctx4} {
+		m.Postprocess(ctx, fetchX509SVIDMethod, true, nil)
+	}
+	require.Empty(t, m.(*streamLimits).streams)
+}
+
+func TestStreamLimitsDisabled(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	m := withStreamLimits(0)
+	for i := 0; i < 10; i++ {
+		_, err := m.Preprocess(withCaller(log, 1000), fetchX509SVIDMethod)
+		require.NoError(t, err)
+	}
+}
+
+func withCaller(log logrus.FieldLogger, uid uint32) context.Context {
+	ctx := rpccontext.WithLogger(context.Background(), log)
+	return peer.NewContext(ctx, &peer.Peer{
+		AuthInfo: peertracker.AuthInfo{
+			Caller: peertracker.CallerInfo{UID: uid},
+		},
+	})
+}
+
+func TestConnLimits(t *testing.T) {
+	log, hook := test.NewNullLogger()
+	fl := newFakeListener()
+	l := withConnLimits(fl, log, 1, map[uint32]int{0: 0, 1001: 2})
+
+	// UID 1000 gets the default limit of one connection
+	fl.push(1000)
+	conn1, err := l.Accept()
+	require.NoError(t, err)
+
+	// The second connection of UID 1000 is dropped; UID 1001 is allowed two
+	dropped := fl.push(1000)
+	fl.push(1001)
+	fl.push(1001)
+	conn2, err := l.Accept()
+	require.NoError(t, err)
+	require.Equal(t, uint32(1001), conn2.(*peertracker.Conn).Info.Caller.UID)
+	conn3, err := l.Accept()
+	require.NoError(t, err)
+	requireClosed(t, dropped)
+	spiretest.AssertLogs(t, hook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Caller has too many open connections",
+			Data:    logrus.Fields{"caller_uid": "1000"},
+		},
+	})
+
+	// Root is not limited
+	var rootConns []net.Conn
+	for i := 0; i < 3; i++ {
+		fl.push(0)
+		conn, err := l.Accept()
+		require.NoError(t, err)
+		rootConns = append(rootConns, conn)
+	}
+
+	// Closing a connection makes room for another one, even if closed twice
+	require.NoError(t, conn1.Close())
+	conn1.Close()
+	fl.push(1000)
+	conn4, err := l.Accept()
+	require.NoError(t, err)
+
+	for _, conn := range append(rootConns, conn2, conn3, conn4) {
+		require.NoError(t, conn.Close())
+	}
+	require.Empty(t, l.(*connLimitListener).conns)
+}
+
+func TestConnLimitsDisabled(t *testing.T) {
+	log, _ := test.NewNullLogger()
+	fl := newFakeListener()
+	require.Equal(t, fl, withConnLimits(fl, log, 0, nil))
+}
+
+type fakeListener struct {
+	net.Listener
+	conns chan net.Conn
+}
+
+func newFakeListener() *fakeListener {
+	return &fakeListener{
+		conns: make(chan net.Conn, 10),
+	}
+}
+
+// push queues a connection from a caller running as the given UID and
+// returns the client end of the connection.
+func (l *fakeListener) push(uid uint32) net.Conn {
+	server, client := net.Pipe()
+	l.conns <- &peertracker.Conn{
+		Conn: server,
+		Info: peertracker.AuthInfo{
+			Caller:  peertracker.CallerInfo{UID: uid},
+			Watcher: FakeWatcher(true),
+		},
+	}
+	return client
+}
+
+func (l *fakeListener) Accept() (net.Conn, error) {
+	return <-l.conns, nil
+}
+
+func requireClosed(t *testing.T, conn net.Conn) {
+	_, err := conn.Read(make([]byte, 1))
+	require.Error(t, err, "connection should have been closed")
+}
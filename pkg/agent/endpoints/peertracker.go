@@ -2,6 +2,7 @@ package endpoints
 
 import (
 	"context"
+	"time"
 
 	attestor "github.com/spiffe/spire/pkg/agent/attestor/workload"
 	"github.com/spiffe/spire/pkg/common/peertracker"
@@ -12,9 +13,14 @@ import (
 
 type peerTrackerAttestor struct {
 	Attestor attestor.Attestor
+
+	// callers, if set, records the selectors of the attested callers
+	callers *callerTracking
 }
 
 func (a peerTrackerAttestor) Attest(ctx context.Context) ([]*common.Selector, error) {
+	start := time.Now()
+
 	watcher, ok := peertracker.WatcherFromContext(ctx)
 	if !ok {
 		return nil, status.Error(codes.Internal, "peer tracker watcher missing from context")
@@ -28,5 +34,8 @@ func (a peerTrackerAttestor) Attest(ctx context.Context) ([]*common.Selector, er
 		return nil, status.Errorf(codes.Unauthenticated, "could not verify existence of the original caller: %v", err)
 	}
 
+	if a.callers != nil {
+		a.callers.attested(ctx, selectors, start)
+	}
 	return selectors, nil
 }
@@ -5,6 +5,7 @@ import (
 	"crypto/rand"
 	"encoding/hex"
 	"io"
+	"sort"
 	"strconv"
 
 	core_v3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
@@ -12,6 +13,7 @@ import (
 	discovery_v3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
 	secret_v3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
 	"github.com/spiffe/spire/pkg/common/api/rpccontext"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
@@ -24,6 +26,16 @@ import (
 	"google.golang.org/protobuf/types/known/anypb"
 )
 
+const (
+	// secretTypeURL is the type URL of the Envoy v3 secret resources served
+	// by the handler
+	secretTypeURL = "type.googleapis.com/envoy.extensions.transport_sockets.tls.v3.Secret"
+
+	// spiffeCertValidatorName is the name of the Envoy SPIFFE certificate
+	// validator extension
+	spiffeCertValidatorName = "envoy.tls.cert_validator.spiffe"
+)
+
 type Attestor interface {
 	Attest(ctx context.Context) ([]*common.Selector, error)
 }
@@ -38,6 +50,16 @@ type Config struct {
 	Manager           Manager
 	DefaultBundleName string
 	DefaultSVIDName   string
+
+	// DefaultAllBundlesName is the name of the validation context that
+	// contains the bundles of all trust domains, including federated ones.
+	// It is only served using the SPIFFE certificate validator.
+	DefaultAllBundlesName string
+
+	// DisableSPIFFECertValidation, if true, disables the use of the Envoy
+	// SPIFFE certificate validator in validation contexts. A flat list of
+	// trusted CAs is sent instead.
+	DisableSPIFFECertValidation bool
 }
 
 type Handler struct {
@@ -222,8 +244,19 @@ func (h *Handler) FetchSecrets(ctx context.Context, req *discovery_v3.DiscoveryR
 }
 
 func (h *Handler) buildResponse(versionInfo string, req *discovery_v3.DiscoveryRequest, upd *cache.WorkloadUpdate) (resp *discovery_v3.DiscoveryResponse, err error) {
+	// The type URL is optional on requests since it can be inferred from
+	// the service. If set, it must be the v3 secret type.
+	typeURL := req.TypeUrl
+	switch typeURL {
+	case "":
+		typeURL = secretTypeURL
+	case secretTypeURL:
+	default:
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resource type %q", req.TypeUrl)
+	}
+
 	resp = &discovery_v3.DiscoveryResponse{
-		TypeUrl:     req.TypeUrl,
+		TypeUrl:     typeURL,
 		VersionInfo: versionInfo,
 	}
 
@@ -242,17 +275,20 @@ func (h *Handler) buildResponse(versionInfo string, req *discovery_v3.DiscoveryR
 		}
 	}
 
-	// TODO: verify the type url
+	// Use the SPIFFE certificate validator when supported by Envoy so
+	// peers are validated against the roots of their own trust domain.
+	spiffeCertValidation := !h.c.DisableSPIFFECertValidation && supportsSPIFFECertValidator(req.Node)
+
 	if upd.Bundle != nil {
 		switch {
 		case len(names) == 0 || names[upd.Bundle.TrustDomainID()]:
-			validationContext, err := buildValidationContext(upd.Bundle, "")
+			validationContext, err := buildValidationContext(upd.Bundle.TrustDomainID(), upd.Bundle, spiffeCertValidation)
 			if err != nil {
 				return nil, err
 			}
 			resp.Resources = append(resp.Resources, validationContext)
 		case names[h.c.DefaultBundleName]:
-			validationContext, err := buildValidationContext(upd.Bundle, h.c.DefaultBundleName)
+			validationContext, err := buildValidationContext(h.c.DefaultBundleName, upd.Bundle, spiffeCertValidation)
 			if err != nil {
 				return nil, err
 			}
@@ -262,7 +298,7 @@ func (h *Handler) buildResponse(versionInfo string, req *discovery_v3.DiscoveryR
 
 	for _, federatedBundle := range upd.FederatedBundles {
 		if len(names) == 0 || names[federatedBundle.TrustDomainID()] {
-			validationContext, err := buildValidationContext(federatedBundle, "")
+			validationContext, err := buildValidationContext(federatedBundle.TrustDomainID(), federatedBundle, spiffeCertValidation)
 			if err != nil {
 				return nil, err
 			}
@@ -270,6 +306,21 @@ func (h *Handler) buildResponse(versionInfo string, req *discovery_v3.DiscoveryR
 		}
 	}
 
+	if upd.Bundle != nil && names[h.c.DefaultAllBundlesName] {
+		if !spiffeCertValidation {
+			return nil, status.Errorf(codes.InvalidArgument, "resource %q requires the SPIFFE certificate validator, which is disabled or not supported by the Envoy version", h.c.DefaultAllBundlesName)
+		}
+		bundles := []*bundleutil.Bundle{upd.Bundle}
+		for _, federatedBundle := range upd.FederatedBundles {
+			bundles = append(bundles, federatedBundle)
+		}
+		validationContext, err := buildSPIFFEValidationContext(h.c.DefaultAllBundlesName, bundles)
+		if err != nil {
+			return nil, err
+		}
+		resp.Resources = append(resp.Resources, validationContext)
+	}
+
 	for i, identity := range upd.Identities {
 		switch {
 		case len(names) == 0 || names[identity.Entry.SpiffeId]:
@@ -328,11 +379,11 @@ func buildTLSCertificate(identity cache.Identity, defaultSVIDName string) (*anyp
 	})
 }
 
-func buildValidationContext(bundle *bundleutil.Bundle, defaultBundleName string) (*anypb.Any, error) {
-	name := bundle.TrustDomainID()
-	if defaultBundleName != "" {
-		name = defaultBundleName
+func buildValidationContext(name string, bundle *bundleutil.Bundle, spiffeCertValidation bool) (*anypb.Any, error) {
+	if spiffeCertValidation {
+		return buildSPIFFEValidationContext(name, []*bundleutil.Bundle{bundle})
 	}
+
 	caBytes := pemutil.EncodeCertificates(bundle.RootCAs())
 	return anypb.New(&tls_v3.Secret{
 		Name: name,
@@ -348,6 +399,61 @@ func buildValidationContext(bundle *bundleutil.Bundle, defaultBundleName string)
 	})
 }
 
+// buildSPIFFEValidationContext builds a validation context that uses the
+// SPIFFE certificate validator configured with the roots of each of the given
+// bundles. Trust domains are sorted by name so the resource is stable across
+// responses.
+func buildSPIFFEValidationContext(name string, bundles []*bundleutil.Bundle) (*anypb.Any, error) {
+	trustDomains := make([]*tls_v3.SPIFFECertValidatorConfig_TrustDomain, 0, len(bundles))
+	for _, bundle := range bundles {
+		td, err := spiffeid.TrustDomainFromString(bundle.TrustDomainID())
+		if err != nil {
+			return nil, err
+		}
+		trustDomains = append(trustDomains, &tls_v3.SPIFFECertValidatorConfig_TrustDomain{
+			Name: td.String(),
+			TrustBundle: &core_v3.DataSource{
+				Specifier: &core_v3.DataSource_InlineBytes{
+					InlineBytes: pemutil.EncodeCertificates(bundle.RootCAs()),
+				},
+			},
+		})
+	}
+	sort.Slice(trustDomains, func(i, j int) bool {
+		return trustDomains[i].Name < trustDomains[j].Name
+	})
+
+	typedConfig, err := anypb.New(&tls_v3.SPIFFECertValidatorConfig{
+		TrustDomains: trustDomains,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return anypb.New(&tls_v3.Secret{
+		Name: name,
+		Type: &tls_v3.Secret_ValidationContext{
+			ValidationContext: &tls_v3.CertificateValidationContext{
+				CustomValidatorConfig: &core_v3.TypedExtensionConfig{
+					Name:        spiffeCertValidatorName,
+					TypedConfig: typedConfig,
+				},
+			},
+		},
+	})
+}
+
+// supportsSPIFFECertValidator returns true if the Envoy node is known to
+// support the SPIFFE certificate validator, which was introduced in Envoy
+// v1.18.0.
+func supportsSPIFFECertValidator(node *core_v3.Node) bool {
+	version := node.GetUserAgentBuildVersion().GetVersion()
+	if version == nil {
+		return false
+	}
+	return version.MajorNumber > 1 || (version.MajorNumber == 1 && version.MinorNumber >= 18)
+}
+
 func nextNonce() (string, error) {
 	b := make([]byte, 4)
 	_, err := rand.Read(b)
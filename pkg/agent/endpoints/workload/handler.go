@@ -6,6 +6,8 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/sirupsen/logrus"
@@ -15,6 +17,7 @@ import (
 	"github.com/spiffe/spire/pkg/common/api/rpccontext"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/jwtsvid"
+	"github.com/spiffe/spire/pkg/common/peertracker"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/proto/spire/common"
@@ -40,6 +43,10 @@ type Attestor interface {
 type Config struct {
 	Manager  Manager
 	Attestor Attestor
+
+	// AuditLog, if set, receives a record for each SVID delivered to a
+	// workload
+	AuditLog logrus.FieldLogger
 }
 
 type Handler struct {
@@ -67,7 +74,6 @@ func (h *Handler) FetchJWTSVID(ctx context.Context, req *workload.JWTSVIDRequest
 		return nil, err
 	}
 
-	var spiffeIDs []string
 	identities := h.c.Manager.MatchingIdentities(selectors)
 	if len(identities) == 0 {
 		log.WithField(telemetry.Registered, false).Error("No identity issued")
@@ -76,15 +82,14 @@ func (h *Handler) FetchJWTSVID(ctx context.Context, req *workload.JWTSVIDRequest
 
 	log = log.WithField(telemetry.Registered, true)
 
+	var svids []*client.JWTSVID
+	var delivered []cache.Identity
+	resp = new(workload.JWTSVIDResponse)
 	for _, identity := range identities {
 		if req.SpiffeId != "" && identity.Entry.SpiffeId != req.SpiffeId {
 			continue
 		}
-		spiffeIDs = append(spiffeIDs, identity.Entry.SpiffeId)
-	}
-
-	resp = new(workload.JWTSVIDResponse)
-	for _, spiffeID := range spiffeIDs {
+		spiffeID := identity.Entry.SpiffeId
 		loopLog := log.WithField(telemetry.SPIFFEID, spiffeID)
 
 		var svid *client.JWTSVID
@@ -97,11 +102,25 @@ func (h *Handler) FetchJWTSVID(ctx context.Context, req *workload.JWTSVIDRequest
 			SpiffeId: spiffeID,
 			Svid:     svid.Token,
 		})
+		svids = append(svids, svid)
+		delivered = append(delivered, identity)
 
 		ttl := time.Until(svid.ExpiresAt)
 		loopLog.WithField(telemetry.TTL, ttl.Seconds()).Debug("Fetched JWT SVID")
 	}
 
+	if auditLog := h.auditLog(ctx, selectors); auditLog != nil {
+		for i, identity := range delivered {
+			auditLog.WithFields(logrus.Fields{
+				telemetry.SVIDType:       "jwt",
+				telemetry.RegistrationID: identity.Entry.EntryId,
+				telemetry.SPIFFEID:       identity.Entry.SpiffeId,
+				telemetry.Audience:       strings.Join(req.Audience, ","),
+				telemetry.Expiration:     svids[i].ExpiresAt.UTC().Format(time.RFC3339),
+			}).Info("SVID delivered")
+		}
+	}
+
 	return resp, nil
 }
 
@@ -186,10 +205,12 @@ func (h *Handler) FetchX509SVID(_ *workload.X509SVIDRequest, stream workload.Spi
 	subscriber := h.c.Manager.SubscribeToCacheChanges(selectors)
 	defer subscriber.Finish()
 
+	auditLog := h.auditLog(ctx, selectors)
+
 	for {
 		select {
 		case update := <-subscriber.Updates():
-			if err := sendX509SVIDResponse(update, stream, log); err != nil {
+			if err := sendX509SVIDResponse(update, stream, log, auditLog); err != nil {
 				return err
 			}
 		case <-ctx.Done():
@@ -198,7 +219,7 @@ func (h *Handler) FetchX509SVID(_ *workload.X509SVIDRequest, stream workload.Spi
 	}
 }
 
-func sendX509SVIDResponse(update *cache.WorkloadUpdate, stream workload.SpiffeWorkloadAPI_FetchX509SVIDServer, log logrus.FieldLogger) (err error) {
+func sendX509SVIDResponse(update *cache.WorkloadUpdate, stream workload.SpiffeWorkloadAPI_FetchX509SVIDServer, log, auditLog logrus.FieldLogger) (err error) {
 	if len(update.Identities) == 0 {
 		log.WithField(telemetry.Registered, false).Error("No identity issued")
 		return status.Error(codes.PermissionDenied, "no identity issued")
@@ -230,9 +251,49 @@ func sendX509SVIDResponse(update *cache.WorkloadUpdate, stream workload.SpiffeWo
 		}).Debug("Fetched X.509 SVID")
 	}
 
+	if auditLog != nil {
+		for _, identity := range update.Identities {
+			auditLog.WithFields(logrus.Fields{
+				telemetry.SVIDType:       "x509",
+				telemetry.RegistrationID: identity.Entry.EntryId,
+				telemetry.SPIFFEID:       identity.Entry.SpiffeId,
+				telemetry.SerialNumber:   identity.SVID[0].SerialNumber.String(),
+				telemetry.Expiration:     identity.SVID[0].NotAfter.UTC().Format(time.RFC3339),
+			}).Info("SVID delivered")
+		}
+	}
+
 	return nil
 }
 
+// auditLog returns the logger for the audit records of SVIDs delivered to
+// the caller, with the caller PID and selectors set, or nil if audit
+// logging is disabled.
+func (h *Handler) auditLog(ctx context.Context, selectors []*common.Selector) logrus.FieldLogger {
+	if h.c.AuditLog == nil {
+		return nil
+	}
+
+	fields := logrus.Fields{
+		telemetry.Selectors: selectorsString(selectors),
+	}
+	if watcher, ok := peertracker.WatcherFromContext(ctx); ok {
+		fields[telemetry.PID] = watcher.PID()
+	}
+	return h.c.AuditLog.WithFields(fields)
+}
+
+// selectorsString returns the selectors in "type:value" form, sorted and
+// separated by commas.
+func selectorsString(selectors []*common.Selector) string {
+	values := make([]string, 0, len(selectors))
+	for _, selector := range selectors {
+		values = append(values, selector.Type+":"+selector.Value)
+	}
+	sort.Strings(values)
+	return strings.Join(values, ",")
+}
+
 func composeX509SVIDResponse(update *cache.WorkloadUpdate) (*workload.X509SVIDResponse, error) {
 	resp := new(workload.X509SVIDResponse)
 	resp.Svids = []*workload.X509SVID{}
@@ -7,6 +7,7 @@ import (
 	"crypto/x509"
 	"encoding/json"
 	"errors"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -47,13 +48,15 @@ func TestFetchX509SVID(t *testing.T) {
 	federatedBundle := testca.New(t, td2).Bundle()
 
 	for _, tt := range []struct {
-		name       string
-		updates    []*cache.WorkloadUpdate
-		attestErr  error
-		expectCode codes.Code
-		expectMsg  string
-		expectResp *workloadPB.X509SVIDResponse
-		expectLogs []spiretest.LogEntry
+		name            string
+		updates         []*cache.WorkloadUpdate
+		selectors       []*common.Selector
+		attestErr       error
+		expectCode      codes.Code
+		expectMsg       string
+		expectResp      *workloadPB.X509SVIDResponse
+		expectLogs      []spiretest.LogEntry
+		expectAuditLogs []spiretest.LogEntry
 	}{
 		{
 			name:       "no identity issued",
@@ -144,15 +147,58 @@ func TestFetchX509SVID(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with audit log",
+			updates: []*cache.WorkloadUpdate{{
+				Identities: []cache.Identity{
+					identityFromX509SVID(x509SVID1),
+				},
+				Bundle: utilBundleFromBundle(t, bundle),
+			}},
+			selectors: []*common.Selector{
+				{Type: "unix", Value: "uid:1000"},
+				{Type: "k8s", Value: "ns:foo"},
+			},
+			expectCode: codes.OK,
+			expectResp: &workloadPB.X509SVIDResponse{
+				Svids: []*workloadPB.X509SVID{
+					{
+						SpiffeId:    x509SVID1.ID.String(),
+						X509Svid:    x509util.DERFromCertificates(x509SVID1.Certificates),
+						X509SvidKey: pkcs8FromSigner(t, x509SVID1.PrivateKey),
+						Bundle:      x509util.DERFromCertificates(bundle.X509Authorities()),
+					},
+				},
+			},
+			expectAuditLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "SVID delivered",
+					Data: logrus.Fields{
+						"svid_type":  "x509",
+						"entry_id":   "ENTRYID-" + x509SVID1.ID.Path(),
+						"spiffe_id":  x509SVID1.ID.String(),
+						"serial_num": x509SVID1.Certificates[0].SerialNumber.String(),
+						"expiration": x509SVID1.Certificates[0].NotAfter.UTC().Format(time.RFC3339),
+						"selectors":  "k8s:ns:foo,unix:uid:1000",
+					},
+				},
+			},
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
 			params := testParams{
 				CA:         ca,
 				Updates:    tt.updates,
+				Selectors:  tt.selectors,
 				AttestErr:  tt.attestErr,
 				ExpectLogs: tt.expectLogs,
 			}
+			var auditLogHook *test.Hook
+			if tt.expectAuditLogs != nil {
+				params.AuditLog, auditLogHook = test.NewNullLogger()
+			}
 			runTest(t, params,
 				func(ctx context.Context, client workloadPB.SpiffeWorkloadAPIClient) {
 					stream, err := client.FetchX509SVID(ctx, &workloadPB.X509SVIDRequest{})
@@ -162,6 +208,9 @@ func TestFetchX509SVID(t *testing.T) {
 					spiretest.RequireGRPCStatus(t, err, tt.expectCode, tt.expectMsg)
 					spiretest.RequireProtoEqual(t, tt.expectResp, resp)
 				})
+			if auditLogHook != nil {
+				spiretest.AssertLogs(t, auditLogHook.AllEntries(), tt.expectAuditLogs)
+			}
 		})
 	}
 }
@@ -183,6 +232,7 @@ func TestFetchJWTSVID(t *testing.T) {
 		expectMsg      string
 		expectTokenIDs []spiffeid.ID
 		expectLogs     []spiretest.LogEntry
+		auditLog       bool
 	}{
 		{
 			name:       "missing required audience",
@@ -277,6 +327,17 @@ func TestFetchJWTSVID(t *testing.T) {
 			expectCode:     codes.OK,
 			expectTokenIDs: []spiffeid.ID{x509SVID2.ID},
 		},
+		{
+			name: "with audit log",
+			identities: []cache.Identity{
+				identityFromX509SVID(x509SVID1),
+				identityFromX509SVID(x509SVID2),
+			},
+			audience:       []string{"AUDIENCE1", "AUDIENCE2"},
+			expectCode:     codes.OK,
+			expectTokenIDs: []spiffeid.ID{x509SVID1.ID, x509SVID2.ID},
+			auditLog:       true,
+		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
@@ -287,6 +348,11 @@ func TestFetchJWTSVID(t *testing.T) {
 				ManagerErr: tt.managerErr,
 				ExpectLogs: tt.expectLogs,
 			}
+			var auditLogHook *test.Hook
+			if tt.auditLog {
+				params.AuditLog, auditLogHook = test.NewNullLogger()
+			}
+			var expectAuditLogs []spiretest.LogEntry
 			runTest(t, params,
 				func(ctx context.Context, client workloadPB.SpiffeWorkloadAPIClient) {
 					resp, err := client.FetchJWTSVID(ctx, &workloadPB.JWTSVIDRequest{
@@ -304,9 +370,24 @@ func TestFetchJWTSVID(t *testing.T) {
 						parsedSVID, err := jwtsvid.ParseInsecure(svid.Svid, tt.audience)
 						require.NoError(t, err, "JWT-SVID token is malformed")
 						tokenIDs = append(tokenIDs, parsedSVID.ID)
+						expectAuditLogs = append(expectAuditLogs, spiretest.LogEntry{
+							Level:   logrus.InfoLevel,
+							Message: "SVID delivered",
+							Data: logrus.Fields{
+								"svid_type":  "jwt",
+								"entry_id":   "ENTRYID-" + parsedSVID.ID.Path(),
+								"spiffe_id":  parsedSVID.ID.String(),
+								"audience":   strings.Join(tt.audience, ","),
+								"expiration": parsedSVID.Expiry.UTC().Format(time.RFC3339),
+								"selectors":  "",
+							},
+						})
 					}
 					assert.Equal(t, tt.expectTokenIDs, tokenIDs)
 				})
+			if auditLogHook != nil {
+				spiretest.AssertLogs(t, auditLogHook.AllEntries(), expectAuditLogs)
+			}
 		})
 	}
 }
@@ -584,6 +665,8 @@ type testParams struct {
 	Updates    []*cache.WorkloadUpdate
 	AttestErr  error
 	ManagerErr error
+	Selectors  []*common.Selector
+	AuditLog   logrus.FieldLogger
 	ExpectLogs []spiretest.LogEntry
 }
 
@@ -599,7 +682,8 @@ func runTest(t *testing.T, params testParams, fn func(ctx context.Context, clien
 
 	handler := workload.New(workload.Config{
 		Manager:  manager,
-		Attestor: &FakeAttestor{err: params.AttestErr},
+		Attestor: &FakeAttestor{selectors: params.Selectors, err: params.AttestErr},
+		AuditLog: params.AuditLog,
 	})
 
 	unaryInterceptor, streamInterceptor := middleware.Interceptors(
@@ -652,7 +736,8 @@ func (m *FakeManager) FetchJWTSVID(ctx context.Context, spiffeID string, audienc
 		return nil, m.err
 	}
 	return &client.JWTSVID{
-		Token: svid.Marshal(),
+		Token:     svid.Marshal(),
+		ExpiresAt: svid.Expiry,
 	}, nil
 }
 
@@ -722,7 +807,10 @@ func (a *FakeAttestor) Attest(ctx context.Context) ([]*common.Selector, error) {
 
 func identityFromX509SVID(svid *x509svid.SVID) cache.Identity {
 	return cache.Identity{
-		Entry:      &common.RegistrationEntry{SpiffeId: svid.ID.String()},
+		Entry: &common.RegistrationEntry{
+			EntryId:  "ENTRYID-" + svid.ID.Path(),
+			SpiffeId: svid.ID.String(),
+		},
 		PrivateKey: svid.PrivateKey,
 		SVID:       svid.Certificates,
 	}
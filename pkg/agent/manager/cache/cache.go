@@ -9,8 +9,10 @@ import (
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/proto/spire/common"
+	"google.golang.org/protobuf/proto"
 )
 
 type Selectors []*common.Selector
@@ -52,6 +54,10 @@ type UpdateSVIDs struct {
 type X509SVID struct {
 	Chain      []*x509.Certificate
 	PrivateKey crypto.Signer
+
+	// KeyCreatedAt is when the private key was generated. It is older than
+	// the SVID when the key is reused across renewals.
+	KeyCreatedAt time.Time
 }
 
 // Cache caches each registration entry, signed X509-SVIDs for those entries,
@@ -71,6 +77,13 @@ type X509SVID struct {
 // selector it encounters. Each selector index tracks the subscribers (i.e
 // workloads) and registration entries that have that selector.
 //
+// Registration entries with a SPIFFE ID template are not given to workloads
+// directly. Instead, for each subscriber whose selectors match the entry, an
+// entry is derived with the template expanded with the subscriber selectors.
+// The derived entry also requires the selectors used in the expansion, so it
+// only matches the workloads that expand to the same SPIFFE ID. Derived
+// entries are kept while there are subscribers for them.
+//
 // When registration entries are added/updated/removed, the set of relevant
 // selectors are gathered and the indexes for those selectors are combed for
 // all relevant subscribers.
@@ -106,6 +119,10 @@ type Cache struct {
 	// records holds the records for registration entries, keyed by registration entry ID
 	records map[string]*cacheRecord
 
+	// templates holds the registration entries with a SPIFFE ID template,
+	// keyed by registration entry ID
+	templates map[string]*common.RegistrationEntry
+
 	// selectors holds the selector indices, keyed by a selector key
 	selectors map[selector]*selectorIndex
 
@@ -122,6 +139,8 @@ type StaleEntry struct {
 	Entry *common.RegistrationEntry
 	// SVIDs expiration time
 	ExpiresAt time.Time
+	// SVID is the current SVID for the entry, if any
+	SVID *X509SVID
 }
 
 func New(log logrus.FieldLogger, trustDomainID string, bundle *Bundle, metrics telemetry.Metrics) *Cache {
@@ -133,6 +152,7 @@ func New(log logrus.FieldLogger, trustDomainID string, bundle *Bundle, metrics t
 		metrics:       metrics,
 		trustDomainID: trustDomainID,
 		records:       make(map[string]*cacheRecord),
+		templates:     make(map[string]*common.RegistrationEntry),
 		selectors:     make(map[selector]*selectorIndex),
 		staleEntries:  make(map[string]bool),
 		bundles: map[string]*bundleutil.Bundle{
@@ -195,6 +215,34 @@ func (c *Cache) FetchWorkloadUpdate(selectors []*common.Selector) *WorkloadUpdat
 	return c.buildWorkloadUpdate(set)
 }
 
+// FetchWorkloadUpdateBySelectorType gets the workload update for the
+// identities whose registration entry selectors are all of the given type.
+// It is used to find the identities designated for an SVIDStore plugin.
+func (c *Cache) FetchWorkloadUpdateBySelectorType(selectorType string) *WorkloadUpdate {
+	set, setDone := allocSelectorSet()
+	defer setDone()
+
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	for _, record := range c.records {
+		if !hasOnlySelectorType(record.entry, selectorType) {
+			continue
+		}
+		for _, s := range record.entry.Selectors {
+			set[makeSelector(s)] = struct{}{}
+		}
+	}
+
+	if len(set) == 0 {
+		return &WorkloadUpdate{
+			Bundle:           c.bundles[c.trustDomainID],
+			FederatedBundles: make(map[string]*bundleutil.Bundle),
+		}
+	}
+	return c.buildWorkloadUpdate(set)
+}
+
 func (c *Cache) SubscribeToWorkloadUpdates(selectors []*common.Selector) Subscriber {
 	c.mu.Lock()
 	defer c.mu.Unlock()
@@ -203,6 +251,24 @@ func (c *Cache) SubscribeToWorkloadUpdates(selectors []*common.Selector) Subscri
 	for s := range sub.set {
 		c.addSelectorIndexSub(s, sub)
 	}
+
+	// Add the entries derived from templates for the subscriber. They have
+	// no SVID yet, so they are marked as stale to get one.
+	for _, entry := range c.deriveEntries(sub.set) {
+		if _, ok := c.records[entry.EntryId]; ok {
+			continue
+		}
+		record, _ := c.updateOrCreateRecord(entry)
+		for _, s := range entry.Selectors {
+			c.addSelectorIndexRecord(makeSelector(s), record)
+		}
+		c.staleEntries[entry.EntryId] = true
+		c.log.WithFields(logrus.Fields{
+			telemetry.Entry:    entry.EntryId,
+			telemetry.SPIFFEID: entry.SpiffeId,
+		}).Debug("Entry derived from template")
+	}
+
 	c.notify(sub)
 	return sub
 }
@@ -265,9 +331,30 @@ func (c *Cache) UpdateEntries(update *UpdateEntries, checkSVID func(*common.Regi
 	fedRem, fedRemDone := allocStringSet()
 	defer fedRemDone()
 
+	// Set aside the entries with a SPIFFE ID template, and add in their place
+	// the entries derived from them for the current subscribers.
+	entries := make(map[string]*common.RegistrationEntry, len(update.RegistrationEntries))
+	c.templates = make(map[string]*common.RegistrationEntry)
+	for id, entry := range update.RegistrationEntries {
+		if idutil.IsTemplate(entry.SpiffeId) {
+			c.templates[id] = entry
+			continue
+		}
+		entries[id] = entry
+	}
+	if len(c.templates) > 0 {
+		subs, subsDone := c.allSubscribers()
+		for sub := range subs {
+			for _, entry := range c.deriveEntries(sub.set) {
+				entries[entry.EntryId] = entry
+			}
+		}
+		subsDone()
+	}
+
 	// Remove records for registration entries that no longer exist
 	for id, record := range c.records {
-		if _, ok := update.RegistrationEntries[id]; !ok {
+		if _, ok := entries[id]; !ok {
 			c.log.WithFields(logrus.Fields{
 				telemetry.Entry:    id,
 				telemetry.SPIFFEID: record.entry.SpiffeId,
@@ -287,7 +374,7 @@ func (c *Cache) UpdateEntries(update *UpdateEntries, checkSVID func(*common.Regi
 	}
 
 	// Add/update records for registration entries in the update
-	for _, newEntry := range update.RegistrationEntries {
+	for _, newEntry := range entries {
 		clearSelectorSet(selAdd)
 		clearSelectorSet(selRem)
 		clearStringSet(fedAdd)
@@ -422,6 +509,7 @@ func (c *Cache) GetStaleEntries() []*StaleEntry {
 		staleEntries = append(staleEntries, &StaleEntry{
 			Entry:     cachedEntry.entry,
 			ExpiresAt: expiresAt,
+			SVID:      cachedEntry.svid,
 		})
 	}
 
@@ -586,9 +674,8 @@ func (c *Cache) matchingIdentities(set selectorSet) []Identity {
 		return nil
 	}
 
-	// Return identities in ascending "entry id" order to maintain a consistent
-	// ordering.
-	// TODO: figure out how to determine the "default" identity
+	// Return identities in a consistent order, with the default identity
+	// first. See sortIdentities.
 	out := make([]Identity, 0, len(records))
 	for record := range records {
 		out = append(out, makeIdentity(record))
@@ -641,15 +728,53 @@ func (c *Cache) getRecordsForSelectors(set selectorSet) (recordSet, func()) {
 	// Filter out records whose registration entry selectors are not within
 	// inside the selector set.
 	for record := range records {
-		for _, s := range record.entry.Selectors {
-			if !set.In(s) {
-				delete(records, record)
-			}
+		if !isEntryInSet(record.entry, set) {
+			delete(records, record)
 		}
 	}
 	return records, recordsDone
 }
 
+// deriveEntries returns the entries derived from the templates whose
+// selectors are within the selector set.
+func (c *Cache) deriveEntries(set selectorSet) []*common.RegistrationEntry {
+	if len(c.templates) == 0 {
+		return nil
+	}
+
+	var selectors []*common.Selector
+	for s := range set {
+		selectors = append(selectors, &common.Selector{Type: s.Type, Value: s.Value})
+	}
+
+	var derived []*common.RegistrationEntry
+	for _, template := range c.templates {
+		if !isEntryInSet(template, set) {
+			continue
+		}
+
+		spiffeID, used, err := idutil.ExpandTemplate(template.SpiffeId, selectors)
+		if err != nil {
+			c.log.WithError(err).WithFields(logrus.Fields{
+				telemetry.Entry:    template.EntryId,
+				telemetry.SPIFFEID: template.SpiffeId,
+			}).Warn("Unable to expand SPIFFE ID template")
+			continue
+		}
+
+		entry := proto.Clone(template).(*common.RegistrationEntry)
+		entry.EntryId = idutil.DerivedEntryID(template.EntryId, spiffeID)
+		entry.SpiffeId = spiffeID
+		for _, s := range used {
+			if !containsSelector(entry.Selectors, s) {
+				entry.Selectors = append(entry.Selectors, s)
+			}
+		}
+		derived = append(derived, entry)
+	}
+	return derived
+}
+
 // getSelectorIndex gets the selector index for the selector. If one doesn't
 // exist, it is created.
 func (c *Cache) getSelectorIndex(s selector) *selectorIndex {
@@ -692,12 +817,32 @@ func newSelectorIndex() *selectorIndex {
 	}
 }
 
+// sortIdentities orders identities by ascending entry creation time, falling
+// back to the entry ID for entries created in the same second. The first
+// identity, i.e. the one from the oldest entry, is the default identity for
+// the workload.
 func sortIdentities(identities []Identity) {
 	sort.Slice(identities, func(a, b int) bool {
-		return identities[a].Entry.EntryId < identities[b].Entry.EntryId
+		entryA, entryB := identities[a].Entry, identities[b].Entry
+		if entryA.CreatedAt != entryB.CreatedAt {
+			return entryA.CreatedAt < entryB.CreatedAt
+		}
+		return entryA.EntryId < entryB.EntryId
 	})
 }
 
+func hasOnlySelectorType(entry *common.RegistrationEntry, selectorType string) bool {
+	if len(entry.Selectors) == 0 {
+		return false
+	}
+	for _, s := range entry.Selectors {
+		if s.Type != selectorType {
+			return false
+		}
+	}
+	return true
+}
+
 func makeIdentity(record *cacheRecord) Identity {
 	return Identity{
 		Entry:      record.entry,
@@ -705,3 +850,21 @@ func makeIdentity(record *cacheRecord) Identity {
 		PrivateKey: record.svid.PrivateKey,
 	}
 }
+
+func isEntryInSet(entry *common.RegistrationEntry, set selectorSet) bool {
+	for _, s := range entry.Selectors {
+		if !set.In(s) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsSelector(selectors []*common.Selector, selector *common.Selector) bool {
+	for _, s := range selectors {
+		if s.Type == selector.Type && s.Value == selector.Value {
+			return true
+		}
+	}
+	return false
+}
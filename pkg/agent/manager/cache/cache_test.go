@@ -4,6 +4,7 @@ import (
 	"crypto/x509"
 	"fmt"
 	"runtime"
+	"sort"
 	"testing"
 	"time"
 
@@ -11,6 +12,7 @@ import (
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -55,6 +57,46 @@ func TestFetchWorkloadUpdate(t *testing.T) {
 	}, workloadUpdate)
 }
 
+func TestFetchWorkloadUpdateBySelectorType(t *testing.T) {
+	cache := newTestCache()
+
+	foo := makeRegistrationEntry("FOO", "A")
+	foo.Selectors = []*common.Selector{
+		{Type: "store", Value: "name:foo"},
+	}
+	bar := makeRegistrationEntry("BAR", "B")
+	bar.Selectors = []*common.Selector{
+		{Type: "store", Value: "name:bar"},
+		{Type: "store", Value: "key:bar"},
+	}
+	bar.FederatesWith = makeFederatesWith(otherBundleV1)
+	// BAZ has a selector of another type so it is not designated for the store
+	baz := makeRegistrationEntry("BAZ", "C")
+	baz.Selectors = append(baz.Selectors, &common.Selector{Type: "store", Value: "name:baz"})
+	updateEntries := &UpdateEntries{
+		Bundles:             makeBundles(bundleV1, otherBundleV1),
+		RegistrationEntries: makeRegistrationEntries(foo, bar, baz),
+	}
+	cache.UpdateEntries(updateEntries, nil)
+	cache.UpdateSVIDs(&UpdateSVIDs{
+		X509SVIDs: makeX509SVIDs(foo, bar, baz),
+	})
+
+	assert.Equal(t, &WorkloadUpdate{
+		Bundle:           bundleV1,
+		FederatedBundles: makeBundles(otherBundleV1),
+		Identities: []Identity{
+			{Entry: bar},
+			{Entry: foo},
+		},
+	}, cache.FetchWorkloadUpdateBySelectorType("store"))
+
+	assert.Equal(t, &WorkloadUpdate{
+		Bundle:           bundleV1,
+		FederatedBundles: makeBundles(),
+	}, cache.FetchWorkloadUpdateBySelectorType("other"))
+}
+
 func TestMatchingIdentities(t *testing.T) {
 	cache := newTestCache()
 
@@ -82,6 +124,35 @@ func TestMatchingIdentities(t *testing.T) {
 	}, identities)
 }
 
+func TestMatchingIdentitiesOrderedByCreation(t *testing.T) {
+	cache := newTestCache()
+
+	// BAR and BAZ are created in the same second, after FOO
+	foo := makeRegistrationEntry("FOO", "A")
+	foo.CreatedAt = 1
+	bar := makeRegistrationEntry("BAR", "A")
+	bar.CreatedAt = 2
+	baz := makeRegistrationEntry("BAZ", "A")
+	baz.CreatedAt = 2
+	updateEntries := &UpdateEntries{
+		Bundles:             makeBundles(bundleV1),
+		RegistrationEntries: makeRegistrationEntries(foo, bar, baz),
+	}
+	cache.UpdateEntries(updateEntries, nil)
+	cache.UpdateSVIDs(&UpdateSVIDs{
+		X509SVIDs: makeX509SVIDs(foo, bar, baz),
+	})
+
+	// The oldest entry provides the default identity; ties are broken by
+	// entry ID.
+	identities := cache.MatchingIdentities(makeSelectors("A"))
+	assert.Equal(t, []Identity{
+		{Entry: foo},
+		{Entry: bar},
+		{Entry: baz},
+	}, identities)
+}
+
 func TestCountSVIDs(t *testing.T) {
 	cache := newTestCache()
 
@@ -579,6 +650,7 @@ func TestGetStaleEntries(t *testing.T) {
 	expectedEntries = []*StaleEntry{{
 		Entry:     cache.records[foo.EntryId].entry,
 		ExpiresAt: expiredAt,
+		SVID:      svids[foo.EntryId],
 	}}
 	assert.Equal(t, expectedEntries, cache.GetStaleEntries())
 
@@ -591,6 +663,94 @@ func TestGetStaleEntries(t *testing.T) {
 	assert.Empty(t, cache.GetStaleEntries())
 }
 
+func TestTemplateEntries(t *testing.T) {
+	cache := newTestCache()
+
+	template := &common.RegistrationEntry{
+		EntryId:        "TEMPLATE",
+		SpiffeId:       "spiffe://domain.test/ns/{test:ns}",
+		Selectors:      makeSelectors("app:foo"),
+		RevisionNumber: 1,
+	}
+	update := &UpdateEntries{
+		Bundles:             makeBundles(bundleV1),
+		RegistrationEntries: makeRegistrationEntries(template),
+	}
+	cache.UpdateEntries(update, nil)
+
+	// The template itself is never given to workloads
+	assert.Empty(t, cache.GetStaleEntries())
+	assert.Empty(t, cache.MatchingIdentities(makeSelectors("app:foo", "ns:a")))
+
+	// Subscribers derive entries from the template, which are stale until
+	// they get an SVID
+	subA := cache.SubscribeToWorkloadUpdates(makeSelectors("app:foo", "ns:a", "uid:1"))
+	defer subA.Finish()
+	assertWorkloadUpdateEqual(t, subA, &WorkloadUpdate{Bundle: bundleV1})
+	subB := cache.SubscribeToWorkloadUpdates(makeSelectors("app:foo", "ns:b"))
+	defer subB.Finish()
+	assertWorkloadUpdateEqual(t, subB, &WorkloadUpdate{Bundle: bundleV1})
+	// Neither of these can expand the template
+	subC := cache.SubscribeToWorkloadUpdates(makeSelectors("app:bar", "ns:c"))
+	defer subC.Finish()
+	assertWorkloadUpdateEqual(t, subC, &WorkloadUpdate{Bundle: bundleV1})
+	subD := cache.SubscribeToWorkloadUpdates(makeSelectors("app:foo"))
+	defer subD.Finish()
+	assertWorkloadUpdateEqual(t, subD, &WorkloadUpdate{Bundle: bundleV1})
+
+	derivedA := &common.RegistrationEntry{
+		EntryId:        "TEMPLATE#spiffe://domain.test/ns/a",
+		SpiffeId:       "spiffe://domain.test/ns/a",
+		Selectors:      makeSelectors("app:foo", "ns:a"),
+		RevisionNumber: 1,
+	}
+	derivedB := &common.RegistrationEntry{
+		EntryId:        "TEMPLATE#spiffe://domain.test/ns/b",
+		SpiffeId:       "spiffe://domain.test/ns/b",
+		Selectors:      makeSelectors("app:foo", "ns:b"),
+		RevisionNumber: 1,
+	}
+	staleEntries := cache.GetStaleEntries()
+	require.Len(t, staleEntries, 2)
+	sort.Slice(staleEntries, func(i, j int) bool {
+		return staleEntries[i].Entry.EntryId < staleEntries[j].Entry.EntryId
+	})
+	spiretest.AssertProtoEqual(t, derivedA, staleEntries[0].Entry)
+	spiretest.AssertProtoEqual(t, derivedB, staleEntries[1].Entry)
+
+	// Each subscriber gets the SVID for its own SPIFFE ID
+	cache.UpdateSVIDs(&UpdateSVIDs{
+		X509SVIDs: makeX509SVIDs(derivedA, derivedB),
+	})
+	assert.Empty(t, cache.GetStaleEntries())
+	identities := (<-subA.Updates()).Identities
+	require.Len(t, identities, 1)
+	assert.Equal(t, derivedA.SpiffeId, identities[0].Entry.SpiffeId)
+	identities = (<-subB.Updates()).Identities
+	require.Len(t, identities, 1)
+	assert.Equal(t, derivedB.SpiffeId, identities[0].Entry.SpiffeId)
+	assertNoWorkloadUpdate(t, subC)
+
+	// Derived entries are kept across updates while there are subscribers
+	// for them
+	cache.UpdateEntries(update, nil)
+	assert.Len(t, cache.Identities(), 2)
+	assertNoWorkloadUpdate(t, subA)
+
+	subB.Finish()
+	cache.UpdateEntries(update, nil)
+	identities = cache.Identities()
+	require.Len(t, identities, 1)
+	assert.Equal(t, derivedA.SpiffeId, identities[0].Entry.SpiffeId)
+
+	// Removing the template removes the derived entries
+	cache.UpdateEntries(&UpdateEntries{
+		Bundles: makeBundles(bundleV1),
+	}, nil)
+	assert.Empty(t, cache.Identities())
+	assertWorkloadUpdateEqual(t, subA, &WorkloadUpdate{Bundle: bundleV1})
+}
+
 func BenchmarkCacheGlobalNotification(b *testing.B) {
 	cache := newTestCache()
 
@@ -11,8 +11,10 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/agent/catalog"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
+	"github.com/spiffe/spire/pkg/agent/manager/hooks"
 	"github.com/spiffe/spire/pkg/agent/svid"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"google.golang.org/grpc/keepalive"
 )
 
 // Config holds a cache manager configuration
@@ -26,11 +28,19 @@ type Config struct {
 	Log              logrus.FieldLogger
 	Metrics          telemetry.Metrics
 	ServerAddr       string
+	ServerKeepalive  *keepalive.ClientParameters
 	SVIDCachePath    string
 	BundleCachePath  string
 	SyncInterval     time.Duration
 	RotationInterval time.Duration
 
+	// ReuseWorkloadKeys, if true, keeps the private key of a workload SVID
+	// when the SVID is renewed instead of generating a new one.
+	ReuseWorkloadKeys bool
+
+	// RotationHooks are commands run when workload SVIDs or bundles rotate.
+	RotationHooks []hooks.Hook
+
 	// Clk is the clock the manager will use to get time
 	Clk clock.Clock
 }
@@ -66,6 +76,8 @@ func newManager(c *Config) *manager {
 		TrustDomain:  c.TrustDomain,
 		Interval:     c.RotationInterval,
 		Clk:          c.Clk,
+
+		ServerKeepalive: c.ServerKeepalive,
 	}
 	svidRotator, client := svid.NewRotator(rotCfg)
 
@@ -78,6 +90,7 @@ func newManager(c *Config) *manager {
 		bundleCachePath: c.BundleCachePath,
 		client:          client,
 		clk:             c.Clk,
+		hooks:           hooks.New(c.Log.WithField(telemetry.SubsystemName, telemetry.RotationHooks), c.RotationHooks),
 	}
 
 	return m
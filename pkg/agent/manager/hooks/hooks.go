@@ -0,0 +1,209 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+)
+
+const (
+	// DefaultTimeout is how long a hook command can run before it is killed,
+	// unless the hook has its own timeout.
+	DefaultTimeout = 30 * time.Second
+
+	// pendingEvents is how many events can be queued while hooks run. Events
+	// are dropped if the queue is full.
+	pendingEvents = 32
+)
+
+// Event is a rotation event hooks can run on.
+type Event string
+
+const (
+	// X509SVIDRotated happens when a workload X509-SVID is issued or renewed.
+	X509SVIDRotated Event = "x509_svid"
+
+	// BundleUpdated happens when the bundle of a trust domain changes.
+	BundleUpdated Event = "bundle"
+)
+
+// ParseEvent parses the name of an event.
+func ParseEvent(s string) (Event, error) {
+	switch e := Event(s); e {
+	case X509SVIDRotated, BundleUpdated:
+		return e, nil
+	default:
+		return "", fmt.Errorf("unknown event %q", s)
+	}
+}
+
+// Hook is a command that runs when an event happens.
+type Hook struct {
+	// Command is the path of the executable and its arguments.
+	Command []string
+
+	// Timeout is how long the command can run before it is killed.
+	// Defaults to DefaultTimeout.
+	Timeout time.Duration
+
+	// Events are the events the hook runs on. Defaults to all events.
+	Events []Event
+
+	// SPIFFEIDs, if set, limits the X509SVIDRotated events the hook runs on
+	// to the rotation of SVIDs with these SPIFFE IDs.
+	SPIFFEIDs []string
+}
+
+// Runner runs the hooks for the events it is notified of. Hooks run one at a
+// time, in the order they were configured, so a slow command delays the
+// hooks that come after it.
+type Runner struct {
+	log    logrus.FieldLogger
+	hooks  []Hook
+	events chan event
+
+	// run runs a hook command. Tests replace it.
+	run func(ctx context.Context, command []string, env []string) error
+}
+
+type event struct {
+	kind Event
+	ids  []string
+}
+
+// New creates a Runner for the hooks.
+func New(log logrus.FieldLogger, hooks []Hook) *Runner {
+	return &Runner{
+		log:    log,
+		hooks:  hooks,
+		events: make(chan event, pendingEvents),
+		run:    runCommand,
+	}
+}
+
+// X509SVIDsRotated notifies the runner that the X509-SVIDs with the given
+// SPIFFE IDs were issued or renewed.
+func (r *Runner) X509SVIDsRotated(spiffeIDs []string) {
+	r.notify(event{kind: X509SVIDRotated, ids: spiffeIDs})
+}
+
+// BundlesUpdated notifies the runner that the bundles of the trust domains
+// with the given IDs changed.
+func (r *Runner) BundlesUpdated(trustDomainIDs []string) {
+	r.notify(event{kind: BundleUpdated, ids: trustDomainIDs})
+}
+
+// Run runs the hooks for the events the runner is notified of until the
+// context is done.
+func (r *Runner) Run(ctx context.Context) error {
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case e := <-r.events:
+			r.runHooks(ctx, e)
+		}
+	}
+}
+
+func (r *Runner) notify(e event) {
+	if len(r.hooks) == 0 || len(e.ids) == 0 {
+		return
+	}
+	select {
+	case r.events <- e:
+	default:
+		r.log.WithField(telemetry.Event, e.kind).Warn("Too many pending rotation events; dropping event")
+	}
+}
+
+func (r *Runner) runHooks(ctx context.Context, e event) {
+	for _, hook := range r.hooks {
+		ids := hook.matching(e)
+		if len(ids) == 0 {
+			continue
+		}
+
+		timeout := hook.Timeout
+		if timeout <= 0 {
+			timeout = DefaultTimeout
+		}
+
+		log := r.log.WithFields(logrus.Fields{
+			telemetry.Event:   e.kind,
+			telemetry.Command: hook.Command[0],
+		})
+
+		runCtx, cancel := context.WithTimeout(ctx, timeout)
+		err := r.run(runCtx, hook.Command, eventEnv(e.kind, ids))
+		cancel()
+		switch {
+		case err == nil:
+			log.Debug("Rotation hook succeeded")
+		case errors.Is(runCtx.Err(), context.DeadlineExceeded):
+			log.WithField(telemetry.Timeout, timeout).Error("Rotation hook timed out")
+		default:
+			log.WithError(err).Error("Rotation hook failed")
+		}
+	}
+}
+
+// matching returns the IDs in the event the hook runs for, if any.
+func (h Hook) matching(e event) []string {
+	if len(h.Events) > 0 && !containsEvent(h.Events, e.kind) {
+		return nil
+	}
+	if e.kind != X509SVIDRotated || len(h.SPIFFEIDs) == 0 {
+		return e.ids
+	}
+
+	var ids []string
+	for _, id := range e.ids {
+		for _, spiffeID := range h.SPIFFEIDs {
+			if id == spiffeID {
+				ids = append(ids, id)
+				break
+			}
+		}
+	}
+	return ids
+}
+
+func containsEvent(events []Event, event Event) bool {
+	for _, e := range events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
+
+// eventEnv returns the environment variables that describe the event to the
+// hook command.
+func eventEnv(kind Event, ids []string) []string {
+	env := []string{"SPIRE_HOOK_EVENT=" + string(kind)}
+	switch kind {
+	case X509SVIDRotated:
+		env = append(env, "SPIRE_HOOK_SPIFFE_IDS="+strings.Join(ids, " "))
+	case BundleUpdated:
+		env = append(env, "SPIRE_HOOK_TRUST_DOMAINS="+strings.Join(ids, " "))
+	}
+	return env
+}
+
+func runCommand(ctx context.Context, command []string, env []string) error {
+	cmd := exec.CommandContext(ctx, command[0], command[1:]...) //nolint: gosec // command is configured by the operator
+	cmd.Env = append(os.Environ(), env...)
+	out, err := cmd.CombinedOutput()
+	if err != nil && len(out) > 0 {
+		return fmt.Errorf("%w: %s", err, strings.TrimSpace(string(out)))
+	}
+	return err
+}
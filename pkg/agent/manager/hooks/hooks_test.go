@@ -0,0 +1,155 @@
+package hooks
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+type call struct {
+	command []string
+	env     []string
+}
+
+func TestRunner(t *testing.T) {
+	log, logHook := test.NewNullLogger()
+	runner := New(log, []Hook{
+		{
+			Command: []string{"all"},
+		},
+		{
+			Command:   []string{"svid", "-reload"},
+			Events:    []Event{X509SVIDRotated},
+			SPIFFEIDs: []string{"spiffe://example.org/foo"},
+		},
+		{
+			Command: []string{"bundle"},
+			Events:  []Event{BundleUpdated},
+		},
+	})
+
+	calls := make(chan call, 10)
+	runner.run = func(ctx context.Context, command []string, env []string) error {
+		calls <- call{command: command, env: env}
+		if command[0] == "bundle" {
+			return errors.New("oh no")
+		}
+		return nil
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = runner.Run(ctx) }()
+
+	runner.X509SVIDsRotated([]string{"spiffe://example.org/bar"})
+	assert.Equal(t, call{
+		command: []string{"all"},
+		env:     []string{"SPIRE_HOOK_EVENT=x509_svid", "SPIRE_HOOK_SPIFFE_IDS=spiffe://example.org/bar"},
+	}, nextCall(t, calls))
+
+	runner.X509SVIDsRotated([]string{"spiffe://example.org/foo", "spiffe://example.org/bar"})
+	assert.Equal(t, call{
+		command: []string{"all"},
+		env:     []string{"SPIRE_HOOK_EVENT=x509_svid", "SPIRE_HOOK_SPIFFE_IDS=spiffe://example.org/foo spiffe://example.org/bar"},
+	}, nextCall(t, calls))
+	assert.Equal(t, call{
+		command: []string{"svid", "-reload"},
+		env:     []string{"SPIRE_HOOK_EVENT=x509_svid", "SPIRE_HOOK_SPIFFE_IDS=spiffe://example.org/foo"},
+	}, nextCall(t, calls))
+
+	runner.BundlesUpdated([]string{"spiffe://example.org"})
+	assert.Equal(t, call{
+		command: []string{"all"},
+		env:     []string{"SPIRE_HOOK_EVENT=bundle", "SPIRE_HOOK_TRUST_DOMAINS=spiffe://example.org"},
+	}, nextCall(t, calls))
+	assert.Equal(t, call{
+		command: []string{"bundle"},
+		env:     []string{"SPIRE_HOOK_EVENT=bundle", "SPIRE_HOOK_TRUST_DOMAINS=spiffe://example.org"},
+	}, nextCall(t, calls))
+
+	// Nothing runs when nothing changed
+	runner.X509SVIDsRotated(nil)
+	runner.BundlesUpdated(nil)
+	select {
+	case c := <-calls:
+		t.Fatalf("unexpected call: %v", c)
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	entry := logHook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, logrus.ErrorLevel, entry.Level)
+	assert.Equal(t, "Rotation hook failed", entry.Message)
+}
+
+func TestRunnerTimeout(t *testing.T) {
+	log, logHook := test.NewNullLogger()
+	runner := New(log, []Hook{{Command: []string{"slow"}, Timeout: time.Millisecond}})
+
+	done := make(chan struct{})
+	runner.run = func(ctx context.Context, command []string, env []string) error {
+		defer close(done)
+		<-ctx.Done()
+		return ctx.Err()
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() { _ = runner.Run(ctx) }()
+
+	runner.BundlesUpdated([]string{"spiffe://example.org"})
+	select {
+	case <-done:
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for the hook to run")
+	}
+
+	require.Eventually(t, func() bool {
+		entry := logHook.LastEntry()
+		return entry != nil && entry.Message == "Rotation hook timed out"
+	}, time.Minute, 10*time.Millisecond)
+}
+
+func TestRunnerDropsEventsWhenFull(t *testing.T) {
+	log, logHook := test.NewNullLogger()
+	runner := New(log, []Hook{{Command: []string{"hook"}}})
+
+	// The runner is not running, so events queue up until the queue is full
+	for i := 0; i < pendingEvents+1; i++ {
+		runner.BundlesUpdated([]string{"spiffe://example.org"})
+	}
+	assert.Len(t, runner.events, pendingEvents)
+
+	entry := logHook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, "Too many pending rotation events; dropping event", entry.Message)
+}
+
+func TestParseEvent(t *testing.T) {
+	event, err := ParseEvent("x509_svid")
+	require.NoError(t, err)
+	assert.Equal(t, X509SVIDRotated, event)
+
+	event, err = ParseEvent("bundle")
+	require.NoError(t, err)
+	assert.Equal(t, BundleUpdated, event)
+
+	_, err = ParseEvent("jwt_svid")
+	require.EqualError(t, err, `unknown event "jwt_svid"`)
+}
+
+func nextCall(t *testing.T, calls chan call) call {
+	select {
+	case c := <-calls:
+		return c
+	case <-time.After(time.Minute):
+		t.Fatal("timed out waiting for hook to run")
+		return call{}
+	}
+}
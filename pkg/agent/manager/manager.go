@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"sort"
 	"sync"
 	"time"
 
@@ -14,9 +15,11 @@ import (
 	"github.com/spiffe/spire/pkg/agent/client"
 	"github.com/spiffe/spire/pkg/agent/common/backoff"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
+	"github.com/spiffe/spire/pkg/agent/manager/hooks"
 	"github.com/spiffe/spire/pkg/agent/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/agent/svid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/nodeutil"
 	"github.com/spiffe/spire/pkg/common/rotationutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
@@ -67,6 +70,10 @@ type Manager interface {
 	// FetchWorkloadUpdates gets the latest workload update for the selectors
 	FetchWorkloadUpdate(selectors []*common.Selector) *cache.WorkloadUpdate
 
+	// FetchWorkloadUpdateBySelectorType gets the latest workload update for
+	// the identities whose selectors are all of the given type
+	FetchWorkloadUpdateBySelectorType(selectorType string) *cache.WorkloadUpdate
+
 	// FetchJWTSVID returns a JWT SVID for the specified SPIFFEID and audience. If there
 	// is no JWT cached, the manager will get one signed upstream.
 	FetchJWTSVID(ctx context.Context, spiffeID string, audience []string) (*client.JWTSVID, error)
@@ -101,6 +108,9 @@ type manager struct {
 
 	clk clock.Clock
 
+	// hooks runs the commands configured to run on rotations
+	hooks *hooks.Runner
+
 	// Saves last success sync
 	lastSync time.Time
 }
@@ -131,6 +141,7 @@ func (m *manager) Run(ctx context.Context) error {
 		m.runSynchronizer,
 		m.runSVIDObserver,
 		m.runBundleObserver,
+		m.hooks.Run,
 		m.svid.Run)
 
 	switch {
@@ -184,6 +195,10 @@ func (m *manager) FetchWorkloadUpdate(selectors []*common.Selector) *cache.Workl
 	return m.cache.FetchWorkloadUpdate(selectors)
 }
 
+func (m *manager) FetchWorkloadUpdateBySelectorType(selectorType string) *cache.WorkloadUpdate {
+	return m.cache.FetchWorkloadUpdateBySelectorType(selectorType)
+}
+
 func (m *manager) FetchJWTSVID(ctx context.Context, spiffeID string, audience []string) (*client.JWTSVID, error) {
 	now := m.clk.Now()
 
@@ -196,6 +211,9 @@ func (m *manager) FetchJWTSVID(ctx context.Context, spiffeID string, audience []
 	if entryID == "" {
 		return nil, errors.New("no entry found")
 	}
+	if _, ok := idutil.TemplateEntryID(entryID); ok {
+		return nil, errors.New("JWT-SVIDs are not supported for entries with a SPIFFE ID template")
+	}
 
 	newSVID, err := m.client.NewJWTSVID(ctx, &node.JSR{
 		SpiffeId: spiffeID,
@@ -290,6 +308,7 @@ func (m *manager) runSVIDObserver(ctx context.Context) error {
 
 func (m *manager) runBundleObserver(ctx context.Context) error {
 	bundleStream := m.SubscribeToBundleChanges()
+	previous := bundleStream.Value()
 	for {
 		select {
 		case <-ctx.Done():
@@ -297,8 +316,23 @@ func (m *manager) runBundleObserver(ctx context.Context) error {
 		case <-bundleStream.Changes():
 			b := bundleStream.Next()
 			m.storeBundle(b[m.c.TrustDomain.String()])
+			m.hooks.BundlesUpdated(changedBundles(previous, b))
+			previous = b
+		}
+	}
+}
+
+// changedBundles returns the IDs of the trust domains whose bundle was
+// added or changed.
+func changedBundles(previous, current map[string]*cache.Bundle) []string {
+	var changed []string
+	for trustDomainID, bundle := range current {
+		if prev, ok := previous[trustDomainID]; !ok || !prev.EqualTo(bundle) {
+			changed = append(changed, trustDomainID)
 		}
 	}
+	sort.Strings(changed)
+	return changed
 }
 
 func (m *manager) storeSVID(svidChain []*x509.Certificate) {
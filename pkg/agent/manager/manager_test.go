@@ -37,6 +37,7 @@ import (
 	"github.com/spiffe/spire/proto/spire/types"
 	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakeagentcatalog"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/spiffe/spire/test/util"
 	"github.com/stretchr/testify/assert"
@@ -586,6 +587,78 @@ func TestSynchronization(t *testing.T) {
 	require.Equal(t, clk.Now(), m.GetLastSync())
 }
 
+func TestSynchronizationReusesWorkloadKeys(t *testing.T) {
+	for _, reuseKeys := range []bool{false, true} {
+		reuseKeys := reuseKeys
+		t.Run(fmt.Sprintf("reuse keys %t", reuseKeys), func(t *testing.T) {
+			dir := spiretest.TempDir(t)
+
+			clk := clock.NewMock(t)
+			api := newMockAPI(t, &mockAPIConfig{
+				getAuthorizedEntries: func(*mockAPI, int32, *entryv1.GetAuthorizedEntriesRequest) (*entryv1.GetAuthorizedEntriesResponse, error) {
+					return makeGetAuthorizedEntriesResponse(t, "resp1", "resp2"), nil
+				},
+				batchNewX509SVIDEntries: func(*mockAPI, int32) []*common.RegistrationEntry {
+					return makeBatchNewX509SVIDEntries("resp1", "resp2")
+				},
+				svidTTL: 3,
+				clk:     clk,
+			})
+
+			baseSVID, baseSVIDKey := api.newSVID("spiffe://"+trustDomain+"/spire/agent/join_token/abcd", 1*time.Hour)
+			cat := fakeagentcatalog.New()
+			cat.SetKeyManager(fakeagentcatalog.KeyManager(memory.New()))
+
+			metrics := fakemetrics.New()
+			c := &Config{
+				ServerAddr:        api.addr,
+				SVID:              baseSVID,
+				SVIDKey:           baseSVIDKey,
+				Log:               testLogger,
+				TrustDomain:       trustDomainID,
+				SVIDCachePath:     path.Join(dir, "svid.der"),
+				BundleCachePath:   path.Join(dir, "bundle.der"),
+				Bundle:            api.bundle,
+				Metrics:           metrics,
+				RotationInterval:  time.Hour,
+				SyncInterval:      time.Hour,
+				Clk:               clk,
+				Catalog:           cat,
+				ReuseWorkloadKeys: reuseKeys,
+			}
+
+			m := newManager(c)
+			require.NoError(t, m.Initialize(context.Background()))
+			identitiesBefore := identitiesByEntryID(m.cache.Identities())
+			require.Len(t, identitiesBefore, 3)
+
+			// The SVIDs are past their half-life after 2 seconds
+			clk.Add(2 * time.Second)
+			require.NoError(t, m.synchronize(context.Background()))
+
+			identitiesAfter := identitiesByEntryID(m.cache.Identities())
+			require.Len(t, identitiesAfter, 3)
+			for key, before := range identitiesBefore {
+				after := identitiesAfter[key]
+				require.NotEqual(t, before.SVID, after.SVID, "SVID was not renewed")
+				if reuseKeys {
+					require.Equal(t, before.PrivateKey, after.PrivateKey)
+				} else {
+					require.NotEqual(t, before.PrivateKey, after.PrivateKey)
+				}
+			}
+
+			var keyAges []float32
+			for _, metric := range metrics.AllMetrics() {
+				if metric.Type == fakemetrics.AddSampleType && metric.Key[len(metric.Key)-1] == telemetry.WorkloadKeyAge {
+					keyAges = append(keyAges, metric.Val)
+				}
+			}
+			require.Equal(t, []float32{2, 2, 2}, keyAges)
+		})
+	}
+}
+
 func TestSynchronizationClearsStaleCacheEntries(t *testing.T) {
 	dir := spiretest.TempDir(t)
 
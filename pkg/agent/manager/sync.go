@@ -2,6 +2,7 @@ package manager
 
 import (
 	"context"
+	"crypto"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -23,6 +24,7 @@ type csrRequest struct {
 	EntryID              string
 	SpiffeID             string
 	CurrentSVIDExpiresAt time.Time
+	CurrentSVID          *cache.X509SVID
 }
 
 // synchronize hits the node api, checks for entries we haven't fetched yet, and fetches them.
@@ -88,6 +90,7 @@ func (m *manager) synchronize(ctx context.Context) (err error) {
 				EntryID:              staleEntry.Entry.EntryId,
 				SpiffeID:             staleEntry.Entry.SpiffeId,
 				CurrentSVIDExpiresAt: staleEntry.ExpiresAt,
+				CurrentSVID:          staleEntry.SVID,
 			})
 		}
 
@@ -97,6 +100,7 @@ func (m *manager) synchronize(ctx context.Context) (err error) {
 		}
 		// the values in `update` now belong to the cache. DO NOT MODIFY.
 		m.cache.UpdateSVIDs(update)
+		m.hooks.X509SVIDsRotated(rotatedSPIFFEIDs(csrs, update))
 	}
 
 	// Set last success sync
@@ -104,6 +108,17 @@ func (m *manager) synchronize(ctx context.Context) (err error) {
 	return nil
 }
 
+// rotatedSPIFFEIDs returns the SPIFFE IDs of the SVIDs in the update.
+func rotatedSPIFFEIDs(csrs []csrRequest, update *cache.UpdateSVIDs) []string {
+	var spiffeIDs []string
+	for _, csr := range csrs {
+		if _, ok := update.X509SVIDs[csr.EntryID]; ok {
+			spiffeIDs = append(spiffeIDs, csr.SpiffeID)
+		}
+	}
+	return spiffeIDs
+}
+
 func (m *manager) fetchSVIDs(ctx context.Context, csrs []csrRequest) (_ *cache.UpdateSVIDs, err error) {
 	// Put all the CSRs in an array to make just one call with all the CSRs.
 	counter := telemetry_agent.StartManagerFetchSVIDsUpdatesCall(m.c.Metrics)
@@ -111,7 +126,8 @@ func (m *manager) fetchSVIDs(ctx context.Context, csrs []csrRequest) (_ *cache.U
 
 	csrsIn := make(map[string][]byte)
 
-	privateKeys := make(map[string]*ecdsa.PrivateKey, len(csrs))
+	privateKeys := make(map[string]crypto.Signer, len(csrs))
+	keysCreatedAt := make(map[string]time.Time, len(csrs))
 	for _, csr := range csrs {
 		log := m.c.Log.WithField("spiffe_id", csr.SpiffeID)
 		if !csr.CurrentSVIDExpiresAt.IsZero() {
@@ -125,11 +141,31 @@ func (m *manager) fetchSVIDs(ctx context.Context, csrs []csrRequest) (_ *cache.U
 		}
 
 		log.Info("Renewing X509-SVID")
-		privateKey, csrBytes, err := newCSR(csr.SpiffeID)
+		now := m.c.Clk.Now()
+		keyCreatedAt := now
+		var privateKey crypto.Signer
+		if csr.CurrentSVID != nil {
+			if !csr.CurrentSVID.KeyCreatedAt.IsZero() {
+				telemetry_agent.AddCacheManagerWorkloadKeyAgeSample(m.c.Metrics, now.Sub(csr.CurrentSVID.KeyCreatedAt))
+			}
+			if m.c.ReuseWorkloadKeys {
+				privateKey = csr.CurrentSVID.PrivateKey
+				keyCreatedAt = csr.CurrentSVID.KeyCreatedAt
+			}
+		}
+
+		var csrBytes []byte
+		var err error
+		if privateKey != nil {
+			csrBytes, err = util.MakeCSR(privateKey, csr.SpiffeID)
+		} else {
+			privateKey, csrBytes, err = newCSR(csr.SpiffeID)
+		}
 		if err != nil {
 			return nil, err
 		}
 		privateKeys[csr.EntryID] = privateKey
+		keysCreatedAt[csr.EntryID] = keyCreatedAt
 		csrsIn[csr.EntryID] = csrBytes
 	}
 
@@ -149,8 +185,9 @@ func (m *manager) fetchSVIDs(ctx context.Context, csrs []csrRequest) (_ *cache.U
 			return nil, err
 		}
 		byEntryID[entryID] = &cache.X509SVID{
-			Chain:      chain,
-			PrivateKey: privateKey,
+			Chain:        chain,
+			PrivateKey:   privateKey,
+			KeyCreatedAt: keysCreatedAt[entryID],
 		}
 	}
 
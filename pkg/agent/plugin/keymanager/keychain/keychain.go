@@ -0,0 +1,161 @@
+package keychain
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"errors"
+	"sync"
+
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/keymanager"
+	"github.com/spiffe/spire/pkg/common/catalog"
+
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+)
+
+const (
+	pluginName = "keychain"
+
+	defaultService = "spire-agent"
+	defaultAccount = "svid.key"
+)
+
+// keychain stores and retrieves secrets identified by a service and an
+// account, like the generic password items of the macOS keychain.
+type keychain interface {
+	// Get returns the secret of the item, and false if there is no such item.
+	Get(service, account string) ([]byte, bool, error)
+
+	// Set creates the item, or replaces its secret if it already exists.
+	Set(service, account string, secret []byte) error
+}
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName, keymanager.PluginServer(p))
+}
+
+type Config struct {
+	Service string `hcl:"service" json:"service"`
+	Account string `hcl:"account" json:"account"`
+}
+
+type Plugin struct {
+	keymanager.UnsafeKeyManagerServer
+
+	// keychain is nil on platforms without keychain support
+	keychain keychain
+
+	mtx     sync.RWMutex
+	service string
+	account string
+}
+
+func New() *Plugin {
+	return newPlugin(newKeychain())
+}
+
+func newPlugin(keychain keychain) *Plugin {
+	return &Plugin{
+		keychain: keychain,
+	}
+}
+
+func (p *Plugin) GenerateKeyPair(context.Context, *keymanager.GenerateKeyPairRequest) (*keymanager.GenerateKeyPairResponse, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, err
+	}
+
+	privData, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	pubData, err := x509.MarshalPKIXPublicKey(&key.PublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanager.GenerateKeyPairResponse{PublicKey: pubData, PrivateKey: privData}, nil
+}
+
+func (p *Plugin) StorePrivateKey(ctx context.Context, req *keymanager.StorePrivateKeyRequest) (*keymanager.StorePrivateKeyResponse, error) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	if p.service == "" {
+		return nil, errors.New("not configured")
+	}
+
+	if err := p.keychain.Set(p.service, p.account, req.PrivateKey); err != nil {
+		return nil, err
+	}
+
+	return &keymanager.StorePrivateKeyResponse{}, nil
+}
+
+func (p *Plugin) FetchPrivateKey(context.Context, *keymanager.FetchPrivateKeyRequest) (*keymanager.FetchPrivateKeyResponse, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	if p.service == "" {
+		return nil, errors.New("not configured")
+	}
+
+	data, ok, err := p.keychain.Get(p.service, p.account)
+	if err != nil {
+		return nil, err
+	}
+	if !ok {
+		return &keymanager.FetchPrivateKeyResponse{PrivateKey: []byte{}}, nil
+	}
+
+	// Check key integrity first
+	key, err := x509.ParseECPrivateKey(data)
+	if err != nil {
+		return nil, err
+	}
+
+	privData, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return &keymanager.FetchPrivateKeyResponse{PrivateKey: privData}, nil
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	if p.keychain == nil {
+		return nil, errors.New("the keychain key manager is only supported on macOS")
+	}
+
+	config := &Config{}
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, err
+	}
+	if config.Service == "" {
+		config.Service = defaultService
+	}
+	if config.Account == "" {
+		config.Account = defaultAccount
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.service = config.Service
+	p.account = config.Account
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
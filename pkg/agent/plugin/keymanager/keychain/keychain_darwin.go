@@ -0,0 +1,131 @@
+// +build cgo
+
+package keychain
+
+/*
+#cgo LDFLAGS: -framework CoreFoundation -framework Security
+#include <stdlib.h>
+#include <CoreFoundation/CoreFoundation.h>
+#include <Security/Security.h>
+
+// newQuery returns a query matching the generic password item with the given
+// service and account. The caller must release it.
+static CFMutableDictionaryRef newQuery(const char *service, const char *account) {
+	CFMutableDictionaryRef query = CFDictionaryCreateMutable(kCFAllocatorDefault, 0,
+		&kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+	CFStringRef serviceRef = CFStringCreateWithCString(kCFAllocatorDefault, service, kCFStringEncodingUTF8);
+	CFStringRef accountRef = CFStringCreateWithCString(kCFAllocatorDefault, account, kCFStringEncodingUTF8);
+	CFDictionarySetValue(query, kSecClass, kSecClassGenericPassword);
+	CFDictionarySetValue(query, kSecAttrService, serviceRef);
+	CFDictionarySetValue(query, kSecAttrAccount, accountRef);
+	CFRelease(serviceRef);
+	CFRelease(accountRef);
+	return query;
+}
+
+// keychainGet copies the secret of the item into data, which the caller must
+// release on success.
+static OSStatus keychainGet(const char *service, const char *account, CFDataRef *data) {
+	CFMutableDictionaryRef query = newQuery(service, account);
+	CFDictionarySetValue(query, kSecReturnData, kCFBooleanTrue);
+	CFDictionarySetValue(query, kSecMatchLimit, kSecMatchLimitOne);
+	OSStatus status = SecItemCopyMatching(query, (CFTypeRef *)data);
+	CFRelease(query);
+	return status;
+}
+
+// keychainSet replaces the secret of the item, adding the item if it does not
+// exist yet.
+static OSStatus keychainSet(const char *service, const char *account, const void *secret, CFIndex length) {
+	CFDataRef data = CFDataCreate(kCFAllocatorDefault, secret, length);
+	CFMutableDictionaryRef query = newQuery(service, account);
+	CFMutableDictionaryRef update = CFDictionaryCreateMutable(kCFAllocatorDefault, 0,
+		&kCFTypeDictionaryKeyCallBacks, &kCFTypeDictionaryValueCallBacks);
+	CFDictionarySetValue(update, kSecValueData, data);
+
+	OSStatus status = SecItemUpdate(query, update);
+	if (status == errSecItemNotFound) {
+		CFDictionarySetValue(query, kSecValueData, data);
+		status = SecItemAdd(query, NULL);
+	}
+
+	CFRelease(update);
+	CFRelease(query);
+	CFRelease(data);
+	return status;
+}
+
+// copyStatusMessage returns a description of the status, or NULL if there is
+// none. The caller must free it.
+static char *copyStatusMessage(OSStatus status) {
+	CFStringRef message = SecCopyErrorMessageString(status, NULL);
+	if (message == NULL) {
+		return NULL;
+	}
+	CFIndex size = CFStringGetMaximumSizeForEncoding(CFStringGetLength(message), kCFStringEncodingUTF8) + 1;
+	char *buf = malloc(size);
+	if (!CFStringGetCString(message, buf, size, kCFStringEncodingUTF8)) {
+		free(buf);
+		buf = NULL;
+	}
+	CFRelease(message);
+	return buf;
+}
+*/
+import "C"
+
+import (
+	"fmt"
+	"unsafe"
+)
+
+// systemKeychain stores secrets as generic password items in the default
+// keychain of the user running the agent, i.e. the System keychain for
+// daemons.
+type systemKeychain struct{}
+
+func newKeychain() keychain {
+	return systemKeychain{}
+}
+
+func (systemKeychain) Get(service, account string) ([]byte, bool, error) {
+	cService := C.CString(service)
+	defer C.free(unsafe.Pointer(cService))
+	cAccount := C.CString(account)
+	defer C.free(unsafe.Pointer(cAccount))
+
+	var data C.CFDataRef
+	switch status := C.keychainGet(cService, cAccount, &data); status {
+	case C.errSecSuccess:
+	case C.errSecItemNotFound:
+		return nil, false, nil
+	default:
+		return nil, false, statusError("failed to get key from keychain", status)
+	}
+	defer C.CFRelease(C.CFTypeRef(data))
+
+	return C.GoBytes(unsafe.Pointer(C.CFDataGetBytePtr(data)), C.int(C.CFDataGetLength(data))), true, nil
+}
+
+func (systemKeychain) Set(service, account string, secret []byte) error {
+	cService := C.CString(service)
+	defer C.free(unsafe.Pointer(cService))
+	cAccount := C.CString(account)
+	defer C.free(unsafe.Pointer(cAccount))
+	cSecret := C.CBytes(secret)
+	defer C.free(cSecret)
+
+	if status := C.keychainSet(cService, cAccount, cSecret, C.CFIndex(len(secret))); status != C.errSecSuccess {
+		return statusError("failed to store key in keychain", status)
+	}
+	return nil
+}
+
+func statusError(msg string, status C.OSStatus) error {
+	description := C.copyStatusMessage(status)
+	if description == nil {
+		return fmt.Errorf("%s: status %d", msg, int(status))
+	}
+	defer C.free(unsafe.Pointer(description))
+	return fmt.Errorf("%s: %s (status %d)", msg, C.GoString(description), int(status))
+}
@@ -0,0 +1,9 @@
+// +build !darwin !cgo
+
+package keychain
+
+// newKeychain returns nil since the keychain is only available on macOS, and
+// accessing it requires cgo.
+func newKeychain() keychain {
+	return nil
+}
@@ -0,0 +1,135 @@
+package keychain
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/spiffe/spire/pkg/agent/plugin/keymanager"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+)
+
+var (
+	ctx = context.Background()
+)
+
+func TestKeychain_StoreAndFetchPrivateKey(t *testing.T) {
+	keychain := newFakeKeychain()
+	plugin := newConfiguredPlugin(t, keychain, "")
+
+	// No key is stored yet
+	fetchResp, err := plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.NoError(t, err)
+	assert.Empty(t, fetchResp.PrivateKey)
+
+	genResp, err := plugin.GenerateKeyPair(ctx, &keymanager.GenerateKeyPairRequest{})
+	require.NoError(t, err)
+	_, err = x509.ParseECPrivateKey(genResp.PrivateKey)
+	require.NoError(t, err)
+
+	_, err = plugin.StorePrivateKey(ctx, &keymanager.StorePrivateKeyRequest{PrivateKey: genResp.PrivateKey})
+	require.NoError(t, err)
+	assert.Equal(t, genResp.PrivateKey, keychain.items[defaultService+"/"+defaultAccount])
+
+	fetchResp, err = plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, genResp.PrivateKey, fetchResp.PrivateKey)
+
+	// Storing again replaces the key
+	genResp, err = plugin.GenerateKeyPair(ctx, &keymanager.GenerateKeyPairRequest{})
+	require.NoError(t, err)
+	_, err = plugin.StorePrivateKey(ctx, &keymanager.StorePrivateKeyRequest{PrivateKey: genResp.PrivateKey})
+	require.NoError(t, err)
+	fetchResp, err = plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, genResp.PrivateKey, fetchResp.PrivateKey)
+}
+
+func TestKeychain_FetchPrivateKeyFailures(t *testing.T) {
+	keychain := newFakeKeychain()
+	plugin := newConfiguredPlugin(t, keychain, "")
+
+	keychain.items[defaultService+"/"+defaultAccount] = []byte("not a key")
+	_, err := plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.Error(t, err)
+
+	keychain.err = errors.New("oh no")
+	_, err = plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.EqualError(t, err, "oh no")
+	_, err = plugin.StorePrivateKey(ctx, &keymanager.StorePrivateKeyRequest{PrivateKey: []byte("key")})
+	require.EqualError(t, err, "oh no")
+}
+
+func TestKeychain_Configure(t *testing.T) {
+	keychain := newFakeKeychain()
+	plugin := newConfiguredPlugin(t, keychain, `
+		service = "example"
+		account = "agent.key"
+	`)
+	assert.Equal(t, "example", plugin.service)
+	assert.Equal(t, "agent.key", plugin.account)
+
+	_, err := plugin.StorePrivateKey(ctx, &keymanager.StorePrivateKeyRequest{PrivateKey: []byte("key")})
+	require.NoError(t, err)
+	assert.Equal(t, map[string][]byte{"example/agent.key": []byte("key")}, keychain.items)
+}
+
+func TestKeychain_NotConfigured(t *testing.T) {
+	plugin := newPlugin(newFakeKeychain())
+
+	_, err := plugin.FetchPrivateKey(ctx, &keymanager.FetchPrivateKeyRequest{})
+	require.EqualError(t, err, "not configured")
+	_, err = plugin.StorePrivateKey(ctx, &keymanager.StorePrivateKeyRequest{PrivateKey: []byte("key")})
+	require.EqualError(t, err, "not configured")
+}
+
+func TestKeychain_ConfigureUnsupported(t *testing.T) {
+	plugin := newPlugin(nil)
+
+	_, err := plugin.Configure(ctx, &spi.ConfigureRequest{})
+	require.EqualError(t, err, "the keychain key manager is only supported on macOS")
+}
+
+func TestKeychain_GetPluginInfo(t *testing.T) {
+	plugin := New()
+	_, e := plugin.GetPluginInfo(ctx, &spi.GetPluginInfoRequest{})
+	require.NoError(t, e)
+}
+
+func newConfiguredPlugin(t *testing.T, keychain keychain, config string) *Plugin {
+	plugin := newPlugin(keychain)
+	_, err := plugin.Configure(ctx, &spi.ConfigureRequest{Configuration: config})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeKeychain struct {
+	items map[string][]byte
+	err   error
+}
+
+func newFakeKeychain() *fakeKeychain {
+	return &fakeKeychain{
+		items: make(map[string][]byte),
+	}
+}
+
+func (k *fakeKeychain) Get(service, account string) ([]byte, bool, error) {
+	if k.err != nil {
+		return nil, false, k.err
+	}
+	secret, ok := k.items[service+"/"+account]
+	return secret, ok, nil
+}
+
+func (k *fakeKeychain) Set(service, account string, secret []byte) error {
+	if k.err != nil {
+		return k.err
+	}
+	k.items[service+"/"+account] = secret
+	return nil
+}
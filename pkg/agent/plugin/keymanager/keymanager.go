@@ -27,6 +27,9 @@ const (
 	Type = "KeyManager"
 )
 
+// Version is the version of the KeyManager definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // KeyManager is the client interface for the service type KeyManager interface.
 type KeyManager interface {
 	FetchPrivateKey(context.Context, *FetchPrivateKeyRequest) (*FetchPrivateKeyResponse, error)
@@ -43,6 +46,11 @@ type Plugin interface {
 	StorePrivateKey(context.Context, *StorePrivateKeyRequest) (*StorePrivateKeyResponse, error)
 }
 
+// Serve serves the KeyManager plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server KeyManagerServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the KeyManager plugin.
 func PluginServer(server KeyManagerServer) catalog.PluginServer {
 	return &pluginServer{
@@ -58,6 +66,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -76,6 +88,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(keymanager.NewKeyManagerClient(conn))
 }
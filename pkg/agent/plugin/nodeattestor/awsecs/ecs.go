@@ -0,0 +1,195 @@
+package awsecs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/sts"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ecsError = caws.ECSErrorClass
+
+	metadataTimeout = 5 * time.Second
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *ECSAttestorPlugin) catalog.Plugin {
+	return catalog.MakePlugin(caws.ECSPluginName, nodeattestor.PluginServer(p))
+}
+
+// ECSAttestorConfig configures a ECSAttestorPlugin.
+type ECSAttestorConfig struct {
+	TaskMetadataEndpoint string `hcl:"task_metadata_endpoint"`
+	trustDomain          string
+}
+
+// ECSAttestorPlugin implements aws ecs nodeattestation in the agent.
+type ECSAttestorPlugin struct {
+	nodeattestor.UnsafeNodeAttestorServer
+
+	log    hclog.Logger
+	config *ECSAttestorConfig
+	mtx    sync.RWMutex
+
+	hooks struct {
+		getenv     func(string) string
+		newSession func(*aws.Config) (*session.Session, error)
+	}
+}
+
+// New creates a new ECSAttestorPlugin.
+func New() *ECSAttestorPlugin {
+	p := &ECSAttestorPlugin{}
+	p.hooks.getenv = os.Getenv
+	p.hooks.newSession = func(config *aws.Config) (*session.Session, error) {
+		return session.NewSession(config)
+	}
+	return p
+}
+
+func (p *ECSAttestorPlugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+// FetchAttestationData fetches the task metadata from the task metadata
+// endpoint and signs a GetCallerIdentity request with the credentials of the
+// task role, which the server uses to verify that the agent runs in the task.
+func (p *ECSAttestorPlugin) FetchAttestationData(stream nodeattestor.NodeAttestor_FetchAttestationDataServer) error {
+	c, err := p.getConfig()
+	if err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), metadataTimeout)
+	defer cancel()
+
+	task, err := caws.FetchECSTaskMetadata(ctx, http.DefaultClient, c.TaskMetadataEndpoint)
+	if err != nil {
+		return caws.ECSAttestationStepError("fetching the task metadata", err)
+	}
+
+	taskARN, err := arn.Parse(task.TaskARN)
+	if err != nil {
+		return caws.ECSAttestationStepError("parsing the task ARN", err)
+	}
+
+	callerIdentityRequest, err := p.signCallerIdentityRequest(taskARN.Region, c.trustDomain)
+	if err != nil {
+		return caws.ECSAttestationStepError("signing the GetCallerIdentity request", err)
+	}
+
+	respData, err := json.Marshal(caws.ECSAttestationData{
+		TaskARN:               task.TaskARN,
+		Cluster:               task.Cluster,
+		CallerIdentityRequest: *callerIdentityRequest,
+	})
+	if err != nil {
+		return caws.ECSAttestationStepError("marshaling the attested data", err)
+	}
+
+	return stream.Send(&nodeattestor.FetchAttestationDataResponse{
+		AttestationData: &common.AttestationData{
+			Type: caws.ECSPluginName,
+			Data: respData,
+		},
+	})
+}
+
+func (p *ECSAttestorPlugin) signCallerIdentityRequest(region, trustDomain string) (*caws.SignedRequest, error) {
+	sess, err := p.hooks.newSession(aws.NewConfig().
+		WithRegion(region).
+		WithSTSRegionalEndpoint(endpoints.RegionalSTSEndpoint))
+	if err != nil {
+		return nil, err
+	}
+
+	req, _ := sts.New(sess).GetCallerIdentityRequest(&sts.GetCallerIdentityInput{})
+	req.HTTPRequest.Header.Set(caws.ECSTrustDomainHeader, trustDomain)
+	if err := req.Sign(); err != nil {
+		return nil, err
+	}
+
+	body := req.GetBody()
+	if _, err := body.Seek(0, io.SeekStart); err != nil {
+		return nil, err
+	}
+	bodyData, err := ioutil.ReadAll(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &caws.SignedRequest{
+		Host:    req.HTTPRequest.URL.Host,
+		Headers: req.HTTPRequest.Header,
+		Body:    bodyData,
+	}, nil
+}
+
+// Configure configures the ECSAttestorPlugin.
+func (p *ECSAttestorPlugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	// Parse HCL config payload into config struct
+	config := &ECSAttestorConfig{}
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if req.GlobalConfig == nil {
+		return nil, ecsError.New("global configuration is required")
+	}
+	if req.GlobalConfig.TrustDomain == "" {
+		return nil, ecsError.New("trust_domain is required")
+	}
+	config.trustDomain = req.GlobalConfig.TrustDomain
+
+	if config.TaskMetadataEndpoint == "" {
+		config.TaskMetadataEndpoint = p.hooks.getenv(caws.ECSTaskMetadataEnv)
+		if config.TaskMetadataEndpoint == "" {
+			return nil, ecsError.New("task_metadata_endpoint is required when %s is not set", caws.ECSTaskMetadataEnv)
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.config = config
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+// GetPluginInfo returns the version and other metadata of the plugin.
+func (*ECSAttestorPlugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *ECSAttestorPlugin) getConfig() (*ECSAttestorConfig, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	if p.config == nil {
+		return nil, ecsError.New("not configured")
+	}
+	return p.config, nil
+}
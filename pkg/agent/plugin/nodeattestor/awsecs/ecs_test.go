@@ -0,0 +1,155 @@
+package awsecs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+)
+
+const (
+	testTaskARN = "arn:aws:ecs:us-west-2:123456789012:task/default/0123456789abcdef0123456789abcdef"
+	testCluster = "arn:aws:ecs:us-west-2:123456789012:cluster/default"
+)
+
+func TestECSAttestorPlugin(t *testing.T) {
+	spiretest.Run(t, new(Suite))
+}
+
+type Suite struct {
+	spiretest.Suite
+
+	p      nodeattestor.Plugin
+	server *httptest.Server
+	status int
+	env    map[string]string
+}
+
+func (s *Suite) SetupTest() {
+	s.server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		if req.URL.Path != "/v4/task" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.WriteHeader(s.status)
+		_, _ = fmt.Fprintf(w, `{"Cluster": %q, "TaskARN": %q, "Family": "web", "Revision": "3"}`, testCluster, testTaskARN)
+	}))
+	s.status = http.StatusOK
+	s.env = map[string]string{
+		caws.ECSTaskMetadataEnv: s.server.URL + "/v4",
+	}
+
+	s.p = s.newPlugin()
+	s.configure("")
+}
+
+func (s *Suite) TearDownTest() {
+	s.server.Close()
+}
+
+func (s *Suite) TestErrorWhenNotConfigured() {
+	p := s.newPlugin()
+	stream, err := p.FetchAttestationData(context.Background())
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(stream.CloseSend())
+	}()
+	resp, err := stream.Recv()
+	s.RequireErrorContains(err, "aws-ecs: not configured")
+	s.Require().Nil(resp)
+}
+
+func (s *Suite) TestUnexpectedStatus() {
+	s.status = http.StatusBadGateway
+	_, err := s.fetchAttestationData()
+	s.RequireErrorContains(err, "fetching the task metadata: unexpected status code from task metadata endpoint: 502")
+}
+
+func (s *Suite) TestSuccessfulAttestationData() {
+	resp, err := s.fetchAttestationData()
+	s.Require().NoError(err)
+	s.Require().Equal(caws.ECSPluginName, resp.AttestationData.Type)
+
+	data := new(caws.ECSAttestationData)
+	s.Require().NoError(json.Unmarshal(resp.AttestationData.Data, data))
+	s.Require().Equal(testTaskARN, data.TaskARN)
+	s.Require().Equal(testCluster, data.Cluster)
+
+	// The request is signed for the regional STS endpoint, with the task
+	// credentials, and binds the trust domain of the agent
+	request := data.CallerIdentityRequest
+	s.Require().Equal("sts.us-west-2.amazonaws.com", request.Host)
+	s.Require().Equal("Action=GetCallerIdentity&Version=2011-06-15", string(request.Body))
+	s.Require().Equal("example.org", request.Headers.Get(caws.ECSTrustDomainHeader))
+	s.Require().Equal("TOKEN", request.Headers.Get("X-Amz-Security-Token"))
+	s.Require().Contains(request.Headers.Get("Authorization"), "Credential=AKID/")
+	s.Require().Contains(request.Headers.Get("Authorization"), "x-spire-trust-domain")
+}
+
+func (s *Suite) TestConfigure() {
+	require := s.Require()
+
+	// malformed
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+		Configuration: `trust_domain`,
+	})
+	require.Error(err)
+
+	// missing trust domain
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig: &plugin.ConfigureRequest_GlobalConfig{},
+	})
+	s.RequireErrorContains(err, "trust_domain is required")
+
+	// missing task metadata endpoint
+	s.env = nil
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig: &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.RequireErrorContains(err, "task_metadata_endpoint is required when ECS_CONTAINER_METADATA_URI_V4 is not set")
+
+	// explicit task metadata endpoint
+	s.configure(fmt.Sprintf("task_metadata_endpoint = %q", s.server.URL+"/v4"))
+	_, err = s.fetchAttestationData()
+	require.NoError(err)
+}
+
+func (s *Suite) newPlugin() nodeattestor.Plugin {
+	p := New()
+	p.hooks.getenv = func(key string) string {
+		return s.env[key]
+	}
+	p.hooks.newSession = func(config *aws.Config) (*session.Session, error) {
+		return session.NewSession(config.WithCredentials(credentials.NewStaticCredentials("AKID", "SECRET", "TOKEN")))
+	}
+
+	var plugin nodeattestor.Plugin
+	s.LoadPlugin(builtin(p), &plugin)
+	return plugin
+}
+
+func (s *Suite) configure(config string) {
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: config,
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) fetchAttestationData() (*nodeattestor.FetchAttestationDataResponse, error) {
+	stream, err := s.p.FetchAttestationData(context.Background())
+	s.NoError(err)
+	s.NoError(stream.CloseSend())
+	return stream.Recv()
+}
@@ -0,0 +1,167 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os/exec"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/plugin/vsphere"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/proto/spire/common/plugin"
+)
+
+const (
+	pluginName = vsphere.PluginName
+
+	defaultUUIDPath    = "/sys/class/dmi/id/product_uuid"
+	defaultRPCToolPath = "vmware-rpctool"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName, nodeattestor.PluginServer(p))
+}
+
+type Config struct {
+	// UUIDPath is the path to the file holding the BIOS UUID of the VM.
+	UUIDPath string `hcl:"uuid_path"`
+	// RPCToolPath is the path to the VMware Tools vmware-rpctool binary, used
+	// to read the challenge from the guestinfo of the VM.
+	RPCToolPath string `hcl:"rpctool_path"`
+}
+
+type Plugin struct {
+	nodeattestor.UnsafeNodeAttestorServer
+
+	m sync.Mutex
+	c *Config
+
+	hooks struct {
+		readFile      func(string) ([]byte, error)
+		readGuestInfo func(rpcToolPath, key string) (string, error)
+	}
+}
+
+func New() *Plugin {
+	p := &Plugin{}
+	p.hooks.readFile = ioutil.ReadFile
+	p.hooks.readGuestInfo = readGuestInfo
+	return p
+}
+
+func (p *Plugin) FetchAttestationData(stream nodeattestor.NodeAttestor_FetchAttestationDataServer) error {
+	config := p.getConfig()
+	if config == nil {
+		return errors.New("vsphere: not configured")
+	}
+
+	uuidBytes, err := p.hooks.readFile(config.UUIDPath)
+	if err != nil {
+		return fmt.Errorf("vsphere: unable to read VM UUID: %v", err)
+	}
+	uuid, err := vsphere.NormalizeUUID(string(uuidBytes))
+	if err != nil {
+		return fmt.Errorf("vsphere: %v", err)
+	}
+
+	attestationDataBytes, err := json.Marshal(vsphere.AttestationData{
+		UUID: uuid,
+	})
+	if err != nil {
+		return fmt.Errorf("vsphere: unable to marshal attestation data: %v", err)
+	}
+
+	if err := stream.Send(&nodeattestor.FetchAttestationDataResponse{
+		AttestationData: &common.AttestationData{
+			Type: pluginName,
+			Data: attestationDataBytes,
+		},
+	}); err != nil {
+		return err
+	}
+
+	// receive challenge
+	resp, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	challenge := new(vsphere.Challenge)
+	if err := json.Unmarshal(resp.Challenge, challenge); err != nil {
+		return fmt.Errorf("vsphere: unable to unmarshal challenge: %v", err)
+	}
+	if err := vsphere.ValidateGuestInfoKey(challenge.Key); err != nil {
+		return fmt.Errorf("vsphere: %v", err)
+	}
+
+	// read the nonce the server delivered to the guestinfo of the VM
+	nonce, err := p.hooks.readGuestInfo(config.RPCToolPath, challenge.Key)
+	if err != nil {
+		return fmt.Errorf("vsphere: unable to read challenge from guestinfo: %v", err)
+	}
+
+	responseBytes, err := json.Marshal(vsphere.Response{
+		Nonce: nonce,
+	})
+	if err != nil {
+		return fmt.Errorf("vsphere: unable to marshal challenge response: %v", err)
+	}
+
+	return stream.Send(&nodeattestor.FetchAttestationDataResponse{
+		Response: responseBytes,
+	})
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *plugin.ConfigureRequest) (*plugin.ConfigureResponse, error) {
+	// Parse HCL config payload into config struct
+	config := new(Config)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, fmt.Errorf("vsphere: unable to decode configuration: %v", err)
+	}
+
+	if config.UUIDPath == "" {
+		config.UUIDPath = defaultUUIDPath
+	}
+	if config.RPCToolPath == "" {
+		config.RPCToolPath = defaultRPCToolPath
+	}
+
+	p.setConfig(config)
+
+	return &plugin.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(ctx context.Context, req *plugin.GetPluginInfoRequest) (*plugin.GetPluginInfoResponse, error) {
+	return &plugin.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfig() *Config {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.c
+}
+
+func (p *Plugin) setConfig(c *Config) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.c = c
+}
+
+func readGuestInfo(rpcToolPath, key string) (string, error) {
+	out, err := exec.Command(rpcToolPath, "info-get "+key).Output() //nolint: gosec // the key is validated
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(out)), nil
+}
@@ -0,0 +1,173 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
+	"github.com/spiffe/spire/pkg/common/plugin/vsphere"
+	"github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	testUUID = "4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1"
+)
+
+func TestVSphere(t *testing.T) {
+	spiretest.Run(t, new(Suite))
+}
+
+type Suite struct {
+	spiretest.Suite
+
+	p         nodeattestor.Plugin
+	files     map[string]string
+	guestInfo map[string]string
+}
+
+func (s *Suite) SetupTest() {
+	s.files = map[string]string{
+		defaultUUIDPath: "4211D0E4-1F5C-3C56-E2A3-6A7DC9A4F4B1\n",
+	}
+	s.guestInfo = map[string]string{
+		vsphere.ChallengeKey: "nonce",
+	}
+
+	p := New()
+	p.hooks.readFile = func(path string) ([]byte, error) {
+		data, ok := s.files[path]
+		if !ok {
+			return nil, errors.New("no such file")
+		}
+		return []byte(data), nil
+	}
+	p.hooks.readGuestInfo = func(rpcToolPath, key string) (string, error) {
+		s.Require().Equal(defaultRPCToolPath, rpcToolPath)
+		value, ok := s.guestInfo[key]
+		if !ok {
+			return "", errors.New("no value found")
+		}
+		return value, nil
+	}
+	s.LoadPlugin(builtin(p), &s.p)
+}
+
+func (s *Suite) TestFetchAttestationDataSuccess() {
+	s.configure()
+
+	stream, err := s.p.FetchAttestationData(context.Background())
+	s.Require().NoError(err)
+
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Equal("vsphere", resp.AttestationData.Type)
+	s.Require().JSONEq(`{"uuid":"`+testUUID+`"}`, string(resp.AttestationData.Data))
+
+	s.Require().NoError(stream.Send(&nodeattestor.FetchAttestationDataRequest{
+		Challenge: s.marshal(vsphere.Challenge{Key: vsphere.ChallengeKey}),
+	}))
+
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().JSONEq(`{"nonce":"nonce"}`, string(resp.Response))
+}
+
+func (s *Suite) TestFetchAttestationDataFailures() {
+	s.configure()
+
+	tests := []struct {
+		desc      string
+		setup     func()
+		challenge []byte
+		err       string
+	}{
+		{
+			desc: "missing UUID",
+			setup: func() {
+				delete(s.files, defaultUUIDPath)
+			},
+			err: "vsphere: unable to read VM UUID: no such file",
+		},
+		{
+			desc: "malformed UUID",
+			setup: func() {
+				s.files[defaultUUIDPath] = "not-a-uuid"
+			},
+			err: `vsphere: malformed UUID "not-a-uuid"`,
+		},
+		{
+			desc:      "malformed challenge",
+			challenge: []byte("{"),
+			err:       "vsphere: unable to unmarshal challenge",
+		},
+		{
+			desc:      "not a guestinfo key",
+			challenge: s.marshal(vsphere.Challenge{Key: "machine.id"}),
+			err:       `vsphere: invalid guestinfo key "machine.id"`,
+		},
+		{
+			desc:      "guestinfo not readable",
+			challenge: s.marshal(vsphere.Challenge{Key: "guestinfo.other"}),
+			err:       "vsphere: unable to read challenge from guestinfo: no value found",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		s.Run(tt.desc, func() {
+			s.SetupTest()
+			s.configure()
+			if tt.setup != nil {
+				tt.setup()
+			}
+
+			stream, err := s.p.FetchAttestationData(context.Background())
+			s.Require().NoError(err)
+
+			resp, err := stream.Recv()
+			if tt.challenge == nil {
+				s.RequireGRPCStatusContains(err, codes.Unknown, tt.err)
+				return
+			}
+			s.Require().NoError(err)
+			s.Require().NotNil(resp.AttestationData)
+
+			s.Require().NoError(stream.Send(&nodeattestor.FetchAttestationDataRequest{
+				Challenge: tt.challenge,
+			}))
+			_, err = stream.Recv()
+			s.RequireGRPCStatusContains(err, codes.Unknown, tt.err)
+		})
+	}
+}
+
+func (s *Suite) TestNotConfigured() {
+	stream, err := s.p.FetchAttestationData(context.Background())
+	s.Require().NoError(err)
+
+	_, err = stream.Recv()
+	s.RequireGRPCStatus(err, codes.Unknown, "vsphere: not configured")
+}
+
+func (s *Suite) TestGetPluginInfo() {
+	resp, err := s.p.GetPluginInfo(context.Background(), &plugin.GetPluginInfoRequest{})
+	s.Require().NoError(err)
+	s.RequireProtoEqual(&plugin.GetPluginInfoResponse{}, resp)
+}
+
+func (s *Suite) configure() {
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig: &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) marshal(obj interface{}) []byte {
+	data, err := json.Marshal(obj)
+	s.Require().NoError(err)
+	return data
+}
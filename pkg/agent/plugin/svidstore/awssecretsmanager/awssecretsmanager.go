@@ -0,0 +1,310 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pluginName = "aws_secretsmanager"
+
+	// managedTagKey is the tag added to the secrets created by the plugin.
+	// Only secrets with this tag are updated or deleted.
+	managedTagKey   = "spire-svid"
+	managedTagValue = "true"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName,
+		svidstore.PluginServer(p),
+	)
+}
+
+type Config struct {
+	Region          string `hcl:"region"`
+	AccessKeyID     string `hcl:"access_key_id"`
+	SecretAccessKey string `hcl:"secret_access_key"`
+
+	// SecretPrefix is prepended to the secret names given in the
+	// "secretname" metadata
+	SecretPrefix string `hcl:"secret_prefix"`
+
+	// KMSKeyID is the KMS key used to encrypt the secrets created by the
+	// plugin, unless overridden by the "kmskeyid" metadata. If unset, the
+	// AWS managed key for Secrets Manager is used.
+	KMSKeyID string `hcl:"kms_key_id"`
+}
+
+type Plugin struct {
+	svidstore.UnsafeSVIDStoreServer
+
+	log hclog.Logger
+
+	mtx    sync.RWMutex
+	config *Config
+	client secretsManagerClient
+
+	hooks struct {
+		newClient func(config *Config) (secretsManagerClient, error)
+	}
+}
+
+func New() *Plugin {
+	return newPlugin(newSecretsManagerClient)
+}
+
+func newPlugin(newClient func(config *Config) (secretsManagerClient, error)) *Plugin {
+	p := &Plugin{}
+	p.hooks.newClient = newClient
+	return p
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(Config)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if config.Region == "" {
+		return nil, status.Error(codes.InvalidArgument, "region is required")
+	}
+
+	client, err := p.hooks.newClient(config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create secrets manager client: %v", err)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.config = config
+	p.client = client
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+// PutX509SVID stores the X509-SVID in the secret identified by the metadata,
+// creating the secret if it does not exist.
+func (p *Plugin) PutX509SVID(ctx context.Context, req *svidstore.PutX509SVIDRequest) (*svidstore.PutX509SVIDResponse, error) {
+	config, client, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := svidstore.SecretFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse request: %v", err)
+	}
+	secretBinary, err := json.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal secret: %v", err)
+	}
+	secretString := string(secretBinary)
+
+	secret, err := describeSecret(ctx, client, opt.secretID)
+	switch {
+	case err != nil:
+		return nil, err
+	case secret == nil:
+		if opt.arn != "" {
+			return nil, status.Errorf(codes.NotFound, "secret %q not found", opt.arn)
+		}
+		resp, err := client.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+			Name:         aws.String(opt.secretID),
+			SecretString: aws.String(secretString),
+			KmsKeyId:     stringOrNil(opt.kmsKeyID),
+			Tags: []*secretsmanager.Tag{
+				{
+					Key:   aws.String(managedTagKey),
+					Value: aws.String(managedTagValue),
+				},
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create secret: %v", err)
+		}
+		p.log.Debug("Secret created", "arn", aws.StringValue(resp.ARN))
+		return &svidstore.PutX509SVIDResponse{}, nil
+	}
+
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	// Secrets Manager only changes the key used to encrypt a secret through
+	// UpdateSecret, which stores the new value as well.
+	if opt.kmsKeyID != "" && opt.kmsKeyID != aws.StringValue(secret.KmsKeyId) {
+		if _, err := client.UpdateSecretWithContext(ctx, &secretsmanager.UpdateSecretInput{
+			SecretId:     secret.ARN,
+			SecretString: aws.String(secretString),
+			KmsKeyId:     aws.String(opt.kmsKeyID),
+		}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to update secret: %v", err)
+		}
+		p.log.Debug("Secret updated", "arn", aws.StringValue(secret.ARN))
+		return &svidstore.PutX509SVIDResponse{}, nil
+	}
+
+	if _, err := client.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     secret.ARN,
+		SecretString: aws.String(secretString),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to put secret value: %v", err)
+	}
+	p.log.Debug("Secret value updated", "arn", aws.StringValue(secret.ARN))
+
+	return &svidstore.PutX509SVIDResponse{}, nil
+}
+
+// DeleteX509SVID deletes the secret identified by the metadata. Secrets that
+// do not exist are ignored.
+func (p *Plugin) DeleteX509SVID(ctx context.Context, req *svidstore.DeleteX509SVIDRequest) (*svidstore.DeleteX509SVIDResponse, error) {
+	config, client, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := describeSecret(ctx, client, opt.secretID)
+	switch {
+	case err != nil:
+		return nil, err
+	case secret == nil:
+		p.log.Warn("Secret not found", "secret_id", opt.secretID)
+		return &svidstore.DeleteX509SVIDResponse{}, nil
+	}
+
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	// The secret is deleted without a recovery window so the name can be
+	// reused if the entry is created again.
+	if _, err := client.DeleteSecretWithContext(ctx, &secretsmanager.DeleteSecretInput{
+		SecretId:                   secret.ARN,
+		ForceDeleteWithoutRecovery: aws.Bool(true),
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete secret: %v", err)
+	}
+	p.log.Debug("Secret deleted", "arn", aws.StringValue(secret.ARN))
+
+	return &svidstore.DeleteX509SVIDResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*Config, secretsManagerClient, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.config == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, p.client, nil
+}
+
+type secretOptions struct {
+	// secretID is the name or ARN of the secret
+	secretID string
+	arn      string
+	kmsKeyID string
+}
+
+func optionsFromMetadata(config *Config, metadata []string) (*secretOptions, error) {
+	data, err := svidstore.ParseMetadata(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+
+	opt := &secretOptions{
+		arn:      data["arn"],
+		kmsKeyID: config.KMSKeyID,
+	}
+	if kmsKeyID, ok := data["kmskeyid"]; ok {
+		opt.kmsKeyID = kmsKeyID
+	}
+
+	secretName := data["secretname"]
+	switch {
+	case opt.arn != "" && secretName != "":
+		return nil, status.Error(codes.InvalidArgument, "only one of secretname or arn can be set")
+	case opt.arn != "":
+		opt.secretID = opt.arn
+	case secretName != "":
+		opt.secretID = config.SecretPrefix + secretName
+	default:
+		return nil, status.Error(codes.InvalidArgument, "either secretname or arn must be set")
+	}
+
+	return opt, nil
+}
+
+// describeSecret returns the description of the secret, or nil if the secret
+// does not exist.
+func describeSecret(ctx context.Context, client secretsManagerClient, secretID string) (*secretsmanager.DescribeSecretOutput, error) {
+	resp, err := client.DescribeSecretWithContext(ctx, &secretsmanager.DescribeSecretInput{
+		SecretId: aws.String(secretID),
+	})
+	if err != nil {
+		if awsErr, ok := err.(awserr.Error); ok && awsErr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+			return nil, nil
+		}
+		return nil, status.Errorf(codes.Internal, "failed to describe secret: %v", err)
+	}
+	return resp, nil
+}
+
+// validateSecret verifies that the secret was created by the plugin and can
+// be modified.
+func validateSecret(secret *secretsmanager.DescribeSecretOutput) error {
+	managed := false
+	for _, tag := range secret.Tags {
+		if aws.StringValue(tag.Key) == managedTagKey && aws.StringValue(tag.Value) == managedTagValue {
+			managed = true
+			break
+		}
+	}
+	if !managed {
+		return status.Errorf(codes.InvalidArgument, "secret %q does not have the %s=%s tag", aws.StringValue(secret.ARN), managedTagKey, managedTagValue)
+	}
+	if secret.DeletedDate != nil {
+		return status.Errorf(codes.FailedPrecondition, "secret %q is marked for deletion", aws.StringValue(secret.ARN))
+	}
+	return nil
+}
+
+func stringOrNil(s string) *string {
+	if s == "" {
+		return nil
+	}
+	return aws.String(s)
+}
@@ -0,0 +1,464 @@
+package awssecretsmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore/svidstoretest"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConfigure(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		config       string
+		newClientErr error
+		code         codes.Code
+		desc         string
+		expected     *Config
+	}{
+		{
+			name:   "malformed",
+			config: "MALFORMED",
+			code:   codes.InvalidArgument,
+			desc:   "unable to decode configuration",
+		},
+		{
+			name:   "missing region",
+			config: `secret_prefix = "spire-"`,
+			code:   codes.InvalidArgument,
+			desc:   "region is required",
+		},
+		{
+			name:         "failed to create client",
+			config:       `region = "us-east-1"`,
+			newClientErr: errors.New("oh no"),
+			code:         codes.Internal,
+			desc:         "failed to create secrets manager client: oh no",
+		},
+		{
+			name: "success",
+			config: `
+				region = "us-east-1"
+				access_key_id = "ACCESS_KEY"
+				secret_access_key = "SECRET_KEY"
+				secret_prefix = "spire-"
+				kms_key_id = "alias/spire"
+			`,
+			expected: &Config{
+				Region:          "us-east-1",
+				AccessKeyID:     "ACCESS_KEY",
+				SecretAccessKey: "SECRET_KEY",
+				SecretPrefix:    "spire-",
+				KMSKeyID:        "alias/spire",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var gotConfig *Config
+			p := newPlugin(func(config *Config) (secretsManagerClient, error) {
+				gotConfig = config
+				if tt.newClientErr != nil {
+					return nil, tt.newClientErr
+				}
+				return newFakeClient(), nil
+			})
+
+			var plugin svidstore.Plugin
+			spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+			_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+				Configuration: tt.config,
+			})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, gotConfig)
+		})
+	}
+}
+
+func TestNotConfigured(t *testing.T) {
+	svidstoretest.RequireNotConfigured(t, BuiltIn())
+}
+
+func TestInvalidMetadata(t *testing.T) {
+	svidstoretest.RequireInvalidMetadata(t, loadPlugin(t, newFakeClient()))
+}
+
+func TestPutX509SVID(t *testing.T) {
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/lambda")
+	expectedSecret, err := svidstore.SecretFromProto(req)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		metadata []string
+		secrets  map[string]*fakeSecret
+		setup    func(c *fakeClient)
+		code     codes.Code
+		desc     string
+		expected map[string]*fakeSecret
+	}{
+		{
+			name:     "create secret with prefix and default KMS key",
+			metadata: []string{"secretname:foo"},
+			expected: map[string]*fakeSecret{
+				"spire-foo": {
+					kmsKeyID: "alias/default",
+					managed:  true,
+					values:   []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "create secret with KMS key from metadata",
+			metadata: []string{"secretname:foo", "kmskeyid:alias/other"},
+			expected: map[string]*fakeSecret{
+				"spire-foo": {
+					kmsKeyID: "alias/other",
+					managed:  true,
+					values:   []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "update existing secret",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {kmsKeyID: "alias/default", managed: true},
+			},
+			expected: map[string]*fakeSecret{
+				"spire-foo": {
+					kmsKeyID: "alias/default",
+					managed:  true,
+					values:   []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "update existing secret by ARN with new KMS key",
+			metadata: []string{"arn:spire-foo", "kmskeyid:alias/other"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {kmsKeyID: "alias/default", managed: true},
+			},
+			expected: map[string]*fakeSecret{
+				"spire-foo": {
+					kmsKeyID: "alias/other",
+					managed:  true,
+					values:   []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "secret by ARN does not exist",
+			metadata: []string{"arn:spire-foo"},
+			code:     codes.NotFound,
+			desc:     `secret "spire-foo" not found`,
+		},
+		{
+			name:     "secret not managed by SPIRE",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {},
+			},
+			code: codes.InvalidArgument,
+			desc: `secret "spire-foo" does not have the spire-svid=true tag`,
+		},
+		{
+			name:     "secret marked for deletion",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {managed: true, deleted: true},
+			},
+			code: codes.FailedPrecondition,
+			desc: `secret "spire-foo" is marked for deletion`,
+		},
+		{
+			name:     "no secret name or ARN",
+			metadata: []string{"kmskeyid:alias/other"},
+			code:     codes.InvalidArgument,
+			desc:     "either secretname or arn must be set",
+		},
+		{
+			name:     "both secret name and ARN",
+			metadata: []string{"secretname:foo", "arn:spire-foo"},
+			code:     codes.InvalidArgument,
+			desc:     "only one of secretname or arn can be set",
+		},
+		{
+			name:     "describe fails",
+			metadata: []string{"secretname:foo"},
+			setup: func(c *fakeClient) {
+				c.describeErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to describe secret: oh no",
+		},
+		{
+			name:     "create fails",
+			metadata: []string{"secretname:foo"},
+			setup: func(c *fakeClient) {
+				c.createErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to create secret: oh no",
+		},
+		{
+			name:     "put value fails",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {kmsKeyID: "alias/default", managed: true},
+			},
+			setup: func(c *fakeClient) {
+				c.putErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to put secret value: oh no",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient()
+			for name, secret := range tt.secrets {
+				client.secrets[name] = secret
+			}
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			plugin := loadPlugin(t, client)
+
+			req.Metadata = tt.metadata
+			_, err := plugin.PutX509SVID(context.Background(), req)
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, client.secrets)
+		})
+	}
+}
+
+func TestDeleteX509SVID(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		metadata []string
+		secrets  map[string]*fakeSecret
+		setup    func(c *fakeClient)
+		code     codes.Code
+		desc     string
+		expected map[string]*fakeSecret
+	}{
+		{
+			name:     "delete secret",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {managed: true},
+				"spire-bar": {managed: true},
+			},
+			expected: map[string]*fakeSecret{
+				"spire-bar": {managed: true},
+			},
+		},
+		{
+			name:     "secret does not exist",
+			metadata: []string{"secretname:foo"},
+			expected: map[string]*fakeSecret{},
+		},
+		{
+			name:     "secret not managed by SPIRE",
+			metadata: []string{"arn:spire-foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {},
+			},
+			code: codes.InvalidArgument,
+			desc: `secret "spire-foo" does not have the spire-svid=true tag`,
+		},
+		{
+			name:     "delete fails",
+			metadata: []string{"secretname:foo"},
+			secrets: map[string]*fakeSecret{
+				"spire-foo": {managed: true},
+			},
+			setup: func(c *fakeClient) {
+				c.deleteErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to delete secret: oh no",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient()
+			for name, secret := range tt.secrets {
+				client.secrets[name] = secret
+			}
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			plugin := loadPlugin(t, client)
+
+			_, err := plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+				Metadata: tt.metadata,
+			})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, client.secrets)
+		})
+	}
+}
+
+func loadPlugin(t *testing.T, client *fakeClient) svidstore.Plugin {
+	p := newPlugin(func(*Config) (secretsManagerClient, error) {
+		return client, nil
+	})
+
+	var plugin svidstore.Plugin
+	spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `
+			region = "us-east-1"
+			secret_prefix = "spire-"
+			kms_key_id = "alias/default"
+		`,
+	})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeSecret struct {
+	kmsKeyID string
+	managed  bool
+	deleted  bool
+	values   []*svidstore.Data
+}
+
+type fakeClient struct {
+	secrets map[string]*fakeSecret
+
+	describeErr error
+	createErr   error
+	putErr      error
+	deleteErr   error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		secrets: make(map[string]*fakeSecret),
+	}
+}
+
+func (c *fakeClient) DescribeSecretWithContext(ctx aws.Context, input *secretsmanager.DescribeSecretInput, opts ...request.Option) (*secretsmanager.DescribeSecretOutput, error) {
+	if c.describeErr != nil {
+		return nil, c.describeErr
+	}
+	name := aws.StringValue(input.SecretId)
+	secret, ok := c.secrets[name]
+	if !ok {
+		return nil, awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil)
+	}
+
+	out := &secretsmanager.DescribeSecretOutput{
+		ARN:  aws.String(name),
+		Name: aws.String(name),
+	}
+	if secret.kmsKeyID != "" {
+		out.KmsKeyId = aws.String(secret.kmsKeyID)
+	}
+	if secret.managed {
+		out.Tags = []*secretsmanager.Tag{
+			{Key: aws.String("spire-svid"), Value: aws.String("true")},
+		}
+	}
+	if secret.deleted {
+		out.DeletedDate = aws.Time(time.Now())
+	}
+	return out, nil
+}
+
+func (c *fakeClient) CreateSecretWithContext(ctx aws.Context, input *secretsmanager.CreateSecretInput, opts ...request.Option) (*secretsmanager.CreateSecretOutput, error) {
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	name := aws.StringValue(input.Name)
+	secret := &fakeSecret{
+		kmsKeyID: aws.StringValue(input.KmsKeyId),
+	}
+	for _, tag := range input.Tags {
+		if aws.StringValue(tag.Key) == "spire-svid" && aws.StringValue(tag.Value) == "true" {
+			secret.managed = true
+		}
+	}
+	if err := secret.addValue(input.SecretString); err != nil {
+		return nil, err
+	}
+	c.secrets[name] = secret
+	return &secretsmanager.CreateSecretOutput{ARN: aws.String(name)}, nil
+}
+
+func (c *fakeClient) PutSecretValueWithContext(ctx aws.Context, input *secretsmanager.PutSecretValueInput, opts ...request.Option) (*secretsmanager.PutSecretValueOutput, error) {
+	if c.putErr != nil {
+		return nil, c.putErr
+	}
+	secret, ok := c.secrets[aws.StringValue(input.SecretId)]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	if err := secret.addValue(input.SecretString); err != nil {
+		return nil, err
+	}
+	return &secretsmanager.PutSecretValueOutput{}, nil
+}
+
+func (c *fakeClient) UpdateSecretWithContext(ctx aws.Context, input *secretsmanager.UpdateSecretInput, opts ...request.Option) (*secretsmanager.UpdateSecretOutput, error) {
+	secret, ok := c.secrets[aws.StringValue(input.SecretId)]
+	if !ok {
+		return nil, errors.New("secret not found")
+	}
+	if input.KmsKeyId != nil {
+		secret.kmsKeyID = aws.StringValue(input.KmsKeyId)
+	}
+	if err := secret.addValue(input.SecretString); err != nil {
+		return nil, err
+	}
+	return &secretsmanager.UpdateSecretOutput{}, nil
+}
+
+func (c *fakeClient) DeleteSecretWithContext(ctx aws.Context, input *secretsmanager.DeleteSecretInput, opts ...request.Option) (*secretsmanager.DeleteSecretOutput, error) {
+	if c.deleteErr != nil {
+		return nil, c.deleteErr
+	}
+	if !aws.BoolValue(input.ForceDeleteWithoutRecovery) {
+		return nil, errors.New("expected deletion without recovery")
+	}
+	delete(c.secrets, aws.StringValue(input.SecretId))
+	return &secretsmanager.DeleteSecretOutput{}, nil
+}
+
+func (s *fakeSecret) addValue(secretString *string) error {
+	data := new(svidstore.Data)
+	if err := json.Unmarshal([]byte(aws.StringValue(secretString)), data); err != nil {
+		return err
+	}
+	s.values = append(s.values, data)
+	return nil
+}
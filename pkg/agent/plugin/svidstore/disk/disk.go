@@ -0,0 +1,287 @@
+package disk
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pluginName = "disk"
+
+	defaultSVIDFileName             = "svid.pem"
+	defaultKeyFileName              = "svid_key.pem"
+	defaultBundleFileName           = "bundle.pem"
+	defaultFederatedBundlesFileName = "federated_bundles.pem"
+
+	dirMode  = 0755
+	certMode = 0644
+	// keyMode is used for the private key unless a group is set, in which
+	// case the group is also allowed to read it.
+	keyMode      = 0600
+	keyGroupMode = 0640
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName,
+		svidstore.PluginServer(p),
+	)
+}
+
+type Config struct {
+	// Directory is where the SVIDs are written. Each SVID is written to the
+	// subdirectory given by the "name" metadata.
+	Directory string `hcl:"directory"`
+
+	SVIDFileName             string `hcl:"svid_file_name"`
+	KeyFileName              string `hcl:"key_file_name"`
+	BundleFileName           string `hcl:"bundle_file_name"`
+	FederatedBundlesFileName string `hcl:"federated_bundles_file_name"`
+}
+
+type Plugin struct {
+	svidstore.UnsafeSVIDStoreServer
+
+	log hclog.Logger
+
+	mtx    sync.RWMutex
+	config *Config
+}
+
+func New() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(Config)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if config.Directory == "" {
+		return nil, status.Error(codes.InvalidArgument, "directory is required")
+	}
+	if !filepath.IsAbs(config.Directory) {
+		return nil, status.Error(codes.InvalidArgument, "directory must be an absolute path")
+	}
+	config.Directory = filepath.Clean(config.Directory)
+
+	for _, fileName := range []struct {
+		name  string
+		value *string
+		def   string
+	}{
+		{name: "svid_file_name", value: &config.SVIDFileName, def: defaultSVIDFileName},
+		{name: "key_file_name", value: &config.KeyFileName, def: defaultKeyFileName},
+		{name: "bundle_file_name", value: &config.BundleFileName, def: defaultBundleFileName},
+		{name: "federated_bundles_file_name", value: &config.FederatedBundlesFileName, def: defaultFederatedBundlesFileName},
+	} {
+		if *fileName.value == "" {
+			*fileName.value = fileName.def
+			continue
+		}
+		if filepath.Base(*fileName.value) != *fileName.value || *fileName.value == "." || *fileName.value == ".." {
+			return nil, status.Errorf(codes.InvalidArgument, "%s must be a file name without a directory: %q", fileName.name, *fileName.value)
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.config = config
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+// PutX509SVID writes the X509-SVID, its private key and the bundles to the
+// directory identified by the metadata. Each file is replaced atomically.
+// The private key is written first so that the certificate is never newer
+// than the key.
+func (p *Plugin) PutX509SVID(ctx context.Context, req *svidstore.PutX509SVIDRequest) (*svidstore.PutX509SVIDResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := svidstore.SecretFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse request: %v", err)
+	}
+
+	if err := os.MkdirAll(opt.dir, dirMode); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create directory: %v", err)
+	}
+
+	keyFileMode := os.FileMode(keyMode)
+	if opt.gid != -1 {
+		keyFileMode = keyGroupMode
+	}
+
+	files := []struct {
+		name string
+		data string
+		mode os.FileMode
+	}{
+		{name: config.KeyFileName, data: data.X509SVIDKey, mode: keyFileMode},
+		{name: config.SVIDFileName, data: data.X509SVID, mode: certMode},
+		{name: config.BundleFileName, data: data.Bundle, mode: certMode},
+	}
+	for _, file := range files {
+		if err := opt.writeFile(file.name, file.data, file.mode); err != nil {
+			return nil, err
+		}
+	}
+
+	federatedBundlesPath := filepath.Join(opt.dir, config.FederatedBundlesFileName)
+	if len(data.FederatedBundles) > 0 {
+		if err := opt.writeFile(config.FederatedBundlesFileName, federatedBundlesPEM(data.FederatedBundles), certMode); err != nil {
+			return nil, err
+		}
+	} else if err := os.Remove(federatedBundlesPath); err != nil && !os.IsNotExist(err) {
+		return nil, status.Errorf(codes.Internal, "failed to remove stale federated bundles: %v", err)
+	}
+
+	p.log.Debug("SVID written", "directory", opt.dir)
+	return &svidstore.PutX509SVIDResponse{}, nil
+}
+
+// DeleteX509SVID removes the files written for the SVID and the directory
+// identified by the metadata if it is left empty. Files that do not exist
+// are ignored.
+func (p *Plugin) DeleteX509SVID(ctx context.Context, req *svidstore.DeleteX509SVIDRequest) (*svidstore.DeleteX509SVIDResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range []string{config.SVIDFileName, config.KeyFileName, config.BundleFileName, config.FederatedBundlesFileName} {
+		if err := os.Remove(filepath.Join(opt.dir, name)); err != nil && !os.IsNotExist(err) {
+			return nil, status.Errorf(codes.Internal, "failed to remove %s: %v", name, err)
+		}
+	}
+
+	// Other files may have been put in the directory by the workload, in
+	// which case it is left in place.
+	if err := os.Remove(opt.dir); err != nil && !os.IsNotExist(err) {
+		p.log.Debug("SVID directory not removed", "directory", opt.dir, "error", err)
+	}
+
+	p.log.Debug("SVID deleted", "directory", opt.dir)
+	return &svidstore.DeleteX509SVIDResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*Config, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.config == nil {
+		return nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, nil
+}
+
+type fileOptions struct {
+	dir string
+	uid int
+	gid int
+}
+
+func (o *fileOptions) writeFile(name, data string, mode os.FileMode) error {
+	if err := diskutil.AtomicWriteFileWithOwner(filepath.Join(o.dir, name), []byte(data), mode, o.uid, o.gid); err != nil {
+		return status.Errorf(codes.Internal, "failed to write %s: %v", name, err)
+	}
+	return nil
+}
+
+func optionsFromMetadata(config *Config, metadata []string) (*fileOptions, error) {
+	data, err := svidstore.ParseMetadata(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+
+	name := data["name"]
+	if name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	// The name must be a relative path that stays within the configured
+	// directory, so registration entries cannot write elsewhere on the host.
+	dir := filepath.Join(config.Directory, name)
+	if filepath.IsAbs(name) || !strings.HasPrefix(dir, config.Directory+string(filepath.Separator)) {
+		return nil, status.Errorf(codes.InvalidArgument, "name must be a relative path within the directory: %q", name)
+	}
+
+	opt := &fileOptions{
+		dir: dir,
+		uid: -1,
+		gid: -1,
+	}
+	if uid, ok := data["uid"]; ok {
+		if opt.uid, err = parseID(uid); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid uid: %v", err)
+		}
+	}
+	if gid, ok := data["gid"]; ok {
+		if opt.gid, err = parseID(gid); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid gid: %v", err)
+		}
+	}
+
+	return opt, nil
+}
+
+func parseID(s string) (int, error) {
+	id, err := strconv.ParseUint(s, 10, 31)
+	if err != nil {
+		return 0, err
+	}
+	return int(id), nil
+}
+
+// federatedBundlesPEM concatenates the federated bundles, ordered by trust
+// domain so the file only changes when the bundles do.
+func federatedBundlesPEM(bundles map[string]string) string {
+	tds := make([]string, 0, len(bundles))
+	for td := range bundles {
+		tds = append(tds, td)
+	}
+	sort.Strings(tds)
+
+	var b strings.Builder
+	for _, td := range tds {
+		b.WriteString(bundles[td])
+	}
+	return b.String()
+}
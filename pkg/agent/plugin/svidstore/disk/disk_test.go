@@ -0,0 +1,292 @@
+package disk
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore/svidstoretest"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/proto"
+)
+
+func TestConfigure(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		config   string
+		code     codes.Code
+		desc     string
+		expected *Config
+	}{
+		{
+			name:   "malformed",
+			config: "MALFORMED",
+			code:   codes.InvalidArgument,
+			desc:   "unable to decode configuration",
+		},
+		{
+			name: "missing directory",
+			code: codes.InvalidArgument,
+			desc: "directory is required",
+		},
+		{
+			name:   "relative directory",
+			config: `directory = "svids"`,
+			code:   codes.InvalidArgument,
+			desc:   "directory must be an absolute path",
+		},
+		{
+			name: "file name with directory",
+			config: `
+				directory = "/run/svids"
+				key_file_name = "../key.pem"
+			`,
+			code: codes.InvalidArgument,
+			desc: `key_file_name must be a file name without a directory: "../key.pem"`,
+		},
+		{
+			name:   "defaults",
+			config: `directory = "/run/svids/"`,
+			expected: &Config{
+				Directory:                "/run/svids",
+				SVIDFileName:             "svid.pem",
+				KeyFileName:              "svid_key.pem",
+				BundleFileName:           "bundle.pem",
+				FederatedBundlesFileName: "federated_bundles.pem",
+			},
+		},
+		{
+			name: "file names",
+			config: `
+				directory = "/run/svids"
+				svid_file_name = "cert.pem"
+				key_file_name = "key.pem"
+				bundle_file_name = "ca.pem"
+				federated_bundles_file_name = "federated_ca.pem"
+			`,
+			expected: &Config{
+				Directory:                "/run/svids",
+				SVIDFileName:             "cert.pem",
+				KeyFileName:              "key.pem",
+				BundleFileName:           "ca.pem",
+				FederatedBundlesFileName: "federated_ca.pem",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := New()
+
+			var plugin svidstore.Plugin
+			spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+			_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+				Configuration: tt.config,
+			})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, p.config)
+		})
+	}
+}
+
+func TestNotConfigured(t *testing.T) {
+	svidstoretest.RequireNotConfigured(t, BuiltIn())
+}
+
+func TestInvalidMetadata(t *testing.T) {
+	svidstoretest.RequireInvalidMetadata(t, loadPlugin(t, spiretest.TempDir(t)))
+}
+
+func TestPutX509SVID(t *testing.T) {
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/legacy")
+	expected, err := svidstore.SecretFromProto(req)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name           string
+		metadata       []string
+		code           codes.Code
+		desc           string
+		expectDir      string
+		expectKeyMode  os.FileMode
+		withFederation bool
+	}{
+		{
+			name:          "success",
+			metadata:      []string{"name:foo"},
+			expectDir:     "foo",
+			expectKeyMode: 0600,
+		},
+		{
+			name:          "nested directory",
+			metadata:      []string{"name:foo/bar"},
+			expectDir:     "foo/bar",
+			expectKeyMode: 0600,
+		},
+		{
+			name:           "with federated bundles",
+			metadata:       []string{"name:foo"},
+			expectDir:      "foo",
+			expectKeyMode:  0600,
+			withFederation: true,
+		},
+		{
+			name:          "with owner",
+			metadata:      []string{"name:foo", fmt.Sprintf("uid:%d", os.Getuid()), fmt.Sprintf("gid:%d", os.Getgid())},
+			expectDir:     "foo",
+			expectKeyMode: 0640,
+		},
+		{
+			name:     "missing name",
+			metadata: []string{"uid:1000"},
+			code:     codes.InvalidArgument,
+			desc:     "name is required",
+		},
+		{
+			name:     "name outside of the directory",
+			metadata: []string{"name:../foo"},
+			code:     codes.InvalidArgument,
+			desc:     `name must be a relative path within the directory: "../foo"`,
+		},
+		{
+			name:     "name is the directory",
+			metadata: []string{"name:."},
+			code:     codes.InvalidArgument,
+			desc:     `name must be a relative path within the directory: "."`,
+		},
+		{
+			name:     "invalid uid",
+			metadata: []string{"name:foo", "uid:nobody"},
+			code:     codes.InvalidArgument,
+			desc:     "invalid uid",
+		},
+		{
+			name:     "invalid gid",
+			metadata: []string{"name:foo", "gid:-1"},
+			code:     codes.InvalidArgument,
+			desc:     "invalid gid",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			dir := spiretest.TempDir(t)
+			plugin := loadPlugin(t, dir)
+
+			req := proto.Clone(req).(*svidstore.PutX509SVIDRequest)
+			req.Metadata = tt.metadata
+			if tt.withFederation {
+				req.FederatedBundles = map[string][]byte{
+					"spiffe://other.org": req.Svid.Bundle[0],
+				}
+			}
+
+			_, err := plugin.PutX509SVID(context.Background(), req)
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+
+			svidDir := filepath.Join(dir, tt.expectDir)
+			requireFile(t, filepath.Join(svidDir, "svid_key.pem"), expected.X509SVIDKey, tt.expectKeyMode)
+			requireFile(t, filepath.Join(svidDir, "svid.pem"), expected.X509SVID, 0644)
+			requireFile(t, filepath.Join(svidDir, "bundle.pem"), expected.Bundle, 0644)
+			if tt.withFederation {
+				requireFile(t, filepath.Join(svidDir, "federated_bundles.pem"), expected.Bundle, 0644)
+			} else {
+				require.NoFileExists(t, filepath.Join(svidDir, "federated_bundles.pem"))
+			}
+		})
+	}
+}
+
+func TestPutX509SVIDRemovesStaleFederatedBundles(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	plugin := loadPlugin(t, dir)
+
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/legacy")
+	req.Metadata = []string{"name:foo"}
+	req.FederatedBundles = map[string][]byte{
+		"spiffe://other.org": req.Svid.Bundle[0],
+	}
+	_, err := plugin.PutX509SVID(context.Background(), req)
+	require.NoError(t, err)
+	require.FileExists(t, filepath.Join(dir, "foo", "federated_bundles.pem"))
+
+	req.FederatedBundles = nil
+	_, err = plugin.PutX509SVID(context.Background(), req)
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(dir, "foo", "federated_bundles.pem"))
+}
+
+func TestDeleteX509SVID(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	plugin := loadPlugin(t, dir)
+
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/legacy")
+	req.Metadata = []string{"name:foo"}
+	_, err := plugin.PutX509SVID(context.Background(), req)
+	require.NoError(t, err)
+	req.Metadata = []string{"name:bar"}
+	_, err = plugin.PutX509SVID(context.Background(), req)
+	require.NoError(t, err)
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, "bar", "other"), []byte("other"), 0600))
+
+	// The directory is removed when empty
+	_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+		Metadata: []string{"name:foo"},
+	})
+	require.NoError(t, err)
+	require.NoDirExists(t, filepath.Join(dir, "foo"))
+
+	// Files not written by the plugin are left in place
+	_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+		Metadata: []string{"name:bar"},
+	})
+	require.NoError(t, err)
+	require.NoFileExists(t, filepath.Join(dir, "bar", "svid.pem"))
+	require.FileExists(t, filepath.Join(dir, "bar", "other"))
+
+	// Deleting an SVID that does not exist succeeds
+	_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+		Metadata: []string{"name:baz"},
+	})
+	require.NoError(t, err)
+
+	_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+		Metadata: []string{"name:../foo"},
+	})
+	spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, `name must be a relative path within the directory: "../foo"`)
+}
+
+func requireFile(t *testing.T, path, content string, mode os.FileMode) {
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	require.Equal(t, mode, info.Mode().Perm(), "unexpected mode for %s", path)
+
+	data, err := ioutil.ReadFile(path)
+	require.NoError(t, err)
+	require.Equal(t, content, string(data))
+}
+
+func loadPlugin(t *testing.T, dir string) svidstore.Plugin {
+	var plugin svidstore.Plugin
+	spiretest.LoadPlugin(t, BuiltIn(), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: fmt.Sprintf("directory = %q", dir),
+	})
+	require.NoError(t, err)
+	return plugin
+}
@@ -0,0 +1,272 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	pluginName = "gcp_secretmanager"
+
+	// managedLabelKey is the label added to the secrets created by the
+	// plugin. Only secrets with this label are updated or deleted.
+	managedLabelKey   = "spire-svid"
+	managedLabelValue = "true"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName,
+		svidstore.PluginServer(p),
+	)
+}
+
+type Config struct {
+	// ProjectID is the project where secrets are stored, unless overridden
+	// by the "projectid" metadata
+	ProjectID string `hcl:"project_id"`
+
+	// ServiceAccountFile is the path to the service account credentials. If
+	// unset, the application default credentials are used.
+	ServiceAccountFile string `hcl:"service_account_file"`
+}
+
+type Plugin struct {
+	svidstore.UnsafeSVIDStoreServer
+
+	log hclog.Logger
+
+	mtx    sync.RWMutex
+	config *Config
+	client secretsClient
+
+	hooks struct {
+		newClient func(ctx context.Context, serviceAccountFile string) (secretsClient, error)
+	}
+}
+
+func New() *Plugin {
+	return newPlugin(newSecretsClient)
+}
+
+func newPlugin(newClient func(ctx context.Context, serviceAccountFile string) (secretsClient, error)) *Plugin {
+	p := &Plugin{}
+	p.hooks.newClient = newClient
+	return p
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(Config)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	client, err := p.hooks.newClient(ctx, config.ServiceAccountFile)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create secret manager client: %v", err)
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	if p.client != nil {
+		if err := p.client.Close(); err != nil {
+			p.log.Warn("Failed to close previous secret manager client", "error", err)
+		}
+	}
+	p.config = config
+	p.client = client
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+// PutX509SVID adds a new version with the X509-SVID to the secret identified
+// by the metadata, creating the secret if it does not exist.
+func (p *Plugin) PutX509SVID(ctx context.Context, req *svidstore.PutX509SVIDRequest) (*svidstore.PutX509SVIDResponse, error) {
+	config, client, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := svidstore.SecretFromProto(req)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "failed to parse request: %v", err)
+	}
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to marshal secret: %v", err)
+	}
+
+	secret, err := getSecret(ctx, client, opt.secretName())
+	switch {
+	case err != nil:
+		return nil, err
+	case secret == nil:
+		secret, err = client.CreateSecret(ctx, &secretmanagerpb.CreateSecretRequest{
+			Parent:   opt.parent(),
+			SecretId: opt.name,
+			Secret: &secretmanagerpb.Secret{
+				Replication: &secretmanagerpb.Replication{
+					Replication: &secretmanagerpb.Replication_Automatic_{
+						Automatic: &secretmanagerpb.Replication_Automatic{},
+					},
+				},
+				Labels: map[string]string{
+					managedLabelKey: managedLabelValue,
+				},
+			},
+		})
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to create secret: %v", err)
+		}
+		p.log.Debug("Secret created", "name", secret.Name)
+	default:
+		if err := validateSecret(secret); err != nil {
+			return nil, err
+		}
+	}
+
+	version, err := client.AddSecretVersion(ctx, &secretmanagerpb.AddSecretVersionRequest{
+		Parent: secret.Name,
+		Payload: &secretmanagerpb.SecretPayload{
+			Data: payload,
+		},
+	})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to add secret version: %v", err)
+	}
+	p.log.Debug("Secret version added", "name", version.Name)
+
+	return &svidstore.PutX509SVIDResponse{}, nil
+}
+
+// DeleteX509SVID deletes the secret identified by the metadata, along with
+// all of its versions. Secrets that do not exist are ignored.
+func (p *Plugin) DeleteX509SVID(ctx context.Context, req *svidstore.DeleteX509SVIDRequest) (*svidstore.DeleteX509SVIDResponse, error) {
+	config, client, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	opt, err := optionsFromMetadata(config, req.Metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	secret, err := getSecret(ctx, client, opt.secretName())
+	switch {
+	case err != nil:
+		return nil, err
+	case secret == nil:
+		p.log.Warn("Secret not found", "name", opt.secretName())
+		return &svidstore.DeleteX509SVIDResponse{}, nil
+	}
+
+	if err := validateSecret(secret); err != nil {
+		return nil, err
+	}
+
+	if err := client.DeleteSecret(ctx, &secretmanagerpb.DeleteSecretRequest{
+		Name: secret.Name,
+	}); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to delete secret: %v", err)
+	}
+	p.log.Debug("Secret deleted", "name", secret.Name)
+
+	return &svidstore.DeleteX509SVIDResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*Config, secretsClient, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+	if p.config == nil {
+		return nil, nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, p.client, nil
+}
+
+type secretOptions struct {
+	projectID string
+	name      string
+}
+
+func (o *secretOptions) parent() string {
+	return fmt.Sprintf("projects/%s", o.projectID)
+}
+
+func (o *secretOptions) secretName() string {
+	return fmt.Sprintf("projects/%s/secrets/%s", o.projectID, o.name)
+}
+
+func optionsFromMetadata(config *Config, metadata []string) (*secretOptions, error) {
+	data, err := svidstore.ParseMetadata(metadata)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid metadata: %v", err)
+	}
+
+	opt := &secretOptions{
+		projectID: config.ProjectID,
+		name:      data["name"],
+	}
+	if projectID, ok := data["projectid"]; ok {
+		opt.projectID = projectID
+	}
+
+	if opt.name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if opt.projectID == "" {
+		return nil, status.Error(codes.InvalidArgument, "projectid is required when project_id is not configured")
+	}
+
+	return opt, nil
+}
+
+// getSecret returns the secret, or nil if the secret does not exist.
+func getSecret(ctx context.Context, client secretsClient, name string) (*secretmanagerpb.Secret, error) {
+	secret, err := client.GetSecret(ctx, &secretmanagerpb.GetSecretRequest{
+		Name: name,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		return secret, nil
+	case codes.NotFound:
+		return nil, nil
+	default:
+		return nil, status.Errorf(codes.Internal, "failed to get secret: %v", err)
+	}
+}
+
+// validateSecret verifies that the secret was created by the plugin.
+func validateSecret(secret *secretmanagerpb.Secret) error {
+	if secret.Labels[managedLabelKey] != managedLabelValue {
+		return status.Errorf(codes.InvalidArgument, "secret %q does not have the %s=%s label", secret.Name, managedLabelKey, managedLabelValue)
+	}
+	return nil
+}
@@ -0,0 +1,389 @@
+package gcpsecretmanager
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/googleapis/gax-go/v2"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore/svidstoretest"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	secretmanagerpb "google.golang.org/genproto/googleapis/cloud/secretmanager/v1"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func TestConfigure(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		config       string
+		newClientErr error
+		code         codes.Code
+		desc         string
+		expected     *Config
+	}{
+		{
+			name:   "malformed",
+			config: "MALFORMED",
+			code:   codes.InvalidArgument,
+			desc:   "unable to decode configuration",
+		},
+		{
+			name:         "failed to create client",
+			config:       `project_id = "project"`,
+			newClientErr: errors.New("oh no"),
+			code:         codes.Internal,
+			desc:         "failed to create secret manager client: oh no",
+		},
+		{
+			name: "success",
+			config: `
+				project_id = "project"
+				service_account_file = "/path/to/sa.json"
+			`,
+			expected: &Config{
+				ProjectID:          "project",
+				ServiceAccountFile: "/path/to/sa.json",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := newPlugin(func(ctx context.Context, serviceAccountFile string) (secretsClient, error) {
+				if tt.newClientErr != nil {
+					return nil, tt.newClientErr
+				}
+				require.Equal(t, tt.expected.ServiceAccountFile, serviceAccountFile)
+				return newFakeClient(), nil
+			})
+
+			var plugin svidstore.Plugin
+			spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+			_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+				Configuration: tt.config,
+			})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, p.config)
+		})
+	}
+}
+
+func TestNotConfigured(t *testing.T) {
+	svidstoretest.RequireNotConfigured(t, BuiltIn())
+}
+
+func TestInvalidMetadata(t *testing.T) {
+	svidstoretest.RequireInvalidMetadata(t, loadPlugin(t, newFakeClient(), `project_id = "project"`))
+}
+
+func TestPutX509SVID(t *testing.T) {
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/function")
+	expectedSecret, err := svidstore.SecretFromProto(req)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		metadata []string
+		secrets  map[string]*fakeSecret
+		setup    func(c *fakeClient)
+		code     codes.Code
+		desc     string
+		expected map[string]*fakeSecret
+	}{
+		{
+			name:     "create secret in configured project",
+			metadata: []string{"name:foo"},
+			expected: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {
+					managed:  true,
+					versions: []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "create secret in project from metadata",
+			metadata: []string{"name:foo", "projectid:other"},
+			expected: map[string]*fakeSecret{
+				"projects/other/secrets/foo": {
+					managed:  true,
+					versions: []*svidstore.Data{expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "add version to existing secret",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {
+					managed:  true,
+					versions: []*svidstore.Data{{SPIFFEID: "old"}},
+				},
+			},
+			expected: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {
+					managed:  true,
+					versions: []*svidstore.Data{{SPIFFEID: "old"}, expectedSecret},
+				},
+			},
+		},
+		{
+			name:     "secret not managed by SPIRE",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {},
+			},
+			code: codes.InvalidArgument,
+			desc: `secret "projects/project/secrets/foo" does not have the spire-svid=true label`,
+		},
+		{
+			name:     "missing name",
+			metadata: []string{"projectid:other"},
+			code:     codes.InvalidArgument,
+			desc:     "name is required",
+		},
+		{
+			name:     "get fails",
+			metadata: []string{"name:foo"},
+			setup: func(c *fakeClient) {
+				c.getErr = status.Error(codes.PermissionDenied, "oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to get secret: rpc error: code = PermissionDenied desc = oh no",
+		},
+		{
+			name:     "create fails",
+			metadata: []string{"name:foo"},
+			setup: func(c *fakeClient) {
+				c.createErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to create secret: oh no",
+		},
+		{
+			name:     "add version fails",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {managed: true},
+			},
+			setup: func(c *fakeClient) {
+				c.addVersionErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to add secret version: oh no",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient()
+			for name, secret := range tt.secrets {
+				client.secrets[name] = secret
+			}
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			plugin := loadPlugin(t, client, `project_id = "project"`)
+
+			req.Metadata = tt.metadata
+			_, err := plugin.PutX509SVID(context.Background(), req)
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, client.secrets)
+		})
+	}
+}
+
+func TestPutX509SVIDWithoutProject(t *testing.T) {
+	plugin := loadPlugin(t, newFakeClient(), "")
+
+	req := svidstoretest.NewPutRequest(t, "spiffe://example.org/function")
+	req.Metadata = []string{"name:foo"}
+	_, err := plugin.PutX509SVID(context.Background(), req)
+	spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, "projectid is required when project_id is not configured")
+}
+
+func TestDeleteX509SVID(t *testing.T) {
+	for _, tt := range []struct {
+		name     string
+		metadata []string
+		secrets  map[string]*fakeSecret
+		setup    func(c *fakeClient)
+		code     codes.Code
+		desc     string
+		expected map[string]*fakeSecret
+	}{
+		{
+			name:     "delete secret",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {managed: true},
+				"projects/project/secrets/bar": {managed: true},
+			},
+			expected: map[string]*fakeSecret{
+				"projects/project/secrets/bar": {managed: true},
+			},
+		},
+		{
+			name:     "secret does not exist",
+			metadata: []string{"name:foo"},
+			expected: map[string]*fakeSecret{},
+		},
+		{
+			name:     "secret not managed by SPIRE",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {},
+			},
+			code: codes.InvalidArgument,
+			desc: `secret "projects/project/secrets/foo" does not have the spire-svid=true label`,
+		},
+		{
+			name:     "delete fails",
+			metadata: []string{"name:foo"},
+			secrets: map[string]*fakeSecret{
+				"projects/project/secrets/foo": {managed: true},
+			},
+			setup: func(c *fakeClient) {
+				c.deleteErr = errors.New("oh no")
+			},
+			code: codes.Internal,
+			desc: "failed to delete secret: oh no",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			client := newFakeClient()
+			for name, secret := range tt.secrets {
+				client.secrets[name] = secret
+			}
+			if tt.setup != nil {
+				tt.setup(client)
+			}
+			plugin := loadPlugin(t, client, `project_id = "project"`)
+
+			_, err := plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+				Metadata: tt.metadata,
+			})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expected, client.secrets)
+		})
+	}
+}
+
+func loadPlugin(t *testing.T, client *fakeClient, config string) svidstore.Plugin {
+	p := newPlugin(func(context.Context, string) (secretsClient, error) {
+		return client, nil
+	})
+
+	var plugin svidstore.Plugin
+	spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: config,
+	})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeSecret struct {
+	managed  bool
+	versions []*svidstore.Data
+}
+
+type fakeClient struct {
+	secrets map[string]*fakeSecret
+
+	getErr        error
+	createErr     error
+	addVersionErr error
+	deleteErr     error
+}
+
+func newFakeClient() *fakeClient {
+	return &fakeClient{
+		secrets: make(map[string]*fakeSecret),
+	}
+}
+
+func (c *fakeClient) GetSecret(ctx context.Context, req *secretmanagerpb.GetSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+	if c.getErr != nil {
+		return nil, c.getErr
+	}
+	secret, ok := c.secrets[req.Name]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "secret not found")
+	}
+	out := &secretmanagerpb.Secret{
+		Name: req.Name,
+	}
+	if secret.managed {
+		out.Labels = map[string]string{"spire-svid": "true"}
+	}
+	return out, nil
+}
+
+func (c *fakeClient) CreateSecret(ctx context.Context, req *secretmanagerpb.CreateSecretRequest, opts ...gax.CallOption) (*secretmanagerpb.Secret, error) {
+	if c.createErr != nil {
+		return nil, c.createErr
+	}
+	if !strings.HasPrefix(req.Parent, "projects/") {
+		return nil, errors.New("invalid parent")
+	}
+	if req.Secret.GetReplication().GetAutomatic() == nil {
+		return nil, errors.New("expected automatic replication")
+	}
+	name := req.Parent + "/secrets/" + req.SecretId
+	c.secrets[name] = &fakeSecret{
+		managed: req.Secret.Labels["spire-svid"] == "true",
+	}
+	return &secretmanagerpb.Secret{
+		Name:   name,
+		Labels: req.Secret.Labels,
+	}, nil
+}
+
+func (c *fakeClient) AddSecretVersion(ctx context.Context, req *secretmanagerpb.AddSecretVersionRequest, opts ...gax.CallOption) (*secretmanagerpb.SecretVersion, error) {
+	if c.addVersionErr != nil {
+		return nil, c.addVersionErr
+	}
+	secret, ok := c.secrets[req.Parent]
+	if !ok {
+		return nil, status.Error(codes.NotFound, "secret not found")
+	}
+	data := new(svidstore.Data)
+	if err := json.Unmarshal(req.Payload.Data, data); err != nil {
+		return nil, err
+	}
+	secret.versions = append(secret.versions, data)
+	return &secretmanagerpb.SecretVersion{
+		Name: req.Parent + "/versions/1",
+	}, nil
+}
+
+func (c *fakeClient) DeleteSecret(ctx context.Context, req *secretmanagerpb.DeleteSecretRequest, opts ...gax.CallOption) error {
+	if c.deleteErr != nil {
+		return c.deleteErr
+	}
+	delete(c.secrets, req.Name)
+	return nil
+}
+
+func (c *fakeClient) Close() error {
+	return nil
+}
@@ -0,0 +1,86 @@
+// Package svidstoretest provides the fixtures shared by the tests of the
+// SVIDStore plugins.
+package svidstoretest
+
+import (
+	"context"
+	"crypto/x509"
+	"testing"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/spiffe/spire/test/testca"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+// NewPutRequest returns a request to store an X509-SVID for the given SPIFFE
+// ID, signed by a new CA for the trust domain of the ID. The request has no
+// metadata.
+func NewPutRequest(t *testing.T, id string) *svidstore.PutX509SVIDRequest {
+	spiffeID := spiffeid.RequireFromString(id)
+	ca := testca.New(t, spiffeID.TrustDomain())
+	svid := ca.CreateX509SVID(spiffeID)
+	keyDER, err := x509.MarshalPKCS8PrivateKey(svid.PrivateKey)
+	require.NoError(t, err)
+
+	return &svidstore.PutX509SVIDRequest{
+		Svid: &svidstore.X509SVID{
+			SpiffeId:   svid.ID.String(),
+			CertChain:  [][]byte{svid.Certificates[0].Raw},
+			PrivateKey: keyDER,
+			Bundle:     [][]byte{ca.X509Authorities()[0].Raw},
+			ExpiresAt:  svid.Certificates[0].NotAfter.Unix(),
+		},
+	}
+}
+
+// RequireNotConfigured asserts that the built-in plugin refuses to store and
+// delete SVIDs until it is configured.
+func RequireNotConfigured(t *testing.T, builtIn catalog.Plugin) {
+	var plugin svidstore.Plugin
+	spiretest.LoadPlugin(t, builtIn, &plugin)
+
+	_, err := plugin.PutX509SVID(context.Background(), &svidstore.PutX509SVIDRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "not configured")
+
+	_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "not configured")
+}
+
+// RequireInvalidMetadata asserts that the configured plugin rejects metadata
+// that cannot be parsed, both when storing and when deleting SVIDs, before
+// reaching its backend.
+func RequireInvalidMetadata(t *testing.T, plugin svidstore.Plugin) {
+	for _, tt := range []struct {
+		name     string
+		metadata []string
+		desc     string
+	}{
+		{
+			name:     "no colon",
+			metadata: []string{"foo"},
+			desc:     `invalid metadata: metadata does not contain a colon: "foo"`,
+		},
+		{
+			name:     "empty key",
+			metadata: []string{":foo"},
+			desc:     `invalid metadata: metadata has an empty key: ":foo"`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := NewPutRequest(t, "spiffe://example.org/workload")
+			req.Metadata = tt.metadata
+			_, err := plugin.PutX509SVID(context.Background(), req)
+			spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, tt.desc)
+
+			_, err = plugin.DeleteX509SVID(context.Background(), &svidstore.DeleteX509SVIDRequest{
+				Metadata: tt.metadata,
+			})
+			spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, tt.desc)
+		})
+	}
+}
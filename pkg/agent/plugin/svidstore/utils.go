@@ -0,0 +1,100 @@
+package svidstore
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// Data is the content of the secret stored by the SVIDStore plugins. All of
+// the certificates and keys are PEM encoded.
+type Data struct {
+	// SPIFFEID is the SPIFFE ID of the SVID
+	SPIFFEID string `json:"spiffeID,omitempty"`
+
+	// X509SVID is the X509-SVID certificate chain, leaf first
+	X509SVID string `json:"x509SVID,omitempty"`
+
+	// X509SVIDKey is the PKCS#8 private key of the X509-SVID
+	X509SVIDKey string `json:"x509SVIDKey,omitempty"`
+
+	// Bundle is the X.509 bundle of the trust domain
+	Bundle string `json:"bundle,omitempty"`
+
+	// FederatedBundles are the X.509 bundles of the federated trust
+	// domains, keyed by trust domain ID
+	FederatedBundles map[string]string `json:"federatedBundles,omitempty"`
+}
+
+// ParseMetadata parses the "key:value" metadata items of a request into a
+// map. Metadata items that are not in the expected form are rejected.
+func ParseMetadata(metadata []string) (map[string]string, error) {
+	data := make(map[string]string)
+	for _, s := range metadata {
+		parts := strings.SplitN(s, ":", 2)
+		switch {
+		case len(parts) != 2:
+			return nil, fmt.Errorf("metadata does not contain a colon: %q", s)
+		case parts[0] == "":
+			return nil, fmt.Errorf("metadata has an empty key: %q", s)
+		}
+		data[parts[0]] = parts[1]
+	}
+
+	return data, nil
+}
+
+// SecretFromProto builds the secret data for the X509-SVID in the request.
+func SecretFromProto(req *PutX509SVIDRequest) (*Data, error) {
+	svid := req.Svid
+	if svid == nil {
+		return nil, errors.New("request is missing the X509-SVID")
+	}
+	if len(svid.CertChain) == 0 {
+		return nil, errors.New("X509-SVID certificate chain is empty")
+	}
+	if len(svid.PrivateKey) == 0 {
+		return nil, errors.New("X509-SVID private key is empty")
+	}
+
+	var federatedBundles map[string]string
+	if len(req.FederatedBundles) > 0 {
+		federatedBundles = make(map[string]string, len(req.FederatedBundles))
+	}
+	for td, bundle := range req.FederatedBundles {
+		certs, err := x509.ParseCertificates(bundle)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse federated bundle %q: %v", td, err)
+		}
+		federatedBundle := make([][]byte, 0, len(certs))
+		for _, cert := range certs {
+			federatedBundle = append(federatedBundle, cert.Raw)
+		}
+		federatedBundles[td] = encodeCertificates(federatedBundle)
+	}
+
+	return &Data{
+		SPIFFEID: svid.SpiffeId,
+		X509SVID: encodeCertificates(svid.CertChain),
+		X509SVIDKey: string(pem.EncodeToMemory(&pem.Block{
+			Type:  "PRIVATE KEY",
+			Bytes: svid.PrivateKey,
+		})),
+		Bundle:           encodeCertificates(svid.Bundle),
+		FederatedBundles: federatedBundles,
+	}, nil
+}
+
+func encodeCertificates(certs [][]byte) string {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		_ = pem.Encode(&buf, &pem.Block{
+			Type:  "CERTIFICATE",
+			Bytes: cert,
+		})
+	}
+	return buf.String()
+}
@@ -0,0 +1,96 @@
+package svidstore
+
+import (
+	"crypto/x509"
+	"testing"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/test/testca"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseMetadata(t *testing.T) {
+	metadata, err := ParseMetadata([]string{"secretname:foo", "arn:arn:aws:secretsmanager:us-east-1:123:secret:bar"})
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{
+		"secretname": "foo",
+		"arn":        "arn:aws:secretsmanager:us-east-1:123:secret:bar",
+	}, metadata)
+
+	_, err = ParseMetadata([]string{"secretname"})
+	require.EqualError(t, err, `metadata does not contain a colon: "secretname"`)
+
+	_, err = ParseMetadata([]string{":foo"})
+	require.EqualError(t, err, `metadata has an empty key: ":foo"`)
+}
+
+func TestSecretFromProto(t *testing.T) {
+	ca := testca.New(t, spiffeid.RequireTrustDomainFromString("example.org"))
+	federatedCA := testca.New(t, spiffeid.RequireTrustDomainFromString("federated.org"))
+	svid := ca.CreateX509SVID(spiffeid.RequireFromString("spiffe://example.org/workload"))
+
+	keyDER, err := x509.MarshalPKCS8PrivateKey(svid.PrivateKey)
+	require.NoError(t, err)
+
+	data, err := SecretFromProto(&PutX509SVIDRequest{
+		Svid: &X509SVID{
+			SpiffeId:   "spiffe://example.org/workload",
+			CertChain:  rawCerts(svid.Certificates),
+			PrivateKey: keyDER,
+			Bundle:     rawCerts(ca.X509Authorities()),
+		},
+		FederatedBundles: map[string][]byte{
+			"spiffe://federated.org": concatRawCerts(federatedCA.X509Authorities()),
+		},
+	})
+	require.NoError(t, err)
+
+	keyPEM, err := pemutil.EncodePKCS8PrivateKey(svid.PrivateKey)
+	require.NoError(t, err)
+	require.Equal(t, &Data{
+		SPIFFEID:    "spiffe://example.org/workload",
+		X509SVID:    string(pemutil.EncodeCertificates(svid.Certificates)),
+		X509SVIDKey: string(keyPEM),
+		Bundle:      string(pemutil.EncodeCertificates(ca.X509Authorities())),
+		FederatedBundles: map[string]string{
+			"spiffe://federated.org": string(pemutil.EncodeCertificates(federatedCA.X509Authorities())),
+		},
+	}, data)
+}
+
+func TestSecretFromProtoFailures(t *testing.T) {
+	_, err := SecretFromProto(&PutX509SVIDRequest{})
+	require.EqualError(t, err, "request is missing the X509-SVID")
+
+	_, err = SecretFromProto(&PutX509SVIDRequest{Svid: &X509SVID{}})
+	require.EqualError(t, err, "X509-SVID certificate chain is empty")
+
+	_, err = SecretFromProto(&PutX509SVIDRequest{Svid: &X509SVID{CertChain: [][]byte{{1}}}})
+	require.EqualError(t, err, "X509-SVID private key is empty")
+
+	_, err = SecretFromProto(&PutX509SVIDRequest{
+		Svid: &X509SVID{CertChain: [][]byte{{1}}, PrivateKey: []byte{1}},
+		FederatedBundles: map[string][]byte{
+			"spiffe://federated.org": []byte("malformed"),
+		},
+	})
+	require.Error(t, err)
+	require.Contains(t, err.Error(), `failed to parse federated bundle "spiffe://federated.org"`)
+}
+
+func rawCerts(certs []*x509.Certificate) [][]byte {
+	var out [][]byte
+	for _, cert := range certs {
+		out = append(out, cert.Raw)
+	}
+	return out
+}
+
+func concatRawCerts(certs []*x509.Certificate) []byte {
+	var out []byte
+	for _, cert := range certs {
+		out = append(out, cert.Raw...)
+	}
+	return out
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"regexp"
+	"strings"
 	"sync"
 
 	"github.com/docker/docker/api/types"
@@ -21,10 +22,11 @@ import (
 )
 
 const (
-	pluginName         = "docker"
-	subselectorLabel   = "label"
-	subselectorImageID = "image_id"
-	subselectorEnv     = "env"
+	pluginName                   = "docker"
+	subselectorLabel             = "label"
+	subselectorImageID           = "image_id"
+	subselectorImageConfigDigest = "image_config_digest"
+	subselectorEnv               = "env"
 )
 
 func BuiltIn() catalog.Plugin {
@@ -50,6 +52,7 @@ type Plugin struct {
 	mtx               sync.RWMutex
 	containerIDFinder cgroup.ContainerIDFinder
 	docker            Docker
+	envAllowlist      map[string]bool
 }
 
 func New() *Plugin {
@@ -66,6 +69,9 @@ type dockerPluginConfig struct {
 	DockerVersion string `hcl:"docker_version"`
 	// ContainerIDCGroupMatchers
 	ContainerIDCGroupMatchers []string `hcl:"container_id_cgroup_matchers"`
+	// EnvAllowlist is the list of environment variable names that selectors
+	// are generated for. If not specified, all environment variables are used.
+	EnvAllowlist []string `hcl:"env_allowlist"`
 }
 
 func (p *Plugin) SetLogger(log hclog.Logger) {
@@ -102,12 +108,22 @@ func (p *Plugin) Attest(ctx context.Context, req *workloadattestor.AttestRequest
 		return nil, err
 	}
 
+	selectors := getSelectorsFromConfig(container.Config, p.envAllowlist)
+	if container.ContainerJSONBase != nil && container.Image != "" {
+		// The image the container was created from, as the digest of the
+		// image configuration, which pins the exact image content.
+		selectors = append(selectors, &common.Selector{
+			Type:  pluginName,
+			Value: fmt.Sprintf("%s:%s", subselectorImageConfigDigest, container.Image),
+		})
+	}
+
 	return &workloadattestor.AttestResponse{
-		Selectors: getSelectorsFromConfig(container.Config),
+		Selectors: selectors,
 	}, nil
 }
 
-func getSelectorsFromConfig(cfg *container.Config) []*common.Selector {
+func getSelectorsFromConfig(cfg *container.Config, envAllowlist map[string]bool) []*common.Selector {
 	var selectors []*common.Selector
 	for label, value := range cfg.Labels {
 		selectors = append(selectors, &common.Selector{
@@ -116,6 +132,9 @@ func getSelectorsFromConfig(cfg *container.Config) []*common.Selector {
 		})
 	}
 	for _, e := range cfg.Env {
+		if envAllowlist != nil && !envAllowlist[strings.SplitN(e, "=", 2)[0]] {
+			continue
+		}
 		selectors = append(selectors, &common.Selector{
 			Type:  pluginName,
 			Value: fmt.Sprintf("%s:%s", subselectorEnv, e),
@@ -161,10 +180,19 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi
 		}
 	}
 
+	var envAllowlist map[string]bool
+	if config.EnvAllowlist != nil {
+		envAllowlist = make(map[string]bool)
+		for _, name := range config.EnvAllowlist {
+			envAllowlist[name] = true
+		}
+	}
+
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 	p.docker = docker
 	p.containerIDFinder = containerIDFinder
+	p.envAllowlist = envAllowlist
 	return &spi.ConfigureResponse{}, nil
 }
 
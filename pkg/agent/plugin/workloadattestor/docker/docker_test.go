@@ -35,6 +35,8 @@ func TestDockerSelectors(t *testing.T) {
 		mockContainerLabels map[string]string
 		mockEnv             []string
 		mockImageID         string
+		mockImageDigest     string
+		config              string
 		requireResult       func(*testing.T, *workloadattestor.AttestResponse)
 	}{
 		{
@@ -84,6 +86,33 @@ func TestDockerSelectors(t *testing.T) {
 				require.Equal(t, "image_id:my-docker-image", res.Selectors[0].Value)
 			},
 		},
+		{
+			desc:            "image config digest",
+			mockImageDigest: "sha256:77af4d6b9913e693e8d0b4b294fa62ade6054e6b2f1ffb617ac955dd63fb0182",
+			requireResult: func(t *testing.T, res *workloadattestor.AttestResponse) {
+				require.Len(t, res.Selectors, 1)
+				require.Equal(t, "docker", res.Selectors[0].Type)
+				require.Equal(t, "image_config_digest:sha256:77af4d6b9913e693e8d0b4b294fa62ade6054e6b2f1ffb617ac955dd63fb0182", res.Selectors[0].Value)
+			},
+		},
+		{
+			desc:    "env allowlist",
+			mockEnv: []string{"VAR=val", "VAR2=val", "SECRET=shh", "NOVALUE"},
+			config:  `env_allowlist = ["VAR", "NOVALUE"]`,
+			requireResult: func(t *testing.T, res *workloadattestor.AttestResponse) {
+				require.Len(t, res.Selectors, 2)
+				require.Equal(t, "env:VAR=val", res.Selectors[0].Value)
+				require.Equal(t, "env:NOVALUE", res.Selectors[1].Value)
+			},
+		},
+		{
+			desc:    "empty env allowlist",
+			mockEnv: []string{"VAR=val"},
+			config:  `env_allowlist = []`,
+			requireResult: func(t *testing.T, res *workloadattestor.AttestResponse) {
+				require.Len(t, res.Selectors, 0)
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -96,10 +125,13 @@ func TestDockerSelectors(t *testing.T) {
 
 			fs := newFakeFileSystem(testCgroupEntries)
 
-			p := newTestPlugin(t, withMockDocker(mockDocker), withFileSystem(fs))
+			p := newTestPlugin(t, withConfig(t, tt.config), withMockDocker(mockDocker), withFileSystem(fs))
 
 			ctx := context.Background()
 			container := types.ContainerJSON{
+				ContainerJSONBase: &types.ContainerJSONBase{
+					Image: tt.mockImageDigest,
+				},
 				Config: &container.Config{
 					Labels: tt.mockContainerLabels,
 					Image:  tt.mockImageID,
@@ -0,0 +1,214 @@
+package ecs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"os"
+	"path"
+	"strings"
+	"sync"
+	"time"
+
+	hclog "github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/agent/common/cgroups"
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+)
+
+const (
+	pluginName = "ecs"
+)
+
+var (
+	metadataTimeout = 5 * time.Second
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName, workloadattestor.PluginServer(p))
+}
+
+// Plugin attests workloads running in containers of the ECS task the agent
+// runs in, using the task metadata endpoint to resolve the container of the
+// workload process.
+type Plugin struct {
+	workloadattestor.UnsafeWorkloadAttestorServer
+
+	log hclog.Logger
+	fs  cgroups.FileSystem
+
+	mtx    sync.RWMutex
+	config *ecsPluginConfig
+
+	hooks struct {
+		getenv     func(string) string
+		httpClient *http.Client
+	}
+}
+
+func New() *Plugin {
+	p := &Plugin{
+		fs: cgroups.OSFileSystem{},
+	}
+	p.hooks.getenv = os.Getenv
+	p.hooks.httpClient = http.DefaultClient
+	return p
+}
+
+type ecsPluginConfig struct {
+	// TaskMetadataEndpoint is the ECS task metadata endpoint (version 4).
+	// If not specified, the value of ECS_CONTAINER_METADATA_URI_V4 is used.
+	TaskMetadataEndpoint string `hcl:"task_metadata_endpoint"`
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Attest(ctx context.Context, req *workloadattestor.AttestRequest) (*workloadattestor.AttestResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	cgroupList, err := cgroups.GetCgroups(req.Pid, p.fs)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, metadataTimeout)
+	defer cancel()
+
+	task, err := caws.FetchECSTaskMetadata(ctx, p.hooks.httpClient, config.TaskMetadataEndpoint)
+	if err != nil {
+		return nil, fmt.Errorf("workloadattestor/ecs: %w", err)
+	}
+
+	container, err := getContainerFromCGroups(task, cgroupList)
+	switch {
+	case err != nil:
+		return nil, err
+	case container == nil:
+		// Not a container of the task. Nothing more to do.
+		return &workloadattestor.AttestResponse{}, nil
+	}
+
+	return &workloadattestor.AttestResponse{
+		Selectors: getSelectors(task, container),
+	}, nil
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := &ecsPluginConfig{}
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, err
+	}
+
+	if config.TaskMetadataEndpoint == "" {
+		config.TaskMetadataEndpoint = p.hooks.getenv(caws.ECSTaskMetadataEnv)
+		if config.TaskMetadataEndpoint == "" {
+			return nil, fmt.Errorf("workloadattestor/ecs: task_metadata_endpoint is required when %s is not set", caws.ECSTaskMetadataEnv)
+		}
+	}
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+	p.config = config
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (*Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*ecsPluginConfig, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	if p.config == nil {
+		return nil, fmt.Errorf("workloadattestor/ecs: not configured")
+	}
+	return p.config, nil
+}
+
+// getContainerFromCGroups returns the container of the task the cgroups
+// belong to. ECS names the cgroup of a container after its Docker ID, e.g.
+// "/ecs/<task id>/<docker id>", so the last element of the cgroup paths is
+// matched against the Docker IDs of the containers in the task metadata.
+// If none of the cgroups belong to a container of the task, the function
+// returns nil. If the cgroups belong to more than one container, the function
+// fails.
+func getContainerFromCGroups(task *caws.ECSTaskMetadata, cgroupList []cgroups.Cgroup) (*caws.ECSContainerMetadata, error) {
+	containers := make(map[string]*caws.ECSContainerMetadata, len(task.Containers))
+	for i := range task.Containers {
+		if dockerID := task.Containers[i].DockerID; dockerID != "" {
+			containers[dockerID] = &task.Containers[i]
+		}
+	}
+
+	var found *caws.ECSContainerMetadata
+	for _, cgroup := range cgroupList {
+		candidate, ok := containers[containerIDFromCGroupPath(cgroup.GroupPath)]
+		if !ok {
+			continue
+		}
+
+		switch {
+		case found == nil:
+			found = candidate
+		case found != candidate:
+			return nil, fmt.Errorf("workloadattestor/ecs: multiple containers found in cgroups (%s, %s)",
+				found.DockerID, candidate.DockerID)
+		}
+	}
+	return found, nil
+}
+
+// containerIDFromCGroupPath returns the last element of the cgroup path,
+// without the decoration added by the systemd cgroup driver
+// (i.e. "docker-<id>.scope").
+func containerIDFromCGroupPath(groupPath string) string {
+	id := path.Base(groupPath)
+	id = strings.TrimPrefix(id, "docker-")
+	id = strings.TrimSuffix(id, ".scope")
+	return id
+}
+
+func getSelectors(task *caws.ECSTaskMetadata, container *caws.ECSContainerMetadata) []*common.Selector {
+	values := []string{
+		fmt.Sprintf("cluster:%s", clusterName(task.Cluster)),
+		fmt.Sprintf("task-family:%s", task.Family),
+		fmt.Sprintf("task-definition:%s:%s", task.Family, task.Revision),
+		fmt.Sprintf("container-name:%s", container.Name),
+		fmt.Sprintf("image:%s", container.Image),
+	}
+	if container.ImageID != "" {
+		values = append(values, fmt.Sprintf("image-id:%s", container.ImageID))
+	}
+	for label, value := range container.Labels {
+		values = append(values, fmt.Sprintf("label:%s:%s", label, value))
+	}
+
+	selectors := make([]*common.Selector, 0, len(values))
+	for _, value := range values {
+		selectors = append(selectors, &common.Selector{
+			Type:  pluginName,
+			Value: value,
+		})
+	}
+	return selectors
+}
+
+// clusterName returns the name of the cluster, which the task metadata holds
+// either as the name or as the ARN of the cluster.
+func clusterName(cluster string) string {
+	return cluster[strings.LastIndex(cluster, "/")+1:]
+}
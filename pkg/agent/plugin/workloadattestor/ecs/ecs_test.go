@@ -0,0 +1,179 @@
+package ecs
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	testDockerID = "cd189a933e5849daa93386466019ab50-2495160603"
+	testSidecar  = "cd189a933e5849daa93386466019ab50-3681227241"
+)
+
+var testTask = caws.ECSTaskMetadata{
+	Cluster:  "arn:aws:ecs:us-west-2:123456789012:cluster/web",
+	TaskARN:  "arn:aws:ecs:us-west-2:123456789012:task/web/cd189a933e5849daa93386466019ab50",
+	Family:   "frontend",
+	Revision: "7",
+	Containers: []caws.ECSContainerMetadata{
+		{
+			DockerID: testDockerID,
+			Name:     "app",
+			Image:    "example/app:1.0",
+			ImageID:  "sha256:0123",
+			Labels:   map[string]string{"team": "web"},
+		},
+		{
+			DockerID: testSidecar,
+			Name:     "spire-agent",
+			Image:    "spire-agent:0.12.0",
+		},
+	},
+}
+
+func TestAttest(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		cgroups   string
+		selectors []string
+		err       string
+	}{
+		{
+			name:    "fargate container",
+			cgroups: "5:cpu:/ecs/cd189a933e5849daa93386466019ab50/" + testDockerID,
+			selectors: []string{
+				"cluster:web",
+				"task-family:frontend",
+				"task-definition:frontend:7",
+				"container-name:app",
+				"image:example/app:1.0",
+				"image-id:sha256:0123",
+				"label:team:web",
+			},
+		},
+		{
+			name:    "systemd cgroup driver",
+			cgroups: "0::/system.slice/docker-" + testSidecar + ".scope",
+			selectors: []string{
+				"cluster:web",
+				"task-family:frontend",
+				"task-definition:frontend:7",
+				"container-name:spire-agent",
+				"image:spire-agent:0.12.0",
+			},
+		},
+		{
+			name:    "not a container of the task",
+			cgroups: "5:cpu:/user.slice",
+		},
+		{
+			name:    "multiple containers",
+			cgroups: "5:cpu:/ecs/task/" + testDockerID + "\n4:memory:/ecs/task/" + testSidecar,
+			err:     "workloadattestor/ecs: multiple containers found in cgroups",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			p := newTestPlugin(t, tt.cgroups)
+
+			resp, err := p.Attest(context.Background(), &workloadattestor.AttestRequest{Pid: 123})
+			if tt.err != "" {
+				spiretest.RequireGRPCStatusContains(t, err, codes.Unknown, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			var expected []*common.Selector
+			for _, value := range tt.selectors {
+				expected = append(expected, &common.Selector{Type: "ecs", Value: value})
+			}
+			spiretest.RequireProtoListEqual(t, expected, resp.Selectors)
+		})
+	}
+}
+
+func TestAttestMetadataFailure(t *testing.T) {
+	p := New()
+	p.fs = fakeFileSystem{"/proc/123/cgroup": "5:cpu:/ecs/task/" + testDockerID}
+	server := httptest.NewServer(http.NotFoundHandler())
+	defer server.Close()
+
+	var plugin workloadattestor.Plugin
+	spiretest.LoadPlugin(t, builtin(p), &plugin)
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `task_metadata_endpoint = "` + server.URL + `"`,
+	})
+	require.NoError(t, err)
+
+	_, err = plugin.Attest(context.Background(), &workloadattestor.AttestRequest{Pid: 123})
+	spiretest.RequireGRPCStatusContains(t, err, codes.Unknown, "workloadattestor/ecs: unexpected status code from task metadata endpoint: 404")
+}
+
+func TestConfigure(t *testing.T) {
+	env := map[string]string{}
+	p := New()
+	p.hooks.getenv = func(key string) string {
+		return env[key]
+	}
+
+	var plugin workloadattestor.Plugin
+	spiretest.LoadPlugin(t, builtin(p), &plugin)
+
+	_, err := plugin.Attest(context.Background(), &workloadattestor.AttestRequest{Pid: 123})
+	spiretest.RequireGRPCStatus(t, err, codes.Unknown, "workloadattestor/ecs: not configured")
+
+	_, err = plugin.Configure(context.Background(), &spi.ConfigureRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.Unknown, "workloadattestor/ecs: task_metadata_endpoint is required when ECS_CONTAINER_METADATA_URI_V4 is not set")
+
+	env[caws.ECSTaskMetadataEnv] = "http://169.254.170.2/v4/abcd"
+	_, err = plugin.Configure(context.Background(), &spi.ConfigureRequest{})
+	require.NoError(t, err)
+	require.Equal(t, "http://169.254.170.2/v4/abcd", p.config.TaskMetadataEndpoint)
+}
+
+func newTestPlugin(t *testing.T, cgroups string) workloadattestor.Plugin {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/v4/abcd/task" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(testTask)
+	}))
+	t.Cleanup(server.Close)
+
+	p := New()
+	p.fs = fakeFileSystem{"/proc/123/cgroup": cgroups}
+
+	var plugin workloadattestor.Plugin
+	spiretest.LoadPlugin(t, builtin(p), &plugin)
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `task_metadata_endpoint = "` + server.URL + `/v4/abcd"`,
+	})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeFileSystem map[string]string
+
+func (fs fakeFileSystem) Open(path string) (io.ReadCloser, error) {
+	data, ok := fs[path]
+	if !ok {
+		return nil, os.ErrNotExist
+	}
+	return ioutil.NopCloser(strings.NewReader(data)), nil
+}
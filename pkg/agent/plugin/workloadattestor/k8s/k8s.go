@@ -182,6 +182,17 @@ func (p *Plugin) Attest(ctx context.Context, req *workloadattestor.AttestRequest
 		log = log.With(telemetry.Attempt, attempt)
 
 		list, err := config.Client.GetPodList()
+		if isUnauthorized(err) && config.Secure {
+			// The token or client certificate may have been rotated since
+			// they were loaded (e.g. projected service account tokens), so
+			// reload them and try again before giving up.
+			log.Debug("Kubelet rejected the credentials; reloading")
+			config, err = p.reloadConfig()
+			if err != nil {
+				return nil, err
+			}
+			list, err = config.Client.GetPodList()
+		}
 		if err != nil {
 			return nil, err
 		}
@@ -259,6 +270,9 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (resp
 	if config.KubeletSecurePort > 0 && config.KubeletReadOnlyPort > 0 {
 		return nil, k8sErr.New("cannot use both the read-only and secure port")
 	}
+	if config.KubeletReadOnlyPort > 0 {
+		p.log.Warn("The kubelet read-only port is deprecated; use the secure port instead")
+	}
 	port := config.KubeletReadOnlyPort
 	secure := false
 	if port <= 0 {
@@ -319,6 +333,22 @@ func (p *Plugin) getConfig() (*k8sConfig, error) {
 	return p.config, nil
 }
 
+// reloadConfig reloads the kubelet client regardless of when it was last
+// reloaded.
+func (p *Plugin) reloadConfig() (*k8sConfig, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.config == nil {
+		return nil, k8sErr.New("not configured")
+	}
+	p.config.LastReload = time.Time{}
+	if err := p.reloadKubeletClient(p.config); err != nil {
+		return nil, err
+	}
+	return p.config, nil
+}
+
 func (p *Plugin) getContainerIDFromCGroups(pid int32) (string, error) {
 	cgroups, err := cgroups.GetCgroups(pid, p.fs)
 	if err != nil {
@@ -523,7 +553,7 @@ func (c *kubeletClient) GetPodList() (*corev1.PodList, error) {
 	defer resp.Body.Close()
 
 	if resp.StatusCode != http.StatusOK {
-		return nil, k8sErr.New("unexpected status code on pods response: %d %s", resp.StatusCode, tryRead(resp.Body))
+		return nil, k8sErr.Wrap(&statusError{code: resp.StatusCode, body: tryRead(resp.Body)})
 	}
 
 	out := new(corev1.PodList)
@@ -534,6 +564,24 @@ func (c *kubeletClient) GetPodList() (*corev1.PodList, error) {
 	return out, nil
 }
 
+// statusError is returned when the kubelet responds to the pods request with
+// an unexpected status code.
+type statusError struct {
+	code int
+	body string
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("unexpected status code on pods response: %d %s", e.code, e.body)
+}
+
+// isUnauthorized returns true if the kubelet rejected the credentials used to
+// authenticate the request.
+func isUnauthorized(err error) bool {
+	var statusErr *statusError
+	return errors.As(err, &statusErr) && statusErr.code == http.StatusUnauthorized
+}
+
 func getContainerIDFromCGroups(cgroups []cgroups.Cgroup) (string, error) {
 	var containerID string
 	for _, cgroup := range cgroups {
@@ -290,6 +290,19 @@ func (s *Suite) TestAttestOverSecurePortViaTokenAuth() {
 	s.requireAttestFailure(`expected "Bearer default-token", got "Bearer bad-token"`)
 }
 
+func (s *Suite) TestAttestOverSecurePortReloadsRotatedToken() {
+	// start up a secure kubelet that only accepts the rotated token
+	s.startSecureKubelet(true, "rotated-token")
+
+	// the default token is loaded on configure
+	s.configureSecure(``)
+
+	// rotate the token before the reload interval elapses and make sure it
+	// is picked up once the kubelet rejects the old one
+	s.writeFile(defaultTokenPath, "rotated-token")
+	s.requireAttestSuccessWithPod()
+}
+
 func (s *Suite) TestAttestOverSecurePortViaClientAuth() {
 	// start up the secure kubelet with host networking and require client certs
 	s.startSecureKubelet(true, "")
@@ -719,7 +732,7 @@ func (s *Suite) startSecureKubelet(hostNetworking bool, token string) {
 			expectedAuth := "Bearer " + token
 			auth := req.Header.Get("Authorization")
 			if auth != expectedAuth {
-				http.Error(w, fmt.Sprintf("expected %q, got %q", expectedAuth, auth), http.StatusForbidden)
+				http.Error(w, fmt.Sprintf("expected %q, got %q", expectedAuth, auth), http.StatusUnauthorized)
 				return
 			}
 		}
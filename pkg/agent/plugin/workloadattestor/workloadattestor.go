@@ -23,6 +23,9 @@ const (
 	Type = "WorkloadAttestor"
 )
 
+// Version is the version of the WorkloadAttestor definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // WorkloadAttestor is the client interface for the service type WorkloadAttestor interface.
 type WorkloadAttestor interface {
 	Attest(context.Context, *AttestRequest) (*AttestResponse, error)
@@ -35,6 +38,11 @@ type Plugin interface {
 	GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error)
 }
 
+// Serve serves the WorkloadAttestor plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server WorkloadAttestorServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the WorkloadAttestor plugin.
 func PluginServer(server WorkloadAttestorServer) catalog.PluginServer {
 	return &pluginServer{
@@ -50,6 +58,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -68,6 +80,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(workloadattestor.NewWorkloadAttestorClient(conn))
 }
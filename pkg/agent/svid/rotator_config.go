@@ -15,6 +15,7 @@ import (
 	"github.com/spiffe/spire/pkg/agent/common/backoff"
 	"github.com/spiffe/spire/pkg/agent/manager/cache"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"google.golang.org/grpc/keepalive"
 )
 
 const DefaultRotatorInterval = 5 * time.Second
@@ -25,6 +26,8 @@ type RotatorConfig struct {
 	Metrics     telemetry.Metrics
 	TrustDomain url.URL
 	ServerAddr  string
+	// Keepalive parameters of the connection to the server, if any
+	ServerKeepalive *keepalive.ClientParameters
 	// Initial SVID and key
 	SVID    []*x509.Certificate
 	SVIDKey *ecdsa.PrivateKey
@@ -64,6 +67,8 @@ func newRotator(c *RotatorConfig) (*rotator, client.Client) {
 		Log:         c.Log,
 		Addr:        c.ServerAddr,
 		RotMtx:      rotMtx,
+		Keepalive:   c.ServerKeepalive,
+		Metrics:     c.Metrics,
 		KeysAndBundle: func() ([]*x509.Certificate, *ecdsa.PrivateKey, []*x509.Certificate) {
 			s := state.Value().(State)
 
@@ -0,0 +1,264 @@
+package store
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"crypto/x509"
+	"sort"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/agent/catalog"
+	"github.com/spiffe/spire/pkg/agent/manager/cache"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+)
+
+const DefaultInterval = 5 * time.Second
+
+// Manager is the subset of the cache manager used by the service.
+type Manager interface {
+	FetchWorkloadUpdateBySelectorType(selectorType string) *cache.WorkloadUpdate
+}
+
+type Config struct {
+	Log     logrus.FieldLogger
+	Manager Manager
+	Stores  []catalog.SVIDStore
+
+	// How long to wait between checks for updated SVIDs
+	Interval time.Duration
+
+	// Clk is the clock that the service will use to create a ticker
+	Clk clock.Clock
+}
+
+// Service stores the SVIDs of the registration entries designated for each
+// SVIDStore plugin. An entry is designated for a plugin when all of its
+// selectors are of the same type as the plugin name (e.g.
+// "aws_secretsmanager:secretname:foo"). The selector values are passed to
+// the plugin as metadata describing where and how to store the SVID.
+type Service struct {
+	c *Config
+
+	// stored holds what was stored for each entry, keyed by plugin name and
+	// then registration entry ID
+	stored map[string]map[string]storedSVID
+}
+
+type storedSVID struct {
+	metadata []string
+	digest   [sha256.Size]byte
+}
+
+func New(c *Config) *Service {
+	if c.Interval == 0 {
+		c.Interval = DefaultInterval
+	}
+	if c.Clk == nil {
+		c.Clk = clock.New()
+	}
+
+	stored := make(map[string]map[string]storedSVID, len(c.Stores))
+	for _, store := range c.Stores {
+		stored[store.Name()] = make(map[string]storedSVID)
+	}
+
+	return &Service{
+		c:      c,
+		stored: stored,
+	}
+}
+
+// Run stores the SVIDs on start and then every interval until the context
+// is canceled.
+func (s *Service) Run(ctx context.Context) error {
+	ticker := s.c.Clk.Ticker(s.c.Interval)
+	defer ticker.Stop()
+
+	for {
+		s.updateStores(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+func (s *Service) updateStores(ctx context.Context) {
+	for _, store := range s.c.Stores {
+		s.updateStore(ctx, store)
+	}
+}
+
+func (s *Service) updateStore(ctx context.Context, store catalog.SVIDStore) {
+	log := s.c.Log.WithField(telemetry.PluginName, store.Name())
+	stored := s.stored[store.Name()]
+
+	update := s.c.Manager.FetchWorkloadUpdateBySelectorType(store.Name())
+
+	current := make(map[string]bool, len(update.Identities))
+	for _, identity := range update.Identities {
+		entryID := identity.Entry.EntryId
+		current[entryID] = true
+
+		log := log.WithFields(logrus.Fields{
+			telemetry.RegistrationID: entryID,
+			telemetry.SPIFFEID:       identity.Entry.SpiffeId,
+		})
+
+		req, err := putRequest(identity, update)
+		if err != nil {
+			log.WithError(err).Error("Failed to build SVID store request")
+			continue
+		}
+
+		digest := requestDigest(req)
+		previous, ok := stored[entryID]
+		if ok && previous.digest == digest {
+			continue
+		}
+
+		if _, err := store.PutX509SVID(ctx, req); err != nil {
+			log.WithError(err).Error("Failed to put SVID to store")
+			continue
+		}
+		log.Debug("SVID stored successfully")
+
+		stored[entryID] = storedSVID{
+			metadata: req.Metadata,
+			digest:   digest,
+		}
+
+		// The metadata identifies where the SVID is stored. If it changed,
+		// the SVID stored at the previous location is deleted.
+		if ok && !equalMetadata(previous.metadata, req.Metadata) {
+			s.deleteSVID(ctx, log, store, previous.metadata)
+		}
+	}
+
+	for entryID, previous := range stored {
+		if current[entryID] {
+			continue
+		}
+		log := log.WithField(telemetry.RegistrationID, entryID)
+		if !s.deleteSVID(ctx, log, store, previous.metadata) {
+			continue
+		}
+		delete(stored, entryID)
+	}
+}
+
+func (s *Service) deleteSVID(ctx context.Context, log logrus.FieldLogger, store catalog.SVIDStore, metadata []string) bool {
+	if _, err := store.DeleteX509SVID(ctx, &svidstore.DeleteX509SVIDRequest{
+		Metadata: metadata,
+	}); err != nil {
+		log.WithError(err).Error("Failed to delete SVID from store")
+		return false
+	}
+	log.Debug("SVID deleted successfully")
+	return true
+}
+
+func putRequest(identity cache.Identity, update *cache.WorkloadUpdate) (*svidstore.PutX509SVIDRequest, error) {
+	keyDER, err := x509.MarshalPKCS8PrivateKey(identity.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiresAt int64
+	if len(identity.SVID) > 0 {
+		expiresAt = identity.SVID[0].NotAfter.Unix()
+	}
+
+	federatedBundles := make(map[string][]byte)
+	for _, federatesWith := range identity.Entry.FederatesWith {
+		if bundle := update.FederatedBundles[federatesWith]; bundle != nil {
+			federatedBundles[federatesWith] = bytes.Join(rawCerts(bundle.RootCAs()), nil)
+		}
+	}
+
+	metadata := make([]string, 0, len(identity.Entry.Selectors))
+	for _, selector := range identity.Entry.Selectors {
+		metadata = append(metadata, selector.Value)
+	}
+	sort.Strings(metadata)
+
+	return &svidstore.PutX509SVIDRequest{
+		Svid: &svidstore.X509SVID{
+			SpiffeId:   identity.Entry.SpiffeId,
+			CertChain:  rawCerts(identity.SVID),
+			PrivateKey: keyDER,
+			Bundle:     rawCerts(bundleRootCAs(update.Bundle)),
+			ExpiresAt:  expiresAt,
+		},
+		Metadata:         metadata,
+		FederatedBundles: federatedBundles,
+	}, nil
+}
+
+// requestDigest returns a digest of everything in the request except the
+// private key, which is rotated along with the certificate chain.
+func requestDigest(req *svidstore.PutX509SVIDRequest) [sha256.Size]byte {
+	h := sha256.New()
+	write := func(b []byte) {
+		_, _ = h.Write(b)
+		_, _ = h.Write([]byte{0})
+	}
+
+	for _, m := range req.Metadata {
+		write([]byte(m))
+	}
+	for _, cert := range req.Svid.CertChain {
+		write(cert)
+	}
+	for _, cert := range req.Svid.Bundle {
+		write(cert)
+	}
+
+	tds := make([]string, 0, len(req.FederatedBundles))
+	for td := range req.FederatedBundles {
+		tds = append(tds, td)
+	}
+	sort.Strings(tds)
+	for _, td := range tds {
+		write([]byte(td))
+		write(req.FederatedBundles[td])
+	}
+
+	var digest [sha256.Size]byte
+	copy(digest[:], h.Sum(nil))
+	return digest
+}
+
+func equalMetadata(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func bundleRootCAs(bundle *bundleutil.Bundle) []*x509.Certificate {
+	if bundle == nil {
+		return nil
+	}
+	return bundle.RootCAs()
+}
+
+func rawCerts(certs []*x509.Certificate) [][]byte {
+	out := make([][]byte, 0, len(certs))
+	for _, cert := range certs {
+		out = append(out, cert.Raw)
+	}
+	return out
+}
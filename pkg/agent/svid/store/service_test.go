@@ -0,0 +1,217 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/agent/catalog"
+	"github.com/spiffe/spire/pkg/agent/manager/cache"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/test/fakes/fakeagentcatalog"
+	"github.com/spiffe/spire/test/testca"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var (
+	td          = spiffeid.RequireTrustDomainFromString("example.org")
+	federatedTD = spiffeid.RequireTrustDomainFromString("federated.org")
+)
+
+func TestUpdateStores(t *testing.T) {
+	ca := testca.New(t, td)
+	federatedCA := testca.New(t, federatedTD)
+
+	bundle := bundleutil.BundleFromRootCAs(td.IDString(), ca.X509Authorities())
+	federatedBundle := bundleutil.BundleFromRootCAs(federatedTD.IDString(), federatedCA.X509Authorities())
+
+	foo := &common.RegistrationEntry{
+		EntryId:  "FOO",
+		SpiffeId: "spiffe://example.org/foo",
+		Selectors: []*common.Selector{
+			{Type: "store", Value: "secretname:foo"},
+			{Type: "store", Value: "kmskeyid:key"},
+		},
+		FederatesWith: []string{federatedTD.IDString()},
+	}
+	bar := &common.RegistrationEntry{
+		EntryId:  "BAR",
+		SpiffeId: "spiffe://example.org/bar",
+		Selectors: []*common.Selector{
+			{Type: "store", Value: "secretname:bar"},
+		},
+	}
+
+	manager := &fakeManager{
+		update: &cache.WorkloadUpdate{
+			Bundle: bundle,
+			FederatedBundles: map[string]*bundleutil.Bundle{
+				federatedTD.IDString(): federatedBundle,
+			},
+			Identities: []cache.Identity{
+				makeIdentity(ca, foo),
+				makeIdentity(ca, bar),
+			},
+		},
+	}
+	store := newFakeStore()
+	service := newService(manager, store)
+
+	// Both SVIDs are stored on the first update
+	service.updateStores(context.Background())
+	require.Len(t, store.puts, 2)
+	fooPut := store.puts[0]
+	assert.Equal(t, []string{"kmskeyid:key", "secretname:foo"}, fooPut.Metadata)
+	assert.Equal(t, "spiffe://example.org/foo", fooPut.Svid.SpiffeId)
+	assert.Equal(t, [][]byte{ca.X509Authorities()[0].Raw}, fooPut.Svid.Bundle)
+	assert.Equal(t, map[string][]byte{
+		federatedTD.IDString(): federatedCA.X509Authorities()[0].Raw,
+	}, fooPut.FederatedBundles)
+	assert.Equal(t, []string{"secretname:bar"}, store.puts[1].Metadata)
+	assert.Empty(t, store.deletes)
+
+	// Nothing is stored when nothing changed
+	store.reset()
+	service.updateStores(context.Background())
+	assert.Empty(t, store.puts)
+	assert.Empty(t, store.deletes)
+
+	// Only the rotated SVID is stored
+	store.reset()
+	manager.update.Identities[1] = makeIdentity(ca, bar)
+	service.updateStores(context.Background())
+	require.Len(t, store.puts, 1)
+	assert.Equal(t, []string{"secretname:bar"}, store.puts[0].Metadata)
+	assert.Empty(t, store.deletes)
+
+	// The SVID is stored in the new location and deleted from the old one
+	// when the metadata changes
+	store.reset()
+	bar.Selectors = []*common.Selector{
+		{Type: "store", Value: "secretname:baz"},
+	}
+	service.updateStores(context.Background())
+	require.Len(t, store.puts, 1)
+	assert.Equal(t, []string{"secretname:baz"}, store.puts[0].Metadata)
+	require.Len(t, store.deletes, 1)
+	assert.Equal(t, []string{"secretname:bar"}, store.deletes[0].Metadata)
+
+	// The SVID is deleted when the entry is removed
+	store.reset()
+	manager.update.Identities = manager.update.Identities[:1]
+	service.updateStores(context.Background())
+	assert.Empty(t, store.puts)
+	require.Len(t, store.deletes, 1)
+	assert.Equal(t, []string{"secretname:baz"}, store.deletes[0].Metadata)
+}
+
+func TestUpdateStoresRetriesFailures(t *testing.T) {
+	ca := testca.New(t, td)
+	foo := &common.RegistrationEntry{
+		EntryId:  "FOO",
+		SpiffeId: "spiffe://example.org/foo",
+		Selectors: []*common.Selector{
+			{Type: "store", Value: "secretname:foo"},
+		},
+	}
+
+	manager := &fakeManager{
+		update: &cache.WorkloadUpdate{
+			Bundle:     bundleutil.BundleFromRootCAs(td.IDString(), ca.X509Authorities()),
+			Identities: []cache.Identity{makeIdentity(ca, foo)},
+		},
+	}
+	store := newFakeStore()
+	service := newService(manager, store)
+
+	store.putErr = errors.New("oh no")
+	service.updateStores(context.Background())
+	assert.Len(t, store.puts, 1)
+
+	store.reset()
+	service.updateStores(context.Background())
+	assert.Len(t, store.puts, 1)
+
+	// Deletes are retried as well
+	store.reset()
+	store.deleteErr = errors.New("oh no")
+	manager.update.Identities = nil
+	service.updateStores(context.Background())
+	assert.Len(t, store.deletes, 1)
+
+	store.reset()
+	service.updateStores(context.Background())
+	assert.Len(t, store.deletes, 1)
+
+	store.reset()
+	service.updateStores(context.Background())
+	assert.Empty(t, store.deletes)
+}
+
+func newService(manager Manager, store *fakeStore) *Service {
+	log, _ := test.NewNullLogger()
+	return New(&Config{
+		Log:     log,
+		Manager: manager,
+		Stores:  []catalog.SVIDStore{fakeagentcatalog.SVIDStore("store", store)},
+	})
+}
+
+func makeIdentity(ca *testca.CA, entry *common.RegistrationEntry) cache.Identity {
+	svid := ca.CreateX509SVID(spiffeid.RequireFromString(entry.SpiffeId))
+	return cache.Identity{
+		Entry:      entry,
+		SVID:       svid.Certificates,
+		PrivateKey: svid.PrivateKey,
+	}
+}
+
+type fakeManager struct {
+	update *cache.WorkloadUpdate
+}
+
+func (m *fakeManager) FetchWorkloadUpdateBySelectorType(selectorType string) *cache.WorkloadUpdate {
+	if selectorType != "store" {
+		return &cache.WorkloadUpdate{}
+	}
+	return m.update
+}
+
+type fakeStore struct {
+	puts      []*svidstore.PutX509SVIDRequest
+	deletes   []*svidstore.DeleteX509SVIDRequest
+	putErr    error
+	deleteErr error
+}
+
+func newFakeStore() *fakeStore {
+	return &fakeStore{}
+}
+
+func (s *fakeStore) reset() {
+	s.puts = nil
+	s.deletes = nil
+	s.putErr = nil
+	s.deleteErr = nil
+}
+
+func (s *fakeStore) PutX509SVID(ctx context.Context, req *svidstore.PutX509SVIDRequest) (*svidstore.PutX509SVIDResponse, error) {
+	s.puts = append(s.puts, req)
+	if s.putErr != nil {
+		return nil, s.putErr
+	}
+	return &svidstore.PutX509SVIDResponse{}, nil
+}
+
+func (s *fakeStore) DeleteX509SVID(ctx context.Context, req *svidstore.DeleteX509SVIDRequest) (*svidstore.DeleteX509SVIDResponse, error) {
+	s.deletes = append(s.deletes, req)
+	if s.deleteErr != nil {
+		return nil, s.deleteErr
+	}
+	return &svidstore.DeleteX509SVIDResponse{}, nil
+}
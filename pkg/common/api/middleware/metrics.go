@@ -2,6 +2,8 @@ package middleware
 
 import (
 	"context"
+	"sync"
+	"sync/atomic"
 
 	"github.com/spiffe/spire/pkg/common/api/rpccontext"
 	"github.com/spiffe/spire/pkg/common/telemetry"
@@ -12,27 +14,68 @@ import (
 // labels to be attached to the per-call metrics via the
 // rpccontext.AddMetricsLabel function. If unset, it also provides name
 // metadata on to the handler context.
+//
+// In addition, calls are counted and timed under a single key labeled with
+// the service, method and status code of the call, and the number of calls in
+// flight is reported for each method. These metrics do not carry the labels
+// added by handlers so they are suitable to measure latency and error rates
+// across methods.
 func WithMetrics(metrics telemetry.Metrics) Middleware {
-	return metricsMiddleware{
+	return &metricsMiddleware{
 		metrics: metrics,
 	}
 }
 
 type metricsMiddleware struct {
 	metrics telemetry.Metrics
+
+	// inFlight holds the number of calls in flight (*int32), keyed by
+	// full method
+	inFlight sync.Map
 }
 
-func (m metricsMiddleware) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
+type handledCallKey struct{}
+
+func (m *metricsMiddleware) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
 	ctx, names := withNames(ctx, fullMethod)
-	counter := telemetry.StartCall(m.metrics, "rpc", names.MetricKey...)
-	return rpccontext.WithCallCounter(ctx, counter), nil
+	counter := telemetry.StartCall(m.metrics, telemetry.RPC, names.MetricKey...)
+	ctx = rpccontext.WithCallCounter(ctx, counter)
+
+	labels := methodLabels(names.Service, names.Method)
+	m.metrics.SetGaugeWithLabels([]string{telemetry.RPC, telemetry.InFlight}, float32(atomic.AddInt32(m.inFlightCounter(fullMethod), 1)), labels)
+
+	handled := telemetry.StartCall(m.metrics, telemetry.RPC, telemetry.Handled)
+	for _, label := range labels {
+		handled.AddLabel(label.Name, label.Value)
+	}
+	return context.WithValue(ctx, handledCallKey{}, handled), nil
 }
 
-func (m metricsMiddleware) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
+func (m *metricsMiddleware) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
 	counter, ok := rpccontext.CallCounter(ctx).(*telemetry.CallCounter)
 	if !ok {
 		LogMisconfiguration(ctx, "Metrics misconfigured; this is a bug")
 		return
 	}
 	counter.Done(&rpcErr)
+
+	if handled, ok := ctx.Value(handledCallKey{}).(*telemetry.CallCounter); ok {
+		handled.Done(&rpcErr)
+	}
+
+	if names, ok := rpccontext.Names(ctx); ok {
+		m.metrics.SetGaugeWithLabels([]string{telemetry.RPC, telemetry.InFlight}, float32(atomic.AddInt32(m.inFlightCounter(fullMethod), -1)), methodLabels(names.Service, names.Method))
+	}
+}
+
+func (m *metricsMiddleware) inFlightCounter(fullMethod string) *int32 {
+	counter, _ := m.inFlight.LoadOrStore(fullMethod, new(int32))
+	return counter.(*int32)
+}
+
+func methodLabels(service, method string) []telemetry.Label {
+	return []telemetry.Label{
+		{Name: telemetry.Service, Value: service},
+		{Name: telemetry.Method, Value: method},
+	}
 }
@@ -61,8 +61,19 @@ func TestWithMetrics(t *testing.T) {
 			m.Postprocess(ctx, fakeFullMethod, false, tt.rpcErr)
 
 			expectedLabels = append(expectedLabels, telemetry.Label{Name: "status", Value: tt.statusLabelValue})
+			methodLabels := []telemetry.Label{
+				{Name: "service", Value: "foo_v1_Foo"},
+				{Name: "method", Value: "SomeMethod"},
+			}
+			handledLabels := append(methodLabels, telemetry.Label{Name: "status", Value: tt.statusLabelValue})
 
 			assert.Equal(t, []fakemetrics.MetricItem{
+				{
+					Type:   fakemetrics.SetGaugeWithLabelsType,
+					Key:    []string{"rpc", "in_flight"},
+					Val:    1.00,
+					Labels: methodLabels,
+				},
 				{
 					Type:   fakemetrics.IncrCounterWithLabelsType,
 					Key:    []string{"rpc", "foo", "v1", "foo", "some_method"},
@@ -75,6 +86,23 @@ func TestWithMetrics(t *testing.T) {
 					Val:    0.00, // This is the elapsed time on the call counter, which doesn't currently support injecting a clock.
 					Labels: expectedLabels,
 				},
+				{
+					Type:   fakemetrics.IncrCounterWithLabelsType,
+					Key:    []string{"rpc", "handled"},
+					Val:    1.00,
+					Labels: handledLabels,
+				},
+				{
+					Type:   fakemetrics.MeasureSinceWithLabelsType,
+					Key:    []string{"rpc", "handled", "elapsed_time"},
+					Labels: handledLabels,
+				},
+				{
+					Type:   fakemetrics.SetGaugeWithLabelsType,
+					Key:    []string{"rpc", "in_flight"},
+					Val:    0.00,
+					Labels: methodLabels,
+				},
 			}, metrics.AllMetrics())
 		})
 	}
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"context"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// ServerTimeKey is the response header that carries the time, in RFC 3339
+// format, at which the server started handling the RPC. Callers compare it
+// against their own clock to detect clock skew.
+const ServerTimeKey = "spire-server-time"
+
+// WithServerTime returns middleware that sets the ServerTimeKey header on
+// every response.
+func WithServerTime(clk clock.Clock) Middleware {
+	return Preprocess(func(ctx context.Context, fullMethod string) (context.Context, error) {
+		// SetHeader only fails if there is no transport stream in the context
+		// or the headers were already sent, neither of which happens before
+		// the handler is invoked.
+		_ = grpc.SetHeader(ctx, metadata.Pairs(ServerTimeKey, clk.Now().UTC().Format(time.RFC3339Nano)))
+		return ctx, nil
+	})
+}
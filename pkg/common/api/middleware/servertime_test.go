@@ -0,0 +1,42 @@
+package middleware_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func TestWithServerTime(t *testing.T) {
+	clk := clock.NewMock(t)
+	clk.Set(time.Date(2021, 1, 2, 3, 4, 5, 6, time.UTC))
+	m := middleware.WithServerTime(clk)
+
+	stream := &fakeTransportStream{}
+	ctx := grpc.NewContextWithServerTransportStream(context.Background(), stream)
+
+	_, err := m.Preprocess(ctx, fakeFullMethod)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"2021-01-02T03:04:05.000000006Z"}, stream.header.Get(middleware.ServerTimeKey))
+
+	// Without a transport stream there is nowhere to set the header, which
+	// is not an error.
+	_, err = m.Preprocess(context.Background(), fakeFullMethod)
+	require.NoError(t, err)
+}
+
+type fakeTransportStream struct {
+	grpc.ServerTransportStream
+	header metadata.MD
+}
+
+func (s *fakeTransportStream) SetHeader(md metadata.MD) error {
+	s.header = metadata.Join(s.header, md)
+	return nil
+}
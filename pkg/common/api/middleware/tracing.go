@@ -0,0 +1,25 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/spiffe/spire/pkg/common/tracing"
+)
+
+// WithTracing adds an OpenTelemetry span to each RPC call. The span
+// continues the trace propagated by the caller, if any, and records the
+// status of the call. Spans are only recorded when tracing is configured.
+func WithTracing() Middleware {
+	return tracingMiddleware{}
+}
+
+type tracingMiddleware struct{}
+
+func (tracingMiddleware) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
+	ctx, names := withNames(ctx, fullMethod)
+	return tracing.StartRPCSpan(ctx, fullMethod, names.RawService, names.Method), nil
+}
+
+func (tracingMiddleware) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
+	tracing.EndSpan(ctx, rpcErr)
+}
@@ -96,6 +96,7 @@ func CommonBundleFromProto(b *types.Bundle) (*common.Bundle, error) {
 	return &common.Bundle{
 		TrustDomainId:  td.IDString(),
 		RefreshHint:    b.RefreshHint,
+		SequenceNumber: b.SequenceNumber,
 		RootCas:        rootCAs,
 		JwtSigningKeys: jwtKeys,
 	}, nil
@@ -140,6 +141,16 @@ func (b *Bundle) SetRefreshHint(d time.Duration) {
 	b.b.RefreshHint = int64((d + (time.Second - 1)) / time.Second)
 }
 
+// SequenceNumber returns the bundle sequence number.
+func (b *Bundle) SequenceNumber() uint64 {
+	return b.b.SequenceNumber
+}
+
+// SetSequenceNumber sets the bundle sequence number.
+func (b *Bundle) SetSequenceNumber(sequenceNumber uint64) {
+	b.b.SequenceNumber = sequenceNumber
+}
+
 func (b *Bundle) AppendRootCA(rootCA *x509.Certificate) {
 	b.b.RootCas = append(b.b.RootCas, &common.Certificate{
 		DerBytes: rootCA.Raw,
@@ -266,7 +277,9 @@ func PruneBundle(bundle *common.Bundle, expiration time.Time, log hclog.Logger)
 
 	// Creates new bundle with non expired certs only
 	newBundle := &common.Bundle{
-		TrustDomainId: bundle.TrustDomainId,
+		TrustDomainId:  bundle.TrustDomainId,
+		RefreshHint:    bundle.RefreshHint,
+		SequenceNumber: bundle.SequenceNumber,
 	}
 	changed := false
 pruneRootCA:
@@ -86,10 +86,13 @@ func Marshal(bundle *Bundle, opts ...MarshalOption) ([]byte, error) {
 	}
 
 	if !c.noJWTSVIDKeys {
-		for keyID, jwtSigningKey := range bundle.JWTSigningKeys() {
+		// Iterate over the keys in the order they are stored in the bundle
+		// instead of the key map so the document is deterministic.
+		jwtSigningKeys := bundle.JWTSigningKeys()
+		for _, jwtSigningKey := range bundle.Proto().JwtSigningKeys {
 			jwks.Keys = append(jwks.Keys, jose.JSONWebKey{
-				Key:   jwtSigningKey,
-				KeyID: keyID,
+				Key:   jwtSigningKeys[jwtSigningKey.Kid],
+				KeyID: jwtSigningKey.Kid,
 				Use:   maybeUse(jwtSVIDUse),
 			})
 		}
@@ -99,6 +102,7 @@ func Marshal(bundle *Bundle, opts ...MarshalOption) ([]byte, error) {
 	if !c.standardJWKS {
 		out = bundleDoc{
 			JSONWebKeySet: jwks,
+			Sequence:      bundle.SequenceNumber(),
 			RefreshHint:   int(c.refreshHint / time.Second),
 		}
 	}
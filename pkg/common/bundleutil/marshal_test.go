@@ -12,10 +12,11 @@ func TestMarshal(t *testing.T) {
 	rootCA := createCACertificate(t)
 
 	testCases := []struct {
-		name  string
-		empty bool
-		opts  []MarshalOption
-		out   string
+		name           string
+		empty          bool
+		sequenceNumber uint64
+		opts           []MarshalOption
+		out            string
 	}{
 		{
 			name:  "empty bundle",
@@ -30,6 +31,21 @@ func TestMarshal(t *testing.T) {
 			},
 			out: `{"keys":null, "spiffe_refresh_hint": 10}`,
 		},
+		{
+			name:           "with sequence number",
+			empty:          true,
+			sequenceNumber: 42,
+			out:            `{"keys":null, "spiffe_refresh_hint": 60, "spiffe_sequence": 42}`,
+		},
+		{
+			name:           "with sequence number as standard JWKS",
+			empty:          true,
+			sequenceNumber: 42,
+			opts: []MarshalOption{
+				StandardJWKS(),
+			},
+			out: `{"keys":null}`,
+		},
 		{
 			name: "without X509 SVID keys",
 			opts: []MarshalOption{
@@ -130,6 +146,7 @@ func TestMarshal(t *testing.T) {
 		t.Run(testCase.name, func(t *testing.T) {
 			bundle := New("spiffe://domain.test")
 			bundle.SetRefreshHint(time.Minute)
+			bundle.SetSequenceNumber(testCase.sequenceNumber)
 			if !testCase.empty {
 				bundle.AppendRootCA(rootCA)
 				require.NoError(t, bundle.AppendJWTSigningKey("FOO", testKey.Public()))
@@ -3,6 +3,7 @@ package bundleutil
 import (
 	"fmt"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -21,6 +22,16 @@ func TestUnmarshal(t *testing.T) {
 			doc:    "{}",
 			bundle: New("spiffe://domain.test"),
 		},
+		{
+			name: "refresh hint and sequence number",
+			doc:  `{"spiffe_refresh_hint": 300, "spiffe_sequence": 7}`,
+			bundle: func() *Bundle {
+				b := New("spiffe://domain.test")
+				b.SetRefreshHint(5 * time.Minute)
+				b.SetSequenceNumber(7)
+				return b
+			}(),
+		},
 		{
 			name: "entry missing use",
 			doc: `{
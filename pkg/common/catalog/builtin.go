@@ -106,12 +106,13 @@ func LoadBuiltInPlugin(ctx context.Context, builtin BuiltInPlugin) (plugin *Load
 	closers.AddCloser(builtinConn)
 
 	plugin, err = newCatalogPlugin(ctx, builtinConn, catalogPluginConfig{
-		Log:           builtin.Log,
-		Name:          builtin.Plugin.Name,
-		BuiltIn:       true,
-		Plugin:        pluginClient,
-		KnownServices: knownServices,
-		HostServices:  builtin.HostServices,
+		Log:             builtin.Log,
+		Name:            builtin.Plugin.Name,
+		BuiltIn:         true,
+		ProtocolVersion: protocolVersion2,
+		Plugin:          pluginClient,
+		KnownServices:   knownServices,
+		HostServices:    builtin.HostServices,
 	})
 	if err != nil {
 		return nil, err
@@ -2,6 +2,7 @@ package catalog
 
 import (
 	"context"
+	"sync"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/telemetry"
@@ -31,6 +32,10 @@ type Config struct {
 
 	// BuiltIns is the set of builtin plugins available to the host.
 	BuiltIns []Plugin
+
+	// RequireChecksums, if true, fails loading external plugins that do not
+	// have a checksum configured.
+	RequireChecksums bool
 }
 
 // Catalog provides a method to obtain clients to loaded plugins and services.
@@ -113,6 +118,12 @@ type Catalog interface {
 	//
 	Fill(x interface{}) error
 
+	// Reconfigure configures the loaded plugins with the given plugin
+	// configurations. Only the plugins whose configuration data changed are
+	// configured again. Plugins cannot be loaded, unloaded or replaced
+	// without reloading the catalog; such changes are logged and ignored.
+	Reconfigure(ctx context.Context, pluginConfigs []PluginConfig) error
+
 	// Close() closes the catalog, shutting down servers and killing external
 	// plugin processes.
 	Close()
@@ -122,7 +133,7 @@ type Closer interface {
 	Close()
 }
 
-func Fill(ctx context.Context, config Config, x interface{}) (Closer, error) {
+func Fill(ctx context.Context, config Config, x interface{}) (Catalog, error) {
 	c, err := Load(ctx, config)
 	if err != nil {
 		return nil, err
@@ -150,7 +161,10 @@ func Load(ctx context.Context, config Config) (_ Catalog, err error) {
 	}
 
 	// close the plugins if there is an error.
-	cat := new(catalog)
+	cat := &catalog{
+		log:          config.Log,
+		globalConfig: config.GlobalConfig,
+	}
 	defer func() {
 		if err != nil {
 			cat.Close()
@@ -188,13 +202,14 @@ func Load(ctx context.Context, config Config) (_ Catalog, err error) {
 			}
 
 			plugin, err = LoadExternalPlugin(ctx, ExternalPlugin{
-				Log:           config.Log,
-				Name:          c.Name,
-				Path:          c.Path,
-				Checksum:      c.Checksum,
-				Plugin:        extPlugin,
-				KnownServices: config.KnownServices,
-				HostServices:  config.HostServices,
+				Log:             config.Log,
+				Name:            c.Name,
+				Path:            c.Path,
+				Checksum:        c.Checksum,
+				RequireChecksum: config.RequireChecksums,
+				Plugin:          extPlugin,
+				KnownServices:   config.KnownServices,
+				HostServices:    config.HostServices,
 			})
 		}
 		if err != nil {
@@ -207,6 +222,7 @@ func Load(ctx context.Context, config Config) (_ Catalog, err error) {
 		// configure, panic, etc.) we want the defer above to close the plugin.
 		// Failure to do so can orphan an external plugin process.
 		cat.plugins = append(cat.plugins, plugin)
+		cat.configs = append(cat.configs, c)
 
 		if err := plugin.Configure(ctx, &spi.ConfigureRequest{
 			GlobalConfig:  config.GlobalConfig,
@@ -223,7 +239,14 @@ func Load(ctx context.Context, config Config) (_ Catalog, err error) {
 }
 
 type catalog struct {
+	log          logrus.FieldLogger
+	globalConfig *GlobalConfig
+
+	mtx     sync.Mutex
 	plugins []*LoadedPlugin
+	// configs holds the configuration each plugin in plugins was last
+	// configured with
+	configs []PluginConfig
 }
 
 func (c *catalog) Fill(x interface{}) (err error) {
@@ -231,6 +254,62 @@ func (c *catalog) Fill(x interface{}) (err error) {
 	return f.fill(x)
 }
 
+func (c *catalog) Reconfigure(ctx context.Context, pluginConfigs []PluginConfig) error {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	type pluginKey struct{ Type, Name string }
+	configs := make(map[pluginKey]PluginConfig, len(pluginConfigs))
+	for _, pc := range pluginConfigs {
+		if !pc.Disabled {
+			configs[pluginKey{Type: pc.Type, Name: pc.Name}] = pc
+		}
+	}
+
+	var errors []error
+	for i, plugin := range c.plugins {
+		current := c.configs[i]
+		key := pluginKey{Type: current.Type, Name: current.Name}
+		pluginLog := c.log.WithFields(logrus.Fields{
+			telemetry.PluginName: current.Name,
+			telemetry.PluginType: current.Type,
+		})
+
+		pc, ok := configs[key]
+		delete(configs, key)
+		switch {
+		case !ok:
+			pluginLog.Warn("Plugin is no longer configured; a restart is required to unload it")
+			continue
+		case pc.Path != current.Path || pc.Checksum != current.Checksum:
+			pluginLog.Warn("Plugin command changed; a restart is required to load it")
+			continue
+		case pc.Data == current.Data:
+			continue
+		}
+
+		if err := plugin.Configure(ctx, &spi.ConfigureRequest{
+			GlobalConfig:  c.globalConfig,
+			Configuration: pc.Data,
+		}); err != nil {
+			pluginLog.WithError(err).Error("Failed to reconfigure plugin")
+			errors = append(errors, errs.New("unable to reconfigure plugin %q: %v", pc.Name, err))
+			continue
+		}
+		c.configs[i].Data = pc.Data
+		pluginLog.Info("Plugin reconfigured")
+	}
+
+	for key := range configs {
+		c.log.WithFields(logrus.Fields{
+			telemetry.PluginName: key.Name,
+			telemetry.PluginType: key.Type,
+		}).Warn("Plugin is not loaded; a restart is required to load it")
+	}
+
+	return errs.Combine(errors...)
+}
+
 func (c *catalog) Close() {
 	for _, p := range c.plugins {
 		p.Close()
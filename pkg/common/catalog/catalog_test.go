@@ -9,6 +9,7 @@ import (
 	"os/exec"
 	"path/filepath"
 	"reflect"
+	"strings"
 	"testing"
 
 	"github.com/sirupsen/logrus"
@@ -59,6 +60,8 @@ type CatalogSuite struct {
 	knownServices []catalog.ServiceClient
 	builtins      []catalog.Plugin
 	hostServices  []catalog.HostServiceServer
+
+	requireChecksums bool
 }
 
 // SetupSuite builds the test plugin binary
@@ -93,6 +96,7 @@ func (s *CatalogSuite) SetupTest() {
 	}
 	s.builtins = nil
 	s.pluginConfig = nil
+	s.requireChecksums = false
 }
 
 func (s *CatalogSuite) AfterTest(suiteName, testName string) {
@@ -108,25 +112,16 @@ func (s *CatalogSuite) AfterTest(suiteName, testName string) {
 func (s *CatalogSuite) TestOldPlugin() {
 	require := s.Require()
 
-	path := filepath.Join(s.dir, "oldpluginbin")
-	buildOutput, err := exec.Command("go", "build", "-o", path, "catalog_test_oldplugin.go").CombinedOutput()
-	if err != nil {
-		s.T().Logf("build output:\n%s\n", string(buildOutput))
-		s.FailNow("failed to build old test plugin")
-	}
-	checksum, err := calculateChecksum(path)
-	require.NoError(err, "unable to calculate old plugin checksum")
-
-	plugin, err := catalog.LoadExternalPlugin(context.Background(), catalog.ExternalPlugin{
-		Log:      s.log,
-		Name:     "oldpluginbin",
-		Path:     path,
-		Checksum: checksum,
-		Plugin:   catalogtest.PluginPluginClient,
-	})
+	plugin, err := s.loadOldPlugin("")
 	require.NoError(err, "unable to load old plugin")
 	defer plugin.Close()
 
+	s.assertHasLogEntry(testLogEntry{
+		Level:   logrus.WarnLevel,
+		Message: "Plugin was built against a deprecated version of the plugin SDK and should be rebuilt",
+		Data:    logrus.Fields{},
+	})
+
 	var v catalogtest.Plugin
 	err = plugin.Fill(&v)
 	require.NoError(err, "unable to get old plugin client")
@@ -138,6 +133,68 @@ func (s *CatalogSuite) TestOldPlugin() {
 	s.Require().Equal("plugin(OLD)", resp.Out)
 }
 
+func (s *CatalogSuite) TestInitRequiredByProtocolVersion2() {
+	plugin, err := s.loadOldPlugin("2")
+	if !s.Error(err) {
+		plugin.Close()
+		return
+	}
+	s.Contains(err.Error(), "code = Unimplemented")
+}
+
+func (s *CatalogSuite) TestUnsupportedProtocolVersion() {
+	plugin, err := s.loadOldPlugin("3")
+	if !s.Error(err) {
+		plugin.Close()
+		return
+	}
+	s.Contains(err.Error(), "Incompatible API version with plugin. Plugin version: 3")
+}
+
+func (s *CatalogSuite) TestPluginVersionMismatch() {
+	s.builtins = []catalog.Plugin{
+		catalog.MakePlugin("testbuiltin",
+			versionedPluginServer{
+				PluginServer: catalogtest.PluginPluginServer(test.NewPlugin()),
+				version:      catalogtest.PluginVersion + 1,
+			},
+		),
+	}
+	s.pluginConfig = s.builtinConfig()
+
+	s.assertFillCatalogFails(`plugin implements Plugin version 2; version 1 is required`)
+}
+
+func (s *CatalogSuite) TestServiceVersionMismatch() {
+	s.builtins = []catalog.Plugin{
+		catalog.MakePlugin("testbuiltin",
+			catalogtest.PluginPluginServer(test.NewPlugin()),
+			versionedServiceServer{
+				ServiceServer: catalogtest.ServiceServiceServer(test.NewService()),
+				version:       catalogtest.ServiceVersion + 1,
+			},
+		),
+	}
+	s.pluginConfig = s.builtinConfig()
+
+	// plugins are still loaded even if they offer unsupported services
+	c := new(testCatalog)
+	closer, err := s.fillCatalog(c)
+	s.Require().NoError(err)
+	defer closer.Close()
+	s.Nil(c.Service)
+
+	s.assertHasLogEntry(testLogEntry{
+		Level:   logrus.WarnLevel,
+		Message: "Unsupported service version",
+		Data: logrus.Fields{
+			telemetry.PluginService:        "Service",
+			telemetry.PluginServiceVersion: uint32(2),
+			telemetry.RequiredVersion:      uint32(1),
+		},
+	})
+}
+
 func (s *CatalogSuite) TestNoKnownPlugin() {
 	s.knownPlugins = nil
 	s.pluginConfig = s.extPluginConfig()
@@ -212,6 +269,34 @@ func (s *CatalogSuite) TestDisabledPlugin() {
 	s.assertFillCatalogFails(`unable to set catalog field "Plugin": requires at least 1 Plugin(s); got 0`)
 }
 
+func (s *CatalogSuite) TestChecksumRequired() {
+	s.pluginConfig = s.extPluginConfig()
+	s.pluginConfig[0].Checksum = ""
+	s.requireChecksums = true
+
+	s.assertFillCatalogFails(`plugin "testext" has no checksum configured; a checksum is required`)
+}
+
+func (s *CatalogSuite) TestChecksumMalformed() {
+	s.pluginConfig = s.extPluginConfig()
+	s.pluginConfig[0].Checksum = "abcd"
+
+	s.assertFillCatalogFails(`checksum must be a hex-encoded SHA-256 digest; got 2 bytes`)
+}
+
+func (s *CatalogSuite) TestChecksumMismatch() {
+	s.pluginConfig = s.extPluginConfig()
+	s.pluginConfig[0].Checksum = strings.Repeat("00", sha256.Size)
+
+	c := new(testCatalog)
+	closer, err := s.fillCatalog(c)
+	if !s.Error(err) {
+		closer.Close()
+		return
+	}
+	s.Contains(err.Error(), "checksums did not match")
+}
+
 func (s *CatalogSuite) TestUnknownBuiltIn() {
 	s.pluginConfig = s.builtinConfig()
 
@@ -668,6 +753,68 @@ func (s *CatalogSuite) TestPluginsFill() {
 	}
 }
 
+func (s *CatalogSuite) TestReconfigure() {
+	s.builtins = []catalog.Plugin{testBuiltIn()}
+	s.pluginConfig = s.builtinConfig()
+	cat := s.loadCatalog()
+	defer cat.Close()
+
+	configureCalls := func() []string {
+		var configs []string
+		for _, entry := range s.logHook.AllEntries() {
+			if entry.Message == "Configure called" {
+				configs = append(configs, entry.Data["config"].(string))
+			}
+		}
+		return configs
+	}
+	s.Require().Equal([]string{"CONFIG"}, configureCalls())
+
+	// Plugins are not configured again if their data did not change
+	s.Require().NoError(cat.Reconfigure(context.Background(), s.builtinConfig()))
+	s.Require().Equal([]string{"CONFIG"}, configureCalls())
+
+	// Plugins are configured again with the changed data
+	pluginConfig := s.builtinConfig()
+	pluginConfig[0].Data = "NEWCONFIG"
+	s.Require().NoError(cat.Reconfigure(context.Background(), pluginConfig))
+	s.Require().Equal([]string{"CONFIG", "NEWCONFIG"}, configureCalls())
+	s.assertHasLogEntry(testLogEntry{
+		Level:   logrus.InfoLevel,
+		Message: "Plugin reconfigured",
+		Data: logrus.Fields{
+			telemetry.PluginName: "testbuiltin",
+			telemetry.PluginType: "Plugin",
+		},
+	})
+
+	// Failures to configure are returned
+	pluginConfig[0].Data = "BAD"
+	s.Require().EqualError(cat.Reconfigure(context.Background(), pluginConfig), `unable to reconfigure plugin "testbuiltin": rpc error: code = InvalidArgument desc = BAD configuration`)
+
+	// Plugins that would need to be loaded or unloaded are ignored
+	s.Require().NoError(cat.Reconfigure(context.Background(), s.extPluginConfig()))
+	s.Require().Equal([]string{"CONFIG", "NEWCONFIG", "BAD"}, configureCalls())
+	s.assertHasLogEntries([]testLogEntry{
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Plugin is no longer configured; a restart is required to unload it",
+			Data: logrus.Fields{
+				telemetry.PluginName: "testbuiltin",
+				telemetry.PluginType: "Plugin",
+			},
+		},
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Plugin is not loaded; a restart is required to load it",
+			Data: logrus.Fields{
+				telemetry.PluginName: "testext",
+				telemetry.PluginType: "Plugin",
+			},
+		},
+	})
+}
+
 func (s *CatalogSuite) assertFillCatalogFails(expectedErr string) {
 	c := new(testCatalog)
 	closer, err := s.fillCatalog(c)
@@ -704,6 +851,33 @@ func (s *CatalogSuite) assertExternalPluginCalls(pluginOut, serviceOut string) {
 	}
 }
 
+// loadOldPlugin builds and loads the old test plugin, served over the given
+// protocol version (or the default if empty).
+func (s *CatalogSuite) loadOldPlugin(protocolVersion string) (*catalog.LoadedPlugin, error) {
+	path := filepath.Join(s.dir, "oldpluginbin")
+	buildOutput, err := exec.Command("go", "build", "-o", path, "catalog_test_oldplugin.go").CombinedOutput()
+	if err != nil {
+		s.T().Logf("build output:\n%s\n", string(buildOutput))
+		s.FailNow("failed to build old test plugin")
+	}
+	checksum, err := calculateChecksum(path)
+	s.Require().NoError(err, "unable to calculate old plugin checksum")
+
+	// the plugin inherits the environment of the process loading it
+	if protocolVersion != "" {
+		os.Setenv("OLD_PLUGIN_PROTOCOL_VERSION", protocolVersion)
+		defer os.Unsetenv("OLD_PLUGIN_PROTOCOL_VERSION")
+	}
+
+	return catalog.LoadExternalPlugin(context.Background(), catalog.ExternalPlugin{
+		Log:      s.log,
+		Name:     "oldpluginbin",
+		Path:     path,
+		Checksum: checksum,
+		Plugin:   catalogtest.PluginPluginClient,
+	})
+}
+
 func (s *CatalogSuite) fillCatalog(c interface{}) (catalog.Closer, error) {
 	return catalog.Fill(context.Background(), catalog.Config{
 		Log: s.log,
@@ -715,6 +889,8 @@ func (s *CatalogSuite) fillCatalog(c interface{}) (catalog.Closer, error) {
 		KnownServices: s.knownServices,
 		BuiltIns:      s.builtins,
 		HostServices:  s.hostServices,
+
+		RequireChecksums: s.requireChecksums,
 	}, c)
 }
 
@@ -729,6 +905,8 @@ func (s *CatalogSuite) loadCatalog() catalog.Catalog {
 		KnownServices: s.knownServices,
 		HostServices:  s.hostServices,
 		BuiltIns:      s.builtins,
+
+		RequireChecksums: s.requireChecksums,
 	})
 	s.Require().NoError(err)
 	return cat
@@ -781,6 +959,38 @@ func testLogEntryFromEntry(entry *logrus.Entry) testLogEntry {
 	}
 }
 
+func TestValidatePluginConfigs(t *testing.T) {
+	knownPlugins := []catalog.PluginClient{catalogtest.PluginPluginClient}
+	builtIns := []catalog.Plugin{testBuiltIn()}
+
+	errs := catalog.ValidatePluginConfigs([]catalog.PluginConfig{
+		{Type: "Plugin", Name: "testbuiltin"},
+		{Type: "Plugin", Name: "testext", Path: "/path/to/plugin"},
+		{Type: "Plugin", Name: "disabled", Disabled: true},
+		{Type: "Plugin", Name: "unknown"},
+		{Type: "Unknown", Name: "ext", Path: "/path/to/plugin"},
+		{Type: "Unknown", Name: "builtin"},
+		{Type: "Plugin", Name: "badchecksum", Path: "/path/to/plugin", Checksum: "abcd"},
+	}, knownPlugins, builtIns)
+	require.Equal(t, []string{
+		`invalid checksum for Plugin plugin "badchecksum": checksum must be a hex-encoded SHA-256 digest; got 2 bytes`,
+		`no such Plugin builtin "unknown"`,
+		`no such Unknown builtin "builtin"`,
+		`unknown plugin type "Unknown" for plugin "ext"`,
+	}, errorStrings(errs))
+
+	errs = catalog.ValidatePluginConfigs(nil, knownPlugins, append(builtIns, testBuiltIn()))
+	require.Equal(t, []string{`duplicate Plugin builtin "testbuiltin"`}, errorStrings(errs))
+}
+
+func errorStrings(errs []error) []string {
+	var out []string
+	for _, err := range errs {
+		out = append(out, err.Error())
+	}
+	return out
+}
+
 func testBuiltIn() catalog.Plugin {
 	builtin := testBuiltInNoService()
 	builtin.Services = append(builtin.Services, catalogtest.ServiceServiceServer(test.NewService()))
@@ -793,6 +1003,24 @@ func testBuiltInNoService() catalog.Plugin {
 	)
 }
 
+type versionedPluginServer struct {
+	catalog.PluginServer
+	version uint32
+}
+
+func (s versionedPluginServer) PluginVersion() uint32 {
+	return s.version
+}
+
+type versionedServiceServer struct {
+	catalog.ServiceServer
+	version uint32
+}
+
+func (s versionedServiceServer) ServiceVersion() uint32 {
+	return s.version
+}
+
 func calculateChecksum(path string) (string, error) {
 	f, err := os.Open(path)
 	if err != nil {
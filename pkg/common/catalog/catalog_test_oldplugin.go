@@ -1,12 +1,16 @@
 // +build ignore
 
 // This file is used during testing. It is built as an external binary and
-// loaded as an external plugin.
+// loaded as an external plugin. It serves the plugin without the catalog
+// plugin initialization service over the protocol version set by the
+// OLD_PLUGIN_PROTOCOL_VERSION environment variable (defaults to 1).
 package main
 
 import (
 	"context"
 	"errors"
+	"os"
+	"strconv"
 
 	goplugin "github.com/hashicorp/go-plugin"
 	"github.com/spiffe/spire/pkg/common/catalog/test"
@@ -15,9 +19,17 @@ import (
 )
 
 func main() {
+	protocolVersion := uint64(1)
+	if s := os.Getenv("OLD_PLUGIN_PROTOCOL_VERSION"); s != "" {
+		var err error
+		protocolVersion, err = strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			panic(err)
+		}
+	}
 	goplugin.Serve(&goplugin.ServeConfig{
 		HandshakeConfig: goplugin.HandshakeConfig{
-			ProtocolVersion:  1,
+			ProtocolVersion:  uint(protocolVersion),
 			MagicCookieKey:   "Plugin",
 			MagicCookieValue: "Plugin",
 		},
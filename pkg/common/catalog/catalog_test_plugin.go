@@ -5,14 +5,13 @@
 package main
 
 import (
-	"github.com/spiffe/spire/pkg/common/catalog"
 	"github.com/spiffe/spire/pkg/common/catalog/test"
 	"github.com/spiffe/spire/proto/private/test/catalogtest"
 )
 
 func main() {
-	catalog.PluginMain(catalog.MakePlugin("test",
-		catalogtest.PluginPluginServer(test.NewPlugin()),
+	catalogtest.PluginServe("test",
+		test.NewPlugin(),
 		catalogtest.ServiceServiceServer(test.NewService()),
-	))
+	)
 }
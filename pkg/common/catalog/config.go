@@ -2,6 +2,8 @@ package catalog
 
 import (
 	"bytes"
+	"fmt"
+	"sort"
 
 	"github.com/hashicorp/hcl"
 	"github.com/hashicorp/hcl/hcl/ast"
@@ -38,6 +40,17 @@ func (c HCLPluginConfig) IsEnabled() bool {
 
 type HCLPluginConfigMap map[string]map[string]HCLPluginConfig
 
+// EnabledCount returns the number of enabled plugins of the given type.
+func (m HCLPluginConfigMap) EnabledCount(pluginType string) int {
+	count := 0
+	for _, c := range m[pluginType] {
+		if c.IsEnabled() {
+			count++
+		}
+	}
+	return count
+}
+
 func ParsePluginConfigsFromHCL(config string) ([]PluginConfig, error) {
 	var hclConfig HCLPluginConfigMap
 	if err := hcl.Decode(&hclConfig, config); err != nil {
@@ -62,8 +75,12 @@ func PluginConfigsFromHCL(hclPlugins HCLPluginConfigMap) ([]PluginConfig, error)
 
 func PluginConfigFromHCL(pluginType, pluginName string, hclPluginConfig HCLPluginConfig) (PluginConfig, error) {
 	var data bytes.Buffer
-	if err := printer.DefaultConfig.Fprint(&data, hclPluginConfig.PluginData); err != nil {
-		return PluginConfig{}, err
+	// The printer writes a diagnostic to stdout when given a nil node, so
+	// only print the data when it has been provided.
+	if hclPluginConfig.PluginData != nil {
+		if err := printer.DefaultConfig.Fprint(&data, hclPluginConfig.PluginData); err != nil {
+			return PluginConfig{}, err
+		}
 	}
 
 	return PluginConfig{
@@ -75,3 +92,47 @@ func PluginConfigFromHCL(pluginType, pluginName string, hclPluginConfig HCLPlugi
 		Disabled: !hclPluginConfig.IsEnabled(),
 	}, nil
 }
+
+// ValidatePluginConfigs checks, without loading any plugin, that each enabled
+// plugin is either one of the given built-ins or an external plugin of a
+// known type with a well-formed checksum, if any. All of the problems found are returned, ordered by plugin type
+// and name.
+func ValidatePluginConfigs(pluginConfigs []PluginConfig, knownPlugins []PluginClient, builtIns []Plugin) []error {
+	knownPluginsMap, err := makePluginsMap(knownPlugins)
+	if err != nil {
+		return []error{err}
+	}
+	builtinsMap, err := makeBuiltInsMap(builtIns)
+	if err != nil {
+		return []error{err}
+	}
+
+	pluginConfigs = append([]PluginConfig(nil), pluginConfigs...)
+	sort.Slice(pluginConfigs, func(i, j int) bool {
+		if pluginConfigs[i].Type != pluginConfigs[j].Type {
+			return pluginConfigs[i].Type < pluginConfigs[j].Type
+		}
+		return pluginConfigs[i].Name < pluginConfigs[j].Name
+	})
+
+	var errs []error
+	for _, c := range pluginConfigs {
+		switch {
+		case c.Disabled:
+		case c.Path == "":
+			if _, ok := builtinsMap.Lookup(c.Name, c.Type); !ok {
+				errs = append(errs, fmt.Errorf("no such %s builtin %q", c.Type, c.Name))
+			}
+		default:
+			if _, ok := knownPluginsMap[c.Type]; !ok {
+				errs = append(errs, fmt.Errorf("unknown plugin type %q for plugin %q", c.Type, c.Name))
+			}
+			if c.Checksum != "" {
+				if _, err := buildSecureConfig(c.Checksum); err != nil {
+					errs = append(errs, fmt.Errorf("invalid checksum for %s plugin %q: %v", c.Type, c.Name, err))
+				}
+			}
+		}
+	}
+	return errs
+}
@@ -70,3 +70,15 @@ func sortPluginConfig(c []PluginConfig) {
 		return a.Name < b.Name
 	})
 }
+
+func TestEnabledCount(t *testing.T) {
+	disabled := false
+	config := HCLPluginConfigMap{
+		"TYPE1": {
+			"NAME1": {},
+			"NAME2": {Enabled: &disabled},
+		},
+	}
+	require.Equal(t, 1, config.EnabledCount("TYPE1"))
+	require.Equal(t, 0, config.EnabledCount("TYPE2"))
+}
@@ -22,6 +22,16 @@ import (
 const (
 	// the ID used to dial host services
 	hostServicesID = 1
+
+	// protocolVersion1 is spoken by plugins that predate versioned plugin
+	// definitions. Initialization is optional and the plugin does not report
+	// the versions of the definitions it implements.
+	protocolVersion1 = 1
+
+	// protocolVersion2 requires initialization. The plugin reports the
+	// versions of the plugin and service definitions it implements, which
+	// must match the versions required by SPIRE.
+	protocolVersion2 = 2
 )
 
 func PluginMain(plugin Plugin) {
@@ -30,17 +40,23 @@ func PluginMain(plugin Plugin) {
 		Output:     os.Stderr,
 		JSONFormat: true,
 	})
+	// The same plugin is served over every protocol version. Older versions of
+	// SPIRE negotiate protocol version 1 and ignore the reported versions.
+	plugins := map[string]goplugin.Plugin{
+		plugin.Name: &hcServerPlugin{
+			logger: logger,
+			plugin: plugin,
+		},
+	}
 	goplugin.Serve(&goplugin.ServeConfig{
 		HandshakeConfig: goplugin.HandshakeConfig{
-			ProtocolVersion:  1,
+			ProtocolVersion:  protocolVersion1,
 			MagicCookieKey:   plugin.Plugin.PluginType(),
 			MagicCookieValue: plugin.Plugin.PluginType(),
 		},
-		Plugins: map[string]goplugin.Plugin{
-			plugin.Name: &hcServerPlugin{
-				logger: logger,
-				plugin: plugin,
-			},
+		VersionedPlugins: map[int]goplugin.PluginSet{
+			protocolVersion1: plugins,
+			protocolVersion2: plugins,
 		},
 		Logger:     logger,
 		GRPCServer: goplugin.DefaultGRPCServer,
@@ -87,6 +103,10 @@ type ExternalPlugin struct {
 	Plugin        PluginClient
 	KnownServices []ServiceClient
 	HostServices  []HostServiceServer
+
+	// RequireChecksum, if true, fails loading the plugin if no checksum is
+	// configured.
+	RequireChecksum bool
 }
 
 func LoadExternalPlugin(ctx context.Context, ext ExternalPlugin) (plugin *LoadedPlugin, err error) {
@@ -105,6 +125,9 @@ func LoadExternalPlugin(ctx context.Context, ext ExternalPlugin) (plugin *Loaded
 			return nil, err
 		}
 	} else {
+		if ext.RequireChecksum {
+			return nil, fmt.Errorf("plugin %q has no checksum configured; a checksum is required", ext.Name)
+		}
 		ext.Log.Warn("Plugin checksum not configured")
 	}
 
@@ -118,9 +141,13 @@ func LoadExternalPlugin(ctx context.Context, ext ExternalPlugin) (plugin *Loaded
 	}
 
 	// start the external plugin. ensure it is killed if there is an error.
+	// the highest protocol version supported by both sides is negotiated.
+	plugins := map[string]goplugin.Plugin{
+		"external": hcPlugin,
+	}
 	pluginClient := goplugin.NewClient(&goplugin.ClientConfig{
 		HandshakeConfig: goplugin.HandshakeConfig{
-			ProtocolVersion:  1,
+			ProtocolVersion:  protocolVersion1,
 			MagicCookieKey:   ext.Plugin.PluginType(),
 			MagicCookieValue: ext.Plugin.PluginType(),
 		},
@@ -129,8 +156,9 @@ func LoadExternalPlugin(ctx context.Context, ext ExternalPlugin) (plugin *Loaded
 		// See https://github.com/hashicorp/go-plugin/issues/109
 		AutoMTLS:         false,
 		AllowedProtocols: []goplugin.Protocol{goplugin.ProtocolGRPC},
-		Plugins: map[string]goplugin.Plugin{
-			"external": hcPlugin,
+		VersionedPlugins: map[int]goplugin.PluginSet{
+			protocolVersion1: plugins,
+			protocolVersion2: plugins,
 		},
 		Logger:       logger.Named(ext.Name),
 		SecureConfig: secureConfig,
@@ -146,6 +174,7 @@ func LoadExternalPlugin(ctx context.Context, ext ExternalPlugin) (plugin *Loaded
 	if err != nil {
 		return nil, err
 	}
+	hcPlugin.protocolVersion = pluginClient.NegotiatedVersion()
 
 	// the primary interface is dispensed via the plugin name
 	pluginRaw, err := grpcClient.Dispense("external")
@@ -173,6 +202,9 @@ func buildSecureConfig(checksum string) (*goplugin.SecureConfig, error) {
 	if err != nil {
 		return nil, fmt.Errorf("unable to decode checksum: %v", err)
 	}
+	if len(sum) != sha256.Size {
+		return nil, fmt.Errorf("checksum must be a hex-encoded SHA-256 digest; got %d bytes", len(sum))
+	}
 
 	return &goplugin.SecureConfig{
 		Checksum: sum,
@@ -182,8 +214,9 @@ func buildSecureConfig(checksum string) (*goplugin.SecureConfig, error) {
 
 type hcClientPlugin struct {
 	goplugin.NetRPCUnsupportedPlugin
-	ext ExternalPlugin
-	wg  sync.WaitGroup
+	ext             ExternalPlugin
+	protocolVersion int
+	wg              sync.WaitGroup
 }
 
 var _ goplugin.GRPCPlugin = (*hcClientPlugin)(nil)
@@ -218,12 +251,13 @@ func (p *hcClientPlugin) GRPCClient(ctx context.Context, b *goplugin.GRPCBroker,
 	}()
 
 	plugin, err := newCatalogPlugin(ctx, c, catalogPluginConfig{
-		Log:           p.ext.Log,
-		Name:          p.ext.Name,
-		BuiltIn:       false,
-		Plugin:        p.ext.Plugin,
-		KnownServices: p.ext.KnownServices,
-		HostServices:  p.ext.HostServices,
+		Log:             p.ext.Log,
+		Name:            p.ext.Name,
+		BuiltIn:         false,
+		ProtocolVersion: p.protocolVersion,
+		Plugin:          p.ext.Plugin,
+		KnownServices:   p.ext.KnownServices,
+		HostServices:    p.ext.HostServices,
 	})
 	if err != nil {
 		return nil, err
@@ -8,6 +8,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/common/tracing"
 	spi "github.com/spiffe/spire/proto/spire/common/plugin"
 	"github.com/zeebo/errs"
 	"google.golang.org/grpc"
@@ -20,12 +21,13 @@ type hostDialer interface {
 }
 
 type catalogPluginConfig struct {
-	Log           logrus.FieldLogger
-	Name          string
-	BuiltIn       bool
-	Plugin        PluginClient
-	KnownServices []ServiceClient
-	HostServices  []HostServiceServer
+	Log             logrus.FieldLogger
+	Name            string
+	BuiltIn         bool
+	ProtocolVersion int
+	Plugin          PluginClient
+	KnownServices   []ServiceClient
+	HostServices    []HostServiceServer
 }
 
 func newCatalogPlugin(ctx context.Context, c *grpc.ClientConn, config catalogPluginConfig) (*LoadedPlugin, error) {
@@ -40,15 +42,31 @@ func newCatalogPlugin(ctx context.Context, c *grpc.ClientConn, config catalogPlu
 	}
 
 	// Try an initialize the plugin. If this fails due to "unimplemented" then
-	// the plugin is an old-style plugin and does not offer services.
+	// the plugin is an old-style plugin and does not offer services. Only
+	// plugins speaking protocol version 1 are allowed to be old-style.
 	initClient := spi.NewPluginInitClient(c)
 	resp, err := initClient.Init(ctx, &spi.InitRequest{
 		HostServices: hostServiceTypes,
 	})
-	if err != nil && status.Code(err) != codes.Unimplemented {
+	if err != nil && (config.ProtocolVersion != protocolVersion1 || status.Code(err) != codes.Unimplemented) {
 		return nil, errs.Wrap(err)
 	}
 
+	// Plugins speaking protocol version 1 do not report the versions of the
+	// definitions they implement, so they are loaded as-is.
+	checkVersions := config.ProtocolVersion != protocolVersion1
+	if checkVersions {
+		if resp.PluginVersion != config.Plugin.PluginVersion() {
+			return nil, errs.New("plugin implements %s version %d; version %d is required",
+				config.Plugin.PluginType(), resp.PluginVersion, config.Plugin.PluginVersion())
+		}
+	} else if !config.BuiltIn {
+		config.Log.Warn("Plugin was built against a deprecated version of the plugin SDK and should be rebuilt")
+	}
+
+	// Plugin and service clients trace the calls made to the plugin
+	cc := tracing.WrapPluginConn(c, config.Name)
+
 	var serviceImpls []interface{}
 	var serviceNames []string
 	if resp != nil {
@@ -58,13 +76,21 @@ func newCatalogPlugin(ctx context.Context, c *grpc.ClientConn, config catalogPlu
 				config.Log.WithField(telemetry.PluginService, typ).Warn("Unknown service type")
 				continue
 			}
-			serviceImpls = append(serviceImpls, service.NewServiceClient(c))
+			if version := resp.PluginServiceVersions[typ]; checkVersions && version != service.ServiceVersion() {
+				config.Log.WithFields(logrus.Fields{
+					telemetry.PluginService:        typ,
+					telemetry.PluginServiceVersion: version,
+					telemetry.RequiredVersion:      service.ServiceVersion(),
+				}).Warn("Unsupported service version")
+				continue
+			}
+			serviceImpls = append(serviceImpls, service.NewServiceClient(cc))
 			serviceNames = append(serviceNames, typ)
 		}
 		sort.Strings(serviceNames)
 	}
 
-	pluginImpl := config.Plugin.NewPluginClient(c)
+	pluginImpl := config.Plugin.NewPluginClient(cc)
 
 	return &LoadedPlugin{
 		name:         config.Name,
@@ -78,26 +104,32 @@ func newCatalogPlugin(ctx context.Context, c *grpc.ClientConn, config catalogPlu
 func initPluginServer(s *grpc.Server, dialer hostDialer, logger hclog.Logger, plugin PluginServer, services []ServiceServer) {
 	var impls []interface{}
 	var pluginServices []string
+	pluginServiceVersions := make(map[string]uint32)
 	impls = append(impls, plugin.RegisterPluginServer(s))
 	for _, service := range services {
 		impls = append(impls, service.RegisterServiceServer(s))
 		pluginServices = append(pluginServices, service.ServiceType())
+		pluginServiceVersions[service.ServiceType()] = service.ServiceVersion()
 	}
 	spi.RegisterPluginInitServer(s, &initServer{
-		logger:         logger,
-		dialer:         dialer,
-		impls:          impls,
-		pluginServices: pluginServices,
+		logger:                logger,
+		dialer:                dialer,
+		impls:                 impls,
+		pluginVersion:         plugin.PluginVersion(),
+		pluginServices:        pluginServices,
+		pluginServiceVersions: pluginServiceVersions,
 	})
 }
 
 type initServer struct {
 	spi.UnsafePluginInitServer
 
-	logger         hclog.Logger
-	dialer         hostDialer
-	impls          []interface{}
-	pluginServices []string
+	logger                hclog.Logger
+	dialer                hostDialer
+	impls                 []interface{}
+	pluginVersion         uint32
+	pluginServices        []string
+	pluginServiceVersions map[string]uint32
 }
 
 func (p *initServer) Init(ctx context.Context, req *spi.InitRequest) (resp *spi.InitResponse, err error) {
@@ -134,7 +166,9 @@ func (p *initServer) Init(ctx context.Context, req *spi.InitRequest) (resp *spi.
 	}
 
 	return &spi.InitResponse{
-		PluginServices: p.pluginServices,
+		PluginServices:        p.pluginServices,
+		PluginVersion:         p.pluginVersion,
+		PluginServiceVersions: p.pluginServiceVersions,
 	}, nil
 }
 
@@ -11,6 +11,10 @@ type PluginServer interface {
 	// PluginType returns the plugin type
 	PluginType() string
 
+	// PluginVersion returns the version of the plugin definition implemented
+	// by the server.
+	PluginVersion() uint32
+
 	// PluginClient returns the PluginClient interface for this server.
 	PluginClient() PluginClient
 
@@ -24,6 +28,10 @@ type PluginServer interface {
 type PluginClient interface {
 	PluginType() string
 
+	// PluginVersion returns the version of the plugin definition required
+	// by the client.
+	PluginVersion() uint32
+
 	// NewPluginClient initializes and returns a service client.
 	NewPluginClient(grpc.ClientConnInterface) interface{}
 }
@@ -34,6 +42,10 @@ type ServiceServer interface {
 	// ServiceType returns the service type
 	ServiceType() string
 
+	// ServiceVersion returns the version of the service definition
+	// implemented by the server.
+	ServiceVersion() uint32
+
 	// ServiceClient returns the PluginClient interface for this server.
 	ServiceClient() ServiceClient
 
@@ -48,6 +60,10 @@ type ServiceClient interface {
 	// ServiceType returns the service type
 	ServiceType() string
 
+	// ServiceVersion returns the version of the service definition required
+	// by the client.
+	ServiceVersion() uint32
+
 	// NewServiceClient initializes and returns a service client.
 	NewServiceClient(grpc.ClientConnInterface) interface{}
 }
@@ -23,6 +23,10 @@ type Env struct {
 	Stdout  io.Writer
 	Stderr  io.Writer
 	BaseDir string
+
+	// OutputFormat is the format commands print their results in. See
+	// OutputFlag.
+	OutputFormat string
 }
 
 func (e *Env) Printf(format string, args ...interface{}) error {
@@ -0,0 +1,83 @@
+package cli
+
+import (
+	"bytes"
+	"encoding/json"
+	"flag"
+	"fmt"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+const (
+	// OutputPretty is the human-oriented output format. It is the default.
+	OutputPretty = "pretty"
+
+	// OutputJSON is the machine-readable output format. Commands print their
+	// result as a single JSON document.
+	OutputJSON = "json"
+)
+
+// OutputFlag facilitates parsing the -output flag shared by the commands
+type OutputFlag string
+
+func (f *OutputFlag) String() string {
+	return string(*f)
+}
+
+func (f *OutputFlag) Set(v string) error {
+	switch v {
+	case OutputPretty, OutputJSON:
+		*f = OutputFlag(v)
+		return nil
+	default:
+		return fmt.Errorf("unsupported output format %q; must be %q or %q", v, OutputPretty, OutputJSON)
+	}
+}
+
+// AddOutputFlag adds the -output flag to the flag set
+func AddOutputFlag(fs *flag.FlagSet, f *OutputFlag) {
+	*f = OutputPretty
+	fs.Var(f, "output", fmt.Sprintf("Desired `format` of the output (%s, %s)", OutputPretty, OutputJSON))
+}
+
+// WithOutput returns a copy of the environment that prints results in the
+// format of the given flag.
+func (e *Env) WithOutput(f OutputFlag) *Env {
+	env := *e
+	env.OutputFormat = f.String()
+	return &env
+}
+
+// JSONOutput returns true if the results must be printed as JSON
+func (e *Env) JSONOutput() bool {
+	return e.OutputFormat == OutputJSON
+}
+
+// PrintJSON prints the value as a single line JSON document. Protobuf
+// messages are marshaled with their protobuf field names and with every
+// field populated, so that the schema of the output does not depend on the
+// values.
+func (e *Env) PrintJSON(v interface{}) error {
+	var data []byte
+	var err error
+	if msg, ok := v.(proto.Message); ok {
+		data, err = protojson.MarshalOptions{
+			UseProtoNames:   true,
+			EmitUnpopulated: true,
+		}.Marshal(msg)
+	} else {
+		data, err = json.Marshal(v)
+	}
+	if err != nil {
+		return fmt.Errorf("unable to marshal output: %w", err)
+	}
+
+	// protojson randomizes the whitespace in its output
+	out := new(bytes.Buffer)
+	if err := json.Compact(out, data); err != nil {
+		return fmt.Errorf("unable to marshal output: %w", err)
+	}
+	return e.Println(out.String())
+}
@@ -0,0 +1,70 @@
+package cli
+
+import (
+	"bytes"
+	"flag"
+	"io/ioutil"
+	"testing"
+
+	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestOutputFlag(t *testing.T) {
+	var output OutputFlag
+	fs := flag.NewFlagSet("test", flag.ContinueOnError)
+	fs.SetOutput(ioutil.Discard)
+	AddOutputFlag(fs, &output)
+
+	// Pretty is the default
+	require.NoError(t, fs.Parse(nil))
+	assert.Equal(t, OutputFlag(OutputPretty), output)
+
+	require.NoError(t, fs.Parse([]string{"-output", "json"}))
+	assert.Equal(t, OutputFlag(OutputJSON), output)
+
+	err := fs.Parse([]string{"-output", "yaml"})
+	assert.EqualError(t, err, `invalid value "yaml" for flag -output: unsupported output format "yaml"; must be "pretty" or "json"`)
+}
+
+func TestWithOutput(t *testing.T) {
+	env := &Env{}
+	jsonEnv := env.WithOutput(OutputJSON)
+	assert.True(t, jsonEnv.JSONOutput())
+	assert.False(t, env.JSONOutput(), "the original environment should not be modified")
+	assert.False(t, env.WithOutput(OutputPretty).JSONOutput())
+}
+
+func TestPrintJSON(t *testing.T) {
+	for _, tt := range []struct {
+		name      string
+		value     interface{}
+		expectOut string
+	}{
+		{
+			name: "protobuf message",
+			value: &types.SPIFFEID{
+				TrustDomain: "example.org",
+			},
+			// Fields are named as in the protobuf definition and unset
+			// fields are included
+			expectOut: `{"trust_domain":"example.org","path":""}` + "\n",
+		},
+		{
+			name: "struct",
+			value: struct {
+				Count int `json:"count"`
+			}{Count: 3},
+			expectOut: `{"count":3}` + "\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			stdout := new(bytes.Buffer)
+			env := &Env{Stdout: stdout}
+			require.NoError(t, env.PrintJSON(tt.value))
+			assert.Equal(t, tt.expectOut, stdout.String())
+		})
+	}
+}
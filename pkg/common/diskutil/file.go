@@ -10,11 +10,26 @@ import (
 // a partially written file at the final location.  Finally, fsync is called on the directory
 // to ensure the rename is persisted.
 func AtomicWriteFile(path string, data []byte, mode os.FileMode) error {
+	return AtomicWriteFileWithOwner(path, data, mode, -1, -1)
+}
+
+// AtomicWriteFileWithOwner is like AtomicWriteFile, but also changes the owner
+// and group of the file before it is swapped in, so the file is never visible
+// at the final location with the wrong ownership. A uid or gid of -1 leaves
+// that value unchanged.
+func AtomicWriteFileWithOwner(path string, data []byte, mode os.FileMode, uid, gid int) error {
 	tmpPath := path + ".tmp"
 	if err := write(tmpPath, data, mode); err != nil {
 		return err
 	}
 
+	if uid != -1 || gid != -1 {
+		if err := os.Chown(tmpPath, uid, gid); err != nil {
+			os.Remove(tmpPath)
+			return err
+		}
+	}
+
 	if err := os.Rename(tmpPath, path); err != nil {
 		return err
 	}
@@ -53,3 +53,27 @@ func TestAtomicWriteFile(t *testing.T) {
 		})
 	}
 }
+
+func TestAtomicWriteFileWithOwner(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	file := filepath.Join(dir, "file")
+
+	// Setting the ownership to the current user and group is always allowed
+	require.NoError(t, AtomicWriteFileWithOwner(file, []byte("data"), 0640, os.Getuid(), os.Getgid()))
+
+	info, err := os.Stat(file)
+	require.NoError(t, err)
+	require.EqualValues(t, 0640, info.Mode())
+
+	content, err := ioutil.ReadFile(file)
+	require.NoError(t, err)
+	require.Equal(t, []byte("data"), content)
+
+	// The temporary file is removed when the ownership cannot be changed
+	if os.Getuid() != 0 {
+		err = AtomicWriteFileWithOwner(filepath.Join(dir, "other"), []byte("data"), 0640, os.Getuid()+1, -1)
+		require.Error(t, err)
+		_, err = os.Stat(filepath.Join(dir, "other.tmp"))
+		require.True(t, os.IsNotExist(err))
+	}
+}
@@ -0,0 +1,107 @@
+package diskutil
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/user"
+	"strconv"
+)
+
+// SocketPermissions describes the file mode and ownership applied to a
+// Unix domain socket after it has been created.
+type SocketPermissions struct {
+	// Mode is the file mode of the socket
+	Mode os.FileMode
+
+	// UID is the user that owns the socket. If -1, the owner is not changed.
+	UID int
+
+	// GID is the group that owns the socket. If -1, the group is not changed.
+	GID int
+}
+
+// NewSocketPermissions parses the mode, owner and group of a socket as they
+// appear in the configuration. The mode is an octal string (e.g. "0770") and
+// defaults to defaultMode if empty. The owner and group may be either names
+// or numeric IDs, and are left unchanged if empty.
+func NewSocketPermissions(mode, owner, group string, defaultMode os.FileMode) (*SocketPermissions, error) {
+	perms := &SocketPermissions{
+		Mode: defaultMode,
+		UID:  -1,
+		GID:  -1,
+	}
+
+	if mode != "" {
+		m, err := strconv.ParseUint(mode, 8, 32)
+		if err != nil {
+			return nil, fmt.Errorf("invalid socket mode %q: must be an octal number", mode)
+		}
+		if m > 0777 {
+			return nil, fmt.Errorf("invalid socket mode %q: only permission bits may be set", mode)
+		}
+		perms.Mode = os.FileMode(m)
+	}
+
+	if owner != "" {
+		uid, err := lookupID(owner, func(name string) (string, error) {
+			u, err := user.Lookup(name)
+			if err != nil {
+				return "", err
+			}
+			return u.Uid, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid socket owner %q: %w", owner, err)
+		}
+		perms.UID = uid
+	}
+
+	if group != "" {
+		gid, err := lookupID(group, func(name string) (string, error) {
+			g, err := user.LookupGroup(name)
+			if err != nil {
+				return "", err
+			}
+			return g.Gid, nil
+		})
+		if err != nil {
+			return nil, fmt.Errorf("invalid socket group %q: %w", group, err)
+		}
+		perms.GID = gid
+	}
+
+	return perms, nil
+}
+
+// Apply sets the file mode and ownership of the socket at the given path.
+func (p *SocketPermissions) Apply(path string) error {
+	if p.UID != -1 || p.GID != -1 {
+		if err := os.Chown(path, p.UID, p.GID); err != nil {
+			return fmt.Errorf("unable to change socket ownership: %w", err)
+		}
+	}
+	if err := os.Chmod(path, p.Mode); err != nil {
+		return fmt.Errorf("unable to change socket permissions: %w", err)
+	}
+	return nil
+}
+
+func lookupID(nameOrID string, lookup func(string) (string, error)) (int, error) {
+	if id, err := strconv.Atoi(nameOrID); err == nil {
+		if id < 0 {
+			return 0, errors.New("ID cannot be negative")
+		}
+		return id, nil
+	}
+
+	idStr, err := lookup(nameOrID)
+	if err != nil {
+		return 0, err
+	}
+	id, err := strconv.Atoi(idStr)
+	if err != nil {
+		return 0, fmt.Errorf("non-numeric ID %q", idStr)
+	}
+	return id, nil
+}
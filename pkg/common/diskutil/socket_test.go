@@ -0,0 +1,120 @@
+package diskutil
+
+import (
+	"net"
+	"os"
+	"os/user"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewSocketPermissions(t *testing.T) {
+	current, err := user.Current()
+	require.NoError(t, err)
+	uid, err := strconv.Atoi(current.Uid)
+	require.NoError(t, err)
+	gid, err := strconv.Atoi(current.Gid)
+	require.NoError(t, err)
+	group, err := user.LookupGroupId(current.Gid)
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name        string
+		mode        string
+		owner       string
+		group       string
+		expect      *SocketPermissions
+		expectError string
+	}{
+		{
+			name:   "defaults",
+			expect: &SocketPermissions{Mode: 0770, UID: -1, GID: -1},
+		},
+		{
+			name:   "mode",
+			mode:   "0660",
+			expect: &SocketPermissions{Mode: 0660, UID: -1, GID: -1},
+		},
+		{
+			name:   "numeric owner and group",
+			owner:  current.Uid,
+			group:  current.Gid,
+			expect: &SocketPermissions{Mode: 0770, UID: uid, GID: gid},
+		},
+		{
+			name:   "owner and group names",
+			owner:  current.Username,
+			group:  group.Name,
+			expect: &SocketPermissions{Mode: 0770, UID: uid, GID: gid},
+		},
+		{
+			name:        "mode is not octal",
+			mode:        "rwx",
+			expectError: `invalid socket mode "rwx": must be an octal number`,
+		},
+		{
+			name:        "mode has non-permission bits",
+			mode:        "4770",
+			expectError: `invalid socket mode "4770": only permission bits may be set`,
+		},
+		{
+			name:        "negative owner",
+			owner:       "-2",
+			expectError: `invalid socket owner "-2": ID cannot be negative`,
+		},
+		{
+			name:        "unknown owner",
+			owner:       "no-such-user-for-spire",
+			expectError: `invalid socket owner "no-such-user-for-spire": `,
+		},
+		{
+			name:        "unknown group",
+			group:       "no-such-group-for-spire",
+			expectError: `invalid socket group "no-such-group-for-spire": `,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			perms, err := NewSocketPermissions(tt.mode, tt.owner, tt.group, 0770)
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				assert.Nil(t, perms)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, perms)
+		})
+	}
+}
+
+func TestSocketPermissionsApply(t *testing.T) {
+	path := filepath.Join(spiretest.TempDir(t), "test.sock")
+	l, err := net.ListenUnix("unix", &net.UnixAddr{Name: path, Net: "unix"})
+	require.NoError(t, err)
+	defer l.Close()
+
+	// Setting the ownership to the current user and group is always allowed
+	perms := &SocketPermissions{Mode: 0740, UID: os.Getuid(), GID: os.Getgid()}
+	require.NoError(t, perms.Apply(path))
+
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0740), info.Mode().Perm())
+
+	perms = &SocketPermissions{Mode: 0700, UID: -1, GID: -1}
+	require.NoError(t, perms.Apply(path))
+
+	info, err = os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0700), info.Mode().Perm())
+
+	err = perms.Apply(filepath.Join(filepath.Dir(path), "missing.sock"))
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unable to change socket permissions: ")
+}
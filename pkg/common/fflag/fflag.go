@@ -0,0 +1,115 @@
+// Package fflag provides the feature flags that gate experimental behavior.
+// Flags are enabled in the `experimental` section of the configuration, with
+// the `feature_flags` setting, and are loaded once when the process starts.
+//
+// To gate a new behavior, add a Flag constant, register it in the flags map
+// below and check it with IsSet. Flags are removed once the behavior they
+// gate is stable, or abandoned.
+package fflag
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Flag is the name of a feature flag, as it appears in the configuration
+type Flag string
+
+const (
+	// FlagTestFlag is defined purely for testing purposes. It gates nothing.
+	FlagTestFlag Flag = "i_am_a_test_flag"
+
+	// FlagSPIFFEIDTemplates enables registration entries with a SPIFFE ID
+	// template. The server cannot verify the workload selectors the agent
+	// expands them with, so agents are trusted with the expansion.
+	FlagSPIFFEIDTemplates Flag = "spiffe_id_templates"
+)
+
+// RawConfig is the list of feature flag names enabled in the configuration
+type RawConfig []string
+
+var singleton = struct {
+	mtx    sync.RWMutex
+	loaded bool
+	flags  map[Flag]bool
+}{
+	flags: map[Flag]bool{
+		FlagTestFlag:          false,
+		FlagSPIFFEIDTemplates: false,
+	},
+}
+
+// Validate returns an error if the configuration enables unknown flags.
+func Validate(rc RawConfig) error {
+	singleton.mtx.RLock()
+	defer singleton.mtx.RUnlock()
+
+	var unknown []string
+	for _, name := range rc {
+		if _, ok := singleton.flags[Flag(name)]; !ok {
+			unknown = append(unknown, fmt.Sprintf("%q", name))
+		}
+	}
+	if len(unknown) > 0 {
+		return fmt.Errorf("unknown feature flags: %s", strings.Join(unknown, ", "))
+	}
+	return nil
+}
+
+// Load enables the flags set in the configuration. It can only be called
+// once; flags cannot be changed while the process is running.
+func Load(rc RawConfig) error {
+	if err := Validate(rc); err != nil {
+		return err
+	}
+
+	singleton.mtx.Lock()
+	defer singleton.mtx.Unlock()
+
+	if singleton.loaded {
+		return errors.New("feature flags have already been loaded")
+	}
+	for _, name := range rc {
+		singleton.flags[Flag(name)] = true
+	}
+	singleton.loaded = true
+	return nil
+}
+
+// Unload disables all flags so they can be loaded again. It is intended for
+// tests.
+func Unload() {
+	singleton.mtx.Lock()
+	defer singleton.mtx.Unlock()
+
+	for flag := range singleton.flags {
+		singleton.flags[flag] = false
+	}
+	singleton.loaded = false
+}
+
+// IsSet returns true if the flag is enabled.
+func IsSet(flag Flag) bool {
+	singleton.mtx.RLock()
+	defer singleton.mtx.RUnlock()
+
+	return singleton.flags[flag]
+}
+
+// Enabled returns the names of the enabled flags, sorted.
+func Enabled() []string {
+	singleton.mtx.RLock()
+	defer singleton.mtx.RUnlock()
+
+	var names []string
+	for flag, set := range singleton.flags {
+		if set {
+			names = append(names, string(flag))
+		}
+	}
+	sort.Strings(names)
+	return names
+}
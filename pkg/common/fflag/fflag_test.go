@@ -0,0 +1,38 @@
+package fflag
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidate(t *testing.T) {
+	assert.NoError(t, Validate(nil))
+	assert.NoError(t, Validate(RawConfig{"i_am_a_test_flag"}))
+	assert.EqualError(t, Validate(RawConfig{"i_am_a_test_flag", "foo", "bar"}), `unknown feature flags: "foo", "bar"`)
+}
+
+func TestLoad(t *testing.T) {
+	defer Unload()
+
+	assert.False(t, IsSet(FlagTestFlag))
+	assert.Empty(t, Enabled())
+
+	// Unknown flags are rejected without enabling any flag
+	assert.EqualError(t, Load(RawConfig{"i_am_a_test_flag", "foo"}), `unknown feature flags: "foo"`)
+	assert.False(t, IsSet(FlagTestFlag))
+
+	require.NoError(t, Load(RawConfig{"i_am_a_test_flag"}))
+	assert.True(t, IsSet(FlagTestFlag))
+	assert.Equal(t, []string{"i_am_a_test_flag"}, Enabled())
+
+	assert.EqualError(t, Load(nil), "feature flags have already been loaded")
+	assert.True(t, IsSet(FlagTestFlag))
+
+	Unload()
+	assert.False(t, IsSet(FlagTestFlag))
+	assert.Empty(t, Enabled())
+	require.NoError(t, Load(nil))
+	assert.False(t, IsSet(FlagTestFlag))
+}
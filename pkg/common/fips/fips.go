@@ -0,0 +1,108 @@
+// Package fips provides the checks that keep SPIRE within the algorithms
+// permitted by FIPS 140 when the Go cryptographic libraries run in FIPS 140
+// mode.
+//
+// FIPS 140 mode is a property of the binary and of the runtime, not of the
+// SPIRE configuration. With Go 1.24 and later it is enabled by building with
+// GOFIPS140 set to a validated module version or by running with
+// GODEBUG=fips140=on. Binaries built with earlier toolchains never run in
+// FIPS 140 mode.
+package fips
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+)
+
+const (
+	// minRSAKeySize is the smallest RSA modulus, in bits, permitted for
+	// signatures.
+	minRSAKeySize = 2048
+)
+
+var (
+	// approvedCipherSuites are the TLS 1.2 cipher suites permitted by
+	// FIPS 140. TLS 1.3 suites are not configurable and are always
+	// permitted.
+	approvedCipherSuites = map[uint16]bool{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256: true,
+		tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384: true,
+		tls.TLS_ECDHE_RSA_WITH_AES_128_GCM_SHA256:   true,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384:   true,
+	}
+
+	approvedSignatureAlgorithms = map[x509.SignatureAlgorithm]bool{
+		x509.SHA256WithRSA:    true,
+		x509.SHA384WithRSA:    true,
+		x509.SHA512WithRSA:    true,
+		x509.SHA256WithRSAPSS: true,
+		x509.SHA384WithRSAPSS: true,
+		x509.SHA512WithRSAPSS: true,
+		x509.ECDSAWithSHA256:  true,
+		x509.ECDSAWithSHA384:  true,
+		x509.ECDSAWithSHA512:  true,
+	}
+)
+
+// Enabled returns true if the Go cryptographic libraries are running in FIPS
+// 140 mode.
+func Enabled() bool {
+	return enabled()
+}
+
+// Require returns an error if FIPS 140 mode is required but not enabled.
+func Require(required bool) error {
+	if required && !Enabled() {
+		return errors.New("FIPS 140 mode is required but not enabled; build with GOFIPS140 or run with GODEBUG=fips140=on using Go 1.24 or later")
+	}
+	return nil
+}
+
+// CheckCipherSuites returns an error if any of the cipher suites is not
+// permitted by FIPS 140.
+func CheckCipherSuites(cipherSuites []uint16) error {
+	for _, id := range cipherSuites {
+		if !approvedCipherSuites[id] {
+			return fmt.Errorf("cipher suite %s is not permitted in FIPS 140 mode", tls.CipherSuiteName(id))
+		}
+	}
+	return nil
+}
+
+// CheckCertificate returns an error if the certificate is signed with, or
+// holds a public key for, an algorithm that is not permitted by FIPS 140.
+func CheckCertificate(cert *x509.Certificate) error {
+	if !approvedSignatureAlgorithms[cert.SignatureAlgorithm] {
+		return fmt.Errorf("certificate %q signature algorithm %s is not permitted in FIPS 140 mode", cert.Subject, cert.SignatureAlgorithm)
+	}
+	switch publicKey := cert.PublicKey.(type) {
+	case *rsa.PublicKey:
+		if size := publicKey.N.BitLen(); size < minRSAKeySize {
+			return fmt.Errorf("certificate %q RSA key size %d is not permitted in FIPS 140 mode; must be at least %d", cert.Subject, size, minRSAKeySize)
+		}
+	case *ecdsa.PublicKey:
+		switch publicKey.Curve {
+		case elliptic.P256(), elliptic.P384(), elliptic.P521():
+		default:
+			return fmt.Errorf("certificate %q curve %s is not permitted in FIPS 140 mode", cert.Subject, publicKey.Curve.Params().Name)
+		}
+	default:
+		return fmt.Errorf("certificate %q public key type %T is not permitted in FIPS 140 mode", cert.Subject, cert.PublicKey)
+	}
+	return nil
+}
+
+// CheckCertificates calls CheckCertificate on each certificate in the chain.
+func CheckCertificates(chain []*x509.Certificate) error {
+	for _, cert := range chain {
+		if err := CheckCertificate(cert); err != nil {
+			return err
+		}
+	}
+	return nil
+}
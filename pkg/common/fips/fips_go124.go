@@ -0,0 +1,9 @@
+// +build go1.24
+
+package fips
+
+import "crypto/fips140"
+
+func enabled() bool {
+	return fips140.Enabled()
+}
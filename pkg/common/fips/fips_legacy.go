@@ -0,0 +1,8 @@
+// +build !go1.24
+
+package fips
+
+// FIPS 140 mode is only available with Go 1.24 and later.
+func enabled() bool {
+	return false
+}
@@ -0,0 +1,96 @@
+package fips
+
+import (
+	"crypto/ed25519"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"testing"
+
+	"github.com/spiffe/spire/test/testkey"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequire(t *testing.T) {
+	assert.NoError(t, Require(false))
+	if Enabled() {
+		assert.NoError(t, Require(true))
+	} else {
+		assert.EqualError(t, Require(true), "FIPS 140 mode is required but not enabled; build with GOFIPS140 or run with GODEBUG=fips140=on using Go 1.24 or later")
+	}
+}
+
+func TestCheckCipherSuites(t *testing.T) {
+	assert.NoError(t, CheckCipherSuites(nil))
+	assert.NoError(t, CheckCipherSuites([]uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_RSA_WITH_AES_256_GCM_SHA384,
+	}))
+	assert.EqualError(t, CheckCipherSuites([]uint16{
+		tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256,
+		tls.TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256,
+	}), "cipher suite TLS_ECDHE_ECDSA_WITH_CHACHA20_POLY1305_SHA256 is not permitted in FIPS 140 mode")
+}
+
+func TestCheckCertificate(t *testing.T) {
+	ed25519Key, _, err := ed25519.GenerateKey(nil)
+	assert.NoError(t, err)
+
+	for _, tt := range []struct {
+		name      string
+		cert      *x509.Certificate
+		expectErr string
+	}{
+		{
+			name: "EC P-256",
+			cert: &x509.Certificate{
+				SignatureAlgorithm: x509.ECDSAWithSHA256,
+				PublicKey:          testkey.NewEC256(t).Public(),
+			},
+		},
+		{
+			name: "RSA 2048",
+			cert: &x509.Certificate{
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKey:          testkey.NewRSA2048(t).Public(),
+			},
+		},
+		{
+			name: "SHA1 signature",
+			cert: &x509.Certificate{
+				Subject:            pkix.Name{CommonName: "CA"},
+				SignatureAlgorithm: x509.SHA1WithRSA,
+				PublicKey:          testkey.NewRSA2048(t).Public(),
+			},
+			expectErr: `certificate "CN=CA" signature algorithm SHA1-RSA is not permitted in FIPS 140 mode`,
+		},
+		{
+			name: "RSA 1024",
+			cert: &x509.Certificate{
+				Subject:            pkix.Name{CommonName: "CA"},
+				SignatureAlgorithm: x509.SHA256WithRSA,
+				PublicKey:          testkey.NewRSA1024(t).Public(),
+			},
+			expectErr: `certificate "CN=CA" RSA key size 1024 is not permitted in FIPS 140 mode; must be at least 2048`,
+		},
+		{
+			name: "Ed25519",
+			cert: &x509.Certificate{
+				Subject:            pkix.Name{CommonName: "CA"},
+				SignatureAlgorithm: x509.ECDSAWithSHA256,
+				PublicKey:          ed25519Key,
+			},
+			expectErr: `certificate "CN=CA" public key type ed25519.PublicKey is not permitted in FIPS 140 mode`,
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			err := CheckCertificates([]*x509.Certificate{tt.cert})
+			if tt.expectErr != "" {
+				assert.EqualError(t, err, tt.expectErr)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
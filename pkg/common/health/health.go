@@ -46,7 +46,7 @@ func NewChecker(config Config, log logrus.FieldLogger) *Checker {
 		}
 	}
 
-	hc.StatusListener = &statusListener{}
+	hc.StatusListener = &statusListener{log: log}
 	hc.Logger = &logadapter{FieldLogger: log.WithField(telemetry.SubsystemName, "health")}
 
 	return &Checker{config: config, server: server, hc: hc, log: log}
@@ -64,6 +64,12 @@ func (c *Checker) AddCheck(name string, checker health.ICheckable, interval time
 	})
 }
 
+// Failed returns true if any of the health checks is currently failing. It
+// is safe to call while the checker is serving.
+func (c *Checker) Failed() bool {
+	return c.hc.Failed()
+}
+
 func (c *Checker) ListenAndServe(ctx context.Context) error {
 	c.mutex.Lock()
 	defer c.mutex.Unlock()
@@ -1,10 +1,13 @@
 package health
 
 import (
+	"errors"
 	"testing"
+	"time"
 
 	logtest "github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestServerDisabledByDefault(t *testing.T) {
@@ -20,3 +23,23 @@ func TestServerEnabled(t *testing.T) {
 
 	assert.NotNil(t, checker.server)
 }
+
+func TestFailed(t *testing.T) {
+	log, _ := logtest.NewNullLogger()
+	checker := NewChecker(Config{}, log)
+	assert.False(t, checker.Failed())
+
+	require.NoError(t, checker.AddCheck("failing", failingCheck{}, time.Minute))
+	require.NoError(t, checker.hc.Start())
+	defer func() {
+		assert.NoError(t, checker.hc.Stop())
+	}()
+
+	require.Eventually(t, checker.Failed, time.Second, 10*time.Millisecond)
+}
+
+type failingCheck struct{}
+
+func (failingCheck) Status() (interface{}, error) {
+	return nil, errors.New("oh no")
+}
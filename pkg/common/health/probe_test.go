@@ -0,0 +1,30 @@
+package health
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestProbe(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		switch req.URL.Path {
+		case "/live":
+			live(w, req)
+		default:
+			w.WriteHeader(http.StatusInternalServerError)
+		}
+	}))
+	defer server.Close()
+
+	assert.NoError(t, Probe(context.Background(), server.URL+"/live"))
+	assert.EqualError(t, Probe(context.Background(), server.URL+"/ready"), `health endpoint returned "500 Internal Server Error"`)
+	assert.EqualError(t, Probe(context.Background(), "\n"), `invalid health endpoint URL: parse "\n": net/url: invalid control character in URL`)
+
+	server.Close()
+	err := Probe(context.Background(), server.URL+"/live")
+	assert.Contains(t, err.Error(), "unable to query health endpoint: ")
+}
@@ -0,0 +1,218 @@
+package idutil
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/proto/spire/common"
+)
+
+// SPIFFE ID templates have variables in their path, in the form
+// "{<selector type>:<selector key>}", that are replaced with the values of
+// the selectors of each workload. For example, the template
+// "spiffe://example.org/ns/{k8s:ns}/sa/{k8s:sa}" expands to
+// "spiffe://example.org/ns/default/sa/foo" for a workload with the
+// "k8s:ns:default" and "k8s:sa:foo" selectors.
+
+// derivedEntryIDSeparator separates the template entry ID from the expanded
+// SPIFFE ID in the IDs of entries derived from a template. Entry IDs never
+// contain it.
+const derivedEntryIDSeparator = "#"
+
+var templateVariable = regexp.MustCompile(`{([^{}/:]+):([^{}/]+)}`)
+
+// IsTemplate returns true if the path of the SPIFFE ID has template
+// variables.
+func IsTemplate(spiffeID string) bool {
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return false
+	}
+	return strings.ContainsAny(id.Path(), "{}")
+}
+
+// ValidateTemplate returns an error if the template variables in the path of
+// the SPIFFE ID are malformed.
+func ValidateTemplate(spiffeID string) error {
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return err
+	}
+	rest := templateVariable.ReplaceAllString(id.Path(), "")
+	if strings.ContainsAny(rest, "{}") {
+		return fmt.Errorf("malformed SPIFFE ID template %q: variables must be in the form {<selector type>:<selector key>}", id.Path())
+	}
+	return nil
+}
+
+// ExpandTemplate replaces the template variables in the path of the SPIFFE
+// ID with the values of the selectors. The variable "{type:key}" is replaced
+// with "value" from the "key:value" selector of that type. It fails if there
+// is no such selector, or there are several with different values. The
+// selectors whose values were used are returned along with the SPIFFE ID.
+func ExpandTemplate(spiffeID string, selectors []*common.Selector) (string, []*common.Selector, error) {
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return "", nil, err
+	}
+
+	var used []*common.Selector
+	var expandErr error
+	path := templateVariable.ReplaceAllStringFunc(id.Path(), func(variable string) string {
+		m := templateVariable.FindStringSubmatch(variable)
+		selector, err := templateSelector(m[1], m[2], selectors)
+		if err != nil {
+			if expandErr == nil {
+				expandErr = err
+			}
+			return ""
+		}
+		used = append(used, selector)
+		return strings.TrimPrefix(selector.Value, m[2]+":")
+	})
+	if expandErr != nil {
+		return "", nil, expandErr
+	}
+
+	expanded, err := spiffeid.New(id.TrustDomain().String(), path)
+	if err != nil {
+		return "", nil, err
+	}
+	return expanded.String(), used, nil
+}
+
+// MatchesTemplate returns true if the SPIFFE ID is an expansion of the
+// template.
+func MatchesTemplate(template string, spiffeID string) bool {
+	_, ok := templateValues(template, spiffeID)
+	return ok
+}
+
+// ValidateTemplateExpansion returns an error if the SPIFFE ID is not an
+// expansion of the template for a workload matching the entry selectors.
+// Such a workload has every entry selector, so a variable whose selector key
+// is set by the entry selectors can only expand to the value they set. Any
+// other variable can expand to any value.
+func ValidateTemplateExpansion(template string, spiffeID string, entrySelectors []*common.Selector) error {
+	values, ok := templateValues(template, spiffeID)
+	if !ok {
+		return fmt.Errorf("%q does not match the entry SPIFFE ID template %q", spiffeID, template)
+	}
+	for _, v := range values {
+		prefix := v.key + ":"
+		for _, s := range entrySelectors {
+			if s.Type != v.selectorType || !strings.HasPrefix(s.Value, prefix) || s.Value == prefix {
+				continue
+			}
+			if s.Value != prefix+v.value {
+				return fmt.Errorf("%q expands template variable {%s:%s} to %q, which the entry selector \"%s:%s\" does not allow", spiffeID, v.selectorType, v.key, v.value, s.Type, s.Value)
+			}
+		}
+	}
+	return nil
+}
+
+type templateValue struct {
+	selectorType string
+	key          string
+	value        string
+}
+
+// templateValues returns the values that the template variables take in the
+// SPIFFE ID. It returns false if the SPIFFE ID is not an expansion of the
+// template.
+func templateValues(template string, spiffeID string) ([]templateValue, bool) {
+	tmpl, err := spiffeid.FromString(template)
+	if err != nil {
+		return nil, false
+	}
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return nil, false
+	}
+	if tmpl.TrustDomain() != id.TrustDomain() {
+		return nil, false
+	}
+
+	var pattern strings.Builder
+	pattern.WriteString("^")
+	last := 0
+	variables := templateVariable.FindAllStringSubmatchIndex(tmpl.Path(), -1)
+	for _, loc := range variables {
+		pattern.WriteString(regexp.QuoteMeta(tmpl.Path()[last:loc[0]]))
+		pattern.WriteString(`([^/{}]+)`)
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(tmpl.Path()[last:]))
+	pattern.WriteString("$")
+
+	re, err := regexp.Compile(pattern.String())
+	if err != nil {
+		return nil, false
+	}
+	m := re.FindStringSubmatch(id.Path())
+	if m == nil {
+		return nil, false
+	}
+
+	// A variable used more than once expands to the same value everywhere
+	var values []templateValue
+	seen := make(map[string]string)
+	for i, loc := range variables {
+		v := templateValue{
+			selectorType: tmpl.Path()[loc[2]:loc[3]],
+			key:          tmpl.Path()[loc[4]:loc[5]],
+			value:        m[i+1],
+		}
+		name := v.selectorType + ":" + v.key
+		if value, ok := seen[name]; ok {
+			if value != v.value {
+				return nil, false
+			}
+			continue
+		}
+		seen[name] = v.value
+		values = append(values, v)
+	}
+	return values, true
+}
+
+// DerivedEntryID returns the ID of the entry derived from the template entry
+// for the expanded SPIFFE ID.
+func DerivedEntryID(templateEntryID, spiffeID string) string {
+	return templateEntryID + derivedEntryIDSeparator + spiffeID
+}
+
+// TemplateEntryID returns the ID of the template entry the entry was derived
+// from. It returns false if the entry was not derived from a template.
+func TemplateEntryID(entryID string) (string, bool) {
+	i := strings.Index(entryID, derivedEntryIDSeparator)
+	if i < 0 {
+		return "", false
+	}
+	return entryID[:i], true
+}
+
+func templateSelector(selectorType, key string, selectors []*common.Selector) (*common.Selector, error) {
+	prefix := key + ":"
+	var selector *common.Selector
+	for _, s := range selectors {
+		if s.Type != selectorType || !strings.HasPrefix(s.Value, prefix) || s.Value == prefix {
+			continue
+		}
+		if selector != nil && selector.Value != s.Value {
+			return nil, fmt.Errorf("template variable {%s:%s} matches more than one selector value", selectorType, key)
+		}
+		selector = s
+	}
+	switch {
+	case selector == nil:
+		return nil, fmt.Errorf("no selector value for template variable {%s:%s}", selectorType, key)
+	case strings.ContainsAny(strings.TrimPrefix(selector.Value, prefix), "/{}"):
+		return nil, errors.New("selector values used in SPIFFE ID templates cannot contain '/', '{' or '}'")
+	}
+	return selector, nil
+}
@@ -0,0 +1,137 @@
+package idutil
+
+import (
+	"testing"
+
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIsTemplate(t *testing.T) {
+	assert.True(t, IsTemplate("spiffe://test.com/ns/{k8s:ns}"))
+	// SPIFFE IDs are stored escaped
+	assert.True(t, IsTemplate("spiffe://test.com/ns/%7Bk8s:ns%7D"))
+	assert.False(t, IsTemplate("spiffe://test.com/ns/default"))
+	assert.False(t, IsTemplate("not a spiffe id"))
+}
+
+func TestValidateTemplate(t *testing.T) {
+	assert.NoError(t, ValidateTemplate("spiffe://test.com/ns/{k8s:ns}/sa/{k8s:sa}"))
+	assert.NoError(t, ValidateTemplate("spiffe://test.com/app-{k8s:pod-label:app}"))
+	assert.NoError(t, ValidateTemplate("spiffe://test.com/no/variables"))
+	assert.EqualError(t, ValidateTemplate("spiffe://test.com/ns/{k8s}"), `malformed SPIFFE ID template "/ns/{k8s}": variables must be in the form {<selector type>:<selector key>}`)
+	assert.EqualError(t, ValidateTemplate("spiffe://test.com/ns/{k8s:ns"), `malformed SPIFFE ID template "/ns/{k8s:ns": variables must be in the form {<selector type>:<selector key>}`)
+	assert.EqualError(t, ValidateTemplate("spiffe://test.com/{k8s:{k8s:ns}}"), `malformed SPIFFE ID template "/{k8s:{k8s:ns}}": variables must be in the form {<selector type>:<selector key>}`)
+}
+
+func TestExpandTemplate(t *testing.T) {
+	selectors := []*common.Selector{
+		{Type: "k8s", Value: "ns:default"},
+		{Type: "k8s", Value: "sa:foo"},
+		{Type: "k8s", Value: "pod-label:app:bar"},
+		{Type: "k8s", Value: "pod-label:tier:web"},
+		{Type: "unix", Value: "uid:1000"},
+		{Type: "docker", Value: "label:path:a/b"},
+	}
+
+	for _, tt := range []struct {
+		name            string
+		template        string
+		expectID        string
+		expectSelectors []*common.Selector
+		err             string
+	}{
+		{
+			name:            "variables",
+			template:        "spiffe://test.com/ns/{k8s:ns}/sa/{k8s:sa}",
+			expectID:        "spiffe://test.com/ns/default/sa/foo",
+			expectSelectors: []*common.Selector{selectors[0], selectors[1]},
+		},
+		{
+			name:            "escaped variables",
+			template:        "spiffe://test.com/ns/%7Bk8s:ns%7D",
+			expectID:        "spiffe://test.com/ns/default",
+			expectSelectors: []*common.Selector{selectors[0]},
+		},
+		{
+			name:            "variable within a segment",
+			template:        "spiffe://test.com/app-{k8s:pod-label:app}/uid-{unix:uid}",
+			expectID:        "spiffe://test.com/app-bar/uid-1000",
+			expectSelectors: []*common.Selector{selectors[2], selectors[4]},
+		},
+		{
+			name:     "no variables",
+			template: "spiffe://test.com/workload",
+			expectID: "spiffe://test.com/workload",
+		},
+		{
+			name:     "missing selector",
+			template: "spiffe://test.com/{k8s:node-name}",
+			err:      "no selector value for template variable {k8s:node-name}",
+		},
+		{
+			name:     "ambiguous selector",
+			template: "spiffe://test.com/{k8s:pod-label}",
+			err:      "template variable {k8s:pod-label} matches more than one selector value",
+		},
+		{
+			name:     "value with a slash",
+			template: "spiffe://test.com/{docker:label:path}",
+			err:      "selector values used in SPIFFE ID templates cannot contain '/', '{' or '}'",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			id, used, err := ExpandTemplate(tt.template, selectors)
+			if tt.err != "" {
+				require.EqualError(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.expectID, id)
+			require.Equal(t, tt.expectSelectors, used)
+		})
+	}
+}
+
+func TestMatchesTemplate(t *testing.T) {
+	template := "spiffe://test.com/ns/{k8s:ns}/sa/{k8s:sa}"
+	assert.True(t, MatchesTemplate(template, "spiffe://test.com/ns/default/sa/foo"))
+	assert.False(t, MatchesTemplate(template, "spiffe://test.com/ns/default/sa/foo/bar"))
+	assert.False(t, MatchesTemplate(template, "spiffe://test.com/ns/default/sa/"))
+	assert.False(t, MatchesTemplate(template, "spiffe://other.com/ns/default/sa/foo"))
+	assert.False(t, MatchesTemplate(template, template))
+	assert.True(t, MatchesTemplate("spiffe://test.com/app-{k8s:app}.v1", "spiffe://test.com/app-foo.v1"))
+	assert.False(t, MatchesTemplate("spiffe://test.com/app-{k8s:app}.v1", "spiffe://test.com/app-foo-v1"))
+}
+
+func TestValidateTemplateExpansion(t *testing.T) {
+	template := "spiffe://test.com/ns/{k8s:ns}/sa/{k8s:sa}"
+	selectors := []*common.Selector{
+		{Type: "k8s", Value: "ns:default"},
+		{Type: "k8s", Value: "pod-label:app:foo"},
+	}
+
+	assert.NoError(t, ValidateTemplateExpansion(template, "spiffe://test.com/ns/default/sa/foo", selectors))
+	assert.NoError(t, ValidateTemplateExpansion(template, "spiffe://test.com/ns/kube-system/sa/admin", nil))
+	assert.EqualError(t, ValidateTemplateExpansion(template, "spiffe://test.com/ns/kube-system/sa/admin", selectors),
+		`"spiffe://test.com/ns/kube-system/sa/admin" expands template variable {k8s:ns} to "kube-system", which the entry selector "k8s:ns:default" does not allow`)
+	assert.EqualError(t, ValidateTemplateExpansion(template, "spiffe://test.com/ns/default", selectors),
+		`"spiffe://test.com/ns/default" does not match the entry SPIFFE ID template "spiffe://test.com/ns/{k8s:ns}/sa/{k8s:sa}"`)
+
+	// A variable used more than once expands to the same value everywhere
+	repeated := "spiffe://test.com/ns/{k8s:ns}/ns/{k8s:ns}"
+	assert.NoError(t, ValidateTemplateExpansion(repeated, "spiffe://test.com/ns/default/ns/default", nil))
+	assert.Error(t, ValidateTemplateExpansion(repeated, "spiffe://test.com/ns/default/ns/other", nil))
+}
+
+func TestDerivedEntryID(t *testing.T) {
+	entryID := DerivedEntryID("template", "spiffe://test.com/ns/default")
+	templateEntryID, ok := TemplateEntryID(entryID)
+	require.True(t, ok)
+	assert.Equal(t, "template", templateEntryID)
+
+	_, ok = TemplateEntryID("template")
+	assert.False(t, ok)
+}
@@ -1,15 +1,24 @@
 package log
 
 import (
+	"fmt"
 	"io"
 	"os"
+	"strings"
 
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/telemetry"
 )
 
 type Logger struct {
 	*logrus.Logger
 	io.Closer
+
+	subsystemLevels map[string]logrus.Level
+
+	// formatter is the formatter configured for the logger, before it is
+	// wrapped to filter records by subsystem level
+	formatter logrus.Formatter
 }
 
 func NewLogger(options ...Option) (*Logger, error) {
@@ -25,9 +34,125 @@ func NewLogger(options ...Option) (*Logger, error) {
 		}
 	}
 
+	logger.formatter = logger.Formatter
+	logger.applyLevels(logger.Level, logger.subsystemLevels)
+
 	return logger, nil
 }
 
+// SetLevels changes the logging level of the logger and of individual
+// subsystems. Subsystems that are not given use the logging level of the
+// logger.
+func (l *Logger) SetLevels(logLevel string, subsystemLevels map[string]string) error {
+	level, err := logrus.ParseLevel(logLevel)
+	if err != nil {
+		return err
+	}
+	levels, err := parseSubsystemLevels(subsystemLevels)
+	if err != nil {
+		return err
+	}
+	l.applyLevels(level, levels)
+	return nil
+}
+
+func (l *Logger) applyLevels(level logrus.Level, subsystemLevels map[string]logrus.Level) {
+	l.subsystemLevels = subsystemLevels
+	if len(subsystemLevels) == 0 {
+		l.SetFormatter(l.formatter)
+		l.SetLevel(level)
+		return
+	}
+
+	// The logger must let through the records of the most verbose level
+	// configured; the formatter drops the records that are not enabled for
+	// their subsystem.
+	l.SetFormatter(&levelFilterFormatter{
+		Formatter:       l.formatter,
+		level:           level,
+		subsystemLevels: subsystemLevels,
+	})
+	maxLevel := level
+	for _, subsystemLevel := range subsystemLevels {
+		if subsystemLevel > maxLevel {
+			maxLevel = subsystemLevel
+		}
+	}
+	l.SetLevel(maxLevel)
+}
+
+func parseSubsystemLevels(levels map[string]string) (map[string]logrus.Level, error) {
+	subsystemLevels := make(map[string]logrus.Level, len(levels))
+	for subsystem, logLevel := range levels {
+		level, err := logrus.ParseLevel(logLevel)
+		if err != nil {
+			return nil, fmt.Errorf("invalid log level for subsystem %q: %v", subsystem, err)
+		}
+		subsystemLevels[strings.ToLower(subsystem)] = level
+	}
+	return subsystemLevels, nil
+}
+
 type nopCloser struct{}
 
 func (nopCloser) Close() error { return nil }
+
+type closers []io.Closer
+
+func (cs closers) Close() error {
+	var firstErr error
+	for _, c := range cs {
+		if err := c.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+// fieldsHook adds its fields to the log records that do not already set them
+type fieldsHook logrus.Fields
+
+func (fieldsHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h fieldsHook) Fire(entry *logrus.Entry) error {
+	// The data may be shared with the entry the record was created from, so
+	// it is copied instead of being modified in place.
+	data := make(logrus.Fields, len(entry.Data)+len(h))
+	for key, value := range h {
+		data[key] = value
+	}
+	for key, value := range entry.Data {
+		data[key] = value
+	}
+	entry.Data = data
+	return nil
+}
+
+// levelFilterFormatter drops the records below the level configured for the
+// subsystem that emits them. The subsystem of plugin records is the plugin
+// type (e.g. "datastore"); otherwise it is the subsystem name.
+type levelFilterFormatter struct {
+	logrus.Formatter
+	level           logrus.Level
+	subsystemLevels map[string]logrus.Level
+}
+
+func (f *levelFilterFormatter) Format(entry *logrus.Entry) ([]byte, error) {
+	if entry.Level > f.levelOf(entry) {
+		return nil, nil
+	}
+	return f.Formatter.Format(entry)
+}
+
+func (f *levelFilterFormatter) levelOf(entry *logrus.Entry) logrus.Level {
+	for _, key := range []string{telemetry.PluginType, telemetry.SubsystemName} {
+		if subsystem, ok := entry.Data[key].(string); ok {
+			if level, ok := f.subsystemLevels[strings.ToLower(subsystem)]; ok {
+				return level
+			}
+		}
+	}
+	return f.level
+}
@@ -1,12 +1,16 @@
 package log
 
 import (
+	"bytes"
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
+	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -67,3 +71,138 @@ func TestOutputFile(t *testing.T) {
 		}
 	}
 }
+
+// Make sure logs are written to every output
+func TestOutputs(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testoutputs")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	first := filepath.Join(dir, "first.log")
+	second := filepath.Join(dir, "second.log")
+
+	logger, err := NewLogger(WithOutputs([]string{first, second}), WithFormat(JSONFormat))
+	require.NoError(t, err)
+
+	logger.Warning("This should get written")
+	require.NoError(t, logger.Close())
+
+	for _, file := range []string{first, second} {
+		log, err := ioutil.ReadFile(file)
+		require.NoError(t, err)
+		assert.Contains(t, string(log), `"msg":"This should get written"`)
+	}
+
+	_, err = NewLogger(WithOutputs([]string{filepath.Join(dir, "missing", "file.log")}))
+	require.Error(t, err)
+}
+
+func TestRotatingOutputFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "testrotatingoutputfile")
+	require.NoError(t, err)
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "spire.log")
+	logger, err := NewLogger(WithRotatingOutputFile(file, RotationConfig{MaxSize: 1}), WithFormat(JSONFormat))
+	require.NoError(t, err)
+
+	// Writing more than the maximum size rotates the file
+	msg := strings.Repeat("a", 1024)
+	for i := 0; i < 1100; i++ {
+		logger.Warning(msg)
+	}
+	require.NoError(t, logger.Close())
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	assert.Len(t, files, 2)
+
+	_, err = NewLogger(WithRotatingOutputFile("", RotationConfig{}))
+	assert.EqualError(t, err, "log file is required to rotate logs")
+}
+
+func TestFields(t *testing.T) {
+	testHook := test.Hook{}
+
+	logger, err := NewLogger(WithFields(logrus.Fields{"trust_domain": "example.org"}))
+	require.NoError(t, err)
+	logger.AddHook(&testHook)
+
+	logger.Info("Hello")
+	assert.Equal(t, logrus.Fields{"trust_domain": "example.org"}, testHook.LastEntry().Data)
+
+	// Fields set on the record are not overridden
+	logger.WithField("trust_domain", "federated.org").Info("Hello")
+	assert.Equal(t, logrus.Fields{"trust_domain": "federated.org"}, testHook.LastEntry().Data)
+}
+
+func TestSubsystemLevels(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger, err := NewLogger(
+		WithLevel("WARN"),
+		WithFormat(JSONFormat),
+		WithSubsystemLevels(map[string]string{
+			"ca":        "DEBUG",
+			"DataStore": "ERROR",
+		}))
+	require.NoError(t, err)
+	logger.SetOutput(buf)
+
+	// The logger lets through the most verbose level configured
+	assert.Equal(t, logrus.DebugLevel, logger.Level)
+
+	logger.WithField("subsystem_name", "ca").Debug("ca debug")
+	logger.WithField("subsystem_name", "endpoints").Info("endpoints info")
+	logger.WithField("subsystem_name", "endpoints").Warn("endpoints warn")
+	logger.WithFields(logrus.Fields{"subsystem_name": "catalog", "plugin_type": "DataStore"}).Warn("datastore warn")
+	logger.WithFields(logrus.Fields{"subsystem_name": "catalog", "plugin_type": "DataStore"}).Error("datastore error")
+	logger.Info("info")
+
+	out := buf.String()
+	assert.Contains(t, out, "ca debug")
+	assert.NotContains(t, out, "endpoints info")
+	assert.Contains(t, out, "endpoints warn")
+	assert.NotContains(t, out, "datastore warn")
+	assert.Contains(t, out, "datastore error")
+	assert.NotContains(t, out, `"info"`)
+
+	_, err = NewLogger(WithSubsystemLevels(map[string]string{"ca": "foo"}))
+	assert.EqualError(t, err, `invalid log level for subsystem "ca": not a valid logrus Level: "foo"`)
+}
+
+func TestSetLevels(t *testing.T) {
+	buf := new(bytes.Buffer)
+	logger, err := NewLogger(WithLevel("WARN"), WithFormat(JSONFormat))
+	require.NoError(t, err)
+	logger.SetOutput(buf)
+
+	require.NoError(t, logger.SetLevels("INFO", map[string]string{"ca": "DEBUG"}))
+	assert.Equal(t, logrus.DebugLevel, logger.Level)
+
+	logger.WithField("subsystem_name", "ca").Debug("ca debug")
+	logger.WithField("subsystem_name", "endpoints").Debug("endpoints debug")
+	logger.Info("info")
+
+	out := buf.String()
+	assert.Contains(t, out, "ca debug")
+	assert.NotContains(t, out, "endpoints debug")
+	assert.Contains(t, out, `"info"`)
+
+	// Removing the subsystem levels restores the unfiltered formatter
+	buf.Reset()
+	require.NoError(t, logger.SetLevels("ERROR", nil))
+	assert.Equal(t, logrus.ErrorLevel, logger.Level)
+	assert.IsType(t, &logrus.JSONFormatter{}, logger.Formatter)
+
+	logger.WithField("subsystem_name", "ca").Warn("ca warn")
+	logger.Error("error")
+
+	out = buf.String()
+	assert.NotContains(t, out, "ca warn")
+	assert.Contains(t, out, `"error"`)
+
+	assert.EqualError(t, logger.SetLevels("foo", nil), `not a valid logrus Level: "foo"`)
+	assert.EqualError(t, logger.SetLevels("INFO", map[string]string{"ca": "foo"}), `invalid log level for subsystem "ca": not a valid logrus Level: "foo"`)
+	// The levels are left unchanged on error
+	assert.Equal(t, logrus.ErrorLevel, logger.Level)
+}
@@ -1,11 +1,14 @@
 package log
 
 import (
+	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	"github.com/sirupsen/logrus"
+	"gopkg.in/natefinch/lumberjack.v2"
 )
 
 const (
@@ -41,6 +44,120 @@ func WithOutputFile(file string) Option {
 	}
 }
 
+// RotationConfig configures the rotation of the log file
+type RotationConfig struct {
+	// MaxSize is the size in megabytes the log file reaches before it is
+	// rotated. Defaults to 100 megabytes.
+	MaxSize int
+
+	// MaxAge is the number of days rotated log files are kept. Rotated
+	// files are not removed based on age if zero.
+	MaxAge int
+
+	// MaxBackups is the number of rotated log files kept. All rotated files
+	// are kept if zero, subject to MaxAge.
+	MaxBackups int
+
+	// Compress enables gzip compression of rotated log files
+	Compress bool
+}
+
+// WithRotatingOutputFile writes the logs to the given file, rotating it
+// according to the configuration. Rotated files are renamed using the time
+// of the rotation (e.g. "server-2021-01-01T00-00-00.000.log").
+func WithRotatingOutputFile(file string, c RotationConfig) Option {
+	return func(logger *Logger) error {
+		if file == "" {
+			return errors.New("log file is required to rotate logs")
+		}
+
+		fw := &lumberjack.Logger{
+			Filename:   file,
+			MaxSize:    c.MaxSize,
+			MaxAge:     c.MaxAge,
+			MaxBackups: c.MaxBackups,
+			Compress:   c.Compress,
+		}
+
+		logger.SetOutput(fw)
+
+		// If, for some reason, there's another closer set, close it first.
+		if logger.Closer != nil {
+			if err := logger.Closer.Close(); err != nil {
+				return err
+			}
+		}
+
+		logger.Closer = fw
+		return nil
+	}
+}
+
+// WithFields adds the given fields to every log record that does not already
+// set them.
+func WithFields(fields logrus.Fields) Option {
+	return func(logger *Logger) error {
+		logger.AddHook(fieldsHook(fields))
+		return nil
+	}
+}
+
+const syslogDestination = "syslog"
+
+func isSyslogDestination(destination string) bool {
+	return destination == syslogDestination || strings.HasPrefix(destination, syslogDestination+"+")
+}
+
+// WithOutputs writes the logs to all of the given destinations. Each
+// destination is either "stdout", "stderr", a syslog destination ("syslog",
+// "syslog+udp://host:port" or "syslog+tcp://host:port") or the path of a file
+// the logs are appended to.
+func WithOutputs(destinations []string) Option {
+	return func(logger *Logger) error {
+		if len(destinations) == 0 {
+			return nil
+		}
+
+		var writers []io.Writer
+		var files closers
+		for _, destination := range destinations {
+			switch destination {
+			case "stdout":
+				writers = append(writers, os.Stdout)
+			case "stderr":
+				writers = append(writers, os.Stderr)
+			default:
+				var w io.WriteCloser
+				var err error
+				if isSyslogDestination(destination) {
+					w, err = newSyslogWriter(destination)
+				} else {
+					w, err = os.OpenFile(destination, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+				}
+				if err != nil {
+					files.Close()
+					return err
+				}
+				writers = append(writers, w)
+				files = append(files, w)
+			}
+		}
+
+		logger.SetOutput(io.MultiWriter(writers...))
+
+		// If, for some reason, there's another closer set, close it first.
+		if logger.Closer != nil {
+			if err := logger.Closer.Close(); err != nil {
+				files.Close()
+				return err
+			}
+		}
+
+		logger.Closer = files
+		return nil
+	}
+}
+
 func WithFormat(format string) Option {
 	return func(logger *Logger) error {
 		switch strings.ToUpper(format) {
@@ -57,6 +174,21 @@ func WithFormat(format string) Option {
 	}
 }
 
+// WithSubsystemLevels sets the logging level of individual subsystems,
+// overriding the logging level of the logger for their records. Subsystems
+// are matched case-insensitively against the plugin type of plugin records
+// (e.g. "datastore") and the subsystem name of the rest (e.g. "ca").
+func WithSubsystemLevels(levels map[string]string) Option {
+	return func(logger *Logger) error {
+		subsystemLevels, err := parseSubsystemLevels(levels)
+		if err != nil {
+			return err
+		}
+		logger.subsystemLevels = subsystemLevels
+		return nil
+	}
+}
+
 func WithLevel(logLevel string) Option {
 	return func(logger *Logger) error {
 		level, err := logrus.ParseLevel(logLevel)
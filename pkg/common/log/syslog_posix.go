@@ -0,0 +1,41 @@
+// +build !windows
+
+package log
+
+import (
+	"fmt"
+	"io"
+	"log/syslog"
+	"net/url"
+)
+
+// newSyslogWriter returns a writer that sends the records to syslog. The
+// destination is either "syslog", for the local syslog daemon, or
+// "syslog+udp://host:port" or "syslog+tcp://host:port" for a remote one.
+func newSyslogWriter(destination string) (io.WriteCloser, error) {
+	const priority = syslog.LOG_INFO | syslog.LOG_AUTH
+
+	if destination == syslogDestination {
+		return syslog.New(priority, "")
+	}
+
+	u, err := url.Parse(destination)
+	if err != nil {
+		return nil, fmt.Errorf("invalid syslog destination %q: %v", destination, err)
+	}
+
+	var network string
+	switch u.Scheme {
+	case "syslog+udp":
+		network = "udp"
+	case "syslog+tcp":
+		network = "tcp"
+	default:
+		return nil, fmt.Errorf("invalid syslog destination %q: scheme must be syslog+udp or syslog+tcp", destination)
+	}
+	if u.Host == "" || u.Port() == "" {
+		return nil, fmt.Errorf("invalid syslog destination %q: host and port are required", destination)
+	}
+
+	return syslog.Dial(network, u.Host, priority, "")
+}
@@ -0,0 +1,49 @@
+// +build !windows
+
+package log
+
+import (
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSyslogOutput(t *testing.T) {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer conn.Close()
+
+	logger, err := NewLogger(WithOutputs([]string{"syslog+udp://" + conn.LocalAddr().String()}), WithFormat(JSONFormat))
+	require.NoError(t, err)
+	defer logger.Close()
+
+	logger.Info("This should get forwarded")
+
+	require.NoError(t, conn.SetReadDeadline(time.Now().Add(5*time.Second)))
+	buf := make([]byte, 4096)
+	n, _, err := conn.ReadFrom(buf)
+	require.NoError(t, err)
+	assert.Contains(t, string(buf[:n]), `"msg":"This should get forwarded"`)
+}
+
+func TestSyslogOutputInvalidDestination(t *testing.T) {
+	for _, tt := range []struct {
+		destination string
+		err         string
+	}{
+		{
+			destination: "syslog+http://localhost:514",
+			err:         `invalid syslog destination "syslog+http://localhost:514": scheme must be syslog+udp or syslog+tcp`,
+		},
+		{
+			destination: "syslog+udp://localhost",
+			err:         `invalid syslog destination "syslog+udp://localhost": host and port are required`,
+		},
+	} {
+		_, err := NewLogger(WithOutputs([]string{tt.destination}))
+		assert.EqualError(t, err, tt.err)
+	}
+}
@@ -0,0 +1,12 @@
+// +build windows
+
+package log
+
+import (
+	"errors"
+	"io"
+)
+
+func newSyslogWriter(destination string) (io.WriteCloser, error) {
+	return nil, errors.New("syslog destinations are not supported on Windows")
+}
@@ -0,0 +1,100 @@
+package aws
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/zeebo/errs"
+)
+
+const (
+	// ECSPluginName for AWS ECS
+	ECSPluginName = "aws_ecs"
+
+	// ECSTaskMetadataEnv is the environment variable holding the ECS task
+	// metadata endpoint (version 4) in containers of ECS tasks
+	ECSTaskMetadataEnv = "ECS_CONTAINER_METADATA_URI_V4"
+
+	// ECSTrustDomainHeader is the header holding the trust domain of the
+	// agent. It is signed into the GetCallerIdentity request so that the
+	// request is only accepted by the servers of that trust domain.
+	ECSTrustDomainHeader = "X-Spire-Trust-Domain"
+)
+
+var (
+	ECSErrorClass = errs.Class("aws-ecs")
+)
+
+// ECSAttestationData AWS ECS attestation data
+type ECSAttestationData struct {
+	// TaskARN is the ARN of the task the agent runs in
+	TaskARN string `json:"task_arn"`
+
+	// Cluster is the name or ARN of the cluster of the task
+	Cluster string `json:"cluster"`
+
+	// CallerIdentityRequest is an STS GetCallerIdentity request signed with
+	// the credentials of the task role
+	CallerIdentityRequest SignedRequest `json:"caller_identity_request"`
+}
+
+// SignedRequest is an AWS API request signed with Signature Version 4
+type SignedRequest struct {
+	Host    string      `json:"host"`
+	Headers http.Header `json:"headers"`
+	Body    []byte      `json:"body"`
+}
+
+// ECSTaskMetadata is the task metadata returned by the ECS task metadata
+// endpoint (version 4)
+type ECSTaskMetadata struct {
+	Cluster          string                 `json:"Cluster"`
+	TaskARN          string                 `json:"TaskARN"`
+	Family           string                 `json:"Family"`
+	Revision         string                 `json:"Revision"`
+	LaunchType       string                 `json:"LaunchType"`
+	AvailabilityZone string                 `json:"AvailabilityZone"`
+	Containers       []ECSContainerMetadata `json:"Containers"`
+}
+
+// ECSContainerMetadata is the metadata of a container of an ECS task
+type ECSContainerMetadata struct {
+	DockerID string            `json:"DockerId"`
+	Name     string            `json:"Name"`
+	Image    string            `json:"Image"`
+	ImageID  string            `json:"ImageID"`
+	Labels   map[string]string `json:"Labels"`
+}
+
+// FetchECSTaskMetadata fetches the metadata of the task from the ECS task
+// metadata endpoint
+func FetchECSTaskMetadata(ctx context.Context, client *http.Client, endpoint string) (*ECSTaskMetadata, error) {
+	req, err := http.NewRequest("GET", strings.TrimSuffix(endpoint, "/")+"/task", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code from task metadata endpoint: %d", resp.StatusCode)
+	}
+
+	task := new(ECSTaskMetadata)
+	if err := json.NewDecoder(resp.Body).Decode(task); err != nil {
+		return nil, fmt.Errorf("unable to decode task metadata: %w", err)
+	}
+	return task, nil
+}
+
+// ECSAttestationStepError error with ECS attestation
+func ECSAttestationStepError(step string, cause error) error {
+	return ECSErrorClass.New("attempted attestation but an error occurred %s: %w", step, cause)
+}
@@ -0,0 +1,100 @@
+package vsphere
+
+import (
+	"crypto/rand"
+	"crypto/subtle"
+	"encoding/hex"
+	"fmt"
+	"path"
+	"regexp"
+	"strings"
+
+	"github.com/spiffe/spire/pkg/common/idutil"
+)
+
+const (
+	nonceLen = 32
+
+	// PluginName for VMware vSphere
+	PluginName = "vsphere"
+
+	// ChallengeKey is the guestinfo key the server delivers the challenge
+	// nonce to. Only processes running in the guest of the VM can read it.
+	ChallengeKey = "guestinfo.spire.challenge"
+)
+
+var (
+	uuidRE         = regexp.MustCompile(`^[0-9a-f]{8}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{4}-[0-9a-f]{12}$`)
+	guestInfoKeyRE = regexp.MustCompile(`^guestinfo\.[A-Za-z0-9._-]+$`)
+)
+
+type AttestationData struct {
+	// UUID is the BIOS UUID of the VM, as read by the guest.
+	UUID string `json:"uuid"`
+}
+
+type Challenge struct {
+	// Key is the guestinfo key holding the nonce.
+	Key string `json:"key"`
+}
+
+type Response struct {
+	// Nonce is the nonce read from the guestinfo key.
+	Nonce string `json:"nonce"`
+}
+
+// NormalizeUUID returns the UUID in lower case, or an error if it is not a
+// well-formed UUID.
+func NormalizeUUID(uuid string) (string, error) {
+	uuid = strings.ToLower(strings.TrimSpace(uuid))
+	if !uuidRE.MatchString(uuid) {
+		return "", fmt.Errorf("malformed UUID %q", uuid)
+	}
+	return uuid, nil
+}
+
+// SwapUUIDByteOrder swaps the byte order of the first three fields of the
+// UUID. Guests of VMs with a hardware version older than 13 read the BIOS
+// UUID in this byte order, which differs from the one reported by vCenter.
+func SwapUUIDByteOrder(uuid string) string {
+	parts := strings.Split(uuid, "-")
+	for i := 0; i < 3 && i < len(parts); i++ {
+		b := []byte(parts[i])
+		for j, k := 0, len(b)-2; j < k; j, k = j+2, k-2 {
+			b[j], b[j+1], b[k], b[k+1] = b[k], b[k+1], b[j], b[j+1]
+		}
+		parts[i] = string(b)
+	}
+	return strings.Join(parts, "-")
+}
+
+// ValidateGuestInfoKey verifies that the key is a guestinfo key, which the
+// guest is allowed to read.
+func ValidateGuestInfoKey(key string) error {
+	if !guestInfoKeyRE.MatchString(key) {
+		return fmt.Errorf("invalid guestinfo key %q", key)
+	}
+	return nil
+}
+
+// GenerateNonce returns a random nonce, hex encoded.
+func GenerateNonce() (string, error) {
+	nonce := make([]byte, nonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(nonce), nil
+}
+
+// VerifyChallengeResponse verifies that the response holds the nonce.
+func VerifyChallengeResponse(nonce string, response *Response) error {
+	if subtle.ConstantTimeCompare([]byte(nonce), []byte(response.Nonce)) != 1 {
+		return fmt.Errorf("nonce does not match")
+	}
+	return nil
+}
+
+// MakeSpiffeID returns the agent ID of the VM with the given UUID.
+func MakeSpiffeID(trustDomain, uuid string) string {
+	return idutil.AgentID(trustDomain, path.Join(PluginName, uuid))
+}
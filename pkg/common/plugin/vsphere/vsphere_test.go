@@ -0,0 +1,41 @@
+package vsphere
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestNormalizeUUID(t *testing.T) {
+	uuid, err := NormalizeUUID(" 4211D0E4-1F5C-3C56-E2A3-6A7DC9A4F4B1\n")
+	require.NoError(t, err)
+	require.Equal(t, "4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1", uuid)
+
+	_, err = NormalizeUUID("4211d0e4")
+	require.EqualError(t, err, `malformed UUID "4211d0e4"`)
+}
+
+func TestSwapUUIDByteOrder(t *testing.T) {
+	require.Equal(t, "e4d01142-5c1f-563c-e2a3-6a7dc9a4f4b1", SwapUUIDByteOrder("4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1"))
+	require.Equal(t, "4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1", SwapUUIDByteOrder(SwapUUIDByteOrder("4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1")))
+}
+
+func TestValidateGuestInfoKey(t *testing.T) {
+	require.NoError(t, ValidateGuestInfoKey(ChallengeKey))
+	require.EqualError(t, ValidateGuestInfoKey("machine.id"), `invalid guestinfo key "machine.id"`)
+	require.EqualError(t, ValidateGuestInfoKey("guestinfo.a b"), `invalid guestinfo key "guestinfo.a b"`)
+}
+
+func TestVerifyChallengeResponse(t *testing.T) {
+	nonce, err := GenerateNonce()
+	require.NoError(t, err)
+	require.Len(t, nonce, 2*nonceLen)
+
+	require.NoError(t, VerifyChallengeResponse(nonce, &Response{Nonce: nonce}))
+	require.EqualError(t, VerifyChallengeResponse(nonce, &Response{Nonce: "bad"}), "nonce does not match")
+}
+
+func TestMakeSpiffeID(t *testing.T) {
+	require.Equal(t, "spiffe://example.org/spire/agent/vsphere/4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1",
+		MakeSpiffeID("example.org", "4211d0e4-1f5c-3c56-e2a3-6a7dc9a4f4b1"))
+}
@@ -2,6 +2,7 @@ package profiling
 
 import (
 	"os"
+	"path/filepath"
 	"runtime"
 	"runtime/pprof"
 	"runtime/trace"
@@ -34,7 +35,7 @@ type traceDumper struct {
 }
 
 func (d *dumper) Prepare() error {
-	err := createProfilesFolder()
+	err := createProfilesFolder(d.c.Dir)
 	if err != nil {
 		return err
 	}
@@ -47,7 +48,7 @@ func (d *dumper) Dump(timestamp string, name string) error {
 		return ErrUnknownProfile
 	}
 
-	filename := getFilename(timestamp, d.c.Tag, name)
+	filename := getFilename(d.c.Dir, timestamp, d.c.Tag, name)
 	f, err := os.Create(filename)
 	if err != nil {
 		return err
@@ -78,11 +79,11 @@ func (d *heapDumper) Release() error {
 }
 
 func (d *traceDumper) Prepare() error {
-	err := createProfilesFolder()
+	err := createProfilesFolder(d.c.Dir)
 	if err != nil {
 		return err
 	}
-	f, err := os.Create(getTempFilename(d.c.Tag, traceProfTmpFilename))
+	f, err := os.Create(getTempFilename(d.c.Dir, d.c.Tag, traceProfTmpFilename))
 	if err != nil {
 		return err
 	}
@@ -97,8 +98,8 @@ func (d *traceDumper) Prepare() error {
 func (d *traceDumper) Dump(timestamp string, name string) error {
 	trace.Stop()
 	d.data.Close()
-	filename := getFilename(timestamp, d.c.Tag, name)
-	if err := os.Rename(getTempFilename(d.c.Tag, traceProfTmpFilename), filename); err != nil {
+	filename := getFilename(d.c.Dir, timestamp, d.c.Tag, name)
+	if err := os.Rename(getTempFilename(d.c.Dir, d.c.Tag, traceProfTmpFilename), filename); err != nil {
 		return errs.Wrap(err)
 	}
 	return d.Prepare()
@@ -106,16 +107,16 @@ func (d *traceDumper) Dump(timestamp string, name string) error {
 
 func (d *traceDumper) Release() error {
 	d.data.Close()
-	os.Remove(getTempFilename(d.c.Tag, traceProfTmpFilename))
+	os.Remove(getTempFilename(d.c.Dir, d.c.Tag, traceProfTmpFilename))
 	return nil
 }
 
 func (d *cpuDumper) Prepare() error {
-	err := createProfilesFolder()
+	err := createProfilesFolder(d.c.Dir)
 	if err != nil {
 		return err
 	}
-	f, err := os.Create(getTempFilename(d.c.Tag, cpuProfTmpFilename))
+	f, err := os.Create(getTempFilename(d.c.Dir, d.c.Tag, cpuProfTmpFilename))
 	if err != nil {
 		return err
 	}
@@ -131,8 +132,8 @@ func (d *cpuDumper) Prepare() error {
 func (d *cpuDumper) Dump(timestamp string, name string) error {
 	pprof.StopCPUProfile()
 	d.data.Close()
-	filename := getFilename(timestamp, d.c.Tag, name)
-	if err := os.Rename(getTempFilename(d.c.Tag, cpuProfTmpFilename), filename); err != nil {
+	filename := getFilename(d.c.Dir, timestamp, d.c.Tag, name)
+	if err := os.Rename(getTempFilename(d.c.Dir, d.c.Tag, cpuProfTmpFilename), filename); err != nil {
 		return errs.Wrap(err)
 	}
 	return d.Prepare()
@@ -140,33 +141,29 @@ func (d *cpuDumper) Dump(timestamp string, name string) error {
 
 func (d *cpuDumper) Release() error {
 	d.data.Close()
-	os.Remove(getTempFilename(d.c.Tag, cpuProfTmpFilename))
+	os.Remove(getTempFilename(d.c.Dir, d.c.Tag, cpuProfTmpFilename))
 	return nil
 }
 
-func getTempFilename(tag, name string) string {
+func getTempFilename(dir, tag, name string) string {
 	filename := &strings.Builder{}
-	filename.WriteString(profilesDir)
-	filename.WriteString("/")
 	filename.WriteString(tag)
 	filename.WriteString("_")
 	filename.WriteString(name)
-	return filename.String()
+	return filepath.Join(dir, filename.String())
 }
 
-func getFilename(timestamp, tag, name string) string {
+func getFilename(dir, timestamp, tag, name string) string {
 	filename := &strings.Builder{}
-	filename.WriteString(profilesDir)
-	filename.WriteString("/")
 	filename.WriteString(timestamp)
 	filename.WriteString("_")
 	filename.WriteString(tag)
 	filename.WriteString("_")
 	filename.WriteString(name)
 	filename.WriteString(".pb.gz")
-	return filename.String()
+	return filepath.Join(dir, filename.String())
 }
 
-func createProfilesFolder() error {
-	return os.MkdirAll(profilesDir, os.ModePerm)
+func createProfilesFolder(dir string) error {
+	return os.MkdirAll(dir, os.ModePerm)
 }
@@ -3,6 +3,7 @@ package profiling
 import (
 	"context"
 	"errors"
+	"fmt"
 	"sync"
 	"time"
 )
@@ -25,6 +26,9 @@ type Config struct {
 	// Available values for each element:
 	// "goroutine", "threadcreate", "heap", "block", "mutex", "trace", "cpu"
 	Profiles []string
+	// Dir is the directory the profiles are written to. Defaults to
+	// ".profiles" in the current working directory.
+	Dir string
 }
 
 // Dumper defines the interface that are used to dump profiling data of some kind.
@@ -46,7 +50,7 @@ type profiler struct {
 }
 
 const (
-	profilesDir = ".profiles"
+	defaultProfilesDir = ".profiles"
 )
 
 var (
@@ -112,6 +116,20 @@ func Run(ctx context.Context, conf *Config) error {
 	return err
 }
 
+// ValidateProfiles returns an error if any of the given profile names is not
+// a known profile.
+func ValidateProfiles(profiles []string) error {
+	profM.Lock()
+	defer profM.Unlock()
+
+	for _, name := range profiles {
+		if _, ok := dumpers[name]; !ok {
+			return fmt.Errorf("%w: %q", ErrUnknownProfile, name)
+		}
+	}
+	return nil
+}
+
 // getDumpers returns a map of valid dumpers, it filters out any non existent profile name.
 func getDumpers(profiles []string) map[string]Dumper {
 	result := map[string]Dumper{}
@@ -167,6 +185,9 @@ func (p *profiler) releaseDumpers() {
 }
 
 func configureDefaultDumpers(conf *Config) {
+	if conf.Dir == "" {
+		conf.Dir = defaultProfilesDir
+	}
 	profileDumper.c = conf
 	heapProfileDumper.dumper.c = conf
 	traceProfileDumper.c = conf
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/proto/spire/server/datastore"
 	"github.com/spiffe/spire/proto/spire/types"
 	"google.golang.org/protobuf/proto"
 )
@@ -14,8 +15,12 @@ var (
 	AllTrueBundleMask = MakeAllTrueMask(&types.BundleMask{}).(*types.BundleMask)
 	AllTrueEntryMask  = MakeAllTrueMask(&types.EntryMask{}).(*types.EntryMask)
 
+	AllTrueFederationRelationshipMask = MakeAllTrueMask(&types.FederationRelationshipMask{}).(*types.FederationRelationshipMask)
+
 	AllTrueCommonBundleMask = MakeAllTrueMask(&common.BundleMask{}).(*common.BundleMask)
 	AllTrueCommonAgentMask  = MakeAllTrueMask(&common.AttestedNodeMask{}).(*common.AttestedNodeMask)
+
+	AllTrueDatastoreFederationRelationshipMask = MakeAllTrueMask(&datastore.FederationRelationshipMask{}).(*datastore.FederationRelationshipMask)
 )
 
 func MakeAllTrueMask(m proto.Message) proto.Message {
@@ -5,6 +5,7 @@ import (
 
 	"github.com/spiffe/spire/pkg/common/protoutil"
 	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/proto/spire/server/datastore"
 	"github.com/spiffe/spire/proto/spire/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -26,18 +27,25 @@ func TestAllTrueMasks(t *testing.T) {
 	}, protoutil.AllTrueBundleMask)
 
 	assert.Equal(t, &types.EntryMask{
-		SpiffeId:       true,
-		ParentId:       true,
-		Selectors:      true,
-		Ttl:            true,
-		FederatesWith:  true,
-		Admin:          true,
-		Downstream:     true,
-		ExpiresAt:      true,
-		DnsNames:       true,
-		RevisionNumber: true,
+		SpiffeId:         true,
+		ParentId:         true,
+		Selectors:        true,
+		Ttl:              true,
+		FederatesWith:    true,
+		Admin:            true,
+		Downstream:       true,
+		ExpiresAt:        true,
+		DnsNames:         true,
+		RevisionNumber:   true,
+		AllowedAudiences: true,
+		CreatedAt:        true,
 	}, protoutil.AllTrueEntryMask)
 
+	assert.Equal(t, &types.FederationRelationshipMask{
+		BundleEndpointUrl:     true,
+		BundleEndpointProfile: true,
+	}, protoutil.AllTrueFederationRelationshipMask)
+
 	assert.Equal(t, &common.BundleMask{
 		RootCas:        true,
 		JwtSigningKeys: true,
@@ -51,4 +59,9 @@ func TestAllTrueMasks(t *testing.T) {
 		NewCertSerialNumber: true,
 		NewCertNotAfter:     true,
 	}, protoutil.AllTrueCommonAgentMask)
+
+	assert.Equal(t, &datastore.FederationRelationshipMask{
+		BundleEndpointUrl:     true,
+		BundleEndpointProfile: true,
+	}, protoutil.AllTrueDatastoreFederationRelationshipMask)
 }
@@ -0,0 +1,18 @@
+package agent
+
+import (
+	"time"
+
+	"github.com/spiffe/spire/pkg/common/telemetry"
+)
+
+// Gauge (remember previous value set)
+
+// SetClockSkewGauge sets the difference, in seconds, between the clock of
+// the server and the clock of the agent. It is positive when the server
+// clock is ahead.
+func SetClockSkewGauge(m telemetry.Metrics, skew time.Duration) {
+	m.SetGauge([]string{telemetry.ClockSkew}, float32(skew.Seconds()))
+}
+
+// End Gauge
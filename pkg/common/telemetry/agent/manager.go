@@ -1,6 +1,8 @@
 package agent
 
 import (
+	"time"
+
 	"github.com/spiffe/spire/pkg/common/telemetry"
 )
 
@@ -37,4 +39,10 @@ func AddCacheManagerOutdatedSVIDsSample(m telemetry.Metrics, count float32) {
 	m.AddSample([]string{telemetry.CacheManager, telemetry.OutdatedSVIDs}, count)
 }
 
+// AddCacheManagerWorkloadKeyAgeSample age, in seconds, of the private key of
+// a workload SVID being renewed by the agent cache manager
+func AddCacheManagerWorkloadKeyAgeSample(m telemetry.Metrics, age time.Duration) {
+	m.AddSample([]string{telemetry.CacheManager, telemetry.WorkloadKeyAge}, float32(age.Seconds()))
+}
+
 // End Add Samples
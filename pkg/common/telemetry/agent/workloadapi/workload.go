@@ -1,6 +1,8 @@
 package workloadapi
 
 import (
+	"time"
+
 	"github.com/spiffe/spire/pkg/common/telemetry"
 )
 
@@ -14,6 +16,14 @@ func StartAttestationCall(m telemetry.Metrics) *telemetry.CallCounter {
 	return cc
 }
 
+// MeasureCallerAttestation emits the time spent attesting a Workload API
+// caller, labeled with the selectors of the caller
+func MeasureCallerAttestation(m telemetry.Metrics, selectors string, start time.Time) {
+	m.MeasureSinceWithLabels([]string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.WorkloadAttestation}, start, []telemetry.Label{
+		{Name: telemetry.Selectors, Value: selectors},
+	})
+}
+
 // StartAttestorCall return metric
 // for agent's Workload API Attestor for a specific attestor
 func StartAttestorCall(m telemetry.Metrics, aType string) *telemetry.CallCounter {
@@ -37,6 +47,22 @@ func SetConnectionTotalGauge(m telemetry.Metrics, connections int32) {
 	m.SetGauge([]string{telemetry.WorkloadAPI, telemetry.Connections}, float32(connections))
 }
 
+// IncrCallerUpdateCounter indicates an update sent to a Workload API
+// caller, labeled with the selectors of the caller
+func IncrCallerUpdateCounter(m telemetry.Metrics, selectors string) {
+	m.IncrCounterWithLabels([]string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Update}, 1, []telemetry.Label{
+		{Name: telemetry.Selectors, Value: selectors},
+	})
+}
+
+// SetCallerConnectionsGauge sets the number of active Workload API
+// connections of callers with the given selectors
+func SetCallerConnectionsGauge(m telemetry.Metrics, selectors string, connections int) {
+	m.SetGaugeWithLabels([]string{telemetry.WorkloadAPI, telemetry.Caller, telemetry.Connections}, float32(connections), []telemetry.Label{
+		{Name: telemetry.Selectors, Value: selectors},
+	})
+}
+
 // End Counters
 
 // Add Samples (metric on count of some object, entries, event...)
@@ -17,6 +17,15 @@ type FileConfig struct {
 	Statsd     []StatsdConfig    `hcl:"Statsd"`
 	M3         []M3Config        `hcl:"M3"`
 	InMem      *InMem            `hcl:"InMem"`
+	Tracing    *TracingConfig    `hcl:"Tracing"`
+
+	// AllowedLabels, if set, is the list of labels that metrics are emitted
+	// with. Any other label is dropped.
+	AllowedLabels []string `hcl:"AllowedLabels"`
+
+	// BlockedLabels is the list of labels that are dropped from metrics. It
+	// takes precedence over AllowedLabels.
+	BlockedLabels []string `hcl:"BlockedLabels"`
 
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
@@ -44,6 +53,25 @@ type M3Config struct {
 }
 
 type InMem struct {
-	Enabled    *bool    `hcl:"enabled"`
+	Enabled *bool `hcl:"enabled"`
+
+	// DumpInterval is how often a summary of the collected metrics is
+	// logged (e.g. "1m"). The summary is not logged if unset.
+	DumpInterval string `hcl:"dump_interval"`
+
+	UnusedKeys []string `hcl:",unusedKeys"`
+}
+
+type TracingConfig struct {
+	// Address is the address of the OTLP collector that spans are
+	// exported to
+	Address string `hcl:"address"`
+
+	// Insecure disables TLS on the connection to the collector
+	Insecure bool `hcl:"insecure"`
+
+	// SampleRatio is the fraction of traces that are sampled. Defaults to 1.
+	SampleRatio float64 `hcl:"sample_ratio"`
+
 	UnusedKeys []string `hcl:",unusedKeys"`
 }
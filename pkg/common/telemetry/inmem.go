@@ -2,9 +2,11 @@ package telemetry
 
 import (
 	"context"
+	"fmt"
 	"io"
 	"os"
 	"os/signal"
+	"sort"
 	"sync"
 	"time"
 
@@ -23,6 +25,7 @@ type inmemRunner struct {
 	loadedSink *metrics.InmemSink
 
 	inMemBlockSet bool
+	dumpInterval  time.Duration
 }
 
 func newInmemRunner(c *MetricsConfig) (sinkRunner, error) {
@@ -38,6 +41,17 @@ func newInmemRunner(c *MetricsConfig) (sinkRunner, error) {
 		}
 	}
 
+	if c.FileConfig.InMem != nil && c.FileConfig.InMem.DumpInterval != "" {
+		dumpInterval, err := time.ParseDuration(c.FileConfig.InMem.DumpInterval)
+		if err != nil {
+			return nil, fmt.Errorf("invalid InMem dump_interval: %v", err)
+		}
+		if dumpInterval < inmemInterval {
+			return nil, fmt.Errorf("InMem dump_interval must be at least %s", inmemInterval)
+		}
+		runner.dumpInterval = dumpInterval
+	}
+
 	if logger, ok := c.Logger.(interface{ Writer() *io.PipeWriter }); ok {
 		runner.w = logger.Writer()
 	} else {
@@ -70,6 +84,10 @@ func (i *inmemRunner) run(ctx context.Context) error {
 
 	i.startInMemMetrics(ctx, &wg)
 
+	if i.dumpInterval > 0 {
+		i.startMetricsDump(ctx, &wg)
+	}
+
 	if !i.inMemBlockSet {
 		i.startConfigWarning(ctx, &wg)
 	}
@@ -107,6 +125,124 @@ func (i *inmemRunner) startInMemMetrics(ctx context.Context, wg *sync.WaitGroup)
 	}()
 }
 
+func (i *inmemRunner) startMetricsDump(ctx context.Context, wg *sync.WaitGroup) {
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		ticker := time.NewTicker(i.dumpInterval)
+		defer ticker.Stop()
+		since := time.Now()
+		for {
+			select {
+			case now := <-ticker.C:
+				since = dumpInmemMetrics(i.log, i.loadedSink.Data(), since, now)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
 func (i *inmemRunner) requiresTypePrefix() bool {
 	return false
 }
+
+// dumpInmemMetrics logs a summary of the metrics collected in the intervals
+// that started at or after since and ended by now. Gauges report their last
+// value while counters and samples are aggregated across the intervals. It
+// returns the time the summary ends, which is where the next one starts.
+func dumpInmemMetrics(log logrus.FieldLogger, data []*metrics.IntervalMetrics, since, now time.Time) time.Time {
+	gauges := make(map[string]float32)
+	counters := make(map[string]*metrics.AggregateSample)
+	samples := make(map[string]*metrics.AggregateSample)
+
+	end := since
+	for _, interval := range data {
+		intervalEnd := interval.Interval.Add(inmemInterval)
+		// Skip intervals that were already summarized or are still in
+		// progress
+		if interval.Interval.Before(since) || intervalEnd.After(now) {
+			continue
+		}
+		if intervalEnd.After(end) {
+			end = intervalEnd
+		}
+
+		interval.RLock()
+		for key, gauge := range interval.Gauges {
+			gauges[key] = gauge.Value
+		}
+		for key, counter := range interval.Counters {
+			counters[key] = mergeAggregateSample(counters[key], counter.AggregateSample)
+		}
+		for key, sample := range interval.Samples {
+			samples[key] = mergeAggregateSample(samples[key], sample.AggregateSample)
+		}
+		interval.RUnlock()
+	}
+
+	for _, key := range sortedGaugeKeys(gauges) {
+		log.WithFields(logrus.Fields{
+			"metric": key,
+			"type":   "gauge",
+			"value":  gauges[key],
+		}).Info("Telemetry summary")
+	}
+	for _, key := range sortedSampleKeys(counters) {
+		counter := counters[key]
+		log.WithFields(logrus.Fields{
+			"metric": key,
+			"type":   "counter",
+			Count:    counter.Count,
+			"sum":    counter.Sum,
+		}).Info("Telemetry summary")
+	}
+	for _, key := range sortedSampleKeys(samples) {
+		sample := samples[key]
+		log.WithFields(logrus.Fields{
+			"metric": key,
+			"type":   "sample",
+			Count:    sample.Count,
+			"min":    sample.Min,
+			"mean":   sample.Mean(),
+			"max":    sample.Max,
+		}).Info("Telemetry summary")
+	}
+
+	return end
+}
+
+func mergeAggregateSample(a, b *metrics.AggregateSample) *metrics.AggregateSample {
+	if a == nil {
+		merged := *b
+		return &merged
+	}
+	if b.Min < a.Min {
+		a.Min = b.Min
+	}
+	if b.Max > a.Max {
+		a.Max = b.Max
+	}
+	a.Count += b.Count
+	a.Sum += b.Sum
+	a.SumSq += b.SumSq
+	return a
+}
+
+func sortedGaugeKeys(m map[string]float32) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+func sortedSampleKeys(m map[string]*metrics.AggregateSample) []string {
+	keys := make([]string, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
@@ -110,6 +110,77 @@ func TestWarnOnFutureDisable(t *testing.T) {
 	require.NoError(t, <-errCh)
 }
 
+func TestInmemDumpInterval(t *testing.T) {
+	config := testInmemConfig()
+	config.FileConfig = FileConfig{
+		InMem: &InMem{
+			DumpInterval: "1m",
+		},
+	}
+	runner, err := newInmemRunner(config)
+	require.NoError(t, err)
+	assert.Equal(t, time.Minute, runner.(*inmemRunner).dumpInterval)
+
+	config.FileConfig.InMem.DumpInterval = "foo"
+	_, err = newInmemRunner(config)
+	assert.EqualError(t, err, `invalid InMem dump_interval: time: invalid duration "foo"`)
+
+	config.FileConfig.InMem.DumpInterval = "1ms"
+	_, err = newInmemRunner(config)
+	assert.EqualError(t, err, "InMem dump_interval must be at least 1s")
+}
+
+func TestDumpInmemMetrics(t *testing.T) {
+	log, hook := test.NewNullLogger()
+
+	start := time.Now().Truncate(inmemInterval)
+	first := metrics.NewIntervalMetrics(start)
+	second := metrics.NewIntervalMetrics(start.Add(inmemInterval))
+	inProgress := metrics.NewIntervalMetrics(start.Add(2 * inmemInterval))
+
+	ingestCounter(first, "counter", 1)
+	ingestCounter(second, "counter", 2)
+	ingestCounter(inProgress, "counter", 4)
+	ingestSample(first, "sample", 10)
+	ingestSample(second, "sample", 30)
+	first.Gauges["gauge"] = metrics.GaugeValue{Value: 1}
+	second.Gauges["gauge"] = metrics.GaugeValue{Value: 2}
+
+	data := []*metrics.IntervalMetrics{first, second, inProgress}
+	now := start.Add(2*inmemInterval + inmemInterval/2)
+	end := dumpInmemMetrics(log, data, start, now)
+	assert.Equal(t, start.Add(2*inmemInterval), end)
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 3)
+	for _, entry := range entries {
+		assert.Equal(t, "Telemetry summary", entry.Message)
+	}
+	assert.Equal(t, logrus.Fields{"metric": "gauge", "type": "gauge", "value": float32(2)}, entries[0].Data)
+	assert.Equal(t, logrus.Fields{"metric": "counter", "type": "counter", "count": 2, "sum": float64(3)}, entries[1].Data)
+	assert.Equal(t, logrus.Fields{"metric": "sample", "type": "sample", "count": 2, "min": float64(10), "mean": float64(20), "max": float64(30)}, entries[2].Data)
+
+	// Intervals that were already summarized are skipped
+	hook.Reset()
+	end = dumpInmemMetrics(log, data, end, now.Add(inmemInterval))
+	assert.Equal(t, start.Add(3*inmemInterval), end)
+	entries = hook.AllEntries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, logrus.Fields{"metric": "counter", "type": "counter", "count": 1, "sum": float64(4)}, entries[0].Data)
+}
+
+func ingestCounter(interval *metrics.IntervalMetrics, key string, val float64) {
+	agg := &metrics.AggregateSample{}
+	agg.Ingest(val, 1)
+	interval.Counters[key] = metrics.SampledValue{Name: key, Hash: key, AggregateSample: agg}
+}
+
+func ingestSample(interval *metrics.IntervalMetrics, key string, val float64) {
+	agg := &metrics.AggregateSample{}
+	agg.Ingest(val, 1)
+	interval.Samples[key] = metrics.SampledValue{Name: key, Hash: key, AggregateSample: agg}
+}
+
 func TestInmemSinks(t *testing.T) {
 	ir, err := newInmemRunner(testUnknownInmemConfig())
 	require.Nil(t, err)
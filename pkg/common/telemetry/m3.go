@@ -2,6 +2,8 @@ package telemetry
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"io"
 	"strings"
 	"sync"
@@ -186,9 +188,18 @@ type m3Runner struct {
 func newM3Runner(c *MetricsConfig) (sinkRunner, error) {
 	runner := &m3Runner{}
 	for _, conf := range c.FileConfig.M3 {
+		// The reporter accepts an empty address, which would silently drop
+		// all metrics, so both settings are checked up front.
+		switch {
+		case conf.Address == "":
+			return runner, errors.New("M3 address is required")
+		case conf.Env == "":
+			return runner, errors.New("M3 env is required")
+		}
+
 		sink, err := newM3Sink(c.ServiceName, conf.Address, conf.Env)
 		if err != nil {
-			return runner, err
+			return runner, fmt.Errorf("unable to create M3 sink for %q: %v", conf.Address, err)
 		}
 
 		runner.loadedSinks = append(runner.loadedSinks, sink)
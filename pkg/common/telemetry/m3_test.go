@@ -23,6 +23,18 @@ func TestNewM3Runner(t *testing.T) {
 	assert.False(t, runner.isConfigured())
 }
 
+func TestNewM3RunnerRequiresAddressAndEnv(t *testing.T) {
+	config := testM3Config()
+	config.FileConfig.M3[0].Address = ""
+	_, err := newM3Runner(config)
+	require.EqualError(t, err, "M3 address is required")
+
+	config = testM3Config()
+	config.FileConfig.M3[0].Env = ""
+	_, err = newM3Runner(config)
+	require.EqualError(t, err, "M3 env is required")
+}
+
 func TestMultipleM3Sinks(t *testing.T) {
 	config := testM3Config()
 	sink2 := M3Config{
@@ -0,0 +1,77 @@
+package telemetry
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLabelFilters(t *testing.T) {
+	for _, tt := range []struct {
+		name          string
+		allowedLabels []string
+		blockedLabels []string
+		expectLabels  []Label
+	}{
+		{
+			name: "no filters",
+			expectLabels: []Label{
+				{Name: "spiffe_id", Value: "foo"},
+				{Name: "status", Value: "OK"},
+			},
+		},
+		{
+			name:          "allowed labels",
+			allowedLabels: []string{"status"},
+			expectLabels: []Label{
+				{Name: "status", Value: "OK"},
+			},
+		},
+		{
+			name:          "blocked labels",
+			blockedLabels: []string{"host", "spiffe_id"},
+			expectLabels: []Label{
+				{Name: "status", Value: "OK"},
+			},
+		},
+		{
+			name:          "blocked labels take precedence",
+			allowedLabels: []string{"spiffe_id", "status"},
+			blockedLabels: []string{"spiffe_id"},
+			expectLabels: []Label{
+				{Name: "status", Value: "OK"},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c := testInmemConfig()
+			c.FileConfig.AllowedLabels = tt.allowedLabels
+			c.FileConfig.BlockedLabels = tt.blockedLabels
+
+			m, err := NewMetrics(c)
+			require.NoError(t, err)
+			require.Len(t, m.runners, 1)
+
+			m.SetGaugeWithLabels([]string{"gauge"}, 1, []Label{
+				{Name: "spiffe_id", Value: "foo"},
+				{Name: "status", Value: "OK"},
+			})
+
+			intervals := m.runners[0].(*inmemRunner).loadedSink.Data()
+			require.Len(t, intervals, 1)
+			require.Len(t, intervals[0].Gauges, 1)
+			for _, gauge := range intervals[0].Gauges {
+				// Ignore the hostname label, which is added to every metric
+				var labels []Label
+				for _, label := range gauge.Labels {
+					if label.Name != "host" {
+						labels = append(labels, label)
+					}
+				}
+				assert.Equal(t, tt.expectLabels, labels)
+			}
+		})
+	}
+}
@@ -22,6 +22,10 @@ const (
 	// to add clarity
 	Attest = "attest"
 
+	// Clamp functionality related to clamping some value (such as a TTL) to a
+	// configured limit; should be used with other tags to add clarity
+	Clamp = "clamp"
+
 	// Create functionality related to creating some entity; should be used with other tags
 	// to add clarity
 	Create = "create"
@@ -58,6 +62,9 @@ const (
 	// (server)
 	GetPublicKeys = "get_public_keys"
 
+	// Handled functionality related to an RPC call that was handled
+	Handled = "handled"
+
 	// List functionality related to listing some objects; should be used
 	// with other tags to add clarity
 	List = "list"
@@ -75,6 +82,10 @@ const (
 	// to add clarity
 	Push = "push"
 
+	// RateLimited functionality related to an RPC call that was failed by a
+	// rate limiter
+	RateLimited = "rate_limited"
+
 	// Reload functionality related to reloading of a cache
 	Reload = "reload"
 
@@ -125,13 +136,33 @@ const (
 	// Audience tags some audience for a token
 	Audience = "audience"
 
+	// BanDuration tags how long a caller is banned for
+	BanDuration = "ban_duration"
+
+	// Caller tags metrics of individual API callers; should be used with
+	// other tags to add clarity
+	Caller = "caller"
+
+	// CallerAddr tags the address of an API caller
+	CallerAddr = "caller_addr"
+
 	// CallerID tags an API caller; should be used with other tags
 	// to add clarity
 	CallerID = "caller_id"
 
+	// CallerUID tags the UID of a Workload API caller
+	CallerUID = "caller_uid"
+
 	// CGroupPath tags a linux CGroup path, most likely for use in attestation
 	CGroupPath = "cgroup_path"
 
+	// ClockSkew tags the difference between the clock of the server and the
+	// local clock
+	ClockSkew = "clock_skew"
+
+	// Command tags the path of a command run by the agent or server
+	Command = "command"
+
 	// Connection functionality related to some connection; should be used with other tags
 	// to add clarity
 	Connection = "connection"
@@ -140,6 +171,10 @@ const (
 	// to add clarity
 	Connections = "connections"
 
+	// ConsecutiveFailures tags a count of failures in a row for some
+	// recurring operation; should be used with other tags to add clarity
+	ConsecutiveFailures = "consecutive_failures"
+
 	// ContainerID tags some container ID, most likely for use in attestation
 	ContainerID = "container_id"
 
@@ -188,6 +223,9 @@ const (
 	// IDType tags some type of ID (eg. registration ID, SPIFFE ID...)
 	IDType = "id_type"
 
+	// InFlight tags the number of operations (e.g. RPC calls) that are in progress
+	InFlight = "in_flight"
+
 	// IssuedAt tags an issuance timestamp
 	IssuedAt = "issued_at"
 
@@ -201,6 +239,9 @@ const (
 	// Kid tags some key ID
 	Kid = "kid"
 
+	// MaxTTL tags the maximum time-to-live some value is capped to
+	MaxTTL = "max_ttl"
+
 	// NewSerialNumber tags a certificate new serial number
 	NewSerialNumber = "new_serial_num"
 
@@ -239,6 +280,9 @@ const (
 	// PluginServices tags services provided by a plugin
 	PluginServices = "plugin_services"
 
+	// PluginServiceVersion tags the version of a service provided by a plugin
+	PluginServiceVersion = "plugin_service_version"
+
 	// PluginType tags type of some plugin
 	PluginType = "plugin_type"
 
@@ -255,6 +299,9 @@ const (
 	// RegistrationEntry tags a registration entry
 	RegistrationEntry = "registration_entry"
 
+	// RequiredVersion tags the version of some definition required by SPIRE
+	RequiredVersion = "required_version"
+
 	// ResourceNames tags some group of resources by name
 	ResourceNames = "resource_names"
 
@@ -298,6 +345,9 @@ const (
 	// Status tags status of call (OK, or some error), or status of some process
 	Status = "status"
 
+	// StatusMessage tags the message of a call status
+	StatusMessage = "status_message"
+
 	// Subject tags some subject (likely a SPIFFE ID, and likely for a token); should be used
 	// with other tags to add clarity
 	Subject = "subject"
@@ -314,10 +364,20 @@ const (
 	// SVIDUpdated tags that for some entity the SVID was updated
 	SVIDUpdated = "svid_updated"
 
+	// Threshold tags some threshold a value is compared against
+	Threshold = "threshold"
+
+	// Timeout tags some timeout duration
+	Timeout = "timeout"
+
 	// TTL functionality related to a time-to-live field; should be used
 	// with other tags to add clarity
 	TTL = "ttl"
 
+	// TrustDomain tags the trust domain of the server or agent emitting the
+	// log record
+	TrustDomain = "trust_domain"
+
 	// TrustDomainID tags some trust domain ID
 	TrustDomainID = "trust_domain_id"
 
@@ -361,6 +421,9 @@ const (
 	// BundleManager functionality related to a Bundle manager
 	BundleManager = "bundle_manager"
 
+	// BundlePublisher functionality related to a bundle publisher plugin
+	BundlePublisher = "bundle_publisher"
+
 	// BundlesUpdate functionality related to updating bundles
 	BundlesUpdate = "bundles_update"
 
@@ -399,10 +462,24 @@ const (
 	// OutdatedSVIDs tags SVID with outdated attributes count/list
 	OutdatedSVIDs = "outdated_svids"
 
+	// WorkloadKeyAge tags the age of the private key of a workload SVID
+	WorkloadKeyAge = "workload_key_age"
+
 	// FederatedBundle functionality related to a federated bundle; should be used
 	// with other tags to add clarity
 	FederatedBundle = "federated_bundle"
 
+	// FederationRelationship functionality related to a federation
+	// relationship; should be used with other tags to add clarity
+	FederationRelationship = "federation_relationship"
+
+	// FeatureFlags tags the names of the enabled feature flags
+	FeatureFlags = "feature_flags"
+
+	// FIPSEnabled tags whether the cryptographic libraries run in FIPS 140
+	// mode
+	FIPSEnabled = "fips_enabled"
+
 	// JoinToken functionality related to a join token; should be used
 	// with other tags to add clarity
 	JoinToken = "join_token"
@@ -436,10 +513,17 @@ const (
 	// to add clarity
 	Notifier = "notifier"
 
+	// Orphaned functionality related to entities whose owner is gone, e.g.
+	// registration entries parented to agents that no longer exist
+	Orphaned = "orphaned"
+
 	// ServerCA functionality related to a server CA; should be used with other tags
 	// to add clarity
 	ServerCA = "server_ca"
 
+	// Service tags the (shortened) name of an RPC service (e.g. svid.v1.SVID)
+	Service = "service"
+
 	// SpireAgent typically the entire spire agent service
 	SpireAgent = "spire_agent"
 
@@ -453,12 +537,25 @@ const (
 	// SVIDRotator functionality related to a SVID rotator
 	SVIDRotator = "svid_rotator"
 
+	// SVIDStore functionality related to storing SVIDs through SVIDStore plugins
+	SVIDStore = "svid_store"
+
 	// RegistrationManager functionality related to a registration manager
 	RegistrationManager = "registration_manager"
 
+	// RotationHooks functionality related to the commands run on rotations
+	RotationHooks = "rotation_hooks"
+
+	// RPC functionality related to an RPC call served by the server or agent
+	RPC = "rpc"
+
 	// Telemetry tags a telemetry module
 	Telemetry = "telemetry"
 
+	// Tombstone functionality related to the record of a deleted entity;
+	// should be used with other tags to add clarity
+	Tombstone = "tombstone"
+
 	// X509CA functionality related to an x509 CA; should be used with other tags
 	// to add clarity
 	X509CA = "x509_ca"
@@ -29,11 +29,22 @@ func newPrometheusRunner(c *MetricsConfig) (sinkRunner, error) {
 		return runner, nil
 	}
 
-	var err error
-	runner.sink, err = prommetrics.NewPrometheusSink()
+	sink, err := prommetrics.NewPrometheusSink()
 	if err != nil {
-		return runner, err
+		// The sink registers itself with the default registry, which only
+		// accepts one sink per process. Reuse the registered sink so metrics
+		// can be set up again (e.g. when the configuration is reloaded).
+		are, ok := err.(prometheus.AlreadyRegisteredError)
+		if !ok {
+			return runner, err
+		}
+		existing, ok := are.ExistingCollector.(*prommetrics.PrometheusSink)
+		if !ok {
+			return runner, err
+		}
+		sink = existing
 	}
+	runner.sink = sink
 
 	handlerOpts := promhttp.HandlerOpts{
 		ErrorLog: runner.log,
@@ -45,7 +56,7 @@ func newPrometheusRunner(c *MetricsConfig) (sinkRunner, error) {
 	}
 
 	if runner.c.Host != "localhost" {
-		runner.log.Warnf("Agent is now configured to accept remote network connections for Prometheus stats collection. Please ensure access to this port is tightly controlled")
+		runner.log.Warnf("%s is now configured to accept remote network connections for Prometheus stats collection. Please ensure access to this port is tightly controlled", serviceDisplayName(c.ServiceName))
 	}
 
 	runner.server = &http.Server{
@@ -97,3 +108,16 @@ func (p *prometheusRunner) run(ctx context.Context) error {
 func (p *prometheusRunner) requiresTypePrefix() bool {
 	return false
 }
+
+// serviceDisplayName returns the name used to refer to the service in log
+// messages (e.g. "spire_server" becomes "Server")
+func serviceDisplayName(serviceName string) string {
+	switch serviceName {
+	case SpireAgent:
+		return "Agent"
+	case SpireServer:
+		return "Server"
+	default:
+		return "Service"
+	}
+}
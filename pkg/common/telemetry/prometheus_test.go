@@ -25,6 +25,20 @@ func TestNewPrometheusRunner(t *testing.T) {
 	assert.NotNil(t, pr)
 }
 
+func TestNewPrometheusRunnerReusesRegisteredSink(t *testing.T) {
+	config := testPrometheusConfig()
+	pr1, err := newPrometheusRunner(config)
+	require.NoError(t, err)
+	sink := pr1.(*prometheusRunner).sink.(*prommetrics.PrometheusSink)
+	defer prometheus.Unregister(sink)
+
+	// Creating the runner again does not fail with a duplicate registration
+	// error and uses the sink that is already registered
+	pr2, err := newPrometheusRunner(testPrometheusConfig())
+	require.NoError(t, err)
+	assert.Same(t, sink, pr2.(*prometheusRunner).sink)
+}
+
 func TestIsConfigured(t *testing.T) {
 	config := testPrometheusConfig()
 
@@ -18,4 +18,32 @@ func IncrBundleManagerUpdateFederatedBundleCounter(m telemetry.Metrics, trustDom
 	})
 }
 
+// IncrBundleManagerUpdateFederatedBundleErrorCounter indicate
+// the number of failed attempts to update a federated bundle by bundle manager
+func IncrBundleManagerUpdateFederatedBundleErrorCounter(m telemetry.Metrics, trustDomain string) {
+	m.IncrCounterWithLabels([]string{
+		telemetry.BundleManager,
+		telemetry.Update,
+		telemetry.FederatedBundle,
+		telemetry.Error,
+	}, 1, []telemetry.Label{
+		{Name: telemetry.TrustDomainID, Value: trustDomain},
+	})
+}
+
 // End Counters
+
+// Gauge (remember previous value set)
+
+// SetBundleManagerConsecutiveFailuresGauge set gauge for the number of
+// consecutive failed attempts to update a federated bundle by bundle manager
+func SetBundleManagerConsecutiveFailuresGauge(m telemetry.Metrics, trustDomain string, val float32) {
+	m.SetGaugeWithLabels(
+		[]string{telemetry.BundleManager, telemetry.FederatedBundle, telemetry.ConsecutiveFailures},
+		val,
+		[]telemetry.Label{
+			{Name: telemetry.TrustDomainID, Value: trustDomain},
+		})
+}
+
+// End Gauge
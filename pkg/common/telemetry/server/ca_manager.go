@@ -68,6 +68,18 @@ func IncrServerCASignJWTSVIDCounter(m telemetry.Metrics) {
 	m.IncrCounter([]string{telemetry.ServerCA, telemetry.Sign, telemetry.JWTSVID}, 1)
 }
 
+// IncrServerCAClampJWTSVIDTTLCounter indicate Server CA
+// clamped the TTL of a JWT SVID to the configured maximum.
+func IncrServerCAClampJWTSVIDTTLCounter(m telemetry.Metrics) {
+	m.IncrCounter([]string{telemetry.ServerCA, telemetry.JWTSVID, telemetry.TTL, telemetry.Clamp}, 1)
+}
+
+// IncrServerCAClampX509SVIDTTLCounter indicate Server CA
+// clamped the TTL of an X509 SVID to the remaining lifetime of the CA.
+func IncrServerCAClampX509SVIDTTLCounter(m telemetry.Metrics) {
+	m.IncrCounter([]string{telemetry.ServerCA, telemetry.X509SVID, telemetry.TTL, telemetry.Clamp}, 1)
+}
+
 // IncrServerCASignX509CACounter indicate Server CA
 // signed an X509 CA SVID.
 func IncrServerCASignX509CACounter(m telemetry.Metrics) {
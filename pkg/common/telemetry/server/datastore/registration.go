@@ -43,6 +43,18 @@ func StartPruneRegistrationCall(m telemetry.Metrics) *telemetry.CallCounter {
 	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntry, telemetry.Prune)
 }
 
+// StartListRegistrationTombstoneCall return metric
+// for server's datastore, on listing registration tombstones.
+func StartListRegistrationTombstoneCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntry, telemetry.Tombstone, telemetry.List)
+}
+
+// StartPruneRegistrationTombstoneCall return metric
+// for server's datastore, on pruning registration tombstones.
+func StartPruneRegistrationTombstoneCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.Datastore, telemetry.RegistrationEntry, telemetry.Tombstone, telemetry.Prune)
+}
+
 // StartUpdateRegistrationCall return metric
 // for server's datastore, on updating a registration.
 func StartUpdateRegistrationCall(m telemetry.Metrics) *telemetry.CallCounter {
@@ -37,6 +37,12 @@ func (w metricsWrapper) CreateBundle(ctx context.Context, req *datastore.CreateB
 	return w.ds.CreateBundle(ctx, req)
 }
 
+func (w metricsWrapper) CreateFederationRelationship(ctx context.Context, req *datastore.CreateFederationRelationshipRequest) (_ *datastore.CreateFederationRelationshipResponse, err error) {
+	callCounter := StartCreateFederationRelationshipCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.CreateFederationRelationship(ctx, req)
+}
+
 func (w metricsWrapper) CreateJoinToken(ctx context.Context, req *datastore.CreateJoinTokenRequest) (_ *datastore.CreateJoinTokenResponse, err error) {
 	callCounter := StartCreateJoinTokenCall(w.m)
 	defer callCounter.Done(&err)
@@ -61,6 +67,12 @@ func (w metricsWrapper) DeleteBundle(ctx context.Context, req *datastore.DeleteB
 	return w.ds.DeleteBundle(ctx, req)
 }
 
+func (w metricsWrapper) DeleteFederationRelationship(ctx context.Context, req *datastore.DeleteFederationRelationshipRequest) (_ *datastore.DeleteFederationRelationshipResponse, err error) {
+	callCounter := StartDeleteFederationRelationshipCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.DeleteFederationRelationship(ctx, req)
+}
+
 func (w metricsWrapper) DeleteJoinToken(ctx context.Context, req *datastore.DeleteJoinTokenRequest) (_ *datastore.DeleteJoinTokenResponse, err error) {
 	callCounter := StartDeleteJoinTokenCall(w.m)
 	defer callCounter.Done(&err)
@@ -85,6 +97,12 @@ func (w metricsWrapper) FetchBundle(ctx context.Context, req *datastore.FetchBun
 	return w.ds.FetchBundle(ctx, req)
 }
 
+func (w metricsWrapper) FetchFederationRelationship(ctx context.Context, req *datastore.FetchFederationRelationshipRequest) (_ *datastore.FetchFederationRelationshipResponse, err error) {
+	callCounter := StartFetchFederationRelationshipCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.FetchFederationRelationship(ctx, req)
+}
+
 func (w metricsWrapper) FetchJoinToken(ctx context.Context, req *datastore.FetchJoinTokenRequest) (_ *datastore.FetchJoinTokenResponse, err error) {
 	callCounter := StartFetchJoinTokenCall(w.m)
 	defer callCounter.Done(&err)
@@ -115,6 +133,18 @@ func (w metricsWrapper) ListBundles(ctx context.Context, req *datastore.ListBund
 	return w.ds.ListBundles(ctx, req)
 }
 
+func (w metricsWrapper) ListFederationRelationships(ctx context.Context, req *datastore.ListFederationRelationshipsRequest) (_ *datastore.ListFederationRelationshipsResponse, err error) {
+	callCounter := StartListFederationRelationshipsCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.ListFederationRelationships(ctx, req)
+}
+
+func (w metricsWrapper) ListJoinTokens(ctx context.Context, req *datastore.ListJoinTokensRequest) (_ *datastore.ListJoinTokensResponse, err error) {
+	callCounter := StartListJoinTokensCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.ListJoinTokens(ctx, req)
+}
+
 func (w metricsWrapper) ListNodeSelectors(ctx context.Context, req *datastore.ListNodeSelectorsRequest) (_ *datastore.ListNodeSelectorsResponse, err error) {
 	callCounter := StartListNodeSelectorsCall(w.m)
 	defer callCounter.Done(&err)
@@ -127,6 +157,12 @@ func (w metricsWrapper) ListRegistrationEntries(ctx context.Context, req *datast
 	return w.ds.ListRegistrationEntries(ctx, req)
 }
 
+func (w metricsWrapper) ListRegistrationEntryTombstones(ctx context.Context, req *datastore.ListRegistrationEntryTombstonesRequest) (_ *datastore.ListRegistrationEntryTombstonesResponse, err error) {
+	callCounter := StartListRegistrationTombstoneCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.ListRegistrationEntryTombstones(ctx, req)
+}
+
 func (w metricsWrapper) CountAttestedNodes(ctx context.Context, req *datastore.CountAttestedNodesRequest) (_ *datastore.CountAttestedNodesResponse, err error) {
 	callCounter := StartCountNodeCall(w.m)
 	defer callCounter.Done(&err)
@@ -163,6 +199,12 @@ func (w metricsWrapper) PruneRegistrationEntries(ctx context.Context, req *datas
 	return w.ds.PruneRegistrationEntries(ctx, req)
 }
 
+func (w metricsWrapper) PruneRegistrationEntryTombstones(ctx context.Context, req *datastore.PruneRegistrationEntryTombstonesRequest) (_ *datastore.PruneRegistrationEntryTombstonesResponse, err error) {
+	callCounter := StartPruneRegistrationTombstoneCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.PruneRegistrationEntryTombstones(ctx, req)
+}
+
 func (w metricsWrapper) SetBundle(ctx context.Context, req *datastore.SetBundleRequest) (_ *datastore.SetBundleResponse, err error) {
 	callCounter := StartSetBundleCall(w.m)
 	defer callCounter.Done(&err)
@@ -187,6 +229,12 @@ func (w metricsWrapper) UpdateBundle(ctx context.Context, req *datastore.UpdateB
 	return w.ds.UpdateBundle(ctx, req)
 }
 
+func (w metricsWrapper) UpdateFederationRelationship(ctx context.Context, req *datastore.UpdateFederationRelationshipRequest) (_ *datastore.UpdateFederationRelationshipResponse, err error) {
+	callCounter := StartUpdateFederationRelationshipCall(w.m)
+	defer callCounter.Done(&err)
+	return w.ds.UpdateFederationRelationship(ctx, req)
+}
+
 func (w metricsWrapper) UpdateRegistrationEntry(ctx context.Context, req *datastore.UpdateRegistrationEntryRequest) (_ *datastore.UpdateRegistrationEntryResponse, err error) {
 	callCounter := StartUpdateRegistrationCall(w.m)
 	defer callCounter.Done(&err)
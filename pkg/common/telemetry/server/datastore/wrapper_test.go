@@ -57,6 +57,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.create",
 			methodName: "CreateBundle",
 		},
+		{
+			key:        "datastore.federation_relationship.create",
+			methodName: "CreateFederationRelationship",
+		},
 		{
 			key:        "datastore.join_token.create",
 			methodName: "CreateJoinToken",
@@ -73,6 +77,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.delete",
 			methodName: "DeleteBundle",
 		},
+		{
+			key:        "datastore.federation_relationship.delete",
+			methodName: "DeleteFederationRelationship",
+		},
 		{
 			key:        "datastore.join_token.delete",
 			methodName: "DeleteJoinToken",
@@ -89,6 +97,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.fetch",
 			methodName: "FetchBundle",
 		},
+		{
+			key:        "datastore.federation_relationship.fetch",
+			methodName: "FetchFederationRelationship",
+		},
 		{
 			key:        "datastore.join_token.fetch",
 			methodName: "FetchJoinToken",
@@ -109,6 +121,14 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.list",
 			methodName: "ListBundles",
 		},
+		{
+			key:        "datastore.federation_relationship.list",
+			methodName: "ListFederationRelationships",
+		},
+		{
+			key:        "datastore.join_token.list",
+			methodName: "ListJoinTokens",
+		},
 		{
 			key:        "datastore.node.selectors.list",
 			methodName: "ListNodeSelectors",
@@ -117,6 +137,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.registration_entry.list",
 			methodName: "ListRegistrationEntries",
 		},
+		{
+			key:        "datastore.registration_entry.tombstone.list",
+			methodName: "ListRegistrationEntryTombstones",
+		},
 		{
 			key:        "datastore.bundle.prune",
 			methodName: "PruneBundle",
@@ -129,6 +153,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.registration_entry.prune",
 			methodName: "PruneRegistrationEntries",
 		},
+		{
+			key:        "datastore.registration_entry.tombstone.prune",
+			methodName: "PruneRegistrationEntryTombstones",
+		},
 		{
 			key:        "datastore.bundle.set",
 			methodName: "SetBundle",
@@ -145,6 +173,10 @@ func TestWithMetrics(t *testing.T) {
 			key:        "datastore.bundle.update",
 			methodName: "UpdateBundle",
 		},
+		{
+			key:        "datastore.federation_relationship.update",
+			methodName: "UpdateFederationRelationship",
+		},
 		{
 			key:        "datastore.registration_entry.update",
 			methodName: "UpdateRegistrationEntry",
@@ -216,6 +248,26 @@ type fakeDataStore struct {
 	err error
 }
 
+func (ds *fakeDataStore) CreateFederationRelationship(context.Context, *datastore.CreateFederationRelationshipRequest) (*datastore.CreateFederationRelationshipResponse, error) {
+	return &datastore.CreateFederationRelationshipResponse{}, ds.err
+}
+
+func (ds *fakeDataStore) DeleteFederationRelationship(context.Context, *datastore.DeleteFederationRelationshipRequest) (*datastore.DeleteFederationRelationshipResponse, error) {
+	return &datastore.DeleteFederationRelationshipResponse{}, ds.err
+}
+
+func (ds *fakeDataStore) FetchFederationRelationship(context.Context, *datastore.FetchFederationRelationshipRequest) (*datastore.FetchFederationRelationshipResponse, error) {
+	return &datastore.FetchFederationRelationshipResponse{}, ds.err
+}
+
+func (ds *fakeDataStore) ListFederationRelationships(context.Context, *datastore.ListFederationRelationshipsRequest) (*datastore.ListFederationRelationshipsResponse, error) {
+	return &datastore.ListFederationRelationshipsResponse{}, ds.err
+}
+
+func (ds *fakeDataStore) ListJoinTokens(context.Context, *datastore.ListJoinTokensRequest) (*datastore.ListJoinTokensResponse, error) {
+	return &datastore.ListJoinTokensResponse{}, ds.err
+}
+
 func (ds *fakeDataStore) SetError(err error) {
 	ds.err = err
 }
@@ -304,6 +356,10 @@ func (ds *fakeDataStore) ListRegistrationEntries(context.Context, *datastore.Lis
 	return &datastore.ListRegistrationEntriesResponse{}, ds.err
 }
 
+func (ds *fakeDataStore) ListRegistrationEntryTombstones(context.Context, *datastore.ListRegistrationEntryTombstonesRequest) (*datastore.ListRegistrationEntryTombstonesResponse, error) {
+	return &datastore.ListRegistrationEntryTombstonesResponse{}, ds.err
+}
+
 func (ds *fakeDataStore) PruneBundle(context.Context, *datastore.PruneBundleRequest) (*datastore.PruneBundleResponse, error) {
 	return &datastore.PruneBundleResponse{}, ds.err
 }
@@ -316,6 +372,10 @@ func (ds *fakeDataStore) PruneRegistrationEntries(context.Context, *datastore.Pr
 	return &datastore.PruneRegistrationEntriesResponse{}, ds.err
 }
 
+func (ds *fakeDataStore) PruneRegistrationEntryTombstones(context.Context, *datastore.PruneRegistrationEntryTombstonesRequest) (*datastore.PruneRegistrationEntryTombstonesResponse, error) {
+	return &datastore.PruneRegistrationEntryTombstonesResponse{}, ds.err
+}
+
 func (ds *fakeDataStore) SetBundle(context.Context, *datastore.SetBundleRequest) (*datastore.SetBundleResponse, error) {
 	return &datastore.SetBundleResponse{}, ds.err
 }
@@ -332,6 +392,10 @@ func (ds *fakeDataStore) UpdateBundle(context.Context, *datastore.UpdateBundleRe
 	return &datastore.UpdateBundleResponse{}, ds.err
 }
 
+func (ds *fakeDataStore) UpdateFederationRelationship(context.Context, *datastore.UpdateFederationRelationshipRequest) (*datastore.UpdateFederationRelationshipResponse, error) {
+	return &datastore.UpdateFederationRelationshipResponse{}, ds.err
+}
+
 func (ds *fakeDataStore) UpdateRegistrationEntry(context.Context, *datastore.UpdateRegistrationEntryRequest) (*datastore.UpdateRegistrationEntryResponse, error) {
 	return &datastore.UpdateRegistrationEntryResponse{}, ds.err
 }
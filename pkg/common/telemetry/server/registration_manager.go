@@ -11,4 +11,44 @@ func StartRegistrationManagerPruneEntryCall(m telemetry.Metrics) *telemetry.Call
 	return telemetry.StartCall(m, telemetry.RegistrationEntry, telemetry.Manager, telemetry.Prune)
 }
 
+// StartRegistrationManagerPruneTombstoneCall returns metric for
+// for server registration manager entry tombstone pruning
+func StartRegistrationManagerPruneTombstoneCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.RegistrationEntry, telemetry.Tombstone, telemetry.Manager, telemetry.Prune)
+}
+
+// StartRegistrationManagerPruneOrphanedEntryCall returns metric for
+// for server registration manager orphaned entry pruning
+func StartRegistrationManagerPruneOrphanedEntryCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.RegistrationEntry, telemetry.Orphaned, telemetry.Manager, telemetry.Prune)
+}
+
+// StartRegistrationManagerPruneJoinTokenCall returns metric for
+// for server registration manager join token pruning
+func StartRegistrationManagerPruneJoinTokenCall(m telemetry.Metrics) *telemetry.CallCounter {
+	return telemetry.StartCall(m, telemetry.JoinToken, telemetry.Manager, telemetry.Prune)
+}
+
 // End Call Counters
+
+// Counters (literal increments, not call counters)
+
+// AddRegistrationManagerPrunedEntryCounter adds the number of expired
+// entries pruned by the server registration manager
+func AddRegistrationManagerPrunedEntryCounter(m telemetry.Metrics, count float32) {
+	m.IncrCounter([]string{telemetry.RegistrationEntry, telemetry.Manager, telemetry.Pruned}, count)
+}
+
+// AddRegistrationManagerPrunedOrphanedEntryCounter adds the number of
+// orphaned entries pruned by the server registration manager
+func AddRegistrationManagerPrunedOrphanedEntryCounter(m telemetry.Metrics, count float32) {
+	m.IncrCounter([]string{telemetry.RegistrationEntry, telemetry.Orphaned, telemetry.Manager, telemetry.Pruned}, count)
+}
+
+// AddRegistrationManagerPrunedJoinTokenCounter adds the number of expired
+// join tokens pruned by the server registration manager
+func AddRegistrationManagerPrunedJoinTokenCounter(m telemetry.Metrics, count float32) {
+	m.IncrCounter([]string{telemetry.JoinToken, telemetry.Manager, telemetry.Pruned}, count)
+}
+
+// End Counters
@@ -1,6 +1,8 @@
 package telemetry
 
 import (
+	"runtime"
+
 	"github.com/spiffe/spire/pkg/common/version"
 )
 
@@ -9,3 +11,14 @@ func EmitVersion(m Metrics) {
 		{Name: "version", Value: version.Version()},
 	})
 }
+
+// EmitBuildInfo sets the build_info gauge to 1, labeled with the version, git
+// hash and Go version the binary was built with, so that version skew across
+// a fleet shows up in metrics.
+func EmitBuildInfo(m Metrics) {
+	m.SetGaugeWithLabels([]string{"build_info"}, 1, []Label{
+		{Name: "version", Value: version.Version()},
+		{Name: "git_hash", Value: version.GitHash()},
+		{Name: "go_version", Value: runtime.Version()},
+	})
+}
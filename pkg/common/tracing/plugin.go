@@ -0,0 +1,53 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+// pluginNameKey is the span attribute holding the name of the plugin invoked
+const pluginNameKey = attribute.Key("spire.plugin_name")
+
+// WrapPluginConn returns a connection that creates a client span for each
+// unary call made to the named plugin over the given connection. The trace
+// context is propagated to the plugin in the outgoing gRPC metadata.
+func WrapPluginConn(cc grpc.ClientConnInterface, pluginName string) grpc.ClientConnInterface {
+	return pluginConn{
+		ClientConnInterface: cc,
+		pluginName:          pluginName,
+	}
+}
+
+type pluginConn struct {
+	grpc.ClientConnInterface
+	pluginName string
+}
+
+func (c pluginConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	ctx, span := Tracer().Start(ctx, spanName(method),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			pluginNameKey.String(c.pluginName),
+		),
+	)
+
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	ctx = metadata.NewOutgoingContext(ctx, md)
+
+	err := c.ClientConnInterface.Invoke(ctx, method, args, reply, opts...)
+	endSpan(span, err)
+	return err
+}
@@ -0,0 +1,151 @@
+// Package tracing configures OpenTelemetry tracing and provides the helpers
+// used to trace API calls and plugin invocations.
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp"
+	"go.opentelemetry.io/otel/exporters/otlp/otlpgrpc"
+	"go.opentelemetry.io/otel/propagation"
+	sdkresource "go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/semconv"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	instrumentationName = "github.com/spiffe/spire"
+
+	// grpcStatusCodeKey is the span attribute holding the gRPC status code
+	grpcStatusCodeKey = attribute.Key("rpc.grpc.status_code")
+)
+
+// Setup configures the global tracer provider to export spans to the OTLP
+// collector described by the configuration. It returns a function that
+// flushes any pending spans and stops the exporter. If the configuration is
+// nil, tracing stays disabled and spans are not recorded.
+func Setup(ctx context.Context, c *telemetry.TracingConfig, serviceName string, log logrus.FieldLogger) (func(context.Context) error, error) {
+	if c == nil {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	if c.Address == "" {
+		return nil, errors.New("tracing address is required")
+	}
+	sampleRatio := c.SampleRatio
+	switch {
+	case sampleRatio == 0:
+		sampleRatio = 1
+	case sampleRatio < 0 || sampleRatio > 1:
+		return nil, fmt.Errorf("tracing sample_ratio must be between 0 and 1; got %v", sampleRatio)
+	}
+
+	driverOpts := []otlpgrpc.Option{otlpgrpc.WithEndpoint(c.Address)}
+	if c.Insecure {
+		driverOpts = append(driverOpts, otlpgrpc.WithInsecure())
+	}
+	exporter, err := otlp.NewExporter(ctx, otlpgrpc.NewDriver(driverOpts...))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create OTLP exporter: %w", err)
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithConfig(sdktrace.Config{
+			DefaultSampler: sdktrace.ParentBased(sdktrace.TraceIDRatioBased(sampleRatio)),
+		}),
+		sdktrace.WithResource(sdkresource.NewWithAttributes(
+			semconv.ServiceNameKey.String(serviceName),
+		)),
+	)
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	log.WithFields(logrus.Fields{
+		telemetry.Address: c.Address,
+		"sample_ratio":    sampleRatio,
+	}).Info("Tracing enabled")
+
+	return provider.Shutdown, nil
+}
+
+// Tracer returns the tracer used for SPIRE spans
+func Tracer() trace.Tracer {
+	return otel.Tracer(instrumentationName)
+}
+
+// StartRPCSpan starts a server span for an RPC call, continuing the trace
+// propagated in the incoming gRPC metadata, if any. The span is ended with
+// EndSpan.
+func StartRPCSpan(ctx context.Context, fullMethod, service, method string) context.Context {
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+	}
+	ctx, _ = Tracer().Start(ctx, spanName(fullMethod),
+		trace.WithSpanKind(trace.SpanKindServer),
+		trace.WithAttributes(
+			semconv.RPCSystemGRPC,
+			semconv.RPCServiceKey.String(service),
+			semconv.RPCMethodKey.String(method),
+		),
+	)
+	return ctx
+}
+
+// EndSpan records the outcome of the call on the span in the context and
+// ends it.
+func EndSpan(ctx context.Context, err error) {
+	endSpan(trace.SpanFromContext(ctx), err)
+}
+
+func endSpan(span trace.Span, err error) {
+	st := status.Convert(err)
+	span.SetAttributes(grpcStatusCodeKey.Int(int(st.Code())))
+	if err != nil {
+		span.SetStatus(otelcodes.Error, st.Message())
+	}
+	span.End()
+}
+
+// spanName returns the span name for a gRPC full method, following the
+// "package.Service/Method" convention.
+func spanName(fullMethod string) string {
+	if len(fullMethod) > 0 && fullMethod[0] == '/' {
+		return fullMethod[1:]
+	}
+	return fullMethod
+}
+
+// metadataCarrier adapts gRPC metadata to the propagation.TextMapCarrier
+// interface
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for key := range c {
+		keys = append(keys, key)
+	}
+	return keys
+}
@@ -0,0 +1,131 @@
+package tracing
+
+import (
+	"context"
+	"sync"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	otelcodes "go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	exporttrace "go.opentelemetry.io/otel/sdk/export/trace"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	traceID     = "4bf92f3577b34da6a3ce929d0e0e4736"
+	traceParent = "00-" + traceID + "-00f067aa0ba902b7-01"
+)
+
+func TestSetup(t *testing.T) {
+	log, _ := test.NewNullLogger()
+
+	// Tracing is disabled when not configured
+	stop, err := Setup(context.Background(), nil, telemetry.SpireServer, log)
+	require.NoError(t, err)
+	assert.NoError(t, stop(context.Background()))
+
+	_, err = Setup(context.Background(), &telemetry.TracingConfig{}, telemetry.SpireServer, log)
+	assert.EqualError(t, err, "tracing address is required")
+
+	_, err = Setup(context.Background(), &telemetry.TracingConfig{
+		Address:     "localhost:4317",
+		SampleRatio: 1.5,
+	}, telemetry.SpireServer, log)
+	assert.EqualError(t, err, "tracing sample_ratio must be between 0 and 1; got 1.5")
+}
+
+func TestRPCSpan(t *testing.T) {
+	exporter := setupTestProvider(t)
+
+	ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("traceparent", traceParent))
+	ctx = StartRPCSpan(ctx, "/spire.api.server.svid.v1.SVID/MintX509SVID", "spire.api.server.svid.v1.SVID", "MintX509SVID")
+	EndSpan(ctx, status.Error(codes.PermissionDenied, "denied"))
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "spire.api.server.svid.v1.SVID/MintX509SVID", span.Name)
+	assert.Equal(t, trace.SpanKindServer, span.SpanKind)
+	assert.Equal(t, traceID, span.SpanContext.TraceID.String())
+	assert.True(t, span.HasRemoteParent)
+	assert.Equal(t, otelcodes.Error, span.StatusCode)
+	assert.Equal(t, "denied", span.StatusMessage)
+	assert.Contains(t, span.Attributes, attribute.String("rpc.method", "MintX509SVID"))
+	assert.Contains(t, span.Attributes, attribute.Int("rpc.grpc.status_code", int(codes.PermissionDenied)))
+}
+
+func TestWrapPluginConn(t *testing.T) {
+	exporter := setupTestProvider(t)
+
+	conn := &fakeConn{}
+	cc := WrapPluginConn(conn, "disk")
+	err := cc.Invoke(context.Background(), "/spire.server.keymanager.KeyManager/GetPublicKey", nil, nil)
+	require.NoError(t, err)
+
+	spans := exporter.Spans()
+	require.Len(t, spans, 1)
+	span := spans[0]
+	assert.Equal(t, "spire.server.keymanager.KeyManager/GetPublicKey", span.Name)
+	assert.Equal(t, trace.SpanKindClient, span.SpanKind)
+	assert.Equal(t, otelcodes.Unset, span.StatusCode)
+	assert.Contains(t, span.Attributes, attribute.String("spire.plugin_name", "disk"))
+
+	// The trace context is propagated to the plugin
+	require.Len(t, conn.md.Get("traceparent"), 1)
+	assert.Contains(t, conn.md.Get("traceparent")[0], span.SpanContext.TraceID.String())
+}
+
+func setupTestProvider(t *testing.T) *fakeExporter {
+	exporter := &fakeExporter{}
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSyncer(exporter))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	return exporter
+}
+
+type fakeExporter struct {
+	mtx   sync.Mutex
+	spans []*exporttrace.SpanSnapshot
+}
+
+func (e *fakeExporter) ExportSpans(ctx context.Context, spans []*exporttrace.SpanSnapshot) error {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	e.spans = append(e.spans, spans...)
+	return nil
+}
+
+func (e *fakeExporter) Shutdown(ctx context.Context) error {
+	return nil
+}
+
+func (e *fakeExporter) Spans() []*exporttrace.SpanSnapshot {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	return e.spans
+}
+
+type fakeConn struct {
+	grpc.ClientConnInterface
+	md metadata.MD
+}
+
+func (c *fakeConn) Invoke(ctx context.Context, method string, args interface{}, reply interface{}, opts ...grpc.CallOption) error {
+	c.md, _ = metadata.FromOutgoingContext(ctx)
+	return nil
+}
@@ -1,9 +1,41 @@
 package uptime
 
-import "time"
+import (
+	"context"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+)
+
+// reportInterval is how often the uptime and build info are emitted. Gauges
+// are emitted again periodically so sinks that expire stale gauges keep them.
+const reportInterval = 10 * time.Second
 
 var start = time.Now()
 
 func Uptime() time.Duration {
 	return time.Since(start)
 }
+
+// ReportMetrics emits the uptime_in_ms and build_info gauges every
+// reportInterval until the context is done.
+func ReportMetrics(ctx context.Context, metrics telemetry.Metrics) error {
+	return reportMetrics(ctx, clock.New(), Uptime, metrics)
+}
+
+func reportMetrics(ctx context.Context, clk clock.Clock, uptime func() time.Duration, metrics telemetry.Metrics) error {
+	ticker := clk.Ticker(reportInterval)
+	defer ticker.Stop()
+
+	for {
+		metrics.SetGauge([]string{"uptime_in_ms"}, float32(uptime()/time.Millisecond))
+		telemetry.EmitBuildInfo(metrics)
+
+		select {
+		case <-ticker.C:
+		case <-ctx.Done():
+			return nil
+		}
+	}
+}
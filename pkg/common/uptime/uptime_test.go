@@ -0,0 +1,62 @@
+package uptime
+
+import (
+	"context"
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/common/version"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReportMetrics(t *testing.T) {
+	clk := clock.NewMock(t)
+	metrics := fakemetrics.New()
+	uptime := 5 * time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- reportMetrics(ctx, clk, func() time.Duration { return uptime }, metrics)
+	}()
+
+	clk.WaitForTicker(time.Minute, "waiting for the report ticker")
+	require.Eventually(t, func() bool {
+		return len(metrics.AllMetrics()) == 2
+	}, time.Minute, 10*time.Millisecond)
+
+	buildInfo := fakemetrics.MetricItem{
+		Type: fakemetrics.SetGaugeWithLabelsType,
+		Key:  []string{"build_info"},
+		Val:  1,
+		Labels: telemetry.SanitizeLabels([]telemetry.Label{
+			{Name: "version", Value: version.Version()},
+			{Name: "git_hash", Value: version.GitHash()},
+			{Name: "go_version", Value: runtime.Version()},
+		}),
+	}
+	assert.Equal(t, []fakemetrics.MetricItem{
+		{Type: fakemetrics.SetGaugeType, Key: []string{"uptime_in_ms"}, Val: 5000},
+		buildInfo,
+	}, metrics.AllMetrics())
+
+	// The gauges are emitted again on the next tick
+	metrics.Reset()
+	uptime += reportInterval
+	clk.Add(reportInterval)
+	require.Eventually(t, func() bool {
+		return len(metrics.AllMetrics()) == 2
+	}, time.Minute, 10*time.Millisecond)
+	assert.Equal(t, []fakemetrics.MetricItem{
+		{Type: fakemetrics.SetGaugeType, Key: []string{"uptime_in_ms"}, Val: 15000},
+		buildInfo,
+	}, metrics.AllMetrics())
+
+	cancel()
+	require.NoError(t, <-done)
+}
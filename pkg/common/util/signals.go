@@ -20,3 +20,22 @@ func SignalListener(ctx context.Context, cancel func()) {
 		}
 	}()
 }
+
+// ReloadListener calls reload each time the process receives a SIGHUP, until
+// the context is done.
+func ReloadListener(ctx context.Context, reload func()) {
+	go func() {
+		signalCh := make(chan os.Signal, 1)
+		signal.Notify(signalCh, syscall.SIGHUP)
+		defer signal.Stop(signalCh)
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-signalCh:
+				reload()
+			}
+		}
+	}()
+}
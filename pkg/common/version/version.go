@@ -22,3 +22,9 @@ func Version() string {
 	}
 	return gittag
 }
+
+// GitHash returns the hash of the commit the binary was built from, or "unk"
+// if it was not set at build time.
+func GitHash() string {
+	return githash
+}
@@ -0,0 +1,176 @@
+// Package winsvc integrates the SPIRE server and agent with the Windows
+// service control manager (SCM). On other platforms, programs run as usual
+// and the service commands fail.
+package winsvc
+
+import (
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/mitchellh/cli"
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+)
+
+// ServiceConfig describes how a program is installed as a service.
+type ServiceConfig struct {
+	// Name is the name of the service, which is also the source of the
+	// records it writes to the event log
+	Name string
+
+	// DisplayName is the name of the service shown to users
+	DisplayName string
+
+	// Description describes the service
+	Description string
+
+	// ExePath is the path of the program executable
+	ExePath string
+
+	// Args are the arguments the program is started with by the SCM
+	Args []string
+
+	// Manual is true if the service is started on demand instead of when
+	// the system boots
+	Manual bool
+}
+
+// NewInstallCommand returns a command that installs the program as a Windows
+// service that executes the run command. The arguments following the flags
+// and a "--" separator are passed to the run command (e.g.
+// "service install -- -config C:\spire\server.conf").
+func NewInstallCommand(defaults ServiceConfig) cli.Command {
+	return newInstallCommand(common_cli.DefaultEnv, defaults, install)
+}
+
+func newInstallCommand(env *common_cli.Env, defaults ServiceConfig, install func(ServiceConfig) error) *installCommand {
+	return &installCommand{
+		env:      env,
+		defaults: defaults,
+		install:  install,
+	}
+}
+
+type installCommand struct {
+	env      *common_cli.Env
+	defaults ServiceConfig
+	install  func(ServiceConfig) error
+}
+
+func (c *installCommand) Help() string {
+	// ignoring parsing errors since "-h" is always supported by the flags package
+	_, _ = c.parseFlags([]string{"-h"})
+	return ""
+}
+
+func (c *installCommand) Synopsis() string {
+	return "Installs the run command as a Windows service"
+}
+
+func (c *installCommand) Run(args []string) int {
+	config, err := c.parseFlags(args)
+	if err != nil {
+		return 1
+	}
+
+	if config.ExePath == "" {
+		exePath, err := os.Executable()
+		if err != nil {
+			_ = c.env.ErrPrintf("Unable to determine the executable path: %v\n", err)
+			return 1
+		}
+		config.ExePath = exePath
+	}
+	config.ExePath, err = filepath.Abs(config.ExePath)
+	if err != nil {
+		_ = c.env.ErrPrintf("Unable to determine the executable path: %v\n", err)
+		return 1
+	}
+
+	if err := c.install(config); err != nil {
+		_ = c.env.ErrPrintf("Unable to install service %q: %v\n", config.Name, err)
+		return 1
+	}
+	if err := c.env.Printf("Service %q installed.\n", config.Name); err != nil {
+		return 1
+	}
+	return 0
+}
+
+func (c *installCommand) parseFlags(args []string) (ServiceConfig, error) {
+	config := c.defaults
+
+	fs := flag.NewFlagSet("service install", flag.ContinueOnError)
+	fs.SetOutput(c.env.Stderr)
+	fs.StringVar(&config.Name, "name", config.Name, "Name of the service")
+	fs.StringVar(&config.DisplayName, "displayName", config.DisplayName, "Name of the service shown to users")
+	fs.StringVar(&config.Description, "description", config.Description, "Description of the service")
+	fs.StringVar(&config.ExePath, "exePath", config.ExePath, "Path of the executable run by the service. Defaults to the current executable")
+	fs.BoolVar(&config.Manual, "manual", config.Manual, "Start the service on demand instead of when the system boots")
+	if err := fs.Parse(args); err != nil {
+		return ServiceConfig{}, err
+	}
+
+	config.Args = append([]string{"run"}, fs.Args()...)
+	return config, nil
+}
+
+// NewUninstallCommand returns a command that removes a Windows service
+// installed with the install command.
+func NewUninstallCommand(defaultName string) cli.Command {
+	return newUninstallCommand(common_cli.DefaultEnv, defaultName, uninstall)
+}
+
+func newUninstallCommand(env *common_cli.Env, defaultName string, uninstall func(string) error) *uninstallCommand {
+	return &uninstallCommand{
+		env:       env,
+		name:      defaultName,
+		uninstall: uninstall,
+	}
+}
+
+type uninstallCommand struct {
+	env       *common_cli.Env
+	name      string
+	uninstall func(string) error
+}
+
+func (c *uninstallCommand) Help() string {
+	// ignoring parsing errors since "-h" is always supported by the flags package
+	_ = c.parseFlags([]string{"-h"})
+	return ""
+}
+
+func (c *uninstallCommand) Synopsis() string {
+	return "Removes the Windows service"
+}
+
+func (c *uninstallCommand) Run(args []string) int {
+	if err := c.parseFlags(args); err != nil {
+		return 1
+	}
+	if err := c.uninstall(c.name); err != nil {
+		_ = c.env.ErrPrintf("Unable to uninstall service %q: %v\n", c.name, err)
+		return 1
+	}
+	if err := c.env.Printf("Service %q uninstalled.\n", c.name); err != nil {
+		return 1
+	}
+	return 0
+}
+
+func (c *uninstallCommand) parseFlags(args []string) error {
+	fs := flag.NewFlagSet("service uninstall", flag.ContinueOnError)
+	fs.SetOutput(c.env.Stderr)
+	fs.StringVar(&c.name, "name", c.name, "Name of the service")
+	if err := fs.Parse(args); err != nil {
+		return err
+	}
+	if fs.NArg() != 0 {
+		err := fmt.Errorf("unexpected arguments: %q", fs.Args())
+		_ = c.env.ErrPrintln(err)
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,38 @@
+// +build !windows
+
+package winsvc
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spiffe/spire/pkg/common/log"
+)
+
+var errUnsupported = errors.New("services are only supported on Windows")
+
+// IsService returns true if the process was started by the SCM. It is always
+// false on this platform.
+func IsService() bool {
+	return false
+}
+
+// Run calls run. The context passed to run is never canceled.
+func Run(name string, run func(ctx context.Context) int) int {
+	return run(context.Background())
+}
+
+// WithEventLog fails on this platform, which has no Windows event log.
+func WithEventLog(source string) log.Option {
+	return func(*log.Logger) error {
+		return errUnsupported
+	}
+}
+
+func install(ServiceConfig) error {
+	return errUnsupported
+}
+
+func uninstall(string) error {
+	return errUnsupported
+}
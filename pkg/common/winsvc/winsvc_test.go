@@ -0,0 +1,150 @@
+package winsvc
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+
+	common_cli "github.com/spiffe/spire/pkg/common/cli"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestInstallCommand(t *testing.T) {
+	defaults := ServiceConfig{
+		Name:        "spire-test",
+		DisplayName: "SPIRE Test",
+	}
+	exePath, err := os.Executable()
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name         string
+		args         []string
+		installErr   error
+		expectCode   int
+		expectConfig ServiceConfig
+		expectStdout string
+		expectStderr string
+	}{
+		{
+			name:       "defaults",
+			expectCode: 0,
+			expectConfig: ServiceConfig{
+				Name:        "spire-test",
+				DisplayName: "SPIRE Test",
+				ExePath:     exePath,
+				Args:        []string{"run"},
+			},
+			expectStdout: "Service \"spire-test\" installed.\n",
+		},
+		{
+			name: "flags and run arguments",
+			args: []string{
+				"-name", "spire-other",
+				"-displayName", "SPIRE Other",
+				"-description", "Other instance",
+				"-exePath", "/opt/spire/bin/spire-test",
+				"-manual",
+				"--",
+				"-config", "/opt/spire/conf/test.conf",
+			},
+			expectCode: 0,
+			expectConfig: ServiceConfig{
+				Name:        "spire-other",
+				DisplayName: "SPIRE Other",
+				Description: "Other instance",
+				ExePath:     "/opt/spire/bin/spire-test",
+				Args:        []string{"run", "-config", "/opt/spire/conf/test.conf"},
+				Manual:      true,
+			},
+			expectStdout: "Service \"spire-other\" installed.\n",
+		},
+		{
+			name:       "install fails",
+			installErr: errors.New("oh no"),
+			expectCode: 1,
+			expectConfig: ServiceConfig{
+				Name:        "spire-test",
+				DisplayName: "SPIRE Test",
+				ExePath:     exePath,
+				Args:        []string{"run"},
+			},
+			expectStderr: "Unable to install service \"spire-test\": oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+			var installed ServiceConfig
+			cmd := newInstallCommand(&common_cli.Env{Stdout: stdout, Stderr: stderr}, defaults, func(config ServiceConfig) error {
+				installed = config
+				return tt.installErr
+			})
+
+			code := cmd.Run(tt.args)
+			assert.Equal(t, tt.expectCode, code)
+			assert.Equal(t, tt.expectConfig, installed)
+			assert.Equal(t, tt.expectStdout, stdout.String())
+			assert.Equal(t, tt.expectStderr, stderr.String())
+		})
+	}
+}
+
+func TestUninstallCommand(t *testing.T) {
+	for _, tt := range []struct {
+		name         string
+		args         []string
+		uninstallErr error
+		expectCode   int
+		expectName   string
+		expectStdout string
+		expectStderr string
+	}{
+		{
+			name:         "default name",
+			expectCode:   0,
+			expectName:   "spire-test",
+			expectStdout: "Service \"spire-test\" uninstalled.\n",
+		},
+		{
+			name:         "name flag",
+			args:         []string{"-name", "spire-other"},
+			expectCode:   0,
+			expectName:   "spire-other",
+			expectStdout: "Service \"spire-other\" uninstalled.\n",
+		},
+		{
+			name:         "unexpected arguments",
+			args:         []string{"foo"},
+			expectCode:   1,
+			expectStderr: "unexpected arguments: [\"foo\"]\n",
+		},
+		{
+			name:         "uninstall fails",
+			uninstallErr: errors.New("oh no"),
+			expectCode:   1,
+			expectName:   "spire-test",
+			expectStderr: "Unable to uninstall service \"spire-test\": oh no\n",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			stdout := new(bytes.Buffer)
+			stderr := new(bytes.Buffer)
+			var uninstalled string
+			cmd := newUninstallCommand(&common_cli.Env{Stdout: stdout, Stderr: stderr}, "spire-test", func(name string) error {
+				uninstalled = name
+				return tt.uninstallErr
+			})
+
+			code := cmd.Run(tt.args)
+			assert.Equal(t, tt.expectCode, code)
+			assert.Equal(t, tt.expectName, uninstalled)
+			assert.Equal(t, tt.expectStdout, stdout.String())
+			assert.Equal(t, tt.expectStderr, stderr.String())
+		})
+	}
+}
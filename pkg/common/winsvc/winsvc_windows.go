@@ -0,0 +1,180 @@
+// +build windows
+
+package winsvc
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/log"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/eventlog"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+const (
+	// eventID is the ID of the event log records. The records are written
+	// with the generic message file of EventCreate, which accepts IDs from
+	// 1 to 1000.
+	eventID = 1
+)
+
+// IsService returns true if the process was started by the SCM.
+func IsService() bool {
+	interactive, err := svc.IsAnInteractiveSession()
+	return err == nil && !interactive
+}
+
+// Run calls run, under the SCM if the process was started by it. The context
+// passed to run is canceled when the SCM asks the service to stop, and the
+// status returned by run is reported to the SCM as the service exit code.
+func Run(name string, run func(ctx context.Context) int) int {
+	if !IsService() {
+		return run(context.Background())
+	}
+
+	h := &handler{run: run}
+	if err := svc.Run(name, h); err != nil {
+		return 1
+	}
+	return h.status
+}
+
+type handler struct {
+	run    func(ctx context.Context) int
+	status int
+}
+
+func (h *handler) Execute(args []string, requests <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	const accepted = svc.AcceptStop | svc.AcceptShutdown
+
+	changes <- svc.Status{State: svc.StartPending}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	done := make(chan int, 1)
+	go func() {
+		done <- h.run(ctx)
+	}()
+
+	changes <- svc.Status{State: svc.Running, Accepts: accepted}
+	for {
+		select {
+		case status := <-done:
+			h.status = status
+			changes <- svc.Status{State: svc.StopPending}
+			return false, uint32(status)
+		case req := <-requests:
+			switch req.Cmd {
+			case svc.Interrogate:
+				changes <- req.CurrentStatus
+			case svc.Stop, svc.Shutdown:
+				changes <- svc.Status{State: svc.StopPending}
+				cancel()
+			}
+		}
+	}
+}
+
+// WithEventLog writes the log records to the Windows event log, using the
+// given event source, in addition to the other outputs of the logger.
+func WithEventLog(source string) log.Option {
+	return func(logger *log.Logger) error {
+		elog, err := eventlog.Open(source)
+		if err != nil {
+			return fmt.Errorf("unable to open event log: %w", err)
+		}
+		logger.AddHook(eventLogHook{elog: elog})
+		return nil
+	}
+}
+
+type eventLogHook struct {
+	elog *eventlog.Log
+}
+
+func (eventLogHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h eventLogHook) Fire(entry *logrus.Entry) error {
+	msg, err := entry.String()
+	if err != nil {
+		return err
+	}
+	// Records dropped by the formatter (e.g. filtered by subsystem level)
+	// are not written.
+	msg = strings.TrimSpace(msg)
+	if msg == "" {
+		return nil
+	}
+
+	switch entry.Level {
+	case logrus.PanicLevel, logrus.FatalLevel, logrus.ErrorLevel:
+		return h.elog.Error(eventID, msg)
+	case logrus.WarnLevel:
+		return h.elog.Warning(eventID, msg)
+	default:
+		return h.elog.Info(eventID, msg)
+	}
+}
+
+func install(config ServiceConfig) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("unable to connect to the service control manager: %w", err)
+	}
+	defer m.Disconnect() //nolint: errcheck // nothing to do if this fails
+
+	if s, err := m.OpenService(config.Name); err == nil {
+		s.Close()
+		return fmt.Errorf("service %q already exists", config.Name)
+	}
+
+	startType := uint32(mgr.StartAutomatic)
+	if config.Manual {
+		startType = mgr.StartManual
+	}
+
+	s, err := m.CreateService(config.Name, config.ExePath, mgr.Config{
+		DisplayName: config.DisplayName,
+		Description: config.Description,
+		StartType:   startType,
+	}, config.Args...)
+	if err != nil {
+		return err
+	}
+	defer s.Close()
+
+	if err := eventlog.InstallAsEventCreate(config.Name, eventlog.Error|eventlog.Warning|eventlog.Info); err != nil {
+		// Leave the system as it was found
+		_ = s.Delete()
+		return fmt.Errorf("unable to register the event log source: %w", err)
+	}
+	return nil
+}
+
+func uninstall(name string) error {
+	m, err := mgr.Connect()
+	if err != nil {
+		return fmt.Errorf("unable to connect to the service control manager: %w", err)
+	}
+	defer m.Disconnect() //nolint: errcheck // nothing to do if this fails
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return fmt.Errorf("service %q is not installed", name)
+	}
+	defer s.Close()
+
+	if err := s.Delete(); err != nil {
+		return err
+	}
+	if err := eventlog.Remove(name); err != nil {
+		return fmt.Errorf("unable to remove the event log source: %w", err)
+	}
+	return nil
+}
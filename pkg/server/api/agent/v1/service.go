@@ -31,6 +31,7 @@ import (
 	"google.golang.org/grpc/peer"
 	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 // RegisterService registers the agent service on the gRPC server/
@@ -222,10 +223,6 @@ func (s *Service) AttestAgent(stream agent.Agent_AttestAgentServer) error {
 	ctx := stream.Context()
 	log := rpccontext.Logger(ctx)
 
-	if err := rpccontext.RateLimit(ctx, 1); err != nil {
-		return api.MakeErr(log, status.Code(err), "rejecting request due to attest agent rate limiting", err)
-	}
-
 	req, err := stream.Recv()
 	if err != nil {
 		return api.MakeErr(log, codes.InvalidArgument, "failed to receive request from stream", err)
@@ -239,6 +236,13 @@ func (s *Service) AttestAgent(stream agent.Agent_AttestAgentServer) error {
 
 	log = log.WithField(telemetry.NodeAttestorType, params.Data.Type)
 
+	// Rate limiting happens once the attestation type is known, since
+	// attestation can be limited per type.
+	ctx = rpccontext.WithAttestationType(ctx, params.Data.Type)
+	if err := rpccontext.RateLimit(ctx, 1); err != nil {
+		return api.MakeErr(log, status.Code(err), "rejecting request due to attest agent rate limiting", err)
+	}
+
 	// attest
 	var attestResp *nodeattestor.AttestResponse
 	if params.Data.Type == "join_token" {
@@ -409,11 +413,16 @@ func (s *Service) CreateJoinToken(ctx context.Context, req *agent.CreateJoinToke
 
 	expiry := time.Now().Unix() + int64(req.Ttl)
 
+	joinToken := &datastore.JoinToken{
+		Token:  req.Token,
+		Expiry: expiry,
+	}
+	if req.AgentId != nil {
+		joinToken.AgentId = agentID.String()
+	}
+
 	result, err := s.ds.CreateJoinToken(ctx, &datastore.CreateJoinTokenRequest{
-		JoinToken: &datastore.JoinToken{
-			Token:  req.Token,
-			Expiry: expiry,
-		},
+		JoinToken: joinToken,
 	})
 	if err != nil {
 		return nil, api.MakeErr(log, codes.Internal, "failed to create token", err)
@@ -429,6 +438,86 @@ func (s *Service) CreateJoinToken(ctx context.Context, req *agent.CreateJoinToke
 	return &types.JoinToken{Value: result.JoinToken.Token, ExpiresAt: expiry}, nil
 }
 
+func (s *Service) ListJoinTokens(ctx context.Context, req *agent.ListJoinTokensRequest) (*agent.ListJoinTokensResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	listReq := &datastore.ListJoinTokensRequest{}
+
+	// Set pagination parameters
+	if req.PageSize > 0 {
+		listReq.Pagination = &datastore.Pagination{
+			PageSize: req.PageSize,
+			Token:    req.PageToken,
+		}
+	}
+
+	dsResp, err := s.ds.ListJoinTokens(ctx, listReq)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to list join tokens", err)
+	}
+
+	resp := &agent.ListJoinTokensResponse{}
+
+	if dsResp.Pagination != nil {
+		resp.NextPageToken = dsResp.Pagination.Token
+	}
+
+	for _, token := range dsResp.JoinTokens {
+		joinToken := &types.JoinToken{
+			Value:     token.Token,
+			ExpiresAt: token.Expiry,
+			CreatedAt: token.CreatedAt,
+		}
+		if token.AgentId != "" {
+			agentID, err := spiffeid.FromString(token.AgentId)
+			if err != nil {
+				log.WithError(err).WithField(telemetry.SPIFFEID, token.AgentId).Warn("Malformed join token agent ID")
+			} else {
+				joinToken.AgentId = api.ProtoFromID(agentID)
+			}
+		}
+		resp.JoinTokens = append(resp.JoinTokens, joinToken)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) RevokeJoinToken(ctx context.Context, req *agent.RevokeJoinTokenRequest) (*emptypb.Empty, error) {
+	log := rpccontext.Logger(ctx)
+
+	if req.Value == "" {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "missing token value", nil)
+	}
+
+	fetchResp, err := s.ds.FetchJoinToken(ctx, &datastore.FetchJoinTokenRequest{
+		Token: req.Value,
+	})
+	switch {
+	case err != nil:
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch join token", err)
+	case fetchResp.JoinToken == nil:
+		return nil, api.MakeErr(log, codes.NotFound, "join token not found", nil)
+	}
+
+	if _, err := s.ds.DeleteJoinToken(ctx, &datastore.DeleteJoinTokenRequest{
+		Token: req.Value,
+	}); err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to delete join token", err)
+	}
+
+	// The entry that maps the join token agent ID to the requested agent ID
+	// is useless once the token is gone.
+	if fetchResp.JoinToken.AgentId != "" {
+		log = log.WithField(telemetry.SPIFFEID, fetchResp.JoinToken.AgentId)
+		if err := s.deleteJoinTokenRegistrationEntries(ctx, req.Value); err != nil {
+			return nil, api.MakeErr(log, codes.Internal, "failed to delete join token registration entry", err)
+		}
+	}
+
+	log.Info("Join token revoked")
+	return &emptypb.Empty{}, nil
+}
+
 func (s *Service) createJoinTokenRegistrationEntry(ctx context.Context, token string, agentID string) error {
 	parentID := s.td.NewID(path.Join("spire", "agent", "join_token", token))
 	req := &datastore.CreateRegistrationEntryRequest{
@@ -447,6 +536,27 @@ func (s *Service) createJoinTokenRegistrationEntry(ctx context.Context, token st
 	return nil
 }
 
+func (s *Service) deleteJoinTokenRegistrationEntries(ctx context.Context, token string) error {
+	parentID := s.td.NewID(path.Join("spire", "agent", "join_token", token))
+	resp, err := s.ds.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{
+		ByParentId: &wrapperspb.StringValue{
+			Value: parentID.String(),
+		},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, entry := range resp.Entries {
+		if _, err := s.ds.DeleteRegistrationEntry(ctx, &datastore.DeleteRegistrationEntryRequest{
+			EntryId: entry.EntryId,
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 func (s *Service) updateAttestedNode(ctx context.Context, req *datastore.UpdateAttestedNodeRequest, log logrus.FieldLogger) error {
 	_, err := s.ds.UpdateAttestedNode(ctx, req)
 	switch status.Code(err) {
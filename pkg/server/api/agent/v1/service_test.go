@@ -1249,6 +1249,124 @@ func TestCreateJoinTokenWithAgentId(t *testing.T) {
 	require.Equal(t, "spiffe://example.org/spire/agent/join_token/"+token.Value, listEntries.Entries[0].Selectors[0].Value)
 }
 
+func TestListJoinTokens(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	token1, err := test.client.CreateJoinToken(ctx, &agentpb.CreateJoinTokenRequest{
+		Ttl:   1000,
+		Token: "token1",
+	})
+	require.NoError(t, err)
+	token2, err := test.client.CreateJoinToken(ctx, &agentpb.CreateJoinTokenRequest{
+		Ttl:     1000,
+		Token:   "token2",
+		AgentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/valid"},
+	})
+	require.NoError(t, err)
+
+	resp, err := test.client.ListJoinTokens(ctx, &agentpb.ListJoinTokensRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.JoinTokens, 2)
+	require.Empty(t, resp.NextPageToken)
+
+	require.Equal(t, token1.Value, resp.JoinTokens[0].Value)
+	require.Equal(t, token1.ExpiresAt, resp.JoinTokens[0].ExpiresAt)
+	require.NotZero(t, resp.JoinTokens[0].CreatedAt)
+	require.Nil(t, resp.JoinTokens[0].AgentId)
+
+	require.Equal(t, token2.Value, resp.JoinTokens[1].Value)
+	require.Equal(t, token2.ExpiresAt, resp.JoinTokens[1].ExpiresAt)
+	require.NotZero(t, resp.JoinTokens[1].CreatedAt)
+	spiretest.RequireProtoEqual(t, &types.SPIFFEID{TrustDomain: "example.org", Path: "/valid"}, resp.JoinTokens[1].AgentId)
+
+	// Paginated
+	resp, err = test.client.ListJoinTokens(ctx, &agentpb.ListJoinTokensRequest{PageSize: 1})
+	require.NoError(t, err)
+	require.Len(t, resp.JoinTokens, 1)
+	require.Equal(t, token1.Value, resp.JoinTokens[0].Value)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = test.client.ListJoinTokens(ctx, &agentpb.ListJoinTokensRequest{PageSize: 1, PageToken: resp.NextPageToken})
+	require.NoError(t, err)
+	require.Len(t, resp.JoinTokens, 1)
+	require.Equal(t, token2.Value, resp.JoinTokens[0].Value)
+
+	// Datastore failure
+	test.ds.SetNextError(errors.New("datastore broken"))
+	_, err = test.client.ListJoinTokens(ctx, &agentpb.ListJoinTokensRequest{})
+	spiretest.RequireGRPCStatusContains(t, err, codes.Internal, "failed to list join tokens: datastore broken")
+}
+
+func TestRevokeJoinToken(t *testing.T) {
+	for _, tt := range []struct {
+		name    string
+		value   string
+		agentID *types.SPIFFEID
+		dsError error
+		code    codes.Code
+		err     string
+	}{
+		{
+			name:  "success",
+			value: "token",
+		},
+		{
+			name:    "success with agent ID",
+			value:   "token",
+			agentID: &types.SPIFFEID{TrustDomain: "example.org", Path: "/valid"},
+		},
+		{
+			name: "missing value",
+			code: codes.InvalidArgument,
+			err:  "missing token value",
+		},
+		{
+			name:  "not found",
+			value: "unknown",
+			code:  codes.NotFound,
+			err:   "join token not found",
+		},
+		{
+			name:    "datastore failure",
+			value:   "token",
+			dsError: errors.New("datastore broken"),
+			code:    codes.Internal,
+			err:     "failed to fetch join token: datastore broken",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+
+			_, err := test.client.CreateJoinToken(ctx, &agentpb.CreateJoinTokenRequest{
+				Ttl:     1000,
+				Token:   "token",
+				AgentId: tt.agentID,
+			})
+			require.NoError(t, err)
+
+			test.ds.SetNextError(tt.dsError)
+			_, err = test.client.RevokeJoinToken(ctx, &agentpb.RevokeJoinTokenRequest{Value: tt.value})
+			if tt.err != "" {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.err)
+				return
+			}
+			require.NoError(t, err)
+
+			fetchResp, err := test.ds.FetchJoinToken(ctx, &datastore.FetchJoinTokenRequest{Token: tt.value})
+			require.NoError(t, err)
+			require.Nil(t, fetchResp.JoinToken)
+
+			// The entry created for the agent ID goes away with the token
+			listEntries, err := test.ds.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{})
+			require.NoError(t, err)
+			require.Empty(t, listEntries.Entries)
+		})
+	}
+}
+
 func TestAttestAgent(t *testing.T) {
 	testCsr, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{}, testkey.MustEC256())
 	require.NoError(t, err)
@@ -1381,7 +1499,7 @@ func TestAttestAgent(t *testing.T) {
 
 		{
 			name:           "rate limit fails",
-			request:        &agentpb.AttestAgentRequest{},
+			request:        getAttestAgentRequest("join_token", []byte("test_token"), testCsr),
 			expectCode:     codes.Unknown,
 			expectMsg:      "rate limit fails",
 			rateLimiterErr: status.Error(codes.Unknown, "rate limit fails"),
@@ -1390,7 +1508,8 @@ func TestAttestAgent(t *testing.T) {
 					Level:   logrus.ErrorLevel,
 					Message: "Rejecting request due to attest agent rate limiting",
 					Data: logrus.Fields{
-						logrus.ErrorKey: "rpc error: code = Unknown desc = rate limit fails",
+						telemetry.NodeAttestorType: "join_token",
+						logrus.ErrorKey:            "rpc error: code = Unknown desc = rate limit fails",
 					},
 				},
 			},
@@ -1755,6 +1874,10 @@ func TestAttestAgent(t *testing.T) {
 			}
 
 			spiretest.RequireGRPCStatusContains(t, err, tt.expectCode, tt.expectMsg)
+			if test.rateLimiter.attestationType != "" {
+				// Attestation is rate limited per attestation type
+				require.Equal(t, tt.request.GetParams().GetData().GetType(), test.rateLimiter.attestationType)
+			}
 			switch {
 			case tt.expectCode != codes.OK:
 				require.Nil(t, result)
@@ -2006,12 +2129,16 @@ func (s *serviceTest) assertAgentWasStored(t *testing.T, expectedID string, expe
 type fakeRateLimiter struct {
 	count int
 	err   error
+
+	// attestationType is the attestation type of the last limited call
+	attestationType string
 }
 
 func (f *fakeRateLimiter) RateLimit(ctx context.Context, count int) error {
 	if f.count != count {
 		return fmt.Errorf("rate limiter got %d but expected %d", count, f.count)
 	}
+	f.attestationType, _ = rpccontext.AttestationType(ctx)
 
 	return f.err
 }
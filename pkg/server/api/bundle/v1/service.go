@@ -3,12 +3,17 @@ package bundle
 import (
 	"context"
 	"fmt"
+	"time"
 
+	"github.com/andres-erbsen/clock"
+	"github.com/hashicorp/go-hclog"
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/bundle/client"
 	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
@@ -17,8 +22,13 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
 )
 
+// watchBundleInterval is how often the bundle is checked for changes on
+// behalf of the WatchBundle streams.
+const watchBundleInterval = 5 * time.Second
+
 // RegisterService registers the bundle service on the gRPC server.
 func RegisterService(s *grpc.Server, service *Service) {
 	bundle.RegisterBundleServer(s, service)
@@ -34,19 +44,35 @@ func (fn UpstreamPublisherFunc) PublishJWTKey(ctx context.Context, jwtKey *commo
 	return fn(ctx, jwtKey)
 }
 
+// RefreshReporter reports the refresh status of the federated bundles
+// maintained by the server
+type RefreshReporter interface {
+	TrustDomainStatuses() []client.TrustDomainStatus
+}
+
 // Config is the service configuration
 type Config struct {
 	DataStore         datastore.DataStore
 	TrustDomain       spiffeid.TrustDomain
 	UpstreamPublisher UpstreamPublisher
+	Clock             clock.Clock
+
+	// RefreshReporter reports when federated bundles were last refreshed, if
+	// set
+	RefreshReporter RefreshReporter
 }
 
 // New creates a new bundle service
 func New(config Config) *Service {
+	if config.Clock == nil {
+		config.Clock = clock.New()
+	}
 	return &Service{
-		ds: config.DataStore,
-		td: config.TrustDomain,
-		up: config.UpstreamPublisher,
+		ds:  config.DataStore,
+		td:  config.TrustDomain,
+		up:  config.UpstreamPublisher,
+		clk: config.Clock,
+		rr:  config.RefreshReporter,
 	}
 }
 
@@ -54,9 +80,11 @@ func New(config Config) *Service {
 type Service struct {
 	bundle.UnsafeBundleServer
 
-	ds datastore.DataStore
-	td spiffeid.TrustDomain
-	up UpstreamPublisher
+	ds  datastore.DataStore
+	td  spiffeid.TrustDomain
+	up  UpstreamPublisher
+	clk clock.Clock
+	rr  RefreshReporter
 }
 
 func (s *Service) GetBundle(ctx context.Context, req *bundle.GetBundleRequest) (*types.Bundle, error) {
@@ -121,6 +149,115 @@ func (s *Service) AppendBundle(ctx context.Context, req *bundle.AppendBundleRequ
 	return bundle, nil
 }
 
+func (s *Service) PruneBundle(ctx context.Context, req *bundle.PruneBundleRequest) (*bundle.PruneBundleResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	if req.ExpiresBefore == 0 {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "expires_before is required", nil)
+	}
+	expiresBefore := time.Unix(req.ExpiresBefore, 0)
+	if expiresBefore.After(time.Now()) {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "expires_before cannot be in the future", nil)
+	}
+
+	log = log.WithFields(logrus.Fields{
+		telemetry.TrustDomainID: s.td.String(),
+		telemetry.Expiration:    expiresBefore.UTC().Format(time.RFC3339),
+	})
+
+	dsResp, err := s.ds.FetchBundle(ctx, &datastore.FetchBundleRequest{
+		TrustDomainId: s.td.IDString(),
+	})
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch bundle", err)
+	}
+	if dsResp.Bundle == nil {
+		return nil, api.MakeErr(log, codes.NotFound, "bundle not found", nil)
+	}
+
+	// The datastore refuses to prune every authority of a kind. Check it
+	// beforehand so the caller can tell it apart from a datastore failure.
+	if _, _, err := bundleutil.PruneBundle(dsResp.Bundle, expiresBefore, hclog.NewNullLogger()); err != nil {
+		return nil, api.MakeErr(log, codes.FailedPrecondition, "unable to prune bundle", err)
+	}
+
+	resp, err := s.ds.PruneBundle(ctx, &datastore.PruneBundleRequest{
+		TrustDomainId: s.td.IDString(),
+		ExpiresBefore: req.ExpiresBefore,
+	})
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to prune bundle", err)
+	}
+
+	if resp.BundleChanged {
+		log.Info("Expired authorities pruned from bundle")
+	}
+	return &bundle.PruneBundleResponse{
+		BundleChanged: resp.BundleChanged,
+	}, nil
+}
+
+func (s *Service) WatchBundle(req *bundle.WatchBundleRequest, stream bundle.Bundle_WatchBundleServer) error {
+	ctx := stream.Context()
+	log := rpccontext.Logger(ctx)
+
+	dsResp, err := s.ds.FetchBundle(dscache.WithCache(ctx), &datastore.FetchBundleRequest{
+		TrustDomainId: s.td.IDString(),
+	})
+	if err != nil {
+		return api.MakeErr(log, codes.Internal, "failed to fetch bundle", err)
+	}
+	if dsResp.Bundle == nil {
+		return api.MakeErr(log, codes.NotFound, "bundle not found", nil)
+	}
+
+	current := dsResp.Bundle
+	if err := sendWatchBundleResponse(log, stream, current, req.OutputMask); err != nil {
+		return err
+	}
+
+	ticker := s.clk.Ticker(watchBundleInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-ticker.C:
+		}
+
+		// Failures are not fatal to the stream; the bundle is fetched again
+		// on the next tick.
+		dsResp, err := s.ds.FetchBundle(dscache.WithCache(ctx), &datastore.FetchBundleRequest{
+			TrustDomainId: s.td.IDString(),
+		})
+		if err != nil {
+			log.WithError(err).Warn("Failed to fetch bundle")
+			continue
+		}
+		if dsResp.Bundle == nil || proto.Equal(dsResp.Bundle, current) {
+			continue
+		}
+
+		current = dsResp.Bundle
+		if err := sendWatchBundleResponse(log, stream, current, req.OutputMask); err != nil {
+			return err
+		}
+	}
+}
+
+func sendWatchBundleResponse(log logrus.FieldLogger, stream bundle.Bundle_WatchBundleServer, b *common.Bundle, outputMask *types.BundleMask) error {
+	protoBundle, err := api.BundleToProto(b)
+	if err != nil {
+		return api.MakeErr(log, codes.Internal, "failed to convert bundle", err)
+	}
+	applyBundleMask(protoBundle, outputMask)
+
+	if err := stream.Send(&bundle.WatchBundleResponse{Bundle: protoBundle}); err != nil {
+		return api.MakeErr(log, codes.Internal, "failed to send response over stream", err)
+	}
+	return nil
+}
+
 func (s *Service) PublishJWTAuthority(ctx context.Context, req *bundle.PublishJWTAuthorityRequest) (*bundle.PublishJWTAuthorityResponse, error) {
 	log := rpccontext.Logger(ctx)
 
@@ -171,6 +308,8 @@ func (s *Service) ListFederatedBundles(ctx context.Context, req *bundle.ListFede
 		resp.NextPageToken = dsResp.Pagination.Token
 	}
 
+	lastRefreshed := s.lastRefreshed()
+
 	for _, dsBundle := range dsResp.Bundles {
 		log = log.WithField(telemetry.TrustDomainID, dsBundle.TrustDomainId)
 		td, err := spiffeid.TrustDomainFromString(dsBundle.TrustDomainId)
@@ -189,11 +328,39 @@ func (s *Service) ListFederatedBundles(ctx context.Context, req *bundle.ListFede
 		}
 		applyBundleMask(b, req.OutputMask)
 		resp.Bundles = append(resp.Bundles, b)
+
+		if refreshedAt, ok := lastRefreshed[td]; ok {
+			if resp.LastRefreshedAt == nil {
+				resp.LastRefreshedAt = make(map[string]int64)
+			}
+			resp.LastRefreshedAt[td.String()] = refreshedAt.Unix()
+		}
 	}
 
 	return resp, nil
 }
 
+// lastRefreshed returns the time of the last successful refresh of each
+// federated bundle maintained by the server.
+func (s *Service) lastRefreshed() map[spiffeid.TrustDomain]time.Time {
+	if s.rr == nil {
+		return nil
+	}
+
+	lastRefreshed := make(map[spiffeid.TrustDomain]time.Time)
+	for _, status := range s.rr.TrustDomainStatuses() {
+		if status.LastSuccess.IsZero() {
+			continue
+		}
+		td, err := spiffeid.TrustDomainFromString(status.TrustDomain)
+		if err != nil {
+			continue
+		}
+		lastRefreshed[td] = status.LastSuccess
+	}
+	return lastRefreshed
+}
+
 func (s *Service) GetFederatedBundle(ctx context.Context, req *bundle.GetFederatedBundleRequest) (*types.Bundle, error) {
 	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, req.TrustDomain)
 
@@ -206,7 +373,7 @@ func (s *Service) GetFederatedBundle(ctx context.Context, req *bundle.GetFederat
 		return nil, api.MakeErr(log, codes.InvalidArgument, "getting a federated bundle for the server's own trust domain is not allowed", nil)
 	}
 
-	dsResp, err := s.ds.FetchBundle(ctx, &datastore.FetchBundleRequest{
+	dsResp, err := s.ds.FetchBundle(dscache.WithCache(ctx), &datastore.FetchBundleRequest{
 		TrustDomainId: td.IDString(),
 	})
 	if err != nil {
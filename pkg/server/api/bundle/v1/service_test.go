@@ -19,10 +19,13 @@ import (
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/bundle/v1"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/bundle/client"
+	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	bundlepb "github.com/spiffe/spire/proto/spire/api/server/bundle/v1"
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/spiffe/spire/test/testca"
@@ -201,6 +204,42 @@ func TestGetFederatedBundle(t *testing.T) {
 	}
 }
 
+func TestGetFederatedBundleCache(t *testing.T) {
+	ds := &fetchCountingDataStore{DataStore: fakedatastore.New(t)}
+	cache := dscache.New(ds, clock.NewMock(t))
+	service := bundle.New(bundle.Config{
+		DataStore:   cache,
+		TrustDomain: serverTrustDomain,
+		Clock:       clock.NewMock(t),
+	})
+	log, _ := test.NewNullLogger()
+	ctx := rpccontext.WithLogger(context.Background(), log)
+	getFederatedBundle := func() *types.Bundle {
+		b, err := service.GetFederatedBundle(ctx, &bundlepb.GetFederatedBundleRequest{
+			TrustDomain: federatedTrustDomain.String(),
+		})
+		require.NoError(t, err)
+		return b
+	}
+
+	federatedBundle := makeValidCommonBundle(t, federatedTrustDomain)
+	_, err := ds.SetBundle(context.Background(), &datastore.SetBundleRequest{Bundle: federatedBundle})
+	require.NoError(t, err)
+
+	// Repeated calls fetch the bundle once
+	require.Equal(t, int64(60), getFederatedBundle().RefreshHint)
+	require.Equal(t, int64(60), getFederatedBundle().RefreshHint)
+	require.Equal(t, 1, ds.fetches)
+
+	// Bundle updates through the cache are seen right away
+	federatedBundle.RefreshHint = 120
+	_, err = cache.UpdateBundle(context.Background(), &datastore.UpdateBundleRequest{Bundle: federatedBundle})
+	require.NoError(t, err)
+	require.Equal(t, int64(120), getFederatedBundle().RefreshHint)
+	require.Equal(t, int64(120), getFederatedBundle().RefreshHint)
+	require.Equal(t, 2, ds.fetches)
+}
+
 func TestGetBundle(t *testing.T) {
 	for _, tt := range []struct {
 		name       string
@@ -258,6 +297,68 @@ func TestGetBundle(t *testing.T) {
 	}
 }
 
+func TestWatchBundle(t *testing.T) {
+	t.Run("Bundle not found", func(t *testing.T) {
+		test := setupServiceTest(t)
+		defer test.Cleanup()
+
+		stream, err := test.client.WatchBundle(context.Background(), &bundlepb.WatchBundleRequest{})
+		require.NoError(t, err)
+		resp, err := stream.Recv()
+		require.Nil(t, resp)
+		spiretest.RequireGRPCStatus(t, err, codes.NotFound, "bundle not found")
+	})
+
+	for _, tt := range []struct {
+		name       string
+		outputMask *types.BundleMask
+	}{
+		{
+			name: "Sends bundle updates",
+		},
+		{
+			name: "Sends bundle updates filtered by mask",
+			outputMask: &types.BundleMask{
+				X509Authorities: true,
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			b := makeValidCommonBundle(t, serverTrustDomain)
+			test.setBundle(t, b)
+
+			stream, err := test.client.WatchBundle(ctx, &bundlepb.WatchBundleRequest{
+				OutputMask: tt.outputMask,
+			})
+			require.NoError(t, err)
+
+			// The current bundle is sent when the stream is opened
+			resp, err := stream.Recv()
+			require.NoError(t, err)
+			assertCommonBundleWithMask(t, b, resp.Bundle, tt.outputMask)
+			test.clk.WaitForTicker(time.Minute, "waiting for the watch ticker")
+
+			// Nothing is sent while the bundle is unchanged, so the next
+			// response is the updated bundle.
+			test.clk.Add(5 * time.Second)
+			b.RootCas = append(b.RootCas, &common.Certificate{DerBytes: testca.New(t, serverTrustDomain).X509Authorities()[0].Raw})
+			test.setBundle(t, b)
+			test.clk.Add(5 * time.Second)
+
+			resp, err = stream.Recv()
+			require.NoError(t, err)
+			assertCommonBundleWithMask(t, b, resp.Bundle, tt.outputMask)
+		})
+	}
+}
+
 func TestAppendBundle(t *testing.T) {
 	ca := testca.New(t, serverTrustDomain)
 	rootCA := ca.X509Authorities()[0]
@@ -317,7 +418,7 @@ func TestAppendBundle(t *testing.T) {
 			expectBundle: &types.Bundle{
 				TrustDomain:     defaultBundle.TrustDomain,
 				RefreshHint:     defaultBundle.RefreshHint,
-				SequenceNumber:  defaultBundle.SequenceNumber,
+				SequenceNumber:  defaultBundle.SequenceNumber + 1,
 				X509Authorities: append(defaultBundle.X509Authorities, x509Cert),
 				JwtAuthorities:  append(defaultBundle.JwtAuthorities, jwtKey2),
 			},
@@ -340,7 +441,7 @@ func TestAppendBundle(t *testing.T) {
 			expectBundle: &types.Bundle{
 				TrustDomain:     defaultBundle.TrustDomain,
 				RefreshHint:     defaultBundle.RefreshHint,
-				SequenceNumber:  defaultBundle.SequenceNumber,
+				SequenceNumber:  defaultBundle.SequenceNumber + 1,
 				JwtAuthorities:  defaultBundle.JwtAuthorities,
 				X509Authorities: append(defaultBundle.X509Authorities, x509Cert),
 			},
@@ -351,7 +452,7 @@ func TestAppendBundle(t *testing.T) {
 			expectBundle: &types.Bundle{
 				TrustDomain:     defaultBundle.TrustDomain,
 				RefreshHint:     defaultBundle.RefreshHint,
-				SequenceNumber:  defaultBundle.SequenceNumber,
+				SequenceNumber:  defaultBundle.SequenceNumber + 1,
 				JwtAuthorities:  append(defaultBundle.JwtAuthorities, jwtKey2),
 				X509Authorities: defaultBundle.X509Authorities,
 			},
@@ -510,6 +611,174 @@ func TestAppendBundle(t *testing.T) {
 	}
 }
 
+func TestPruneBundle(t *testing.T) {
+	now := time.Now()
+	expiredCA, _ := testca.CreateCACertificate(t, nil, nil, testca.WithLifetime(now.Add(-3*time.Hour), now.Add(-2*time.Hour)))
+	validCA, _ := testca.CreateCACertificate(t, nil, nil, testca.WithLifetime(now.Add(-time.Hour), now.Add(time.Hour)))
+
+	pkixBytes, err := base64.StdEncoding.DecodeString("MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEYSlUVLqTD8DEnA4F1EWMTf5RXc5lnCxw+5WKJwngEL3rPc9i4Tgzz9riR3I/NiSlkgRO1WsxBusqpC284j9dXA==")
+	require.NoError(t, err)
+	expiredKey := &common.PublicKey{Kid: "expired", PkixBytes: pkixBytes, NotAfter: now.Add(-2 * time.Hour).Unix()}
+	validKey := &common.PublicKey{Kid: "valid", PkixBytes: pkixBytes, NotAfter: now.Add(time.Hour).Unix()}
+
+	makeBundle := func(rootCAs []*x509.Certificate, jwtKeys ...*common.PublicKey) *common.Bundle {
+		b := &common.Bundle{
+			TrustDomainId:  serverTrustDomain.IDString(),
+			JwtSigningKeys: jwtKeys,
+		}
+		for _, rootCA := range rootCAs {
+			b.RootCas = append(b.RootCas, &common.Certificate{DerBytes: rootCA.Raw})
+		}
+		return b
+	}
+
+	logData := logrus.Fields{
+		telemetry.TrustDomainID: serverTrustDomain.String(),
+		telemetry.Expiration:    now.Add(-time.Hour).UTC().Format(time.RFC3339),
+	}
+
+	for _, tt := range []struct {
+		name          string
+		bundle        *common.Bundle
+		expiresBefore int64
+		dsError       error
+		code          codes.Code
+		err           string
+		expectResp    *bundlepb.PruneBundleResponse
+		expectBundle  *common.Bundle
+		expectLogs    []spiretest.LogEntry
+	}{
+		{
+			name:          "expired authorities are pruned",
+			bundle:        makeBundle([]*x509.Certificate{expiredCA, validCA}, expiredKey, validKey),
+			expiresBefore: now.Add(-time.Hour).Unix(),
+			expectResp:    &bundlepb.PruneBundleResponse{BundleChanged: true},
+			expectBundle:  makeBundle([]*x509.Certificate{validCA}, validKey),
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.InfoLevel,
+					Message: "Expired authorities pruned from bundle",
+					Data:    logData,
+				},
+			},
+		},
+		{
+			name:          "nothing to prune",
+			bundle:        makeBundle([]*x509.Certificate{validCA}, validKey),
+			expiresBefore: now.Add(-time.Hour).Unix(),
+			expectResp:    &bundlepb.PruneBundleResponse{},
+			expectBundle:  makeBundle([]*x509.Certificate{validCA}, validKey),
+		},
+		{
+			name:          "would prune all X.509 authorities",
+			bundle:        makeBundle([]*x509.Certificate{expiredCA}, validKey),
+			expiresBefore: now.Add(-time.Hour).Unix(),
+			code:          codes.FailedPrecondition,
+			err:           "unable to prune bundle: would prune all certificates",
+			expectBundle:  makeBundle([]*x509.Certificate{expiredCA}, validKey),
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Unable to prune bundle",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: serverTrustDomain.String(),
+						telemetry.Expiration:    now.Add(-time.Hour).UTC().Format(time.RFC3339),
+						logrus.ErrorKey:         "would prune all certificates",
+					},
+				},
+			},
+		},
+		{
+			name: "missing expires_before",
+			code: codes.InvalidArgument,
+			err:  "expires_before is required",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: expires_before is required",
+				},
+			},
+		},
+		{
+			name:          "expires_before in the future",
+			expiresBefore: now.Add(time.Hour).Unix(),
+			code:          codes.InvalidArgument,
+			err:           "expires_before cannot be in the future",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: expires_before cannot be in the future",
+				},
+			},
+		},
+		{
+			name:          "bundle not found",
+			expiresBefore: now.Add(-time.Hour).Unix(),
+			code:          codes.NotFound,
+			err:           "bundle not found",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Bundle not found",
+					Data:    logData,
+				},
+			},
+		},
+		{
+			name:          "datastore fails",
+			bundle:        makeBundle([]*x509.Certificate{validCA}, validKey),
+			expiresBefore: now.Add(-time.Hour).Unix(),
+			dsError:       errors.New("oh no"),
+			code:          codes.Internal,
+			err:           "failed to fetch bundle: oh no",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to fetch bundle",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: serverTrustDomain.String(),
+						telemetry.Expiration:    now.Add(-time.Hour).UTC().Format(time.RFC3339),
+						logrus.ErrorKey:         "oh no",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+
+			if tt.bundle != nil {
+				test.setBundle(t, tt.bundle)
+			}
+			test.ds.SetNextError(tt.dsError)
+
+			resp, err := test.client.PruneBundle(ctx, &bundlepb.PruneBundleRequest{
+				ExpiresBefore: tt.expiresBefore,
+			})
+
+			spiretest.AssertLogs(t, test.logHook.AllEntries(), tt.expectLogs)
+			if tt.err != "" {
+				spiretest.RequireGRPCStatus(t, err, tt.code, tt.err)
+				require.Nil(t, resp)
+			} else {
+				require.NoError(t, err)
+				spiretest.AssertProtoEqual(t, tt.expectResp, resp)
+			}
+
+			if tt.expectBundle != nil {
+				dsResp, err := test.ds.FetchBundle(ctx, &datastore.FetchBundleRequest{
+					TrustDomainId: serverTrustDomain.IDString(),
+				})
+				require.NoError(t, err)
+				spiretest.AssertProtoListEqual(t, tt.expectBundle.RootCas, dsResp.Bundle.RootCas)
+				spiretest.AssertProtoListEqual(t, tt.expectBundle.JwtSigningKeys, dsResp.Bundle.JwtSigningKeys)
+			}
+		})
+	}
+}
+
 func TestBatchDeleteFederatedBundle(t *testing.T) {
 	test := setupServiceTest(t)
 	defer test.Cleanup()
@@ -1072,6 +1341,48 @@ func TestListFederatedBundles(t *testing.T) {
 	}
 }
 
+func TestListFederatedBundlesLastRefreshedAt(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	_ = createBundle(t, test, serverTrustDomain.IDString())
+	_ = createBundle(t, test, "spiffe://td1.org")
+	_ = createBundle(t, test, "spiffe://td2.org")
+	_ = createBundle(t, test, "spiffe://td3.org")
+
+	refreshedAt := time.Unix(1600000000, 0)
+	test.rr.statuses = []client.TrustDomainStatus{
+		// Refreshed successfully
+		{TrustDomain: "td1.org", LastAttempt: refreshedAt, LastSuccess: refreshedAt},
+		// Never refreshed successfully
+		{TrustDomain: "td2.org", LastAttempt: refreshedAt},
+		// Refreshed but without a stored bundle
+		{TrustDomain: "td4.org", LastAttempt: refreshedAt, LastSuccess: refreshedAt},
+	}
+
+	resp, err := test.client.ListFederatedBundles(ctx, &bundlepb.ListFederatedBundlesRequest{})
+	require.NoError(t, err)
+	require.Len(t, resp.Bundles, 3)
+	require.Equal(t, map[string]int64{"td1.org": refreshedAt.Unix()}, resp.LastRefreshedAt)
+
+	// Only bundles in the returned page are reported
+	resp, err = test.client.ListFederatedBundles(ctx, &bundlepb.ListFederatedBundlesRequest{
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Bundles)
+	require.Empty(t, resp.LastRefreshedAt)
+
+	resp, err = test.client.ListFederatedBundles(ctx, &bundlepb.ListFederatedBundlesRequest{
+		PageSize:  1,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Bundles, 1)
+	require.Equal(t, "td1.org", resp.Bundles[0].TrustDomain)
+	require.Equal(t, map[string]int64{"td1.org": refreshedAt.Unix()}, resp.LastRefreshedAt)
+}
+
 func createBundle(t *testing.T, test *serviceTest, td string) *common.Bundle {
 	b := &common.Bundle{
 		TrustDomainId: td,
@@ -1338,7 +1649,7 @@ func TestBatchUpdateFederatedBundle(t *testing.T) {
 			expectedResults: []*bundlepb.BatchCreateFederatedBundleResponse_Result{
 				{
 					Status: api.OK(),
-					Bundle: makeValidBundle(t, federatedTrustDomain),
+					Bundle: makeUpdatedBundle(t, federatedTrustDomain),
 				},
 			},
 			expectedLogMsgs: []spiretest.LogEntry{
@@ -1363,7 +1674,7 @@ func TestBatchUpdateFederatedBundle(t *testing.T) {
 			expectedResults: []*bundlepb.BatchCreateFederatedBundleResponse_Result{
 				{
 					Status: api.OK(),
-					Bundle: makeValidBundle(t, federatedTrustDomain),
+					Bundle: makeUpdatedBundle(t, federatedTrustDomain),
 				},
 			},
 			expectedLogMsgs: []spiretest.LogEntry{
@@ -1523,7 +1834,7 @@ func TestBatchUpdateFederatedBundle(t *testing.T) {
 				},
 				{
 					Status: api.OK(),
-					Bundle: makeValidBundle(t, federatedTrustDomain),
+					Bundle: makeUpdatedBundle(t, federatedTrustDomain),
 				},
 			},
 			expectedLogMsgs: []spiretest.LogEntry{
@@ -1683,7 +1994,11 @@ func TestBatchSetFederatedBundle(t *testing.T) {
 				},
 				{
 					Status: api.OK(),
-					Bundle: updatedBundle,
+					Bundle: func() *types.Bundle {
+						b := makeUpdatedBundle(t, federatedTrustDomain)
+						b.RefreshHint = updatedBundle.RefreshHint
+						return b
+					}(),
 				},
 			},
 			expectedLogMsgs: []spiretest.LogEntry{
@@ -1860,11 +2175,25 @@ func (c *serviceTest) setBundle(t *testing.T, b *common.Bundle) {
 	require.NoError(t, err)
 }
 
+// fetchCountingDataStore counts the bundles fetched from the datastore it
+// wraps.
+type fetchCountingDataStore struct {
+	datastore.DataStore
+	fetches int
+}
+
+func (ds *fetchCountingDataStore) FetchBundle(ctx context.Context, req *datastore.FetchBundleRequest) (*datastore.FetchBundleResponse, error) {
+	ds.fetches++
+	return ds.DataStore.FetchBundle(ctx, req)
+}
+
 type serviceTest struct {
 	client      bundlepb.BundleClient
 	ds          *fakedatastore.DataStore
+	clk         *clock.Mock
 	logHook     *test.Hook
 	up          *fakeUpstreamPublisher
+	rr          *fakeRefreshReporter
 	rateLimiter *fakeRateLimiter
 	done        func()
 	isAdmin     bool
@@ -1879,11 +2208,15 @@ func (c *serviceTest) Cleanup() {
 func setupServiceTest(t *testing.T) *serviceTest {
 	ds := fakedatastore.New(t)
 	up := new(fakeUpstreamPublisher)
+	rr := new(fakeRefreshReporter)
 	rateLimiter := new(fakeRateLimiter)
+	clk := clock.NewMock(t)
 	service := bundle.New(bundle.Config{
 		DataStore:         ds,
 		TrustDomain:       serverTrustDomain,
 		UpstreamPublisher: up,
+		Clock:             clk,
+		RefreshReporter:   rr,
 	})
 
 	log, logHook := test.NewNullLogger()
@@ -1894,8 +2227,10 @@ func setupServiceTest(t *testing.T) *serviceTest {
 
 	test := &serviceTest{
 		ds:          ds,
+		clk:         clk,
 		logHook:     logHook,
 		up:          up,
+		rr:          rr,
 		rateLimiter: rateLimiter,
 	}
 
@@ -1925,6 +2260,14 @@ func setupServiceTest(t *testing.T) *serviceTest {
 	return test
 }
 
+// makeUpdatedBundle returns the valid bundle as stored after it replaced
+// a different bundle, which bumps the sequence number.
+func makeUpdatedBundle(t *testing.T, td spiffeid.TrustDomain) *types.Bundle {
+	b := makeValidBundle(t, td)
+	b.SequenceNumber = 1
+	return b
+}
+
 func makeValidBundle(t *testing.T, td spiffeid.TrustDomain) *types.Bundle {
 	b, err := spiffebundle.Parse(td, bundleBytes)
 	require.NoError(t, err)
@@ -1991,6 +2334,14 @@ func (f *fakeUpstreamPublisher) PublishJWTKey(ctx context.Context, jwtKey *commo
 	return []*common.PublicKey{jwtKey}, nil
 }
 
+type fakeRefreshReporter struct {
+	statuses []client.TrustDomainStatus
+}
+
+func (f *fakeRefreshReporter) TrustDomainStatuses() []client.TrustDomainStatus {
+	return f.statuses
+}
+
 type fakeRateLimiter struct {
 	count int
 	err   error
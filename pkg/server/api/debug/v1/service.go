@@ -3,6 +3,7 @@ package debug
 import (
 	"context"
 	"crypto/x509"
+	"runtime"
 	"sync"
 	"time"
 
@@ -10,8 +11,11 @@ import (
 	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
+	"github.com/spiffe/spire/pkg/common/version"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/bundle/client"
+	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/pkg/server/svid"
 	"github.com/spiffe/spire/proto/spire/api/server/debug/v1"
@@ -30,6 +34,22 @@ func RegisterService(s *grpc.Server, service *Service) {
 	debug.RegisterDebugServer(s, service)
 }
 
+// CASlotReporter reports the state of the server CA slots
+type CASlotReporter interface {
+	SlotStates() []ca.SlotState
+}
+
+// CAJournal verifies and repairs the server CA journal
+type CAJournal interface {
+	VerifyJournal(ctx context.Context) ([]ca.JournalProblem, error)
+	RepairJournal(ctx context.Context, reinitialize bool) ([]ca.JournalProblem, error)
+}
+
+// FederationReporter reports the status of the federation relationships
+type FederationReporter interface {
+	TrustDomainStatuses() []client.TrustDomainStatus
+}
+
 // Config configurations for debug service
 type Config struct {
 	Clock        clock.Clock
@@ -37,16 +57,34 @@ type Config struct {
 	SVIDObserver svid.Observer
 	TrustDomain  spiffeid.TrustDomain
 	Uptime       func() time.Duration
+
+	// CASlots reports the CA slots, if set
+	CASlots CASlotReporter
+
+	// CAJournal verifies and repairs the CA journal, if set
+	CAJournal CAJournal
+
+	// Federation reports the federation relationships, if set
+	Federation FederationReporter
+
+	// BundleEndpointURL is the URL other trust domains reach the bundle
+	// endpoint at, if the bundle endpoint is served
+	BundleEndpointURL string
 }
 
 // New creates a new debug service
 func New(config Config) *Service {
 	return &Service{
-		clock:  config.Clock,
-		ds:     config.DataStore,
-		so:     config.SVIDObserver,
-		td:     config.TrustDomain,
-		uptime: config.Uptime,
+		clock:      config.Clock,
+		ds:         config.DataStore,
+		so:         config.SVIDObserver,
+		td:         config.TrustDomain,
+		uptime:     config.Uptime,
+		caSlots:    config.CASlots,
+		caJournal:  config.CAJournal,
+		federation: config.Federation,
+
+		bundleEndpointURL: config.BundleEndpointURL,
 	}
 }
 
@@ -60,6 +98,12 @@ type Service struct {
 	td     spiffeid.TrustDomain
 	uptime func() time.Duration
 
+	caSlots    CASlotReporter
+	caJournal  CAJournal
+	federation FederationReporter
+
+	bundleEndpointURL string
+
 	getInfoResp getInfoResp
 }
 
@@ -106,12 +150,57 @@ func (s *Service) GetInfo(ctx context.Context, req *debug.GetInfoRequest) (*debu
 			FederatedBundlesCount: bundles.Bundles,
 			SvidChain:             svidChain,
 			Uptime:                int32(s.uptime().Seconds()),
+			BuildInfo: &debug.GetInfoResponse_BuildInfo{
+				Version:   version.Version(),
+				GoVersion: runtime.Version(),
+			},
+			CaSlots:                 s.getCASlots(),
+			FederationRelationships: s.getFederationRelationships(),
+			BundleEndpointUrl:       s.bundleEndpointURL,
 		}
 	}
 
 	return s.getInfoResp.resp, nil
 }
 
+// VerifyCAJournal verifies the CA journal against the KeyManager and the
+// trust domain bundle
+func (s *Service) VerifyCAJournal(ctx context.Context, req *debug.VerifyCAJournalRequest) (*debug.VerifyCAJournalResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	if s.caJournal == nil {
+		return nil, api.MakeErr(log, codes.Unimplemented, "CA journal is not available", nil)
+	}
+
+	problems, err := s.caJournal.VerifyJournal(ctx)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to verify CA journal", err)
+	}
+
+	return &debug.VerifyCAJournalResponse{
+		Problems: caJournalProblems(problems),
+	}, nil
+}
+
+// RepairCAJournal repairs the problems found in the CA journal, or
+// reinitializes it
+func (s *Service) RepairCAJournal(ctx context.Context, req *debug.RepairCAJournalRequest) (*debug.RepairCAJournalResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	if s.caJournal == nil {
+		return nil, api.MakeErr(log, codes.Unimplemented, "CA journal is not available", nil)
+	}
+
+	problems, err := s.caJournal.RepairJournal(ctx, req.Reinitialize)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to repair CA journal", err)
+	}
+
+	return &debug.RepairCAJournalResponse{
+		Problems: caJournalProblems(problems),
+	}, nil
+}
+
 func (s *Service) getCertificateChain(ctx context.Context, log logrus.FieldLogger) ([]*debug.GetInfoResponse_Cert, error) {
 	trustDomainID := s.td.IDString()
 
@@ -157,6 +246,111 @@ func (s *Service) getCertificateChain(ctx context.Context, log logrus.FieldLogge
 	return svidChain, nil
 }
 
+func (s *Service) getCASlots() []*debug.GetInfoResponse_CASlot {
+	if s.caSlots == nil {
+		return nil
+	}
+
+	var caSlots []*debug.GetInfoResponse_CASlot
+	for _, slot := range s.caSlots.SlotStates() {
+		caSlots = append(caSlots, &debug.GetInfoResponse_CASlot{
+			Id:        slot.ID,
+			Kind:      caSlotKind(slot.Kind),
+			Status:    caSlotStatus(slot.Status),
+			IssuedAt:  unixOrZero(slot.IssuedAt),
+			ExpiresAt: unixOrZero(slot.ExpiresAt),
+		})
+	}
+	return caSlots
+}
+
+func (s *Service) getFederationRelationships() []*debug.GetInfoResponse_FederationRelationship {
+	if s.federation == nil {
+		return nil
+	}
+
+	var relationships []*debug.GetInfoResponse_FederationRelationship
+	for _, status := range s.federation.TrustDomainStatuses() {
+		relationships = append(relationships, &debug.GetInfoResponse_FederationRelationship{
+			TrustDomain:         status.TrustDomain,
+			BundleEndpointUrl:   status.EndpointURL,
+			LastAttemptAt:       unixOrZero(status.LastAttempt),
+			LastSuccessAt:       unixOrZero(status.LastSuccess),
+			ConsecutiveFailures: int32(status.ConsecutiveFailures),
+			LastError:           status.LastError,
+		})
+	}
+	return relationships
+}
+
+func caSlotKind(kind ca.SlotKind) debug.GetInfoResponse_CASlot_Kind {
+	switch kind {
+	case ca.SlotKindX509CA:
+		return debug.GetInfoResponse_CASlot_X509_CA
+	case ca.SlotKindJWTKey:
+		return debug.GetInfoResponse_CASlot_JWT_KEY
+	default:
+		return debug.GetInfoResponse_CASlot_UNKNOWN_KIND
+	}
+}
+
+func caJournalProblems(problems []ca.JournalProblem) []*debug.CAJournalProblem {
+	var out []*debug.CAJournalProblem
+	for _, problem := range problems {
+		out = append(out, &debug.CAJournalProblem{
+			Kind:     caJournalProblemKind(problem.Kind),
+			SlotId:   problem.SlotID,
+			IssuedAt: unixOrZero(problem.IssuedAt),
+			Problem:  problem.Problem,
+			Repair:   caJournalRepair(problem.Repair),
+		})
+	}
+	return out
+}
+
+func caJournalProblemKind(kind ca.SlotKind) debug.CAJournalProblem_Kind {
+	switch kind {
+	case ca.SlotKindX509CA:
+		return debug.CAJournalProblem_X509_CA
+	case ca.SlotKindJWTKey:
+		return debug.CAJournalProblem_JWT_KEY
+	default:
+		return debug.CAJournalProblem_UNKNOWN_KIND
+	}
+}
+
+func caJournalRepair(repair ca.JournalRepair) debug.CAJournalProblem_Repair {
+	switch repair {
+	case ca.JournalRepairDropEntry:
+		return debug.CAJournalProblem_DROP_ENTRY
+	case ca.JournalRepairAppendBundle:
+		return debug.CAJournalProblem_APPEND_BUNDLE
+	default:
+		return debug.CAJournalProblem_UNKNOWN_REPAIR
+	}
+}
+
+func caSlotStatus(status ca.SlotStatus) debug.GetInfoResponse_CASlot_Status {
+	switch status {
+	case ca.SlotActive:
+		return debug.GetInfoResponse_CASlot_ACTIVE
+	case ca.SlotPrepared:
+		return debug.GetInfoResponse_CASlot_PREPARED
+	case ca.SlotEmpty:
+		return debug.GetInfoResponse_CASlot_EMPTY
+	default:
+		return debug.GetInfoResponse_CASlot_UNKNOWN_STATUS
+	}
+}
+
+// unixOrZero returns the Unix time of t, or zero if t is not set
+func unixOrZero(t time.Time) int64 {
+	if t.IsZero() {
+		return 0
+	}
+	return t.Unix()
+}
+
 // spiffeIDFromCert gets types SPIFFE ID from certificate, it can be nil
 func spiffeIDFromCert(cert *x509.Certificate) *types.SPIFFEID {
 	id, err := x509svid.IDFromCert(cert)
@@ -6,6 +6,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"runtime"
 	"testing"
 	"time"
 
@@ -14,9 +15,12 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/common/version"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/pkg/server/api/debug/v1"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/bundle/client"
+	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/pkg/server/svid"
 	debugpb "github.com/spiffe/spire/proto/spire/api/server/debug/v1"
@@ -51,8 +55,8 @@ var (
 
 func TestGetInfo(t *testing.T) {
 	// Create root CA
-	ca := testca.New(t, td)
-	x509SVID := ca.CreateX509SVID(td.NewID("/spire/server"))
+	rootCA := testca.New(t, td)
+	x509SVID := rootCA.CreateX509SVID(td.NewID("/spire/server"))
 	x509SVIDState := svid.State{
 		SVID: x509SVID.Certificates,
 		Key:  x509SVID.PrivateKey.(*ecdsa.PrivateKey),
@@ -67,15 +71,15 @@ func TestGetInfo(t *testing.T) {
 			Subject:   x509SVID.Certificates[0].Subject.String(),
 		},
 		{
-			ExpiresAt: ca.X509Authorities()[0].NotAfter.Unix(),
-			Subject:   ca.X509Authorities()[0].Subject.String(),
+			ExpiresAt: rootCA.X509Authorities()[0].NotAfter.Unix(),
+			Subject:   rootCA.X509Authorities()[0].Subject.String(),
 		},
 	}
 
 	// Create intermediate with SPIFFE ID and subject
 	now := time.Now()
 	intermediateCANoAfter := now.Add(2 * time.Minute)
-	intermediateCA := ca.ChildCA(testca.WithURIs(td.ID().URL()),
+	intermediateCA := rootCA.ChildCA(testca.WithURIs(td.ID().URL()),
 		testca.WithLifetime(now, intermediateCANoAfter),
 		testca.WithSubject(pkix.Name{CommonName: "UPSTREAM-1"}))
 
@@ -98,8 +102,8 @@ func TestGetInfo(t *testing.T) {
 			Subject:   "CN=UPSTREAM-1",
 		},
 		{
-			ExpiresAt: ca.X509Authorities()[0].NotAfter.Unix(),
-			Subject:   ca.X509Authorities()[0].Subject.String(),
+			ExpiresAt: rootCA.X509Authorities()[0].NotAfter.Unix(),
+			Subject:   rootCA.X509Authorities()[0].Subject.String(),
 		},
 	}
 
@@ -154,7 +158,7 @@ func TestGetInfo(t *testing.T) {
 		TrustDomainId: td.IDString(),
 		RootCas: []*common.Certificate{
 			{
-				DerBytes: x509util.DERFromCertificates(ca.X509Authorities()),
+				DerBytes: x509util.DERFromCertificates(rootCA.X509Authorities()),
 			},
 		},
 	}
@@ -169,6 +173,35 @@ func TestGetInfo(t *testing.T) {
 		},
 	}
 
+	buildInfo := &debugpb.GetInfoResponse_BuildInfo{
+		Version:   version.Version(),
+		GoVersion: runtime.Version(),
+	}
+
+	slotIssuedAt := now.Add(-time.Hour)
+	slotExpiresAt := now.Add(time.Hour)
+	caSlots := []ca.SlotState{
+		{ID: "A", Kind: ca.SlotKindX509CA, Status: ca.SlotActive, IssuedAt: slotIssuedAt, ExpiresAt: slotExpiresAt},
+		{ID: "B", Kind: ca.SlotKindX509CA, Status: ca.SlotEmpty},
+		{ID: "A", Kind: ca.SlotKindJWTKey, Status: ca.SlotActive, IssuedAt: slotIssuedAt, ExpiresAt: slotExpiresAt},
+		{ID: "B", Kind: ca.SlotKindJWTKey, Status: ca.SlotPrepared, IssuedAt: now, ExpiresAt: slotExpiresAt},
+	}
+	trustDomainStatuses := []client.TrustDomainStatus{
+		{
+			TrustDomain: "domain1.test",
+			EndpointURL: "https://domain1.test/bundle",
+			LastAttempt: now,
+			LastSuccess: now,
+		},
+		{
+			TrustDomain:         "domain2.test",
+			EndpointURL:         "https://domain2.test/bundle",
+			LastAttempt:         now,
+			ConsecutiveFailures: 3,
+			LastError:           "connection refused",
+		},
+	}
+
 	_, expectParseErr := x509.ParseCertificate([]byte{11, 22, 33, 44})
 	require.Error(t, expectParseErr)
 
@@ -184,6 +217,9 @@ func TestGetInfo(t *testing.T) {
 		addToClk  time.Duration
 		initCache bool
 
+		caSlots             []ca.SlotState
+		trustDomainStatuses []client.TrustDomainStatus
+
 		attestedNodes       []*common.AttestedNode
 		bundles             []*common.Bundle
 		registrationEntries []*common.RegistrationEntry
@@ -193,6 +229,7 @@ func TestGetInfo(t *testing.T) {
 		{
 			name: "regular SVID",
 			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
 				FederatedBundlesCount: 1,
 				SvidChain:             x509SVIDChain,
 			},
@@ -202,6 +239,7 @@ func TestGetInfo(t *testing.T) {
 		{
 			name: "SVID with intermediate",
 			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
 				FederatedBundlesCount: 1,
 				SvidChain:             svidWithIntermediateChain,
 			},
@@ -211,6 +249,7 @@ func TestGetInfo(t *testing.T) {
 		{
 			name: "complete data",
 			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
 				SvidChain:             x509SVIDChain,
 				AgentsCount:           2,
 				EntriesCount:          2,
@@ -228,6 +267,7 @@ func TestGetInfo(t *testing.T) {
 			name: "response from cache",
 			// No registration entries and attested nodes expected, those are created after cache is initiated
 			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
 				SvidChain:             x509SVIDChain,
 				FederatedBundlesCount: 2,
 			},
@@ -244,6 +284,7 @@ func TestGetInfo(t *testing.T) {
 			name: "expired cache",
 			// Actual state expected after expiration
 			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
 				SvidChain:             x509SVIDChain,
 				AgentsCount:           2,
 				EntriesCount:          2,
@@ -261,6 +302,61 @@ func TestGetInfo(t *testing.T) {
 			state:               x509SVIDState,
 			initCache:           true,
 		},
+		{
+			name: "CA slots and federation relationships",
+			expectResp: &debugpb.GetInfoResponse{
+				BuildInfo:             buildInfo,
+				FederatedBundlesCount: 1,
+				SvidChain:             x509SVIDChain,
+				CaSlots: []*debugpb.GetInfoResponse_CASlot{
+					{
+						Id:        "A",
+						Kind:      debugpb.GetInfoResponse_CASlot_X509_CA,
+						Status:    debugpb.GetInfoResponse_CASlot_ACTIVE,
+						IssuedAt:  slotIssuedAt.Unix(),
+						ExpiresAt: slotExpiresAt.Unix(),
+					},
+					{
+						Id:     "B",
+						Kind:   debugpb.GetInfoResponse_CASlot_X509_CA,
+						Status: debugpb.GetInfoResponse_CASlot_EMPTY,
+					},
+					{
+						Id:        "A",
+						Kind:      debugpb.GetInfoResponse_CASlot_JWT_KEY,
+						Status:    debugpb.GetInfoResponse_CASlot_ACTIVE,
+						IssuedAt:  slotIssuedAt.Unix(),
+						ExpiresAt: slotExpiresAt.Unix(),
+					},
+					{
+						Id:        "B",
+						Kind:      debugpb.GetInfoResponse_CASlot_JWT_KEY,
+						Status:    debugpb.GetInfoResponse_CASlot_PREPARED,
+						IssuedAt:  now.Unix(),
+						ExpiresAt: slotExpiresAt.Unix(),
+					},
+				},
+				FederationRelationships: []*debugpb.GetInfoResponse_FederationRelationship{
+					{
+						TrustDomain:       "domain1.test",
+						BundleEndpointUrl: "https://domain1.test/bundle",
+						LastAttemptAt:     now.Unix(),
+						LastSuccessAt:     now.Unix(),
+					},
+					{
+						TrustDomain:         "domain2.test",
+						BundleEndpointUrl:   "https://domain2.test/bundle",
+						LastAttemptAt:       now.Unix(),
+						ConsecutiveFailures: 3,
+						LastError:           "connection refused",
+					},
+				},
+			},
+			bundles:             []*common.Bundle{commonCABundle},
+			state:               x509SVIDState,
+			caSlots:             caSlots,
+			trustDomainStatuses: trustDomainStatuses,
+		},
 		{
 			name:     "failed to count attested nodes",
 			dsErrors: []error{errors.New("some error")},
@@ -384,6 +480,8 @@ func TestGetInfo(t *testing.T) {
 				test.ds.AppendNextError(err)
 			}
 			test.so.state = tt.state
+			test.reporter.caSlots = tt.caSlots
+			test.reporter.trustDomainStatuses = tt.trustDomainStatuses
 			for _, bundle := range tt.bundles {
 				_, err := test.ds.CreateBundle(ctx, &datastore.CreateBundleRequest{
 					Bundle: bundle,
@@ -427,15 +525,86 @@ func TestGetInfo(t *testing.T) {
 	}
 }
 
+func TestVerifyCAJournal(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	test.reporter.journalProblems = journalProblems
+	resp, err := test.client.VerifyCAJournal(ctx, &debugpb.VerifyCAJournalRequest{})
+	require.NoError(t, err)
+	spiretest.RequireProtoEqual(t, &debugpb.VerifyCAJournalResponse{
+		Problems: expectedJournalProblems,
+	}, resp)
+
+	test.reporter.journalErr = errors.New("oh no")
+	_, err = test.client.VerifyCAJournal(ctx, &debugpb.VerifyCAJournalRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.Internal, "failed to verify CA journal: oh no")
+}
+
+func TestRepairCAJournal(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	test.reporter.journalProblems = journalProblems
+	resp, err := test.client.RepairCAJournal(ctx, &debugpb.RepairCAJournalRequest{Reinitialize: true})
+	require.NoError(t, err)
+	require.True(t, test.reporter.reinitialized)
+	spiretest.RequireProtoEqual(t, &debugpb.RepairCAJournalResponse{
+		Problems: expectedJournalProblems,
+	}, resp)
+
+	test.reporter.journalErr = errors.New("oh no")
+	_, err = test.client.RepairCAJournal(ctx, &debugpb.RepairCAJournalRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.Internal, "failed to repair CA journal: oh no")
+	require.False(t, test.reporter.reinitialized)
+}
+
+var (
+	journalProblems = []ca.JournalProblem{
+		{
+			Kind:     ca.SlotKindX509CA,
+			SlotID:   "A",
+			IssuedAt: time.Unix(1000, 0),
+			Problem:  "no key manager key",
+			Repair:   ca.JournalRepairDropEntry,
+		},
+		{
+			Kind:     ca.SlotKindJWTKey,
+			SlotID:   "B",
+			IssuedAt: time.Unix(2000, 0),
+			Problem:  "public key is not in the trust domain bundle",
+			Repair:   ca.JournalRepairAppendBundle,
+		},
+	}
+
+	expectedJournalProblems = []*debugpb.CAJournalProblem{
+		{
+			Kind:     debugpb.CAJournalProblem_X509_CA,
+			SlotId:   "A",
+			IssuedAt: 1000,
+			Problem:  "no key manager key",
+			Repair:   debugpb.CAJournalProblem_DROP_ENTRY,
+		},
+		{
+			Kind:     debugpb.CAJournalProblem_JWT_KEY,
+			SlotId:   "B",
+			IssuedAt: 2000,
+			Problem:  "public key is not in the trust domain bundle",
+			Repair:   debugpb.CAJournalProblem_APPEND_BUNDLE,
+		},
+	}
+)
+
 type serviceTest struct {
 	client debugpb.DebugClient
 	done   func()
 
-	clk     *clock.Mock
-	logHook *test.Hook
-	ds      *fakedatastore.DataStore
-	so      *fakeObserver
-	uptime  *fakeUptime
+	clk      *clock.Mock
+	logHook  *test.Hook
+	ds       *fakedatastore.DataStore
+	so       *fakeObserver
+	uptime   *fakeUptime
+	reporter *fakeReporter
 }
 
 func (s *serviceTest) Cleanup() {
@@ -452,6 +621,7 @@ func setupServiceTest(t *testing.T) *serviceTest {
 		clk:   clk,
 	}
 	observer := &fakeObserver{}
+	reporter := &fakeReporter{}
 
 	service := debug.New(debug.Config{
 		Clock:        clk,
@@ -459,14 +629,18 @@ func setupServiceTest(t *testing.T) *serviceTest {
 		SVIDObserver: observer,
 		TrustDomain:  td,
 		Uptime:       fakeUptime.uptime,
+		CASlots:      reporter,
+		CAJournal:    reporter,
+		Federation:   reporter,
 	})
 
 	test := &serviceTest{
-		clk:     clk,
-		ds:      ds,
-		logHook: logHook,
-		so:      observer,
-		uptime:  fakeUptime,
+		clk:      clk,
+		ds:       ds,
+		logHook:  logHook,
+		so:       observer,
+		uptime:   fakeUptime,
+		reporter: reporter,
 	}
 
 	registerFn := func(s *grpc.Server) {
@@ -500,3 +674,29 @@ type fakeUptime struct {
 func (f *fakeUptime) uptime() time.Duration {
 	return f.clk.Now().Sub(f.start)
 }
+
+type fakeReporter struct {
+	caSlots             []ca.SlotState
+	trustDomainStatuses []client.TrustDomainStatus
+
+	journalProblems []ca.JournalProblem
+	journalErr      error
+	reinitialized   bool
+}
+
+func (r *fakeReporter) SlotStates() []ca.SlotState {
+	return r.caSlots
+}
+
+func (r *fakeReporter) VerifyJournal(ctx context.Context) ([]ca.JournalProblem, error) {
+	return r.journalProblems, r.journalErr
+}
+
+func (r *fakeReporter) RepairJournal(ctx context.Context, reinitialize bool) ([]ca.JournalProblem, error) {
+	r.reinitialized = reinitialize
+	return r.journalProblems, r.journalErr
+}
+
+func (r *fakeReporter) TrustDomainStatuses() []client.TrustDomainStatus {
+	return r.trustDomainStatuses
+}
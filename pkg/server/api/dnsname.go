@@ -0,0 +1,72 @@
+package api
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// DNSNamePolicy restricts the DNS names registration entries can have and
+// X509-SVIDs can be signed for. A DNS name is allowed if it is within one of
+// the allowed domains or matches one of the allowed patterns. A nil policy
+// allows any DNS name.
+type DNSNamePolicy struct {
+	allowedDomains  []string
+	allowedPatterns []*regexp.Regexp
+}
+
+// NewDNSNamePolicy creates a DNS name policy. Allowed domains allow the
+// domain itself and any name under it (e.g. "example.org" allows
+// "example.org" and "foo.example.org"). Allowed patterns are regular
+// expressions a DNS name must match in full.
+func NewDNSNamePolicy(allowedDomains, allowedPatterns []string) (*DNSNamePolicy, error) {
+	if len(allowedDomains) == 0 && len(allowedPatterns) == 0 {
+		return nil, errors.New("at least one allowed domain or pattern is required")
+	}
+
+	p := new(DNSNamePolicy)
+	for _, domain := range allowedDomains {
+		domain = strings.ToLower(strings.Trim(domain, "."))
+		if domain == "" {
+			return nil, errors.New("allowed domain cannot be empty")
+		}
+		p.allowedDomains = append(p.allowedDomains, domain)
+	}
+	for _, pattern := range allowedPatterns {
+		re, err := regexp.Compile("^(?:" + pattern + ")$")
+		if err != nil {
+			return nil, fmt.Errorf("invalid allowed pattern %q: %v", pattern, err)
+		}
+		p.allowedPatterns = append(p.allowedPatterns, re)
+	}
+	return p, nil
+}
+
+// Check returns an error if any of the DNS names is not allowed by the
+// policy.
+func (p *DNSNamePolicy) Check(dnsNames []string) error {
+	if p == nil {
+		return nil
+	}
+	for _, dnsName := range dnsNames {
+		if !p.allows(strings.ToLower(dnsName)) {
+			return fmt.Errorf("DNS name %q is not allowed by the DNS name policy", dnsName)
+		}
+	}
+	return nil
+}
+
+func (p *DNSNamePolicy) allows(dnsName string) bool {
+	for _, domain := range p.allowedDomains {
+		if dnsName == domain || strings.HasSuffix(dnsName, "."+domain) {
+			return true
+		}
+	}
+	for _, re := range p.allowedPatterns {
+		if re.MatchString(dnsName) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package api_test
+
+import (
+	"testing"
+
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDNSNamePolicy(t *testing.T) {
+	policy, err := api.NewDNSNamePolicy([]string{"example.org", ".Team.Test."}, []string{`web-[0-9]+\.internal`})
+	require.NoError(t, err)
+
+	for _, dnsName := range []string{
+		"example.org",
+		"foo.example.org",
+		"*.foo.example.org",
+		"FOO.TEAM.TEST",
+		"web-1.internal",
+	} {
+		require.NoError(t, policy.Check([]string{dnsName}), dnsName)
+	}
+
+	for _, dnsName := range []string{
+		"badexample.org",
+		"example.org.evil.test",
+		"team.test.evil",
+		"web-x.internal",
+		"foo.web-1.internal",
+	} {
+		require.EqualError(t, policy.Check([]string{"foo.example.org", dnsName}), `DNS name "`+dnsName+`" is not allowed by the DNS name policy`)
+	}
+
+	// A nil policy allows everything
+	var nilPolicy *api.DNSNamePolicy
+	require.NoError(t, nilPolicy.Check([]string{"anything.test"}))
+}
+
+func TestNewDNSNamePolicy(t *testing.T) {
+	_, err := api.NewDNSNamePolicy(nil, nil)
+	require.EqualError(t, err, "at least one allowed domain or pattern is required")
+
+	_, err = api.NewDNSNamePolicy([]string{"."}, nil)
+	require.EqualError(t, err, "allowed domain cannot be empty")
+
+	_, err = api.NewDNSNamePolicy(nil, []string{"("})
+	require.EqualError(t, err, "invalid allowed pattern \"(\": error parsing regexp: missing closing ): `^(?:()$`")
+}
@@ -5,6 +5,8 @@ import (
 	"fmt"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/fflag"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/protoutil"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/proto/spire/common"
@@ -53,17 +55,19 @@ func RegistrationEntryToProto(e *common.RegistrationEntry) (*types.Entry, error)
 	}
 
 	return &types.Entry{
-		Id:             e.EntryId,
-		SpiffeId:       ProtoFromID(spiffeID),
-		ParentId:       ProtoFromID(parentID),
-		Selectors:      ProtoFromSelectors(e.Selectors),
-		Ttl:            e.Ttl,
-		FederatesWith:  federatesWith,
-		Admin:          e.Admin,
-		Downstream:     e.Downstream,
-		ExpiresAt:      e.EntryExpiry,
-		DnsNames:       append([]string(nil), e.DnsNames...),
-		RevisionNumber: e.RevisionNumber,
+		Id:               e.EntryId,
+		SpiffeId:         ProtoFromID(spiffeID),
+		ParentId:         ProtoFromID(parentID),
+		Selectors:        ProtoFromSelectors(e.Selectors),
+		Ttl:              e.Ttl,
+		FederatesWith:    federatesWith,
+		Admin:            e.Admin,
+		Downstream:       e.Downstream,
+		ExpiresAt:        e.EntryExpiry,
+		DnsNames:         append([]string(nil), e.DnsNames...),
+		RevisionNumber:   e.RevisionNumber,
+		AllowedAudiences: append([]string(nil), e.AllowedAudiences...),
+		CreatedAt:        e.CreatedAt,
 	}, nil
 }
 
@@ -102,6 +106,19 @@ func ProtoToRegistrationEntryWithMask(td spiffeid.TrustDomain, e *types.Entry, m
 			return nil, fmt.Errorf("invalid spiffe ID: %v", err)
 		}
 		spiffeIDString = spiffeID.String()
+		if idutil.IsTemplate(spiffeIDString) {
+			if !fflag.IsSet(fflag.FlagSPIFFEIDTemplates) {
+				return nil, fmt.Errorf("invalid spiffe ID: SPIFFE ID templates require the %q feature flag", fflag.FlagSPIFFEIDTemplates)
+			}
+			if err := idutil.ValidateTemplate(spiffeIDString); err != nil {
+				return nil, fmt.Errorf("invalid spiffe ID: %v", err)
+			}
+			// Node entries are matched against the selectors of agents,
+			// which never expand templates.
+			if parentIDString == td.NewID(idutil.ServerIDPath).String() {
+				return nil, errors.New("invalid spiffe ID: SPIFFE ID templates are not supported for node entries")
+			}
+		}
 	}
 
 	var admin bool
@@ -164,17 +181,51 @@ func ProtoToRegistrationEntryWithMask(td spiffeid.TrustDomain, e *types.Entry, m
 		revisionNumber = e.RevisionNumber
 	}
 
+	var allowedAudiences []string
+	if mask.AllowedAudiences {
+		allowedAudiences = make([]string, 0, len(e.AllowedAudiences))
+		for _, audience := range e.AllowedAudiences {
+			if audience == "" {
+				return nil, errors.New("invalid allowed audience: audience is empty")
+			}
+			allowedAudiences = append(allowedAudiences, audience)
+		}
+	}
+
 	return &common.RegistrationEntry{
-		EntryId:        e.Id,
-		ParentId:       parentIDString,
-		SpiffeId:       spiffeIDString,
-		Admin:          admin,
-		DnsNames:       dnsNames,
-		Downstream:     downstream,
-		EntryExpiry:    expiresAt,
-		FederatesWith:  federatesWith,
-		Selectors:      selectors,
-		Ttl:            ttl,
-		RevisionNumber: revisionNumber,
+		EntryId:          e.Id,
+		ParentId:         parentIDString,
+		SpiffeId:         spiffeIDString,
+		Admin:            admin,
+		DnsNames:         dnsNames,
+		Downstream:       downstream,
+		EntryExpiry:      expiresAt,
+		FederatesWith:    federatesWith,
+		Selectors:        selectors,
+		Ttl:              ttl,
+		RevisionNumber:   revisionNumber,
+		AllowedAudiences: allowedAudiences,
 	}, nil
 }
+
+// AudiencesAllowed returns whether JWT-SVIDs can be minted for the audience
+// by an entry with the given allowed audiences. Any audience is allowed if
+// the entry has no allowed audiences.
+func AudiencesAllowed(allowedAudiences, audience []string) bool {
+	if len(allowedAudiences) == 0 {
+		return true
+	}
+	for _, aud := range audience {
+		allowed := false
+		for _, allowedAudience := range allowedAudiences {
+			if aud == allowedAudience {
+				allowed = true
+				break
+			}
+		}
+		if !allowed {
+			return false
+		}
+	}
+	return true
+}
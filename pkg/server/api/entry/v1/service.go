@@ -29,14 +29,18 @@ type Config struct {
 	TrustDomain  spiffeid.TrustDomain
 	EntryFetcher api.AuthorizedEntryFetcher
 	DataStore    datastore.DataStore
+
+	// DNSNamePolicy, if set, restricts the DNS names of entries
+	DNSNamePolicy *api.DNSNamePolicy
 }
 
 // New creates a new entry service
 func New(config Config) *Service {
 	return &Service{
-		td: config.TrustDomain,
-		ds: config.DataStore,
-		ef: config.EntryFetcher,
+		td:        config.TrustDomain,
+		ds:        config.DataStore,
+		ef:        config.EntryFetcher,
+		dnsPolicy: config.DNSNamePolicy,
 	}
 }
 
@@ -44,9 +48,10 @@ func New(config Config) *Service {
 type Service struct {
 	entry.UnsafeEntryServer
 
-	td spiffeid.TrustDomain
-	ds datastore.DataStore
-	ef api.AuthorizedEntryFetcher
+	td        spiffeid.TrustDomain
+	ds        datastore.DataStore
+	ef        api.AuthorizedEntryFetcher
+	dnsPolicy *api.DNSNamePolicy
 }
 
 func (s *Service) ListEntries(ctx context.Context, req *entry.ListEntriesRequest) (*entry.ListEntriesResponse, error) {
@@ -188,6 +193,12 @@ func (s *Service) createEntry(ctx context.Context, e *types.Entry, outputMask *t
 
 	log = log.WithField(telemetry.SPIFFEID, cEntry.SpiffeId)
 
+	if err := s.dnsPolicy.Check(cEntry.DnsNames); err != nil {
+		return &entry.BatchCreateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.InvalidArgument, "entry DNS names are not allowed", err),
+		}
+	}
+
 	existingEntry, err := s.getExistingEntry(ctx, cEntry)
 	if err != nil {
 		return &entry.BatchCreateEntryResponse_Result{
@@ -305,6 +316,84 @@ func (s *Service) GetAuthorizedEntries(ctx context.Context, req *entry.GetAuthor
 	return resp, nil
 }
 
+func (s *Service) GetAgentAuthorizedEntries(ctx context.Context, req *entry.GetAgentAuthorizedEntriesRequest) (*entry.GetAgentAuthorizedEntriesResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	agentID, err := api.TrustDomainAgentIDFromProto(s.td, req.AgentId)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "invalid agent ID", err)
+	}
+	log = log.WithField(telemetry.AgentID, agentID.String())
+
+	resp, err := s.ds.FetchAttestedNode(ctx, &datastore.FetchAttestedNodeRequest{
+		SpiffeId: agentID.String(),
+	})
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch agent", err)
+	}
+	if resp.Node == nil {
+		return nil, api.MakeErr(log, codes.NotFound, "agent not found", nil)
+	}
+
+	entries, err := s.ef.FetchAuthorizedEntries(ctx, agentID)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch entries", err)
+	}
+	for _, entry := range entries {
+		applyMask(entry, req.OutputMask)
+	}
+
+	return &entry.GetAgentAuthorizedEntriesResponse{
+		Entries: entries,
+	}, nil
+}
+
+func (s *Service) ListEntryTombstones(ctx context.Context, req *entry.ListEntryTombstonesRequest) (*entry.ListEntryTombstonesResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	listReq := &datastore.ListRegistrationEntryTombstonesRequest{
+		DeletedSince: req.DeletedSince,
+	}
+	if req.PageSize > 0 {
+		listReq.Pagination = &datastore.Pagination{
+			PageSize: req.PageSize,
+			Token:    req.PageToken,
+		}
+	}
+
+	dsResp, err := s.ds.ListRegistrationEntryTombstones(ctx, listReq)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to list entry tombstones", err)
+	}
+
+	resp := &entry.ListEntryTombstonesResponse{}
+	if dsResp.Pagination != nil {
+		resp.NextPageToken = dsResp.Pagination.Token
+	}
+
+	for _, tombstone := range dsResp.Tombstones {
+		spiffeID, err := spiffeid.FromString(tombstone.SpiffeId)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to convert entry tombstone: %q", tombstone.EntryId)
+			continue
+		}
+		parentID, err := spiffeid.FromString(tombstone.ParentId)
+		if err != nil {
+			log.WithError(err).Errorf("Failed to convert entry tombstone: %q", tombstone.EntryId)
+			continue
+		}
+		resp.Tombstones = append(resp.Tombstones, &entry.EntryTombstone{
+			Id:             tombstone.EntryId,
+			SpiffeId:       api.ProtoFromID(spiffeID),
+			ParentId:       api.ProtoFromID(parentID),
+			RevisionNumber: tombstone.RevisionNumber,
+			DeletedAt:      tombstone.DeletedAt,
+		})
+	}
+
+	return resp, nil
+}
+
 // fetchEntries fetches authorized entries using caller ID from context
 func (s *Service) fetchEntries(ctx context.Context, log logrus.FieldLogger) ([]*types.Entry, error) {
 	callerID, ok := rpccontext.CallerID(ctx)
@@ -364,6 +453,14 @@ func applyMask(e *types.Entry, mask *types.EntryMask) {
 	if !mask.RevisionNumber {
 		e.RevisionNumber = 0
 	}
+
+	if !mask.AllowedAudiences {
+		e.AllowedAudiences = nil
+	}
+
+	if !mask.CreatedAt {
+		e.CreatedAt = 0
+	}
 }
 
 func (s *Service) getExistingEntry(ctx context.Context, e *common.RegistrationEntry) (*common.RegistrationEntry, error) {
@@ -401,26 +498,40 @@ func (s *Service) updateEntry(ctx context.Context, e *types.Entry, inputMask *ty
 		}
 	}
 
+	if inputMask == nil || inputMask.DnsNames {
+		if err := s.dnsPolicy.Check(convEntry.DnsNames); err != nil {
+			return &entry.BatchUpdateEntryResponse_Result{
+				Status: api.MakeStatus(log, codes.InvalidArgument, "entry DNS names are not allowed", err),
+			}
+		}
+	}
+
 	var resp *datastore.UpdateRegistrationEntryResponse
 	if inputMask != nil {
 		resp, err = s.ds.UpdateRegistrationEntry(ctx, &datastore.UpdateRegistrationEntryRequest{
 			Entry: convEntry,
 			Mask: &common.RegistrationEntryMask{
-				SpiffeId:      inputMask.SpiffeId,
-				ParentId:      inputMask.ParentId,
-				Ttl:           inputMask.Ttl,
-				FederatesWith: inputMask.FederatesWith,
-				Admin:         inputMask.Admin,
-				Downstream:    inputMask.Downstream,
-				EntryExpiry:   inputMask.ExpiresAt,
-				DnsNames:      inputMask.DnsNames,
-				Selectors:     inputMask.Selectors,
+				SpiffeId:         inputMask.SpiffeId,
+				ParentId:         inputMask.ParentId,
+				Ttl:              inputMask.Ttl,
+				FederatesWith:    inputMask.FederatesWith,
+				Admin:            inputMask.Admin,
+				Downstream:       inputMask.Downstream,
+				EntryExpiry:      inputMask.ExpiresAt,
+				DnsNames:         inputMask.DnsNames,
+				Selectors:        inputMask.Selectors,
+				AllowedAudiences: inputMask.AllowedAudiences,
 			}})
 	} else {
 		resp, err = s.ds.UpdateRegistrationEntry(ctx, &datastore.UpdateRegistrationEntryRequest{Entry: convEntry})
 	}
 
-	if err != nil {
+	switch {
+	case status.Code(err) == codes.Aborted:
+		return &entry.BatchUpdateEntryResponse_Result{
+			Status: api.MakeStatus(log, codes.Aborted, "entry revision is stale", err),
+		}
+	case err != nil:
 		return &entry.BatchUpdateEntryResponse_Result{
 			Status: api.MakeStatus(log, codes.Internal, "failed to update entry", err),
 		}
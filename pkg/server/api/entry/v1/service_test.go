@@ -119,6 +119,7 @@ func TestListEntries(t *testing.T) {
 		FederatesWith: []string{
 			federatedTd.String(),
 		},
+		CreatedAt: childEntry.Entry.CreatedAt,
 	}
 
 	expectedSecondChild := &types.Entry{
@@ -132,6 +133,7 @@ func TestListEntries(t *testing.T) {
 			federatedTd.String(),
 			secondFederatedTd.String(),
 		},
+		CreatedAt: secondChildEntry.Entry.CreatedAt,
 	}
 
 	for _, tt := range []struct {
@@ -554,6 +556,7 @@ func TestGetEntry(t *testing.T) {
 				DnsNames:      []string{"dns1", "dns2"},
 				Downstream:    true,
 				ExpiresAt:     expiresAt,
+				CreatedAt:     goodEntry.Entry.CreatedAt,
 			},
 		},
 		{
@@ -1212,6 +1215,90 @@ func TestBatchDeleteEntry(t *testing.T) {
 	}
 }
 
+func TestListEntryTombstones(t *testing.T) {
+	ds := fakedatastore.New(t)
+	test := setupServiceTest(t, ds)
+	defer test.Cleanup()
+
+	parentID := td.NewID("host")
+	fooID := td.NewID("foo")
+	barID := td.NewID("bar")
+	entries := createTestEntries(t, ds,
+		&common.RegistrationEntry{
+			ParentId:  parentID.String(),
+			SpiffeId:  fooID.String(),
+			Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+		},
+		&common.RegistrationEntry{
+			ParentId:  parentID.String(),
+			SpiffeId:  barID.String(),
+			Selectors: []*common.Selector{{Type: "not", Value: "relevant"}},
+		},
+	)
+	fooEntryID := entries[fooID.String()].EntryId
+	barEntryID := entries[barID.String()].EntryId
+
+	since := time.Now().Add(-time.Second).Unix()
+	_, err := test.client.BatchDeleteEntry(ctx, &entrypb.BatchDeleteEntryRequest{
+		Ids: []string{fooEntryID, barEntryID},
+	})
+	require.NoError(t, err)
+
+	resp, err := test.client.ListEntryTombstones(ctx, &entrypb.ListEntryTombstonesRequest{
+		DeletedSince: since,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tombstones, 2)
+	require.Empty(t, resp.NextPageToken)
+	for _, tombstone := range resp.Tombstones {
+		assert.GreaterOrEqual(t, tombstone.DeletedAt, since)
+		tombstone.DeletedAt = 0
+	}
+	spiretest.AssertProtoListEqual(t, []*entrypb.EntryTombstone{
+		{
+			Id:             fooEntryID,
+			SpiffeId:       api.ProtoFromID(fooID),
+			ParentId:       api.ProtoFromID(parentID),
+			RevisionNumber: 1,
+		},
+		{
+			Id:             barEntryID,
+			SpiffeId:       api.ProtoFromID(barID),
+			ParentId:       api.ProtoFromID(parentID),
+			RevisionNumber: 1,
+		},
+	}, resp.Tombstones)
+
+	// Page through the tombstones
+	resp, err = test.client.ListEntryTombstones(ctx, &entrypb.ListEntryTombstonesRequest{
+		PageSize: 1,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tombstones, 1)
+	assert.Equal(t, fooEntryID, resp.Tombstones[0].Id)
+	require.NotEmpty(t, resp.NextPageToken)
+	resp, err = test.client.ListEntryTombstones(ctx, &entrypb.ListEntryTombstonesRequest{
+		PageSize:  1,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Tombstones, 1)
+	assert.Equal(t, barEntryID, resp.Tombstones[0].Id)
+
+	// Nothing was deleted after now
+	resp, err = test.client.ListEntryTombstones(ctx, &entrypb.ListEntryTombstonesRequest{
+		DeletedSince: time.Now().Add(time.Hour).Unix(),
+	})
+	require.NoError(t, err)
+	require.Empty(t, resp.Tombstones)
+
+	// Datastore failures are reported
+	ds.SetNextError(errors.New("oh no"))
+	resp, err = test.client.ListEntryTombstones(ctx, &entrypb.ListEntryTombstonesRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.Internal, "failed to list entry tombstones: oh no")
+	require.Nil(t, resp)
+}
+
 func TestGetAuthorizedEntries(t *testing.T) {
 	entry1 := types.Entry{
 		Id:       "entry-1",
@@ -1362,6 +1449,157 @@ func TestGetAuthorizedEntries(t *testing.T) {
 	}
 }
 
+func TestGetAgentAuthorizedEntries(t *testing.T) {
+	attestedAgentID := spiffeid.RequireFromString("spiffe://example.org/spire/agent/x509pop/1234")
+	entry1 := types.Entry{
+		Id:       "entry-1",
+		ParentId: api.ProtoFromID(attestedAgentID),
+		SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+		Selectors: []*types.Selector{
+			{Type: "unix", Value: "uid:1000"},
+		},
+	}
+	entry2 := types.Entry{
+		Id:       "entry-2",
+		ParentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/node-alias"},
+		SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/baz"},
+		Selectors: []*types.Selector{
+			{Type: "unix", Value: "uid:1001"},
+		},
+	}
+
+	for _, tt := range []struct {
+		name           string
+		agentID        *types.SPIFFEID
+		noAgent        bool
+		dsErr          error
+		fetcherErr     string
+		outputMask     *types.EntryMask
+		fetcherEntries []*types.Entry
+		expectEntries  []*types.Entry
+		code           codes.Code
+		err            string
+		expectLogs     []spiretest.LogEntry
+	}{
+		{
+			name:           "success",
+			agentID:        api.ProtoFromID(attestedAgentID),
+			fetcherEntries: []*types.Entry{proto.Clone(&entry1).(*types.Entry), proto.Clone(&entry2).(*types.Entry)},
+			expectEntries:  []*types.Entry{&entry1, &entry2},
+		},
+		{
+			name:           "success with output mask",
+			agentID:        api.ProtoFromID(attestedAgentID),
+			fetcherEntries: []*types.Entry{proto.Clone(&entry1).(*types.Entry), proto.Clone(&entry2).(*types.Entry)},
+			expectEntries: []*types.Entry{
+				{Id: entry1.Id, SpiffeId: entry1.SpiffeId},
+				{Id: entry2.Id, SpiffeId: entry2.SpiffeId},
+			},
+			outputMask: &types.EntryMask{SpiffeId: true},
+		},
+		{
+			name:    "invalid agent ID",
+			agentID: &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+			code:    codes.InvalidArgument,
+			err:     "invalid agent ID",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: invalid agent ID",
+					Data: logrus.Fields{
+						logrus.ErrorKey: `"spiffe://example.org/workload" is not an agent in trust domain "example.org"; path is not in the agent namespace`,
+					},
+				},
+			},
+		},
+		{
+			name:    "agent not found",
+			agentID: api.ProtoFromID(attestedAgentID),
+			noAgent: true,
+			code:    codes.NotFound,
+			err:     "agent not found",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Agent not found",
+					Data: logrus.Fields{
+						telemetry.AgentID: attestedAgentID.String(),
+					},
+				},
+			},
+		},
+		{
+			name:    "fails to fetch agent",
+			agentID: api.ProtoFromID(attestedAgentID),
+			dsErr:   errors.New("ds error"),
+			code:    codes.Internal,
+			err:     "failed to fetch agent: ds error",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to fetch agent",
+					Data: logrus.Fields{
+						telemetry.AgentID: attestedAgentID.String(),
+						logrus.ErrorKey:   "ds error",
+					},
+				},
+			},
+		},
+		{
+			name:       "fails to fetch entries",
+			agentID:    api.ProtoFromID(attestedAgentID),
+			fetcherErr: "fetcher fails",
+			code:       codes.Internal,
+			err:        "failed to fetch entries",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to fetch entries",
+					Data: logrus.Fields{
+						telemetry.AgentID: attestedAgentID.String(),
+						logrus.ErrorKey:   "rpc error: code = Internal desc = fetcher fails",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ds := fakedatastore.New(t)
+			test := setupServiceTest(t, ds)
+			defer test.Cleanup()
+
+			if !tt.noAgent {
+				_, err := ds.CreateAttestedNode(ctx, &datastore.CreateAttestedNodeRequest{
+					Node: &common.AttestedNode{SpiffeId: attestedAgentID.String()},
+				})
+				require.NoError(t, err)
+			}
+			ds.SetNextError(tt.dsErr)
+
+			test.ef.expectAgentID = attestedAgentID
+			test.ef.entries = tt.fetcherEntries
+			test.ef.err = tt.fetcherErr
+			resp, err := test.client.GetAgentAuthorizedEntries(ctx, &entrypb.GetAgentAuthorizedEntriesRequest{
+				AgentId:    tt.agentID,
+				OutputMask: tt.outputMask,
+			})
+
+			spiretest.AssertLogs(t, test.logHook.AllEntries(), tt.expectLogs)
+			if tt.err != "" {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.err)
+				require.Nil(t, resp)
+				return
+			}
+
+			require.NoError(t, err)
+			spiretest.AssertProtoEqual(t, &entrypb.GetAgentAuthorizedEntriesResponse{
+				Entries: tt.expectEntries,
+			}, resp)
+		})
+	}
+}
+
 func createFederatedBundles(t *testing.T, ds datastore.DataStore) {
 	_, err := ds.CreateBundle(ctx, &datastore.CreateBundleRequest{
 		Bundle: &common.Bundle{
@@ -2015,6 +2253,45 @@ func TestBatchUpdateEntry(t *testing.T) {
 				}
 			},
 		},
+		{
+			name:           "Fail Stale Revision",
+			initialEntries: []*types.Entry{initialEntry},
+			inputMask: &types.EntryMask{
+				Ttl:            true,
+				RevisionNumber: true,
+			},
+			updateEntries: []*types.Entry{
+				{
+					Ttl:            500000,
+					RevisionNumber: 3,
+				},
+			},
+			expectDsEntries: func(id string) []*types.Entry {
+				unmodifiedEntry := proto.Clone(initialEntry).(*types.Entry)
+				unmodifiedEntry.Id = id
+				return []*types.Entry{unmodifiedEntry}
+			},
+			expectResults: []*entrypb.BatchUpdateEntryResponse_Result{
+				{
+					Status: &types.Status{
+						Code:    int32(codes.Aborted),
+						Message: "entry revision is stale: datastore-sql: entry has been updated since revision 3",
+					},
+				},
+			},
+			expectLogs: func(m map[string]string) []spiretest.LogEntry {
+				return []spiretest.LogEntry{
+					{
+						Level:   logrus.ErrorLevel,
+						Message: "Entry revision is stale",
+						Data: logrus.Fields{
+							telemetry.RegistrationID: m[entry1SpiffeID.Path],
+							logrus.ErrorKey:          "rpc error: code = Aborted desc = datastore-sql: entry has been updated since revision 3",
+						},
+					},
+				}
+			},
+		},
 		{
 			name:           "Success Nil Input Mask",
 			initialEntries: []*types.Entry{initialEntry},
@@ -2180,11 +2457,12 @@ func TestBatchUpdateEntry(t *testing.T) {
 			}
 			require.Equal(t, len(tt.updateEntries), len(resp.Results))
 
-			// The updated entries contain IDs, which we don't know before running the test.
-			// To make things easy we set all the IDs to empty before checking the results.
+			// The updated entries contain IDs and creation times, which we don't know before
+			// running the test. To make things easy we set them to empty before checking the results.
 			for i := range resp.Results {
 				if resp.Results[i].Entry != nil {
 					resp.Results[i].Entry.Id = ""
+					resp.Results[i].Entry.CreatedAt = 0
 				}
 			}
 
@@ -2202,6 +2480,7 @@ func TestBatchUpdateEntry(t *testing.T) {
 				require.NoError(t, err)
 				firstEntry, err := api.RegistrationEntryToProto(listEntries.Entries[0])
 				require.NoError(t, err)
+				firstEntry.CreatedAt = 0
 				spiretest.AssertProtoEqual(t, firstEntry, tt.expectDsEntries(listEntries.Entries[0].EntryId)[0])
 			}
 		})
@@ -2260,6 +2539,10 @@ func (f *fakeDS) CreateRegistrationEntry(ctx context.Context, req *datastore.Cre
 type entryFetcher struct {
 	err     string
 	entries []*types.Entry
+
+	// expectAgentID, if set, is the agent the entries are expected to be
+	// fetched for instead of the caller
+	expectAgentID spiffeid.ID
 }
 
 func (f *entryFetcher) FetchAuthorizedEntries(ctx context.Context, agentID spiffeid.ID) ([]*types.Entry, error) {
@@ -2267,6 +2550,13 @@ func (f *entryFetcher) FetchAuthorizedEntries(ctx context.Context, agentID spiff
 		return nil, status.Error(codes.Internal, f.err)
 	}
 
+	if !f.expectAgentID.IsZero() {
+		if agentID != f.expectAgentID {
+			return nil, fmt.Errorf("unexpected agent ID %q", agentID)
+		}
+		return f.entries, nil
+	}
+
 	caller, ok := rpccontext.CallerID(ctx)
 	if !ok {
 		return nil, errors.New("missing caller ID")
@@ -2278,3 +2568,65 @@ func (f *entryFetcher) FetchAuthorizedEntries(ctx context.Context, agentID spiff
 
 	return f.entries, nil
 }
+
+func TestEntryDNSNamePolicy(t *testing.T) {
+	ds := fakedatastore.New(t)
+	policy, err := api.NewDNSNamePolicy([]string{"team.example.org"}, nil)
+	require.NoError(t, err)
+	service := entry.New(entry.Config{
+		TrustDomain:   td,
+		DataStore:     ds,
+		EntryFetcher:  &entryFetcher{},
+		DNSNamePolicy: policy,
+	})
+
+	log, logHook := test.NewNullLogger()
+	ctx := rpccontext.WithLogger(context.Background(), log)
+
+	newEntry := func(dnsNames ...string) *types.Entry {
+		return &types.Entry{
+			ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/parent"},
+			SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/workload"},
+			Selectors: []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+			DnsNames:  dnsNames,
+		}
+	}
+
+	createResp, err := service.BatchCreateEntry(ctx, &entrypb.BatchCreateEntryRequest{
+		Entries: []*types.Entry{newEntry("other.example.org")},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, &types.Status{
+		Code:    int32(codes.InvalidArgument),
+		Message: `entry DNS names are not allowed: DNS name "other.example.org" is not allowed by the DNS name policy`,
+	}, createResp.Results[0].Status)
+	require.Equal(t, "Invalid argument: entry DNS names are not allowed", logHook.LastEntry().Message)
+
+	createResp, err = service.BatchCreateEntry(ctx, &entrypb.BatchCreateEntryRequest{
+		Entries: []*types.Entry{newEntry("web.team.example.org")},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, api.OK(), createResp.Results[0].Status)
+	created := createResp.Results[0].Entry
+
+	// DNS names are only checked on update if they are updated
+	updated := newEntry("other.example.org")
+	updated.Id = created.Id
+	updated.Ttl = 60
+	updateResp, err := service.BatchUpdateEntry(ctx, &entrypb.BatchUpdateEntryRequest{
+		Entries:   []*types.Entry{updated},
+		InputMask: &types.EntryMask{Ttl: true},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, api.OK(), updateResp.Results[0].Status)
+
+	updateResp, err = service.BatchUpdateEntry(ctx, &entrypb.BatchUpdateEntryRequest{
+		Entries:   []*types.Entry{updated},
+		InputMask: &types.EntryMask{DnsNames: true},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, &types.Status{
+		Code:    int32(codes.InvalidArgument),
+		Message: `entry DNS names are not allowed: DNS name "other.example.org" is not allowed by the DNS name policy`,
+	}, updateResp.Results[0].Status)
+}
@@ -5,6 +5,7 @@ import (
 	"time"
 
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/protoutil"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/proto/spire/common"
@@ -40,11 +41,12 @@ func TestRegistrationEntryToProto(t *testing.T) {
 					// domain name either way.
 					"domain2.com",
 				},
-				Admin:          true,
-				EntryExpiry:    expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				EntryExpiry:      expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
 			},
 			expectEntry: &types.Entry{
 				Id:       "entry1",
@@ -59,11 +61,12 @@ func TestRegistrationEntryToProto(t *testing.T) {
 					"domain1.com",
 					"domain2.com",
 				},
-				Admin:          true,
-				ExpiresAt:      expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				ExpiresAt:        expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
 			},
 		},
 		{
@@ -132,11 +135,12 @@ func TestProtoToRegistrationEntryWithMask(t *testing.T) {
 					// either way.
 					"spiffe://domain2.com",
 				},
-				Admin:          true,
-				ExpiresAt:      expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				ExpiresAt:        expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId:  "entry1",
@@ -151,27 +155,29 @@ func TestProtoToRegistrationEntryWithMask(t *testing.T) {
 					"spiffe://domain1.com",
 					"spiffe://domain2.com",
 				},
-				Admin:          true,
-				EntryExpiry:    expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				EntryExpiry:      expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
 			},
 			mask: protoutil.AllTrueEntryMask,
 		},
 		{
 			name: "mask off all fields",
 			entry: &types.Entry{
-				Id:             "entry1",
-				ParentId:       &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
-				Selectors:      []*types.Selector{},
-				DnsNames:       []string{"name1"},
-				FederatesWith:  []string{"domain.test"},
-				Ttl:            1,
-				Admin:          true,
-				Downstream:     true,
-				ExpiresAt:      4,
-				RevisionNumber: 99,
+				Id:               "entry1",
+				ParentId:         &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
+				Selectors:        []*types.Selector{},
+				DnsNames:         []string{"name1"},
+				FederatesWith:    []string{"domain.test"},
+				Ttl:              1,
+				Admin:            true,
+				Downstream:       true,
+				ExpiresAt:        4,
+				RevisionNumber:   99,
+				AllowedAudiences: []string{"audience1"},
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId: "entry1",
@@ -199,6 +205,9 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 	td := spiffeid.RequireTrustDomainFromString("example.org")
 	expiresAt := time.Now().Unix()
 
+	require.NoError(t, fflag.Load(fflag.RawConfig{string(fflag.FlagSPIFFEIDTemplates)}))
+	defer fflag.Unload()
+
 	for _, tt := range []struct {
 		name        string
 		entry       *types.Entry
@@ -223,11 +232,12 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 					// either way.
 					"spiffe://domain2.com",
 				},
-				Admin:          true,
-				ExpiresAt:      expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				ExpiresAt:        expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
 			},
 			expectEntry: &common.RegistrationEntry{
 				EntryId:  "entry1",
@@ -242,11 +252,29 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 					"spiffe://domain1.com",
 					"spiffe://domain2.com",
 				},
-				Admin:          true,
-				EntryExpiry:    expiresAt,
-				DnsNames:       []string{"dns1", "dns2"},
-				Downstream:     true,
-				RevisionNumber: 99,
+				Admin:            true,
+				EntryExpiry:      expiresAt,
+				DnsNames:         []string{"dns1", "dns2"},
+				AllowedAudiences: []string{"audience1"},
+				Downstream:       true,
+				RevisionNumber:   99,
+			},
+		},
+		{
+			name: "spiffe ID template",
+			entry: &types.Entry{
+				Id:        "entry1",
+				ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
+				SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/{k8s:ns}/sa/{k8s:sa}"},
+				Selectors: []*types.Selector{{Type: "k8s", Value: "pod-label:app:foo"}},
+			},
+			expectEntry: &common.RegistrationEntry{
+				EntryId:       "entry1",
+				ParentId:      "spiffe://example.org/foo",
+				SpiffeId:      "spiffe://example.org/ns/%7Bk8s:ns%7D/sa/%7Bk8s:sa%7D",
+				Selectors:     []*common.Selector{{Type: "k8s", Value: "pod-label:app:foo"}},
+				DnsNames:      []string{},
+				FederatesWith: []string{},
 			},
 		},
 		{
@@ -283,6 +311,22 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 				ParentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
 			},
 		},
+		{
+			name: "malformed spiffe ID template",
+			err:  `invalid spiffe ID: malformed SPIFFE ID template "/ns/{k8s}"`,
+			entry: &types.Entry{
+				SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/{k8s}"},
+				ParentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+			},
+		},
+		{
+			name: "spiffe ID template in node entry",
+			err:  "invalid spiffe ID: SPIFFE ID templates are not supported for node entries",
+			entry: &types.Entry{
+				SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/{k8s:ns}"},
+				ParentId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/spire/server"},
+			},
+		},
 		{
 			name: "invalid DNS name",
 			err:  "invalid DNS name: label does not match regex: abc-",
@@ -293,6 +337,16 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 				DnsNames:  []string{"abc-"},
 			},
 		},
+		{
+			name: "empty allowed audience",
+			err:  "invalid allowed audience: audience is empty",
+			entry: &types.Entry{
+				SpiffeId:         &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
+				ParentId:         &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"},
+				Selectors:        []*types.Selector{{Type: "unix", Value: "uid:1000"}},
+				AllowedAudiences: []string{""},
+			},
+		},
 		{
 			name: "malformed federated trust domain",
 			err:  `invalid federated trust domain: spiffeid: unable to parse: parse "spiffe://malformed td":`,
@@ -362,3 +416,20 @@ func TestProtoToRegistrationEntry(t *testing.T) {
 		})
 	}
 }
+
+func TestProtoToRegistrationEntryTemplatesDisabled(t *testing.T) {
+	td := spiffeid.RequireTrustDomainFromString("example.org")
+	entry, err := api.ProtoToRegistrationEntry(td, &types.Entry{
+		ParentId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"},
+		SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/{k8s:ns}"},
+		Selectors: []*types.Selector{{Type: "k8s", Value: "pod-label:app:foo"}},
+	})
+	require.EqualError(t, err, `invalid spiffe ID: SPIFFE ID templates require the "spiffe_id_templates" feature flag`)
+	require.Nil(t, entry)
+}
+
+func TestAudiencesAllowed(t *testing.T) {
+	require.True(t, api.AudiencesAllowed(nil, []string{"audience1"}))
+	require.True(t, api.AudiencesAllowed([]string{"audience1", "audience2"}, []string{"audience2", "audience1"}))
+	require.False(t, api.AudiencesAllowed([]string{"audience1"}, []string{"audience1", "audience2"}))
+}
@@ -0,0 +1,126 @@
+package api
+
+import (
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/server/plugin/datastore"
+	"github.com/spiffe/spire/proto/spire/types"
+)
+
+// FederationRelationshipToProto converts a datastore federation relationship
+// into its API representation
+func FederationRelationshipToProto(fr *datastore.FederationRelationship) (*types.FederationRelationship, error) {
+	if fr == nil {
+		return nil, errors.New("no federation relationship provided")
+	}
+
+	td, err := spiffeid.TrustDomainFromString(fr.TrustDomainId)
+	if err != nil {
+		return nil, err
+	}
+
+	pb := &types.FederationRelationship{
+		TrustDomain:       td.String(),
+		BundleEndpointUrl: fr.BundleEndpointUrl,
+	}
+
+	switch fr.BundleEndpointProfile {
+	case datastore.FederationRelationship_HTTPS_WEB:
+		pb.BundleEndpointProfile = &types.FederationRelationship_HttpsWeb{
+			HttpsWeb: &types.HTTPSWebProfile{
+				RootCas:    fr.WebPkiRootCas,
+				PinRootCas: fr.PinWebPkiRootCas,
+			},
+		}
+	case datastore.FederationRelationship_HTTPS_SPIFFE:
+		pb.BundleEndpointProfile = &types.FederationRelationship_HttpsSpiffe{
+			HttpsSpiffe: &types.HTTPSSPIFFEProfile{
+				EndpointSpiffeId: fr.EndpointSpiffeId,
+			},
+		}
+	default:
+		return nil, fmt.Errorf("unknown bundle endpoint profile %d", fr.BundleEndpointProfile)
+	}
+
+	return pb, nil
+}
+
+// ProtoToFederationRelationship converts and validates a federation
+// relationship received through the API into its datastore representation
+func ProtoToFederationRelationship(pb *types.FederationRelationship) (*datastore.FederationRelationship, error) {
+	if pb == nil {
+		return nil, errors.New("no federation relationship provided")
+	}
+
+	td, err := spiffeid.TrustDomainFromString(pb.TrustDomain)
+	if err != nil {
+		return nil, err
+	}
+
+	u, err := url.Parse(pb.BundleEndpointUrl)
+	if err != nil {
+		return nil, fmt.Errorf("unable to parse bundle endpoint URL: %v", err)
+	}
+	if u.Scheme != "https" {
+		return nil, errors.New("bundle endpoint URL must use the https scheme")
+	}
+	if u.Host == "" {
+		return nil, errors.New("bundle endpoint URL must specify the host")
+	}
+	if u.User != nil {
+		return nil, errors.New("bundle endpoint URL must not contain user info")
+	}
+
+	fr := &datastore.FederationRelationship{
+		TrustDomainId:     td.IDString(),
+		BundleEndpointUrl: u.String(),
+	}
+
+	switch profile := pb.BundleEndpointProfile.(type) {
+	case *types.FederationRelationship_HttpsWeb:
+		fr.BundleEndpointProfile = datastore.FederationRelationship_HTTPS_WEB
+		if profile.HttpsWeb != nil {
+			for _, rootCA := range profile.HttpsWeb.RootCas {
+				if _, err := x509.ParseCertificate(rootCA); err != nil {
+					return nil, fmt.Errorf("unable to parse https_web root CA: %v", err)
+				}
+			}
+			if profile.HttpsWeb.PinRootCas && len(profile.HttpsWeb.RootCas) == 0 {
+				return nil, errors.New("https_web root CAs are required to pin them")
+			}
+			fr.WebPkiRootCas = profile.HttpsWeb.RootCas
+			fr.PinWebPkiRootCas = profile.HttpsWeb.PinRootCas
+		}
+	case *types.FederationRelationship_HttpsSpiffe:
+		if profile.HttpsSpiffe == nil {
+			return nil, errors.New("bundle endpoint profile does not contain \"HttpsSpiffe\"")
+		}
+		endpointID, err := spiffeid.FromString(profile.HttpsSpiffe.EndpointSpiffeId)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse bundle endpoint SPIFFE ID: %v", err)
+		}
+		fr.BundleEndpointProfile = datastore.FederationRelationship_HTTPS_SPIFFE
+		fr.EndpointSpiffeId = endpointID.String()
+	default:
+		return nil, fmt.Errorf("unsupported bundle endpoint profile type: %T", pb.BundleEndpointProfile)
+	}
+
+	return fr, nil
+}
+
+// ProtoToFederationRelationshipMask converts a federation relationship mask
+// received through the API into its datastore representation
+func ProtoToFederationRelationshipMask(mask *types.FederationRelationshipMask) *datastore.FederationRelationshipMask {
+	if mask == nil {
+		return nil
+	}
+
+	return &datastore.FederationRelationshipMask{
+		BundleEndpointUrl:     mask.BundleEndpointUrl,
+		BundleEndpointProfile: mask.BundleEndpointProfile,
+	}
+}
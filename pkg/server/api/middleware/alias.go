@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"github.com/andres-erbsen/clock"
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/api/middleware"
 	"github.com/spiffe/spire/pkg/common/telemetry"
@@ -35,6 +36,14 @@ func WithMetrics(metrics telemetry.Metrics) Middleware {
 	return middleware.WithMetrics(metrics)
 }
 
+func WithTracing() Middleware {
+	return middleware.WithTracing()
+}
+
+func WithServerTime(clk clock.Clock) Middleware {
+	return middleware.WithServerTime(clk)
+}
+
 func Interceptors(m Middleware) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
 	return middleware.Interceptors(m)
 }
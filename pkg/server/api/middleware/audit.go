@@ -0,0 +1,110 @@
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/golang/protobuf/proto"
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// AuditInterceptors returns interceptors that write an audit record to the
+// given logger for each API call. The record holds the identity of the
+// caller, the RPC, a summary of the request and the result of the call.
+// Request values are not recorded since they can hold secrets (e.g. join
+// tokens); the summary only names the fields that are set.
+//
+// The interceptors are expected to be outermost so calls rejected by the
+// middleware (e.g. unauthorized calls) are audited as well.
+func AuditInterceptors(log logrus.FieldLogger) (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
+	unary := func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		audit(ctx, log, info.FullMethod, req, err)
+		return resp, err
+	}
+	stream := func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		as := &auditServerStream{ServerStream: ss}
+		err := handler(srv, as)
+		audit(ss.Context(), log, info.FullMethod, as.firstReq, err)
+		return err
+	}
+	return unary, stream
+}
+
+// auditServerStream captures the first message received on the stream, which
+// is used as the request of streaming RPCs
+type auditServerStream struct {
+	grpc.ServerStream
+	firstReq interface{}
+}
+
+func (ss *auditServerStream) RecvMsg(m interface{}) error {
+	err := ss.ServerStream.RecvMsg(m)
+	if err == nil && ss.firstReq == nil {
+		ss.firstReq = m
+	}
+	return err
+}
+
+func audit(ctx context.Context, log logrus.FieldLogger, fullMethod string, req interface{}, rpcErr error) {
+	fields := logrus.Fields{
+		"rpc": fullMethod,
+	}
+
+	if callerCtx, err := callerContextFromContext(ctx); err == nil {
+		if addr := rpccontext.CallerAddr(callerCtx); addr != nil {
+			fields[telemetry.CallerAddr] = addr.String()
+		}
+		if id, ok := rpccontext.CallerID(callerCtx); ok {
+			fields[telemetry.CallerID] = id.String()
+		}
+		fields["caller_local"] = rpccontext.CallerIsLocal(callerCtx)
+	}
+
+	if requestType, requestFields, ok := summarizeRequest(req); ok {
+		fields["request"] = requestType
+		fields["request_fields"] = requestFields
+	}
+
+	st := status.Convert(rpcErr)
+	fields[telemetry.Status] = st.Code().String()
+	if rpcErr != nil {
+		fields[telemetry.StatusMessage] = st.Message()
+	}
+
+	log.WithFields(fields).Info("API call")
+}
+
+// summarizeRequest returns the type of the request message and the names of
+// the fields that are set, sorted by name. The number of elements is included
+// for lists and maps (e.g. "entries[3]").
+func summarizeRequest(req interface{}) (string, string, bool) {
+	msgV1, ok := req.(proto.Message)
+	if !ok {
+		return "", "", false
+	}
+	msg := proto.MessageReflect(msgV1)
+
+	var fields []string
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsList():
+			fields = append(fields, fmt.Sprintf("%s[%d]", fd.Name(), v.List().Len()))
+		case fd.IsMap():
+			fields = append(fields, fmt.Sprintf("%s[%d]", fd.Name(), v.Map().Len()))
+		default:
+			fields = append(fields, string(fd.Name()))
+		}
+		return true
+	})
+	sort.Strings(fields)
+
+	return string(msg.Descriptor().FullName()), strings.Join(fields, ","), true
+}
@@ -0,0 +1,157 @@
+package middleware
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/url"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	entryv1 "github.com/spiffe/spire/proto/spire/api/server/entry/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+func TestAuditUnaryInterceptor(t *testing.T) {
+	adminID := spiffeid.Must("example.org", "admin")
+
+	unixPeer := &peer.Peer{
+		Addr: &net.UnixAddr{Net: "unix", Name: "/tmp/spire-server/private/api.sock"},
+	}
+	mtlsPeer := &peer.Peer{
+		Addr: &net.TCPAddr{IP: net.ParseIP("1.1.1.1"), Port: 8081},
+		AuthInfo: credentials.TLSInfo{
+			State: tls.ConnectionState{
+				HandshakeComplete: true,
+				PeerCertificates:  []*x509.Certificate{{URIs: []*url.URL{adminID.URL()}}},
+			},
+		},
+	}
+
+	req := &entryv1.BatchCreateEntryRequest{
+		Entries: []*types.Entry{
+			{SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/foo"}},
+			{SpiffeId: &types.SPIFFEID{TrustDomain: "example.org", Path: "/bar"}},
+		},
+		OutputMask: &types.EntryMask{SpiffeId: true},
+	}
+
+	for _, tt := range []struct {
+		name       string
+		peer       *peer.Peer
+		handlerErr error
+		expectData logrus.Fields
+	}{
+		{
+			name: "local caller",
+			peer: unixPeer,
+			expectData: logrus.Fields{
+				"rpc":            "/spire.api.server.entry.v1.Entry/BatchCreateEntry",
+				"caller_addr":    "/tmp/spire-server/private/api.sock",
+				"caller_local":   true,
+				"request":        "spire.api.server.entry.v1.BatchCreateEntryRequest",
+				"request_fields": "entries[2],output_mask",
+				"status":         "OK",
+			},
+		},
+		{
+			name:       "remote caller",
+			peer:       mtlsPeer,
+			handlerErr: status.Error(codes.PermissionDenied, "authorization denied"),
+			expectData: logrus.Fields{
+				"rpc":            "/spire.api.server.entry.v1.Entry/BatchCreateEntry",
+				"caller_addr":    "1.1.1.1:8081",
+				"caller_id":      "spiffe://example.org/admin",
+				"caller_local":   false,
+				"request":        "spire.api.server.entry.v1.BatchCreateEntryRequest",
+				"request_fields": "entries[2],output_mask",
+				"status":         "PermissionDenied",
+				"status_message": "authorization denied",
+			},
+		},
+		{
+			name: "no peer",
+			expectData: logrus.Fields{
+				"rpc":            "/spire.api.server.entry.v1.Entry/BatchCreateEntry",
+				"request":        "spire.api.server.entry.v1.BatchCreateEntryRequest",
+				"request_fields": "entries[2],output_mask",
+				"status":         "OK",
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			log, hook := test.NewNullLogger()
+			unary, _ := AuditInterceptors(log)
+
+			ctx := context.Background()
+			if tt.peer != nil {
+				ctx = peer.NewContext(ctx, tt.peer)
+			}
+			handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, tt.handlerErr
+			}
+
+			_, err := unary(ctx, req, &grpc.UnaryServerInfo{FullMethod: "/spire.api.server.entry.v1.Entry/BatchCreateEntry"}, handler)
+			assert.Equal(t, tt.handlerErr, err)
+
+			entries := hook.AllEntries()
+			require.Len(t, entries, 1)
+			assert.Equal(t, "API call", entries[0].Message)
+			assert.Equal(t, tt.expectData, entries[0].Data)
+		})
+	}
+}
+
+func TestAuditStreamInterceptor(t *testing.T) {
+	log, hook := test.NewNullLogger()
+	_, stream := AuditInterceptors(log)
+
+	ss := &fakeAuditServerStream{
+		ctx: context.Background(),
+		req: &entryv1.GetAuthorizedEntriesRequest{
+			OutputMask: &types.EntryMask{SpiffeId: true},
+		},
+	}
+	handler := func(srv interface{}, ss grpc.ServerStream) error {
+		req := new(entryv1.GetAuthorizedEntriesRequest)
+		return ss.RecvMsg(req)
+	}
+
+	err := stream(nil, ss, &grpc.StreamServerInfo{FullMethod: "/spire.api.server.entry.v1.Entry/GetAuthorizedEntries"}, handler)
+	require.NoError(t, err)
+
+	entries := hook.AllEntries()
+	require.Len(t, entries, 1)
+	assert.Equal(t, logrus.Fields{
+		"rpc":            "/spire.api.server.entry.v1.Entry/GetAuthorizedEntries",
+		"request":        "spire.api.server.entry.v1.GetAuthorizedEntriesRequest",
+		"request_fields": "output_mask",
+		"status":         "OK",
+	}, entries[0].Data)
+}
+
+type fakeAuditServerStream struct {
+	grpc.ServerStream
+	ctx context.Context
+	req *entryv1.GetAuthorizedEntriesRequest
+}
+
+func (ss *fakeAuditServerStream) Context() context.Context {
+	return ss.ctx
+}
+
+func (ss *fakeAuditServerStream) RecvMsg(m interface{}) error {
+	m.(*entryv1.GetAuthorizedEntriesRequest).OutputMask = ss.req.OutputMask
+	return nil
+}
@@ -4,8 +4,10 @@ import (
 	"context"
 
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/api/middleware"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
@@ -23,14 +25,28 @@ type Authorizer interface {
 	AuthorizeCaller(ctx context.Context) (context.Context, error)
 }
 
-func WithAuthorization(authorizers map[string]Authorizer) middleware.Middleware {
+// WithAuthorization returns a middleware that authorizes callers according
+// to the given authorization policy. The policy decides, per method, whether
+// any caller is authorized or which roles (local, admin, downstream or agent)
+// the caller must have. Roles are verified using the given entry fetcher,
+// agent authorizer and admin IDs.
+func WithAuthorization(policyEngine *authpolicy.Engine, entryFetcher EntryFetcher, agentAuthorizer AgentAuthorizer, adminIDs []spiffeid.ID) middleware.Middleware {
 	return &authorizationMiddleware{
-		authorizers: authorizers,
+		policyEngine: policyEngine,
+		local:        AuthorizeLocal(),
+		admin:        AuthorizeAdmin(entryFetcher, adminIDs),
+		downstream:   AuthorizeDownstream(entryFetcher),
+		agent:        AuthorizeAgent(agentAuthorizer),
 	}
 }
 
 type authorizationMiddleware struct {
-	authorizers map[string]Authorizer
+	policyEngine *authpolicy.Engine
+
+	local      Authorizer
+	admin      Authorizer
+	downstream Authorizer
+	agent      Authorizer
 }
 
 func (m *authorizationMiddleware) Preprocess(ctx context.Context, methodName string) (context.Context, error) {
@@ -43,19 +59,48 @@ func (m *authorizationMiddleware) Preprocess(ctx context.Context, methodName str
 	if !rpccontext.CallerIsLocal(ctx) {
 		fields["caller-addr"] = rpccontext.CallerAddr(ctx).String()
 	}
+	input := authpolicy.Input{
+		FullMethod: methodName,
+	}
 	if id, ok := rpccontext.CallerID(ctx); ok {
 		fields["caller-id"] = id.String()
+		input.Caller = id.String()
 	}
 	if len(fields) > 0 {
 		ctx = rpccontext.WithLogger(ctx, rpccontext.Logger(ctx).WithFields(fields))
 	}
 
-	authorizer, ok := m.authorizers[methodName]
-	if !ok {
-		middleware.LogMisconfiguration(ctx, "Authorization misconfigured (method not registered); this is a bug")
-		return nil, status.Errorf(codes.Internal, "authorization misconfigured for %q (method not registered)", methodName)
+	result, err := m.policyEngine.Eval(ctx, input)
+	if err != nil {
+		rpccontext.Logger(ctx).WithError(err).Error("Failed to evaluate authorization policy")
+		return nil, status.Errorf(codes.Internal, "failed to evaluate authorization policy: %v", err)
+	}
+	if result.Allow {
+		return ctx, nil
+	}
+
+	var authorizers []Authorizer
+	if result.AllowIfLocal {
+		authorizers = append(authorizers, m.local)
+	}
+	if result.AllowIfAdmin {
+		authorizers = append(authorizers, m.admin)
+	}
+	if result.AllowIfDownstream {
+		authorizers = append(authorizers, m.downstream)
+	}
+	if result.AllowIfAgent {
+		authorizers = append(authorizers, m.agent)
+	}
+
+	switch len(authorizers) {
+	case 0:
+		return nil, status.Errorf(codes.PermissionDenied, "authorization denied for method %s", methodName)
+	case 1:
+		return authorizers[0].AuthorizeCaller(ctx)
+	default:
+		return AuthorizeAnyOf(authorizers...).AuthorizeCaller(ctx)
 	}
-	return authorizer.AuthorizeCaller(ctx)
 }
 
 func (m *authorizationMiddleware) Postprocess(ctx context.Context, methodName string, handlerInvoked bool, rpcErr error) {
@@ -9,11 +9,14 @@ import (
 	"net/url"
 	"testing"
 
+	"github.com/open-policy-agent/opa/storage/inmem"
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/server/api/middleware"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
+	"github.com/spiffe/spire/proto/spire/types"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -53,51 +56,59 @@ func TestWithAuthorizationPreprocess(t *testing.T) {
 		},
 	}
 
+	policyEngine, err := authpolicy.NewEngineFromRego(context.Background(), testPolicy, inmem.New())
+	require.NoError(t, err)
+
 	for _, tt := range []struct {
-		name          string
-		fullMethod    string
-		peer          *peer.Peer
-		authorizerErr error
-		expectCode    codes.Code
-		expectMsg     string
-		expectLogs    []spiretest.LogEntry
+		name       string
+		fullMethod string
+		peer       *peer.Peer
+		expectCode codes.Code
+		expectMsg  string
+		expectLogs []spiretest.LogEntry
 	}{
+		{
+			name:       "any caller",
+			fullMethod: "/fake.Service/Any",
+			peer:       tlsPeer,
+			expectCode: codes.OK,
+		},
 		{
 			name:       "local caller",
-			fullMethod: fakeFullMethod,
+			fullMethod: "/fake.Service/Local",
 			peer:       unixPeer,
 			expectCode: codes.OK,
-			expectLogs: []spiretest.LogEntry{
-				{
-					Level:   logrus.InfoLevel,
-					Message: "Authorizer called",
-				},
-			},
 		},
 		{
-			name:       "remote caller without ID",
-			fullMethod: fakeFullMethod,
+			name:       "remote caller without required role",
+			fullMethod: "/fake.Service/Local",
 			peer:       tlsPeer,
-			expectCode: codes.OK,
-			expectLogs: []spiretest.LogEntry{
-				{
-					Level:   logrus.InfoLevel,
-					Message: "Authorizer called",
-					Data: logrus.Fields{
-						"caller-addr": "1.1.1.1:1",
-					},
-				},
-			},
+			expectCode: codes.PermissionDenied,
+			expectMsg:  "caller is not local",
 		},
 		{
-			name:       "remote caller with ID",
-			fullMethod: fakeFullMethod,
+			name:       "caller ID is provided to the policy",
+			fullMethod: "/fake.Service/Workload",
 			peer:       mtlsPeer,
 			expectCode: codes.OK,
+		},
+		{
+			name:       "caller without ID is denied by the policy",
+			fullMethod: "/fake.Service/Workload",
+			peer:       tlsPeer,
+			expectCode: codes.PermissionDenied,
+			expectMsg:  "authorization denied for method /fake.Service/Workload",
+		},
+		{
+			name:       "remote caller with ID without any of the required roles",
+			fullMethod: "/fake.Service/LocalOrAdmin",
+			peer:       mtlsPeer,
+			expectCode: codes.PermissionDenied,
+			expectMsg:  `caller must be one of ["local" "admin"]`,
 			expectLogs: []spiretest.LogEntry{
 				{
 					Level:   logrus.InfoLevel,
-					Message: "Authorizer called",
+					Message: "Entry fetcher called",
 					Data: logrus.Fields{
 						"caller-addr": "2.2.2.2:2",
 						"caller-id":   "spiffe://example.org/workload",
@@ -107,45 +118,29 @@ func TestWithAuthorizationPreprocess(t *testing.T) {
 		},
 		{
 			name:       "no peer",
-			fullMethod: fakeFullMethod,
+			fullMethod: "/fake.Service/Any",
 			peer:       nil,
 			expectCode: codes.Internal,
 			expectMsg:  "no peer information available",
 		},
 		{
-			name:       "unrecognized method",
+			name:       "method not in the policy",
 			fullMethod: "/some.Service/WhatInTheWorld",
 			peer:       unixPeer,
-			expectCode: codes.Internal,
-			expectMsg:  `authorization misconfigured for "/some.Service/WhatInTheWorld" (method not registered)`,
-			expectLogs: []spiretest.LogEntry{
-				{
-					Level:   logrus.ErrorLevel,
-					Message: "Authorization misconfigured (method not registered); this is a bug",
-				},
-			},
+			expectCode: codes.PermissionDenied,
+			expectMsg:  "authorization denied for method /some.Service/WhatInTheWorld",
 		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
-			authorizer := authorizerFn(func(ctx context.Context) (context.Context, error) {
-				// Assert the context is set and has the caller information.
-				require.NotNil(t, ctx, "authorizer was not called")
-				assert.Equal(t, rpccontext.CallerAddr(ctx), tt.peer.Addr)
-
+			entryFetcher := middleware.EntryFetcherFunc(func(ctx context.Context, id spiffeid.ID) ([]*types.Entry, error) {
 				// Logging here allows us to assert that the right fields were
 				// added to the logger.
-				rpccontext.Logger(ctx).Info("Authorizer called")
-
-				// Wrap the returned context so we can assert that the wrapped
-				// context was returned from the middleware.
-				return wrapContext(ctx), tt.authorizerErr
+				rpccontext.Logger(ctx).Info("Entry fetcher called")
+				return nil, nil
 			})
 
-			// Set up an authorization middleware with one method.
-			m := middleware.WithAuthorization(map[string]middleware.Authorizer{
-				fakeFullMethod: authorizer,
-			})
+			m := middleware.WithAuthorization(policyEngine, entryFetcher, nil, nil)
 
 			// Set up the incoming context with a logger and optionally a peer.
 			log, hook := test.NewNullLogger()
@@ -164,24 +159,78 @@ func TestWithAuthorizationPreprocess(t *testing.T) {
 				return
 			}
 			require.NotNil(t, ctxOut, "returned context should have been non-nil on success")
-			assert.Equal(t, 1, wrapCount(ctxOut), "returned context was not wrapped by authorizer")
+			assert.Equal(t, tt.peer.Addr, rpccontext.CallerAddr(ctxOut))
 		})
 	}
 }
 
+func TestWithAuthorizationPolicyFailure(t *testing.T) {
+	// The policy does not define a result
+	policyEngine, err := authpolicy.NewEngineFromRego(context.Background(), "package spire\n", inmem.New())
+	require.NoError(t, err)
+
+	m := middleware.WithAuthorization(policyEngine, nil, nil, nil)
+
+	log, hook := test.NewNullLogger()
+	ctx := rpccontext.WithLogger(context.Background(), log)
+	ctx = peer.NewContext(ctx, &peer.Peer{
+		Addr: &net.UnixAddr{Net: "unix", Name: "/not/a/real/path.sock"},
+	})
+
+	_, err = m.Preprocess(ctx, fakeFullMethod)
+	spiretest.RequireGRPCStatus(t, err, codes.Internal, "failed to evaluate authorization policy: policy did not produce a single result")
+	spiretest.AssertLogs(t, hook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.ErrorLevel,
+			Message: "Failed to evaluate authorization policy",
+			Data: logrus.Fields{
+				logrus.ErrorKey: "policy did not produce a single result",
+			},
+		},
+	})
+}
+
 func TestWithAuthorizationPostprocess(t *testing.T) {
 	// Postprocess doesn't do anything. Let's just make sure it doesn't panic.
-	m := middleware.WithAuthorization(nil)
+	m := middleware.WithAuthorization(nil, nil, nil, nil)
 	m.Postprocess(context.Background(), "", false, nil)
 	m.Postprocess(context.Background(), "", true, errors.New("ohno"))
 }
 
-type authorizerFn func(ctx context.Context) (context.Context, error)
+const testPolicy = `package spire
+
+result = {
+	"allow": allow,
+	"allow_if_admin": allow_if_admin,
+	"allow_if_local": allow_if_local,
+	"allow_if_downstream": false,
+	"allow_if_agent": false,
+}
+
+default allow = false
+
+default allow_if_admin = false
+
+default allow_if_local = false
+
+allow {
+	input.full_method == "/fake.Service/Any"
+}
+
+allow {
+	input.full_method == "/fake.Service/Workload"
+	input.caller == "spiffe://example.org/workload"
+}
+
+allow_if_local {
+	input.full_method == "/fake.Service/Local"
+}
 
-func (fn authorizerFn) Name() string {
-	return "fake"
+allow_if_local {
+	input.full_method == "/fake.Service/LocalOrAdmin"
 }
 
-func (fn authorizerFn) AuthorizeCaller(ctx context.Context) (context.Context, error) {
-	return fn(ctx)
+allow_if_admin {
+	input.full_method == "/fake.Service/LocalOrAdmin"
 }
+`
@@ -3,18 +3,25 @@ package middleware
 import (
 	"context"
 
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
 	"github.com/spiffe/spire/proto/spire/types"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
 )
 
-func AuthorizeAdmin(entryFetcher EntryFetcher) Authorizer {
-	return adminAuthorizer{entryFetcher: entryFetcher}
+// AuthorizeAdmin returns an authorizer that authorizes callers that are
+// either one of the given admin IDs or have an admin registration entry.
+func AuthorizeAdmin(entryFetcher EntryFetcher, adminIDs []spiffeid.ID) Authorizer {
+	return adminAuthorizer{
+		entryFetcher: entryFetcher,
+		adminIDs:     adminIDs,
+	}
 }
 
 type adminAuthorizer struct {
 	entryFetcher EntryFetcher
+	adminIDs     []spiffeid.ID
 }
 
 func (a adminAuthorizer) Name() string {
@@ -22,6 +29,16 @@ func (a adminAuthorizer) Name() string {
 }
 
 func (a adminAuthorizer) AuthorizeCaller(ctx context.Context) (context.Context, error) {
+	if callerID, ok := rpccontext.CallerID(ctx); ok {
+		for _, adminID := range a.adminIDs {
+			if callerID == adminID {
+				// Admin IDs are not required to have registration entries,
+				// so there are no admin entries to attach.
+				return rpccontext.WithCallerAdminEntries(ctx, []*types.Entry{}), nil
+			}
+		}
+	}
+
 	ctx, entries, err := WithCallerEntries(ctx, a.entryFetcher)
 	if err != nil {
 		return nil, err
@@ -17,7 +17,7 @@ import (
 )
 
 func TestAdminAuthorizerName(t *testing.T) {
-	assert.Equal(t, "admin", middleware.AuthorizeAdmin(nil).Name())
+	assert.Equal(t, "admin", middleware.AuthorizeAdmin(nil, nil).Name())
 }
 
 func TestAdminAuthorizer(t *testing.T) {
@@ -34,6 +34,8 @@ func TestAdminAuthorizer(t *testing.T) {
 
 	failMeID := spiffeid.Must("example.org", "fail-me")
 
+	federatedAdminID := spiffeid.Must("domain.test", "admin")
+
 	authorizer := middleware.AuthorizeAdmin(middleware.EntryFetcherFunc(
 		func(ctx context.Context, id spiffeid.ID) ([]*types.Entry, error) {
 			switch id {
@@ -45,7 +47,7 @@ func TestAdminAuthorizer(t *testing.T) {
 				return nil, errors.New("ohno")
 			}
 		},
-	))
+	), []spiffeid.ID{federatedAdminID})
 
 	for _, tt := range []struct {
 		name          string
@@ -63,6 +65,12 @@ func TestAdminAuthorizer(t *testing.T) {
 				{Id: "1", Admin: true},
 			},
 		},
+		{
+			name:          "with configured admin ID",
+			id:            federatedAdminID,
+			expectCode:    codes.OK,
+			expectEntries: []*types.Entry{},
+		},
 		{
 			name:       "with non-admin ID",
 			id:         nonAdminID,
@@ -2,24 +2,34 @@ package middleware
 
 import (
 	"context"
-	"errors"
 	"net"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/andres-erbsen/clock"
+	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
 	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 const (
-	// gcInterval is the interval at which per-ip limiters are garbage
-	// collected.
+	// gcInterval is the interval at which per-ip and per-caller limiters are
+	// garbage collected.
 	gcInterval = time.Minute
+
+	// maxWait is the longest a call will wait on a rate limiter. Calls that
+	// would need to wait longer are failed with a RESOURCE_EXHAUSTED error
+	// that tells the caller when to retry, so that a stampede of callers
+	// does not pile up waiting on the server.
+	maxWait = 5 * time.Second
 )
 
 var (
@@ -68,6 +78,39 @@ func PerIPLimit(limit int) api.RateLimiter {
 	return newPerIPLimiter(limit)
 }
 
+// PerCallerLimit returns a rate limiter that imposes a per-caller limit on
+// calls to a method, where callers are identified by the SPIFFE ID they
+// authenticated with. Callers that did not present a SPIFFE ID aren't
+// limited. It can be shared across methods to enforce per-caller limits for a
+// group of methods.
+func PerCallerLimit(limit int) api.RateLimiter {
+	return newPerCallerLimiter(limit)
+}
+
+// PerAttestationTypeLimit returns a rate limiter that imposes a server-wide
+// limit on node attestation attempts for each attestation type, so that
+// attempts against a weak attestor are limited no matter how many addresses
+// they come from. Calls that don't perform node attestation aren't limited.
+func PerAttestationTypeLimit(limit int) api.RateLimiter {
+	return newPerKeyLimiter(limit, rpccontext.AttestationType)
+}
+
+// PerIPFailureBan returns a rate limiter that bans an IP address for the
+// given duration once calls from it have failed the given number of times
+// within that duration. Calls not via TCP/IP aren't limited. The outcome of
+// the calls is reported to the limiter by the rate limiting middleware. Bans
+// are kept when the limiter is replaced through ReloadableRateLimits.
+func PerIPFailureBan(threshold int, duration time.Duration) api.RateLimiter {
+	return newPerIPFailureBan(threshold, duration)
+}
+
+// AllLimits returns a rate limiter that imposes all of the given limits on
+// calls to a method. The limits are applied in order and the first one that
+// fails the call is returned.
+func AllLimits(limiters ...api.RateLimiter) api.RateLimiter {
+	return allLimits(limiters)
+}
+
 // WithRateLimits returns a middleware that performs rate limiting for the
 // group of methods descripted by the rateLimits map. It provides the
 // configured rate limiter to the method handlers via the request context. If
@@ -78,17 +121,70 @@ func PerIPLimit(limit int) api.RateLimiter {
 // the context when a limit has been configured or the handler invokes the rate
 // limiter when a no limit has been configured.
 //
+// Calls that are failed by the rate limiter are counted, labeled with the
+// service and method of the call.
+//
 // WithRateLimits owns the passed rateLimits map and assumes it will not be
 // mutated after the method is called.
 //
 // The WithRateLimits middleware depends on the Logger and Authorization
 // middlewares.
-func WithRateLimits(rateLimits map[string]api.RateLimiter) middleware.Middleware {
+func WithRateLimits(rateLimits map[string]api.RateLimiter, metrics telemetry.Metrics) middleware.Middleware {
+	return WithReloadableRateLimits(NewReloadableRateLimits(rateLimits), metrics)
+}
+
+// WithReloadableRateLimits is like WithRateLimits, except that the rate
+// limiters are obtained from the given ReloadableRateLimits on each call, so
+// that they can be replaced while the middleware is in use.
+func WithReloadableRateLimits(rateLimits *ReloadableRateLimits, metrics telemetry.Metrics) middleware.Middleware {
 	return rateLimitsMiddleware{
 		limiters: rateLimits,
+		metrics:  metrics,
 	}
 }
 
+// ReloadableRateLimits holds the group of methods and rate limiters used by
+// the WithReloadableRateLimits middleware. The rate limiters can be replaced
+// at any time; calls in progress keep the limiter they started with.
+type ReloadableRateLimits struct {
+	limiters atomic.Value
+}
+
+// NewReloadableRateLimits returns a ReloadableRateLimits initialized with the
+// given rate limiters. It owns the passed rateLimits map and assumes it will
+// not be mutated after the method is called.
+func NewReloadableRateLimits(rateLimits map[string]api.RateLimiter) *ReloadableRateLimits {
+	r := new(ReloadableRateLimits)
+	r.Set(rateLimits)
+	return r
+}
+
+// Set replaces the rate limiters. It owns the passed rateLimits map and
+// assumes it will not be mutated after the method is called.
+//
+// The failures and bans tracked by a PerIPFailureBan limiter are handed over
+// to the PerIPFailureBan limiter replacing it for the same method, so that
+// replacing the rate limiters does not lift active bans.
+func (r *ReloadableRateLimits) Set(rateLimits map[string]api.RateLimiter) {
+	if previous, ok := r.limiters.Load().(map[string]api.RateLimiter); ok {
+		for fullMethod, rateLimiter := range rateLimits {
+			ban, ok := findFailureBan(rateLimiter)
+			if !ok {
+				continue
+			}
+			if previousBan, ok := findFailureBan(previous[fullMethod]); ok && previousBan != ban {
+				ban.records = previousBan.records
+			}
+		}
+	}
+	r.limiters.Store(rateLimits)
+}
+
+func (r *ReloadableRateLimits) get(fullMethod string) (api.RateLimiter, bool) {
+	rateLimiter, ok := r.limiters.Load().(map[string]api.RateLimiter)[fullMethod]
+	return rateLimiter, ok
+}
+
 type noLimit struct{}
 
 func (noLimit) RateLimit(ctx context.Context, count int) error {
@@ -113,9 +209,172 @@ func (lim *perCallLimiter) RateLimit(ctx context.Context, count int) error {
 	return waitN(ctx, lim.limiter, count)
 }
 
-type perIPLimiter struct {
+type allLimits []api.RateLimiter
+
+func (lims allLimits) RateLimit(ctx context.Context, count int) error {
+	for _, lim := range lims {
+		if err := lim.RateLimit(ctx, count); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (lims allLimits) recordResult(ctx context.Context, rpcErr error) {
+	for _, lim := range lims {
+		if recorder, ok := lim.(resultRecorder); ok {
+			recorder.recordResult(ctx, rpcErr)
+		}
+	}
+}
+
+// resultRecorder is implemented by rate limiters that track the outcome of
+// the calls they let through.
+type resultRecorder interface {
+	recordResult(ctx context.Context, rpcErr error)
+}
+
+// perIPFailureBan bans IP addresses that fail too many calls.
+type perIPFailureBan struct {
+	threshold int
+	duration  time.Duration
+
+	// records is shared with the limiters that replace this one, see
+	// ReloadableRateLimits.Set
+	records *failureRecords
+}
+
+// failureRecords holds the failures and bans of each IP address.
+type failureRecords struct {
+	mtx sync.Mutex
+
+	byIP map[string]*failureRecord
+
+	// lastGC is the last time expired records were removed
+	lastGC time.Time
+}
+
+type failureRecord struct {
+	// failures is the number of failures since windowStart
+	failures    int
+	windowStart time.Time
+	bannedUntil time.Time
+}
+
+func newPerIPFailureBan(threshold int, duration time.Duration) *perIPFailureBan {
+	return &perIPFailureBan{
+		threshold: threshold,
+		duration:  duration,
+		records: &failureRecords{
+			byIP:   make(map[string]*failureRecord),
+			lastGC: clk.Now(),
+		},
+	}
+}
+
+// findFailureBan returns the perIPFailureBan limiter among the given rate
+// limiter, if any.
+func findFailureBan(rateLimiter api.RateLimiter) (*perIPFailureBan, bool) {
+	switch lim := rateLimiter.(type) {
+	case *perIPFailureBan:
+		return lim, true
+	case allLimits:
+		for _, l := range lim {
+			if ban, ok := findFailureBan(l); ok {
+				return ban, true
+			}
+		}
+	}
+	return nil, false
+}
+
+func (lim *perIPFailureBan) RateLimit(ctx context.Context, count int) error {
+	ip, ok := callerIP(ctx)
+	if !ok {
+		return nil
+	}
+
+	lim.records.mtx.Lock()
+	defer lim.records.mtx.Unlock()
+
+	record, ok := lim.records.byIP[ip]
+	if !ok {
+		return nil
+	}
+	if remaining := record.bannedUntil.Sub(clk.Now()); remaining > 0 {
+		return retryAfter("too many failed attempts", remaining)
+	}
+	return nil
+}
+
+func (lim *perIPFailureBan) recordResult(ctx context.Context, rpcErr error) {
+	switch status.Code(rpcErr) {
+	case codes.OK, codes.ResourceExhausted, codes.Canceled, codes.DeadlineExceeded:
+		// Only calls that were let through and failed count
+		return
+	}
+
+	ip, ok := callerIP(ctx)
+	if !ok {
+		return
+	}
+
+	lim.records.mtx.Lock()
+	defer lim.records.mtx.Unlock()
+
+	now := clk.Now()
+	lim.gc(now)
+
+	record, ok := lim.records.byIP[ip]
+	if !ok || now.Sub(record.windowStart) >= lim.duration {
+		record = &failureRecord{windowStart: now}
+		lim.records.byIP[ip] = record
+	}
+	record.failures++
+	if record.failures < lim.threshold {
+		return
+	}
+
+	record.failures = 0
+	record.windowStart = now
+	record.bannedUntil = now.Add(lim.duration)
+	rpccontext.Logger(ctx).WithFields(logrus.Fields{
+		telemetry.CallerAddr:  ip,
+		telemetry.BanDuration: lim.duration,
+	}).Warn("Banning caller after repeated failures")
+}
+
+// gc removes the records whose failure window and ban have both expired.
+func (lim *perIPFailureBan) gc(now time.Time) {
+	if now.Sub(lim.records.lastGC) < gcInterval {
+		return
+	}
+	for ip, record := range lim.records.byIP {
+		if now.Sub(record.windowStart) >= lim.duration && !now.Before(record.bannedUntil) {
+			delete(lim.records.byIP, ip)
+		}
+	}
+	lim.records.lastGC = now
+}
+
+func callerIP(ctx context.Context) (string, bool) {
+	tcpAddr, ok := rpccontext.CallerAddr(ctx).(*net.TCPAddr)
+	if !ok {
+		// Calls not via TCP/IP aren't limited
+		return "", false
+	}
+	return tcpAddr.IP.String(), true
+}
+
+// perKeyLimiter maintains a rate limiter for each key derived from the
+// caller context (e.g. the caller IP address).
+type perKeyLimiter struct {
 	limit int
 
+	// keyFn returns the key identifying the caller. Callers for which no key
+	// can be determined aren't limited.
+	keyFn func(ctx context.Context) (string, bool)
+
 	mtx sync.RWMutex
 
 	// previous holds all of the limiters that were current at the GC
@@ -129,50 +388,65 @@ type perIPLimiter struct {
 	lastGC time.Time
 }
 
-func newPerIPLimiter(limit int) *perIPLimiter {
-	return &perIPLimiter{limit: limit,
+func newPerIPLimiter(limit int) *perKeyLimiter {
+	return newPerKeyLimiter(limit, callerIP)
+}
+
+func newPerCallerLimiter(limit int) *perKeyLimiter {
+	return newPerKeyLimiter(limit, func(ctx context.Context) (string, bool) {
+		id, ok := rpccontext.CallerID(ctx)
+		if !ok {
+			// Callers without a SPIFFE ID aren't limited
+			return "", false
+		}
+		return id.String(), true
+	})
+}
+
+func newPerKeyLimiter(limit int, keyFn func(ctx context.Context) (string, bool)) *perKeyLimiter {
+	return &perKeyLimiter{limit: limit,
+		keyFn:   keyFn,
 		current: make(map[string]rawRateLimiter),
 		lastGC:  clk.Now(),
 	}
 }
 
-func (lim *perIPLimiter) RateLimit(ctx context.Context, count int) error {
-	tcpAddr, ok := rpccontext.CallerAddr(ctx).(*net.TCPAddr)
+func (lim *perKeyLimiter) RateLimit(ctx context.Context, count int) error {
+	key, ok := lim.keyFn(ctx)
 	if !ok {
-		// Calls not via TCP/IP aren't limited
 		return nil
 	}
-	limiter := lim.getLimiter(tcpAddr.IP.String())
+	limiter := lim.getLimiter(key)
 	return waitN(ctx, limiter, count)
 }
 
-func (lim *perIPLimiter) getLimiter(ip string) rawRateLimiter {
+func (lim *perKeyLimiter) getLimiter(key string) rawRateLimiter {
 	lim.mtx.RLock()
-	limiter, ok := lim.current[ip]
+	limiter, ok := lim.current[key]
 	if ok {
 		lim.mtx.RUnlock()
 		return limiter
 	}
 	lim.mtx.RUnlock()
 
-	// A limiter does not exist for that address.
+	// A limiter does not exist for that key.
 	lim.mtx.Lock()
 	defer lim.mtx.Unlock()
 
-	// Check the "current" entries in case another goroutine raced on this IP.
-	if limiter, ok = lim.current[ip]; ok {
+	// Check the "current" entries in case another goroutine raced on this key.
+	if limiter, ok = lim.current[key]; ok {
 		return limiter
 	}
 
 	// Then check the "previous" entries to see if a limiter exists for this
-	// IP as of the last GC. If so, move it to current and return it.
-	if limiter, ok = lim.previous[ip]; ok {
-		lim.current[ip] = limiter
-		delete(lim.previous, ip)
+	// key as of the last GC. If so, move it to current and return it.
+	if limiter, ok = lim.previous[key]; ok {
+		lim.current[key] = limiter
+		delete(lim.previous, key)
 		return limiter
 	}
 
-	// There is no limiter for this IP. Before we create one, we should see
+	// There is no limiter for this key. Before we create one, we should see
 	// if we need to do GC.
 	now := clk.Now()
 	if now.Sub(lim.lastGC) >= gcInterval {
@@ -182,16 +456,17 @@ func (lim *perIPLimiter) getLimiter(ip string) rawRateLimiter {
 	}
 
 	limiter = newRawRateLimiter(rate.Limit(lim.limit), lim.limit)
-	lim.current[ip] = limiter
+	lim.current[key] = limiter
 	return limiter
 }
 
 type rateLimitsMiddleware struct {
-	limiters map[string]api.RateLimiter
+	limiters *ReloadableRateLimits
+	metrics  telemetry.Metrics
 }
 
 func (i rateLimitsMiddleware) Preprocess(ctx context.Context, fullMethod string) (context.Context, error) {
-	rateLimiter, ok := i.limiters[fullMethod]
+	rateLimiter, ok := i.limiters.get(fullMethod)
 	if !ok {
 		middleware.LogMisconfiguration(ctx, "Rate limiting misconfigured; this is a bug")
 		return nil, status.Errorf(codes.Internal, "rate limiting misconfigured for %q", fullMethod)
@@ -200,6 +475,10 @@ func (i rateLimitsMiddleware) Preprocess(ctx context.Context, fullMethod string)
 }
 
 func (i rateLimitsMiddleware) Postprocess(ctx context.Context, fullMethod string, handlerInvoked bool, rpcErr error) {
+	if handlerInvoked {
+		recordResult(ctx, rpcErr)
+	}
+
 	// Handlers are expected to invoke the rate limiter unless they failed to
 	// parse parameters. If the handler itself wasn't invoked then there is no
 	// need to check if rate limiting was invoked.
@@ -223,9 +502,40 @@ func (i rateLimitsMiddleware) Postprocess(ctx context.Context, fullMethod string
 		return
 	}
 
+	if wrapper.Limited() {
+		i.countLimited(ctx)
+	}
+
 	logLimiterMisuse(ctx, wrapper.rateLimiter, wrapper.Used())
 }
 
+func (i rateLimitsMiddleware) countLimited(ctx context.Context) {
+	var labels []telemetry.Label
+	if names, ok := rpccontext.Names(ctx); ok {
+		labels = []telemetry.Label{
+			{Name: telemetry.Service, Value: names.Service},
+			{Name: telemetry.Method, Value: names.Method},
+		}
+	}
+	i.metrics.IncrCounterWithLabels([]string{telemetry.RPC, telemetry.RateLimited}, 1, labels)
+}
+
+// recordResult reports the outcome of the call to the rate limiter of the
+// call, if the limiter was used and tracks outcomes.
+func recordResult(ctx context.Context, rpcErr error) {
+	rateLimiter, ok := rpccontext.RateLimiter(ctx)
+	if !ok {
+		return
+	}
+	wrapper, ok := rateLimiter.(*rateLimiterWrapper)
+	if !ok || !wrapper.Used() {
+		return
+	}
+	if recorder, ok := wrapper.rateLimiter.(resultRecorder); ok {
+		recorder.recordResult(ctx, rpcErr)
+	}
+}
+
 func logLimiterMisuse(ctx context.Context, rateLimiter api.RateLimiter, used bool) {
 	switch rateLimiter.(type) {
 	case noLimit:
@@ -255,17 +565,26 @@ func logLimiterMisuse(ctx context.Context, rateLimiter api.RateLimiter, used boo
 type rateLimiterWrapper struct {
 	rateLimiter api.RateLimiter
 	used        bool
+	limited     bool
 }
 
 func (w *rateLimiterWrapper) RateLimit(ctx context.Context, count int) error {
 	w.used = true
-	return w.rateLimiter.RateLimit(ctx, count)
+	err := w.rateLimiter.RateLimit(ctx, count)
+	if status.Code(err) == codes.ResourceExhausted {
+		w.limited = true
+	}
+	return err
 }
 
 func (w *rateLimiterWrapper) Used() bool {
 	return w.used
 }
 
+func (w *rateLimiterWrapper) Limited() bool {
+	return w.limited
+}
+
 func waitN(ctx context.Context, limiter rawRateLimiter, count int) error {
 	// limiter.WaitN already provides this check but the error returned is not
 	// strongly typed and is a little messy. Lifting this check so we can
@@ -274,13 +593,46 @@ func waitN(ctx context.Context, limiter rawRateLimiter, count int) error {
 		return status.Errorf(codes.ResourceExhausted, "rate (%d) exceeds burst size (%d)", count, limiter.Burst())
 	}
 
-	err := limiter.WaitN(ctx, count)
+	waitCtx, cancel := context.WithTimeout(ctx, maxWait)
+	defer cancel()
+
+	err := limiter.WaitN(waitCtx, count)
 	switch {
 	case err == nil:
 		return nil
-	case errors.Is(err, context.Canceled), errors.Is(err, context.DeadlineExceeded):
+	case ctx.Err() != nil:
+		// The caller gave up (or ran out of time) while waiting
 		return ctx.Err()
 	default:
-		return status.Error(codes.ResourceExhausted, err.Error())
+		// The wait would have exceeded the maximum wait time
+		return rateLimitExceeded(limiter, count)
+	}
+}
+
+// rateLimitExceeded returns a RESOURCE_EXHAUSTED error with a hint of how
+// long the caller should wait before retrying, i.e. the time it takes for the
+// limiter to accrue the tokens needed by the call.
+func rateLimitExceeded(limiter rawRateLimiter, count int) error {
+	var retryDelay time.Duration
+	if limit := float64(limiter.Limit()); limit > 0 {
+		retryDelay = time.Duration(float64(count) / limit * float64(time.Second))
+	}
+	return retryAfter("rate limit exceeded", retryDelay)
+}
+
+// retryAfter returns a RESOURCE_EXHAUSTED error telling the caller to retry
+// after the given delay, rounded to the second and at least one second.
+func retryAfter(reason string, retryDelay time.Duration) error {
+	retryDelay = retryDelay.Round(time.Second)
+	if retryDelay < time.Second {
+		retryDelay = time.Second
+	}
+
+	st := status.Newf(codes.ResourceExhausted, "%s; retry after %s", reason, retryDelay)
+	if withDetails, err := st.WithDetails(&errdetails.RetryInfo{
+		RetryDelay: durationpb.New(retryDelay),
+	}); err == nil {
+		st = withDetails
 	}
+	return st.Err()
 }
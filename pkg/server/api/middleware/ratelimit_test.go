@@ -5,20 +5,27 @@ import (
 	"errors"
 	"net"
 	"testing"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	commonapi "github.com/spiffe/spire/pkg/common/api"
 	"github.com/spiffe/spire/pkg/common/api/middleware"
+	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
 	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
 	"github.com/spiffe/spire/test/spiretest"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"golang.org/x/time/rate"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/durationpb"
 )
 
 func TestNoLimit(t *testing.T) {
@@ -95,6 +102,178 @@ func TestPerIPLimit(t *testing.T) {
 	}, limiters.WaitNEvents)
 }
 
+func TestPerCallerLimit(t *testing.T) {
+	limiters := NewFakeLimiters()
+
+	m := PerCallerLimit(10)
+
+	// Does not rate limit callers without a SPIFFE ID
+	err := m.RateLimit(tcpCallerContext("1.1.1.1"), 11)
+	require.NoError(t, err)
+
+	// Once exceeding burst size for spiffe://example.org/foo
+	err = m.RateLimit(idCallerContext("spiffe://example.org/foo"), 11)
+	spiretest.RequireGRPCStatus(t, err, codes.ResourceExhausted, "rate (11) exceeds burst size (10)")
+
+	// Once within burst size for spiffe://example.org/foo
+	require.NoError(t, m.RateLimit(idCallerContext("spiffe://example.org/foo"), 1))
+
+	// Twice within burst size for spiffe://example.org/bar
+	require.NoError(t, m.RateLimit(idCallerContext("spiffe://example.org/bar"), 2))
+	require.NoError(t, m.RateLimit(idCallerContext("spiffe://example.org/bar"), 3))
+
+	// There should be two rate limiters; one for each caller.
+	assert.Equal(t, 2, limiters.Count)
+	assert.Equal(t, []WaitNEvent{
+		{ID: 1, Count: 1},
+		{ID: 2, Count: 2},
+		{ID: 2, Count: 3},
+	}, limiters.WaitNEvents)
+}
+
+func TestAllLimits(t *testing.T) {
+	limiters := NewFakeLimiters()
+
+	m := AllLimits(PerIPLimit(10), PerCallerLimit(5))
+
+	// Both limits are applied
+	ctx := rpccontext.WithCallerID(tcpCallerContext("1.1.1.1"), spiffeid.Must("example.org", "foo"))
+	require.NoError(t, m.RateLimit(ctx, 5))
+
+	// The per-caller limit fails the call even though the per-ip limit
+	// would allow it.
+	err := m.RateLimit(ctx, 6)
+	spiretest.RequireGRPCStatus(t, err, codes.ResourceExhausted, "rate (6) exceeds burst size (5)")
+
+	assert.Equal(t, 2, limiters.Count)
+	assert.Equal(t, []WaitNEvent{
+		{ID: 1, Count: 5},
+		{ID: 2, Count: 5},
+		{ID: 1, Count: 6},
+	}, limiters.WaitNEvents)
+}
+
+func TestPerAttestationTypeLimit(t *testing.T) {
+	limiters := NewFakeLimiters()
+
+	m := PerAttestationTypeLimit(10)
+
+	// Does not rate limit calls that don't perform attestation
+	require.NoError(t, m.RateLimit(tcpCallerContext("1.1.1.1"), 11))
+
+	// Attempts of the same type are limited together, whatever the IP
+	require.NoError(t, m.RateLimit(rpccontext.WithAttestationType(tcpCallerContext("1.1.1.1"), "join_token"), 1))
+	require.NoError(t, m.RateLimit(rpccontext.WithAttestationType(tcpCallerContext("2.2.2.2"), "join_token"), 2))
+	require.NoError(t, m.RateLimit(rpccontext.WithAttestationType(tcpCallerContext("1.1.1.1"), "x509pop"), 3))
+
+	// There should be two rate limiters; one for each attestation type.
+	assert.Equal(t, 2, limiters.Count)
+	assert.Equal(t, []WaitNEvent{
+		{ID: 1, Count: 1},
+		{ID: 1, Count: 2},
+		{ID: 2, Count: 3},
+	}, limiters.WaitNEvents)
+}
+
+func TestPerIPFailureBan(t *testing.T) {
+	mockClk, restoreClk := setupClock(t)
+	defer restoreClk()
+
+	log, hook := test.NewNullLogger()
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/fake.Service/WithLimit"}
+	handlerErr := status.Error(codes.PermissionDenied, "failed to attest")
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rpccontext.RateLimit(ctx, 1); err != nil {
+			return nil, err
+		}
+		return nil, handlerErr
+	}
+
+	unaryInterceptor := middleware.UnaryInterceptor(WithRateLimits(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": PerIPFailureBan(2, time.Minute),
+	}, fakemetrics.New()))
+	call := func(ip string) error {
+		ctx := rpccontext.WithLogger(tcpCallerContext(ip), log)
+		_, err := unaryInterceptor(ctx, struct{}{}, serverInfo, handler)
+		return err
+	}
+
+	// Failures spread over more than the ban duration don't ban
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	mockClk.Add(time.Minute)
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	require.Empty(t, hook.AllEntries())
+
+	// The second failure within the ban duration bans the IP
+	mockClk.Add(30 * time.Second)
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	spiretest.AssertLogs(t, hook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.WarnLevel,
+			Message: "Banning caller after repeated failures",
+			Data: logrus.Fields{
+				telemetry.CallerAddr:  "1.1.1.1",
+				telemetry.BanDuration: "1m0s",
+			},
+		},
+	})
+
+	err := call("1.1.1.1")
+	spiretest.RequireGRPCStatus(t, err, codes.ResourceExhausted, "too many failed attempts; retry after 1m0s")
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	spiretest.AssertProtoEqual(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Minute)}, details[0].(*errdetails.RetryInfo))
+
+	// Rejected calls don't count as failures, so the ban is not extended
+	mockClk.Add(40 * time.Second)
+	spiretest.RequireGRPCStatus(t, call("1.1.1.1"), codes.ResourceExhausted, "too many failed attempts; retry after 20s")
+
+	// Other IPs and calls not via TCP/IP are not affected
+	require.Equal(t, handlerErr, call("2.2.2.2"))
+	_, err = unaryInterceptor(rpccontext.WithLogger(unixCallerContext(), log), struct{}{}, serverInfo, handler)
+	require.Equal(t, handlerErr, err)
+
+	// The ban is lifted after the ban duration
+	mockClk.Add(20 * time.Second)
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+}
+
+func TestAllLimitsRecordResult(t *testing.T) {
+	_, restoreClk := setupClock(t)
+	defer restoreClk()
+
+	log, _ := test.NewNullLogger()
+	ctx := rpccontext.WithLogger(tcpCallerContext("1.1.1.1"), log)
+
+	// The outcome of calls is forwarded to the limiters that track it
+	m := AllLimits(PerCallLimit(10), PerIPFailureBan(1, time.Minute))
+	require.NoError(t, m.RateLimit(ctx, 1))
+	m.(resultRecorder).recordResult(ctx, status.Error(codes.Internal, "failed to attest"))
+	spiretest.RequireGRPCStatus(t, m.RateLimit(ctx, 1), codes.ResourceExhausted, "too many failed attempts; retry after 1m0s")
+}
+
+func TestRateLimitExceeded(t *testing.T) {
+	limiters := NewFakeLimiters()
+	limiters.WaitNErr = errors.New("rate: Wait(n=3) would exceed context deadline")
+
+	m := PerCallLimit(2)
+
+	err := m.RateLimit(context.Background(), 2)
+	spiretest.RequireGRPCStatus(t, err, codes.ResourceExhausted, "rate limit exceeded; retry after 1s")
+
+	details := status.Convert(err).Details()
+	require.Len(t, details, 1)
+	retryInfo, ok := details[0].(*errdetails.RetryInfo)
+	require.True(t, ok, "detail is not retry info")
+	spiretest.AssertProtoEqual(t, &errdetails.RetryInfo{RetryDelay: durationpb.New(time.Second)}, retryInfo)
+
+	// Errors due to the caller context are returned as is
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	err = m.RateLimit(ctx, 1)
+	require.Equal(t, context.Canceled, err)
+}
+
 func TestPerIPLimitGC(t *testing.T) {
 	mockClk, restoreClk := setupClock(t)
 	defer restoreClk()
@@ -147,9 +326,11 @@ func TestRateLimits(t *testing.T) {
 		rateLimitCount int
 		returnErr      error
 		downstreamErr  error
+		waitNErr       error
 		expectLogs     []spiretest.LogEntry
 		expectCode     codes.Code
 		expectMsg      string
+		expectMetrics  []fakemetrics.MetricItem
 	}{
 		{
 			name:       "RPC fails if method not configured for rate limiting",
@@ -233,12 +414,46 @@ func TestRateLimits(t *testing.T) {
 			rateLimitCount: 3,
 			expectCode:     codes.ResourceExhausted,
 			expectMsg:      "rate (3) exceeds burst size (2)",
+			expectMetrics: []fakemetrics.MetricItem{
+				{
+					Type: fakemetrics.IncrCounterWithLabelsType,
+					Key:  []string{telemetry.RPC, telemetry.RateLimited},
+					Val:  1,
+					Labels: []telemetry.Label{
+						{Name: telemetry.Service, Value: "fake_Service"},
+						{Name: telemetry.Method, Value: "WithLimit"},
+					},
+				},
+			},
+		},
+		{
+			name:           "returns resource exhausted when the wait exceeds the max wait",
+			method:         "/fake.Service/WithLimit",
+			rateLimitCount: 2,
+			waitNErr:       errors.New("rate: Wait(n=2) would exceed context deadline"),
+			expectCode:     codes.ResourceExhausted,
+			expectMsg:      "rate limit exceeded; retry after 1s",
+			expectMetrics: []fakemetrics.MetricItem{
+				{
+					Type: fakemetrics.IncrCounterWithLabelsType,
+					Key:  []string{telemetry.RPC, telemetry.RateLimited},
+					Val:  1,
+					Labels: []telemetry.Label{
+						{Name: telemetry.Service, Value: "fake_Service"},
+						{Name: telemetry.Method, Value: "WithLimit"},
+					},
+				},
+			},
 		},
 	} {
 		tt := tt
 		t.Run(tt.name, func(t *testing.T) {
+			limiters := NewFakeLimiters()
+			limiters.WaitNErr = tt.waitNErr
+
 			log, hook := test.NewNullLogger()
 			ctx := rpccontext.WithLogger(context.Background(), log)
+			ctx = rpccontext.WithNames(ctx, commonapi.Names{Service: "fake.Service", Method: "WithLimit"})
 			if tt.prepareCtx != nil {
 				ctx = tt.prepareCtx(ctx)
 			}
@@ -256,6 +471,7 @@ func TestRateLimits(t *testing.T) {
 				return struct{}{}, nil
 			}
 
+			metrics := fakemetrics.New()
 			unaryInterceptor := middleware.UnaryInterceptor(middleware.Chain(
 				WithRateLimits(
 					map[string]api.RateLimiter{
@@ -263,6 +479,7 @@ func TestRateLimits(t *testing.T) {
 						"/fake.Service/DisabledLimit": DisabledLimit(),
 						"/fake.Service/WithLimit":     PerCallLimit(2),
 					},
+					metrics,
 				),
 				// Install a middleware downstream so that we can test what
 				// happens in postprocess if the handler is never invoked.
@@ -279,10 +496,95 @@ func TestRateLimits(t *testing.T) {
 				assert.Nil(t, resp)
 			}
 			spiretest.AssertLogs(t, hook.AllEntries(), tt.expectLogs)
+			assert.Equal(t, tt.expectMetrics, metrics.AllMetrics())
 		})
 	}
 }
 
+func TestReloadableRateLimits(t *testing.T) {
+	NewFakeLimiters()
+
+	log, hook := test.NewNullLogger()
+	ctx := rpccontext.WithLogger(context.Background(), log)
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/fake.Service/WithLimit"}
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rpccontext.RateLimit(ctx, 2); err != nil {
+			return nil, err
+		}
+		return struct{}{}, nil
+	}
+
+	rateLimits := NewReloadableRateLimits(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": PerCallLimit(1),
+	})
+	unaryInterceptor := middleware.UnaryInterceptor(WithReloadableRateLimits(rateLimits, fakemetrics.New()))
+
+	// The call exceeds the burst size of the initial limiter
+	_, err := unaryInterceptor(ctx, struct{}{}, serverInfo, handler)
+	spiretest.AssertGRPCStatus(t, err, codes.ResourceExhausted, "rate (2) exceeds burst size (1)")
+
+	// The call is within the burst size of the replacement limiter
+	rateLimits.Set(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": PerCallLimit(2),
+	})
+	_, err = unaryInterceptor(ctx, struct{}{}, serverInfo, handler)
+	require.NoError(t, err)
+
+	// Methods removed from the replacement are no longer configured
+	rateLimits.Set(map[string]api.RateLimiter{})
+	_, err = unaryInterceptor(ctx, struct{}{}, serverInfo, handler)
+	spiretest.AssertGRPCStatus(t, err, codes.Internal, `rate limiting misconfigured for "/fake.Service/WithLimit"`)
+	assert.Len(t, hook.AllEntries(), 1)
+}
+
+func TestReloadableRateLimitsKeepsBans(t *testing.T) {
+	mockClk, restoreClk := setupClock(t)
+	defer restoreClk()
+
+	log, _ := test.NewNullLogger()
+	serverInfo := &grpc.UnaryServerInfo{FullMethod: "/fake.Service/WithLimit"}
+	handlerErr := status.Error(codes.PermissionDenied, "failed to attest")
+	handler := func(ctx context.Context, _ interface{}) (interface{}, error) {
+		if err := rpccontext.RateLimit(ctx, 1); err != nil {
+			return nil, err
+		}
+		return nil, handlerErr
+	}
+
+	rateLimits := NewReloadableRateLimits(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": PerIPFailureBan(1, time.Minute),
+	})
+	unaryInterceptor := middleware.UnaryInterceptor(WithReloadableRateLimits(rateLimits, fakemetrics.New()))
+	call := func(ip string) error {
+		ctx := rpccontext.WithLogger(tcpCallerContext(ip), log)
+		_, err := unaryInterceptor(ctx, struct{}{}, serverInfo, handler)
+		return err
+	}
+
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	spiretest.RequireGRPCStatus(t, call("1.1.1.1"), codes.ResourceExhausted, "too many failed attempts; retry after 1m0s")
+
+	// Reloading the limits, here with the ban combined with other limits,
+	// keeps the active ban
+	mockClk.Add(30 * time.Second)
+	rateLimits.Set(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": AllLimits(PerIPFailureBan(2, 2*time.Minute), PerCallLimit(10)),
+	})
+	spiretest.RequireGRPCStatus(t, call("1.1.1.1"), codes.ResourceExhausted, "too many failed attempts; retry after 30s")
+
+	// The ban is lifted when it expires and the new threshold applies
+	mockClk.Add(30 * time.Second)
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+	spiretest.RequireGRPCStatus(t, call("1.1.1.1"), codes.ResourceExhausted, "too many failed attempts; retry after 2m0s")
+
+	// Limits without a ban for the method start afresh
+	rateLimits.Set(map[string]api.RateLimiter{
+		"/fake.Service/WithLimit": PerCallLimit(10),
+	})
+	require.Equal(t, handlerErr, call("1.1.1.1"))
+}
+
 type WaitNEvent struct {
 	ID    int
 	Count int
@@ -291,6 +593,9 @@ type WaitNEvent struct {
 type FakeLimiters struct {
 	Count       int
 	WaitNEvents []WaitNEvent
+
+	// WaitNErr, if set, is returned by WaitN
+	WaitNErr error
 }
 
 func NewFakeLimiters() *FakeLimiters {
@@ -314,7 +619,10 @@ func (ls *FakeLimiters) waitN(ctx context.Context, id, count int) error {
 		ID:    id,
 		Count: count,
 	})
-	return nil
+	if ls.WaitNErr != nil {
+		return ls.WaitNErr
+	}
+	return ctx.Err()
 }
 
 type fakeLimiter struct {
@@ -359,6 +667,10 @@ func tcpCallerContext(ip string) context.Context {
 	})
 }
 
+func idCallerContext(id string) context.Context {
+	return rpccontext.WithCallerID(context.Background(), spiffeid.RequireFromString(id))
+}
+
 func setupClock(t *testing.T) (*clock.Mock, func()) {
 	mockClk := clock.NewMock(t)
 	oldClk := clk
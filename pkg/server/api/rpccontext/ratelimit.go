@@ -26,3 +26,18 @@ func RateLimit(ctx context.Context, count int) error {
 	}
 	return limiter.RateLimit(ctx, count)
 }
+
+type attestationTypeKey struct{}
+
+// WithAttestationType returns a context with the type of node attestation
+// performed by the call, used to rate limit attestation per type.
+func WithAttestationType(ctx context.Context, attestationType string) context.Context {
+	return context.WithValue(ctx, attestationTypeKey{}, attestationType)
+}
+
+// AttestationType returns the type of node attestation performed by the
+// call, if any.
+func AttestationType(ctx context.Context) (string, bool) {
+	value, ok := ctx.Value(attestationTypeKey{}).(string)
+	return value, ok
+}
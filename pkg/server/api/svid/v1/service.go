@@ -2,23 +2,31 @@ package svid
 
 import (
 	"context"
+	"crypto"
 	"crypto/x509"
+	"errors"
 	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/jwtsvid"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
 	"github.com/spiffe/spire/pkg/server/ca"
+	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/proto/spire/api/server/svid/v1"
+	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/proto/spire/types"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/structpb"
 )
 
 // RegisterService registers the service on the gRPC server.
@@ -214,6 +222,32 @@ func (s *Service) newX509SVID(ctx context.Context, param *svid.NewX509SVIDParams
 			Status: api.MakeStatus(log, codes.Internal, "entry has malformed SPIFFE ID", err),
 		}
 	}
+
+	// Entries with a SPIFFE ID template are expanded by the agent for each
+	// workload. The SVID is signed for the SPIFFE ID in the CSR, as long as
+	// it is an expansion of the template that the entry selectors allow.
+	svidID := entry.SpiffeId
+	if idutil.IsTemplate(spiffeID.String()) {
+		if !fflag.IsSet(fflag.FlagSPIFFEIDTemplates) {
+			return &svid.BatchNewX509SVIDResponse_Result{
+				Status: api.MakeStatus(log, codes.FailedPrecondition, "SPIFFE ID templates are not enabled", nil),
+			}
+		}
+		selectors, err := api.SelectorsFromProto(entry.Selectors)
+		if err != nil {
+			// This shouldn't be the case unless there is invalid data in the datastore
+			return &svid.BatchNewX509SVIDResponse_Result{
+				Status: api.MakeStatus(log, codes.Internal, "entry has malformed selectors", err),
+			}
+		}
+		spiffeID, err = templateSPIFFEIDFromCSR(spiffeID, selectors, csr)
+		if err != nil {
+			return &svid.BatchNewX509SVIDResponse_Result{
+				Status: api.MakeStatus(log, codes.InvalidArgument, "invalid CSR for SPIFFE ID template", err),
+			}
+		}
+		svidID = api.ProtoFromID(spiffeID)
+	}
 	log = log.WithField(telemetry.SPIFFEID, spiffeID.String())
 
 	x509Svid, err := s.ca.SignX509SVID(ctx, ca.X509SVIDParams{
@@ -221,6 +255,7 @@ func (s *Service) newX509SVID(ctx context.Context, param *svid.NewX509SVIDParams
 		PublicKey: csr.PublicKey,
 		DNSList:   entry.DnsNames,
 		TTL:       time.Duration(entry.Ttl) * time.Second,
+		EntryID:   entry.Id,
 	})
 	if err != nil {
 		return &svid.BatchNewX509SVIDResponse_Result{
@@ -230,7 +265,7 @@ func (s *Service) newX509SVID(ctx context.Context, param *svid.NewX509SVIDParams
 
 	return &svid.BatchNewX509SVIDResponse_Result{
 		Svid: &types.X509SVID{
-			Id:        entry.SpiffeId,
+			Id:        svidID,
 			CertChain: x509util.RawCertsFromCertificates(x509Svid),
 			ExpiresAt: x509Svid[0].NotAfter.UTC().Unix(),
 		},
@@ -251,6 +286,11 @@ func (s *Service) mintJWTSVID(ctx context.Context, protoID *types.SPIFFEID, audi
 	if len(audience) == 0 {
 		return nil, api.MakeErr(log, codes.InvalidArgument, "at least one audience is required", nil)
 	}
+	for _, aud := range audience {
+		if aud == "" {
+			return nil, api.MakeErr(log, codes.InvalidArgument, "audience cannot be empty", nil)
+		}
+	}
 
 	token, err := s.ca.SignJWTSVID(ctx, ca.JWTSVIDParams{
 		SpiffeID: id,
@@ -292,6 +332,14 @@ func (s *Service) NewJWTSVID(ctx context.Context, req *svid.NewJWTSVIDRequest) (
 		return nil, api.MakeErr(log, codes.NotFound, "entry not found or not authorized", nil)
 	}
 
+	if spiffeID, err := api.TrustDomainMemberIDFromProto(s.td, entry.SpiffeId); err == nil && idutil.IsTemplate(spiffeID.String()) {
+		return nil, api.MakeErr(log, codes.FailedPrecondition, "JWT-SVIDs are not supported for entries with a SPIFFE ID template", nil)
+	}
+
+	if !api.AudiencesAllowed(entry.AllowedAudiences, req.Audience) {
+		return nil, api.MakeErr(log.WithField(telemetry.Audience, req.Audience), codes.PermissionDenied, "audience is not allowed for the entry", nil)
+	}
+
 	jwtsvid, err := s.mintJWTSVID(ctx, entry.SpiffeId, req.Audience, entry.Ttl)
 	if err != nil {
 		return nil, err
@@ -352,6 +400,84 @@ func (s *Service) NewDownstreamX509CA(ctx context.Context, req *svid.NewDownstre
 	}, nil
 }
 
+func (s *Service) ValidateJWTSVID(ctx context.Context, req *svid.ValidateJWTSVIDRequest) (*svid.ValidateJWTSVIDResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	switch {
+	case req.Token == "":
+		return nil, api.MakeErr(log, codes.InvalidArgument, "missing token", nil)
+	case req.Audience == "":
+		return nil, api.MakeErr(log, codes.InvalidArgument, "missing audience", nil)
+	}
+
+	log = log.WithField(telemetry.Audience, req.Audience)
+
+	keyStore := &dataStoreKeyStore{ds: s.ds}
+	spiffeID, claims, err := jwtsvid.ValidateToken(ctx, req.Token, keyStore, []string{req.Audience})
+	switch {
+	case keyStore.err != nil:
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch bundle", keyStore.err)
+	case err != nil:
+		return nil, api.MakeErr(log, codes.InvalidArgument, "failed to validate JWT-SVID", err)
+	}
+
+	id, err := spiffeid.FromString(spiffeID)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "invalid SPIFFE ID", err)
+	}
+
+	claimsStruct, err := structpb.NewStruct(claims)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to convert JWT-SVID claims", err)
+	}
+
+	return &svid.ValidateJWTSVIDResponse{
+		Id:     api.ProtoFromID(id),
+		Claims: claimsStruct,
+	}, nil
+}
+
+// dataStoreKeyStore finds JWT signing keys in the bundles stored in the
+// datastore, which hold both the bundle of the server trust domain and the
+// bundles of federated trust domains. The error of the last failed attempt
+// to fetch a bundle is kept so it can be told apart from validation errors.
+type dataStoreKeyStore struct {
+	ds  datastore.DataStore
+	err error
+}
+
+func (k *dataStoreKeyStore) FindPublicKey(ctx context.Context, trustDomainID, keyID string) (crypto.PublicKey, error) {
+	// A key missing from the cached bundle may have been added since the
+	// bundle was cached, e.g. by another server sharing the datastore, so the
+	// bundle is fetched again before the key is reported as not found.
+	publicKey, err := k.findPublicKey(dscache.WithCache(ctx), trustDomainID, keyID)
+	if err == nil || k.err != nil {
+		return publicKey, err
+	}
+	return k.findPublicKey(ctx, trustDomainID, keyID)
+}
+
+func (k *dataStoreKeyStore) findPublicKey(ctx context.Context, trustDomainID, keyID string) (crypto.PublicKey, error) {
+	resp, err := k.ds.FetchBundle(ctx, &datastore.FetchBundleRequest{
+		TrustDomainId: trustDomainID,
+	})
+	if err != nil {
+		k.err = err
+		return nil, err
+	}
+
+	keys := make(map[string]map[string]crypto.PublicKey)
+	if resp.Bundle != nil {
+		bundle, err := bundleutil.BundleFromProto(resp.Bundle)
+		if err != nil {
+			k.err = err
+			return nil, err
+		}
+		keys[trustDomainID] = bundle.JWTSigningKeys()
+	}
+	return jwtsvid.NewKeyStore(keys).FindPublicKey(ctx, trustDomainID, keyID)
+}
+
 func parseAndCheckCSR(ctx context.Context, csrBytes []byte) (*x509.CertificateRequest, error) {
 	log := rpccontext.Logger(ctx)
 
@@ -366,3 +492,19 @@ func parseAndCheckCSR(ctx context.Context, csrBytes []byte) (*x509.CertificateRe
 
 	return csr, nil
 }
+
+// templateSPIFFEIDFromCSR returns the SPIFFE ID in the URI SAN of the CSR if
+// it is an expansion of the template that the entry selectors allow.
+func templateSPIFFEIDFromCSR(template spiffeid.ID, selectors []*common.Selector, csr *x509.CertificateRequest) (spiffeid.ID, error) {
+	if len(csr.URIs) != 1 {
+		return spiffeid.ID{}, errors.New("CSR must have exactly one URI SAN")
+	}
+	id, err := spiffeid.FromURI(csr.URIs[0])
+	if err != nil {
+		return spiffeid.ID{}, err
+	}
+	if err := idutil.ValidateTemplateExpansion(template.String(), id.String(), selectors); err != nil {
+		return spiffeid.ID{}, err
+	}
+	return id, nil
+}
@@ -7,6 +7,7 @@ import (
 	"crypto/x509/pkix"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/url"
 	"testing"
 	"time"
@@ -15,15 +16,19 @@ import (
 	"github.com/sirupsen/logrus/hooks/test"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 
+	"github.com/spiffe/spire/pkg/common/fflag"
+	"github.com/spiffe/spire/pkg/common/jwtsvid"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/rpccontext"
 	"github.com/spiffe/spire/pkg/server/api/svid/v1"
+	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	svidpb "github.com/spiffe/spire/proto/spire/api/server/svid/v1"
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
 	"github.com/spiffe/spire/test/fakes/fakeserverca"
 	"github.com/spiffe/spire/test/spiretest"
@@ -343,6 +348,15 @@ func TestServiceMintJWTSVID(t *testing.T) {
 			expiresAt: expiresAt,
 			id:        workloadID,
 		},
+		{
+			name:      "empty audience",
+			code:      codes.InvalidArgument,
+			audience:  []string{"AUDIENCE", ""},
+			err:       "audience cannot be empty",
+			logMsg:    "Invalid argument: audience cannot be empty",
+			expiresAt: expiresAt,
+			id:        workloadID,
+		},
 		{
 			name:        "fails minting",
 			code:        codes.Internal,
@@ -404,8 +418,14 @@ func TestServiceNewJWTSVID(t *testing.T) {
 		ParentId: api.ProtoFromID(agentID),
 		SpiffeId: &types.SPIFFEID{},
 	}
+	entryWithAllowedAudiences := &types.Entry{
+		Id:               "agent-entry-audiences-id",
+		ParentId:         api.ProtoFromID(agentID),
+		SpiffeId:         &types.SPIFFEID{TrustDomain: "example.org", Path: "/agent-audiences"},
+		AllowedAudiences: []string{"AUDIENCE1", "AUDIENCE2"},
+	}
 
-	test.ef.entries = []*types.Entry{entry, entryWithTTL, invalidEntry}
+	test.ef.entries = []*types.Entry{entry, entryWithTTL, invalidEntry, entryWithAllowedAudiences}
 	jwtKey := test.ca.JWTKey()
 	now := test.ca.Clock().Now().UTC()
 
@@ -445,6 +465,20 @@ func TestServiceNewJWTSVID(t *testing.T) {
 			err:      "invalid SPIFFE ID: spiffeid: trust domain is empty",
 			logMsg:   "Invalid argument: invalid SPIFFE ID",
 		},
+		{
+			name:      "success allowed audiences",
+			audience:  []string{"AUDIENCE2", "AUDIENCE1"},
+			entry:     entryWithAllowedAudiences,
+			expiresAt: expiresAt,
+		},
+		{
+			name:     "audience not allowed",
+			code:     codes.PermissionDenied,
+			audience: []string{"AUDIENCE1", "AUDIENCE3"},
+			entry:    entryWithAllowedAudiences,
+			err:      "audience is not allowed for the entry",
+			logMsg:   "Audience is not allowed for the entry",
+		},
 		{
 			name:   "no audience",
 			code:   codes.InvalidArgument,
@@ -452,6 +486,14 @@ func TestServiceNewJWTSVID(t *testing.T) {
 			logMsg: "Invalid argument: at least one audience is required",
 			entry:  entry,
 		},
+		{
+			name:     "empty audience",
+			code:     codes.InvalidArgument,
+			audience: []string{""},
+			err:      "audience cannot be empty",
+			logMsg:   "Invalid argument: audience cannot be empty",
+			entry:    entry,
+		},
 		{
 			name:         "no caller id",
 			code:         codes.Internal,
@@ -928,6 +970,111 @@ func TestServiceBatchNewX509SVID(t *testing.T) {
 	}
 }
 
+func TestServiceBatchNewX509SVIDWithTemplate(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	require.NoError(t, fflag.Load(fflag.RawConfig{string(fflag.FlagSPIFFEIDTemplates)}))
+	defer fflag.Unload()
+
+	templateEntry := &types.Entry{
+		Id:        "template",
+		ParentId:  api.ProtoFromID(agentID),
+		SpiffeId:  &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/{k8s:ns}/sa/{k8s:sa}"},
+		Selectors: []*types.Selector{{Type: "k8s", Value: "ns:default"}},
+	}
+	test.ef.entries = []*types.Entry{templateEntry}
+	test.withCallerID = true
+
+	for _, tt := range []struct {
+		name      string
+		uris      []string
+		expectID  string
+		expectErr string
+	}{
+		{
+			name:     "expanded SPIFFE ID",
+			uris:     []string{"spiffe://example.org/ns/default/sa/foo"},
+			expectID: "spiffe://example.org/ns/default/sa/foo",
+		},
+		{
+			name:      "SPIFFE ID does not match template",
+			uris:      []string{"spiffe://example.org/ns/default"},
+			expectErr: `invalid CSR for SPIFFE ID template: "spiffe://example.org/ns/default" does not match the entry SPIFFE ID template`,
+		},
+		{
+			name:      "SPIFFE ID not allowed by the entry selectors",
+			uris:      []string{"spiffe://example.org/ns/kube-system/sa/admin"},
+			expectErr: `invalid CSR for SPIFFE ID template: "spiffe://example.org/ns/kube-system/sa/admin" expands template variable {k8s:ns} to "kube-system", which the entry selector "k8s:ns:default" does not allow`,
+		},
+		{
+			name:      "SPIFFE ID from another trust domain",
+			uris:      []string{"spiffe://other.org/ns/default/sa/foo"},
+			expectErr: `invalid CSR for SPIFFE ID template: "spiffe://other.org/ns/default/sa/foo" does not match the entry SPIFFE ID template`,
+		},
+		{
+			name:      "no URI SAN",
+			expectErr: "invalid CSR for SPIFFE ID template: CSR must have exactly one URI SAN",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var uris []*url.URL
+			for _, uri := range tt.uris {
+				u, err := url.Parse(uri)
+				require.NoError(t, err)
+				uris = append(uris, u)
+			}
+
+			test.rateLimiter.count = 1
+			resp, err := test.client.BatchNewX509SVID(context.Background(), &svidpb.BatchNewX509SVIDRequest{
+				Params: []*svidpb.NewX509SVIDParams{
+					{EntryId: templateEntry.Id, Csr: createCSR(t, &x509.CertificateRequest{URIs: uris})},
+				},
+			})
+			require.NoError(t, err)
+			require.Len(t, resp.Results, 1)
+			result := resp.Results[0]
+
+			if tt.expectErr != "" {
+				require.Equal(t, int32(codes.InvalidArgument), result.Status.Code)
+				require.Contains(t, result.Status.Message, tt.expectErr)
+				require.Nil(t, result.Svid)
+				return
+			}
+
+			require.Equal(t, int32(codes.OK), result.Status.Code)
+			id := spiffeid.RequireFromString(tt.expectID)
+			spiretest.AssertProtoEqual(t, api.ProtoFromID(id), result.Svid.Id)
+
+			certChain, err := x509util.RawCertsToCertificates(result.Svid.CertChain)
+			require.NoError(t, err)
+			require.Equal(t, []*url.URL{id.URL()}, certChain[0].URIs)
+		})
+	}
+
+	// JWT-SVIDs cannot be minted for templates
+	test.rateLimiter.count = 1
+	_, err := test.client.NewJWTSVID(context.Background(), &svidpb.NewJWTSVIDRequest{
+		EntryId:  templateEntry.Id,
+		Audience: []string{"AUDIENCE"},
+	})
+	spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "JWT-SVIDs are not supported for entries with a SPIFFE ID template")
+
+	// X509-SVIDs are not signed for templates once the feature is disabled
+	fflag.Unload()
+	test.rateLimiter.count = 1
+	resp, err := test.client.BatchNewX509SVID(context.Background(), &svidpb.BatchNewX509SVIDRequest{
+		Params: []*svidpb.NewX509SVIDParams{
+			{EntryId: templateEntry.Id, Csr: createCSR(t, &x509.CertificateRequest{URIs: []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/ns/default/sa/foo"}}})},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 1)
+	require.Equal(t, int32(codes.FailedPrecondition), resp.Results[0].Status.Code)
+	require.Equal(t, "SPIFFE ID templates are not enabled", resp.Results[0].Status.Message)
+}
+
 func TestNewDownstreamX509CA(t *testing.T) {
 	type downstreamCaTest struct {
 		name           string
@@ -1078,6 +1225,209 @@ func TestNewDownstreamX509CA(t *testing.T) {
 	}
 }
 
+func TestServiceValidateJWTSVID(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	federatedTD := spiffeid.RequireTrustDomainFromString("federated.test")
+	federatedKey := testkey.NewEC256(t)
+
+	jwtKeyPKIX, err := x509.MarshalPKIXPublicKey(test.ca.JWTKey().Signer.Public())
+	require.NoError(t, err)
+	federatedKeyPKIX, err := x509.MarshalPKIXPublicKey(federatedKey.Public())
+	require.NoError(t, err)
+
+	for _, bundle := range []*common.Bundle{
+		{
+			TrustDomainId:  td.IDString(),
+			JwtSigningKeys: []*common.PublicKey{{Kid: test.ca.JWTKey().Kid, PkixBytes: jwtKeyPKIX}},
+		},
+		{
+			TrustDomainId:  federatedTD.IDString(),
+			JwtSigningKeys: []*common.PublicKey{{Kid: "FEDERATED", PkixBytes: federatedKeyPKIX}},
+		},
+	} {
+		_, err := test.ds.CreateBundle(context.Background(), &datastore.CreateBundleRequest{Bundle: bundle})
+		require.NoError(t, err)
+	}
+
+	mintResp, err := test.client.MintJWTSVID(context.Background(), &svidpb.MintJWTSVIDRequest{
+		Id:       api.ProtoFromID(workloadID),
+		Audience: []string{"AUDIENCE"},
+	})
+	require.NoError(t, err)
+	localToken := mintResp.Svid.Token
+
+	federatedID := federatedTD.NewID("workload")
+	signer := jwtsvid.NewSigner(jwtsvid.SignerConfig{})
+	federatedToken, err := signer.SignToken(federatedID.String(), []string{"AUDIENCE"}, time.Now().Add(time.Minute), federatedKey, "FEDERATED")
+	require.NoError(t, err)
+	unknownKeyToken, err := signer.SignToken(federatedID.String(), []string{"AUDIENCE"}, time.Now().Add(time.Minute), federatedKey, "UNKNOWN")
+	require.NoError(t, err)
+	expiredToken, err := signer.SignToken(federatedID.String(), []string{"AUDIENCE"}, time.Now().Add(-time.Minute), federatedKey, "FEDERATED")
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		name     string
+		token    string
+		audience string
+		expectID spiffeid.ID
+		code     codes.Code
+		err      string
+		logMsg   string
+	}{
+		{
+			name:     "success",
+			token:    localToken,
+			audience: "AUDIENCE",
+			expectID: workloadID,
+		},
+		{
+			name:     "success with federated trust domain",
+			token:    federatedToken,
+			audience: "AUDIENCE",
+			expectID: federatedID,
+		},
+		{
+			name:     "missing token",
+			audience: "AUDIENCE",
+			code:     codes.InvalidArgument,
+			err:      "missing token",
+			logMsg:   "Invalid argument: missing token",
+		},
+		{
+			name:   "missing audience",
+			token:  localToken,
+			code:   codes.InvalidArgument,
+			err:    "missing audience",
+			logMsg: "Invalid argument: missing audience",
+		},
+		{
+			name:     "malformed token",
+			token:    "not-a-token",
+			audience: "AUDIENCE",
+			code:     codes.InvalidArgument,
+			err:      "failed to validate JWT-SVID: unable to parse JWT token",
+			logMsg:   "Invalid argument: failed to validate JWT-SVID",
+		},
+		{
+			name:     "unexpected audience",
+			token:    localToken,
+			audience: "OTHER",
+			code:     codes.InvalidArgument,
+			err:      `failed to validate JWT-SVID: expected audience in ["OTHER"] (audience=["AUDIENCE"])`,
+			logMsg:   "Invalid argument: failed to validate JWT-SVID",
+		},
+		{
+			name:     "unknown key",
+			token:    unknownKeyToken,
+			audience: "AUDIENCE",
+			code:     codes.InvalidArgument,
+			err:      `failed to validate JWT-SVID: public key "UNKNOWN" not found in trust domain "spiffe://federated.test"`,
+			logMsg:   "Invalid argument: failed to validate JWT-SVID",
+		},
+		{
+			name:     "expired token",
+			token:    expiredToken,
+			audience: "AUDIENCE",
+			code:     codes.InvalidArgument,
+			err:      "failed to validate JWT-SVID: token has expired",
+			logMsg:   "Invalid argument: failed to validate JWT-SVID",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test.logHook.Reset()
+
+			resp, err := test.client.ValidateJWTSVID(context.Background(), &svidpb.ValidateJWTSVIDRequest{
+				Token:    tt.token,
+				Audience: tt.audience,
+			})
+			if tt.err != "" {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.err)
+				require.Nil(t, resp)
+				require.Equal(t, tt.logMsg, test.logHook.LastEntry().Message)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, api.ProtoFromID(tt.expectID), resp.Id)
+			require.Equal(t, tt.expectID.String(), resp.Claims.Fields["sub"].GetStringValue())
+			require.Equal(t, "AUDIENCE", resp.Claims.Fields["aud"].GetListValue().Values[0].GetStringValue())
+		})
+	}
+
+	t.Run("keys are served from the bundle cache", func(t *testing.T) {
+		ds := &fetchCountingDataStore{DataStore: fakedatastore.New(t)}
+		cache := dscache.New(ds, clock.NewMock(t))
+		service := svid.New(svid.Config{
+			TrustDomain: td,
+			DataStore:   cache,
+		})
+		log := logrus.New()
+		log.SetOutput(ioutil.Discard)
+		ctx := rpccontext.WithLogger(context.Background(), log)
+		validate := func() error {
+			_, err := service.ValidateJWTSVID(ctx, &svidpb.ValidateJWTSVIDRequest{
+				Token:    federatedToken,
+				Audience: "AUDIENCE",
+			})
+			return err
+		}
+
+		federatedBundle := &common.Bundle{
+			TrustDomainId:  federatedTD.IDString(),
+			JwtSigningKeys: []*common.PublicKey{{Kid: "FEDERATED", PkixBytes: federatedKeyPKIX}},
+		}
+		_, err := ds.SetBundle(context.Background(), &datastore.SetBundleRequest{Bundle: federatedBundle})
+		require.NoError(t, err)
+
+		// Repeated validations fetch the bundle once
+		require.NoError(t, validate())
+		require.NoError(t, validate())
+		require.Equal(t, 1, ds.fetches)
+
+		// Bundle updates through the cache are seen right away
+		_, err = cache.SetBundle(context.Background(), &datastore.SetBundleRequest{Bundle: &common.Bundle{
+			TrustDomainId:  federatedTD.IDString(),
+			JwtSigningKeys: []*common.PublicKey{{Kid: "OTHER", PkixBytes: federatedKeyPKIX}},
+		}})
+		require.NoError(t, err)
+		spiretest.RequireGRPCStatusContains(t, validate(), codes.InvalidArgument, `public key "FEDERATED" not found`)
+
+		// Keys added to the datastore behind the back of the cache are found
+		// by fetching the bundle again
+		_, err = ds.AppendBundle(context.Background(), &datastore.AppendBundleRequest{Bundle: federatedBundle})
+		require.NoError(t, err)
+		fetches := ds.fetches
+		require.NoError(t, validate())
+		require.Equal(t, fetches+1, ds.fetches)
+		require.NoError(t, validate())
+		require.Equal(t, fetches+1, ds.fetches)
+	})
+
+	t.Run("fails to fetch bundle", func(t *testing.T) {
+		test.ds.SetNextError(errors.New("oh no"))
+		resp, err := test.client.ValidateJWTSVID(context.Background(), &svidpb.ValidateJWTSVIDRequest{
+			Token:    localToken,
+			Audience: "AUDIENCE",
+		})
+		spiretest.RequireGRPCStatus(t, err, codes.Internal, "failed to fetch bundle: oh no")
+		require.Nil(t, resp)
+	})
+}
+
+// fetchCountingDataStore counts the bundles fetched from the datastore it
+// wraps.
+type fetchCountingDataStore struct {
+	datastore.DataStore
+	fetches int
+}
+
+func (ds *fetchCountingDataStore) FetchBundle(ctx context.Context, req *datastore.FetchBundleRequest) (*datastore.FetchBundleResponse, error) {
+	ds.fetches++
+	return ds.DataStore.FetchBundle(ctx, req)
+}
+
 type serviceTest struct {
 	client       svidpb.SVIDClient
 	ef           *entryFetcher // Stores entries explicitly fetched using FetchAuthorizedEntries
@@ -0,0 +1,314 @@
+package trustdomain
+
+import (
+	"context"
+
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/plugin/datastore"
+	"github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// BundleRefresher is used by the service to refresh bundles.
+type BundleRefresher interface {
+	// RefreshBundleFor refreshes the bundle for the given trust domain. It
+	// returns false if the trust domain is not federated with.
+	RefreshBundleFor(ctx context.Context, td spiffeid.TrustDomain) (bool, error)
+}
+
+// Config is the service configuration
+type Config struct {
+	DataStore       datastore.DataStore
+	TrustDomain     spiffeid.TrustDomain
+	BundleRefresher BundleRefresher
+}
+
+// New creates a new trustdomain service
+func New(config Config) *Service {
+	return &Service{
+		ds: config.DataStore,
+		td: config.TrustDomain,
+		br: config.BundleRefresher,
+	}
+}
+
+// Service implements the v1 trustdomain service
+type Service struct {
+	trustdomain.UnsafeTrustDomainServer
+
+	ds datastore.DataStore
+	td spiffeid.TrustDomain
+	br BundleRefresher
+}
+
+// RegisterService registers the trustdomain service on the gRPC server.
+func RegisterService(s *grpc.Server, service *Service) {
+	trustdomain.RegisterTrustDomainServer(s, service)
+}
+
+func (s *Service) ListFederationRelationships(ctx context.Context, req *trustdomain.ListFederationRelationshipsRequest) (*trustdomain.ListFederationRelationshipsResponse, error) {
+	log := rpccontext.Logger(ctx)
+
+	listReq := &datastore.ListFederationRelationshipsRequest{}
+
+	// Set pagination parameters
+	if req.PageSize > 0 {
+		listReq.Pagination = &datastore.Pagination{
+			PageSize: req.PageSize,
+			Token:    req.PageToken,
+		}
+	}
+
+	dsResp, err := s.ds.ListFederationRelationships(ctx, listReq)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to list federation relationships", err)
+	}
+
+	resp := &trustdomain.ListFederationRelationshipsResponse{}
+
+	if dsResp.Pagination != nil {
+		resp.NextPageToken = dsResp.Pagination.Token
+	}
+
+	for _, dsFederationRelationship := range dsResp.FederationRelationships {
+		fr, err := api.FederationRelationshipToProto(dsFederationRelationship)
+		if err != nil {
+			return nil, api.MakeErr(log.WithField(telemetry.TrustDomainID, dsFederationRelationship.TrustDomainId), codes.Internal, "failed to convert federation relationship", err)
+		}
+		applyFederationRelationshipMask(fr, req.OutputMask)
+		resp.FederationRelationships = append(resp.FederationRelationships, fr)
+	}
+
+	return resp, nil
+}
+
+func (s *Service) GetFederationRelationship(ctx context.Context, req *trustdomain.GetFederationRelationshipRequest) (*types.FederationRelationship, error) {
+	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, req.TrustDomain)
+
+	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "trust domain argument is not valid", err)
+	}
+
+	dsResp, err := s.ds.FetchFederationRelationship(ctx, &datastore.FetchFederationRelationshipRequest{
+		TrustDomainId: td.IDString(),
+	})
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to fetch federation relationship", err)
+	}
+
+	if dsResp.FederationRelationship == nil {
+		return nil, api.MakeErr(log, codes.NotFound, "federation relationship does not exist", nil)
+	}
+
+	fr, err := api.FederationRelationshipToProto(dsResp.FederationRelationship)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to convert federation relationship", err)
+	}
+
+	applyFederationRelationshipMask(fr, req.OutputMask)
+	return fr, nil
+}
+
+func (s *Service) BatchCreateFederationRelationship(ctx context.Context, req *trustdomain.BatchCreateFederationRelationshipRequest) (*trustdomain.BatchCreateFederationRelationshipResponse, error) {
+	var results []*trustdomain.BatchCreateFederationRelationshipResponse_Result
+	for _, fr := range req.FederationRelationships {
+		results = append(results, s.createFederationRelationship(ctx, fr, req.OutputMask))
+	}
+
+	return &trustdomain.BatchCreateFederationRelationshipResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *Service) createFederationRelationship(ctx context.Context, fr *types.FederationRelationship, outputMask *types.FederationRelationshipMask) *trustdomain.BatchCreateFederationRelationshipResponse_Result {
+	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, fr.TrustDomain)
+
+	dsFederationRelationship, err := api.ProtoToFederationRelationship(fr)
+	if err != nil {
+		return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.InvalidArgument, "failed to convert federation relationship", err),
+		}
+	}
+
+	if s.td.IDString() == dsFederationRelationship.TrustDomainId {
+		return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.InvalidArgument, "unable to create federation relationship for server trust domain", nil),
+		}
+	}
+
+	resp, err := s.ds.CreateFederationRelationship(ctx, &datastore.CreateFederationRelationshipRequest{
+		FederationRelationship: dsFederationRelationship,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.AlreadyExists:
+		return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.AlreadyExists, "federation relationship already exists", nil),
+		}
+	default:
+		return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to create federation relationship", err),
+		}
+	}
+
+	protoFederationRelationship, err := api.FederationRelationshipToProto(resp.FederationRelationship)
+	if err != nil {
+		return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert federation relationship", err),
+		}
+	}
+
+	applyFederationRelationshipMask(protoFederationRelationship, outputMask)
+
+	log.Debug("Federation relationship created")
+	return &trustdomain.BatchCreateFederationRelationshipResponse_Result{
+		Status:                 api.OK(),
+		FederationRelationship: protoFederationRelationship,
+	}
+}
+
+func (s *Service) BatchUpdateFederationRelationship(ctx context.Context, req *trustdomain.BatchUpdateFederationRelationshipRequest) (*trustdomain.BatchUpdateFederationRelationshipResponse, error) {
+	var results []*trustdomain.BatchUpdateFederationRelationshipResponse_Result
+	for _, fr := range req.FederationRelationships {
+		results = append(results, s.updateFederationRelationship(ctx, fr, req.InputMask, req.OutputMask))
+	}
+
+	return &trustdomain.BatchUpdateFederationRelationshipResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *Service) updateFederationRelationship(ctx context.Context, fr *types.FederationRelationship, inputMask, outputMask *types.FederationRelationshipMask) *trustdomain.BatchUpdateFederationRelationshipResponse_Result {
+	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, fr.TrustDomain)
+
+	dsFederationRelationship, err := api.ProtoToFederationRelationship(fr)
+	if err != nil {
+		return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.InvalidArgument, "failed to convert federation relationship", err),
+		}
+	}
+
+	if s.td.IDString() == dsFederationRelationship.TrustDomainId {
+		return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.InvalidArgument, "unable to update federation relationship for server trust domain", nil),
+		}
+	}
+
+	resp, err := s.ds.UpdateFederationRelationship(ctx, &datastore.UpdateFederationRelationshipRequest{
+		FederationRelationship: dsFederationRelationship,
+		InputMask:              api.ProtoToFederationRelationshipMask(inputMask),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.NotFound:
+		return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.NotFound, "federation relationship does not exist", err),
+		}
+	default:
+		return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to update federation relationship", err),
+		}
+	}
+
+	protoFederationRelationship, err := api.FederationRelationshipToProto(resp.FederationRelationship)
+	if err != nil {
+		return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+			Status: api.MakeStatus(log, codes.Internal, "failed to convert federation relationship", err),
+		}
+	}
+
+	applyFederationRelationshipMask(protoFederationRelationship, outputMask)
+
+	log.Debug("Federation relationship updated")
+	return &trustdomain.BatchUpdateFederationRelationshipResponse_Result{
+		Status:                 api.OK(),
+		FederationRelationship: protoFederationRelationship,
+	}
+}
+
+func (s *Service) BatchDeleteFederationRelationship(ctx context.Context, req *trustdomain.BatchDeleteFederationRelationshipRequest) (*trustdomain.BatchDeleteFederationRelationshipResponse, error) {
+	var results []*trustdomain.BatchDeleteFederationRelationshipResponse_Result
+	for _, trustDomain := range req.TrustDomains {
+		results = append(results, s.deleteFederationRelationship(ctx, trustDomain))
+	}
+
+	return &trustdomain.BatchDeleteFederationRelationshipResponse{
+		Results: results,
+	}, nil
+}
+
+func (s *Service) deleteFederationRelationship(ctx context.Context, trustDomain string) *trustdomain.BatchDeleteFederationRelationshipResponse_Result {
+	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, trustDomain)
+
+	td, err := spiffeid.TrustDomainFromString(trustDomain)
+	if err != nil {
+		return &trustdomain.BatchDeleteFederationRelationshipResponse_Result{
+			Status:      api.MakeStatus(log, codes.InvalidArgument, "trust domain argument is not valid", err),
+			TrustDomain: trustDomain,
+		}
+	}
+
+	_, err = s.ds.DeleteFederationRelationship(ctx, &datastore.DeleteFederationRelationshipRequest{
+		TrustDomainId: td.IDString(),
+	})
+	switch status.Code(err) {
+	case codes.OK:
+		log.Debug("Federation relationship deleted")
+		return &trustdomain.BatchDeleteFederationRelationshipResponse_Result{
+			Status:      api.OK(),
+			TrustDomain: trustDomain,
+		}
+	case codes.NotFound:
+		return &trustdomain.BatchDeleteFederationRelationshipResponse_Result{
+			Status:      api.MakeStatus(log, codes.NotFound, "federation relationship does not exist", err),
+			TrustDomain: trustDomain,
+		}
+	default:
+		return &trustdomain.BatchDeleteFederationRelationshipResponse_Result{
+			Status:      api.MakeStatus(log, codes.Internal, "failed to delete federation relationship", err),
+			TrustDomain: trustDomain,
+		}
+	}
+}
+
+func (s *Service) RefreshBundle(ctx context.Context, req *trustdomain.RefreshBundleRequest) (*emptypb.Empty, error) {
+	log := rpccontext.Logger(ctx).WithField(telemetry.TrustDomainID, req.TrustDomain)
+
+	td, err := spiffeid.TrustDomainFromString(req.TrustDomain)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.InvalidArgument, "trust domain argument is not valid", err)
+	}
+
+	federated, err := s.br.RefreshBundleFor(ctx, td)
+	if err != nil {
+		return nil, api.MakeErr(log, codes.Internal, "failed to refresh bundle", err)
+	}
+	if !federated {
+		return nil, api.MakeErr(log, codes.NotFound, "no relationship with trust domain", nil)
+	}
+
+	log.Debug("Bundle refreshed")
+	return &emptypb.Empty{}, nil
+}
+
+func applyFederationRelationshipMask(fr *types.FederationRelationship, mask *types.FederationRelationshipMask) {
+	if mask == nil {
+		return
+	}
+
+	if !mask.BundleEndpointUrl {
+		fr.BundleEndpointUrl = ""
+	}
+
+	if !mask.BundleEndpointProfile {
+		fr.BundleEndpointProfile = nil
+	}
+}
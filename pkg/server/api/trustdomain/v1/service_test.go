@@ -0,0 +1,531 @@
+package trustdomain_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/pkg/server/api/rpccontext"
+	"github.com/spiffe/spire/pkg/server/api/trustdomain/v1"
+	"github.com/spiffe/spire/pkg/server/plugin/datastore"
+	trustdomainpb "github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
+	"github.com/spiffe/spire/proto/spire/types"
+	"github.com/spiffe/spire/test/fakes/fakedatastore"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+var (
+	ctx         = context.Background()
+	td          = spiffeid.RequireTrustDomainFromString("example.org")
+	federatedTD = spiffeid.RequireTrustDomainFromString("domain1.org")
+)
+
+func TestGetFederationRelationship(t *testing.T) {
+	dsFR := &datastore.FederationRelationship{
+		TrustDomainId:         federatedTD.IDString(),
+		BundleEndpointUrl:     "https://domain1.org/bundle",
+		BundleEndpointProfile: datastore.FederationRelationship_HTTPS_SPIFFE,
+		EndpointSpiffeId:      "spiffe://domain1.org/bundle-server",
+	}
+
+	for _, tt := range []struct {
+		name        string
+		trustDomain string
+		outputMask  *types.FederationRelationshipMask
+		dsError     error
+		expectFR    *types.FederationRelationship
+		expectErr   string
+		expectLogs  []spiretest.LogEntry
+	}{
+		{
+			name:        "success",
+			trustDomain: "domain1.org",
+			expectFR: &types.FederationRelationship{
+				TrustDomain:       "domain1.org",
+				BundleEndpointUrl: "https://domain1.org/bundle",
+				BundleEndpointProfile: &types.FederationRelationship_HttpsSpiffe{
+					HttpsSpiffe: &types.HTTPSSPIFFEProfile{EndpointSpiffeId: "spiffe://domain1.org/bundle-server"},
+				},
+			},
+		},
+		{
+			name:        "success with output mask",
+			trustDomain: "domain1.org",
+			outputMask:  &types.FederationRelationshipMask{BundleEndpointUrl: true},
+			expectFR: &types.FederationRelationship{
+				TrustDomain:       "domain1.org",
+				BundleEndpointUrl: "https://domain1.org/bundle",
+			},
+		},
+		{
+			name:        "malformed trust domain",
+			trustDomain: "malformed id",
+			expectErr:   `rpc error: code = InvalidArgument desc = trust domain argument is not valid: spiffeid: unable to parse: parse "spiffe://malformed id": invalid character " " in host name`,
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: trust domain argument is not valid",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "malformed id",
+						logrus.ErrorKey:         `spiffeid: unable to parse: parse "spiffe://malformed id": invalid character " " in host name`,
+					},
+				},
+			},
+		},
+		{
+			name:        "not found",
+			trustDomain: "unknown.org",
+			expectErr:   "rpc error: code = NotFound desc = federation relationship does not exist",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Federation relationship does not exist",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "unknown.org",
+					},
+				},
+			},
+		},
+		{
+			name:        "datastore failure",
+			trustDomain: "domain1.org",
+			dsError:     errors.New("oh no"),
+			expectErr:   "rpc error: code = Internal desc = failed to fetch federation relationship: oh no",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to fetch federation relationship",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "domain1.org",
+						logrus.ErrorKey:         "oh no",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+			test.createFederationRelationship(t, dsFR)
+
+			test.ds.SetNextError(tt.dsError)
+			fr, err := test.client.GetFederationRelationship(ctx, &trustdomainpb.GetFederationRelationshipRequest{
+				TrustDomain: tt.trustDomain,
+				OutputMask:  tt.outputMask,
+			})
+			spiretest.AssertLogs(t, test.logHook.AllEntries(), tt.expectLogs)
+			if tt.expectErr != "" {
+				require.EqualError(t, err, tt.expectErr)
+				require.Nil(t, fr)
+				return
+			}
+			require.NoError(t, err)
+			spiretest.AssertProtoEqual(t, tt.expectFR, fr)
+		})
+	}
+}
+
+func TestListFederationRelationships(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	for _, trustDomain := range []string{"domain1.org", "domain2.org", "domain3.org"} {
+		test.createFederationRelationship(t, &datastore.FederationRelationship{
+			TrustDomainId:     "spiffe://" + trustDomain,
+			BundleEndpointUrl: "https://" + trustDomain + "/bundle",
+		})
+	}
+	webFR := func(trustDomain string) *types.FederationRelationship {
+		return &types.FederationRelationship{
+			TrustDomain:           trustDomain,
+			BundleEndpointUrl:     "https://" + trustDomain + "/bundle",
+			BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+		}
+	}
+
+	// List everything
+	resp, err := test.client.ListFederationRelationships(ctx, &trustdomainpb.ListFederationRelationshipsRequest{})
+	require.NoError(t, err)
+	spiretest.AssertProtoListEqual(t, []*types.FederationRelationship{
+		webFR("domain1.org"), webFR("domain2.org"), webFR("domain3.org"),
+	}, resp.FederationRelationships)
+	require.Empty(t, resp.NextPageToken)
+
+	// List with pagination and an output mask
+	resp, err = test.client.ListFederationRelationships(ctx, &trustdomainpb.ListFederationRelationshipsRequest{
+		PageSize:   2,
+		OutputMask: &types.FederationRelationshipMask{},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoListEqual(t, []*types.FederationRelationship{
+		{TrustDomain: "domain1.org"}, {TrustDomain: "domain2.org"},
+	}, resp.FederationRelationships)
+	require.NotEmpty(t, resp.NextPageToken)
+
+	resp, err = test.client.ListFederationRelationships(ctx, &trustdomainpb.ListFederationRelationshipsRequest{
+		PageSize:  2,
+		PageToken: resp.NextPageToken,
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoListEqual(t, []*types.FederationRelationship{webFR("domain3.org")}, resp.FederationRelationships)
+
+	// Datastore failure
+	test.logHook.Reset()
+	test.ds.SetNextError(errors.New("oh no"))
+	resp, err = test.client.ListFederationRelationships(ctx, &trustdomainpb.ListFederationRelationshipsRequest{})
+	require.EqualError(t, err, "rpc error: code = Internal desc = failed to list federation relationships: oh no")
+	require.Nil(t, resp)
+	spiretest.AssertLogs(t, test.logHook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.ErrorLevel,
+			Message: "Failed to list federation relationships",
+			Data: logrus.Fields{
+				logrus.ErrorKey: "oh no",
+			},
+		},
+	})
+}
+
+func TestBatchCreateFederationRelationship(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	fr := &types.FederationRelationship{
+		TrustDomain:       "domain1.org",
+		BundleEndpointUrl: "https://domain1.org/bundle",
+		BundleEndpointProfile: &types.FederationRelationship_HttpsSpiffe{
+			HttpsSpiffe: &types.HTTPSSPIFFEProfile{EndpointSpiffeId: "spiffe://domain1.org/bundle-server"},
+		},
+	}
+
+	resp, err := test.client.BatchCreateFederationRelationship(ctx, &trustdomainpb.BatchCreateFederationRelationshipRequest{
+		FederationRelationships: []*types.FederationRelationship{
+			fr,
+			fr,
+			{
+				TrustDomain:           "example.org",
+				BundleEndpointUrl:     "https://example.org/bundle",
+				BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+			},
+			{
+				TrustDomain:       "domain2.org",
+				BundleEndpointUrl: "http://domain2.org/bundle",
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 4)
+
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{
+		Status:                 api.OK(),
+		FederationRelationship: fr,
+	}, resp.Results[0])
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{
+		Status: api.CreateStatus(codes.AlreadyExists, "federation relationship already exists"),
+	}, resp.Results[1])
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{
+		Status: api.CreateStatus(codes.InvalidArgument, "unable to create federation relationship for server trust domain"),
+	}, resp.Results[2])
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchCreateFederationRelationshipResponse_Result{
+		Status: api.CreateStatus(codes.InvalidArgument, "failed to convert federation relationship: bundle endpoint URL must use the https scheme"),
+	}, resp.Results[3])
+
+	spiretest.AssertLogs(t, test.logHook.AllEntries(), []spiretest.LogEntry{
+		{
+			Level:   logrus.DebugLevel,
+			Message: "Federation relationship created",
+			Data: logrus.Fields{
+				telemetry.TrustDomainID: "domain1.org",
+			},
+		},
+		{
+			Level:   logrus.ErrorLevel,
+			Message: "Federation relationship already exists",
+			Data: logrus.Fields{
+				telemetry.TrustDomainID: "domain1.org",
+			},
+		},
+		{
+			Level:   logrus.ErrorLevel,
+			Message: "Invalid argument: unable to create federation relationship for server trust domain",
+			Data: logrus.Fields{
+				telemetry.TrustDomainID: "example.org",
+			},
+		},
+		{
+			Level:   logrus.ErrorLevel,
+			Message: "Invalid argument: failed to convert federation relationship",
+			Data: logrus.Fields{
+				telemetry.TrustDomainID: "domain2.org",
+				logrus.ErrorKey:         "bundle endpoint URL must use the https scheme",
+			},
+		},
+	})
+
+	// The relationship was persisted
+	dsResp, err := test.ds.FetchFederationRelationship(ctx, &datastore.FetchFederationRelationshipRequest{
+		TrustDomainId: federatedTD.IDString(),
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, &datastore.FederationRelationship{
+		TrustDomainId:         "spiffe://domain1.org",
+		BundleEndpointUrl:     "https://domain1.org/bundle",
+		BundleEndpointProfile: datastore.FederationRelationship_HTTPS_SPIFFE,
+		EndpointSpiffeId:      "spiffe://domain1.org/bundle-server",
+	}, dsResp.FederationRelationship)
+}
+
+func TestBatchUpdateFederationRelationship(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	test.createFederationRelationship(t, &datastore.FederationRelationship{
+		TrustDomainId:     federatedTD.IDString(),
+		BundleEndpointUrl: "https://domain1.org/bundle",
+	})
+
+	update := &types.FederationRelationship{
+		TrustDomain:       "domain1.org",
+		BundleEndpointUrl: "https://domain1.org:8443/bundle",
+		BundleEndpointProfile: &types.FederationRelationship_HttpsSpiffe{
+			HttpsSpiffe: &types.HTTPSSPIFFEProfile{EndpointSpiffeId: "spiffe://domain1.org/bundle-server"},
+		},
+	}
+
+	resp, err := test.client.BatchUpdateFederationRelationship(ctx, &trustdomainpb.BatchUpdateFederationRelationshipRequest{
+		FederationRelationships: []*types.FederationRelationship{
+			update,
+			{
+				TrustDomain:           "unknown.org",
+				BundleEndpointUrl:     "https://unknown.org/bundle",
+				BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+			},
+			{
+				TrustDomain:           "example.org",
+				BundleEndpointUrl:     "https://example.org/bundle",
+				BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+			},
+		},
+		InputMask:  &types.FederationRelationshipMask{BundleEndpointUrl: true},
+		OutputMask: &types.FederationRelationshipMask{BundleEndpointUrl: true, BundleEndpointProfile: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Results, 3)
+
+	// Only the URL was updated
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{
+		Status: api.OK(),
+		FederationRelationship: &types.FederationRelationship{
+			TrustDomain:           "domain1.org",
+			BundleEndpointUrl:     "https://domain1.org:8443/bundle",
+			BundleEndpointProfile: &types.FederationRelationship_HttpsWeb{HttpsWeb: &types.HTTPSWebProfile{}},
+		},
+	}, resp.Results[0])
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{
+		Status: api.CreateStatus(codes.NotFound, "federation relationship does not exist"),
+	}, resp.Results[1])
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{
+		Status: api.CreateStatus(codes.InvalidArgument, "unable to update federation relationship for server trust domain"),
+	}, resp.Results[2])
+
+	// Without an input mask everything gets updated
+	resp, err = test.client.BatchUpdateFederationRelationship(ctx, &trustdomainpb.BatchUpdateFederationRelationshipRequest{
+		FederationRelationships: []*types.FederationRelationship{update},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoEqual(t, &trustdomainpb.BatchUpdateFederationRelationshipResponse_Result{
+		Status:                 api.OK(),
+		FederationRelationship: update,
+	}, resp.Results[0])
+}
+
+func TestBatchDeleteFederationRelationship(t *testing.T) {
+	test := setupServiceTest(t)
+	defer test.Cleanup()
+
+	test.createFederationRelationship(t, &datastore.FederationRelationship{
+		TrustDomainId:     federatedTD.IDString(),
+		BundleEndpointUrl: "https://domain1.org/bundle",
+	})
+
+	resp, err := test.client.BatchDeleteFederationRelationship(ctx, &trustdomainpb.BatchDeleteFederationRelationshipRequest{
+		TrustDomains: []string{"domain1.org", "domain1.org", "malformed id"},
+	})
+	require.NoError(t, err)
+	spiretest.AssertProtoListEqual(t, []*trustdomainpb.BatchDeleteFederationRelationshipResponse_Result{
+		{
+			Status:      api.OK(),
+			TrustDomain: "domain1.org",
+		},
+		{
+			Status:      api.CreateStatus(codes.NotFound, "federation relationship does not exist"),
+			TrustDomain: "domain1.org",
+		},
+		{
+			Status:      api.CreateStatus(codes.InvalidArgument, `trust domain argument is not valid: spiffeid: unable to parse: parse "spiffe://malformed id": invalid character " " in host name`),
+			TrustDomain: "malformed id",
+		},
+	}, resp.Results)
+
+	dsResp, err := test.ds.FetchFederationRelationship(ctx, &datastore.FetchFederationRelationshipRequest{
+		TrustDomainId: federatedTD.IDString(),
+	})
+	require.NoError(t, err)
+	require.Nil(t, dsResp.FederationRelationship)
+}
+
+func TestRefreshBundle(t *testing.T) {
+	for _, tt := range []struct {
+		name        string
+		trustDomain string
+		refreshErr  error
+		expectErr   string
+		expectLogs  []spiretest.LogEntry
+	}{
+		{
+			name:        "success",
+			trustDomain: "domain1.org",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.DebugLevel,
+					Message: "Bundle refreshed",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "domain1.org",
+					},
+				},
+			},
+		},
+		{
+			name:        "no relationship",
+			trustDomain: "unknown.org",
+			expectErr:   "rpc error: code = NotFound desc = no relationship with trust domain",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "No relationship with trust domain",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "unknown.org",
+					},
+				},
+			},
+		},
+		{
+			name:        "refresh fails",
+			trustDomain: "domain1.org",
+			refreshErr:  errors.New("oh no"),
+			expectErr:   "rpc error: code = Internal desc = failed to refresh bundle: oh no",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Failed to refresh bundle",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "domain1.org",
+						logrus.ErrorKey:         "oh no",
+					},
+				},
+			},
+		},
+		{
+			name:        "malformed trust domain",
+			trustDomain: "",
+			expectErr:   "rpc error: code = InvalidArgument desc = trust domain argument is not valid: spiffeid: trust domain is empty",
+			expectLogs: []spiretest.LogEntry{
+				{
+					Level:   logrus.ErrorLevel,
+					Message: "Invalid argument: trust domain argument is not valid",
+					Data: logrus.Fields{
+						telemetry.TrustDomainID: "",
+						logrus.ErrorKey:         "spiffeid: trust domain is empty",
+					},
+				},
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			test := setupServiceTest(t)
+			defer test.Cleanup()
+			test.br.err = tt.refreshErr
+
+			_, err := test.client.RefreshBundle(ctx, &trustdomainpb.RefreshBundleRequest{
+				TrustDomain: tt.trustDomain,
+			})
+			spiretest.AssertLogs(t, test.logHook.AllEntries(), tt.expectLogs)
+			if tt.expectErr != "" {
+				require.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+type serviceTest struct {
+	client  trustdomainpb.TrustDomainClient
+	ds      *fakedatastore.DataStore
+	br      *fakeBundleRefresher
+	logHook *test.Hook
+	done    func()
+}
+
+func (s *serviceTest) Cleanup() {
+	s.done()
+}
+
+func (s *serviceTest) createFederationRelationship(t *testing.T, fr *datastore.FederationRelationship) {
+	_, err := s.ds.CreateFederationRelationship(ctx, &datastore.CreateFederationRelationshipRequest{
+		FederationRelationship: fr,
+	})
+	require.NoError(t, err)
+}
+
+func setupServiceTest(t *testing.T) *serviceTest {
+	ds := fakedatastore.New(t)
+	br := &fakeBundleRefresher{
+		trustDomains: map[spiffeid.TrustDomain]bool{federatedTD: true},
+	}
+	service := trustdomain.New(trustdomain.Config{
+		DataStore:       ds,
+		TrustDomain:     td,
+		BundleRefresher: br,
+	})
+
+	log, logHook := test.NewNullLogger()
+	log.Level = logrus.DebugLevel
+	registerFn := func(s *grpc.Server) {
+		trustdomain.RegisterService(s, service)
+	}
+	contextFn := func(ctx context.Context) context.Context {
+		return rpccontext.WithLogger(ctx, log)
+	}
+
+	conn, done := spiretest.NewAPIServer(t, registerFn, contextFn)
+	return &serviceTest{
+		client:  trustdomainpb.NewTrustDomainClient(conn),
+		ds:      ds,
+		br:      br,
+		logHook: logHook,
+		done:    done,
+	}
+}
+
+type fakeBundleRefresher struct {
+	trustDomains map[spiffeid.TrustDomain]bool
+	err          error
+}
+
+func (r *fakeBundleRefresher) RefreshBundleFor(ctx context.Context, td spiffeid.TrustDomain) (bool, error) {
+	if !r.trustDomains[td] {
+		return false, nil
+	}
+	return true, r.err
+}
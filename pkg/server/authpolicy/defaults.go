@@ -0,0 +1,107 @@
+package authpolicy
+
+// defaultPolicyRego is the built-in authorization policy. A call is
+// authorized if the policy data has an entry for the method that either
+// allows any caller or allows the role of the caller.
+const defaultPolicyRego = `package spire
+
+result = {
+	"allow": allow,
+	"allow_if_admin": allow_if_admin,
+	"allow_if_local": allow_if_local,
+	"allow_if_downstream": allow_if_downstream,
+	"allow_if_agent": allow_if_agent,
+}
+
+default allow = false
+
+default allow_if_admin = false
+
+default allow_if_local = false
+
+default allow_if_downstream = false
+
+default allow_if_agent = false
+
+allow {
+	r := data.apis[_]
+	r.full_method == input.full_method
+	r.allow_any
+}
+
+allow_if_admin {
+	r := data.apis[_]
+	r.full_method == input.full_method
+	r.allow_if_admin
+}
+
+allow_if_local {
+	r := data.apis[_]
+	r.full_method == input.full_method
+	r.allow_if_local
+}
+
+allow_if_downstream {
+	r := data.apis[_]
+	r.full_method == input.full_method
+	r.allow_if_downstream
+}
+
+allow_if_agent {
+	r := data.apis[_]
+	r.full_method == input.full_method
+	r.allow_if_agent
+}
+`
+
+// defaultPolicyData is the data evaluated by the built-in policy. It lists
+// the roles authorized to call each of the server APIs.
+const defaultPolicyData = `{
+	"apis": [
+		{"full_method": "/spire.api.server.svid.v1.SVID/MintX509SVID", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.svid.v1.SVID/MintJWTSVID", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.svid.v1.SVID/BatchNewX509SVID", "allow_if_agent": true},
+		{"full_method": "/spire.api.server.svid.v1.SVID/NewJWTSVID", "allow_if_agent": true},
+		{"full_method": "/spire.api.server.svid.v1.SVID/NewDownstreamX509CA", "allow_if_downstream": true},
+		{"full_method": "/spire.api.server.svid.v1.SVID/ValidateJWTSVID", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/GetBundle", "allow_any": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/AppendBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/PruneBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/WatchBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/PublishJWTAuthority", "allow_if_downstream": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/ListFederatedBundles", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/GetFederatedBundle", "allow_if_local": true, "allow_if_admin": true, "allow_if_agent": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/BatchCreateFederatedBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/BatchUpdateFederatedBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/BatchSetFederatedBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.bundle.v1.Bundle/BatchDeleteFederatedBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.debug.v1.Debug/GetInfo", "allow_if_local": true},
+		{"full_method": "/spire.api.server.debug.v1.Debug/VerifyCAJournal", "allow_if_local": true},
+		{"full_method": "/spire.api.server.debug.v1.Debug/RepairCAJournal", "allow_if_local": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/ListEntries", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/GetEntry", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/BatchCreateEntry", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/BatchUpdateEntry", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/BatchDeleteEntry", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/GetAuthorizedEntries", "allow_if_agent": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/GetAgentAuthorizedEntries", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.entry.v1.Entry/ListEntryTombstones", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/ListAgents", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/GetAgent", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/DeleteAgent", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/BanAgent", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/AttestAgent", "allow_any": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/RenewAgent", "allow_if_agent": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/CreateJoinToken", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/ListJoinTokens", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.agent.v1.Agent/RevokeJoinToken", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/ListFederationRelationships", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/GetFederationRelationship", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/BatchCreateFederationRelationship", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/BatchUpdateFederationRelationship", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/BatchDeleteFederationRelationship", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/spire.api.server.trustdomain.v1.TrustDomain/RefreshBundle", "allow_if_local": true, "allow_if_admin": true},
+		{"full_method": "/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo", "allow_if_local": true, "allow_if_admin": true}
+	]
+}
+`
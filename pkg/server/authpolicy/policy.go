@@ -0,0 +1,164 @@
+package authpolicy
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+
+	"github.com/open-policy-agent/opa/rego"
+	"github.com/open-policy-agent/opa/storage"
+	"github.com/open-policy-agent/opa/storage/inmem"
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// policyQuery is the query evaluated against the policy to obtain the
+	// authorization result.
+	policyQuery = "data.spire.result"
+)
+
+// Engine evaluates an authorization policy, written in Rego, to decide
+// whether a caller may invoke an RPC.
+type Engine struct {
+	rego rego.PreparedEvalQuery
+}
+
+// Input is the input provided to the policy.
+type Input struct {
+	// Caller is the SPIFFE ID of the caller, if any
+	Caller string `json:"caller"`
+
+	// FullMethod is the full gRPC method name of the RPC being invoked
+	// (e.g. "/spire.api.server.entry.v1.Entry/ListEntries")
+	FullMethod string `json:"full_method"`
+}
+
+// Result is the result of a policy evaluation. If Allow is true, the call is
+// authorized. Otherwise, the call is authorized if the caller satisfies any
+// of the conditions that are set.
+type Result struct {
+	// Allow is true if the call is authorized regardless of the caller
+	Allow bool `json:"allow"`
+
+	// AllowIfAdmin is true if the call is authorized for admin callers
+	AllowIfAdmin bool `json:"allow_if_admin"`
+
+	// AllowIfLocal is true if the call is authorized for callers on the
+	// local UDS
+	AllowIfLocal bool `json:"allow_if_local"`
+
+	// AllowIfDownstream is true if the call is authorized for downstream
+	// callers
+	AllowIfDownstream bool `json:"allow_if_downstream"`
+
+	// AllowIfAgent is true if the call is authorized for agent callers
+	AllowIfAgent bool `json:"allow_if_agent"`
+}
+
+// OpaEngineConfig configures the Rego policy and the policy data used by the
+// engine.
+type OpaEngineConfig struct {
+	Local *LocalOpaProviderConfig
+}
+
+// LocalOpaProviderConfig configures a policy that is loaded from the local
+// filesystem.
+type LocalOpaProviderConfig struct {
+	// RegoPath is the path to the Rego policy
+	RegoPath string
+
+	// PolicyDataPath is the path to the JSON policy data. If unset, the
+	// policy is evaluated without data.
+	PolicyDataPath string
+}
+
+// NewEngineFromConfigOrDefault returns an engine for the policy described by
+// the configuration, or the default policy if no configuration is provided.
+func NewEngineFromConfigOrDefault(ctx context.Context, log logrus.FieldLogger, cfg *OpaEngineConfig) (*Engine, error) {
+	if cfg == nil || cfg.Local == nil {
+		return DefaultAuthPolicy(ctx)
+	}
+
+	log.WithField("rego_path", cfg.Local.RegoPath).Info("Using a custom authorization policy")
+
+	module, err := ioutil.ReadFile(cfg.Local.RegoPath)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read rego policy: %w", err)
+	}
+
+	data := []byte("{}")
+	if cfg.Local.PolicyDataPath != "" {
+		data, err = ioutil.ReadFile(cfg.Local.PolicyDataPath)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read policy data: %w", err)
+		}
+	}
+
+	store, err := storeFromJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	return NewEngineFromRego(ctx, string(module), store)
+}
+
+// DefaultAuthPolicy returns an engine for the built-in policy, which
+// authorizes callers to the server APIs based on their role (local, admin,
+// downstream or agent).
+func DefaultAuthPolicy(ctx context.Context) (*Engine, error) {
+	store, err := storeFromJSON([]byte(defaultPolicyData))
+	if err != nil {
+		return nil, err
+	}
+	return NewEngineFromRego(ctx, defaultPolicyRego, store)
+}
+
+// NewEngineFromRego returns an engine for the given Rego policy module,
+// evaluated against the data in the given store. The module must define the
+// "result" rule in the "spire" package.
+func NewEngineFromRego(ctx context.Context, module string, store storage.Store) (*Engine, error) {
+	query, err := rego.New(
+		rego.Query(policyQuery),
+		rego.Module("spire.rego", module),
+		rego.Store(store),
+	).PrepareForEval(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("unable to prepare rego policy: %w", err)
+	}
+	return &Engine{rego: query}, nil
+}
+
+// Eval evaluates the policy for the given input.
+func (e *Engine) Eval(ctx context.Context, input Input) (Result, error) {
+	rs, err := e.rego.Eval(ctx, rego.EvalInput(input))
+	if err != nil {
+		return Result{}, fmt.Errorf("unable to evaluate policy: %w", err)
+	}
+	if len(rs) != 1 || len(rs[0].Expressions) != 1 {
+		return Result{}, errors.New("policy did not produce a single result")
+	}
+
+	// Round-trip through JSON to decode the result into the struct
+	value, err := json.Marshal(rs[0].Expressions[0].Value)
+	if err != nil {
+		return Result{}, fmt.Errorf("unable to marshal policy result: %w", err)
+	}
+	var result Result
+	if err := json.Unmarshal(value, &result); err != nil {
+		return Result{}, fmt.Errorf("policy result is malformed: %w", err)
+	}
+	return result, nil
+}
+
+func storeFromJSON(data []byte) (storage.Store, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var obj map[string]interface{}
+	if err := decoder.Decode(&obj); err != nil {
+		return nil, fmt.Errorf("policy data is malformed: %w", err)
+	}
+	return inmem.NewFromObject(obj), nil
+}
@@ -0,0 +1,148 @@
+package authpolicy
+
+import (
+	"context"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestDefaultAuthPolicy(t *testing.T) {
+	engine, err := DefaultAuthPolicy(context.Background())
+	require.NoError(t, err)
+
+	for _, tt := range []struct {
+		fullMethod string
+		expect     Result
+	}{
+		{
+			fullMethod: "/spire.api.server.bundle.v1.Bundle/GetBundle",
+			expect:     Result{Allow: true},
+		},
+		{
+			fullMethod: "/spire.api.server.entry.v1.Entry/ListEntries",
+			expect:     Result{AllowIfLocal: true, AllowIfAdmin: true},
+		},
+		{
+			fullMethod: "/spire.api.server.bundle.v1.Bundle/GetFederatedBundle",
+			expect:     Result{AllowIfLocal: true, AllowIfAdmin: true, AllowIfAgent: true},
+		},
+		{
+			fullMethod: "/spire.api.server.svid.v1.SVID/NewDownstreamX509CA",
+			expect:     Result{AllowIfDownstream: true},
+		},
+		{
+			fullMethod: "/spire.api.server.debug.v1.Debug/GetInfo",
+			expect:     Result{AllowIfLocal: true},
+		},
+		{
+			fullMethod: "/some.Service/Unknown",
+			expect:     Result{},
+		},
+	} {
+		tt := tt
+		t.Run(tt.fullMethod, func(t *testing.T) {
+			result, err := engine.Eval(context.Background(), Input{
+				Caller:     "spiffe://example.org/foo",
+				FullMethod: tt.fullMethod,
+			})
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
+
+func TestNewEngineFromConfigOrDefault(t *testing.T) {
+	dir := spiretest.TempDir(t)
+	regoPath := filepath.Join(dir, "policy.rego")
+	dataPath := filepath.Join(dir, "policy_data.json")
+	badRegoPath := filepath.Join(dir, "bad.rego")
+	badDataPath := filepath.Join(dir, "bad.json")
+
+	require.NoError(t, ioutil.WriteFile(regoPath, []byte(`package spire
+
+result = {
+	"allow": allow,
+	"allow_if_admin": false,
+	"allow_if_local": true,
+	"allow_if_downstream": false,
+	"allow_if_agent": false,
+}
+
+default allow = false
+
+allow {
+	input.caller == data.superuser
+}
+`), 0600))
+	require.NoError(t, ioutil.WriteFile(dataPath, []byte(`{"superuser": "spiffe://example.org/superuser"}`), 0600))
+	require.NoError(t, ioutil.WriteFile(badRegoPath, []byte(`not rego`), 0600))
+	require.NoError(t, ioutil.WriteFile(badDataPath, []byte(`not json`), 0600))
+
+	for _, tt := range []struct {
+		name        string
+		config      *OpaEngineConfig
+		input       Input
+		expect      Result
+		expectError string
+	}{
+		{
+			name:   "default policy when not configured",
+			input:  Input{FullMethod: "/spire.api.server.bundle.v1.Bundle/GetBundle"},
+			expect: Result{Allow: true},
+		},
+		{
+			name:   "custom policy and data",
+			config: &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: regoPath, PolicyDataPath: dataPath}},
+			input:  Input{Caller: "spiffe://example.org/superuser", FullMethod: "/spire.api.server.entry.v1.Entry/ListEntries"},
+			expect: Result{Allow: true, AllowIfLocal: true},
+		},
+		{
+			name:   "custom policy without data",
+			config: &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: regoPath}},
+			input:  Input{Caller: "spiffe://example.org/superuser", FullMethod: "/spire.api.server.entry.v1.Entry/ListEntries"},
+			expect: Result{AllowIfLocal: true},
+		},
+		{
+			name:        "missing rego policy",
+			config:      &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: filepath.Join(dir, "missing.rego")}},
+			expectError: "unable to read rego policy: ",
+		},
+		{
+			name:        "missing policy data",
+			config:      &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: regoPath, PolicyDataPath: filepath.Join(dir, "missing.json")}},
+			expectError: "unable to read policy data: ",
+		},
+		{
+			name:        "malformed rego policy",
+			config:      &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: badRegoPath}},
+			expectError: "unable to prepare rego policy: ",
+		},
+		{
+			name:        "malformed policy data",
+			config:      &OpaEngineConfig{Local: &LocalOpaProviderConfig{RegoPath: regoPath, PolicyDataPath: badDataPath}},
+			expectError: "policy data is malformed: ",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			log, _ := test.NewNullLogger()
+			engine, err := NewEngineFromConfigOrDefault(context.Background(), log, tt.config)
+			if tt.expectError != "" {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), tt.expectError)
+				return
+			}
+			require.NoError(t, err)
+
+			result, err := engine.Eval(context.Background(), tt.input)
+			require.NoError(t, err)
+			assert.Equal(t, tt.expect, result)
+		})
+	}
+}
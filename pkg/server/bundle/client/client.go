@@ -2,10 +2,11 @@ package client
 
 import (
 	"context"
+	"crypto/tls"
 	"crypto/x509"
-	"fmt"
 	"io"
 	"net/http"
+	"sync"
 
 	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
@@ -29,13 +30,22 @@ type ClientConfig struct { //nolint: golint // name stutter is intentional
 	// TrustDomain is the federated trust domain (i.e. domain.test)
 	TrustDomain string
 
-	// EndpointAddress is the bundle endpoint for the trust domain.
-	EndpointAddress string
+	// EndpointURL is the URL of the bundle endpoint for the trust domain.
+	EndpointURL string
 
 	// SPIFFEAuth contains required configuration to authenticate the endpoint
 	// using SPIFFE authentication. If unset, it is assumed that the endpoint
 	// is authenticated via Web PKI.
 	SPIFFEAuth *SPIFFEAuthConfig
+
+	// WebPKIRootCAs are root CA certificates trusted to authenticate the
+	// endpoint via Web PKI, in addition to the system roots. Ignored when
+	// SPIFFEAuth is set.
+	WebPKIRootCAs []*x509.Certificate
+
+	// PinWebPKIRootCAs is true if the endpoint is authenticated via Web PKI
+	// with WebPKIRootCAs only, ignoring the system roots.
+	PinWebPKIRootCAs bool
 }
 
 // Client is used to fetch a bundle and metadata from a bundle endpoint
@@ -46,6 +56,12 @@ type Client interface {
 type client struct {
 	c      ClientConfig
 	client *http.Client
+
+	// mtx protects the bundle last fetched and its ETag, which are used to
+	// issue conditional requests.
+	mtx    sync.Mutex
+	etag   string
+	bundle *bundleutil.Bundle
 }
 
 func NewClient(config ClientConfig) (Client, error) {
@@ -73,6 +89,27 @@ func NewClient(config ClientConfig) (Client, error) {
 		httpClient.Transport = &http.Transport{
 			TLSClientConfig: tlsconfig.TLSClientConfig(bundle, authorizer),
 		}
+	} else if len(config.WebPKIRootCAs) > 0 || config.PinWebPKIRootCAs {
+		rootCAs := x509.NewCertPool()
+		if !config.PinWebPKIRootCAs {
+			systemRootCAs, err := x509.SystemCertPool()
+			if err != nil {
+				return nil, errs.New("unable to load system root CAs: %v", err)
+			}
+			rootCAs = systemRootCAs
+		}
+		for _, rootCA := range config.WebPKIRootCAs {
+			rootCAs.AddCert(rootCA)
+		}
+
+		// Start from the default transport so proxy settings from the
+		// environment and the default timeouts still apply.
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.TLSClientConfig = &tls.Config{
+			RootCAs:    rootCAs,
+			MinVersion: tls.VersionTLS12,
+		}
+		httpClient.Transport = transport
 	}
 	return &client{
 		c:      config,
@@ -80,13 +117,32 @@ func NewClient(config ClientConfig) (Client, error) {
 	}, nil
 }
 
+// FetchBundle fetches the bundle from the endpoint. If the endpoint served an
+// ETag with the bundle last fetched, the request is conditional and the same
+// bundle is returned if the endpoint reports it as not modified.
 func (c *client) FetchBundle(ctx context.Context) (*bundleutil.Bundle, error) {
-	resp, err := c.client.Get(fmt.Sprintf("https://%s", c.c.EndpointAddress))
+	req, err := http.NewRequestWithContext(ctx, "GET", c.c.EndpointURL, nil)
+	if err != nil {
+		return nil, errs.New("failed to fetch bundle: %v", err)
+	}
+
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+
+	if c.etag != "" {
+		req.Header.Set("If-None-Match", c.etag)
+	}
+
+	resp, err := c.client.Do(req)
 	if err != nil {
 		return nil, errs.New("failed to fetch bundle: %v", err)
 	}
 	defer resp.Body.Close()
 
+	if resp.StatusCode == http.StatusNotModified && c.etag != "" {
+		return c.bundle, nil
+	}
+
 	if resp.StatusCode != http.StatusOK {
 		return nil, errs.New("unexpected status %d fetching bundle: %s", resp.StatusCode, tryRead(resp.Body))
 	}
@@ -96,6 +152,8 @@ func (c *client) FetchBundle(ctx context.Context) (*bundleutil.Bundle, error) {
 		return nil, err
 	}
 
+	c.etag = resp.Header.Get("ETag")
+	c.bundle = b
 	return b, nil
 }
 
@@ -74,8 +74,8 @@ func TestClient(t *testing.T) {
 			defer server.Close()
 
 			client, err := NewClient(ClientConfig{
-				TrustDomain:     "domain.test",
-				EndpointAddress: server.Listener.Addr().String(),
+				TrustDomain: "domain.test",
+				EndpointURL: server.URL,
 				SPIFFEAuth: &SPIFFEAuthConfig{
 					EndpointSpiffeID: testCase.spiffeID,
 					RootCAs:          []*x509.Certificate{serverCert},
@@ -97,6 +97,134 @@ func TestClient(t *testing.T) {
 	}
 }
 
+func TestClientWebPKIRootCAs(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+	otherCert, _ := createServerCertificate(t)
+
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		_, _ = w.Write([]byte(`{"spiffe_refresh_hint": 10}`))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{serverCert.Raw},
+				PrivateKey:  serverKey,
+			},
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	for _, tt := range []struct {
+		name        string
+		rootCAs     []*x509.Certificate
+		pin         bool
+		errContains string
+	}{
+		{
+			name:        "system roots only",
+			errContains: "x509: certificate signed by unknown authority",
+		},
+		{
+			name:    "additional root CA",
+			rootCAs: []*x509.Certificate{serverCert},
+		},
+		{
+			name:    "pinned root CA",
+			rootCAs: []*x509.Certificate{serverCert},
+			pin:     true,
+		},
+		{
+			name:        "pinned root CA not matching",
+			rootCAs:     []*x509.Certificate{otherCert},
+			pin:         true,
+			errContains: "x509: certificate signed by unknown authority",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			c, err := NewClient(ClientConfig{
+				TrustDomain:      "domain.test",
+				EndpointURL:      server.URL,
+				WebPKIRootCAs:    tt.rootCAs,
+				PinWebPKIRootCAs: tt.pin,
+			})
+			require.NoError(t, err)
+
+			// the transport keeps the proxy and timeout settings of the
+			// default transport
+			if tt.rootCAs != nil {
+				transport, ok := c.(*client).client.Transport.(*http.Transport)
+				require.True(t, ok)
+				require.NotNil(t, transport.Proxy)
+				require.Equal(t, http.DefaultTransport.(*http.Transport).TLSHandshakeTimeout, transport.TLSHandshakeTimeout)
+			}
+
+			bundle, err := c.FetchBundle(context.Background())
+			if tt.errContains != "" {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tt.errContains)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, "spiffe://domain.test", bundle.TrustDomainID())
+		})
+	}
+}
+
+func TestClientConditionalRequests(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+
+	var ifNoneMatch []string
+	etag, body := `W/"1"`, `{"spiffe_refresh_hint": 10}`
+	server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		ifNoneMatch = append(ifNoneMatch, req.Header.Get("If-None-Match"))
+		w.Header().Set("ETag", etag)
+		if req.Header.Get("If-None-Match") == etag {
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		_, _ = w.Write([]byte(body))
+	}))
+	server.TLS = &tls.Config{
+		Certificates: []tls.Certificate{
+			{
+				Certificate: [][]byte{serverCert.Raw},
+				PrivateKey:  serverKey,
+			},
+		},
+	}
+	server.StartTLS()
+	defer server.Close()
+
+	client, err := NewClient(ClientConfig{
+		TrustDomain: "domain.test",
+		EndpointURL: server.URL,
+		SPIFFEAuth: &SPIFFEAuthConfig{
+			RootCAs: []*x509.Certificate{serverCert},
+		},
+	})
+	require.NoError(t, err)
+
+	// The first request is unconditional
+	bundle1, err := client.FetchBundle(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 10*time.Second, bundle1.RefreshHint())
+
+	// The bundle last fetched is returned when not modified
+	bundle2, err := client.FetchBundle(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, bundle1, bundle2)
+
+	// The changed bundle is returned when modified
+	etag, body = `W/"2"`, `{"spiffe_refresh_hint": 20}`
+	bundle3, err := client.FetchBundle(context.Background())
+	require.NoError(t, err)
+	require.Equal(t, 20*time.Second, bundle3.RefreshHint())
+
+	require.Equal(t, []string{"", `W/"1"`, `W/"1"`}, ifNoneMatch)
+}
+
 func createServerCertificate(t *testing.T) (*x509.Certificate, crypto.Signer) {
 	return spiretest.SelfSignCertificate(t, &x509.Certificate{
 		SerialNumber: big.NewInt(0),
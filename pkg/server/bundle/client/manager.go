@@ -1,15 +1,21 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
+	"math/rand"
+	"sort"
+	"strings"
+	"sync"
 	"time"
 
 	"github.com/andres-erbsen/clock"
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
-	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 )
 
@@ -19,11 +25,25 @@ const (
 	// bundle. It is important to try more than once within a refresh hint
 	// period so we can be resilient to temporary downtime or failures.
 	attemptsPerRefreshHint = 4
+
+	// relationshipsPollInterval is how often the manager reloads the
+	// federation relationships from the datastore.
+	relationshipsPollInterval = 10 * time.Second
+
+	// retryInitialInterval is how long the manager waits before retrying a
+	// failed refresh. The interval doubles with each consecutive failure, up
+	// to the regular refresh interval.
+	retryInitialInterval = 5 * time.Second
+
+	// refreshJitterFactor is the maximum fraction by which a refresh is
+	// brought forward, so that updaters started at the same time don't hit
+	// their endpoints in lockstep.
+	refreshJitterFactor = 0.1
 )
 
 type TrustDomainConfig struct {
-	// EndpointAddress is the bundle endpoint for the trust domain.
-	EndpointAddress string
+	// EndpointURL is the URL of the bundle endpoint for the trust domain.
+	EndpointURL string
 
 	// EndpointSpiffeID is the expected SPIFFE ID of the endpoint server. If
 	// unset, it defaults to the SPIRE server ID within the trust domain.
@@ -32,6 +52,36 @@ type TrustDomainConfig struct {
 	// UseWebPKI is true if the endpoint should be authenticated with Web PKI.
 	// Otherwise, SPIFFE authentication is assumed.
 	UseWebPKI bool
+
+	// BootstrapBundlePath is the path to a bundle, in PEM or SPIFFE bundle
+	// format, used to authenticate the endpoint with SPIFFE authentication
+	// until a bundle for the trust domain has been obtained.
+	BootstrapBundlePath string
+
+	// WebPKIRootCAs are root CA certificates trusted to authenticate the
+	// endpoint with Web PKI, in addition to the system roots.
+	WebPKIRootCAs []*x509.Certificate
+
+	// PinWebPKIRootCAs is true if the endpoint is authenticated with
+	// WebPKIRootCAs only, ignoring the system roots.
+	PinWebPKIRootCAs bool
+}
+
+func (c TrustDomainConfig) equal(other TrustDomainConfig) bool {
+	if c.EndpointURL != other.EndpointURL ||
+		c.EndpointSpiffeID != other.EndpointSpiffeID ||
+		c.UseWebPKI != other.UseWebPKI ||
+		c.BootstrapBundlePath != other.BootstrapBundlePath ||
+		c.PinWebPKIRootCAs != other.PinWebPKIRootCAs ||
+		len(c.WebPKIRootCAs) != len(other.WebPKIRootCAs) {
+		return false
+	}
+	for i, rootCA := range c.WebPKIRootCAs {
+		if !rootCA.Equal(other.WebPKIRootCAs[i]) {
+			return false
+		}
+	}
+	return true
 }
 
 type ManagerConfig struct {
@@ -45,11 +95,62 @@ type ManagerConfig struct {
 	newBundleUpdater func(BundleUpdaterConfig) BundleUpdater
 }
 
+// Manager runs a bundle updater for each trust domain the server federates
+// with. The set of trust domains is made up of the statically configured
+// trust domains along with the federation relationships stored in the
+// datastore, which are periodically reloaded. Each updater schedules its own
+// refreshes based on the refresh hint of the bundle it manages, backing off
+// on consecutive failures.
 type Manager struct {
-	log      logrus.FieldLogger
-	metrics  telemetry.Metrics
-	clock    clock.Clock
-	updaters map[string]BundleUpdater
+	log              logrus.FieldLogger
+	metrics          telemetry.Metrics
+	ds               datastore.DataStore
+	clock            clock.Clock
+	trustDomains     map[string]TrustDomainConfig
+	newBundleUpdater func(BundleUpdaterConfig) BundleUpdater
+
+	mu       sync.Mutex
+	updaters map[string]*managedUpdater
+
+	// reconcileCh is signaled to reconcile the updaters before the next
+	// poll, e.g. when the statically configured trust domains change.
+	reconcileCh chan struct{}
+}
+
+type managedUpdater struct {
+	config  TrustDomainConfig
+	updater BundleUpdater
+	cancel  context.CancelFunc
+	done    chan struct{}
+
+	// refreshCh is used to ask the updater goroutine to refresh the bundle
+	// right away. The result of the refresh is sent on the provided channel.
+	refreshCh chan chan error
+
+	statusMtx sync.Mutex
+	status    TrustDomainStatus
+}
+
+// TrustDomainStatus describes the health of the federation relationship with
+// a trust domain, as observed by the bundle refreshes.
+type TrustDomainStatus struct {
+	TrustDomain string
+	EndpointURL string
+
+	// LastAttempt is the time of the last refresh attempt. It is zero if no
+	// refresh has been attempted yet.
+	LastAttempt time.Time
+
+	// LastSuccess is the time of the last successful refresh. It is zero if
+	// no refresh has succeeded yet.
+	LastSuccess time.Time
+
+	// ConsecutiveFailures is the number of refreshes that have failed since
+	// the last successful one.
+	ConsecutiveFailures int
+
+	// LastError is the error of the last refresh, if it failed
+	LastError string
 }
 
 func NewManager(config ManagerConfig) *Manager {
@@ -60,46 +161,244 @@ func NewManager(config ManagerConfig) *Manager {
 		config.newBundleUpdater = NewBundleUpdater
 	}
 
-	updaters := make(map[string]BundleUpdater)
-	for trustDomain, trustDomainConfig := range config.TrustDomains {
-		updaters[trustDomain] = config.newBundleUpdater(BundleUpdaterConfig{
-			TrustDomainConfig: trustDomainConfig,
-			TrustDomain:       trustDomain,
-			DataStore:         config.DataStore,
-		})
-	}
-
 	return &Manager{
-		log:      config.Log,
-		metrics:  config.Metrics,
-		clock:    config.Clock,
-		updaters: updaters,
+		log:              config.Log,
+		metrics:          config.Metrics,
+		ds:               config.DataStore,
+		clock:            config.Clock,
+		trustDomains:     config.TrustDomains,
+		newBundleUpdater: config.newBundleUpdater,
+		updaters:         make(map[string]*managedUpdater),
+		reconcileCh:      make(chan struct{}, 1),
 	}
 }
 
 func (m *Manager) Run(ctx context.Context) error {
-	var tasks []func(context.Context) error
-	for trustDomain, updater := range m.updaters {
-		// alias the loop variables that are used by the closure
-		trustDomain := trustDomain
-		updater := updater
-		tasks = append(tasks, func(ctx context.Context) error {
-			return m.runUpdater(ctx, trustDomain, updater)
-		})
+	defer m.stopUpdaters()
+
+	for {
+		if err := m.reconcileUpdaters(ctx); err != nil {
+			m.log.WithError(err).Error("Failed to load federation relationships")
+		}
+
+		select {
+		case <-m.clock.After(relationshipsPollInterval):
+		case <-m.reconcileCh:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
 	}
+}
 
-	return util.RunTasks(ctx, tasks...)
+// SetTrustDomains replaces the statically configured trust domains. Updaters
+// are started, restarted or stopped accordingly right away, without waiting
+// for the next poll of the federation relationships.
+func (m *Manager) SetTrustDomains(trustDomains map[string]TrustDomainConfig) {
+	m.mu.Lock()
+	m.trustDomains = trustDomains
+	m.mu.Unlock()
+
+	select {
+	case m.reconcileCh <- struct{}{}:
+	default:
+	}
 }
 
-func (m *Manager) runUpdater(ctx context.Context, trustDomain string, updater BundleUpdater) error {
-	log := m.log.WithField("trust_domain", trustDomain)
+// RefreshBundleFor refreshes the bundle for the given trust domain right
+// away. It returns false if the trust domain is not managed by the manager.
+// The refresh is performed by the updater of the trust domain, so it never
+// runs concurrently with a scheduled refresh.
+func (m *Manager) RefreshBundleFor(ctx context.Context, td spiffeid.TrustDomain) (bool, error) {
+	m.mu.Lock()
+	mu, ok := m.updaters[td.String()]
+	m.mu.Unlock()
+	if !ok {
+		return false, nil
+	}
+
+	resultCh := make(chan error, 1)
+	select {
+	case mu.refreshCh <- resultCh:
+	case <-mu.done:
+		// The updater was stopped since the trust domain is no longer
+		// federated with.
+		return false, nil
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+
+	select {
+	case err := <-resultCh:
+		return true, err
+	case <-ctx.Done():
+		return true, ctx.Err()
+	}
+}
+
+// TrustDomainStatuses returns the status of the federation relationships the
+// manager is updating bundles for, sorted by trust domain.
+func (m *Manager) TrustDomainStatuses() []TrustDomainStatus {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	statuses := make([]TrustDomainStatus, 0, len(m.updaters))
+	for _, mu := range m.updaters {
+		statuses = append(statuses, mu.getStatus())
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].TrustDomain < statuses[j].TrustDomain
+	})
+	return statuses
+}
+
+// reconcileUpdaters starts updaters for new or changed trust domains and
+// stops updaters for trust domains that are no longer federated with.
+func (m *Manager) reconcileUpdaters(ctx context.Context) error {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	// On failure, the returned trust domains still include the statically
+	// configured ones and those currently being updated, so reconciliation
+	// can proceed.
+	trustDomains, err := m.loadTrustDomains(ctx)
+
+	for trustDomain, mu := range m.updaters {
+		if config, ok := trustDomains[trustDomain]; !ok || !config.equal(mu.config) {
+			mu.stop()
+			delete(m.updaters, trustDomain)
+		}
+	}
+
+	for trustDomain, config := range trustDomains {
+		if _, ok := m.updaters[trustDomain]; ok {
+			continue
+		}
+		m.updaters[trustDomain] = m.startUpdater(ctx, trustDomain, config)
+	}
+	return err
+}
+
+// loadTrustDomains returns the statically configured trust domains merged
+// with the federation relationships stored in the datastore. Statically
+// configured trust domains take precedence. It must be called with the
+// manager lock held.
+func (m *Manager) loadTrustDomains(ctx context.Context) (map[string]TrustDomainConfig, error) {
+	trustDomains := make(map[string]TrustDomainConfig, len(m.trustDomains))
+	for trustDomain, config := range m.trustDomains {
+		trustDomains[trustDomain] = config
+	}
+
+	resp, err := m.ds.ListFederationRelationships(ctx, &datastore.ListFederationRelationshipsRequest{})
+	if err != nil {
+		// Keep the current set of updaters running
+		for trustDomain, mu := range m.updaters {
+			trustDomains[trustDomain] = mu.config
+		}
+		return trustDomains, err
+	}
+
+	for _, fr := range resp.FederationRelationships {
+		trustDomain := strings.TrimPrefix(fr.TrustDomainId, "spiffe://")
+		if _, ok := m.trustDomains[trustDomain]; ok {
+			m.log.WithField(telemetry.TrustDomainID, trustDomain).Warn("Ignoring federation relationship for statically configured trust domain")
+			continue
+		}
+		rootCAs, err := x509.ParseCertificates(bytes.Join(fr.WebPkiRootCas, nil))
+		if err != nil {
+			m.log.WithError(err).WithField(telemetry.TrustDomainID, trustDomain).Error("Ignoring federation relationship with invalid web PKI root CAs")
+			continue
+		}
+		trustDomains[trustDomain] = TrustDomainConfig{
+			EndpointURL:      fr.BundleEndpointUrl,
+			EndpointSpiffeID: fr.EndpointSpiffeId,
+			UseWebPKI:        fr.BundleEndpointProfile == datastore.FederationRelationship_HTTPS_WEB,
+			WebPKIRootCAs:    rootCAs,
+			PinWebPKIRootCAs: fr.PinWebPkiRootCas,
+		}
+	}
+	return trustDomains, nil
+}
+
+func (m *Manager) startUpdater(ctx context.Context, trustDomain string, config TrustDomainConfig) *managedUpdater {
+	ctx, cancel := context.WithCancel(ctx)
+	mu := &managedUpdater{
+		config: config,
+		updater: m.newBundleUpdater(BundleUpdaterConfig{
+			TrustDomainConfig: config,
+			TrustDomain:       trustDomain,
+			DataStore:         m.ds,
+		}),
+		cancel:    cancel,
+		done:      make(chan struct{}),
+		refreshCh: make(chan chan error),
+		status: TrustDomainStatus{
+			TrustDomain: trustDomain,
+			EndpointURL: config.EndpointURL,
+		},
+	}
+	go func() {
+		defer close(mu.done)
+		_ = m.runUpdater(ctx, trustDomain, mu)
+	}()
+	return mu
+}
+
+func (m *Manager) stopUpdaters() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	for trustDomain, mu := range m.updaters {
+		mu.stop()
+		delete(m.updaters, trustDomain)
+	}
+}
+
+func (mu *managedUpdater) stop() {
+	mu.cancel()
+	<-mu.done
+}
+
+func (mu *managedUpdater) getStatus() TrustDomainStatus {
+	mu.statusMtx.Lock()
+	defer mu.statusMtx.Unlock()
+	return mu.status
+}
+
+func (mu *managedUpdater) recordAttempt(now time.Time, err error) {
+	mu.statusMtx.Lock()
+	defer mu.statusMtx.Unlock()
+	mu.status.LastAttempt = now
+	if err != nil {
+		mu.status.ConsecutiveFailures++
+		mu.status.LastError = err.Error()
+		return
+	}
+	mu.status.LastSuccess = now
+	mu.status.ConsecutiveFailures = 0
+	mu.status.LastError = ""
+}
+
+func (m *Manager) runUpdater(ctx context.Context, trustDomain string, mu *managedUpdater) error {
+	log := m.log.WithField(telemetry.TrustDomainID, trustDomain)
+	failures := 0
+	// resultCh is set when the refresh was requested by RefreshBundleFor
+	var resultCh chan error
 	for {
 		var nextRefresh time.Duration
 		log.Debug("Polling for bundle update")
-		localBundle, endpointBundle, err := updater.UpdateBundle(ctx)
+		localBundle, endpointBundle, err := mu.updater.UpdateBundle(ctx)
+		mu.recordAttempt(m.clock.Now(), err)
+		if resultCh != nil {
+			resultCh <- err
+			resultCh = nil
+		}
 		if err != nil {
-			log.WithError(err).Error("Error updating bundle")
+			failures++
+			telemetry_server.IncrBundleManagerUpdateFederatedBundleErrorCounter(m.metrics, trustDomain)
+			log.WithError(err).WithField(telemetry.Attempt, failures).Error("Error updating bundle")
+		} else {
+			failures = 0
 		}
+		telemetry_server.SetBundleManagerConsecutiveFailuresGauge(m.metrics, trustDomain, float32(failures))
 
 		switch {
 		case endpointBundle != nil:
@@ -117,6 +416,7 @@ func (m *Manager) runUpdater(ctx context.Context, trustDomain string, updater Bu
 			// TODO: reevaluate once we support web auth
 			nextRefresh = bundleutil.MinimumRefreshHint
 		}
+		nextRefresh = jitter(retryInterval(nextRefresh, failures))
 
 		log.WithFields(logrus.Fields{
 			"at": m.clock.Now().Add(nextRefresh).UTC().Format(time.RFC3339),
@@ -125,6 +425,8 @@ func (m *Manager) runUpdater(ctx context.Context, trustDomain string, updater Bu
 		timer := m.clock.Timer(nextRefresh)
 		select {
 		case <-timer.C:
+		case resultCh = <-mu.refreshCh:
+			timer.Stop()
 		case <-ctx.Done():
 			timer.Stop()
 			return ctx.Err()
@@ -132,6 +434,28 @@ func (m *Manager) runUpdater(ctx context.Context, trustDomain string, updater Bu
 	}
 }
 
+// retryInterval returns how long to wait before the next refresh attempt
+// given the regular refresh interval and the number of consecutive failures.
+func retryInterval(refreshInterval time.Duration, failures int) time.Duration {
+	if failures == 0 {
+		return refreshInterval
+	}
+	interval := retryInitialInterval
+	for i := 1; i < failures && interval < refreshInterval; i++ {
+		interval *= 2
+	}
+	if interval > refreshInterval {
+		return refreshInterval
+	}
+	return interval
+}
+
+// jitter brings the given interval forward by a random amount of up to
+// refreshJitterFactor of the interval.
+func jitter(interval time.Duration) time.Duration {
+	return interval - time.Duration(rand.Float64()*refreshJitterFactor*float64(interval)) // nolint: gosec // no need for cryptographic randomness
+}
+
 func calculateNextUpdate(b *bundleutil.Bundle) time.Duration {
 	return bundleutil.CalculateRefreshHint(b) / attemptsPerRefreshHint
 }
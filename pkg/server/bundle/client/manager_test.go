@@ -2,16 +2,20 @@ package client
 
 import (
 	"context"
+	"crypto/x509"
 	"errors"
 	"sync"
 	"testing"
 	"time"
 
 	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
 	"github.com/stretchr/testify/require"
 	"github.com/zeebo/errs"
 	"gotest.tools/assert"
@@ -30,21 +34,25 @@ func TestManager(t *testing.T) {
 		localBundle    *bundleutil.Bundle
 		endpointBundle *bundleutil.Bundle
 		nextRefresh    time.Duration
+		secondRefresh  time.Duration
 	}{
 		{
-			name:        "update failed to obtain local bundle",
-			nextRefresh: bundleutil.MinimumRefreshHint,
+			name:          "update failed to obtain local bundle",
+			nextRefresh:   retryInitialInterval,
+			secondRefresh: 2 * retryInitialInterval,
 		},
 		{
-			name:        "update failed to obtain endpoint bundle",
-			localBundle: localBundle,
-			nextRefresh: calculateNextUpdate(localBundle),
+			name:          "update failed to obtain endpoint bundle",
+			localBundle:   localBundle,
+			nextRefresh:   retryInitialInterval,
+			secondRefresh: 2 * retryInitialInterval,
 		},
 		{
 			name:           "update obtained endpoint bundle",
 			localBundle:    localBundle,
 			endpointBundle: endpointBundle,
 			nextRefresh:    calculateNextUpdate(endpointBundle),
+			secondRefresh:  calculateNextUpdate(endpointBundle),
 		},
 	}
 
@@ -55,7 +63,7 @@ func TestManager(t *testing.T) {
 
 			updater := newFakeBundleUpdater(testCase.localBundle, testCase.endpointBundle)
 
-			done := startManager(t, clock, updater)
+			_, done := startManager(t, clock, telemetry.Blackhole{}, updater)
 			defer done()
 
 			// wait for the initial refresh
@@ -64,31 +72,274 @@ func TestManager(t *testing.T) {
 
 			// advance time and make sure another refresh happens
 			clock.Add(testCase.nextRefresh + time.Millisecond)
-			waitForRefresh(t, clock, testCase.nextRefresh)
+			waitForRefresh(t, clock, testCase.secondRefresh)
 			require.Equal(t, 2, updater.UpdateCount())
 		})
 	}
 }
 
-func startManager(t *testing.T, clock clock.Clock, updater BundleUpdater) func() {
+func TestManagerHealthMetrics(t *testing.T) {
+	clock := clock.NewMock(t)
+	metrics := fakemetrics.New()
+	updater := newFakeBundleUpdater(nil, nil)
+
+	_, done := startManager(t, clock, metrics, updater)
+	defer done()
+
+	waitForRefresh(t, clock, retryInitialInterval)
+	clock.Add(retryInitialInterval)
+	waitForRefresh(t, clock, 2*retryInitialInterval)
+
+	labels := []telemetry.Label{{Name: telemetry.TrustDomainID, Value: "domain_test"}}
+	errorCounter := fakemetrics.MetricItem{
+		Type:   fakemetrics.IncrCounterWithLabelsType,
+		Key:    []string{telemetry.BundleManager, telemetry.Update, telemetry.FederatedBundle, telemetry.Error},
+		Val:    1,
+		Labels: labels,
+	}
+	failuresGauge := func(val float32) fakemetrics.MetricItem {
+		return fakemetrics.MetricItem{
+			Type:   fakemetrics.SetGaugeWithLabelsType,
+			Key:    []string{telemetry.BundleManager, telemetry.FederatedBundle, telemetry.ConsecutiveFailures},
+			Val:    val,
+			Labels: labels,
+		}
+	}
+	require.Equal(t, []fakemetrics.MetricItem{
+		errorCounter,
+		failuresGauge(1),
+		errorCounter,
+		failuresGauge(2),
+	}, metrics.AllMetrics())
+}
+
+func TestManagerTrustDomainStatuses(t *testing.T) {
+	clock := clock.NewMock(t)
+	updater := newFakeBundleUpdater(nil, nil)
+
+	manager, done := startManager(t, clock, telemetry.Blackhole{}, updater)
+	defer done()
+
+	// The first refresh fails
+	waitForRefresh(t, clock, retryInitialInterval)
+	firstAttempt := clock.Now()
+	require.Equal(t, []TrustDomainStatus{
+		{
+			TrustDomain:         "domain.test",
+			EndpointURL:         "ENDPOINT_URL",
+			LastAttempt:         firstAttempt,
+			ConsecutiveFailures: 1,
+			LastError:           "UNUSED",
+		},
+	}, manager.TrustDomainStatuses())
+
+	// The next refresh succeeds
+	updater.SetEndpointBundle(bundleutil.BundleFromRootCA("spiffe://domain.test", createCACertificate(t, "endpoint")))
+	clock.Add(retryInitialInterval)
+	waitForRefresh(t, clock, calculateNextUpdate(updater.endpointBundle))
+	statuses := manager.TrustDomainStatuses()
+	require.Len(t, statuses, 1)
+	require.True(t, statuses[0].LastAttempt.After(firstAttempt))
+	require.Equal(t, TrustDomainStatus{
+		TrustDomain: "domain.test",
+		EndpointURL: "ENDPOINT_URL",
+		LastAttempt: statuses[0].LastAttempt,
+		LastSuccess: statuses[0].LastAttempt,
+	}, statuses[0])
+}
+
+func TestManagerRefreshBundleFor(t *testing.T) {
+	clock := clock.NewMock(t)
+	endpointBundle := bundleutil.BundleFromRootCA("spiffe://domain.test", createCACertificate(t, "endpoint"))
+	endpointBundle.SetRefreshHint(time.Hour)
+	updater := newFakeBundleUpdater(nil, endpointBundle)
+
+	manager, done := startManager(t, clock, telemetry.Blackhole{}, updater)
+	defer done()
+
+	waitForRefresh(t, clock, calculateNextUpdate(endpointBundle))
+	require.Equal(t, 1, updater.UpdateCount())
+
+	// The refresh is performed by the updater goroutine, which then
+	// schedules the next refresh
+	ok, err := manager.RefreshBundleFor(context.Background(), spiffeid.RequireTrustDomainFromString("domain.test"))
+	require.True(t, ok)
+	require.NoError(t, err)
+	require.Equal(t, 2, updater.UpdateCount())
+	waitForRefresh(t, clock, calculateNextUpdate(endpointBundle))
+
+	// Failures are returned to the caller and the updater backs off
+	updater.SetEndpointBundle(nil)
+	ok, err = manager.RefreshBundleFor(context.Background(), spiffeid.RequireTrustDomainFromString("domain.test"))
+	require.True(t, ok)
+	require.EqualError(t, err, "UNUSED")
+	require.Equal(t, 3, updater.UpdateCount())
+	waitForRefresh(t, clock, retryInitialInterval)
+}
+
+func TestRetryInterval(t *testing.T) {
+	for _, tt := range []struct {
+		failures int
+		expected time.Duration
+	}{
+		{failures: 0, expected: time.Hour},
+		{failures: 1, expected: retryInitialInterval},
+		{failures: 2, expected: 2 * retryInitialInterval},
+		{failures: 3, expected: 4 * retryInitialInterval},
+		{failures: 100, expected: time.Hour},
+	} {
+		require.Equal(t, tt.expected, retryInterval(time.Hour, tt.failures), "failures=%d", tt.failures)
+	}
+}
+
+func TestManagerFederationRelationships(t *testing.T) {
+	clock := clock.NewMock(t)
+	log, _ := test.NewNullLogger()
+	ds := fakedatastore.New(t)
+	rootCA, _ := createServerCertificate(t)
+
+	_, err := ds.CreateFederationRelationship(context.Background(), &datastore.CreateFederationRelationshipRequest{
+		FederationRelationship: &datastore.FederationRelationship{
+			TrustDomainId:     "spiffe://other.test",
+			BundleEndpointUrl: "https://other.test/bundle",
+			WebPkiRootCas:     [][]byte{rootCA.Raw},
+			PinWebPkiRootCas:  true,
+		},
+	})
+	require.NoError(t, err)
+
+	updater := newFakeBundleUpdater(nil, nil)
+	configs := make(chan BundleUpdaterConfig, 10)
+	manager := NewManager(ManagerConfig{
+		Log:       log,
+		Metrics:   telemetry.Blackhole{},
+		DataStore: ds,
+		Clock:     clock,
+		TrustDomains: map[string]TrustDomainConfig{
+			"domain.test": {EndpointURL: "https://domain.test/bundle"},
+		},
+		newBundleUpdater: func(config BundleUpdaterConfig) BundleUpdater {
+			configs <- config
+			return updater
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- manager.Run(ctx)
+	}()
+
+	clock.WaitForAfter(time.Minute, "timed out waiting for relationships poll")
+
+	// Both the statically configured trust domain and the relationship from
+	// the datastore get an updater
+	got := make(map[string]TrustDomainConfig)
+	for i := 0; i < 2; i++ {
+		config := <-configs
+		got[config.TrustDomain] = config.TrustDomainConfig
+	}
+	require.Equal(t, map[string]TrustDomainConfig{
+		"domain.test": {EndpointURL: "https://domain.test/bundle"},
+		"other.test": {
+			EndpointURL:      "https://other.test/bundle",
+			UseWebPKI:        true,
+			WebPKIRootCAs:    []*x509.Certificate{rootCA},
+			PinWebPKIRootCAs: true,
+		},
+	}, got)
+
+	ok, err := manager.RefreshBundleFor(ctx, spiffeid.RequireTrustDomainFromString("other.test"))
+	require.True(t, ok)
+	require.EqualError(t, err, "UNUSED")
+
+	ok, err = manager.RefreshBundleFor(ctx, spiffeid.RequireTrustDomainFromString("unknown.test"))
+	require.False(t, ok)
+	require.NoError(t, err)
+
+	// Once the relationship is removed, the updater is stopped on the next
+	// poll
+	_, err = ds.DeleteFederationRelationship(context.Background(), &datastore.DeleteFederationRelationshipRequest{
+		TrustDomainId: "spiffe://other.test",
+	})
+	require.NoError(t, err)
+	clock.Add(relationshipsPollInterval)
+	require.Eventually(t, func() bool {
+		ok, _ := manager.RefreshBundleFor(ctx, spiffeid.RequireTrustDomainFromString("other.test"))
+		return !ok
+	}, time.Minute, 10*time.Millisecond)
+
+	cancel()
+	require.EqualError(t, <-errCh, "context canceled")
+}
+
+func TestManagerSetTrustDomains(t *testing.T) {
+	clock := clock.NewMock(t)
+	log, _ := test.NewNullLogger()
+
+	updater := newFakeBundleUpdater(nil, nil)
+	configs := make(chan BundleUpdaterConfig, 10)
+	manager := NewManager(ManagerConfig{
+		Log:       log,
+		Metrics:   telemetry.Blackhole{},
+		DataStore: fakedatastore.New(t),
+		Clock:     clock,
+		TrustDomains: map[string]TrustDomainConfig{
+			"domain.test": {EndpointURL: "https://domain.test/bundle"},
+		},
+		newBundleUpdater: func(config BundleUpdaterConfig) BundleUpdater {
+			configs <- config
+			return updater
+		},
+	})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- manager.Run(ctx)
+	}()
+
+	config := <-configs
+	require.Equal(t, "domain.test", config.TrustDomain)
+
+	// The updaters are reconciled right away, without advancing the clock
+	// to the next poll
+	manager.SetTrustDomains(map[string]TrustDomainConfig{
+		"other.test": {EndpointURL: "https://other.test/bundle"},
+	})
+	config = <-configs
+	require.Equal(t, "other.test", config.TrustDomain)
+	require.Equal(t, TrustDomainConfig{EndpointURL: "https://other.test/bundle"}, config.TrustDomainConfig)
+	require.Eventually(t, func() bool {
+		ok, _ := manager.RefreshBundleFor(ctx, spiffeid.RequireTrustDomainFromString("domain.test"))
+		return !ok
+	}, time.Minute, 10*time.Millisecond)
+
+	cancel()
+	require.EqualError(t, <-errCh, "context canceled")
+}
+
+func startManager(t *testing.T, clock clock.Clock, metrics telemetry.Metrics, updater BundleUpdater) (*Manager, func()) {
 	log, _ := test.NewNullLogger()
 	ds := fakedatastore.New(t)
 
 	trustDomainConfig := TrustDomainConfig{
-		EndpointAddress:  "ENDPOINT_ADDRESS",
+		EndpointURL:      "ENDPOINT_URL",
 		EndpointSpiffeID: "ENDPOINT_SPIFFEID",
 	}
 
 	manager := NewManager(ManagerConfig{
 		Log:       log,
-		Metrics:   telemetry.Blackhole{},
+		Metrics:   metrics,
 		DataStore: ds,
 		Clock:     clock,
 		TrustDomains: map[string]TrustDomainConfig{
 			"domain.test": trustDomainConfig,
 		},
 		newBundleUpdater: func(config BundleUpdaterConfig) BundleUpdater {
-			assert.Equal(t, trustDomainConfig, config.TrustDomainConfig)
+			assert.DeepEqual(t, trustDomainConfig, config.TrustDomainConfig)
 			assert.Equal(t, "domain.test", config.TrustDomain)
 			return updater
 		},
@@ -105,7 +356,7 @@ func startManager(t *testing.T, clock clock.Clock, updater BundleUpdater) func()
 		errCh <- manager.Run(ctx)
 	}()
 
-	return func() {
+	return manager, func() {
 		cancel()
 		select {
 		case err := <-errCh:
@@ -116,10 +367,13 @@ func startManager(t *testing.T, clock clock.Clock, updater BundleUpdater) func()
 	}
 }
 
+// waitForRefresh waits for the next refresh to be scheduled and asserts that
+// it was scheduled within the jitter bounds of the expected duration.
 func waitForRefresh(t *testing.T, clock *clock.Mock, expectedDuration time.Duration) {
 	select {
 	case d := <-clock.TimerCh():
-		require.Equal(t, expectedDuration, d)
+		require.LessOrEqual(t, int64(d), int64(expectedDuration))
+		require.GreaterOrEqual(t, int64(d), int64(float64(expectedDuration)*(1-refreshJitterFactor)))
 	case <-time.After(time.Second * 10):
 		require.Fail(t, "timed out waiting for timer creation")
 	}
@@ -146,9 +400,18 @@ func (u *fakeBundleUpdater) UpdateCount() int {
 	return u.updateCount
 }
 
+func (u *fakeBundleUpdater) SetEndpointBundle(endpointBundle *bundleutil.Bundle) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	u.endpointBundle = endpointBundle
+}
+
 func (u *fakeBundleUpdater) UpdateBundle(context.Context) (*bundleutil.Bundle, *bundleutil.Bundle, error) {
 	u.mu.Lock()
 	defer u.mu.Unlock()
 	u.updateCount++
-	return u.localBundle, u.endpointBundle, errors.New("UNUSED")
+	if u.endpointBundle != nil {
+		return u.localBundle, u.endpointBundle, nil
+	}
+	return u.localBundle, nil, errors.New("UNUSED")
 }
@@ -1,13 +1,18 @@
 package client
 
 import (
+	"bytes"
 	"context"
+	"crypto/x509"
 	"fmt"
+	"io/ioutil"
 
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/common/pemutil"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/zeebo/errs"
+	"google.golang.org/protobuf/proto"
 )
 
 type BundleUpdaterConfig struct {
@@ -34,6 +39,12 @@ type BundleUpdater interface {
 
 type bundleUpdater struct {
 	c BundleUpdaterConfig
+
+	// client is kept across updates while its configuration does not change
+	// so the ETag of the last fetched bundle is reused in conditional
+	// requests.
+	client       Client
+	clientConfig ClientConfig
 }
 
 func NewBundleUpdater(config BundleUpdaterConfig) BundleUpdater {
@@ -62,7 +73,7 @@ func (u *bundleUpdater) UpdateBundle(ctx context.Context) (*bundleutil.Bundle, *
 		return localBundleOrNil, nil, fmt.Errorf("failed to fetch endpoint bundle: %v", err)
 	}
 
-	if localBundleOrNil != nil && endpointBundle.EqualTo(localBundleOrNil) {
+	if localBundleOrNil != nil && sameBundleContent(endpointBundle, localBundleOrNil) {
 		return localBundleOrNil, nil, nil
 	}
 
@@ -78,19 +89,95 @@ func (u *bundleUpdater) UpdateBundle(ctx context.Context) (*bundleutil.Bundle, *
 
 func (u *bundleUpdater) newClient(localBundleOrNil *bundleutil.Bundle) (Client, error) {
 	config := ClientConfig{
-		TrustDomain:     u.c.TrustDomain,
-		EndpointAddress: u.c.EndpointAddress,
+		TrustDomain:      u.c.TrustDomain,
+		EndpointURL:      u.c.EndpointURL,
+		WebPKIRootCAs:    u.c.WebPKIRootCAs,
+		PinWebPKIRootCAs: u.c.PinWebPKIRootCAs,
 	}
 	if !u.c.UseWebPKI {
-		if localBundleOrNil == nil {
-			return nil, errs.New("local bundle not found")
+		authBundle := localBundleOrNil
+		if authBundle == nil {
+			if u.c.BootstrapBundlePath == "" {
+				return nil, errs.New("local bundle not found")
+			}
+			var err error
+			authBundle, err = loadBootstrapBundle(u.c.TrustDomain, u.c.BootstrapBundlePath)
+			if err != nil {
+				return nil, errs.New("local bundle not found and failed to load bootstrap bundle: %v", err)
+			}
 		}
 		config.SPIFFEAuth = &SPIFFEAuthConfig{
 			EndpointSpiffeID: u.c.EndpointSpiffeID,
-			RootCAs:          localBundleOrNil.RootCAs(),
+			RootCAs:          authBundle.RootCAs(),
 		}
 	}
-	return u.c.newClient(config)
+	if u.client != nil && sameClientConfig(u.clientConfig, config) {
+		return u.client, nil
+	}
+
+	client, err := u.c.newClient(config)
+	if err != nil {
+		return nil, err
+	}
+	u.client = client
+	u.clientConfig = config
+	return client, nil
+}
+
+func sameClientConfig(a, b ClientConfig) bool {
+	if a.TrustDomain != b.TrustDomain ||
+		a.EndpointURL != b.EndpointURL ||
+		a.PinWebPKIRootCAs != b.PinWebPKIRootCAs ||
+		!sameCertificates(a.WebPKIRootCAs, b.WebPKIRootCAs) {
+		return false
+	}
+	if a.SPIFFEAuth == nil || b.SPIFFEAuth == nil {
+		return a.SPIFFEAuth == b.SPIFFEAuth
+	}
+	return a.SPIFFEAuth.EndpointSpiffeID == b.SPIFFEAuth.EndpointSpiffeID &&
+		sameCertificates(a.SPIFFEAuth.RootCAs, b.SPIFFEAuth.RootCAs)
+}
+
+func sameCertificates(a, b []*x509.Certificate) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Equal(b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+// loadBootstrapBundle loads the bundle for the trust domain from the given
+// path. The bundle can either be a SPIFFE bundle document or a set of PEM
+// encoded root CA certificates.
+func loadBootstrapBundle(trustDomain, path string) (*bundleutil.Bundle, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	trustDomainID := idutil.TrustDomainID(trustDomain)
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		return bundleutil.Unmarshal(trustDomainID, data)
+	}
+
+	rootCAs, err := pemutil.ParseCertificates(data)
+	if err != nil {
+		return nil, err
+	}
+	return bundleutil.BundleFromRootCAs(trustDomainID, rootCAs), nil
+}
+
+// sameBundleContent returns true if the bundles have the same root CAs, JWT
+// signing keys and refresh hint. Sequence numbers are ignored since the
+// datastore maintains its own for the stored bundle.
+func sameBundleContent(a, b *bundleutil.Bundle) bool {
+	aProto, bProto := a.Proto(), b.Proto()
+	aProto.SequenceNumber, bProto.SequenceNumber = 0, 0
+	return proto.Equal(aProto, bProto)
 }
 
 func fetchBundleIfExists(ctx context.Context, ds datastore.DataStore, trustDomain string) (*bundleutil.Bundle, error) {
@@ -5,12 +5,15 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"errors"
+	"io/ioutil"
 	"math/big"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/idutil"
+	"github.com/spiffe/spire/pkg/common/pemutil"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
 	"github.com/spiffe/spire/test/spiretest"
@@ -23,6 +26,20 @@ func TestBundleUpdater(t *testing.T) {
 	bundle2 := bundleutil.BundleFromRootCA("spiffe://domain.test", createCACertificate(t, "bundle2"))
 	bundle2.SetRefreshHint(time.Minute)
 
+	// The datastore bumps the sequence number when bundle2 replaces bundle1
+	updatedBundle2, err := bundleutil.BundleFromProto(bundle2.Proto())
+	require.NoError(t, err)
+	updatedBundle2.SetSequenceNumber(1)
+
+	// The local bundle has its own sequence number, which can differ from the
+	// one published by the endpoint.
+	sequencedBundle1, err := bundleutil.BundleFromProto(bundle1.Proto())
+	require.NoError(t, err)
+	sequencedBundle1.SetSequenceNumber(4)
+
+	bundle1JSON, err := bundleutil.Marshal(bundle1)
+	require.NoError(t, err)
+
 	testCases := []struct {
 		// name of the test
 		name string
@@ -30,6 +47,10 @@ func TestBundleUpdater(t *testing.T) {
 		trustDomain string
 		// the bundle prepopulated in the datastore and returned from Update()
 		localBundle *bundleutil.Bundle
+		// the contents of the bootstrap bundle file, if any
+		bootstrapBundle string
+		// the root CAs the endpoint client is expected to authenticate with
+		clientRootCAs []*x509.Certificate
 		// the expected endpoint bundle returned from Update()
 		endpointBundle *bundleutil.Bundle
 		// the bundle in the datastore after Update()
@@ -59,12 +80,22 @@ func TestBundleUpdater(t *testing.T) {
 				bundle: bundle1,
 			},
 		},
+		{
+			name:           "bundle has no changes but a different sequence number",
+			trustDomain:    "domain.test",
+			localBundle:    sequencedBundle1,
+			endpointBundle: nil,
+			storedBundle:   sequencedBundle1,
+			client: fakeClient{
+				bundle: bundle1,
+			},
+		},
 		{
 			name:           "bundle changed",
 			trustDomain:    "domain.test",
 			localBundle:    bundle1,
 			endpointBundle: bundle2,
-			storedBundle:   bundle2,
+			storedBundle:   updatedBundle2,
 			client: fakeClient{
 				bundle: bundle2,
 			},
@@ -80,6 +111,45 @@ func TestBundleUpdater(t *testing.T) {
 			},
 			err: "ohno",
 		},
+		{
+			name:            "bootstrap bundle (pem) used when local bundle not found",
+			trustDomain:     "domain.test",
+			bootstrapBundle: string(pemutil.EncodeCertificates(bundle1.RootCAs())),
+			clientRootCAs:   bundle1.RootCAs(),
+			endpointBundle:  bundle2,
+			storedBundle:    bundle2,
+			client: fakeClient{
+				bundle: bundle2,
+			},
+		},
+		{
+			name:            "bootstrap bundle (spiffe) used when local bundle not found",
+			trustDomain:     "domain.test",
+			bootstrapBundle: string(bundle1JSON),
+			clientRootCAs:   bundle1.RootCAs(),
+			endpointBundle:  bundle2,
+			storedBundle:    bundle2,
+			client: fakeClient{
+				bundle: bundle2,
+			},
+		},
+		{
+			name:            "bootstrap bundle ignored when local bundle exists",
+			trustDomain:     "domain.test",
+			localBundle:     bundle1,
+			bootstrapBundle: string(pemutil.EncodeCertificates(bundle2.RootCAs())),
+			clientRootCAs:   bundle1.RootCAs(),
+			storedBundle:    bundle1,
+			client: fakeClient{
+				bundle: bundle1,
+			},
+		},
+		{
+			name:            "bootstrap bundle fails to load",
+			trustDomain:     "domain.test",
+			bootstrapBundle: "not a bundle",
+			err:             "local bundle not found and failed to load bootstrap bundle",
+		},
 	}
 
 	for _, testCase := range testCases {
@@ -94,14 +164,24 @@ func TestBundleUpdater(t *testing.T) {
 				require.NoError(t, err)
 			}
 
+			var bootstrapBundlePath string
+			if testCase.bootstrapBundle != "" {
+				bootstrapBundlePath = filepath.Join(spiretest.TempDir(t), "bundle")
+				require.NoError(t, ioutil.WriteFile(bootstrapBundlePath, []byte(testCase.bootstrapBundle), 0600))
+			}
+
 			updater := NewBundleUpdater(BundleUpdaterConfig{
 				DataStore:   ds,
 				TrustDomain: testCase.trustDomain,
 				TrustDomainConfig: TrustDomainConfig{
-					EndpointAddress:  "ENDPOINT_ADDRESS",
-					EndpointSpiffeID: "ENDPOINT_SPIFFEID",
+					EndpointURL:         "ENDPOINT_URL",
+					EndpointSpiffeID:    "ENDPOINT_SPIFFEID",
+					BootstrapBundlePath: bootstrapBundlePath,
 				},
 				newClient: func(client ClientConfig) (Client, error) {
+					if testCase.clientRootCAs != nil {
+						require.Equal(t, testCase.clientRootCAs, client.SPIFFEAuth.RootCAs)
+					}
 					return testCase.client, nil
 				},
 			})
@@ -141,6 +221,50 @@ func TestBundleUpdater(t *testing.T) {
 	}
 }
 
+func TestBundleUpdaterReusesClient(t *testing.T) {
+	bundle1 := bundleutil.BundleFromRootCA("spiffe://domain.test", createCACertificate(t, "bundle1"))
+	bundle2 := bundleutil.BundleFromRootCA("spiffe://domain.test", createCACertificate(t, "bundle2"))
+
+	ds := fakedatastore.New(t)
+	_, err := ds.CreateBundle(context.Background(), &datastore.CreateBundleRequest{
+		Bundle: bundle1.Proto(),
+	})
+	require.NoError(t, err)
+
+	var clients []ClientConfig
+	client := &fakeClient{bundle: bundle1}
+	updater := NewBundleUpdater(BundleUpdaterConfig{
+		DataStore:   ds,
+		TrustDomain: "domain.test",
+		TrustDomainConfig: TrustDomainConfig{
+			EndpointURL:      "ENDPOINT_URL",
+			EndpointSpiffeID: "ENDPOINT_SPIFFEID",
+		},
+		newClient: func(config ClientConfig) (Client, error) {
+			clients = append(clients, config)
+			return client, nil
+		},
+	})
+
+	// The client is kept while the local bundle does not change
+	_, _, err = updater.UpdateBundle(context.Background())
+	require.NoError(t, err)
+	_, _, err = updater.UpdateBundle(context.Background())
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+
+	// A new client authenticating with the new local bundle is created once
+	// the bundle changes
+	client.bundle = bundle2
+	_, _, err = updater.UpdateBundle(context.Background())
+	require.NoError(t, err)
+	require.Len(t, clients, 1)
+	_, _, err = updater.UpdateBundle(context.Background())
+	require.NoError(t, err)
+	require.Len(t, clients, 2)
+	require.Equal(t, bundle2.RootCAs(), clients[1].SPIFFEAuth.RootCAs)
+}
+
 type fakeClient struct {
 	bundle *bundleutil.Bundle
 	err    error
@@ -56,6 +56,10 @@ type X509SVIDParams struct {
 
 	// Subject of the SVID. Default subject is used if it is empty.
 	Subject pkix.Name
+
+	// EntryID is the ID of the registration entry the SVID is issued for, if
+	// any. It identifies the entry when the TTL of the SVID is clamped.
+	EntryID string
 }
 
 // X509CASVIDParams are parameters relevant to X509 CA SVID creation
@@ -117,6 +121,19 @@ type Config struct {
 	JWTIssuer   string
 	Clock       clock.Clock
 	CASubject   pkix.Name
+
+	// Backdate is how far in the past the NotBefore of issued X509-SVIDs is
+	// set, to tolerate clock skew between the server, agents and the
+	// validators of the SVIDs. Defaults to DefaultBackdate.
+	Backdate time.Duration
+
+	// MaxJWTSVIDTTL, if set, caps the TTL of JWT-SVIDs, whatever TTL is
+	// requested.
+	MaxJWTSVIDTTL time.Duration
+
+	// DNSNamePolicy, if set, restricts the DNS names X509-SVIDs can be
+	// signed for.
+	DNSNamePolicy *api.DNSNamePolicy
 }
 
 type CA struct {
@@ -136,6 +153,9 @@ func NewCA(config Config) *CA {
 	if config.JWTSVIDTTL <= 0 {
 		config.JWTSVIDTTL = DefaultJWTSVIDTTL
 	}
+	if config.Backdate <= 0 {
+		config.Backdate = DefaultBackdate
+	}
 	if config.Clock == nil {
 		config.Clock = clock.New()
 	}
@@ -179,11 +199,27 @@ func (ca *CA) SignX509SVID(ctx context.Context, params X509SVIDParams) ([]*x509.
 		return nil, errs.New("X509 CA is not available for signing")
 	}
 
+	if err := ca.c.DNSNamePolicy.Check(params.DNSList); err != nil {
+		return nil, err
+	}
+
 	if params.TTL <= 0 {
 		params.TTL = ca.c.X509SVIDTTL
 	}
 
-	notBefore, notAfter := ca.capLifetime(params.TTL, x509CA.Certificate.NotAfter)
+	notBefore, notAfter, clamped := ca.capLifetime(params.TTL, x509CA.Certificate.NotAfter)
+	if clamped {
+		telemetry_server.IncrServerCAClampX509SVIDTTLCounter(ca.c.Metrics)
+		fields := logrus.Fields{
+			telemetry.SPIFFEID:   params.SpiffeID,
+			telemetry.TTL:        params.TTL,
+			telemetry.Expiration: notAfter.Format(time.RFC3339),
+		}
+		if params.EntryID != "" {
+			fields[telemetry.RegistrationID] = params.EntryID
+		}
+		ca.c.Log.WithFields(fields).Warn("X509-SVID TTL exceeds the remaining lifetime of the CA; clamping")
+	}
 	serialNumber, err := x509util.NewSerialNumber()
 	if err != nil {
 		return nil, err
@@ -218,8 +254,9 @@ func (ca *CA) SignX509SVID(ctx context.Context, params X509SVIDParams) ([]*x509.
 	spiffeID := cert.URIs[0].String()
 
 	ca.c.Log.WithFields(logrus.Fields{
-		telemetry.SPIFFEID:   spiffeID,
-		telemetry.Expiration: cert.NotAfter.Format(time.RFC3339),
+		telemetry.SPIFFEID:     spiffeID,
+		telemetry.SerialNumber: cert.SerialNumber.String(),
+		telemetry.Expiration:   cert.NotAfter.Format(time.RFC3339),
 	}).Debug("Signed X509 SVID")
 
 	telemetry_server.IncrServerCASignX509Counter(ca.c.Metrics)
@@ -237,7 +274,7 @@ func (ca *CA) SignX509CASVID(ctx context.Context, params X509CASVIDParams) ([]*x
 		params.TTL = ca.c.X509SVIDTTL
 	}
 
-	notBefore, notAfter := ca.capLifetime(params.TTL, x509CA.Certificate.NotAfter)
+	notBefore, notAfter, _ := ca.capLifetime(params.TTL, x509CA.Certificate.NotAfter)
 	serialNumber, err := x509util.NewSerialNumber()
 	if err != nil {
 		return nil, err
@@ -289,7 +326,16 @@ func (ca *CA) SignJWTSVID(ctx context.Context, params JWTSVIDParams) (string, er
 	if ttl <= 0 {
 		ttl = ca.c.JWTSVIDTTL
 	}
-	_, expiresAt := ca.capLifetime(ttl, jwtKey.NotAfter)
+	if ca.c.MaxJWTSVIDTTL > 0 && ttl > ca.c.MaxJWTSVIDTTL {
+		telemetry_server.IncrServerCAClampJWTSVIDTTLCounter(ca.c.Metrics)
+		ca.c.Log.WithFields(logrus.Fields{
+			telemetry.SPIFFEID: params.SpiffeID,
+			telemetry.TTL:      ttl,
+			telemetry.MaxTTL:   ca.c.MaxJWTSVIDTTL,
+		}).Warn("Requested JWT-SVID TTL exceeds the maximum; clamping")
+		ttl = ca.c.MaxJWTSVIDTTL
+	}
+	_, expiresAt, _ := ca.capLifetime(ttl, jwtKey.NotAfter)
 
 	token, err := ca.jwtSigner.SignToken(params.SpiffeID.String(), params.Audience, expiresAt, jwtKey.Signer, jwtKey.Kid)
 	if err != nil {
@@ -306,14 +352,18 @@ func (ca *CA) SignJWTSVID(ctx context.Context, params JWTSVIDParams) (string, er
 	return token, nil
 }
 
-func (ca *CA) capLifetime(ttl time.Duration, expirationCap time.Time) (notBefore, notAfter time.Time) {
+// capLifetime returns the lifetime of an SVID with the given TTL, capped to
+// the expiration of the signing key. It reports whether the lifetime was
+// capped.
+func (ca *CA) capLifetime(ttl time.Duration, expirationCap time.Time) (notBefore, notAfter time.Time, capped bool) {
 	now := ca.c.Clock.Now()
-	notBefore = now.Add(-backdate)
+	notBefore = now.Add(-ca.c.Backdate)
 	notAfter = now.Add(ttl)
 	if notAfter.After(expirationCap) {
 		notAfter = expirationCap
+		capped = true
 	}
-	return notBefore, notAfter
+	return notBefore, notAfter, capped
 }
 
 func makeSVIDCertChain(x509CA *X509CA, cert *x509.Certificate) []*x509.Certificate {
@@ -15,7 +15,9 @@ import (
 	"github.com/spiffe/spire/pkg/common/pemutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/x509util"
+	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
 	"github.com/stretchr/testify/require"
 	"github.com/stretchr/testify/suite"
 )
@@ -119,7 +121,7 @@ func (s *CATestSuite) TestSignX509SVIDUsesDefaultTTLIfTTLUnspecified() {
 	svid, err := s.ca.SignX509SVID(ctx, s.createX509SVIDParams())
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
 }
 
@@ -127,19 +129,28 @@ func (s *CATestSuite) TestSignX509SVIDUsesDefaultTTLAndNoCNDNS() {
 	svid, err := s.ca.SignX509SVID(ctx, s.createX509SVIDParams())
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
 	s.Require().Empty(svid[0].DNSNames)
 	s.Require().Empty(svid[0].Subject.CommonName)
 }
 
+func (s *CATestSuite) TestSignX509SVIDUsesConfiguredBackdate() {
+	s.ca.c.Backdate = time.Minute
+	svid, err := s.ca.SignX509SVID(ctx, s.createX509SVIDParams())
+	s.Require().NoError(err)
+	s.Require().Len(svid, 1)
+	s.Require().Equal(s.clock.Now().Add(-time.Minute), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
+}
+
 func (s *CATestSuite) TestSignX509SVIDSingleDNS() {
 	params := s.createX509SVIDParams()
 	params.DNSList = []string{"somehost1"}
 	svid, err := s.ca.SignX509SVID(ctx, params)
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
 	s.Require().Equal(params.DNSList, svid[0].DNSNames)
 	s.Require().Equal("somehost1", svid[0].Subject.CommonName)
@@ -151,12 +162,27 @@ func (s *CATestSuite) TestSignX509SVIDMultipleDNS() {
 	svid, err := s.ca.SignX509SVID(ctx, params)
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
 	s.Require().Equal(params.DNSList, svid[0].DNSNames)
 	s.Require().Equal("somehost1", svid[0].Subject.CommonName)
 }
 
+func (s *CATestSuite) TestSignX509SVIDEnforcesDNSNamePolicy() {
+	policy, err := api.NewDNSNamePolicy([]string{"example.org"}, nil)
+	s.Require().NoError(err)
+	s.ca.c.DNSNamePolicy = policy
+
+	params := s.createX509SVIDParams()
+	params.DNSList = []string{"foo.example.org"}
+	_, err = s.ca.SignX509SVID(ctx, params)
+	s.Require().NoError(err)
+
+	params.DNSList = []string{"foo.example.org", "foo.example.com"}
+	_, err = s.ca.SignX509SVID(ctx, params)
+	s.Require().EqualError(err, `DNS name "foo.example.com" is not allowed by the DNS name policy`)
+}
+
 func (s *CATestSuite) TestSignX509SVIDWithSubject() {
 	subject := pkix.Name{
 		Organization: []string{"ORG"},
@@ -226,7 +252,7 @@ func (s *CATestSuite) TestSignX509SVIDUsesTTLIfSpecified() {
 	svid, err := s.ca.SignX509SVID(ctx, params)
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute+time.Second), svid[0].NotAfter)
 }
 
@@ -236,8 +262,45 @@ func (s *CATestSuite) TestSignX509SVIDCapsTTLToCATTL() {
 	svid, err := s.ca.SignX509SVID(ctx, params)
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(10*time.Minute), svid[0].NotAfter)
+}
+
+func (s *CATestSuite) TestSignX509SVIDReportsClampedTTL() {
+	metrics := fakemetrics.New()
+	s.ca.c.Metrics = metrics
+
+	params := s.createX509SVIDParams()
+	params.TTL = time.Hour
+	params.EntryID = "entry-id"
+	svid, err := s.ca.SignX509SVID(ctx, params)
+	s.Require().NoError(err)
+	s.Require().Len(svid, 1)
 	s.Require().Equal(s.clock.Now().Add(10*time.Minute), svid[0].NotAfter)
+
+	s.Require().Contains(metrics.AllMetrics(), fakemetrics.MetricItem{
+		Type: fakemetrics.IncrCounterType,
+		Key:  []string{"server_ca", "x509_svid", "ttl", "clamp"},
+		Val:  1,
+	})
+	entry := s.logHook.LastEntry()
+	s.Require().NotNil(entry)
+	s.Require().Equal("X509-SVID TTL exceeds the remaining lifetime of the CA; clamping", entry.Message)
+	s.Require().Equal("entry-id", entry.Data["entry_id"])
+	s.Require().Equal(time.Hour, entry.Data["ttl"])
+
+	// Nothing is reported when the TTL fits in the lifetime of the CA
+	metrics.Reset()
+	s.logHook.Reset()
+	params.TTL = time.Minute
+	_, err = s.ca.SignX509SVID(ctx, params)
+	s.Require().NoError(err)
+	s.Require().NotContains(metrics.AllMetrics(), fakemetrics.MetricItem{
+		Type: fakemetrics.IncrCounterType,
+		Key:  []string{"server_ca", "x509_svid", "ttl", "clamp"},
+		Val:  1,
+	})
+	s.Require().Empty(s.logHook.AllEntries())
 }
 
 func (s *CATestSuite) TestSignX509SVIDValidatesTrustDomain() {
@@ -288,6 +351,36 @@ func (s *CATestSuite) TestSignJWTSVIDCapsTTLToKeyExpiry() {
 	s.Require().Equal(s.clock.Now().Add(10*time.Minute), expiresAt)
 }
 
+func (s *CATestSuite) TestSignJWTSVIDClampsTTLToMaximum() {
+	metrics := fakemetrics.New()
+	s.ca.c.Metrics = metrics
+	s.ca.c.MaxJWTSVIDTTL = 2 * time.Minute
+
+	token, err := s.ca.SignJWTSVID(ctx, s.createJWTSVIDParams(trustDomainExample, time.Minute))
+	s.Require().NoError(err)
+	_, expiresAt, err := jwtsvid.GetTokenExpiry(token)
+	s.Require().NoError(err)
+	s.Require().Equal(s.clock.Now().Add(time.Minute), expiresAt)
+	s.Require().Empty(s.logHook.AllEntries())
+
+	token, err = s.ca.SignJWTSVID(ctx, s.createJWTSVIDParams(trustDomainExample, 5*time.Minute))
+	s.Require().NoError(err)
+	_, expiresAt, err = jwtsvid.GetTokenExpiry(token)
+	s.Require().NoError(err)
+	s.Require().Equal(s.clock.Now().Add(2*time.Minute), expiresAt)
+
+	s.Require().Contains(metrics.AllMetrics(), fakemetrics.MetricItem{
+		Type: fakemetrics.IncrCounterType,
+		Key:  []string{"server_ca", "jwt_svid", "ttl", "clamp"},
+		Val:  1,
+	})
+	entry := s.logHook.LastEntry()
+	s.Require().NotNil(entry)
+	s.Require().Equal("Requested JWT-SVID TTL exceeds the maximum; clamping", entry.Message)
+	s.Require().Equal(5*time.Minute, entry.Data["ttl"])
+	s.Require().Equal(2*time.Minute, entry.Data["max_ttl"])
+}
+
 func (s *CATestSuite) TestSignJWTSVIDValidatesJSR() {
 	// spiffe id for wrong trust domain
 	_, err := s.ca.SignJWTSVID(ctx, s.createJWTSVIDParams(trustDomainFoo, 0))
@@ -335,7 +428,7 @@ func (s *CATestSuite) TestSignX509CASVIDUsesDefaultTTLIfTTLUnspecified() {
 	svid, err := s.ca.SignX509CASVID(ctx, s.createX509CASVIDParams(trustDomainExample))
 	s.Require().NoError(err)
 	s.Require().Len(svid, 1)
-	s.Require().Equal(s.clock.Now().Add(-backdate), svid[0].NotBefore)
+	s.Require().Equal(s.clock.Now().Add(-DefaultBackdate), svid[0].NotBefore)
 	s.Require().Equal(s.clock.Now().Add(time.Minute), svid[0].NotAfter)
 }
 
@@ -73,7 +73,7 @@ func (j *Journal) Entries() *JournalEntries {
 	return proto.Clone(j.entries).(*JournalEntries)
 }
 
-func (j *Journal) AppendX509CA(slotID string, issuedAt time.Time, x509CA *X509CA) error {
+func (j *Journal) AppendX509CA(slotID, keyID string, issuedAt time.Time, x509CA *X509CA) error {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
@@ -83,6 +83,7 @@ func (j *Journal) AppendX509CA(slotID string, issuedAt time.Time, x509CA *X509CA
 		IssuedAt:      issuedAt.Unix(),
 		Certificate:   x509CA.Certificate.Raw,
 		UpstreamChain: chainDER(x509CA.UpstreamChain),
+		KeyId:         keyID,
 	})
 
 	exceeded := len(j.entries.X509CAs) - journalCap
@@ -101,7 +102,7 @@ func (j *Journal) AppendX509CA(slotID string, issuedAt time.Time, x509CA *X509CA
 	return nil
 }
 
-func (j *Journal) AppendJWTKey(slotID string, issuedAt time.Time, jwtKey *JWTKey) error {
+func (j *Journal) AppendJWTKey(slotID, keyID string, issuedAt time.Time, jwtKey *JWTKey) error {
 	j.mu.Lock()
 	defer j.mu.Unlock()
 
@@ -117,6 +118,7 @@ func (j *Journal) AppendJWTKey(slotID string, issuedAt time.Time, jwtKey *JWTKey
 		Kid:       jwtKey.Kid,
 		PublicKey: pkixBytes,
 		NotAfter:  jwtKey.NotAfter.Unix(),
+		KeyId:     keyID,
 	})
 
 	exceeded := len(j.entries.JwtKeys) - journalCap
@@ -177,7 +179,7 @@ func migrateJSONFile(from, to string) (bool, error) {
 	}
 
 	parseX509CA := func(slotID string) (*X509CAEntry, error) {
-		certsBytes := data.CAs[x509CAKmKeyID(slotID)]
+		certsBytes := data.CAs[x509CAKmKeyID("", slotID)]
 		if len(certsBytes) == 0 {
 			return nil, nil
 		}
@@ -210,7 +212,7 @@ func migrateJSONFile(from, to string) (bool, error) {
 	}
 
 	parseJWTKey := func(slotID string) (*JWTKeyEntry, error) {
-		entryData := data.PublicKeys[jwtKeyKmKeyID(slotID)]
+		entryData := data.PublicKeys[jwtKeyKmKeyID("", slotID)]
 		if len(entryData) == 0 {
 			return nil, nil
 		}
@@ -0,0 +1,277 @@
+package ca
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"time"
+
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/zeebo/errs"
+)
+
+// JournalRepair is how a journal problem is repaired.
+type JournalRepair string
+
+const (
+	// JournalRepairDropEntry removes the entry from the journal. The slot
+	// that would have been loaded from it is prepared again.
+	JournalRepairDropEntry JournalRepair = "drop_entry"
+
+	// JournalRepairAppendBundle appends the key material of the entry to the
+	// trust domain bundle.
+	JournalRepairAppendBundle JournalRepair = "append_bundle"
+)
+
+// JournalProblem describes a journal entry that does not agree with the
+// KeyManager or the trust domain bundle.
+type JournalProblem struct {
+	Kind     SlotKind
+	SlotID   string
+	IssuedAt time.Time
+	Problem  string
+	Repair   JournalRepair
+}
+
+// journalCheck holds the problems found in the journal entries, along with
+// what is needed to repair them.
+type journalCheck struct {
+	problems []JournalProblem
+
+	dropX509CAs map[int]bool
+	dropJWTKeys map[int]bool
+
+	missingRootCAs []*x509.Certificate
+	missingJWTKeys []*common.PublicKey
+}
+
+// VerifyJournal checks the journal entries the slots are loaded from against
+// the KeyManager and the trust domain bundle. Only the two most recent
+// entries of each kind are checked, since older entries are never loaded
+// again. Expired entries are not checked.
+func (m *Manager) VerifyJournal(ctx context.Context) ([]JournalProblem, error) {
+	m.rotateMtx.Lock()
+	defer m.rotateMtx.Unlock()
+
+	check, err := m.checkJournal(ctx, m.journal.Entries())
+	if err != nil {
+		return nil, err
+	}
+	return check.problems, nil
+}
+
+// RepairJournal repairs the problems found by VerifyJournal, or drops every
+// journal entry if reinitialize is true, and then reloads the slots from the
+// journal. Slots left empty are prepared again right away. It returns the
+// problems that were repaired.
+func (m *Manager) RepairJournal(ctx context.Context, reinitialize bool) ([]JournalProblem, error) {
+	m.rotateMtx.Lock()
+	defer m.rotateMtx.Unlock()
+
+	entries := m.journal.Entries()
+	check, err := m.checkJournal(ctx, entries)
+	if err != nil {
+		return nil, err
+	}
+
+	if reinitialize {
+		entries = new(JournalEntries)
+	} else {
+		if len(check.missingRootCAs) > 0 || len(check.missingJWTKeys) > 0 {
+			if _, err := m.appendBundle(ctx, check.missingRootCAs, check.missingJWTKeys); err != nil {
+				return nil, errs.New("unable to append to bundle: %v", err)
+			}
+		}
+		entries = filterJournalEntries(entries, check.dropX509CAs, check.dropJWTKeys)
+	}
+
+	if err := saveJournalEntries(m.journalPath(), entries); err != nil {
+		return nil, err
+	}
+
+	if reinitialize {
+		m.c.Log.Warn("Journal reinitialized; reloading slots")
+	} else {
+		m.c.Log.WithField(telemetry.Count, len(check.problems)).Warn("Journal repaired; reloading slots")
+	}
+
+	m.currentX509CA, m.nextX509CA = nil, nil
+	m.currentJWTKey, m.nextJWTKey = nil, nil
+	if err := m.loadJournal(ctx); err != nil {
+		return nil, err
+	}
+	if err := m.rotateLocked(ctx); err != nil {
+		return nil, err
+	}
+	return check.problems, nil
+}
+
+func (m *Manager) checkJournal(ctx context.Context, entries *JournalEntries) (*journalCheck, error) {
+	bundle, err := m.fetchRequiredBundle(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	roots := x509.NewCertPool()
+	for _, rootCA := range bundle.RootCas {
+		cert, err := x509.ParseCertificate(rootCA.DerBytes)
+		if err != nil {
+			return nil, errs.New("unable to parse bundle root CA: %v", err)
+		}
+		roots.AddCert(cert)
+	}
+
+	check := &journalCheck{
+		dropX509CAs: make(map[int]bool),
+		dropJWTKeys: make(map[int]bool),
+	}
+	now := m.c.Clock.Now()
+
+	for i := lastEntriesStart(len(entries.X509CAs)); i < len(entries.X509CAs); i++ {
+		entry := entries.X509CAs[i]
+		problem := func(repair JournalRepair, format string, args ...interface{}) {
+			check.problems = append(check.problems, JournalProblem{
+				Kind:     SlotKindX509CA,
+				SlotID:   entry.SlotId,
+				IssuedAt: time.Unix(entry.IssuedAt, 0),
+				Problem:  errs.New(format, args...).Error(),
+				Repair:   repair,
+			})
+			if repair == JournalRepairDropEntry {
+				check.dropX509CAs[i] = true
+			}
+		}
+
+		if _, err := x509.ParseCertificate(entry.Certificate); err != nil {
+			problem(JournalRepairDropEntry, "unable to parse CA certificate: %v", err)
+			continue
+		}
+		if _, err := x509.ParseCertificates(bytes.Join(entry.UpstreamChain, nil)); err != nil {
+			problem(JournalRepairDropEntry, "unable to parse upstream chain certificate: %v", err)
+			continue
+		}
+
+		slot, badReason, err := m.loadX509CASlotFromEntry(ctx, entry)
+		switch {
+		case err != nil:
+			return nil, err
+		case badReason != "":
+			problem(JournalRepairDropEntry, "%s", badReason)
+			continue
+		}
+
+		cert := slot.x509CA.Certificate
+		if !now.Before(cert.NotAfter) {
+			continue
+		}
+
+		if len(slot.x509CA.UpstreamChain) == 0 {
+			if !bundleHasRootCA(bundle, cert) {
+				problem(JournalRepairAppendBundle, "self-signed CA certificate is not in the trust domain bundle")
+				check.missingRootCAs = append(check.missingRootCAs, cert)
+			}
+			continue
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, upstreamCert := range slot.x509CA.UpstreamChain {
+			intermediates.AddCert(upstreamCert)
+		}
+		if _, err := cert.Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			CurrentTime:   now,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+		}); err != nil {
+			problem(JournalRepairDropEntry, "CA certificate does not chain to the trust domain bundle: %v", err)
+		}
+	}
+
+	for i := lastEntriesStart(len(entries.JwtKeys)); i < len(entries.JwtKeys); i++ {
+		entry := entries.JwtKeys[i]
+		problem := func(repair JournalRepair, format string, args ...interface{}) {
+			check.problems = append(check.problems, JournalProblem{
+				Kind:     SlotKindJWTKey,
+				SlotID:   entry.SlotId,
+				IssuedAt: time.Unix(entry.IssuedAt, 0),
+				Problem:  errs.New(format, args...).Error(),
+				Repair:   repair,
+			})
+			if repair == JournalRepairDropEntry {
+				check.dropJWTKeys[i] = true
+			}
+		}
+
+		if _, err := x509.ParsePKIXPublicKey(entry.PublicKey); err != nil {
+			problem(JournalRepairDropEntry, "unable to parse public key: %v", err)
+			continue
+		}
+
+		_, badReason, err := m.loadJWTKeySlotFromEntry(ctx, entry)
+		switch {
+		case err != nil:
+			return nil, err
+		case badReason != "":
+			problem(JournalRepairDropEntry, "%s", badReason)
+			continue
+		}
+
+		if !now.Before(time.Unix(entry.NotAfter, 0)) {
+			continue
+		}
+
+		if !bundleHasJWTKey(bundle, entry) {
+			problem(JournalRepairAppendBundle, "public key is not in the trust domain bundle")
+			check.missingJWTKeys = append(check.missingJWTKeys, &common.PublicKey{
+				PkixBytes: entry.PublicKey,
+				Kid:       entry.Kid,
+				NotAfter:  entry.NotAfter,
+			})
+		}
+	}
+
+	return check, nil
+}
+
+// lastEntriesStart returns the index of the first of the entries loadJournal
+// considers for the current and next slots.
+func lastEntriesStart(n int) int {
+	if n < 2 {
+		return 0
+	}
+	return n - 2
+}
+
+func filterJournalEntries(entries *JournalEntries, dropX509CAs, dropJWTKeys map[int]bool) *JournalEntries {
+	filtered := new(JournalEntries)
+	for i, entry := range entries.X509CAs {
+		if !dropX509CAs[i] {
+			filtered.X509CAs = append(filtered.X509CAs, entry)
+		}
+	}
+	for i, entry := range entries.JwtKeys {
+		if !dropJWTKeys[i] {
+			filtered.JwtKeys = append(filtered.JwtKeys, entry)
+		}
+	}
+	return filtered
+}
+
+func bundleHasRootCA(bundle *common.Bundle, cert *x509.Certificate) bool {
+	for _, rootCA := range bundle.RootCas {
+		if bytes.Equal(rootCA.DerBytes, cert.Raw) {
+			return true
+		}
+	}
+	return false
+}
+
+func bundleHasJWTKey(bundle *common.Bundle, entry *JWTKeyEntry) bool {
+	for _, jwtKey := range bundle.JwtSigningKeys {
+		if jwtKey.Kid == entry.Kid && bytes.Equal(jwtKey.PkixBytes, entry.PublicKey) {
+			return true
+		}
+	}
+	return false
+}
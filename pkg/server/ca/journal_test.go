@@ -124,14 +124,14 @@ func (s *JournalSuite) TestPersistence() {
 
 	journal := s.loadJournal()
 
-	err := journal.AppendX509CA("A", now, &X509CA{
+	err := journal.AppendX509CA("A", "x509-CA-A", now, &X509CA{
 		Signer:        testSigner,
 		Certificate:   testChain[0],
 		UpstreamChain: testChain,
 	})
 	s.Require().NoError(err)
 
-	err = journal.AppendJWTKey("B", now, &JWTKey{
+	err = journal.AppendJWTKey("B", "JWT-Signer-B", now, &JWTKey{
 		Signer:   testSigner,
 		Kid:      "KID",
 		NotAfter: now.Add(time.Hour),
@@ -148,7 +148,7 @@ func (s *JournalSuite) TestX509CAOverflow() {
 
 	for i := 0; i < (journalCap + 1); i++ {
 		now = now.Add(time.Minute)
-		err := journal.AppendX509CA("A", now, &X509CA{
+		err := journal.AppendX509CA("A", "x509-CA-A", now, &X509CA{
 			Signer:      testSigner,
 			Certificate: testChain[0],
 		})
@@ -168,7 +168,7 @@ func (s *JournalSuite) TestJWTKeyOverflow() {
 
 	for i := 0; i < (journalCap + 1); i++ {
 		now = now.Add(time.Minute)
-		err := journal.AppendJWTKey("B", now, &JWTKey{
+		err := journal.AppendJWTKey("B", "JWT-Signer-B", now, &JWTKey{
 			Signer:   testSigner,
 			Kid:      "KID",
 			NotAfter: now.Add(time.Hour),
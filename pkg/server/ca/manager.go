@@ -19,10 +19,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/cryptoutil"
+	"github.com/spiffe/spire/pkg/common/fips"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
 	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/pkg/server/catalog"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/server/plugin/notifier"
@@ -34,7 +36,7 @@ import (
 
 const (
 	DefaultCATTL    = 24 * time.Hour
-	backdate        = 10 * time.Second
+	DefaultBackdate = 10 * time.Second
 	rotateInterval  = 10 * time.Second
 	pruneInterval   = 6 * time.Hour
 	safetyThreshold = 24 * time.Hour
@@ -46,6 +48,10 @@ const (
 	activationThresholdCap = sevenDays
 
 	publishJWKTimeout = 5 * time.Second
+
+	// caEventBacklog is the number of CA events that can be pending delivery
+	// to the notifiers before new events are dropped.
+	caEventBacklog = 16
 )
 
 type ManagedCA interface {
@@ -65,11 +71,22 @@ type ManagerConfig struct {
 	Log           logrus.FieldLogger
 	Metrics       telemetry.Metrics
 	Clock         clock.Clock
+
+	// Backdate is how far in the past the NotBefore of self-signed CA
+	// certificates is set, to tolerate clock skew. Defaults to
+	// DefaultBackdate.
+	Backdate time.Duration
+
+	// ServerID, if set, is included in the key manager key ids of the CA
+	// keys. It keeps the keys of servers sharing a key manager (e.g. in HA
+	// deployments) from overwriting each other.
+	ServerID string
 }
 
 type Manager struct {
 	c                  ManagerConfig
 	bundleUpdatedCh    chan struct{}
+	caEventCh          chan *notifier.NotifyRequest
 	upstreamClient     *UpstreamClient
 	upstreamPluginName string
 
@@ -80,6 +97,15 @@ type Manager struct {
 
 	journal *Journal
 
+	// rotateMtx serializes rotation with journal repairs, which reload the
+	// slots.
+	rotateMtx sync.Mutex
+
+	// slotStates is a snapshot of the slots, taken after each rotation, so
+	// they can be reported without racing with the rotation.
+	slotStatesMtx sync.RWMutex
+	slotStates    []SlotState
+
 	// Used to log a warning only once when the UpstreamAuthority does not support JWT-SVIDs.
 	jwtUnimplementedWarnOnce sync.Once
 }
@@ -88,6 +114,9 @@ func NewManager(c ManagerConfig) *Manager {
 	if c.CATTL <= 0 {
 		c.CATTL = DefaultCATTL
 	}
+	if c.Backdate <= 0 {
+		c.Backdate = DefaultBackdate
+	}
 	if c.Clock == nil {
 		c.Clock = clock.New()
 	}
@@ -101,6 +130,7 @@ func NewManager(c ManagerConfig) *Manager {
 	m := &Manager{
 		c:               c,
 		bundleUpdatedCh: make(chan struct{}, 1),
+		caEventCh:       make(chan *notifier.NotifyRequest, caEventBacklog),
 	}
 
 	if upstreamAuthority, ok := c.Catalog.GetUpstreamAuthority(); ok {
@@ -139,6 +169,9 @@ func (m *Manager) Run(ctx context.Context) error {
 	if err := m.notifyBundleLoaded(ctx); err != nil {
 		return err
 	}
+	if err := m.publishBundle(ctx); err != nil {
+		m.c.Log.WithError(err).Warn("Failed to publish bundle")
+	}
 	err := util.RunTasks(ctx,
 		func(ctx context.Context) error {
 			return m.rotateEvery(ctx, rotateInterval)
@@ -178,6 +211,12 @@ func (m *Manager) rotateEvery(ctx context.Context, interval time.Duration) error
 }
 
 func (m *Manager) rotate(ctx context.Context) error {
+	m.rotateMtx.Lock()
+	defer m.rotateMtx.Unlock()
+	return m.rotateLocked(ctx)
+}
+
+func (m *Manager) rotateLocked(ctx context.Context) error {
 	x509CAErr := m.rotateX509CA(ctx)
 	if x509CAErr != nil {
 		m.c.Log.WithError(x509CAErr).Error("Unable to rotate X509 CA")
@@ -188,9 +227,32 @@ func (m *Manager) rotate(ctx context.Context) error {
 		m.c.Log.WithError(jwtKeyErr).Error("Unable to rotate JWT key")
 	}
 
+	m.updateSlotStates()
+
 	return errs.Combine(x509CAErr, jwtKeyErr)
 }
 
+// SlotStates returns the state of the X509 CA and JWT key slots as of the
+// last rotation. Current slots are listed before next slots.
+func (m *Manager) SlotStates() []SlotState {
+	m.slotStatesMtx.RLock()
+	defer m.slotStatesMtx.RUnlock()
+	return append([]SlotState(nil), m.slotStates...)
+}
+
+func (m *Manager) updateSlotStates() {
+	slotStates := []SlotState{
+		m.currentX509CA.state(SlotActive),
+		m.nextX509CA.state(SlotPrepared),
+		m.currentJWTKey.state(SlotActive),
+		m.nextJWTKey.state(SlotPrepared),
+	}
+
+	m.slotStatesMtx.Lock()
+	defer m.slotStatesMtx.Unlock()
+	m.slotStates = slotStates
+}
+
 func (m *Manager) rotateX509CA(ctx context.Context) error {
 	now := m.c.Clock.Now()
 
@@ -199,7 +261,9 @@ func (m *Manager) rotateX509CA(ctx context.Context) error {
 		if err := m.prepareX509CA(ctx, m.currentX509CA); err != nil {
 			return err
 		}
+		m.x509CAPrepared(m.currentX509CA)
 		m.activateX509CA()
+		m.x509CAActivated(m.currentX509CA)
 	}
 
 	// if there is no next keypair set and the current is within the
@@ -208,12 +272,15 @@ func (m *Manager) rotateX509CA(ctx context.Context) error {
 		if err := m.prepareX509CA(ctx, m.nextX509CA); err != nil {
 			return err
 		}
+		m.x509CAPrepared(m.nextX509CA)
 	}
 
 	if m.currentX509CA.ShouldActivateNext(now) {
 		m.currentX509CA, m.nextX509CA = m.nextX509CA, m.currentX509CA
+		m.x509CADeactivated(m.nextX509CA)
 		m.nextX509CA.Reset()
 		m.activateX509CA()
+		m.x509CAActivated(m.currentX509CA)
 	}
 
 	return nil
@@ -227,6 +294,7 @@ func (m *Manager) prepareX509CA(ctx context.Context, slot *x509CASlot) (err erro
 	log.Debug("Preparing X509 CA")
 
 	slot.Reset()
+	slot.keyID = x509CAKmKeyID(m.c.ServerID, slot.id)
 
 	now := m.c.Clock.Now()
 	km := m.c.Catalog.GetKeyManager()
@@ -241,8 +309,15 @@ func (m *Manager) prepareX509CA(ctx context.Context, slot *x509CASlot) (err erro
 		if err != nil {
 			return err
 		}
+		// The upstream authority is outside of our control; make sure the
+		// chain it minted does not take the CA out of FIPS 140 mode.
+		if fips.Enabled() {
+			if err := fips.CheckCertificates(x509CA.UpstreamChain); err != nil {
+				return fmt.Errorf("upstream X509 CA chain: %w", err)
+			}
+		}
 	} else {
-		notBefore := now.Add(-backdate)
+		notBefore := now.Add(-m.c.Backdate)
 		notAfter := now.Add(m.c.CATTL)
 		var trustBundle []*x509.Certificate
 		x509CA, trustBundle, err = SelfSignX509CA(ctx, signer, m.c.TrustDomain, m.c.CASubject, notBefore, notAfter)
@@ -257,7 +332,7 @@ func (m *Manager) prepareX509CA(ctx context.Context, slot *x509CASlot) (err erro
 	slot.issuedAt = now
 	slot.x509CA = x509CA
 
-	if err := m.journal.AppendX509CA(slot.id, slot.issuedAt, slot.x509CA); err != nil {
+	if err := m.journal.AppendX509CA(slot.id, slot.keyID, slot.issuedAt, slot.x509CA); err != nil {
 		log.WithError(err).Error("Unable to append X509 CA to journal")
 	}
 
@@ -324,6 +399,7 @@ func (m *Manager) prepareJWTKey(ctx context.Context, slot *jwtKeySlot) (err erro
 	log.Debug("Preparing JWT key")
 
 	slot.Reset()
+	slot.keyID = jwtKeyKmKeyID(m.c.ServerID, slot.id)
 
 	now := m.c.Clock.Now()
 	notAfter := now.Add(m.c.CATTL)
@@ -351,7 +427,7 @@ func (m *Manager) prepareJWTKey(ctx context.Context, slot *jwtKeySlot) (err erro
 	slot.issuedAt = now
 	slot.jwtKey = jwtKey
 
-	if err := m.journal.AppendJWTKey(slot.id, slot.issuedAt, slot.jwtKey); err != nil {
+	if err := m.journal.AppendJWTKey(slot.id, slot.keyID, slot.issuedAt, slot.jwtKey); err != nil {
 		log.WithError(err).Error("Unable to append JWT key to journal")
 	}
 
@@ -615,7 +691,12 @@ func (m *Manager) loadX509CASlotFromEntry(ctx context.Context, entry *X509CAEntr
 		upstreamChain = append(upstreamChain, cert)
 	}
 
-	signer, err := m.makeSigner(ctx, x509CAKmKeyID(entry.SlotId))
+	keyID := entry.KeyId
+	if keyID == "" {
+		keyID = x509CAKmKeyID("", entry.SlotId)
+	}
+
+	signer, err := m.makeSigner(ctx, keyID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -629,6 +710,7 @@ func (m *Manager) loadX509CASlotFromEntry(ctx context.Context, entry *X509CAEntr
 
 	return &x509CASlot{
 		id:       entry.SlotId,
+		keyID:    keyID,
 		issuedAt: time.Unix(entry.IssuedAt, 0),
 		x509CA: &X509CA{
 			Signer:        signer,
@@ -665,7 +747,12 @@ func (m *Manager) loadJWTKeySlotFromEntry(ctx context.Context, entry *JWTKeyEntr
 		return nil, "", errs.Wrap(err)
 	}
 
-	signer, err := m.makeSigner(ctx, jwtKeyKmKeyID(entry.SlotId))
+	keyID := entry.KeyId
+	if keyID == "" {
+		keyID = jwtKeyKmKeyID("", entry.SlotId)
+	}
+
+	signer, err := m.makeSigner(ctx, keyID)
 	if err != nil {
 		return nil, "", err
 	}
@@ -679,6 +766,7 @@ func (m *Manager) loadJWTKeySlotFromEntry(ctx context.Context, entry *JWTKeyEntr
 
 	return &jwtKeySlot{
 		id:       entry.SlotId,
+		keyID:    keyID,
 		issuedAt: time.Unix(entry.IssuedAt, 0),
 		jwtKey: &JWTKey{
 			Signer:   signer,
@@ -723,6 +811,49 @@ func (m *Manager) dropBundleUpdated() {
 	}
 }
 
+func (m *Manager) x509CAPrepared(slot *x509CASlot) {
+	m.queueCAEvent(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaPrepared{
+			X509CaPrepared: &notifier.X509CAPrepared{
+				X509Ca: x509CAEvent(slot),
+			},
+		},
+	})
+}
+
+func (m *Manager) x509CAActivated(slot *x509CASlot) {
+	m.queueCAEvent(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaActivated{
+			X509CaActivated: &notifier.X509CAActivated{
+				X509Ca: x509CAEvent(slot),
+			},
+		},
+	})
+}
+
+func (m *Manager) x509CADeactivated(slot *x509CASlot) {
+	m.queueCAEvent(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaDeactivated{
+			X509CaDeactivated: &notifier.X509CADeactivated{
+				X509Ca: x509CAEvent(slot),
+			},
+		},
+	})
+}
+
+// queueCAEvent queues a CA lifecycle event to be sent to the notifiers. The
+// event is dropped if the backlog is full so that rotation is never blocked
+// on slow notifiers.
+func (m *Manager) queueCAEvent(req *notifier.NotifyRequest) {
+	select {
+	case m.caEventCh <- req:
+	default:
+		m.c.Log.Warn("Dropping CA event notification; too many pending notifications")
+	}
+}
+
+// notifyOnBundleUpdate sends bundle updated and CA lifecycle events to the
+// notifiers until the context is canceled.
 func (m *Manager) notifyOnBundleUpdate(ctx context.Context) {
 	for {
 		select {
@@ -730,6 +861,13 @@ func (m *Manager) notifyOnBundleUpdate(ctx context.Context) {
 			if err := m.notifyBundleUpdated(ctx); err != nil {
 				m.c.Log.WithError(err).Warn("Failed to notify on bundle update")
 			}
+			if err := m.publishBundle(ctx); err != nil {
+				m.c.Log.WithError(err).Warn("Failed to publish bundle")
+			}
+		case req := <-m.caEventCh:
+			if err := m.notifyCAEvent(ctx, req); err != nil {
+				m.c.Log.WithError(err).Warn("Failed to notify on CA event")
+			}
 		case <-ctx.Done():
 			return
 		}
@@ -782,6 +920,24 @@ func (m *Manager) notifyBundleUpdated(ctx context.Context) error {
 	)
 }
 
+func (m *Manager) notifyCAEvent(ctx context.Context, req *notifier.NotifyRequest) error {
+	var event string
+	switch req.Event.(type) {
+	case *notifier.NotifyRequest_X509CaPrepared:
+		event = "x509 ca prepared"
+	case *notifier.NotifyRequest_X509CaActivated:
+		event = "x509 ca activated"
+	case *notifier.NotifyRequest_X509CaDeactivated:
+		event = "x509 ca deactivated"
+	}
+	return m.notify(ctx, event, false, nil,
+		func(ctx context.Context, n notifier.Notifier) error {
+			_, err := n.Notify(ctx, req)
+			return err
+		},
+	)
+}
+
 func (m *Manager) notify(ctx context.Context, event string, advise bool, pre func(context.Context) error, do func(context.Context, notifier.Notifier) error) error {
 	notifiers := m.c.Catalog.GetNotifiers()
 	if len(notifiers) == 0 {
@@ -830,6 +986,37 @@ func (m *Manager) notify(ctx context.Context, event string, advise bool, pre fun
 	return nil
 }
 
+// publishBundle publishes the current bundle using every BundlePublisher
+// plugin. Publishing with each plugin is attempted even if another fails.
+func (m *Manager) publishBundle(ctx context.Context) error {
+	publishers := m.c.Catalog.GetBundlePublishers()
+	if len(publishers) == 0 {
+		return nil
+	}
+
+	bundle, err := m.fetchRequiredBundle(ctx)
+	if err != nil {
+		return err
+	}
+
+	var allErrs errs.Group
+	for _, p := range publishers {
+		log := m.c.Log.WithField(telemetry.BundlePublisher, p.Name())
+		if _, err := p.PublishBundle(ctx, &bundlepublisher.PublishBundleRequest{
+			Bundle: bundle,
+		}); err != nil {
+			log.WithError(err).Warn("Bundle publisher failed to publish bundle")
+			allErrs.Add(err)
+			continue
+		}
+		log.Debug("Bundle published")
+	}
+	if err := allErrs.Err(); err != nil {
+		return errs.New("one or more bundle publishers returned an error: %v", err)
+	}
+	return nil
+}
+
 func (m *Manager) fetchRequiredBundle(ctx context.Context) (*common.Bundle, error) {
 	bundle, err := m.fetchOptionalBundle(ctx)
 	if err != nil {
@@ -902,20 +1089,77 @@ func (u *bundleUpdater) appendBundle(ctx context.Context, bundle *common.Bundle)
 	return resp.Bundle, nil
 }
 
-func x509CAKmKeyID(id string) string {
-	return fmt.Sprintf("x509-CA-%s", id)
+// x509CAKmKeyID returns the key manager key id of the X509 CA key for the
+// given slot. Key ids without a server id are the ones used before server ids
+// were introduced.
+func x509CAKmKeyID(serverID, id string) string {
+	if serverID == "" {
+		return fmt.Sprintf("x509-CA-%s", id)
+	}
+	return fmt.Sprintf("x509-CA-%s-%s", serverID, id)
 }
 
-func jwtKeyKmKeyID(id string) string {
-	return fmt.Sprintf("JWT-Signer-%s", id)
+// jwtKeyKmKeyID returns the key manager key id of the JWT key for the given
+// slot. Key ids without a server id are the ones used before server ids were
+// introduced.
+func jwtKeyKmKeyID(serverID, id string) string {
+	if serverID == "" {
+		return fmt.Sprintf("JWT-Signer-%s", id)
+	}
+	return fmt.Sprintf("JWT-Signer-%s-%s", serverID, id)
+}
+
+// SlotKind is the kind of key material held by a slot
+type SlotKind string
+
+const (
+	SlotKindX509CA SlotKind = "x509_ca"
+	SlotKindJWTKey SlotKind = "jwt_key"
+)
+
+// SlotStatus is the status of a slot
+type SlotStatus string
+
+const (
+	// SlotActive is the status of the slot in use for signing
+	SlotActive SlotStatus = "active"
+	// SlotPrepared is the status of the slot that is activated next
+	SlotPrepared SlotStatus = "prepared"
+	// SlotEmpty is the status of a slot without key material
+	SlotEmpty SlotStatus = "empty"
+)
+
+// SlotState describes the key material held by a slot. The times are zero
+// for empty slots.
+type SlotState struct {
+	ID        string
+	Kind      SlotKind
+	Status    SlotStatus
+	IssuedAt  time.Time
+	ExpiresAt time.Time
 }
 
 type x509CASlot struct {
 	id       string
+	keyID    string
 	issuedAt time.Time
 	x509CA   *X509CA
 }
 
+func x509CAEvent(slot *x509CASlot) *notifier.X509CA {
+	var upstreamChain [][]byte
+	for _, cert := range slot.x509CA.UpstreamChain {
+		upstreamChain = append(upstreamChain, cert.Raw)
+	}
+	return &notifier.X509CA{
+		SlotId:        slot.id,
+		Certificate:   slot.x509CA.Certificate.Raw,
+		IssuedAt:      slot.issuedAt.Unix(),
+		ExpiresAt:     slot.x509CA.Certificate.NotAfter.Unix(),
+		UpstreamChain: upstreamChain,
+	}
+}
+
 func newX509CASlot(id string) *x509CASlot {
 	return &x509CASlot{
 		id: id,
@@ -923,7 +1167,7 @@ func newX509CASlot(id string) *x509CASlot {
 }
 
 func (s *x509CASlot) KmKeyID() string {
-	return x509CAKmKeyID(s.id)
+	return s.keyID
 }
 
 func (s *x509CASlot) IsEmpty() bool {
@@ -934,6 +1178,20 @@ func (s *x509CASlot) Reset() {
 	s.x509CA = nil
 }
 
+func (s *x509CASlot) state(status SlotStatus) SlotState {
+	state := SlotState{
+		ID:     s.id,
+		Kind:   SlotKindX509CA,
+		Status: SlotEmpty,
+	}
+	if !s.IsEmpty() {
+		state.Status = status
+		state.IssuedAt = s.issuedAt
+		state.ExpiresAt = s.x509CA.Certificate.NotAfter
+	}
+	return state
+}
+
 func (s *x509CASlot) ShouldPrepareNext(now time.Time) bool {
 	return s.x509CA != nil && now.After(preparationThreshold(s.issuedAt, s.x509CA.Certificate.NotAfter))
 }
@@ -944,6 +1202,7 @@ func (s *x509CASlot) ShouldActivateNext(now time.Time) bool {
 
 type jwtKeySlot struct {
 	id       string
+	keyID    string
 	issuedAt time.Time
 	jwtKey   *JWTKey
 }
@@ -955,7 +1214,7 @@ func newJWTKeySlot(id string) *jwtKeySlot {
 }
 
 func (s *jwtKeySlot) KmKeyID() string {
-	return jwtKeyKmKeyID(s.id)
+	return s.keyID
 }
 
 func (s *jwtKeySlot) IsEmpty() bool {
@@ -966,6 +1225,20 @@ func (s *jwtKeySlot) Reset() {
 	s.jwtKey = nil
 }
 
+func (s *jwtKeySlot) state(status SlotStatus) SlotState {
+	state := SlotState{
+		ID:     s.id,
+		Kind:   SlotKindJWTKey,
+		Status: SlotEmpty,
+	}
+	if !s.IsEmpty() {
+		state.Status = status
+		state.IssuedAt = s.issuedAt
+		state.ExpiresAt = s.jwtKey.NotAfter
+	}
+	return state
+}
+
 func (s *jwtKeySlot) ShouldPrepareNext(now time.Time) bool {
 	return s.jwtKey == nil || now.After(preparationThreshold(s.issuedAt, s.jwtKey.NotAfter))
 }
@@ -22,6 +22,7 @@ import (
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
 	"github.com/spiffe/spire/pkg/server/catalog"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager/memory"
@@ -133,6 +134,139 @@ func (s *ManagerSuite) TestPersistenceFailsIfJournalLost() {
 	s.requireJWTKeyNotEqual(jwtKey, s.currentJWTKey())
 }
 
+func (s *ManagerSuite) TestVerifyAndRepairJournal() {
+	s.initSelfSignedManager()
+	x509CA, jwtKey := s.currentX509CA(), s.currentJWTKey()
+	issuedAt := time.Unix(s.m.currentX509CA.issuedAt.Unix(), 0)
+
+	problems, err := s.m.VerifyJournal(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(problems)
+
+	// drop the CA certificate from the bundle. repairing the journal appends
+	// it again and keeps the CA.
+	bundle := s.fetchBundle()
+	bundle.RootCas = nil
+	_, err = s.ds.SetBundle(ctx, &datastore.SetBundleRequest{Bundle: bundle})
+	s.Require().NoError(err)
+
+	expected := []JournalProblem{
+		{
+			Kind:     SlotKindX509CA,
+			SlotID:   "A",
+			IssuedAt: issuedAt,
+			Problem:  "self-signed CA certificate is not in the trust domain bundle",
+			Repair:   JournalRepairAppendBundle,
+		},
+	}
+	problems, err = s.m.VerifyJournal(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, problems)
+
+	problems, err = s.m.RepairJournal(ctx, false)
+	s.Require().NoError(err)
+	s.Require().Equal(expected, problems)
+	s.requireBundleRootCAs(x509CA.Certificate)
+	s.requireX509CAEqual(x509CA, s.currentX509CA())
+	s.requireJWTKeyEqual(jwtKey, s.currentJWTKey())
+
+	// lose the keys. repairing the journal drops the entries and prepares
+	// new keys.
+	s.cat.SetKeyManager(memory.New())
+	problems, err = s.m.VerifyJournal(ctx)
+	s.Require().NoError(err)
+	s.Require().Equal([]JournalProblem{
+		{
+			Kind:     SlotKindX509CA,
+			SlotID:   "A",
+			IssuedAt: issuedAt,
+			Problem:  "no key manager key",
+			Repair:   JournalRepairDropEntry,
+		},
+		{
+			Kind:     SlotKindJWTKey,
+			SlotID:   "A",
+			IssuedAt: issuedAt,
+			Problem:  "no key manager key",
+			Repair:   JournalRepairDropEntry,
+		},
+	}, problems)
+
+	problems, err = s.m.RepairJournal(ctx, false)
+	s.Require().NoError(err)
+	s.Require().Len(problems, 2)
+	s.requireX509CANotEqual(x509CA, s.currentX509CA())
+	s.requireJWTKeyNotEqual(jwtKey, s.currentJWTKey())
+
+	problems, err = s.m.VerifyJournal(ctx)
+	s.Require().NoError(err)
+	s.Require().Empty(problems)
+
+	// reinitializing prepares new keys even if nothing is wrong
+	x509CA, jwtKey = s.currentX509CA(), s.currentJWTKey()
+	problems, err = s.m.RepairJournal(ctx, true)
+	s.Require().NoError(err)
+	s.Require().Empty(problems)
+	s.requireX509CANotEqual(x509CA, s.currentX509CA())
+	s.requireJWTKeyNotEqual(jwtKey, s.currentJWTKey())
+}
+
+func (s *ManagerSuite) TestServerIDKeepsKeysDistinct() {
+	// Two servers share the key manager and the datastore but have their
+	// own journal
+	c1 := s.selfSignedConfig()
+	c1.ServerID = "server1"
+	m1 := NewManager(c1)
+	s.Require().NoError(m1.Initialize(context.Background()))
+	x509CA1, jwtKey1 := s.ca.X509CA(), s.ca.JWTKey()
+
+	c2 := s.selfSignedConfig()
+	c2.ServerID = "server2"
+	c2.Dir = s.TempDir()
+	m2 := NewManager(c2)
+	s.Require().NoError(m2.Initialize(context.Background()))
+
+	entries := m1.journal.Entries()
+	s.Require().Len(entries.X509CAs, 1)
+	s.Equal("x509-CA-server1-A", entries.X509CAs[0].KeyId)
+	s.Require().Len(entries.JwtKeys, 1)
+	s.Equal("JWT-Signer-server1-A", entries.JwtKeys[0].KeyId)
+
+	// The keys of the first server were not overwritten by the second one
+	m1 = NewManager(c1)
+	s.Require().NoError(m1.Initialize(context.Background()))
+	s.requireX509CAEqual(x509CA1, s.ca.X509CA())
+	s.requireJWTKeyEqual(jwtKey1, s.ca.JWTKey())
+}
+
+func (s *ManagerSuite) TestServerIDLoadsLegacyJournalEntries() {
+	s.initSelfSignedManager()
+	x509CA, jwtKey := s.currentX509CA(), s.currentJWTKey()
+
+	// Drop the key ids from the journal, as written before they were recorded
+	entries := s.m.journal.Entries()
+	for _, entry := range entries.X509CAs {
+		entry.KeyId = ""
+	}
+	for _, entry := range entries.JwtKeys {
+		entry.KeyId = ""
+	}
+	s.Require().NoError(saveJournalEntries(s.m.journalPath(), entries))
+
+	// The keys are still found when a server id is configured
+	c := s.selfSignedConfig()
+	c.ServerID = "server1"
+	s.m = NewManager(c)
+	s.Require().NoError(s.m.Initialize(context.Background()))
+	s.requireX509CAEqual(x509CA, s.currentX509CA())
+	s.requireJWTKeyEqual(jwtKey, s.currentJWTKey())
+
+	// New keys are prepared under key ids holding the server id
+	s.addTimeAndRotate(prepareAfter + time.Minute)
+	s.Equal("x509-CA-server1-B", s.m.nextX509CA.KmKeyID())
+	s.Equal("JWT-Signer-server1-B", s.m.nextJWTKey.KmKeyID())
+}
+
 func (s *ManagerSuite) TestSelfSigning() {
 	s.initSelfSignedManager()
 
@@ -195,6 +329,12 @@ func (s *ManagerSuite) TestUpstreamIntermediateSigned() {
 		s.Equal(fakeUA.X509Intermediate(), x509CA.UpstreamChain[1])
 	}
 
+	// CA events carry the chain back to the upstream bundle
+	s.Equal([][]byte{
+		x509CA.Certificate.Raw,
+		fakeUA.X509Intermediate().Raw,
+	}, x509CAEvent(s.m.currentX509CA).UpstreamChain)
+
 	// The trust bundle should contain the upstream root
 	s.requireBundleRootCAs(fakeUA.X509Root())
 
@@ -298,6 +438,69 @@ func (s *ManagerSuite) TestX509CARotation() {
 	s.Nil(s.nextX509CA())
 }
 
+func (s *ManagerSuite) TestX509CARotationNotifiesCAEvents() {
+	caEventCh := make(chan *notifier.NotifyRequest, 10)
+	s.setNotifier(fakenotifier.New(fakenotifier.Config{
+		OnNotify: func(req *notifier.NotifyRequest) (*notifier.NotifyResponse, error) {
+			if _, ok := req.Event.(*notifier.NotifyRequest_BundleUpdated); !ok {
+				caEventCh <- req
+			}
+			return &notifier.NotifyResponse{}, nil
+		},
+	}))
+	s.initSelfSignedManager()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	go s.m.notifyOnBundleUpdate(ctx)
+
+	x509CAEvent := func(slot *x509CASlot) *notifier.X509CA {
+		return &notifier.X509CA{
+			SlotId:      slot.id,
+			Certificate: slot.x509CA.Certificate.Raw,
+			IssuedAt:    slot.issuedAt.Unix(),
+			ExpiresAt:   slot.x509CA.Certificate.NotAfter.Unix(),
+		}
+	}
+
+	// initialization prepares and activates the first X509CA
+	first := x509CAEvent(s.m.currentX509CA)
+	s.RequireProtoEqual(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaPrepared{
+			X509CaPrepared: &notifier.X509CAPrepared{X509Ca: first},
+		},
+	}, s.waitForCAEvent(caEventCh))
+	s.RequireProtoEqual(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaActivated{
+			X509CaActivated: &notifier.X509CAActivated{X509Ca: first},
+		},
+	}, s.waitForCAEvent(caEventCh))
+
+	// move past the preparation mark to prepare the second X509CA
+	initTime := s.clock.Now()
+	s.setTimeAndRotateX509CA(initTime.Add(prepareAfter + time.Minute))
+	second := x509CAEvent(s.m.nextX509CA)
+	s.RequireProtoEqual(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaPrepared{
+			X509CaPrepared: &notifier.X509CAPrepared{X509Ca: second},
+		},
+	}, s.waitForCAEvent(caEventCh))
+
+	// move past the activation mark to deactivate the first X509CA and
+	// activate the second
+	s.setTimeAndRotateX509CA(initTime.Add(activateAfter + time.Minute))
+	s.RequireProtoEqual(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaDeactivated{
+			X509CaDeactivated: &notifier.X509CADeactivated{X509Ca: first},
+		},
+	}, s.waitForCAEvent(caEventCh))
+	s.RequireProtoEqual(&notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaActivated{
+			X509CaActivated: &notifier.X509CAActivated{X509Ca: second},
+		},
+	}, s.waitForCAEvent(caEventCh))
+}
+
 func (s *ManagerSuite) TestX509CARotationMetric() {
 	s.initSelfSignedManager()
 
@@ -396,6 +599,42 @@ func (s *ManagerSuite) TestJWTKeyRotation() {
 	s.Nil(s.nextJWTKey())
 }
 
+func (s *ManagerSuite) TestSlotStates() {
+	s.initSelfSignedManager()
+
+	// after initialization, the current slots are active and the next slots
+	// are empty.
+	initTime := s.clock.Now()
+	firstX509CA := s.currentX509CA()
+	firstJWTKey := s.currentJWTKey()
+	s.Require().Equal([]SlotState{
+		{ID: "A", Kind: SlotKindX509CA, Status: SlotActive, IssuedAt: initTime, ExpiresAt: firstX509CA.Certificate.NotAfter},
+		{ID: "B", Kind: SlotKindX509CA, Status: SlotEmpty},
+		{ID: "A", Kind: SlotKindJWTKey, Status: SlotActive, IssuedAt: initTime, ExpiresAt: firstJWTKey.NotAfter},
+		{ID: "B", Kind: SlotKindJWTKey, Status: SlotEmpty},
+	}, s.m.SlotStates())
+
+	// move past the preparation mark. the next slots are prepared.
+	s.addTimeAndRotate(prepareAfter + time.Minute)
+	prepareTime := s.clock.Now()
+	s.Require().Equal([]SlotState{
+		{ID: "A", Kind: SlotKindX509CA, Status: SlotActive, IssuedAt: initTime, ExpiresAt: firstX509CA.Certificate.NotAfter},
+		{ID: "B", Kind: SlotKindX509CA, Status: SlotPrepared, IssuedAt: prepareTime, ExpiresAt: s.nextX509CA().Certificate.NotAfter},
+		{ID: "A", Kind: SlotKindJWTKey, Status: SlotActive, IssuedAt: initTime, ExpiresAt: firstJWTKey.NotAfter},
+		{ID: "B", Kind: SlotKindJWTKey, Status: SlotPrepared, IssuedAt: prepareTime, ExpiresAt: s.nextJWTKey().NotAfter},
+	}, s.m.SlotStates())
+
+	// move past the activation mark. the next slots become active and the
+	// previous ones are emptied.
+	s.addTimeAndRotate(activateAfter - prepareAfter)
+	s.Require().Equal([]SlotState{
+		{ID: "B", Kind: SlotKindX509CA, Status: SlotActive, IssuedAt: prepareTime, ExpiresAt: s.currentX509CA().Certificate.NotAfter},
+		{ID: "A", Kind: SlotKindX509CA, Status: SlotEmpty},
+		{ID: "B", Kind: SlotKindJWTKey, Status: SlotActive, IssuedAt: prepareTime, ExpiresAt: s.currentJWTKey().NotAfter},
+		{ID: "A", Kind: SlotKindJWTKey, Status: SlotEmpty},
+	}, s.m.SlotStates())
+}
+
 func (s *ManagerSuite) TestPrune() {
 	notifier, notifyCh := fakenotifier.NotifyWaiter()
 	s.setNotifier(notifier)
@@ -504,6 +743,58 @@ func (s *ManagerSuite) TestRunFailsIfNotifierFails() {
 	s.Equal("Notifier failed to handle event", entry.Message)
 }
 
+func (s *ManagerSuite) TestBundlePublishedOnRunAndUpdate() {
+	publisher := &fakeBundlePublisher{
+		publishedCh: make(chan *common.Bundle, 10),
+	}
+	s.cat.AddBundlePublisher(fakeservercatalog.BundlePublisher("fake", publisher))
+	s.initSelfSignedManager()
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.m.Run(ctx)
+	}()
+
+	// the bundle is published when the manager starts running
+	s.RequireProtoEqual(s.fetchBundle(), s.waitForPublishedBundle(publisher.publishedCh))
+
+	// the bundle is published again when it is updated
+	s.setTimeAndRotateX509CA(s.clock.Now().Add(prepareAfter + time.Minute))
+	s.m.bundleUpdated()
+	s.RequireProtoEqual(s.fetchBundle(), s.waitForPublishedBundle(publisher.publishedCh))
+
+	cancel()
+	s.Require().NoError(<-errCh)
+}
+
+func (s *ManagerSuite) TestBundlePublisherFailureIsLogged() {
+	s.cat.AddBundlePublisher(fakeservercatalog.BundlePublisher("fake", &fakeBundlePublisher{
+		err: errors.New("ohno"),
+	}))
+	s.initSelfSignedManager()
+
+	err := s.m.publishBundle(ctx)
+	s.Require().EqualError(err, "one or more bundle publishers returned an error: ohno")
+
+	entry := s.logHook.LastEntry()
+	s.Equal("fake", entry.Data[telemetry.BundlePublisher])
+	s.Equal("ohno", fmt.Sprintf("%v", entry.Data["error"]))
+	s.Equal("Bundle publisher failed to publish bundle", entry.Message)
+}
+
+func (s *ManagerSuite) waitForPublishedBundle(ch <-chan *common.Bundle) *common.Bundle {
+	select {
+	case <-time.After(time.Minute):
+		s.FailNow("timed out waiting for bundle to be published")
+		return nil
+	case bundle := <-ch:
+		return bundle
+	}
+}
+
 func (s *ManagerSuite) TestPreparationThresholdCap() {
 	issuedAt := time.Now()
 	notAfter := issuedAt.Add(365 * 24 * time.Hour)
@@ -884,15 +1175,33 @@ func (s *ManagerSuite) wipeJournal() {
 }
 
 func (s *ManagerSuite) waitForBundleUpdatedNotification(ch <-chan *notifier.NotifyRequest) {
+	for {
+		select {
+		case <-time.After(time.Minute):
+			s.FailNow("timed out waiting for bundle update notification")
+		case req := <-ch:
+			switch req.Event.(type) {
+			case *notifier.NotifyRequest_X509CaPrepared, *notifier.NotifyRequest_X509CaActivated, *notifier.NotifyRequest_X509CaDeactivated:
+				// CA events are covered by TestX509CARotationNotifiesCAEvents
+				continue
+			}
+			event, ok := req.Event.(*notifier.NotifyRequest_BundleUpdated)
+			s.Require().True(ok, "expected a bundle updated notification")
+			actual := event.BundleUpdated.Bundle
+			expected := s.fetchBundle()
+			s.RequireProtoEqual(expected, actual)
+			return
+		}
+	}
+}
+
+func (s *ManagerSuite) waitForCAEvent(ch <-chan *notifier.NotifyRequest) *notifier.NotifyRequest {
 	select {
 	case <-time.After(time.Minute):
-		s.FailNow("timed out waiting for bundle update notification")
+		s.FailNow("timed out waiting for CA event notification")
+		return nil
 	case req := <-ch:
-		event, ok := req.Event.(*notifier.NotifyRequest_BundleUpdated)
-		s.Require().True(ok, "expected a bundle updated notification")
-		actual := event.BundleUpdated.Bundle
-		expected := s.fetchBundle()
-		s.RequireProtoEqual(expected, actual)
+		return req
 	}
 }
 
@@ -935,3 +1244,16 @@ func (s *fakeCA) SetJWTKey(jwtKey *JWTKey) {
 	defer s.mu.Unlock()
 	s.jwtKey = jwtKey
 }
+
+type fakeBundlePublisher struct {
+	publishedCh chan *common.Bundle
+	err         error
+}
+
+func (p *fakeBundlePublisher) PublishBundle(ctx context.Context, req *bundlepublisher.PublishBundleRequest) (*bundlepublisher.PublishBundleResponse, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	p.publishedCh <- req.Bundle
+	return &bundlepublisher.PublishBundleResponse{}, nil
+}
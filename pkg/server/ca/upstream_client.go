@@ -1,6 +1,7 @@
 package ca
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"io"
@@ -277,7 +278,49 @@ func parseMintX509CAFirstResponse(resp *upstreamauthority.MintX509CAResponse) ([
 	if err != nil {
 		return nil, nil, err
 	}
-	return x509CA, x509Roots, nil
+	return orderX509CAChain(x509CA, x509Roots), x509Roots, nil
+}
+
+// orderX509CAChain orders the X.509 CA chain returned by the upstream
+// authority from the CA certificate up to the last intermediate. The chain is
+// appended to every SVID signed by the CA, so it has to be a path workloads
+// can verify with the trust bundle alone. Upstream authorities are not
+// consistent in what they return, so roots (which workloads already have)
+// and certificates that are not on the path to a root are dropped.
+func orderX509CAChain(chain, roots []*x509.Certificate) []*x509.Certificate {
+	isRoot := func(cert *x509.Certificate) bool {
+		for _, root := range roots {
+			if bytes.Equal(cert.Raw, root.Raw) {
+				return true
+			}
+		}
+		return bytes.Equal(cert.RawIssuer, cert.RawSubject) && cert.CheckSignatureFrom(cert) == nil
+	}
+
+	var remaining []*x509.Certificate
+	for _, cert := range chain[1:] {
+		if !isRoot(cert) {
+			remaining = append(remaining, cert)
+		}
+	}
+
+	ordered := chain[:1:1]
+	for len(remaining) > 0 {
+		current := ordered[len(ordered)-1]
+		issuer := -1
+		for i, cert := range remaining {
+			if bytes.Equal(current.RawIssuer, cert.RawSubject) && current.CheckSignatureFrom(cert) == nil {
+				issuer = i
+				break
+			}
+		}
+		if issuer < 0 {
+			break
+		}
+		ordered = append(ordered, remaining[issuer])
+		remaining = append(remaining[:issuer], remaining[issuer+1:]...)
+	}
+	return ordered
 }
 
 func parseMintX509CABundleUpdate(resp *upstreamauthority.MintX509CAResponse) ([]*x509.Certificate, error) {
@@ -43,6 +43,25 @@ func TestUpstreamClientMintX509CA_HandlesBundleUpdates(t *testing.T) {
 	require.Equal(t, ua.X509Roots(), updater.WaitForAppendedX509Roots(t))
 }
 
+func TestUpstreamClientMintX509CA_OrdersChain(t *testing.T) {
+	var intermediate []byte
+	client, _, _ := setUpUpstreamClientTest(t, fakeupstreamauthority.Config{
+		TrustDomain:     trustDomain,
+		UseIntermediate: true,
+		MutateMintX509CAResponse: func(resp *upstreamauthority.MintX509CAResponse) {
+			// Return the root before the intermediate, as some upstream
+			// authorities do.
+			intermediate = resp.X509CaChain[1]
+			resp.X509CaChain = [][]byte{resp.X509CaChain[0], resp.UpstreamX509Roots[0], intermediate}
+		},
+	})
+
+	x509CA, err := client.MintX509CA(context.Background(), csr, 0)
+	require.NoError(t, err)
+	require.Len(t, x509CA, 2)
+	require.Equal(t, intermediate, x509CA[1].Raw)
+}
+
 func TestUpstreamClientMintX509CA_FailsOnBadFirstResponse(t *testing.T) {
 	for _, tt := range []struct {
 		name   string
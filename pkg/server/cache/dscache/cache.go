@@ -15,6 +15,11 @@ const (
 
 type useCache struct{}
 
+// WithCache returns a context that allows FetchBundle to return a cached
+// bundle, fetched from the datastore at most datastoreCacheExpiry ago or since
+// the bundle was last changed through the cache. It is meant for the paths
+// that fetch bundles on behalf of agents and workloads, which would otherwise
+// hit the datastore on every call.
 func WithCache(ctx context.Context) context.Context {
 	return context.WithValue(ctx, useCache{}, struct{}{})
 }
@@ -31,6 +36,8 @@ type DatastoreCache struct {
 
 	bundlesMu sync.Mutex
 	bundles   map[string]*bundleEntry
+
+	entriesChanged chan struct{}
 }
 
 func New(ds datastore.DataStore, clock clock.Clock) *DatastoreCache {
@@ -38,9 +45,19 @@ func New(ds datastore.DataStore, clock clock.Clock) *DatastoreCache {
 		DataStore: ds,
 		clock:     clock,
 		bundles:   make(map[string]*bundleEntry),
+
+		entriesChanged: make(chan struct{}, 1),
 	}
 }
 
+// EntriesChanged returns a channel that receives a value after registration
+// entries or node selectors are changed through the cache. Changes made while
+// a value is pending are coalesced into it, so the channel is meant for a
+// single receiver that reloads everything it depends on.
+func (ds *DatastoreCache) EntriesChanged() <-chan struct{} {
+	return ds.entriesChanged
+}
+
 func (ds *DatastoreCache) FetchBundle(ctx context.Context, req *datastore.FetchBundleRequest) (*datastore.FetchBundleResponse, error) {
 	ds.bundlesMu.Lock()
 	entry, ok := ds.bundles[req.TrustDomainId]
@@ -102,6 +119,55 @@ func (ds *DatastoreCache) SetBundle(ctx context.Context, req *datastore.SetBundl
 	return
 }
 
+func (ds *DatastoreCache) CreateRegistrationEntry(ctx context.Context, req *datastore.CreateRegistrationEntryRequest) (resp *datastore.CreateRegistrationEntryResponse, err error) {
+	if resp, err = ds.DataStore.CreateRegistrationEntry(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) UpdateRegistrationEntry(ctx context.Context, req *datastore.UpdateRegistrationEntryRequest) (resp *datastore.UpdateRegistrationEntryResponse, err error) {
+	if resp, err = ds.DataStore.UpdateRegistrationEntry(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) DeleteRegistrationEntry(ctx context.Context, req *datastore.DeleteRegistrationEntryRequest) (resp *datastore.DeleteRegistrationEntryResponse, err error) {
+	if resp, err = ds.DataStore.DeleteRegistrationEntry(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) PruneRegistrationEntries(ctx context.Context, req *datastore.PruneRegistrationEntriesRequest) (resp *datastore.PruneRegistrationEntriesResponse, err error) {
+	if resp, err = ds.DataStore.PruneRegistrationEntries(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) SetNodeSelectors(ctx context.Context, req *datastore.SetNodeSelectorsRequest) (resp *datastore.SetNodeSelectorsResponse, err error) {
+	if resp, err = ds.DataStore.SetNodeSelectors(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) DeleteAttestedNode(ctx context.Context, req *datastore.DeleteAttestedNodeRequest) (resp *datastore.DeleteAttestedNodeResponse, err error) {
+	if resp, err = ds.DataStore.DeleteAttestedNode(ctx, req); err == nil {
+		ds.notifyEntriesChanged()
+	}
+	return
+}
+
+func (ds *DatastoreCache) notifyEntriesChanged() {
+	select {
+	case ds.entriesChanged <- struct{}{}:
+	default:
+	}
+}
+
 func (ds *DatastoreCache) invalidateBundleEntry(trustDomainID string) {
 	ds.bundlesMu.Lock()
 	delete(ds.bundles, trustDomainID)
@@ -56,11 +56,12 @@ func TestFetchBundleCache(t *testing.T) {
 	require.NoError(t, err)
 	spiretest.RequireProtoEqual(t, bundle1, resp.Bundle)
 
-	// If caches expires by time, FetchBundle must fetch a fresh bundle
+	// If caches expires by time, FetchBundle must fetch a fresh bundle. The
+	// datastore bumps the sequence number when the bundle changes.
 	clock.Add(datastoreCacheExpiry)
 	resp, err = cache.FetchBundle(ctxWithCache, req)
 	require.NoError(t, err)
-	spiretest.RequireProtoEqual(t, bundle2, resp.Bundle)
+	spiretest.RequireProtoEqual(t, &common.Bundle{TrustDomainId: "spiffe://domain.test", RefreshHint: 2, SequenceNumber: 1}, resp.Bundle)
 
 	// Change bundle
 	_, err = ds.SetBundle(context.Background(), &datastore.SetBundleRequest{
@@ -69,6 +70,7 @@ func TestFetchBundleCache(t *testing.T) {
 	require.NoError(t, err)
 
 	// If a context without cache is used, FetchBundle must fetch a fresh bundle
+	bundle1.SequenceNumber = 2
 	resp, err = cache.FetchBundle(ctxWithoutCache, req)
 	require.NoError(t, err)
 	spiretest.RequireProtoEqual(t, bundle1, resp.Bundle)
@@ -212,12 +214,135 @@ func TestBundleInvalidations(t *testing.T) {
 
 			// If invalidatingFunc succeeds, we invalidate the current cache
 			// value, next call to FetchBundle should return the updated
-			// bundle (bundle2)
+			// bundle (bundle2, as stored by the datastore)
+			expected, err := ds.FetchBundle(context.Background(), req)
+			require.NoError(t, err)
+			spiretest.RequireProtoListEqual(t, bundle2.RootCas, expected.Bundle.RootCas)
 			resp, err := cache.FetchBundle(ctxWithCache, req)
 			require.NoError(t, err)
-			spiretest.RequireProtoEqual(t, bundle2, resp.Bundle)
+			spiretest.RequireProtoEqual(t, expected.Bundle, resp.Bundle)
+		})
+	}
+}
+
+func TestEntriesChanged(t *testing.T) {
+	entry := &common.RegistrationEntry{
+		ParentId:  "spiffe://domain.test/agent",
+		SpiffeId:  "spiffe://domain.test/workload",
+		Selectors: []*common.Selector{{Type: "unix", Value: "uid:1000"}},
+	}
+	nodeSelectors := &datastore.NodeSelectors{
+		SpiffeId:  "spiffe://domain.test/agent",
+		Selectors: []*common.Selector{{Type: "foo", Value: "bar"}},
+	}
+	createEntry := func(t *testing.T, ds datastore.DataStore) *common.RegistrationEntry {
+		resp, err := ds.CreateRegistrationEntry(context.Background(), &datastore.CreateRegistrationEntryRequest{Entry: entry})
+		require.NoError(t, err)
+		return resp.Entry
+	}
+
+	for _, tt := range []struct {
+		name string
+		// change returns a function that changes the datastore through the
+		// cache. Any setup is done directly on the datastore, so it is not
+		// notified.
+		change func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error
+	}{
+		{
+			name: "CreateRegistrationEntry",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				return func(cache *DatastoreCache) error {
+					_, err := cache.CreateRegistrationEntry(context.Background(), &datastore.CreateRegistrationEntryRequest{Entry: entry})
+					return err
+				}
+			},
+		},
+		{
+			name: "UpdateRegistrationEntry",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				created := createEntry(t, ds)
+				return func(cache *DatastoreCache) error {
+					_, err := cache.UpdateRegistrationEntry(context.Background(), &datastore.UpdateRegistrationEntryRequest{Entry: created})
+					return err
+				}
+			},
+		},
+		{
+			name: "DeleteRegistrationEntry",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				created := createEntry(t, ds)
+				return func(cache *DatastoreCache) error {
+					_, err := cache.DeleteRegistrationEntry(context.Background(), &datastore.DeleteRegistrationEntryRequest{EntryId: created.EntryId})
+					return err
+				}
+			},
+		},
+		{
+			name: "PruneRegistrationEntries",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				return func(cache *DatastoreCache) error {
+					_, err := cache.PruneRegistrationEntries(context.Background(), &datastore.PruneRegistrationEntriesRequest{ExpiresBefore: time.Now().Unix()})
+					return err
+				}
+			},
+		},
+		{
+			name: "SetNodeSelectors",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				return func(cache *DatastoreCache) error {
+					_, err := cache.SetNodeSelectors(context.Background(), &datastore.SetNodeSelectorsRequest{Selectors: nodeSelectors})
+					return err
+				}
+			},
+		},
+		{
+			name: "DeleteAttestedNode",
+			change: func(t *testing.T, ds datastore.DataStore) func(*DatastoreCache) error {
+				_, err := ds.CreateAttestedNode(context.Background(), &datastore.CreateAttestedNodeRequest{
+					Node: &common.AttestedNode{SpiffeId: "spiffe://domain.test/agent", CertSerialNumber: "1"},
+				})
+				require.NoError(t, err)
+				return func(cache *DatastoreCache) error {
+					_, err := cache.DeleteAttestedNode(context.Background(), &datastore.DeleteAttestedNodeRequest{SpiffeId: "spiffe://domain.test/agent"})
+					return err
+				}
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			ds := fakedatastore.New(t)
+			cache := New(ds, clock.NewMock(t))
+
+			// Failures do not notify
+			change := tt.change(t, ds)
+			ds.SetNextError(fmt.Errorf("failure"))
+			require.Error(t, change(cache))
+			requireEntriesChanged(t, cache, false)
+
+			require.NoError(t, change(cache))
+			requireEntriesChanged(t, cache, true)
 		})
 	}
+
+	t.Run("notifications are coalesced", func(t *testing.T) {
+		cache := New(fakedatastore.New(t), clock.NewMock(t))
+		for i := 0; i < 3; i++ {
+			_, err := cache.SetNodeSelectors(context.Background(), &datastore.SetNodeSelectorsRequest{Selectors: nodeSelectors})
+			require.NoError(t, err)
+		}
+		requireEntriesChanged(t, cache, true)
+		requireEntriesChanged(t, cache, false)
+	})
+}
+
+func requireEntriesChanged(t *testing.T, cache *DatastoreCache, expected bool) {
+	select {
+	case <-cache.EntriesChanged():
+		require.True(t, expected, "unexpected change notification")
+	default:
+		require.False(t, expected, "expected a change notification")
+	}
 }
 
 // getBundles returns two different bundles with the same trust domain.
@@ -14,6 +14,9 @@ import (
 	datastore_telemetry "github.com/spiffe/spire/pkg/common/telemetry/server/datastore"
 	keymanager_telemetry "github.com/spiffe/spire/pkg/common/telemetry/server/keymanager"
 	"github.com/spiffe/spire/pkg/server/cache/dscache"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
+	bp_aws_s3 "github.com/spiffe/spire/pkg/server/plugin/bundlepublisher/awss3"
+	bp_gcs "github.com/spiffe/spire/pkg/server/plugin/bundlepublisher/gcs"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	ds_sql "github.com/spiffe/spire/pkg/server/plugin/datastore/sql"
 	"github.com/spiffe/spire/pkg/server/plugin/hostservices"
@@ -22,12 +25,14 @@ import (
 	km_memory "github.com/spiffe/spire/pkg/server/plugin/keymanager/memory"
 	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
 	na_aws_iid "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/aws"
+	na_aws_ecs "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/awsecs"
 	na_azure_msi "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/azure"
 	na_gcp_iit "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/gcp"
 	na_join_token "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/jointoken"
 	na_k8s_psat "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/k8s/psat"
 	na_k8s_sat "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/k8s/sat"
 	na_sshpop "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/sshpop"
+	na_vsphere "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/vsphere"
 	na_x509pop "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/x509pop"
 	"github.com/spiffe/spire/pkg/server/plugin/noderesolver"
 	nr_aws_iid "github.com/spiffe/spire/pkg/server/plugin/noderesolver/aws"
@@ -36,6 +41,7 @@ import (
 	"github.com/spiffe/spire/pkg/server/plugin/notifier"
 	no_gcs_bundle "github.com/spiffe/spire/pkg/server/plugin/notifier/gcsbundle"
 	no_k8sbundle "github.com/spiffe/spire/pkg/server/plugin/notifier/k8sbundle"
+	no_webhook "github.com/spiffe/spire/pkg/server/plugin/notifier/webhook"
 	"github.com/spiffe/spire/pkg/server/plugin/upstreamauthority"
 	up_awspca "github.com/spiffe/spire/pkg/server/plugin/upstreamauthority/awspca"
 	up_awssecret "github.com/spiffe/spire/pkg/server/plugin/upstreamauthority/awssecret"
@@ -51,6 +57,7 @@ var (
 		ds_sql.BuiltIn(),
 		// NodeAttestors
 		na_aws_iid.BuiltIn(),
+		na_aws_ecs.BuiltIn(),
 		na_gcp_iit.BuiltIn(),
 		na_x509pop.BuiltIn(),
 		na_sshpop.BuiltIn(),
@@ -58,6 +65,7 @@ var (
 		na_k8s_sat.BuiltIn(),
 		na_k8s_psat.BuiltIn(),
 		na_join_token.BuiltIn(),
+		na_vsphere.BuiltIn(),
 		// NodeResolvers
 		nr_noop.BuiltIn(),
 		nr_aws_iid.BuiltIn(),
@@ -74,6 +82,10 @@ var (
 		// Notifiers
 		no_k8sbundle.BuiltIn(),
 		no_gcs_bundle.BuiltIn(),
+		no_webhook.BuiltIn(),
+		// BundlePublishers
+		bp_aws_s3.BuiltIn(),
+		bp_gcs.BuiltIn(),
 	}
 )
 
@@ -83,6 +95,7 @@ type Catalog interface {
 	GetNodeResolverNamed(name string) (noderesolver.NodeResolver, bool)
 	GetKeyManager() keymanager.KeyManager
 	GetNotifiers() []Notifier
+	GetBundlePublishers() []BundlePublisher
 	GetUpstreamAuthority() (*UpstreamAuthority, bool)
 }
 
@@ -97,6 +110,7 @@ func KnownPlugins() []catalog.PluginClient {
 		upstreamauthority.PluginClient,
 		keymanager.PluginClient,
 		notifier.PluginClient,
+		bundlepublisher.PluginClient,
 	}
 }
 
@@ -118,6 +132,11 @@ type Notifier struct {
 	notifier.Notifier
 }
 
+type BundlePublisher struct {
+	catalog.PluginInfo
+	bundlepublisher.BundlePublisher
+}
+
 type UpstreamAuthority struct {
 	catalog.PluginInfo
 	upstreamauthority.UpstreamAuthority
@@ -132,6 +151,7 @@ type Plugins struct {
 	UpstreamAuthority *UpstreamAuthority
 	KeyManager        keymanager.KeyManager
 	Notifiers         []Notifier
+	BundlePublishers  []BundlePublisher
 }
 
 var _ Catalog = (*Plugins)(nil)
@@ -158,6 +178,10 @@ func (p *Plugins) GetNotifiers() []Notifier {
 	return p.Notifiers
 }
 
+func (p *Plugins) GetBundlePublishers() []BundlePublisher {
+	return p.BundlePublishers
+}
+
 func (p *Plugins) GetUpstreamAuthority() (*UpstreamAuthority, bool) {
 	return p.UpstreamAuthority, p.UpstreamAuthority != nil
 }
@@ -167,6 +191,15 @@ type Config struct {
 	GlobalConfig *GlobalConfig
 	PluginConfig HCLPluginConfigMap
 
+	// BuiltIns are additional built-in plugins made available to the catalog
+	// alongside the ones shipped with SPIRE. They are loaded in-process and,
+	// like any other built-in, are only used if configured in PluginConfig.
+	BuiltIns []catalog.Plugin
+
+	// RequirePluginChecksums, if true, fails loading external plugins that
+	// do not have a checksum configured.
+	RequirePluginChecksums bool
+
 	Metrics          telemetry.Metrics
 	IdentityProvider hostservices.IdentityProviderServer
 	AgentStore       hostservices.AgentStoreServer
@@ -176,31 +209,46 @@ type Config struct {
 type Repository struct {
 	Catalog
 	catalog.Closer
+
+	catalog         catalog.Catalog
+	ds              *ds_sql.Plugin
+	dsCache         *dscache.DatastoreCache
+	dataStoreConfig catalog.PluginConfig
+}
+
+// EntriesChanged returns a channel that receives a value after registration
+// entries or node selectors are changed through the datastore of the catalog.
+// See dscache.DatastoreCache.EntriesChanged.
+func (r *Repository) EntriesChanged() <-chan struct{} {
+	return r.dsCache.EntriesChanged()
 }
 
 func Load(ctx context.Context, config Config) (*Repository, error) {
 	// Strip out the Datastore plugin configuration and load the SQL plugin
 	// directly. This allows us to bypass gRPC and get rid of response limits.
-	dataStoreConfig := config.PluginConfig[datastore.Type]
-	delete(config.PluginConfig, datastore.Type)
+	dataStoreConfig, err := sqlDataStoreConfig(config.PluginConfig[datastore.Type])
+	if err != nil {
+		return nil, err
+	}
 	ds, err := loadSQLDataStore(ctx, config.Log, dataStoreConfig)
 	if err != nil {
 		return nil, err
 	}
 
-	pluginConfigs, err := catalog.PluginConfigsFromHCL(config.PluginConfig)
+	pluginConfigs, err := pluginConfigsWithoutDataStore(config.PluginConfig)
 	if err != nil {
 		return nil, err
 	}
 
 	p := new(Plugins)
-	closer, err := catalog.Fill(ctx, catalog.Config{
-		Log:           config.Log,
-		GlobalConfig:  config.GlobalConfig,
-		PluginConfig:  pluginConfigs,
-		KnownPlugins:  KnownPlugins(),
-		KnownServices: KnownServices(),
-		BuiltIns:      BuiltIns(),
+	cat, err := catalog.Fill(ctx, catalog.Config{
+		Log:              config.Log,
+		GlobalConfig:     config.GlobalConfig,
+		PluginConfig:     pluginConfigs,
+		KnownPlugins:     KnownPlugins(),
+		KnownServices:    KnownServices(),
+		BuiltIns:         append(BuiltIns(), config.BuiltIns...),
+		RequireChecksums: config.RequirePluginChecksums,
 		HostServices: []catalog.HostServiceServer{
 			hostservices.IdentityProviderHostServiceServer(config.IdentityProvider),
 			hostservices.AgentStoreHostServiceServer(config.AgentStore),
@@ -212,38 +260,102 @@ func Load(ctx context.Context, config Config) (*Repository, error) {
 	}
 
 	p.DataStore.DataStore = datastore_telemetry.WithMetrics(ds, config.Metrics)
-	p.DataStore.DataStore = dscache.New(p.DataStore.DataStore, clock.New())
+	dsCache := dscache.New(p.DataStore.DataStore, clock.New())
+	p.DataStore.DataStore = dsCache
 	p.KeyManager = keymanager_telemetry.WithMetrics(p.KeyManager, config.Metrics)
 
 	return &Repository{
-		Catalog: p,
-		Closer:  closer,
+		Catalog:         p,
+		Closer:          cat,
+		catalog:         cat,
+		ds:              ds,
+		dsCache:         dsCache,
+		dataStoreConfig: dataStoreConfig,
 	}, nil
 }
 
-func loadSQLDataStore(ctx context.Context, log logrus.FieldLogger, datastoreConfig map[string]catalog.HCLPluginConfig) (*ds_sql.Plugin, error) {
+// Reconfigure configures the loaded plugins, including the DataStore, with
+// the given plugin configuration. Only the plugins whose configuration data
+// changed are configured again.
+func (r *Repository) Reconfigure(ctx context.Context, pluginConfig HCLPluginConfigMap) error {
+	dataStoreConfig, err := sqlDataStoreConfig(pluginConfig[datastore.Type])
+	if err != nil {
+		return err
+	}
+	pluginConfigs, err := pluginConfigsWithoutDataStore(pluginConfig)
+	if err != nil {
+		return err
+	}
+
+	if dataStoreConfig.Data != r.dataStoreConfig.Data {
+		if _, err := r.ds.Configure(ctx, &spi.ConfigureRequest{
+			Configuration: dataStoreConfig.Data,
+		}); err != nil {
+			return fmt.Errorf("unable to reconfigure datastore: %w", err)
+		}
+		r.dataStoreConfig = dataStoreConfig
+	}
+
+	return r.catalog.Reconfigure(ctx, pluginConfigs)
+}
+
+// Validate checks the plugin configuration without loading any plugin. All
+// of the problems found are returned.
+func Validate(pluginConfig HCLPluginConfigMap) []error {
+	var errs []error
+	if _, err := sqlDataStoreConfig(pluginConfig[datastore.Type]); err != nil {
+		errs = append(errs, err)
+	}
+	if n := pluginConfig.EnabledCount(keymanager.Type); n != 1 {
+		errs = append(errs, fmt.Errorf("expecting exactly one KeyManager plugin; got %d", n))
+	}
+	if n := pluginConfig.EnabledCount(upstreamauthority.Type); n > 1 {
+		errs = append(errs, fmt.Errorf("only one UpstreamAuthority plugin is allowed; got %d", n))
+	}
+
+	pluginConfigs, err := pluginConfigsWithoutDataStore(pluginConfig)
+	if err != nil {
+		return append(errs, err)
+	}
+	return append(errs, catalog.ValidatePluginConfigs(pluginConfigs, KnownPlugins(), BuiltIns())...)
+}
+
+func pluginConfigsWithoutDataStore(pluginConfig HCLPluginConfigMap) ([]catalog.PluginConfig, error) {
+	withoutDataStore := make(HCLPluginConfigMap, len(pluginConfig))
+	for pluginType, pluginsForType := range pluginConfig {
+		if pluginType != datastore.Type {
+			withoutDataStore[pluginType] = pluginsForType
+		}
+	}
+	return catalog.PluginConfigsFromHCL(withoutDataStore)
+}
+
+func sqlDataStoreConfig(datastoreConfig map[string]catalog.HCLPluginConfig) (catalog.PluginConfig, error) {
 	switch {
 	case len(datastoreConfig) == 0:
-		return nil, errors.New("expecting a DataStore plugin")
+		return catalog.PluginConfig{}, errors.New("expecting a DataStore plugin")
 	case len(datastoreConfig) > 1:
-		return nil, errors.New("only one DataStore plugin is allowed")
+		return catalog.PluginConfig{}, errors.New("only one DataStore plugin is allowed")
 	}
 
 	sqlHCLConfig, ok := datastoreConfig[ds_sql.PluginName]
 	if !ok {
-		return nil, fmt.Errorf("pluggability for the DataStore is deprecated; only the built-in %q plugin is supported", ds_sql.PluginName)
+		return catalog.PluginConfig{}, fmt.Errorf("pluggability for the DataStore is deprecated; only the built-in %q plugin is supported", ds_sql.PluginName)
 	}
 
 	sqlConfig, err := catalog.PluginConfigFromHCL(datastore.Type, ds_sql.PluginName, sqlHCLConfig)
 	if err != nil {
-		return nil, err
+		return catalog.PluginConfig{}, err
 	}
 
 	// Is the plugin external?
 	if sqlConfig.Path != "" {
-		return nil, fmt.Errorf("pluggability for the DataStore is deprecated; only the built-in %q plugin is supported", ds_sql.PluginName)
+		return catalog.PluginConfig{}, fmt.Errorf("pluggability for the DataStore is deprecated; only the built-in %q plugin is supported", ds_sql.PluginName)
 	}
+	return sqlConfig, nil
+}
 
+func loadSQLDataStore(ctx context.Context, log logrus.FieldLogger, sqlConfig catalog.PluginConfig) (*ds_sql.Plugin, error) {
 	ds := ds_sql.New()
 	ds.SetLogger(common_log.NewHCLogAdapter(log, telemetry.PluginBuiltIn).Named(sqlConfig.Name))
 	if _, err := ds.Configure(ctx, &spi.ConfigureRequest{
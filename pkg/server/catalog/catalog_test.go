@@ -0,0 +1,112 @@
+package catalog
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/hcl"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	km_memory "github.com/spiffe/spire/pkg/server/plugin/keymanager/memory"
+	"github.com/spiffe/spire/test/fakes/fakemetrics"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoadWithBuiltIns(t *testing.T) {
+	dir := spiretest.TempDir(t)
+
+	var pluginConfig HCLPluginConfigMap
+	require.NoError(t, hcl.Decode(&pluginConfig, fmt.Sprintf(`
+		DataStore "sql" {
+			plugin_data {
+				database_type = "sqlite3"
+				connection_string = %q
+			}
+		}
+		KeyManager "custom" {}
+	`, filepath.Join(dir, "datastore.sqlite3"))))
+
+	custom := km_memory.BuiltIn()
+	custom.Name = "custom"
+
+	load := func(builtIns ...catalog.Plugin) (*Repository, error) {
+		log, _ := test.NewNullLogger()
+		return Load(context.Background(), Config{
+			Log:          log,
+			GlobalConfig: &GlobalConfig{TrustDomain: "example.org"},
+			PluginConfig: pluginConfig,
+			BuiltIns:     builtIns,
+			Metrics:      fakemetrics.New(),
+		})
+	}
+
+	t.Run("unknown plugin without the builtin", func(t *testing.T) {
+		_, err := load()
+		require.Error(t, err)
+		require.Contains(t, err.Error(), `no such KeyManager builtin "custom"`)
+	})
+
+	t.Run("duplicate builtin", func(t *testing.T) {
+		_, err := load(km_memory.BuiltIn())
+		require.EqualError(t, err, `duplicate KeyManager builtin "memory"`)
+	})
+
+	t.Run("success", func(t *testing.T) {
+		repo, err := load(custom)
+		require.NoError(t, err)
+		defer repo.Close()
+		require.NotNil(t, repo.GetKeyManager())
+	})
+}
+
+func TestValidate(t *testing.T) {
+	for _, tt := range []struct {
+		name   string
+		config string
+		expect []string
+	}{
+		{
+			name: "valid",
+			config: `
+				DataStore "sql" {}
+				KeyManager "memory" {}
+				NodeAttestor "join_token" {}
+				UpstreamAuthority "disk" {}
+				Notifier "custom" { plugin_cmd = "/path/to/plugin" }
+			`,
+		},
+		{
+			name: "invalid",
+			config: `
+				KeyManager "memory" {}
+				KeyManager "disk" {}
+				NodeAttestor "unknown" {}
+				UpstreamAuthority "disk" {}
+				UpstreamAuthority "vault" {}
+				Unknown "custom" { plugin_cmd = "/path/to/plugin" }
+			`,
+			expect: []string{
+				"expecting a DataStore plugin",
+				"expecting exactly one KeyManager plugin; got 2",
+				"only one UpstreamAuthority plugin is allowed; got 2",
+				`no such NodeAttestor builtin "unknown"`,
+				`unknown plugin type "Unknown" for plugin "custom"`,
+			},
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var pluginConfig HCLPluginConfigMap
+			require.NoError(t, hcl.Decode(&pluginConfig, tt.config))
+
+			var actual []string
+			for _, err := range Validate(pluginConfig) {
+				actual = append(actual, err.Error())
+			}
+			require.Equal(t, tt.expect, actual)
+		})
+	}
+}
@@ -8,8 +8,12 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	common "github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/diskutil"
+	"github.com/spiffe/spire/pkg/common/fflag"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/server/api"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	bundle_client "github.com/spiffe/spire/pkg/server/bundle/client"
 	"github.com/spiffe/spire/pkg/server/endpoints"
 	"github.com/spiffe/spire/pkg/server/endpoints/bundle"
@@ -20,20 +24,63 @@ type Config struct {
 	// Configurations for server plugins
 	PluginConfigs common.HCLPluginConfigMap
 
+	// BuiltInPlugins are additional plugins that run in-process with the
+	// server, alongside the built-in plugins shipped with SPIRE. This allows
+	// applications embedding the server to provide their own plugins without
+	// an external plugin binary. They must still be enabled in PluginConfigs
+	// by name, without a plugin_cmd.
+	BuiltInPlugins []common.Plugin
+
+	// RequirePluginChecksums, if true, requires a checksum to be configured
+	// for every external plugin.
+	RequirePluginChecksums bool
+
+	// RequireFIPS, if true, fails startup unless the Go cryptographic
+	// libraries run in FIPS 140 mode.
+	RequireFIPS bool
+
 	Log logrus.FieldLogger
 
+	// AuditLog, if set, receives an audit record for each API call. It is
+	// kept apart from Log so audit records can be sent to their own
+	// destinations.
+	AuditLog logrus.FieldLogger
+
 	// Address of SPIRE server
 	BindAddress *net.TCPAddr
 
+	// TLS policy of the TCP listener and the admin TCP listener
+	TLSPolicy endpoints.TLSPolicy
+
+	// Additional addresses, each with its own TLS policy, on which the
+	// agent-facing API is served
+	AdditionalBindAddresses []endpoints.TCPListener
+
+	// GRPC tunes the connections to the TCP listeners
+	GRPC endpoints.GRPCConfig
+
 	// Address of the UDS SPIRE server
 	BindUDSAddress *net.UnixAddr
 
+	// BindUDSPermissions are the file mode and ownership applied to the UDS.
+	// If nil, the default mode is used and the ownership is unchanged.
+	BindUDSPermissions *diskutil.SocketPermissions
+
+	// AdminBindAddress, if set, is the address of an additional TCP
+	// listener serving the management APIs to callers authenticated with an
+	// X509-SVID, e.g. admin IDs
+	AdminBindAddress *net.TCPAddr
+
 	// Directory to store runtime data
 	DataDir string
 
 	// Trust domain
 	TrustDomain spiffeid.TrustDomain
 
+	// AdminIDs are SPIFFE IDs, possibly from federated trust domains, that
+	// are authorized to call the server admin APIs
+	AdminIDs []spiffeid.ID
+
 	Experimental ExperimentalConfig
 
 	// If true enables profiling.
@@ -48,6 +95,9 @@ type Config struct {
 	// Array of profiles names that will be generated on each profiling tick.
 	ProfilingNames []string
 
+	// Directory the profiles generated on each profiling tick are written to.
+	ProfilingDir string
+
 	// SVIDTTL is default time-to-live for SVIDs
 	SVIDTTL time.Duration
 
@@ -55,6 +105,23 @@ type Config struct {
 	// self-signed CA certificates, otherwise it is up to the upstream CA.
 	CATTL time.Duration
 
+	// Backdate is how far in the past the NotBefore of issued SVIDs and
+	// self-signed CA certificates is set, to tolerate clock skew.
+	Backdate time.Duration
+
+	// MaxJWTSVIDTTL, if set, caps the TTL of JWT-SVIDs minted by the server,
+	// whatever TTL is requested.
+	MaxJWTSVIDTTL time.Duration
+
+	// PruneInterval is how often expired and orphaned registration state is
+	// pruned. If zero, the default interval is used.
+	PruneInterval time.Duration
+
+	// OrphanedEntryRetention, if set, is how long registration entries
+	// parented to agents that are no longer attested are kept before they
+	// are pruned. If zero, orphaned entries are kept.
+	OrphanedEntryRetention time.Duration
+
 	// JWTIssuer is used as the issuer claim in JWT-SVIDs minted by the server.
 	// If unset, the JWT-SVID will not have an issuer claim.
 	JWTIssuer string
@@ -71,17 +138,33 @@ type Config struct {
 	// CAKeyType is the key type used for the X509 and JWT signing keys
 	CAKeyType keymanager.KeyType
 
+	// ServerID, if set, is included in the key manager key ids of the CA
+	// keys so servers sharing a key manager do not overwrite each other's
+	// keys.
+	ServerID string
+
 	// Federation holds the configuration needed to federate with other
 	// trust domains.
 	Federation FederationConfig
 
 	// RateLimit holds rate limiting configurations.
 	RateLimit endpoints.RateLimitConfig
+
+	// AuthOpaPolicyEngineConfig, if set, configures a custom authorization
+	// policy for the server APIs. If unset, the default policy is used.
+	AuthOpaPolicyEngineConfig *authpolicy.OpaEngineConfig
+
+	// DNSNamePolicy, if set, restricts the DNS names of registration entries
+	// and X509-SVIDs.
+	DNSNamePolicy *api.DNSNamePolicy
 }
 
 type ExperimentalConfig struct {
 	// Skip agent id validation in node attestation
 	AllowAgentlessNodeAttestors bool
+
+	// FeatureFlags are the names of the feature flags to enable
+	FeatureFlags fflag.RawConfig
 }
 
 type FederationConfig struct {
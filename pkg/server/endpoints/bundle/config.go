@@ -1,6 +1,11 @@
 package bundle
 
-import "net"
+import (
+	"crypto/tls"
+	"fmt"
+	"net"
+	"time"
+)
 
 type EndpointConfig struct {
 	// Address is the address on which to serve the federation bundle endpoint.
@@ -9,4 +14,69 @@ type EndpointConfig struct {
 	// ACME is the ACME configuration for the bundle endpoint.
 	// If unset, the bundle endpoint will use SPIFFE auth.
 	ACME *ACMEConfig
+
+	// RefreshHint is the refresh hint advertised in the served bundle. If
+	// unset, the refresh hint is calculated from the bundle contents.
+	RefreshHint time.Duration
+
+	// TLSPolicy is the TLS policy enforced on connections to the bundle
+	// endpoint.
+	TLSPolicy TLSPolicy
+
+	// AdditionalListeners are additional addresses on which the bundle
+	// endpoint is served, e.g. to serve both IPv4 and IPv6 clients.
+	AdditionalListeners []ListenerConfig
+
+	// AdvertisedAddress is the host:port other trust domains reach the bundle
+	// endpoint at, when it differs from Address, e.g. when the server sits
+	// behind a load balancer.
+	AdvertisedAddress string
+
+	// TrustedProxies are the networks of the proxies in front of the bundle
+	// endpoint. The client address of the requests relayed by these proxies
+	// is taken from the PROXY protocol or X-Forwarded-For header.
+	TrustedProxies []*net.IPNet
+
+	// ProxyProtocol, if true, requires connections from the trusted proxies
+	// to start with a PROXY protocol header.
+	ProxyProtocol bool
+}
+
+// AdvertisedURL returns the URL other trust domains reach the bundle endpoint
+// at. Unless an advertised address is configured, it is derived from the ACME
+// domain name or the address the endpoint is served on.
+func (c *EndpointConfig) AdvertisedURL() string {
+	switch {
+	case c.AdvertisedAddress != "":
+		return fmt.Sprintf("https://%s", c.AdvertisedAddress)
+	case c.ACME != nil:
+		return fmt.Sprintf("https://%s", c.ACME.DomainName)
+	default:
+		return fmt.Sprintf("https://%s", c.Address)
+	}
+}
+
+// ListenerConfig configures an additional listener for the bundle endpoint.
+type ListenerConfig struct {
+	// Address is the address on which to serve the bundle endpoint.
+	Address *net.TCPAddr
+
+	// TLSPolicy is the TLS policy enforced on connections to this listener.
+	TLSPolicy TLSPolicy
+}
+
+// TLSPolicy configures the TLS parameters of the bundle endpoint.
+type TLSPolicy struct {
+	// MinVersion is the minimum TLS version accepted. If unset, TLS 1.2 is
+	// the minimum.
+	MinVersion uint16
+
+	// CipherSuites is the list of enabled cipher suites for TLS 1.2 and
+	// below. If empty, the Go defaults are used.
+	CipherSuites []uint16
+
+	// ClientAuth is the policy for TLS client authentication. When client
+	// certificates are verified, they must chain up to the X.509 authorities
+	// of the trust domain bundle served by the endpoint.
+	ClientAuth tls.ClientAuthType
 }
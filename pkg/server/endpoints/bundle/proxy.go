@@ -0,0 +1,282 @@
+package bundle
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+const (
+	// proxyHeaderTimeout is how long a trusted proxy has to send the PROXY
+	// protocol header once the connection is accepted.
+	proxyHeaderTimeout = 10 * time.Second
+
+	// proxyV1MaxLength is the maximum length of a version 1 header,
+	// including the CRLF.
+	proxyV1MaxLength = 107
+)
+
+var proxyV2Signature = []byte("\r\n\r\n\x00\r\nQUIT\n")
+
+// trustedProxies is the set of networks of the proxies in front of the
+// bundle endpoint.
+type trustedProxies []*net.IPNet
+
+func (t trustedProxies) contains(ip net.IP) bool {
+	for _, network := range t {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
+
+func (t trustedProxies) containsAddr(addr net.Addr) bool {
+	tcpAddr, ok := addr.(*net.TCPAddr)
+	return ok && t.contains(tcpAddr.IP)
+}
+
+// clientAddr returns the address of the client that made the request. For
+// requests relayed by a trusted proxy, it is the right-most address of the
+// X-Forwarded-For header that is not a trusted proxy itself.
+func (t trustedProxies) clientAddr(req *http.Request) string {
+	host, _, err := net.SplitHostPort(req.RemoteAddr)
+	if err != nil || !t.contains(net.ParseIP(host)) {
+		return req.RemoteAddr
+	}
+
+	forwardedFor := strings.Split(strings.Join(req.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwardedFor) - 1; i >= 0; i-- {
+		ip := net.ParseIP(strings.TrimSpace(forwardedFor[i]))
+		if ip == nil {
+			break
+		}
+		if !t.contains(ip) {
+			return ip.String()
+		}
+	}
+	return req.RemoteAddr
+}
+
+// proxyListener reads the PROXY protocol header that trusted proxies send at
+// the start of each connection, so that the remote address of the accepted
+// connections is the address of the client rather than that of the proxy.
+// Headers are read off the accept loop so that a slow proxy does not hold up
+// other connections.
+type proxyListener struct {
+	net.Listener
+	log     logrus.FieldLogger
+	trusted trustedProxies
+
+	conns     chan net.Conn
+	errs      chan error
+	closed    chan struct{}
+	closeOnce sync.Once
+}
+
+func newProxyListener(listener net.Listener, trusted trustedProxies, log logrus.FieldLogger) *proxyListener {
+	l := &proxyListener{
+		Listener: listener,
+		log:      log,
+		trusted:  trusted,
+		conns:    make(chan net.Conn),
+		errs:     make(chan error),
+		closed:   make(chan struct{}),
+	}
+	go l.acceptLoop()
+	return l
+}
+
+func (l *proxyListener) Accept() (net.Conn, error) {
+	select {
+	case conn := <-l.conns:
+		return conn, nil
+	case err := <-l.errs:
+		return nil, err
+	case <-l.closed:
+		return nil, errors.New("use of closed network connection")
+	}
+}
+
+func (l *proxyListener) Close() error {
+	l.closeOnce.Do(func() {
+		close(l.closed)
+	})
+	return l.Listener.Close()
+}
+
+func (l *proxyListener) acceptLoop() {
+	for {
+		conn, err := l.Listener.Accept()
+		if err != nil {
+			select {
+			case l.errs <- err:
+			case <-l.closed:
+				return
+			}
+			if netErr, ok := err.(net.Error); ok && netErr.Temporary() { //nolint:staticcheck
+				continue
+			}
+			return
+		}
+		go l.handle(conn)
+	}
+}
+
+func (l *proxyListener) handle(conn net.Conn) {
+	if l.trusted.containsAddr(conn.RemoteAddr()) {
+		proxied, err := readProxyHeader(conn)
+		if err != nil {
+			l.log.WithError(err).WithField("proxy_addr", conn.RemoteAddr().String()).Warn("Dropping connection with invalid PROXY protocol header")
+			conn.Close()
+			return
+		}
+		conn = proxied
+	}
+
+	select {
+	case l.conns <- conn:
+	case <-l.closed:
+		conn.Close()
+	}
+}
+
+// proxyConn is a connection whose remote address was read from a PROXY
+// protocol header.
+type proxyConn struct {
+	net.Conn
+	r          io.Reader
+	remoteAddr net.Addr
+}
+
+func (c *proxyConn) Read(b []byte) (int, error) {
+	return c.r.Read(b)
+}
+
+func (c *proxyConn) RemoteAddr() net.Addr {
+	return c.remoteAddr
+}
+
+// readProxyHeader reads a version 1 or 2 PROXY protocol header from the
+// connection. The returned connection reports the source address of the
+// header as its remote address, unless the header does not carry one (e.g.
+// health checks by the proxy itself).
+func readProxyHeader(conn net.Conn) (net.Conn, error) {
+	if err := conn.SetReadDeadline(time.Now().Add(proxyHeaderTimeout)); err != nil {
+		return nil, err
+	}
+
+	r := bufio.NewReaderSize(conn, 256)
+	signature, err := r.Peek(len(proxyV2Signature))
+	if err != nil {
+		return nil, fmt.Errorf("unable to read header: %w", err)
+	}
+
+	var remoteAddr net.Addr
+	switch {
+	case bytes.Equal(signature, proxyV2Signature):
+		remoteAddr, err = readProxyV2Header(r)
+	case bytes.HasPrefix(signature, []byte("PROXY ")):
+		remoteAddr, err = readProxyV1Header(r)
+	default:
+		err = errors.New("missing header")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := conn.SetReadDeadline(time.Time{}); err != nil {
+		return nil, err
+	}
+	if remoteAddr == nil {
+		remoteAddr = conn.RemoteAddr()
+	}
+	return &proxyConn{Conn: conn, r: r, remoteAddr: remoteAddr}, nil
+}
+
+func readProxyV1Header(r *bufio.Reader) (net.Addr, error) {
+	var line []byte
+	for !bytes.HasSuffix(line, []byte("\r\n")) {
+		if len(line) >= proxyV1MaxLength {
+			return nil, errors.New("header is too long")
+		}
+		b, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("unable to read header: %w", err)
+		}
+		line = append(line, b)
+	}
+
+	// PROXY <TCP4|TCP6|UNKNOWN> <src ip> <dst ip> <src port> <dst port>
+	fields := strings.Split(strings.TrimSuffix(string(line), "\r\n"), " ")
+	if len(fields) >= 2 && fields[1] == "UNKNOWN" {
+		return nil, nil
+	}
+	if len(fields) != 6 || (fields[1] != "TCP4" && fields[1] != "TCP6") {
+		return nil, fmt.Errorf("malformed header %q", line)
+	}
+	ip := net.ParseIP(fields[2])
+	if ip == nil {
+		return nil, fmt.Errorf("invalid source address %q", fields[2])
+	}
+	port, err := strconv.ParseUint(fields[4], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("invalid source port %q", fields[4])
+	}
+	return &net.TCPAddr{IP: ip, Port: int(port)}, nil
+}
+
+func readProxyV2Header(r *bufio.Reader) (net.Addr, error) {
+	header := make([]byte, len(proxyV2Signature)+4)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("unable to read header: %w", err)
+	}
+	versionCommand := header[12]
+	family := header[13]
+	payload := make([]byte, binary.BigEndian.Uint16(header[14:16]))
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, fmt.Errorf("unable to read header: %w", err)
+	}
+
+	if versionCommand>>4 != 2 {
+		return nil, fmt.Errorf("unsupported version %d", versionCommand>>4)
+	}
+	switch versionCommand & 0xf {
+	case 0x0:
+		// LOCAL: the connection was made by the proxy itself
+		return nil, nil
+	case 0x1:
+		// PROXY
+	default:
+		return nil, fmt.Errorf("unsupported command %d", versionCommand&0xf)
+	}
+
+	var ipLen int
+	switch family {
+	case 0x11: // TCP over IPv4
+		ipLen = net.IPv4len
+	case 0x21: // TCP over IPv6
+		ipLen = net.IPv6len
+	default:
+		// Addresses of other families are not relevant to the endpoint
+		return nil, nil
+	}
+	if len(payload) < 2*ipLen+4 {
+		return nil, errors.New("header addresses are truncated")
+	}
+	return &net.TCPAddr{
+		IP:   net.IP(payload[:ipLen]),
+		Port: int(binary.BigEndian.Uint16(payload[2*ipLen:])),
+	}, nil
+}
@@ -0,0 +1,256 @@
+package bundle
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/sirupsen/logrus"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadProxyHeader(t *testing.T) {
+	v2Header := func(command, family byte, addrs []byte) string {
+		header := append([]byte{}, proxyV2Signature...)
+		header = append(header, 0x20|command, family, 0, byte(len(addrs)))
+		return string(append(header, addrs...))
+	}
+
+	for _, tt := range []struct {
+		name            string
+		header          string
+		expectAddr      string
+		expectErr       string
+		expectUnproxied bool
+	}{
+		{
+			name:       "v1 TCP4",
+			header:     "PROXY TCP4 192.0.2.1 198.51.100.1 56324 443\r\n",
+			expectAddr: "192.0.2.1:56324",
+		},
+		{
+			name:       "v1 TCP6",
+			header:     "PROXY TCP6 2001:db8::1 2001:db8::2 56324 443\r\n",
+			expectAddr: "[2001:db8::1]:56324",
+		},
+		{
+			name:            "v1 UNKNOWN",
+			header:          "PROXY UNKNOWN\r\n",
+			expectUnproxied: true,
+		},
+		{
+			name:      "v1 malformed",
+			header:    "PROXY TCP4 192.0.2.1\r\n",
+			expectErr: `malformed header "PROXY TCP4 192.0.2.1\r\n"`,
+		},
+		{
+			name:      "v1 invalid source address",
+			header:    "PROXY TCP4 192.0.2 198.51.100.1 56324 443\r\n",
+			expectErr: `invalid source address "192.0.2"`,
+		},
+		{
+			name:       "v2 TCP4",
+			header:     v2Header(0x1, 0x11, []byte{192, 0, 2, 1, 198, 51, 100, 1, 0xdc, 0x04, 0x01, 0xbb}),
+			expectAddr: "192.0.2.1:56324",
+		},
+		{
+			name:            "v2 LOCAL",
+			header:          v2Header(0x0, 0x00, nil),
+			expectUnproxied: true,
+		},
+		{
+			name:      "v2 truncated addresses",
+			header:    v2Header(0x1, 0x11, []byte{192, 0, 2, 1}),
+			expectErr: "header addresses are truncated",
+		},
+		{
+			name:      "missing header",
+			header:    "GET / HTTP/1.1\r\n",
+			expectErr: "missing header",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			serverConn, clientConn := net.Pipe()
+			defer serverConn.Close()
+			defer clientConn.Close()
+
+			go func() {
+				_, _ = clientConn.Write([]byte(tt.header + "payload"))
+			}()
+
+			conn, err := readProxyHeader(serverConn)
+			if tt.expectErr != "" {
+				require.EqualError(t, err, tt.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			if tt.expectUnproxied {
+				assert.Equal(t, serverConn.RemoteAddr(), conn.RemoteAddr())
+			} else {
+				assert.Equal(t, tt.expectAddr, conn.RemoteAddr().String())
+			}
+
+			// The data following the header is left to read
+			payload := make([]byte, len("payload"))
+			_, err = conn.Read(payload)
+			require.NoError(t, err)
+			assert.Equal(t, "payload", string(payload))
+		})
+	}
+}
+
+func TestTrustedProxiesClientAddr(t *testing.T) {
+	_, proxies, err := net.ParseCIDR("10.0.0.0/8")
+	require.NoError(t, err)
+	trusted := trustedProxies{proxies}
+
+	for _, tt := range []struct {
+		name          string
+		remoteAddr    string
+		forwardedFor  []string
+		expectAddress string
+	}{
+		{
+			name:          "untrusted peer",
+			remoteAddr:    "192.0.2.1:1234",
+			forwardedFor:  []string{"198.51.100.1"},
+			expectAddress: "192.0.2.1:1234",
+		},
+		{
+			name:          "trusted proxy",
+			remoteAddr:    "10.0.0.1:1234",
+			forwardedFor:  []string{"198.51.100.1"},
+			expectAddress: "198.51.100.1",
+		},
+		{
+			name:          "chain of trusted proxies",
+			remoteAddr:    "10.0.0.1:1234",
+			forwardedFor:  []string{"203.0.113.1, 198.51.100.1", "10.0.0.2"},
+			expectAddress: "198.51.100.1",
+		},
+		{
+			name:          "trusted proxy without header",
+			remoteAddr:    "10.0.0.1:1234",
+			expectAddress: "10.0.0.1:1234",
+		},
+		{
+			name:          "trusted proxy with malformed header",
+			remoteAddr:    "10.0.0.1:1234",
+			forwardedFor:  []string{"unknown"},
+			expectAddress: "10.0.0.1:1234",
+		},
+	} {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			req := &http.Request{
+				RemoteAddr: tt.remoteAddr,
+				Header:     http.Header{"X-Forwarded-For": tt.forwardedFor},
+			}
+			assert.Equal(t, tt.expectAddress, trusted.clientAddr(req))
+		})
+	}
+}
+
+func TestServerProxyProtocol(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+	bundle := bundleutil.New("spiffe://domain.test")
+	bundle.AppendRootCA(serverCert)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(serverCert)
+
+	_, loopback, err := net.ParseCIDR("127.0.0.0/8")
+	require.NoError(t, err)
+
+	log, hook := test.NewNullLogger()
+	log.Level = logrus.DebugLevel
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	addrCh := make(chan net.Addr, 1)
+	server := NewServer(ServerConfig{
+		Log:            log,
+		Address:        "127.0.0.1:0",
+		Getter:         testGetter(bundle),
+		ServerAuth:     testSPIFFEAuth(serverCert, serverKey),
+		TrustedProxies: []*net.IPNet{loopback},
+		ProxyProtocol:  true,
+		listen: func(network, address string) (net.Listener, error) {
+			listener, err := net.Listen(network, address)
+			if err != nil {
+				return nil, err
+			}
+			addrCh <- listener.Addr()
+			return listener, nil
+		},
+	})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.ListenAndServe(ctx)
+	}()
+
+	var addr net.Addr
+	select {
+	case addr = <-addrCh:
+	case err := <-errCh:
+		require.NoError(t, err, "unexpected error while waiting for listener")
+	case <-time.After(time.Minute):
+		require.FailNow(t, "timed out waiting for listener")
+	}
+
+	client := http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: rootCAs,
+			},
+			DialContext: func(ctx context.Context, network, address string) (net.Conn, error) {
+				conn, err := new(net.Dialer).DialContext(ctx, network, address)
+				if err != nil {
+					return nil, err
+				}
+				if _, err := conn.Write([]byte("PROXY TCP4 192.0.2.1 127.0.0.1 56324 443\r\n")); err != nil {
+					conn.Close()
+					return nil, err
+				}
+				return conn, nil
+			},
+		},
+	}
+
+	resp, err := client.Get(fmt.Sprintf("https://%s", addr))
+	require.NoError(t, err)
+	_, _ = ioutil.ReadAll(resp.Body)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	// The client address is taken from the PROXY protocol header
+	entry := hook.LastEntry()
+	require.NotNil(t, entry)
+	assert.Equal(t, "Serving bundle", entry.Message)
+	assert.Equal(t, "192.0.2.1:56324", entry.Data[telemetry.CallerAddr])
+
+	// Connections from trusted proxies without a header are dropped
+	_, err = (&http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{RootCAs: rootCAs},
+		},
+	}).Get(fmt.Sprintf("https://%s", addr))
+	require.Error(t, err)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
@@ -1,14 +1,21 @@
 package bundle
 
 import (
+	"compress/gzip"
 	"context"
+	"crypto/sha256"
 	"crypto/tls"
 	"crypto/x509"
+	"encoding/hex"
 	"net"
 	"net/http"
+	"strings"
+	"time"
 
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/zeebo/errs"
 )
 
@@ -27,10 +34,24 @@ type ServerAuth interface {
 }
 
 type ServerConfig struct {
-	Log        logrus.FieldLogger
-	Address    string
-	Getter     Getter
-	ServerAuth ServerAuth
+	Log         logrus.FieldLogger
+	Address     string
+	Getter      Getter
+	ServerAuth  ServerAuth
+	RefreshHint time.Duration
+	TLSPolicy   TLSPolicy
+
+	// AdditionalListeners are served alongside Address, each with its own
+	// TLS policy.
+	AdditionalListeners []ListenerConfig
+
+	// TrustedProxies are the networks of the proxies in front of the
+	// endpoint. See EndpointConfig.
+	TrustedProxies []*net.IPNet
+
+	// ProxyProtocol, if true, requires connections from the trusted proxies
+	// to start with a PROXY protocol header.
+	ProxyProtocol bool
 
 	// test hooks
 	listen func(network, address string) (net.Listener, error)
@@ -50,21 +71,59 @@ func NewServer(config ServerConfig) *Server {
 }
 
 func (s *Server) ListenAndServe(ctx context.Context) error {
+	tasks := []func(context.Context) error{
+		func(ctx context.Context) error {
+			return s.listenAndServe(ctx, s.c.Address, s.c.TLSPolicy)
+		},
+	}
+	for _, listener := range s.c.AdditionalListeners {
+		listener := listener
+		tasks = append(tasks, func(ctx context.Context) error {
+			return s.listenAndServe(ctx, listener.Address.String(), listener.TLSPolicy)
+		})
+	}
+
+	err := util.RunTasks(ctx, tasks...)
+	if err == context.Canceled {
+		err = nil
+	}
+	return err
+}
+
+func (s *Server) listenAndServe(ctx context.Context, address string, policy TLSPolicy) error {
 	// create the listener explicitly instead of using ListenAndServeTLS since
 	// it gives us the ability to use/inspect an ephemeral port during testing.
-	listener, err := s.c.listen("tcp", s.c.Address)
+	listener, err := s.c.listen("tcp", address)
 	if err != nil {
 		return errs.Wrap(err)
 	}
+	if s.c.ProxyProtocol {
+		listener = newProxyListener(listener, s.c.TrustedProxies, s.c.Log)
+	}
 
-	// Set up the TLS config, setting TLS 1.2 as the minimum.
+	// Set up the TLS config, setting TLS 1.2 as the minimum unless the
+	// policy requires a higher version.
 	tlsConfig := s.c.ServerAuth.GetTLSConfig()
 	tlsConfig.MinVersion = tls.VersionTLS12
+	if policy.MinVersion > tlsConfig.MinVersion {
+		tlsConfig.MinVersion = policy.MinVersion
+	}
+	tlsConfig.CipherSuites = policy.CipherSuites
+	tlsConfig.ClientAuth = policy.ClientAuth
+	if tlsConfig.ClientAuth >= tls.VerifyClientCertIfGiven {
+		tlsConfig.GetConfigForClient = s.getConfigForClient(ctx, tlsConfig)
+	}
 
 	server := &http.Server{
 		Handler:   http.HandlerFunc(s.serveHTTP),
 		TLSConfig: tlsConfig,
 	}
+	if len(tlsConfig.CipherSuites) > 0 {
+		// HTTP/2 mandates cipher suites that may have been left out of the
+		// configured list, which would prevent the server from starting.
+		// The bundle endpoint does not benefit from HTTP/2, so disable it.
+		server.TLSNextProto = make(map[string]func(*http.Server, *tls.Conn, http.Handler))
+	}
 
 	errCh := make(chan error, 1)
 	go func() {
@@ -80,6 +139,29 @@ func (s *Server) ListenAndServe(ctx context.Context) error {
 	}
 }
 
+// getConfigForClient returns a callback that provides the TLS config for
+// each handshake with the X.509 authorities of the current trust domain
+// bundle as the client CAs.
+func (s *Server) getConfigForClient(ctx context.Context, base *tls.Config) func(*tls.ClientHelloInfo) (*tls.Config, error) {
+	return func(*tls.ClientHelloInfo) (*tls.Config, error) {
+		b, err := s.c.Getter.GetBundle(ctx)
+		if err != nil {
+			s.c.Log.WithError(err).Error("Unable to retrieve local bundle to verify client")
+			return nil, err
+		}
+
+		clientCAs := x509.NewCertPool()
+		for _, rootCA := range b.RootCAs() {
+			clientCAs.AddCert(rootCA)
+		}
+
+		tlsConfig := base.Clone()
+		tlsConfig.GetConfigForClient = nil
+		tlsConfig.ClientCAs = clientCAs
+		return tlsConfig, nil
+	}
+}
+
 func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
 	if req.Method != "GET" {
 		http.Error(w, "405 method not allowed", http.StatusMethodNotAllowed)
@@ -90,29 +172,90 @@ func (s *Server) serveHTTP(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 
+	log := s.c.Log.WithField(telemetry.CallerAddr, trustedProxies(s.c.TrustedProxies).clientAddr(req))
+
 	b, err := s.c.Getter.GetBundle(req.Context())
 	if err != nil {
-		s.c.Log.WithError(err).Error("Unable to retrieve local bundle")
+		log.WithError(err).Error("Unable to retrieve local bundle")
 		http.Error(w, "500 unable to retrieve local bundle", http.StatusInternalServerError)
 		return
 	}
 
-	refreshHint := bundleutil.CalculateRefreshHint(b)
+	refreshHint := s.c.RefreshHint
+	if refreshHint == 0 {
+		refreshHint = bundleutil.CalculateRefreshHint(b)
+	}
 
-	// TODO: bundle sequence number?
 	opts := []bundleutil.MarshalOption{
 		bundleutil.OverrideRefreshHint(refreshHint),
 	}
 
 	jsonBytes, err := bundleutil.Marshal(b, opts...)
 	if err != nil {
-		s.c.Log.WithError(err).Error("Unable to marshal local bundle")
+		log.WithError(err).Error("Unable to marshal local bundle")
 		http.Error(w, "500 unable to marshal local bundle", http.StatusInternalServerError)
 		return
 	}
 
+	// The ETag is derived from the served document so pollers can issue
+	// conditional requests and skip the download when nothing has changed.
+	// It is weak since it is shared by the gzip and identity encodings of
+	// the document.
+	etag := computeETag(jsonBytes)
+	log.Debug("Serving bundle")
+	w.Header().Set("ETag", etag)
+	w.Header().Set("Vary", "Accept-Encoding")
+	if etagMatches(req.Header.Get("If-None-Match"), etag) {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+
 	w.Header().Set("Content-Type", "application/json")
-	_, _ = w.Write(jsonBytes)
+	if !acceptsGzip(req.Header.Get("Accept-Encoding")) {
+		_, _ = w.Write(jsonBytes)
+		return
+	}
+
+	w.Header().Set("Content-Encoding", "gzip")
+	gz := gzip.NewWriter(w)
+	_, _ = gz.Write(jsonBytes)
+	_ = gz.Close()
+}
+
+func computeETag(body []byte) string {
+	sum := sha256.Sum256(body)
+	return `W/"` + hex.EncodeToString(sum[:]) + `"`
+}
+
+// etagMatches returns true if the If-None-Match header value matches the
+// given ETag. Weak comparison is used, as described in RFC 7232.
+func etagMatches(ifNoneMatch, etag string) bool {
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+	return false
+}
+
+// acceptsGzip returns true if the Accept-Encoding header value allows for a
+// gzip encoded response.
+func acceptsGzip(acceptEncoding string) bool {
+	for _, coding := range strings.Split(acceptEncoding, ",") {
+		params := strings.Split(coding, ";")
+		if !strings.EqualFold(strings.TrimSpace(params[0]), "gzip") {
+			continue
+		}
+		for _, param := range params[1:] {
+			param = strings.ReplaceAll(param, " ", "")
+			if param == "q=0" || param == "q=0.0" || param == "q=0.00" || param == "q=0.000" {
+				return false
+			}
+		}
+		return true
+	}
+	return false
 }
 
 func chainDER(chain []*x509.Certificate) [][]byte {
@@ -1,6 +1,8 @@
 package bundle
 
 import (
+	"bytes"
+	"compress/gzip"
 	"context"
 	"crypto"
 	"crypto/tls"
@@ -13,6 +15,7 @@ import (
 	"net"
 	"net/http"
 	"net/url"
+	"strings"
 	"testing"
 	"time"
 
@@ -24,6 +27,7 @@ import (
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager/memory"
 	"github.com/spiffe/spire/test/spiretest"
+	"github.com/spiffe/spire/test/testkey"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -41,6 +45,10 @@ func TestServer(t *testing.T) {
 	bundle := bundleutil.New("spiffe://domain.test")
 	bundle.AppendRootCA(serverCert)
 
+	sequencedBundle := bundleutil.New("spiffe://domain.test")
+	sequencedBundle.AppendRootCA(serverCert)
+	sequencedBundle.SetSequenceNumber(3)
+
 	// even though this will be SPIFFE authentication in production, there is
 	// no functional change in the code based on the server certificate
 	// returned from the getter, so for test purposes we'll just use a
@@ -56,14 +64,15 @@ func TestServer(t *testing.T) {
 	}
 
 	testCases := []struct {
-		name       string
-		method     string
-		path       string
-		status     int
-		body       string
-		bundle     *bundleutil.Bundle
-		serverCert *x509.Certificate
-		reqErr     string
+		name        string
+		method      string
+		path        string
+		status      int
+		body        string
+		bundle      *bundleutil.Bundle
+		serverCert  *x509.Certificate
+		refreshHint time.Duration
+		reqErr      string
 	}{
 		{
 			name:   "success",
@@ -86,6 +95,29 @@ func TestServer(t *testing.T) {
 			bundle:     bundle,
 			serverCert: serverCert,
 		},
+		{
+			name:   "success with sequence number and configured refresh hint",
+			method: "GET",
+			path:   "/",
+			status: http.StatusOK,
+			body: fmt.Sprintf(`{
+				"keys": [
+					{
+						"crv":"P-256",
+						"kty":"EC",
+						"use":"x509-svid",
+						"x":"kkEn5E2Hd_rvCRDCVMNj3deN0ADij9uJVmN-El0CJz0",
+						"y":"qNrnjhtzrtTR0bRgI2jPIC1nEgcWNX63YcZOEzyo1iA",
+						"x5c": [%q]
+					}
+				],
+				"spiffe_refresh_hint": 600,
+				"spiffe_sequence": 3
+			}`, base64.StdEncoding.EncodeToString(serverCert.Raw)),
+			bundle:      sequencedBundle,
+			serverCert:  serverCert,
+			refreshHint: 10 * time.Minute,
+		},
 		{
 			name:       "invalid method",
 			method:     "POST",
@@ -119,10 +151,11 @@ func TestServer(t *testing.T) {
 	for _, testCase := range testCases {
 		testCase := testCase
 		t.Run(testCase.name, func(t *testing.T) {
-			addr, done := newTestServer(t,
-				testGetter(testCase.bundle),
-				testSPIFFEAuth(testCase.serverCert, serverKey),
-			)
+			addr, done := newTestServer(t, ServerConfig{
+				Getter:      testGetter(testCase.bundle),
+				ServerAuth:  testSPIFFEAuth(testCase.serverCert, serverKey),
+				RefreshHint: testCase.refreshHint,
+			})
 			defer done()
 
 			// form and make the request
@@ -151,6 +184,271 @@ func TestServer(t *testing.T) {
 	}
 }
 
+func TestServerConditionalAndCompressedResponses(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+
+	bundle := bundleutil.New("spiffe://domain.test")
+	bundle.AppendRootCA(serverCert)
+	expectedBody, err := bundleutil.Marshal(bundle, bundleutil.OverrideRefreshHint(bundleutil.CalculateRefreshHint(bundle)))
+	require.NoError(t, err)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(serverCert)
+	client := http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs: rootCAs,
+			},
+			// handle the encoding explicitly in the test
+			DisableCompression: true,
+		},
+	}
+
+	addr, done := newTestServer(t, ServerConfig{
+		Getter:     testGetter(bundle),
+		ServerAuth: testSPIFFEAuth(serverCert, serverKey),
+	})
+	defer done()
+
+	get := func(header http.Header) (*http.Response, []byte) {
+		req, err := http.NewRequest("GET", fmt.Sprintf("https://%s", addr), nil)
+		require.NoError(t, err)
+		req.Header = header
+		resp, err := client.Do(req)
+		require.NoError(t, err)
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		require.NoError(t, err)
+		return resp, body
+	}
+
+	// uncompressed response carrying an ETag
+	resp, body := get(http.Header{})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("Content-Encoding"))
+	require.Equal(t, expectedBody, body)
+	etag := resp.Header.Get("ETag")
+	require.True(t, strings.HasPrefix(etag, `W/"`), "ETag %q is not weak", etag)
+
+	// gzip compressed response
+	resp, body = get(http.Header{"Accept-Encoding": {"deflate, gzip"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, "gzip", resp.Header.Get("Content-Encoding"))
+	require.Equal(t, etag, resp.Header.Get("ETag"))
+	gz, err := gzip.NewReader(bytes.NewReader(body))
+	require.NoError(t, err)
+	uncompressed, err := ioutil.ReadAll(gz)
+	require.NoError(t, err)
+	require.Equal(t, expectedBody, uncompressed)
+
+	// gzip explicitly refused
+	resp, body = get(http.Header{"Accept-Encoding": {"gzip;q=0"}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Empty(t, resp.Header.Get("Content-Encoding"))
+	require.Equal(t, expectedBody, body)
+
+	// unchanged bundle
+	resp, body = get(http.Header{"If-None-Match": {etag}})
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+	require.Equal(t, etag, resp.Header.Get("ETag"))
+	require.Empty(t, body)
+
+	// unchanged bundle with a strong validator among others
+	resp, _ = get(http.Header{"If-None-Match": {`"other", ` + strings.TrimPrefix(etag, "W/")}})
+	require.Equal(t, http.StatusNotModified, resp.StatusCode)
+
+	// changed bundle
+	resp, body = get(http.Header{"If-None-Match": {`"other"`}})
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+	require.Equal(t, expectedBody, body)
+}
+
+func TestServerTLSPolicy(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+	untrustedKey := testkey.NewEC256(t)
+	untrustedCert := spiretest.SelfSignCertificateWithKey(t, &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		NotAfter:     time.Now().Add(serverCertLifetime),
+	}, untrustedKey)
+
+	// the server certificate is also used as the client certificate since
+	// it is self-signed and part of the bundle.
+	bundle := bundleutil.New("spiffe://domain.test")
+	bundle.AppendRootCA(serverCert)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(serverCert)
+
+	clientCert := tls.Certificate{
+		Certificate: [][]byte{serverCert.Raw},
+		PrivateKey:  serverKey,
+	}
+	untrustedClientCert := tls.Certificate{
+		Certificate: [][]byte{untrustedCert.Raw},
+		PrivateKey:  untrustedKey,
+	}
+
+	testCases := []struct {
+		name         string
+		tlsPolicy    TLSPolicy
+		clientConfig *tls.Config
+		expectErr    bool
+		reqErr       string
+	}{
+		{
+			name:         "default policy",
+			clientConfig: &tls.Config{MaxVersion: tls.VersionTLS12},
+		},
+		{
+			name:         "minimum version not met",
+			tlsPolicy:    TLSPolicy{MinVersion: tls.VersionTLS13},
+			clientConfig: &tls.Config{MaxVersion: tls.VersionTLS12},
+			expectErr:    true,
+			reqErr:       "remote error: tls: protocol version not supported",
+		},
+		{
+			name:         "minimum version met",
+			tlsPolicy:    TLSPolicy{MinVersion: tls.VersionTLS13},
+			clientConfig: &tls.Config{},
+		},
+		{
+			name: "cipher suite not enabled",
+			tlsPolicy: TLSPolicy{
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_256_GCM_SHA384},
+			},
+			clientConfig: &tls.Config{
+				MaxVersion:   tls.VersionTLS12,
+				CipherSuites: []uint16{tls.TLS_ECDHE_ECDSA_WITH_AES_128_GCM_SHA256},
+			},
+			expectErr: true,
+		},
+		{
+			name:         "client certificate required but not provided",
+			tlsPolicy:    TLSPolicy{ClientAuth: tls.RequireAndVerifyClientCert},
+			clientConfig: &tls.Config{},
+			expectErr:    true,
+		},
+		{
+			name:         "client certificate not trusted",
+			tlsPolicy:    TLSPolicy{ClientAuth: tls.RequireAndVerifyClientCert},
+			clientConfig: &tls.Config{Certificates: []tls.Certificate{untrustedClientCert}},
+			expectErr:    true,
+			reqErr:       "remote error: tls: unknown certificate authority",
+		},
+		{
+			name:         "client certificate trusted",
+			tlsPolicy:    TLSPolicy{ClientAuth: tls.RequireAndVerifyClientCert},
+			clientConfig: &tls.Config{Certificates: []tls.Certificate{clientCert}},
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+		t.Run(testCase.name, func(t *testing.T) {
+			addr, done := newTestServer(t, ServerConfig{
+				Getter:     testGetter(bundle),
+				ServerAuth: testSPIFFEAuth(serverCert, serverKey),
+				TLSPolicy:  testCase.tlsPolicy,
+			})
+			defer done()
+
+			clientConfig := testCase.clientConfig
+			clientConfig.RootCAs = rootCAs
+			client := http.Client{
+				Transport: &http.Transport{
+					TLSClientConfig: clientConfig,
+				},
+			}
+
+			resp, err := client.Get(fmt.Sprintf("https://%s", addr))
+			if testCase.expectErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), testCase.reqErr)
+				return
+			}
+			require.NoError(t, err)
+			resp.Body.Close()
+			require.Equal(t, http.StatusOK, resp.StatusCode)
+		})
+	}
+}
+
+func TestServerAdditionalListeners(t *testing.T) {
+	serverCert, serverKey := createServerCertificate(t)
+	bundle := bundleutil.New("spiffe://domain.test")
+	bundle.AppendRootCA(serverCert)
+
+	rootCAs := x509.NewCertPool()
+	rootCAs.AddCert(serverCert)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// Record the ephemeral address each listener is bound to, keyed by the
+	// requested address.
+	addrCh := make(chan [2]string, 2)
+	log, _ := test.NewNullLogger()
+	server := NewServer(ServerConfig{
+		Log:        log,
+		Address:    "localhost:0",
+		Getter:     testGetter(bundle),
+		ServerAuth: testSPIFFEAuth(serverCert, serverKey),
+		AdditionalListeners: []ListenerConfig{
+			{
+				Address:   &net.TCPAddr{IP: net.IPv4(127, 0, 0, 1)},
+				TLSPolicy: TLSPolicy{MinVersion: tls.VersionTLS13},
+			},
+		},
+		listen: func(network, address string) (net.Listener, error) {
+			listener, err := net.Listen(network, address)
+			if err != nil {
+				return nil, err
+			}
+			addrCh <- [2]string{address, listener.Addr().String()}
+			return listener, nil
+		},
+	})
+
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- server.ListenAndServe(ctx)
+	}()
+
+	addrs := make(map[string]string)
+	for len(addrs) < 2 {
+		select {
+		case addr := <-addrCh:
+			addrs[addr[0]] = addr[1]
+		case err := <-errCh:
+			require.NoError(t, err, "unexpected error while waiting for listeners")
+		case <-time.After(time.Minute):
+			require.FailNow(t, "timed out waiting for listeners")
+		}
+	}
+
+	// Each listener enforces its own TLS policy
+	client := http.Client{
+		Transport: &http.Transport{
+			TLSClientConfig: &tls.Config{
+				RootCAs:    rootCAs,
+				MaxVersion: tls.VersionTLS12,
+			},
+		},
+	}
+
+	resp, err := client.Get(fmt.Sprintf("https://%s", addrs["localhost:0"]))
+	require.NoError(t, err)
+	resp.Body.Close()
+	require.Equal(t, http.StatusOK, resp.StatusCode)
+
+	_, err = client.Get(fmt.Sprintf("https://%s", addrs["127.0.0.1:0"]))
+	require.Error(t, err)
+	require.Contains(t, err.Error(), "remote error: tls: protocol version not supported")
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
+
 func TestACMEAuth(t *testing.T) {
 	dir := spiretest.TempDir(t)
 
@@ -173,15 +471,16 @@ func TestACMEAuth(t *testing.T) {
 	// configurable to be set in order to funcion.
 	t.Run("new-account-tos-not-accepted", func(t *testing.T) {
 		log, hook := test.NewNullLogger()
-		addr, done := newTestServer(t, testGetter(bundle),
-			ACMEAuth(log, km, ACMEConfig{
+		addr, done := newTestServer(t, ServerConfig{
+			Getter: testGetter(bundle),
+			ServerAuth: ACMEAuth(log, km, ACMEConfig{
 				DirectoryURL: ca.URL,
 				DomainName:   "domain.test",
 				CacheDir:     dir,
 				Email:        "admin@domain.test",
 				ToSAccepted:  false,
 			}),
-		)
+		})
 		defer done()
 
 		ca.Resolve("domain.test", addr.String())
@@ -205,15 +504,16 @@ func TestACMEAuth(t *testing.T) {
 	// Perform the initial challenge to obtain a new certificate.
 	t.Run("initial", func(t *testing.T) {
 		log, hook := test.NewNullLogger()
-		addr, done := newTestServer(t, testGetter(bundle),
-			ACMEAuth(log, km, ACMEConfig{
+		addr, done := newTestServer(t, ServerConfig{
+			Getter: testGetter(bundle),
+			ServerAuth: ACMEAuth(log, km, ACMEConfig{
 				DirectoryURL: ca.URL,
 				DomainName:   "domain.test",
 				CacheDir:     dir,
 				Email:        "admin@domain.test",
 				ToSAccepted:  true,
 			}),
-		)
+		})
 		defer done()
 
 		ca.Resolve("domain.test", addr.String())
@@ -248,15 +548,16 @@ func TestACMEAuth(t *testing.T) {
 	// as a way of telling that the challenge was not attempted
 	t.Run("cached", func(t *testing.T) {
 		log, _ := test.NewNullLogger()
-		addr, done := newTestServer(t, testGetter(bundle),
-			ACMEAuth(log, km, ACMEConfig{
+		addr, done := newTestServer(t, ServerConfig{
+			Getter: testGetter(bundle),
+			ServerAuth: ACMEAuth(log, km, ACMEConfig{
 				DirectoryURL: ca.URL,
 				DomainName:   "domain.test",
 				CacheDir:     dir,
 				Email:        "admin@domain.test",
 				ToSAccepted:  true,
 			}),
-		)
+		})
 		defer done()
 
 		ca.Resolve("domain.test", "127.0.0.1:0")
@@ -267,7 +568,7 @@ func TestACMEAuth(t *testing.T) {
 	})
 }
 
-func newTestServer(t *testing.T, getter Getter, serverAuth ServerAuth) (net.Addr, func()) {
+func newTestServer(t *testing.T, config ServerConfig) (net.Addr, func()) {
 	ctx, cancel := context.WithCancel(context.Background())
 
 	addrCh := make(chan net.Addr, 1)
@@ -281,13 +582,10 @@ func newTestServer(t *testing.T, getter Getter, serverAuth ServerAuth) (net.Addr
 	}
 
 	log, _ := test.NewNullLogger()
-	server := NewServer(ServerConfig{
-		Log:        log,
-		Address:    "localhost:0",
-		Getter:     getter,
-		ServerAuth: serverAuth,
-		listen:     listen,
-	})
+	config.Log = log
+	config.Address = "localhost:0"
+	config.listen = listen
+	server := NewServer(config)
 
 	errCh := make(chan error, 1)
 	go func() {
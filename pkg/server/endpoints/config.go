@@ -2,6 +2,7 @@ package endpoints
 
 import (
 	"crypto"
+	"crypto/tls"
 	"crypto/x509"
 	"errors"
 	"net"
@@ -11,6 +12,7 @@ import (
 	"github.com/sirupsen/logrus"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
 	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/diskutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/server/api"
 	agentv1 "github.com/spiffe/spire/pkg/server/api/agent/v1"
@@ -18,6 +20,8 @@ import (
 	debugv1 "github.com/spiffe/spire/pkg/server/api/debug/v1"
 	entryv1 "github.com/spiffe/spire/pkg/server/api/entry/v1"
 	svidv1 "github.com/spiffe/spire/pkg/server/api/svid/v1"
+	trustdomainv1 "github.com/spiffe/spire/pkg/server/api/trustdomain/v1"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/catalog"
@@ -29,20 +33,108 @@ import (
 	"golang.org/x/net/context"
 )
 
+// TCPListener configures an additional TCP listener for the agent-facing API.
+type TCPListener struct {
+	// Address is the address to bind the listener to.
+	Address *net.TCPAddr
+
+	// TLSPolicy is the TLS policy enforced on connections to the listener.
+	TLSPolicy TLSPolicy
+}
+
+// TLSPolicy configures the TLS parameters of a TCP listener.
+type TLSPolicy struct {
+	// MinVersion is the minimum TLS version accepted. If unset, TLS 1.2 is
+	// the minimum.
+	MinVersion uint16
+
+	// CipherSuites is the list of enabled cipher suites for TLS 1.2 and
+	// below. If empty, the Go defaults are used.
+	CipherSuites []uint16
+}
+
+func (p TLSPolicy) apply(config *tls.Config) {
+	if p.MinVersion > config.MinVersion {
+		config.MinVersion = p.MinVersion
+	}
+	if len(p.CipherSuites) > 0 {
+		config.CipherSuites = p.CipherSuites
+	}
+}
+
+// GRPCConfig tunes the connections to the TCP listeners. Zero values leave
+// the defaults in place.
+type GRPCConfig struct {
+	// KeepaliveTime is the duration of inactivity after which the server
+	// pings the client to check that the connection is still alive.
+	KeepaliveTime time.Duration
+
+	// KeepaliveTimeout is how long the server waits for a ping to be
+	// acknowledged before closing the connection.
+	KeepaliveTimeout time.Duration
+
+	// KeepaliveMinTime is the minimum interval clients are allowed to send
+	// keepalive pings at. Clients pinging more often are disconnected.
+	KeepaliveMinTime time.Duration
+
+	// PermitKeepaliveWithoutStream allows clients to send keepalive pings
+	// when there are no active streams on the connection.
+	PermitKeepaliveWithoutStream bool
+
+	// MaxConnectionAge is the maximum amount of time an agent connection may
+	// exist before the server sends a hangup request. Defaults to three
+	// minutes.
+	MaxConnectionAge time.Duration
+
+	// MaxConcurrentStreams is the maximum number of concurrent streams per
+	// connection.
+	MaxConcurrentStreams uint32
+
+	// ReflectionEnabled serves the gRPC server reflection service on the UDS
+	// and admin TCP listeners, so tools like grpcurl can discover the APIs
+	// without the protobuf definitions.
+	ReflectionEnabled bool
+}
+
 // Config is a configuration for endpoints
 type Config struct {
 	// TPCAddr is the address to bind the TCP listener to.
 	TCPAddr *net.TCPAddr
 
+	// TLSPolicy is the TLS policy enforced on connections to the TCP
+	// listener and the admin TCP listener.
+	TLSPolicy TLSPolicy
+
+	// AdditionalTCPListeners are additional addresses on which the
+	// agent-facing API is served alongside TCPAddr, e.g. to serve both IPv4
+	// and IPv6 agents or agents on different interfaces.
+	AdditionalTCPListeners []TCPListener
+
+	// GRPC tunes the connections to the TCP listeners.
+	GRPC GRPCConfig
+
 	// UDSAddr is the address to bind the UDS listener to.
 	UDSAddr *net.UnixAddr
 
+	// UDSPermissions are the file mode and ownership applied to the UDS. If
+	// nil, the UDS is only accessible to the user and group of the server.
+	UDSPermissions *diskutil.SocketPermissions
+
+	// AdminTCPAddr, if set, is the address to bind an additional TCP
+	// listener to that serves the management APIs to callers authenticated
+	// with an X509-SVID.
+	AdminTCPAddr *net.TCPAddr
+
 	// The svid rotator used to obtain the latest server credentials
 	SVIDObserver svid.Observer
 
 	// The server's configured trust domain. Used for validation, server SVID, etc.
 	TrustDomain spiffeid.TrustDomain
 
+	// AdminIDs are SPIFFE IDs, possibly from federated trust domains, that
+	// are authorized as admins when calling the server APIs over mTLS.
+	AdminIDs []spiffeid.ID
+
 	// Plugin catalog
 	Catalog catalog.Catalog
 
@@ -52,20 +144,42 @@ type Config struct {
 	// Allow agentless spiffeIds when doing node attestation
 	AllowAgentlessNodeAttestors bool
 
+	// DNSNamePolicy, if set, restricts the DNS names of registration entries
+	DNSNamePolicy *api.DNSNamePolicy
+
 	// Bundle endpoint configuration
 	BundleEndpoint bundle.EndpointConfig
 
 	// CA Manager
 	Manager *ca.Manager
 
+	// Bundle refresher used to refresh federated bundles on demand
+	BundleRefresher trustdomainv1.BundleRefresher
+
+	// Federation reporter used to report the health of the federation
+	// relationships
+	FederationReporter debugv1.FederationReporter
+
 	Log     logrus.FieldLogger
 	Metrics telemetry.Metrics
 
+	// AuditLog, if set, receives an audit record for each API call
+	AuditLog logrus.FieldLogger
+
 	// RateLimit holds rate limiting configurations.
 	RateLimit RateLimitConfig
 
+	// AuthPolicyEngine decides which callers are authorized to call the
+	// server APIs.
+	AuthPolicyEngine *authpolicy.Engine
+
 	Uptime func() time.Duration
 
+	// EntriesChanged receives a value after registration entries or node
+	// selectors are changed, which triggers a rebuild of the in-memory entry
+	// cache. If nil, the cache is only rebuilt periodically.
+	EntriesChanged <-chan struct{}
+
 	Clock clock.Clock
 }
 
@@ -76,6 +190,8 @@ func (c *Config) makeOldAPIServers() (OldAPIServers, error) {
 		Catalog:     c.Catalog,
 		TrustDomain: c.TrustDomain,
 		ServerCA:    c.ServerCA,
+
+		DNSNamePolicy: c.DNSNamePolicy,
 	}
 
 	nodeHandler, err := node.NewHandler(node.HandlerConfig{
@@ -103,6 +219,10 @@ func (c *Config) maybeMakeBundleEndpointServer() Server {
 		return nil
 	}
 	c.Log.WithField("addr", c.BundleEndpoint.Address).Info("Serving bundle endpoint")
+	for _, listener := range c.BundleEndpoint.AdditionalListeners {
+		c.Log.WithField("addr", listener.Address).Info("Serving bundle endpoint")
+	}
+	c.Log.WithField("url", c.BundleEndpoint.AdvertisedURL()).Info("Bundle endpoint advertised")
 
 	var serverAuth bundle.ServerAuth
 	if c.BundleEndpoint.ACME != nil {
@@ -130,7 +250,13 @@ func (c *Config) maybeMakeBundleEndpointServer() Server {
 			}
 			return bundleutil.BundleFromProto(resp.Bundle)
 		}),
-		ServerAuth: serverAuth,
+		ServerAuth:  serverAuth,
+		RefreshHint: c.BundleEndpoint.RefreshHint,
+		TLSPolicy:   c.BundleEndpoint.TLSPolicy,
+
+		AdditionalListeners: c.BundleEndpoint.AdditionalListeners,
+		TrustedProxies:      c.BundleEndpoint.TrustedProxies,
+		ProxyProtocol:       c.BundleEndpoint.ProxyProtocol,
 	})
 }
 
@@ -138,6 +264,19 @@ func (c *Config) makeAPIServers(entryFetcher api.AuthorizedEntryFetcher) APIServ
 	ds := c.Catalog.GetDataStore()
 	upstreamPublisher := UpstreamPublisher(c.Manager)
 
+	// Avoid a non-nil interface holding a nil manager
+	var caSlots debugv1.CASlotReporter
+	var caJournal debugv1.CAJournal
+	if c.Manager != nil {
+		caSlots = c.Manager
+		caJournal = c.Manager
+	}
+
+	var bundleEndpointURL string
+	if c.BundleEndpoint.Address != nil {
+		bundleEndpointURL = c.BundleEndpoint.AdvertisedURL()
+	}
+
 	return APIServers{
 		AgentServer: agentv1.New(agentv1.Config{
 			DataStore:   ds,
@@ -150,11 +289,14 @@ func (c *Config) makeAPIServers(entryFetcher api.AuthorizedEntryFetcher) APIServ
 			TrustDomain:       c.TrustDomain,
 			DataStore:         ds,
 			UpstreamPublisher: upstreamPublisher,
+			Clock:             c.Clock,
+			RefreshReporter:   c.FederationReporter,
 		}),
 		EntryServer: entryv1.New(entryv1.Config{
-			TrustDomain:  c.TrustDomain,
-			DataStore:    ds,
-			EntryFetcher: entryFetcher,
+			TrustDomain:   c.TrustDomain,
+			DataStore:     ds,
+			EntryFetcher:  entryFetcher,
+			DNSNamePolicy: c.DNSNamePolicy,
 		}),
 		SVIDServer: svidv1.New(svidv1.Config{
 			TrustDomain:  c.TrustDomain,
@@ -163,11 +305,20 @@ func (c *Config) makeAPIServers(entryFetcher api.AuthorizedEntryFetcher) APIServ
 			DataStore:    ds,
 		}),
 		DebugServer: debugv1.New(debugv1.Config{
-			TrustDomain:  c.TrustDomain,
-			Clock:        c.Clock,
-			DataStore:    ds,
-			SVIDObserver: c.SVIDObserver,
-			Uptime:       c.Uptime,
+			TrustDomain:       c.TrustDomain,
+			Clock:             c.Clock,
+			DataStore:         ds,
+			SVIDObserver:      c.SVIDObserver,
+			Uptime:            c.Uptime,
+			CASlots:           caSlots,
+			CAJournal:         caJournal,
+			Federation:        c.FederationReporter,
+			BundleEndpointURL: bundleEndpointURL,
+		}),
+		TrustDomainServer: trustdomainv1.New(trustdomainv1.Config{
+			TrustDomain:     c.TrustDomain,
+			DataStore:       ds,
+			BundleRefresher: c.BundleRefresher,
 		}),
 	}
 }
@@ -15,14 +15,19 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
 
 	"github.com/andres-erbsen/clock"
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/bundle/x509bundle"
 	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	"github.com/spiffe/spire/pkg/common/auth"
+	"github.com/spiffe/spire/pkg/common/diskutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/util"
 	"github.com/spiffe/spire/pkg/server/api/middleware"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	datastore_pb "github.com/spiffe/spire/pkg/server/plugin/datastore"
@@ -34,6 +39,7 @@ import (
 	debugv1_pb "github.com/spiffe/spire/proto/spire/api/server/debug/v1"
 	entryv1_pb "github.com/spiffe/spire/proto/spire/api/server/entry/v1"
 	svidv1_pb "github.com/spiffe/spire/proto/spire/api/server/svid/v1"
+	trustdomainv1_pb "github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
 )
 
 // This is the maximum amount of time an agent connection may exist before
@@ -41,6 +47,9 @@ import (
 // route to the server in the case of a change in DNS membership.
 const defaultMaxConnectionAge = 3 * time.Minute
 
+// DefaultUDSMode is the file mode of the UDS unless configured otherwise.
+const DefaultUDSMode os.FileMode = 0770
+
 // Server manages gRPC and HTTP endpoint lifecycle
 type Server interface {
 	// ListenAndServe starts all endpoint servers and blocks until the context
@@ -54,15 +63,23 @@ type Endpoints struct {
 	OldAPIServers
 
 	TCPAddr                      *net.TCPAddr
+	TLSPolicy                    TLSPolicy
+	AdditionalTCPListeners       []TCPListener
+	GRPC                         GRPCConfig
 	UDSAddr                      *net.UnixAddr
+	UDSPermissions               *diskutil.SocketPermissions
+	AdminTCPAddr                 *net.TCPAddr
 	SVIDObserver                 svid.Observer
 	TrustDomain                  spiffeid.TrustDomain
+	AdminIDs                     []spiffeid.ID
 	DataStore                    datastore.DataStore
 	APIServers                   APIServers
 	BundleEndpointServer         Server
 	Log                          logrus.FieldLogger
+	AuditLog                     logrus.FieldLogger
 	Metrics                      telemetry.Metrics
-	RateLimit                    RateLimitConfig
+	RateLimits                   *middleware.ReloadableRateLimits
+	AuthPolicyEngine             *authpolicy.Engine
 	EntryFetcherCacheRebuildTask func(context.Context) error
 }
 
@@ -72,17 +89,52 @@ type OldAPIServers struct {
 }
 
 type APIServers struct {
-	AgentServer  agentv1_pb.AgentServer
-	BundleServer bundlev1_pb.BundleServer
-	DebugServer  debugv1_pb.DebugServer
-	EntryServer  entryv1_pb.EntryServer
-	SVIDServer   svidv1_pb.SVIDServer
+	AgentServer       agentv1_pb.AgentServer
+	BundleServer      bundlev1_pb.BundleServer
+	DebugServer       debugv1_pb.DebugServer
+	EntryServer       entryv1_pb.EntryServer
+	SVIDServer        svidv1_pb.SVIDServer
+	TrustDomainServer trustdomainv1_pb.TrustDomainServer
 }
 
 // RateLimitConfig holds rate limiting configurations.
 type RateLimitConfig struct {
 	// Attestation, if true, rate limits attestation
 	Attestation bool
+
+	// AttestationLimit is the number of node attestations allowed per second
+	// per IP address. If zero, a default limit is used.
+	AttestationLimit int
+
+	// AttestationPerTypeLimit is the number of node attestations allowed per
+	// second for each attestation type, across all IP addresses. If zero,
+	// attestation is not limited per type.
+	AttestationPerTypeLimit int
+
+	// AttestationBanThreshold is the number of failed node attestations
+	// after which an IP address is banned from attesting for
+	// AttestationBanDuration. If zero, IP addresses are not banned.
+	AttestationBanThreshold int
+
+	// AttestationBanDuration is how long an IP address is banned from
+	// attesting, and the window over which its failures are counted.
+	AttestationBanDuration time.Duration
+
+	// CSRLimit is the number of CSRs signed per second per IP address. If
+	// zero, a default limit is used.
+	CSRLimit int
+
+	// CSRPerCallerLimit is the number of CSRs signed per second per caller
+	// SPIFFE ID. If zero, CSR signing is not limited per caller.
+	CSRPerCallerLimit int
+
+	// JWTLimit is the number of JWT-SVIDs minted per second per IP address.
+	// If zero, a default limit is used.
+	JWTLimit int
+
+	// JWTPerCallerLimit is the number of JWT-SVIDs minted per second per
+	// caller SPIFFE ID. If zero, JWT-SVID minting is not limited per caller.
+	JWTPerCallerLimit int
 }
 
 // New creates new endpoints struct
@@ -98,7 +150,7 @@ func New(ctx context.Context, c Config) (*Endpoints, error) {
 		return entrycache.BuildFromDataStore(ctx, c.Catalog.GetDataStore())
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCacheFn, c.Log, c.Clock)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCacheFn, c.EntriesChanged, c.Log, c.Clock)
 	if err != nil {
 		return nil, err
 	}
@@ -106,15 +158,23 @@ func New(ctx context.Context, c Config) (*Endpoints, error) {
 	return &Endpoints{
 		OldAPIServers:                oldAPIServers,
 		TCPAddr:                      c.TCPAddr,
+		TLSPolicy:                    c.TLSPolicy,
+		AdditionalTCPListeners:       c.AdditionalTCPListeners,
+		GRPC:                         c.GRPC,
 		UDSAddr:                      c.UDSAddr,
+		UDSPermissions:               c.UDSPermissions,
+		AdminTCPAddr:                 c.AdminTCPAddr,
 		SVIDObserver:                 c.SVIDObserver,
 		TrustDomain:                  c.TrustDomain,
+		AdminIDs:                     c.AdminIDs,
 		DataStore:                    c.Catalog.GetDataStore(),
 		APIServers:                   c.makeAPIServers(ef),
 		BundleEndpointServer:         c.maybeMakeBundleEndpointServer(),
 		Log:                          c.Log,
+		AuditLog:                     c.AuditLog,
 		Metrics:                      c.Metrics,
-		RateLimit:                    c.RateLimit,
+		RateLimits:                   middleware.NewReloadableRateLimits(RateLimits(c.RateLimit)),
+		AuthPolicyEngine:             c.AuthPolicyEngine,
 		EntryFetcherCacheRebuildTask: ef.RunRebuildCacheTask,
 	}, nil
 }
@@ -128,29 +188,27 @@ func (e *Endpoints) ListenAndServe(ctx context.Context) error {
 
 	unaryInterceptor, streamInterceptor := e.makeInterceptors()
 
-	tcpServer := e.createTCPServer(ctx, unaryInterceptor, streamInterceptor)
+	tcpServer := e.createTCPServer(ctx, e.TLSPolicy, unaryInterceptor, streamInterceptor)
 	udsServer := e.createUDSServer(unaryInterceptor, streamInterceptor)
 
 	// Old APIs
-	node_pb.RegisterNodeServer(tcpServer, e.OldAPIServers.NodeServer)
-	registration_pb.RegisterRegistrationServer(tcpServer, e.OldAPIServers.RegistrationServer)
+	e.registerOldTCPAPIs(tcpServer)
 	registration_pb.RegisterRegistrationServer(udsServer, e.OldAPIServers.RegistrationServer)
 
 	// New APIs
-	agentv1_pb.RegisterAgentServer(tcpServer, e.APIServers.AgentServer)
+	e.registerTCPAPIs(tcpServer)
 	agentv1_pb.RegisterAgentServer(udsServer, e.APIServers.AgentServer)
-	bundlev1_pb.RegisterBundleServer(tcpServer, e.APIServers.BundleServer)
 	bundlev1_pb.RegisterBundleServer(udsServer, e.APIServers.BundleServer)
-	entryv1_pb.RegisterEntryServer(tcpServer, e.APIServers.EntryServer)
 	entryv1_pb.RegisterEntryServer(udsServer, e.APIServers.EntryServer)
-	svidv1_pb.RegisterSVIDServer(tcpServer, e.APIServers.SVIDServer)
 	svidv1_pb.RegisterSVIDServer(udsServer, e.APIServers.SVIDServer)
+	trustdomainv1_pb.RegisterTrustDomainServer(udsServer, e.APIServers.TrustDomainServer)
 	// Register Debug API only on UDS server
 	debugv1_pb.RegisterDebugServer(udsServer, e.APIServers.DebugServer)
+	e.maybeRegisterReflection(udsServer)
 
 	tasks := []func(context.Context) error{
 		func(ctx context.Context) error {
-			return e.runTCPServer(ctx, tcpServer)
+			return e.runTCPServer(ctx, tcpServer, e.TCPAddr)
 		},
 		func(ctx context.Context) error {
 			return e.runUDSServer(ctx, udsServer)
@@ -158,6 +216,33 @@ func (e *Endpoints) ListenAndServe(ctx context.Context) error {
 		e.EntryFetcherCacheRebuildTask,
 	}
 
+	// Each additional listener is served by its own gRPC server since the
+	// TLS policy is bound to the server credentials.
+	for _, listener := range e.AdditionalTCPListeners {
+		listener := listener
+		server := e.createTCPServer(ctx, listener.TLSPolicy, unaryInterceptor, streamInterceptor)
+		e.registerOldTCPAPIs(server)
+		e.registerTCPAPIs(server)
+		tasks = append(tasks, func(ctx context.Context) error {
+			return e.runTCPServer(ctx, server, listener.Address)
+		})
+	}
+
+	if e.AdminTCPAddr != nil {
+		// The admin listener only serves the management APIs and requires
+		// callers to present an X509-SVID.
+		adminServer := e.createAdminTCPServer(ctx, unaryInterceptor, streamInterceptor)
+		agentv1_pb.RegisterAgentServer(adminServer, e.APIServers.AgentServer)
+		bundlev1_pb.RegisterBundleServer(adminServer, e.APIServers.BundleServer)
+		entryv1_pb.RegisterEntryServer(adminServer, e.APIServers.EntryServer)
+		svidv1_pb.RegisterSVIDServer(adminServer, e.APIServers.SVIDServer)
+		trustdomainv1_pb.RegisterTrustDomainServer(adminServer, e.APIServers.TrustDomainServer)
+		e.maybeRegisterReflection(adminServer)
+		tasks = append(tasks, func(ctx context.Context) error {
+			return e.runAdminTCPServer(ctx, adminServer)
+		})
+	}
+
 	if e.BundleEndpointServer != nil {
 		tasks = append(tasks, e.BundleEndpointServer.ListenAndServe)
 	}
@@ -169,19 +254,99 @@ func (e *Endpoints) ListenAndServe(ctx context.Context) error {
 	return err
 }
 
-func (e *Endpoints) createTCPServer(ctx context.Context, unaryInterceptor grpc.UnaryServerInterceptor, streamInterceptor grpc.StreamServerInterceptor) *grpc.Server {
+// registerOldTCPAPIs registers the deprecated APIs served to agents.
+func (e *Endpoints) registerOldTCPAPIs(server *grpc.Server) {
+	node_pb.RegisterNodeServer(server, e.OldAPIServers.NodeServer)
+	registration_pb.RegisterRegistrationServer(server, e.OldAPIServers.RegistrationServer)
+}
+
+// registerTCPAPIs registers the APIs served on the agent-facing listeners.
+func (e *Endpoints) registerTCPAPIs(server *grpc.Server) {
+	agentv1_pb.RegisterAgentServer(server, e.APIServers.AgentServer)
+	bundlev1_pb.RegisterBundleServer(server, e.APIServers.BundleServer)
+	entryv1_pb.RegisterEntryServer(server, e.APIServers.EntryServer)
+	svidv1_pb.RegisterSVIDServer(server, e.APIServers.SVIDServer)
+	trustdomainv1_pb.RegisterTrustDomainServer(server, e.APIServers.TrustDomainServer)
+}
+
+// maybeRegisterReflection registers the gRPC server reflection service, if
+// enabled. It is not served on the agent-facing listeners.
+func (e *Endpoints) maybeRegisterReflection(server *grpc.Server) {
+	if e.GRPC.ReflectionEnabled {
+		reflection.Register(server)
+	}
+}
+
+func (e *Endpoints) createTCPServer(ctx context.Context, policy TLSPolicy, unaryInterceptor grpc.UnaryServerInterceptor, streamInterceptor grpc.StreamServerInterceptor) *grpc.Server {
+	getTLSConfig := e.getTLSConfig(ctx)
 	tlsConfig := &tls.Config{
-		GetConfigForClient: e.getTLSConfig(ctx),
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			config, err := getTLSConfig(hello)
+			if err != nil {
+				return nil, err
+			}
+			policy.apply(config)
+			return config, nil
+		},
 	}
 
-	return grpc.NewServer(
+	maxConnectionAge := e.GRPC.MaxConnectionAge
+	if maxConnectionAge == 0 {
+		maxConnectionAge = defaultMaxConnectionAge
+	}
+
+	return grpc.NewServer(append([]grpc.ServerOption{
 		grpc.UnaryInterceptor(unaryInterceptor),
 		grpc.StreamInterceptor(streamInterceptor),
 		grpc.Creds(credentials.NewTLS(tlsConfig)),
+	}, e.connectionOptions(maxConnectionAge)...)...)
+}
+
+// connectionOptions returns the server options that tune connections to a
+// TCP listener.
+func (e *Endpoints) connectionOptions(maxConnectionAge time.Duration) []grpc.ServerOption {
+	opts := []grpc.ServerOption{
 		grpc.KeepaliveParams(keepalive.ServerParameters{
-			MaxConnectionAge: defaultMaxConnectionAge,
+			MaxConnectionAge: maxConnectionAge,
+			Time:             e.GRPC.KeepaliveTime,
+			Timeout:          e.GRPC.KeepaliveTimeout,
 		}),
-	)
+	}
+	if e.GRPC.KeepaliveMinTime != 0 || e.GRPC.PermitKeepaliveWithoutStream {
+		opts = append(opts, grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             e.GRPC.KeepaliveMinTime,
+			PermitWithoutStream: e.GRPC.PermitKeepaliveWithoutStream,
+		}))
+	}
+	if e.GRPC.MaxConcurrentStreams != 0 {
+		opts = append(opts, grpc.MaxConcurrentStreams(e.GRPC.MaxConcurrentStreams))
+	}
+	return opts
+}
+
+func (e *Endpoints) createAdminTCPServer(ctx context.Context, unaryInterceptor grpc.UnaryServerInterceptor, streamInterceptor grpc.StreamServerInterceptor) *grpc.Server {
+	getTLSConfig := e.getTLSConfig(ctx)
+	tlsConfig := &tls.Config{
+		GetConfigForClient: func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
+			config, err := getTLSConfig(hello)
+			if err != nil {
+				return nil, err
+			}
+			// Unlike the agent listener, there is no bootstrap flow that
+			// needs to be served without a client certificate.
+			config.ClientAuth = tls.RequireAndVerifyClientCert
+			e.TLSPolicy.apply(config)
+			return config, nil
+		},
+	}
+
+	// Admin connections are not subject to the maximum connection age,
+	// which only exists to rebalance agents across servers.
+	return grpc.NewServer(append([]grpc.ServerOption{
+		grpc.UnaryInterceptor(unaryInterceptor),
+		grpc.StreamInterceptor(streamInterceptor),
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+	}, e.connectionOptions(0)...)...)
 }
 
 func (e *Endpoints) createUDSServer(unaryInterceptor grpc.UnaryServerInterceptor, streamInterceptor grpc.StreamServerInterceptor) *grpc.Server {
@@ -192,8 +357,8 @@ func (e *Endpoints) createUDSServer(unaryInterceptor grpc.UnaryServerInterceptor
 }
 
 // runTCPServer will start the server and block until it exits or we are dying.
-func (e *Endpoints) runTCPServer(ctx context.Context, server *grpc.Server) error {
-	l, err := net.Listen(e.TCPAddr.Network(), e.TCPAddr.String())
+func (e *Endpoints) runTCPServer(ctx context.Context, server *grpc.Server, addr *net.TCPAddr) error {
+	l, err := net.Listen(addr.Network(), addr.String())
 	if err != nil {
 		return err
 	}
@@ -217,6 +382,31 @@ func (e *Endpoints) runTCPServer(ctx context.Context, server *grpc.Server) error
 	}
 }
 
+// runAdminTCPServer will start the server and block until it exits or we are dying.
+func (e *Endpoints) runAdminTCPServer(ctx context.Context, server *grpc.Server) error {
+	l, err := net.Listen(e.AdminTCPAddr.Network(), e.AdminTCPAddr.String())
+	if err != nil {
+		return err
+	}
+	defer l.Close()
+
+	e.Log.WithField(telemetry.Address, l.Addr().String()).Info("Starting admin TCP server")
+	errChan := make(chan error)
+	go func() { errChan <- server.Serve(l) }()
+
+	select {
+	case err = <-errChan:
+		e.Log.WithError(err).Error("Admin TCP server stopped prematurely")
+		return err
+	case <-ctx.Done():
+		e.Log.Info("Stopping admin TCP server")
+		server.Stop()
+		<-errChan
+		e.Log.Info("Admin TCP server has stopped")
+		return nil
+	}
+}
+
 // runUDSServer  will start the server and block until it exits or we are dying.
 func (e *Endpoints) runUDSServer(ctx context.Context, server *grpc.Server) error {
 	os.Remove(e.UDSAddr.String())
@@ -226,9 +416,13 @@ func (e *Endpoints) runUDSServer(ctx context.Context, server *grpc.Server) error
 	}
 	defer l.Close()
 
-	// Restrict access to the UDS to processes running as the same user or
-	// group as the server.
-	if err := os.Chmod(e.UDSAddr.String(), 0770); err != nil {
+	// Unless configured otherwise, restrict access to the UDS to processes
+	// running as the same user or group as the server.
+	perms := e.UDSPermissions
+	if perms == nil {
+		perms = &diskutil.SocketPermissions{Mode: DefaultUDSMode, UID: -1, GID: -1}
+	}
+	if err := perms.Apply(e.UDSAddr.String()); err != nil {
 		return err
 	}
 
@@ -253,12 +447,19 @@ func (e *Endpoints) runUDSServer(ctx context.Context, server *grpc.Server) error
 // getTLSConfig returns a TLS Config hook for the gRPC server
 func (e *Endpoints) getTLSConfig(ctx context.Context) func(*tls.ClientHelloInfo) (*tls.Config, error) {
 	return func(hello *tls.ClientHelloInfo) (*tls.Config, error) {
-		certs, roots, err := e.getCerts(ctx)
+		certs, bundles, err := e.getCerts(ctx)
 		if err != nil {
 			e.Log.WithError(err).WithField(telemetry.Address, hello.Conn.RemoteAddr().String()).Error("Could not generate TLS config for gRPC client")
 			return nil, err
 		}
 
+		roots := x509.NewCertPool()
+		for _, bundle := range bundles.Bundles() {
+			for _, c := range bundle.X509Authorities() {
+				roots.AddCert(c)
+			}
+		}
+
 		return &tls.Config{
 			// When bootstrapping, the agent does not yet have
 			// an SVID. In order to include the bootstrap endpoint
@@ -269,6 +470,11 @@ func (e *Endpoints) getTLSConfig(ctx context.Context) func(*tls.ClientHelloInfo)
 			Certificates: certs,
 			ClientCAs:    roots,
 
+			// The client CAs include the bundles of trust domains
+			// admin IDs belong to, so the chain must additionally
+			// be checked against the caller trust domain bundle.
+			VerifyPeerCertificate: e.verifyPeerCertificate(bundles),
+
 			MinVersion: tls.VersionTLS12,
 
 			NextProtos: []string{http2.NextProtoTLS},
@@ -276,31 +482,79 @@ func (e *Endpoints) getTLSConfig(ctx context.Context) func(*tls.ClientHelloInfo)
 	}
 }
 
+// verifyPeerCertificate returns a function that checks verified client
+// certificate chains. Clients must be members of the server trust domain or
+// be one of the configured admin IDs, and must chain up to the bundle of the
+// trust domain they belong to. This prevents a federated trust domain from
+// impersonating local workloads.
+func (e *Endpoints) verifyPeerCertificate(bundles *x509bundle.Set) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, verifiedChains [][]*x509.Certificate) error {
+		if len(verifiedChains) == 0 {
+			// Client didn't provide a certificate (e.g. node attestation)
+			return nil
+		}
+
+		id, err := x509svid.IDFromCert(verifiedChains[0][0])
+		if err != nil {
+			return fmt.Errorf("invalid client certificate: %v", err)
+		}
+		if !e.isAuthorizedClientID(id) {
+			return fmt.Errorf("unauthorized client ID %q", id)
+		}
+
+		bundle, ok := bundles.Get(id.TrustDomain())
+		if !ok {
+			return fmt.Errorf("no bundle for trust domain %q", id.TrustDomain())
+		}
+		for _, chain := range verifiedChains {
+			if bundle.HasX509Authority(chain[len(chain)-1]) {
+				return nil
+			}
+		}
+		return fmt.Errorf("client certificate does not chain up to the %q bundle", id.TrustDomain())
+	}
+}
+
+func (e *Endpoints) isAuthorizedClientID(id spiffeid.ID) bool {
+	if id.MemberOf(e.TrustDomain) {
+		return true
+	}
+	for _, adminID := range e.AdminIDs {
+		if id == adminID {
+			return true
+		}
+	}
+	return false
+}
+
 // getCerts queries the datastore and returns a TLS serving certificate(s) plus
-// the current CA root bundle.
-func (e *Endpoints) getCerts(ctx context.Context) ([]tls.Certificate, *x509.CertPool, error) {
-	resp, err := e.DataStore.FetchBundle(dscache.WithCache(ctx), &datastore_pb.FetchBundleRequest{
-		TrustDomainId: e.TrustDomain.IDString(),
-	})
+// the bundles used to verify clients. The bundle set contains the current CA
+// root bundle and the bundles of the trust domains admin IDs belong to.
+func (e *Endpoints) getCerts(ctx context.Context) ([]tls.Certificate, *x509bundle.Set, error) {
+	localBundle, err := e.fetchX509Bundle(ctx, e.TrustDomain)
 	if err != nil {
-		return nil, nil, fmt.Errorf("get bundle from datastore: %v", err)
+		return nil, nil, err
 	}
-	if resp.Bundle == nil {
+	if localBundle == nil {
 		return nil, nil, errors.New("bundle not found")
 	}
 
-	var caCerts []*x509.Certificate
-	for _, rootCA := range resp.Bundle.RootCas {
-		rootCACerts, err := x509.ParseCertificates(rootCA.DerBytes)
+	bundles := x509bundle.NewSet(localBundle)
+	for _, adminID := range e.AdminIDs {
+		td := adminID.TrustDomain()
+		if bundles.Has(td) {
+			continue
+		}
+		bundle, err := e.fetchX509Bundle(ctx, td)
 		if err != nil {
-			return nil, nil, fmt.Errorf("parse bundle: %v", err)
+			return nil, nil, err
+		}
+		// The bundle may not be available yet (e.g. federation has not
+		// completed). Admins from that trust domain are rejected until
+		// the bundle is present.
+		if bundle != nil {
+			bundles.Add(bundle)
 		}
-		caCerts = append(caCerts, rootCACerts...)
-	}
-
-	caPool := x509.NewCertPool()
-	for _, c := range caCerts {
-		caPool.AddCert(c)
 	}
 
 	svidState := e.SVIDObserver.State()
@@ -315,7 +569,39 @@ func (e *Endpoints) getCerts(ctx context.Context) ([]tls.Certificate, *x509.Cert
 		PrivateKey:  svidState.Key,
 	}
 
-	return []tls.Certificate{tlsCert}, caPool, nil
+	return []tls.Certificate{tlsCert}, bundles, nil
+}
+
+// fetchX509Bundle fetches the X.509 bundle for the trust domain from the
+// datastore. It returns nil if the bundle does not exist.
+func (e *Endpoints) fetchX509Bundle(ctx context.Context, td spiffeid.TrustDomain) (*x509bundle.Bundle, error) {
+	resp, err := e.DataStore.FetchBundle(dscache.WithCache(ctx), &datastore_pb.FetchBundleRequest{
+		TrustDomainId: td.IDString(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("get bundle from datastore: %v", err)
+	}
+	if resp.Bundle == nil {
+		return nil, nil
+	}
+
+	var caCerts []*x509.Certificate
+	for _, rootCA := range resp.Bundle.RootCas {
+		rootCACerts, err := x509.ParseCertificates(rootCA.DerBytes)
+		if err != nil {
+			return nil, fmt.Errorf("parse bundle: %v", err)
+		}
+		caCerts = append(caCerts, rootCACerts...)
+	}
+
+	return x509bundle.FromX509Authorities(td, caCerts), nil
+}
+
+// SetRateLimits replaces the rate limits of the APIs served by the endpoints.
+// Calls in progress are not affected. The rate limits of the deprecated APIs
+// cannot be changed.
+func (e *Endpoints) SetRateLimits(config RateLimitConfig) {
+	e.RateLimits.Set(RateLimits(config))
 }
 
 func (e *Endpoints) makeInterceptors() (grpc.UnaryServerInterceptor, grpc.StreamServerInterceptor) {
@@ -323,7 +609,12 @@ func (e *Endpoints) makeInterceptors() (grpc.UnaryServerInterceptor, grpc.Stream
 
 	oldUnary, oldStream := wrapWithDeprecationLogging(log, auth.UnaryAuthorizeCall, auth.StreamAuthorizeCall)
 
-	newUnary, newStream := middleware.Interceptors(Middleware(log, e.Metrics, e.DataStore, clock.New(), e.RateLimit))
+	newUnary, newStream := middleware.Interceptors(Middleware(log, e.Metrics, e.DataStore, clock.New(), e.RateLimits, e.AuthPolicyEngine, e.AdminIDs))
 
-	return unaryInterceptorMux(oldUnary, newUnary), streamInterceptorMux(oldStream, newStream)
+	unary, stream := unaryInterceptorMux(oldUnary, newUnary), streamInterceptorMux(oldStream, newStream)
+	if e.AuditLog != nil {
+		auditUnary, auditStream := middleware.AuditInterceptors(e.AuditLog)
+		unary, stream = chainUnaryInterceptors(auditUnary, unary), chainStreamInterceptors(auditStream, stream)
+	}
+	return unary, stream
 }
@@ -16,6 +16,8 @@ import (
 	"github.com/spiffe/go-spiffe/v2/spiffetls/tlsconfig"
 	"github.com/spiffe/go-spiffe/v2/svid/x509svid"
 	"github.com/spiffe/spire/pkg/common/auth"
+	"github.com/spiffe/spire/pkg/server/api/middleware"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/cache/entrycache"
 	"github.com/spiffe/spire/pkg/server/endpoints/bundle"
@@ -28,6 +30,7 @@ import (
 	debugv1 "github.com/spiffe/spire/proto/spire/api/server/debug/v1"
 	entryv1 "github.com/spiffe/spire/proto/spire/api/server/entry/v1"
 	svidv1 "github.com/spiffe/spire/proto/spire/api/server/svid/v1"
+	trustdomainv1 "github.com/spiffe/spire/proto/spire/api/server/trustdomain/v1"
 	"github.com/spiffe/spire/proto/spire/common"
 	"github.com/spiffe/spire/test/clock"
 	"github.com/spiffe/spire/test/fakes/fakedatastore"
@@ -42,6 +45,7 @@ import (
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/peer"
+	reflection_pb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
 	"google.golang.org/grpc/status"
 )
 
@@ -51,7 +55,10 @@ var (
 	agentID      = testTD.NewID("/agent")
 	adminID      = testTD.NewID("/admin")
 	downstreamID = testTD.NewID("/downstream")
-	rateLimit    = RateLimitConfig{Attestation: true}
+
+	federatedTD      = spiffeid.RequireTrustDomainFromString("federated.test")
+	federatedAdminID = federatedTD.NewID("/admin")
+	rateLimit        = RateLimitConfig{Attestation: true}
 )
 
 func TestNew(t *testing.T) {
@@ -107,7 +114,9 @@ func TestNew(t *testing.T) {
 	assert.NotNil(t, endpoints.APIServers.EntryServer)
 	assert.NotNil(t, endpoints.APIServers.SVIDServer)
 	assert.NotNil(t, endpoints.APIServers.DebugServer)
+	assert.NotNil(t, endpoints.APIServers.TrustDomainServer)
 	assert.NotNil(t, endpoints.BundleEndpointServer)
+	assert.NotNil(t, endpoints.RateLimits)
 	assert.Equal(t, cat.GetDataStore(), endpoints.DataStore)
 	assert.Equal(t, log, endpoints.Log)
 	assert.Equal(t, metrics, endpoints.Metrics)
@@ -167,10 +176,21 @@ func TestListenAndServe(t *testing.T) {
 	adminSVID := ca.CreateX509SVID(adminID)
 	downstreamSVID := ca.CreateX509SVID(downstreamID)
 
+	federatedCA := testca.New(t, federatedTD)
+	federatedAdminSVID := federatedCA.CreateX509SVID(federatedAdminID)
+
 	listener, err := net.Listen("tcp", "localhost:0")
 	require.NoError(t, err)
 	require.NoError(t, listener.Close())
 
+	adminListener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	require.NoError(t, adminListener.Close())
+
+	additionalListener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+	require.NoError(t, additionalListener.Close())
+
 	dir := spiretest.TempDir(t)
 	udsPath := filepath.Join(dir, "socket")
 
@@ -187,30 +207,44 @@ func TestListenAndServe(t *testing.T) {
 		return entrycache.BuildFromDataStore(ctx, ds)
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(context.Background(), buildCacheFn, log, clk)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(context.Background(), buildCacheFn, nil, log, clk)
+	require.NoError(t, err)
+
+	policyEngine, err := authpolicy.DefaultAuthPolicy(context.Background())
 	require.NoError(t, err)
 
 	endpoints := Endpoints{
 		TCPAddr:      listener.Addr().(*net.TCPAddr),
 		UDSAddr:      &net.UnixAddr{Name: udsPath, Net: "unix"},
+		AdminTCPAddr: adminListener.Addr().(*net.TCPAddr),
+		AdditionalTCPListeners: []TCPListener{
+			{
+				Address:   additionalListener.Addr().(*net.TCPAddr),
+				TLSPolicy: TLSPolicy{MinVersion: tls.VersionTLS13},
+			},
+		},
+		GRPC:         GRPCConfig{ReflectionEnabled: true},
 		SVIDObserver: newSVIDObserver(serverSVID),
 		TrustDomain:  testTD,
+		AdminIDs:     []spiffeid.ID{federatedAdminID},
 		DataStore:    ds,
 		OldAPIServers: OldAPIServers{
 			RegistrationServer: registrationServer,
 			NodeServer:         nodeServer,
 		},
 		APIServers: APIServers{
-			AgentServer:  &agentv1.UnimplementedAgentServer{},
-			BundleServer: &bundlev1.UnimplementedBundleServer{},
-			EntryServer:  &entryv1.UnimplementedEntryServer{},
-			SVIDServer:   &svidv1.UnimplementedSVIDServer{},
-			DebugServer:  &debugv1.UnimplementedDebugServer{},
+			AgentServer:       &agentv1.UnimplementedAgentServer{},
+			BundleServer:      &bundlev1.UnimplementedBundleServer{},
+			EntryServer:       &entryv1.UnimplementedEntryServer{},
+			SVIDServer:        &svidv1.UnimplementedSVIDServer{},
+			DebugServer:       &debugv1.UnimplementedDebugServer{},
+			TrustDomainServer: &trustdomainv1.UnimplementedTrustDomainServer{},
 		},
 		BundleEndpointServer:         bundleEndpointServer,
 		Log:                          log,
 		Metrics:                      metrics,
-		RateLimit:                    rateLimit,
+		RateLimits:                   middleware.NewReloadableRateLimits(RateLimits(rateLimit)),
+		AuthPolicyEngine:             policyEngine,
 		EntryFetcherCacheRebuildTask: ef.RunRebuildCacheTask,
 	}
 
@@ -221,6 +255,12 @@ func TestListenAndServe(t *testing.T) {
 	// - downstream registration entry
 	prepareDataStore(t, ds, ca, agentSVID)
 
+	// Add the federated bundle used to verify the federated admin
+	_, err = ds.CreateBundle(context.Background(), &datastore.CreateBundleRequest{
+		Bundle: makeBundle(federatedCA, federatedTD),
+	})
+	require.NoError(t, err)
+
 	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
 	defer cancel()
 
@@ -270,6 +310,53 @@ func TestListenAndServe(t *testing.T) {
 		}
 	})
 
+	t.Run("Federated Client SVID", func(t *testing.T) {
+		dialFederated := func(svid *x509svid.SVID) error {
+			ctx, cancel := context.WithTimeout(ctx, time.Second)
+			defer cancel()
+			conn, err := grpc.DialContext(ctx, endpoints.TCPAddr.String(), grpc.WithBlock(), grpc.FailOnNonTempDialError(true),
+				grpc.WithTransportCredentials(credentials.NewTLS(tlsconfig.MTLSClientConfig(svid, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))),
+			)
+			if err == nil {
+				conn.Close()
+			}
+			return err
+		}
+
+		// Federated admin IDs are accepted
+		require.NoError(t, dialFederated(federatedAdminSVID))
+
+		// Other IDs from the federated trust domain are rejected
+		assert.Error(t, dialFederated(federatedCA.CreateX509SVID(federatedTD.NewID("/workload"))))
+
+		// The federated trust domain cannot impersonate local IDs
+		assert.Error(t, dialFederated(federatedCA.CreateX509SVID(adminID)))
+	})
+
+	t.Run("Additional TCP listener", func(t *testing.T) {
+		dialAdditional := func(tlsConfig *tls.Config) (*grpc.ClientConn, error) {
+			ctx, cancel := context.WithTimeout(ctx, time.Second)
+			defer cancel()
+			return grpc.DialContext(ctx, endpoints.AdditionalTCPListeners[0].Address.String(), grpc.WithBlock(), grpc.FailOnNonTempDialError(true),
+				grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			)
+		}
+
+		// The agent-facing APIs are served on the additional listener
+		conn, err := dialAdditional(tlsconfig.MTLSClientConfig(agentSVID, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))
+		require.NoError(t, err)
+		defer conn.Close()
+		_, err = bundlev1.NewBundleClient(conn).GetBundle(ctx, &bundlev1.GetBundleRequest{})
+		spiretest.AssertGRPCStatusContains(t, err, codes.Unimplemented, "method GetBundle not implemented")
+
+		// The listener enforces its own TLS policy
+		tlsConfig := tlsconfig.MTLSClientConfig(agentSVID, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID))
+		tlsConfig.MaxVersion = tls.VersionTLS12
+		conn, err = dialAdditional(tlsConfig)
+		if !assert.Error(t, err, "dialing should have failed") {
+			conn.Close()
+		}
+	})
 	t.Run("Registration", func(t *testing.T) {
 		testRegistrationAPI(ctx, t, registrationServer, udsConn, noauthConn, agentConn)
 	})
@@ -288,9 +375,84 @@ func TestListenAndServe(t *testing.T) {
 	t.Run("Entry", func(t *testing.T) {
 		testEntryAPI(ctx, t, udsConn, noauthConn, agentConn, adminConn, downstreamConn)
 	})
+	t.Run("Federated Admin", func(t *testing.T) {
+		federatedAdminConn := dialTCP(tlsconfig.MTLSClientConfig(federatedAdminSVID, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))
+		defer federatedAdminConn.Close()
+
+		testAuthorization(ctx, t, entryv1.NewEntryClient(federatedAdminConn), map[string]bool{
+			"ListEntries":               true,
+			"GetEntry":                  true,
+			"BatchCreateEntry":          true,
+			"BatchUpdateEntry":          true,
+			"BatchDeleteEntry":          true,
+			"ListEntryTombstones":       true,
+			"GetAgentAuthorizedEntries": true,
+			"GetAuthorizedEntries":      false,
+		})
+	})
+	t.Run("Admin TCP", func(t *testing.T) {
+		dialAdminTCP := func(tlsConfig *tls.Config) *grpc.ClientConn {
+			conn, err := grpc.DialContext(ctx, endpoints.AdminTCPAddr.String(),
+				grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+			)
+			require.NoError(t, err)
+			return conn
+		}
+
+		adminTCPConn := dialAdminTCP(tlsconfig.MTLSClientConfig(adminSVID, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))
+		defer adminTCPConn.Close()
+
+		// The management APIs are served to admins
+		testAuthorization(ctx, t, entryv1.NewEntryClient(adminTCPConn), map[string]bool{
+			"ListEntries":               true,
+			"GetEntry":                  true,
+			"BatchCreateEntry":          true,
+			"BatchUpdateEntry":          true,
+			"BatchDeleteEntry":          true,
+			"ListEntryTombstones":       true,
+			"GetAgentAuthorizedEntries": true,
+			"GetAuthorizedEntries":      false,
+		})
+
+		// The debug API is only served over the UDS
+		_, err := debugv1.NewDebugClient(adminTCPConn).GetInfo(ctx, &debugv1.GetInfoRequest{})
+		spiretest.AssertGRPCStatusContains(t, err, codes.Unimplemented, "unknown service")
+
+		// Callers without an X509-SVID are rejected, even for RPCs that
+		// are open to everyone on the agent listener
+		noauthTCPConn := dialAdminTCP(tlsconfig.TLSClientConfig(ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))
+		defer noauthTCPConn.Close()
+		_, err = bundlev1.NewBundleClient(noauthTCPConn).GetBundle(ctx, &bundlev1.GetBundleRequest{})
+		assert.Equal(t, codes.Unavailable, status.Code(err))
+	})
+	t.Run("Reflection", func(t *testing.T) {
+		adminTCPConn, err := grpc.DialContext(ctx, endpoints.AdminTCPAddr.String(),
+			grpc.WithTransportCredentials(credentials.NewTLS(tlsconfig.MTLSClientConfig(adminSVID, ca.X509Bundle(), tlsconfig.AuthorizeID(serverID)))),
+		)
+		require.NoError(t, err)
+		defer adminTCPConn.Close()
+
+		// Reflection is served to local callers and admins
+		services, err := listServices(ctx, udsConn)
+		require.NoError(t, err)
+		assert.Contains(t, services, "spire.api.server.entry.v1.Entry")
+		assert.Contains(t, services, "spire.api.server.debug.v1.Debug")
+
+		services, err = listServices(ctx, adminTCPConn)
+		require.NoError(t, err)
+		assert.Contains(t, services, "spire.api.server.entry.v1.Entry")
+		assert.NotContains(t, services, "spire.api.server.debug.v1.Debug")
+
+		// Reflection is not served on the agent-facing listener
+		_, err = listServices(ctx, adminConn)
+		spiretest.AssertGRPCStatusContains(t, err, codes.Unimplemented, "unknown service")
+	})
 	t.Run("SVID", func(t *testing.T) {
 		testSVIDAPI(ctx, t, udsConn, noauthConn, agentConn, adminConn, downstreamConn)
 	})
+	t.Run("TrustDomain", func(t *testing.T) {
+		testTrustDomainAPI(ctx, t, udsConn, noauthConn, agentConn, adminConn, downstreamConn)
+	})
 
 	// Assert that the bundle endpoint server was called to listen and serve
 	require.True(t, bundleEndpointServer.Used(), "bundle server was not called to listen and serve")
@@ -309,7 +471,7 @@ func TestListenAndServe(t *testing.T) {
 func prepareDataStore(t *testing.T, ds datastore.DataStore, ca *testca.CA, agentSVID *x509svid.SVID) {
 	// Prepare the bundle
 	_, err := ds.CreateBundle(context.Background(), &datastore.CreateBundleRequest{
-		Bundle: makeBundle(ca),
+		Bundle: makeBundle(ca, testTD),
 	})
 	require.NoError(t, err)
 
@@ -416,6 +578,8 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"AttestAgent":     true,
 			"RenewAgent":      false,
 			"CreateJoinToken": true,
+			"ListJoinTokens":  true,
+			"RevokeJoinToken": true,
 		})
 	})
 
@@ -428,6 +592,8 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"AttestAgent":     true,
 			"RenewAgent":      false,
 			"CreateJoinToken": false,
+			"ListJoinTokens":  false,
+			"RevokeJoinToken": false,
 		})
 	})
 
@@ -440,6 +606,8 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"AttestAgent":     true,
 			"RenewAgent":      true,
 			"CreateJoinToken": false,
+			"ListJoinTokens":  false,
+			"RevokeJoinToken": false,
 		})
 	})
 
@@ -452,6 +620,8 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"AttestAgent":     true,
 			"RenewAgent":      false,
 			"CreateJoinToken": true,
+			"ListJoinTokens":  true,
+			"RevokeJoinToken": true,
 		})
 	})
 
@@ -464,6 +634,8 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 			"AttestAgent":     true,
 			"RenewAgent":      false,
 			"CreateJoinToken": false,
+			"ListJoinTokens":  false,
+			"RevokeJoinToken": false,
 		})
 	})
 }
@@ -471,31 +643,41 @@ func testAgentAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentC
 func testDebugAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentConn, adminConn, downstreamConn *grpc.ClientConn) {
 	t.Run("UDS", func(t *testing.T) {
 		testAuthorization(ctx, t, debugv1.NewDebugClient(udsConn), map[string]bool{
-			"GetInfo": true,
+			"GetInfo":         true,
+			"VerifyCAJournal": true,
+			"RepairCAJournal": true,
 		})
 	})
 
 	t.Run("NoAuth", func(t *testing.T) {
 		testAuthorization(ctx, t, debugv1.NewDebugClient(noauthConn), map[string]bool{
-			"GetInfo": true,
+			"GetInfo":         true,
+			"VerifyCAJournal": true,
+			"RepairCAJournal": true,
 		})
 	})
 
 	t.Run("Agent", func(t *testing.T) {
 		testAuthorization(ctx, t, debugv1.NewDebugClient(agentConn), map[string]bool{
-			"GetInfo": true,
+			"GetInfo":         true,
+			"VerifyCAJournal": true,
+			"RepairCAJournal": true,
 		})
 	})
 
 	t.Run("Admin", func(t *testing.T) {
 		testAuthorization(ctx, t, debugv1.NewDebugClient(adminConn), map[string]bool{
-			"GetInfo": true,
+			"GetInfo":         true,
+			"VerifyCAJournal": true,
+			"RepairCAJournal": true,
 		})
 	})
 
 	t.Run("Downstream", func(t *testing.T) {
 		testAuthorization(ctx, t, debugv1.NewDebugClient(downstreamConn), map[string]bool{
-			"GetInfo": true,
+			"GetInfo":         true,
+			"VerifyCAJournal": true,
+			"RepairCAJournal": true,
 		})
 	})
 }
@@ -505,6 +687,8 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 		testAuthorization(ctx, t, bundlev1.NewBundleClient(udsConn), map[string]bool{
 			"GetBundle":                  true,
 			"AppendBundle":               true,
+			"PruneBundle":                true,
+			"WatchBundle":                true,
 			"PublishJWTAuthority":        false,
 			"ListFederatedBundles":       true,
 			"GetFederatedBundle":         true,
@@ -519,6 +703,8 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 		testAuthorization(ctx, t, bundlev1.NewBundleClient(noauthConn), map[string]bool{
 			"GetBundle":                  true,
 			"AppendBundle":               false,
+			"PruneBundle":                false,
+			"WatchBundle":                false,
 			"PublishJWTAuthority":        false,
 			"ListFederatedBundles":       false,
 			"GetFederatedBundle":         false,
@@ -533,6 +719,8 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 		testAuthorization(ctx, t, bundlev1.NewBundleClient(agentConn), map[string]bool{
 			"GetBundle":                  true,
 			"AppendBundle":               false,
+			"PruneBundle":                false,
+			"WatchBundle":                false,
 			"PublishJWTAuthority":        false,
 			"ListFederatedBundles":       false,
 			"GetFederatedBundle":         true,
@@ -547,6 +735,8 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 		testAuthorization(ctx, t, bundlev1.NewBundleClient(adminConn), map[string]bool{
 			"GetBundle":                  true,
 			"AppendBundle":               true,
+			"PruneBundle":                true,
+			"WatchBundle":                true,
 			"PublishJWTAuthority":        false,
 			"ListFederatedBundles":       true,
 			"GetFederatedBundle":         true,
@@ -561,6 +751,8 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 		testAuthorization(ctx, t, bundlev1.NewBundleClient(downstreamConn), map[string]bool{
 			"GetBundle":                  true,
 			"AppendBundle":               false,
+			"PruneBundle":                false,
+			"WatchBundle":                false,
 			"PublishJWTAuthority":        true,
 			"ListFederatedBundles":       false,
 			"GetFederatedBundle":         false,
@@ -575,56 +767,66 @@ func testBundleAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agent
 func testEntryAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentConn, adminConn, downstreamConn *grpc.ClientConn) {
 	t.Run("UDS", func(t *testing.T) {
 		testAuthorization(ctx, t, entryv1.NewEntryClient(udsConn), map[string]bool{
-			"ListEntries":          true,
-			"GetEntry":             true,
-			"BatchCreateEntry":     true,
-			"BatchUpdateEntry":     true,
-			"BatchDeleteEntry":     true,
-			"GetAuthorizedEntries": false,
+			"ListEntries":               true,
+			"GetEntry":                  true,
+			"BatchCreateEntry":          true,
+			"BatchUpdateEntry":          true,
+			"BatchDeleteEntry":          true,
+			"ListEntryTombstones":       true,
+			"GetAgentAuthorizedEntries": true,
+			"GetAuthorizedEntries":      false,
 		})
 	})
 
 	t.Run("NoAuth", func(t *testing.T) {
 		testAuthorization(ctx, t, entryv1.NewEntryClient(noauthConn), map[string]bool{
-			"ListEntries":          false,
-			"GetEntry":             false,
-			"BatchCreateEntry":     false,
-			"BatchUpdateEntry":     false,
-			"BatchDeleteEntry":     false,
-			"GetAuthorizedEntries": false,
+			"ListEntries":               false,
+			"GetEntry":                  false,
+			"BatchCreateEntry":          false,
+			"BatchUpdateEntry":          false,
+			"BatchDeleteEntry":          false,
+			"ListEntryTombstones":       false,
+			"GetAgentAuthorizedEntries": false,
+			"GetAuthorizedEntries":      false,
 		})
 	})
 
 	t.Run("Agent", func(t *testing.T) {
 		testAuthorization(ctx, t, entryv1.NewEntryClient(agentConn), map[string]bool{
-			"ListEntries":          false,
-			"GetEntry":             false,
-			"BatchCreateEntry":     false,
-			"BatchUpdateEntry":     false,
-			"BatchDeleteEntry":     false,
-			"GetAuthorizedEntries": true,
+			"ListEntries":               false,
+			"GetEntry":                  false,
+			"BatchCreateEntry":          false,
+			"BatchUpdateEntry":          false,
+			"BatchDeleteEntry":          false,
+			"ListEntryTombstones":       false,
+			"GetAgentAuthorizedEntries": false,
+			"GetAuthorizedEntries":      true,
 		})
 	})
 
 	t.Run("Admin", func(t *testing.T) {
 		testAuthorization(ctx, t, entryv1.NewEntryClient(adminConn), map[string]bool{
-			"ListEntries":          true,
-			"GetEntry":             true,
-			"BatchCreateEntry":     true,
-			"BatchUpdateEntry":     true,
-			"BatchDeleteEntry":     true,
-			"GetAuthorizedEntries": false,
+			"ListEntries":               true,
+			"GetEntry":                  true,
+			"BatchCreateEntry":          true,
+			"BatchUpdateEntry":          true,
+			"BatchDeleteEntry":          true,
+			"ListEntryTombstones":       true,
+			"GetAgentAuthorizedEntries": true,
+			"GetAuthorizedEntries":      false,
 		})
 	})
 
 	t.Run("Downstream", func(t *testing.T) {
 		testAuthorization(ctx, t, entryv1.NewEntryClient(downstreamConn), map[string]bool{
-			"ListEntries":          false,
-			"GetEntry":             false,
-			"BatchCreateEntry":     false,
-			"BatchUpdateEntry":     false,
-			"BatchDeleteEntry":     false,
-			"GetAuthorizedEntries": false,
+			"ListEntries":               false,
+			"GetEntry":                  false,
+			"BatchCreateEntry":          false,
+			"BatchUpdateEntry":          false,
+			"BatchDeleteEntry":          false,
+			"ListEntryTombstones":       false,
+			"GetAgentAuthorizedEntries": false,
+			"GetAuthorizedEntries":      false,
 		})
 	})
 }
@@ -637,6 +839,7 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 			"BatchNewX509SVID":    false,
 			"NewJWTSVID":          false,
 			"NewDownstreamX509CA": false,
+			"ValidateJWTSVID":     true,
 		})
 	})
 
@@ -647,6 +850,7 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 			"BatchNewX509SVID":    false,
 			"NewJWTSVID":          false,
 			"NewDownstreamX509CA": false,
+			"ValidateJWTSVID":     false,
 		})
 	})
 
@@ -657,6 +861,7 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 			"BatchNewX509SVID":    true,
 			"NewJWTSVID":          true,
 			"NewDownstreamX509CA": false,
+			"ValidateJWTSVID":     false,
 		})
 	})
 
@@ -667,6 +872,7 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 			"BatchNewX509SVID":    false,
 			"NewJWTSVID":          false,
 			"NewDownstreamX509CA": false,
+			"ValidateJWTSVID":     true,
 		})
 	})
 
@@ -677,6 +883,64 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 			"BatchNewX509SVID":    false,
 			"NewJWTSVID":          false,
 			"NewDownstreamX509CA": true,
+			"ValidateJWTSVID":     false,
+		})
+	})
+}
+
+func testTrustDomainAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentConn, adminConn, downstreamConn *grpc.ClientConn) {
+	t.Run("UDS", func(t *testing.T) {
+		testAuthorization(ctx, t, trustdomainv1.NewTrustDomainClient(udsConn), map[string]bool{
+			"ListFederationRelationships":       true,
+			"GetFederationRelationship":         true,
+			"BatchCreateFederationRelationship": true,
+			"BatchUpdateFederationRelationship": true,
+			"BatchDeleteFederationRelationship": true,
+			"RefreshBundle":                     true,
+		})
+	})
+
+	t.Run("NoAuth", func(t *testing.T) {
+		testAuthorization(ctx, t, trustdomainv1.NewTrustDomainClient(noauthConn), map[string]bool{
+			"ListFederationRelationships":       false,
+			"GetFederationRelationship":         false,
+			"BatchCreateFederationRelationship": false,
+			"BatchUpdateFederationRelationship": false,
+			"BatchDeleteFederationRelationship": false,
+			"RefreshBundle":                     false,
+		})
+	})
+
+	t.Run("Agent", func(t *testing.T) {
+		testAuthorization(ctx, t, trustdomainv1.NewTrustDomainClient(agentConn), map[string]bool{
+			"ListFederationRelationships":       false,
+			"GetFederationRelationship":         false,
+			"BatchCreateFederationRelationship": false,
+			"BatchUpdateFederationRelationship": false,
+			"BatchDeleteFederationRelationship": false,
+			"RefreshBundle":                     false,
+		})
+	})
+
+	t.Run("Admin", func(t *testing.T) {
+		testAuthorization(ctx, t, trustdomainv1.NewTrustDomainClient(adminConn), map[string]bool{
+			"ListFederationRelationships":       true,
+			"GetFederationRelationship":         true,
+			"BatchCreateFederationRelationship": true,
+			"BatchUpdateFederationRelationship": true,
+			"BatchDeleteFederationRelationship": true,
+			"RefreshBundle":                     true,
+		})
+	})
+
+	t.Run("Downstream", func(t *testing.T) {
+		testAuthorization(ctx, t, trustdomainv1.NewTrustDomainClient(downstreamConn), map[string]bool{
+			"ListFederationRelationships":       false,
+			"GetFederationRelationship":         false,
+			"BatchCreateFederationRelationship": false,
+			"BatchUpdateFederationRelationship": false,
+			"BatchDeleteFederationRelationship": false,
+			"RefreshBundle":                     false,
 		})
 	})
 }
@@ -685,6 +949,32 @@ func testSVIDAPI(ctx context.Context, t *testing.T, udsConn, noauthConn, agentCo
 // asserts that the RPC was authorized or not. If a method is not represented
 // in the expectedAuthResults, or a method in expectedAuthResults does not
 // belong to the client interface, the test will fail.
+func listServices(ctx context.Context, conn *grpc.ClientConn) ([]string, error) {
+	stream, err := reflection_pb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		_ = stream.CloseSend()
+	}()
+
+	if err := stream.Send(&reflection_pb.ServerReflectionRequest{
+		MessageRequest: &reflection_pb.ServerReflectionRequest_ListServices{},
+	}); err != nil {
+		return nil, err
+	}
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+
+	var services []string
+	for _, service := range resp.GetListServicesResponse().GetService() {
+		services = append(services, service.Name)
+	}
+	return services, nil
+}
+
 func testAuthorization(ctx context.Context, t *testing.T, client interface{}, expectedAuthResults map[string]bool) {
 	cv := reflect.ValueOf(client)
 	ct := cv.Type()
@@ -696,8 +986,9 @@ func testAuthorization(ctx context.Context, t *testing.T, client interface{}, ex
 		t.Run(methodName, func(t *testing.T) {
 			var out []reflect.Value
 
-			if mv.Type().NumIn() == 2 {
-				// server-stream method
+			switch {
+			case mv.Type().NumIn() == 2:
+				// bidirectional stream method
 				out = mv.Call([]reflect.Value{reflect.ValueOf(ctx)})
 				require.Len(t, out, 2)
 				// assert there is no failure
@@ -705,7 +996,16 @@ func testAuthorization(ctx context.Context, t *testing.T, client interface{}, ex
 				// Now call the Recv() method on the stream
 				rv := out[0].MethodByName("Recv")
 				out = rv.Call([]reflect.Value{})
-			} else {
+			case mt.Out(0).Kind() == reflect.Interface:
+				// server-stream method
+				out = mv.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.New(mt.In(1).Elem())})
+				require.Len(t, out, 2)
+				// assert there is no failure
+				require.Nil(t, out[1].Interface())
+				// Now call the Recv() method on the stream
+				rv := out[0].MethodByName("Recv")
+				out = rv.Call([]reflect.Value{})
+			default:
 				// unary method
 				out = mv.Call([]reflect.Value{reflect.ValueOf(ctx), reflect.New(mt.In(1).Elem())})
 			}
@@ -826,9 +1126,9 @@ func (s *bundleEndpointServer) Used() bool {
 	return s.used
 }
 
-func makeBundle(ca *testca.CA) *common.Bundle {
+func makeBundle(ca *testca.CA, td spiffeid.TrustDomain) *common.Bundle {
 	bundle := &common.Bundle{
-		TrustDomainId: testTD.IDString(),
+		TrustDomainId: td.IDString(),
 	}
 
 	for _, x509Authority := range ca.X509Authorities() {
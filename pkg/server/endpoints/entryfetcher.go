@@ -14,7 +14,14 @@ import (
 )
 
 const (
+	// cacheReloadInterval is the interval at which the cache is rebuilt to
+	// pick up changes made by other servers sharing the datastore
 	cacheReloadInterval = 5 * time.Second
+
+	// cacheMinReloadInterval is the minimum interval between rebuilds
+	// triggered by changes, so bursts of changes do not keep the datastore
+	// busy listing everything
+	cacheMinReloadInterval = time.Second
 )
 
 var _ api.AuthorizedEntryFetcher = (*AuthorizedEntryFetcherWithFullCache)(nil)
@@ -23,13 +30,17 @@ type entryCacheBuilderFn func(ctx context.Context) (entrycache.Cache, error)
 
 type AuthorizedEntryFetcherWithFullCache struct {
 	buildCache entryCacheBuilderFn
+	changed    <-chan struct{}
 	cache      entrycache.Cache
 	clk        clock.Clock
 	log        logrus.FieldLogger
 	mu         sync.RWMutex
 }
 
-func NewAuthorizedEntryFetcherWithFullCache(ctx context.Context, buildCache entryCacheBuilderFn, log logrus.FieldLogger, clk clock.Clock) (*AuthorizedEntryFetcherWithFullCache, error) {
+// NewAuthorizedEntryFetcherWithFullCache builds the in-memory entry cache.
+// The cache is rebuilt periodically and, if changed is not nil, soon after
+// each value received on changed.
+func NewAuthorizedEntryFetcherWithFullCache(ctx context.Context, buildCache entryCacheBuilderFn, changed <-chan struct{}, log logrus.FieldLogger, clk clock.Clock) (*AuthorizedEntryFetcherWithFullCache, error) {
 	log.Info("Building in-memory entry cache")
 	cache, err := buildCache(ctx)
 	if err != nil {
@@ -39,6 +50,7 @@ func NewAuthorizedEntryFetcherWithFullCache(ctx context.Context, buildCache entr
 	log.Info("Completed building in-memory entry cache")
 	return &AuthorizedEntryFetcherWithFullCache{
 		buildCache: buildCache,
+		changed:    changed,
 		cache:      cache,
 		clk:        clk,
 		log:        log,
@@ -51,9 +63,12 @@ func (a *AuthorizedEntryFetcherWithFullCache) FetchAuthorizedEntries(ctx context
 	return a.cache.GetAuthorizedEntries(agentID), nil
 }
 
-// RunRebuildCacheTask starts a ticker which rebuilds the in-memory entry cache.
+// RunRebuildCacheTask rebuilds the in-memory entry cache periodically and
+// when entries change.
 func (a *AuthorizedEntryFetcherWithFullCache) RunRebuildCacheTask(ctx context.Context) error {
+	lastRebuild := a.clk.Now()
 	rebuild := func() {
+		lastRebuild = a.clk.Now()
 		cache, err := a.buildCache(ctx)
 		if err != nil {
 			a.log.WithError(err).Error("Failed to reload entry cache")
@@ -71,6 +86,16 @@ func (a *AuthorizedEntryFetcherWithFullCache) RunRebuildCacheTask(ctx context.Co
 			return nil
 		case <-a.clk.After(cacheReloadInterval):
 			rebuild()
+		case <-a.changed:
+			if wait := cacheMinReloadInterval - a.clk.Now().Sub(lastRebuild); wait > 0 {
+				select {
+				case <-ctx.Done():
+					a.log.Debug("Stopping in-memory entry cache hydrator")
+					return nil
+				case <-a.clk.After(wait):
+				}
+			}
+			rebuild()
 		}
 	}
 }
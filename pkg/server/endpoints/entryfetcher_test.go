@@ -47,7 +47,7 @@ func TestNewAuthorizedEntryFetcherWithFullCache(t *testing.T) {
 		return newStaticEntryCache(entries), nil
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, log, clk)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, nil, log, clk)
 	assert.NoError(t, err)
 	assert.NotNil(t, ef)
 }
@@ -61,7 +61,7 @@ func TestNewAuthorizedEntryFetcherWithFullCacheErrorBuildingCache(t *testing.T)
 		return nil, errors.New("some cache build error")
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, log, clk)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, nil, log, clk)
 	assert.Error(t, err)
 	assert.Nil(t, ef)
 }
@@ -81,7 +81,7 @@ func TestFetchRegistrationEntries(t *testing.T) {
 		return newStaticEntryCache(entries), nil
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCacheFn, log, clk)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCacheFn, nil, log, clk)
 	require.NoError(t, err)
 	require.NotNil(t, ef)
 
@@ -150,7 +150,7 @@ func TestRunRebuildCacheTask(t *testing.T) {
 		}
 	}
 
-	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, log, clk)
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, nil, log, clk)
 	require.NoError(t, err)
 	require.NotNil(t, ef)
 
@@ -216,6 +216,55 @@ func TestRunRebuildCacheTask(t *testing.T) {
 	sendResult(req, entryMap, nil)
 }
 
+func TestRunRebuildCacheTaskOnChange(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	log, _ := test.NewNullLogger()
+	clk := clock.NewMock(t)
+	changed := make(chan struct{}, 1)
+
+	builds := make(chan struct{}, 10)
+	buildCache := func(context.Context) (entrycache.Cache, error) {
+		builds <- struct{}{}
+		return newStaticEntryCache(nil), nil
+	}
+	waitForBuild := func() {
+		select {
+		case <-builds:
+		case <-ctx.Done():
+			t.Fatal("timed out waiting for the cache to be rebuilt")
+		}
+	}
+
+	ef, err := NewAuthorizedEntryFetcherWithFullCache(ctx, buildCache, changed, log, clk)
+	require.NoError(t, err)
+	waitForBuild()
+
+	watchErr := make(chan error, 1)
+	go func() {
+		watchErr <- ef.RunRebuildCacheTask(ctx)
+	}()
+	clk.WaitForAfter(time.Minute, "waiting for the reload timer")
+
+	// A change right after a rebuild waits for the minimum reload interval
+	changed <- struct{}{}
+	clk.WaitForAfter(time.Minute, "waiting for the minimum reload interval timer")
+	assert.Empty(t, builds)
+	clk.Add(cacheMinReloadInterval)
+	waitForBuild()
+
+	// Otherwise, the cache is rebuilt right away, before the reload interval
+	// elapses
+	clk.WaitForAfter(time.Minute, "waiting for the reload timer")
+	clk.Add(2 * cacheMinReloadInterval)
+	changed <- struct{}{}
+	waitForBuild()
+
+	cancel()
+	assert.NoError(t, <-watchErr)
+}
+
 func setupExpectedEntriesData(t *testing.T, agentID spiffeid.ID) []*types.Entry {
 	const numEntries = 2
 	entryIDs := make([]spiffeid.ID, numEntries)
@@ -11,6 +11,7 @@ import (
 	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/api/bundle/v1"
 	"github.com/spiffe/spire/pkg/server/api/middleware"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/cache/entrycache"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
@@ -30,60 +31,17 @@ const (
 	entriesCacheSize = 500_000
 )
 
-func Middleware(log logrus.FieldLogger, metrics telemetry.Metrics, ds datastore.DataStore, clk clock.Clock, rlConf RateLimitConfig) middleware.Middleware {
+func Middleware(log logrus.FieldLogger, metrics telemetry.Metrics, ds datastore.DataStore, clk clock.Clock, rateLimits *middleware.ReloadableRateLimits, policyEngine *authpolicy.Engine, adminIDs []spiffeid.ID) middleware.Middleware {
 	return middleware.Chain(
 		middleware.WithLogger(log),
+		middleware.WithTracing(),
+		middleware.WithServerTime(clk),
 		middleware.WithMetrics(metrics),
-		middleware.WithAuthorization(Authorization(log, ds, clk)),
-		middleware.WithRateLimits(RateLimits(rlConf)),
+		middleware.WithAuthorization(policyEngine, EntryFetcher(ds), AgentAuthorizer(log, ds, clk), adminIDs),
+		middleware.WithReloadableRateLimits(rateLimits, metrics),
 	)
 }
 
-func Authorization(log logrus.FieldLogger, ds datastore.DataStore, clk clock.Clock) map[string]middleware.Authorizer {
-	agentAuthorizer := AgentAuthorizer(log, ds, clk)
-	entryFetcher := EntryFetcher(ds)
-
-	any := middleware.AuthorizeAny()
-	local := middleware.AuthorizeLocal()
-	agent := middleware.AuthorizeAgent(agentAuthorizer)
-	downstream := middleware.AuthorizeDownstream(entryFetcher)
-	admin := middleware.AuthorizeAdmin(entryFetcher)
-
-	localOrAdmin := middleware.AuthorizeAnyOf(local, admin)
-	localOrAdminOrAgent := middleware.AuthorizeAnyOf(local, admin, agent)
-
-	return map[string]middleware.Authorizer{
-		"/spire.api.server.svid.v1.SVID/MintX509SVID":                   localOrAdmin,
-		"/spire.api.server.svid.v1.SVID/MintJWTSVID":                    localOrAdmin,
-		"/spire.api.server.svid.v1.SVID/BatchNewX509SVID":               agent,
-		"/spire.api.server.svid.v1.SVID/NewJWTSVID":                     agent,
-		"/spire.api.server.svid.v1.SVID/NewDownstreamX509CA":            downstream,
-		"/spire.api.server.bundle.v1.Bundle/GetBundle":                  any,
-		"/spire.api.server.bundle.v1.Bundle/AppendBundle":               localOrAdmin,
-		"/spire.api.server.bundle.v1.Bundle/PublishJWTAuthority":        downstream,
-		"/spire.api.server.bundle.v1.Bundle/ListFederatedBundles":       localOrAdmin,
-		"/spire.api.server.bundle.v1.Bundle/GetFederatedBundle":         localOrAdminOrAgent,
-		"/spire.api.server.bundle.v1.Bundle/BatchCreateFederatedBundle": localOrAdmin,
-		"/spire.api.server.bundle.v1.Bundle/BatchUpdateFederatedBundle": localOrAdmin,
-		"/spire.api.server.bundle.v1.Bundle/BatchSetFederatedBundle":    localOrAdmin,
-		"/spire.api.server.bundle.v1.Bundle/BatchDeleteFederatedBundle": localOrAdmin,
-		"/spire.api.server.debug.v1.Debug/GetInfo":                      local,
-		"/spire.api.server.entry.v1.Entry/ListEntries":                  localOrAdmin,
-		"/spire.api.server.entry.v1.Entry/GetEntry":                     localOrAdmin,
-		"/spire.api.server.entry.v1.Entry/BatchCreateEntry":             localOrAdmin,
-		"/spire.api.server.entry.v1.Entry/BatchUpdateEntry":             localOrAdmin,
-		"/spire.api.server.entry.v1.Entry/BatchDeleteEntry":             localOrAdmin,
-		"/spire.api.server.entry.v1.Entry/GetAuthorizedEntries":         agent,
-		"/spire.api.server.agent.v1.Agent/ListAgents":                   localOrAdmin,
-		"/spire.api.server.agent.v1.Agent/GetAgent":                     localOrAdmin,
-		"/spire.api.server.agent.v1.Agent/DeleteAgent":                  localOrAdmin,
-		"/spire.api.server.agent.v1.Agent/BanAgent":                     localOrAdmin,
-		"/spire.api.server.agent.v1.Agent/AttestAgent":                  any,
-		"/spire.api.server.agent.v1.Agent/RenewAgent":                   agent,
-		"/spire.api.server.agent.v1.Agent/CreateJoinToken":              localOrAdmin,
-	}
-}
-
 func EntryFetcher(ds datastore.DataStore) middleware.EntryFetcher {
 	return middleware.EntryFetcherFunc(func(ctx context.Context, id spiffeid.ID) ([]*types.Entry, error) {
 		resp, err := ds.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{
@@ -193,44 +151,101 @@ func RateLimits(config RateLimitConfig) map[string]api.RateLimiter {
 	noLimit := middleware.NoLimit()
 	attestLimit := middleware.DisabledLimit()
 	if config.Attestation {
-		attestLimit = middleware.PerIPLimit(node_pb.AttestLimit)
+		attestLimit = attestationLimit(config)
 	}
-	csrLimit := middleware.PerIPLimit(node_pb.CSRLimit)
-	jsrLimit := middleware.PerIPLimit(node_pb.JSRLimit)
+	csrLimit := perIPAndCallerLimit(limitOrDefault(config.CSRLimit, node_pb.CSRLimit), config.CSRPerCallerLimit)
+	jsrLimit := perIPAndCallerLimit(limitOrDefault(config.JWTLimit, node_pb.JSRLimit), config.JWTPerCallerLimit)
 	pushJWTKeyLimit := middleware.PerIPLimit(node_pb.PushJWTKeyLimit)
 
 	return map[string]api.RateLimiter{
-		"/spire.api.server.svid.v1.SVID/MintX509SVID":                   noLimit,
-		"/spire.api.server.svid.v1.SVID/MintJWTSVID":                    noLimit,
-		"/spire.api.server.svid.v1.SVID/BatchNewX509SVID":               csrLimit,
-		"/spire.api.server.svid.v1.SVID/NewJWTSVID":                     jsrLimit,
-		"/spire.api.server.svid.v1.SVID/NewDownstreamX509CA":            csrLimit,
-		"/spire.api.server.bundle.v1.Bundle/GetBundle":                  noLimit,
-		"/spire.api.server.bundle.v1.Bundle/AppendBundle":               noLimit,
-		"/spire.api.server.bundle.v1.Bundle/PublishJWTAuthority":        pushJWTKeyLimit,
-		"/spire.api.server.bundle.v1.Bundle/ListFederatedBundles":       noLimit,
-		"/spire.api.server.bundle.v1.Bundle/GetFederatedBundle":         noLimit,
-		"/spire.api.server.bundle.v1.Bundle/BatchCreateFederatedBundle": noLimit,
-		"/spire.api.server.bundle.v1.Bundle/BatchUpdateFederatedBundle": noLimit,
-		"/spire.api.server.bundle.v1.Bundle/BatchSetFederatedBundle":    noLimit,
-		"/spire.api.server.bundle.v1.Bundle/BatchDeleteFederatedBundle": noLimit,
-		"/spire.api.server.debug.v1.Debug/GetInfo":                      noLimit,
-		"/spire.api.server.entry.v1.Entry/ListEntries":                  noLimit,
-		"/spire.api.server.entry.v1.Entry/GetEntry":                     noLimit,
-		"/spire.api.server.entry.v1.Entry/BatchCreateEntry":             noLimit,
-		"/spire.api.server.entry.v1.Entry/BatchUpdateEntry":             noLimit,
-		"/spire.api.server.entry.v1.Entry/BatchDeleteEntry":             noLimit,
-		"/spire.api.server.entry.v1.Entry/GetAuthorizedEntries":         noLimit,
-		"/spire.api.server.agent.v1.Agent/ListAgents":                   noLimit,
-		"/spire.api.server.agent.v1.Agent/GetAgent":                     noLimit,
-		"/spire.api.server.agent.v1.Agent/DeleteAgent":                  noLimit,
-		"/spire.api.server.agent.v1.Agent/BanAgent":                     noLimit,
-		"/spire.api.server.agent.v1.Agent/AttestAgent":                  attestLimit,
-		"/spire.api.server.agent.v1.Agent/RenewAgent":                   csrLimit,
-		"/spire.api.server.agent.v1.Agent/CreateJoinToken":              noLimit,
+		"/spire.api.server.svid.v1.SVID/MintX509SVID":                                    noLimit,
+		"/spire.api.server.svid.v1.SVID/MintJWTSVID":                                     noLimit,
+		"/spire.api.server.svid.v1.SVID/BatchNewX509SVID":                                csrLimit,
+		"/spire.api.server.svid.v1.SVID/NewJWTSVID":                                      jsrLimit,
+		"/spire.api.server.svid.v1.SVID/NewDownstreamX509CA":                             csrLimit,
+		"/spire.api.server.svid.v1.SVID/ValidateJWTSVID":                                 noLimit,
+		"/spire.api.server.bundle.v1.Bundle/GetBundle":                                   noLimit,
+		"/spire.api.server.bundle.v1.Bundle/AppendBundle":                                noLimit,
+		"/spire.api.server.bundle.v1.Bundle/PruneBundle":                                 noLimit,
+		"/spire.api.server.bundle.v1.Bundle/WatchBundle":                                 noLimit,
+		"/spire.api.server.bundle.v1.Bundle/PublishJWTAuthority":                         pushJWTKeyLimit,
+		"/spire.api.server.bundle.v1.Bundle/ListFederatedBundles":                        noLimit,
+		"/spire.api.server.bundle.v1.Bundle/GetFederatedBundle":                          noLimit,
+		"/spire.api.server.bundle.v1.Bundle/BatchCreateFederatedBundle":                  noLimit,
+		"/spire.api.server.bundle.v1.Bundle/BatchUpdateFederatedBundle":                  noLimit,
+		"/spire.api.server.bundle.v1.Bundle/BatchSetFederatedBundle":                     noLimit,
+		"/spire.api.server.bundle.v1.Bundle/BatchDeleteFederatedBundle":                  noLimit,
+		"/spire.api.server.debug.v1.Debug/GetInfo":                                       noLimit,
+		"/spire.api.server.debug.v1.Debug/VerifyCAJournal":                               noLimit,
+		"/spire.api.server.debug.v1.Debug/RepairCAJournal":                               noLimit,
+		"/spire.api.server.entry.v1.Entry/ListEntries":                                   noLimit,
+		"/spire.api.server.entry.v1.Entry/GetEntry":                                      noLimit,
+		"/spire.api.server.entry.v1.Entry/BatchCreateEntry":                              noLimit,
+		"/spire.api.server.entry.v1.Entry/BatchUpdateEntry":                              noLimit,
+		"/spire.api.server.entry.v1.Entry/BatchDeleteEntry":                              noLimit,
+		"/spire.api.server.entry.v1.Entry/GetAuthorizedEntries":                          noLimit,
+		"/spire.api.server.entry.v1.Entry/GetAgentAuthorizedEntries":                     noLimit,
+		"/spire.api.server.entry.v1.Entry/ListEntryTombstones":                           noLimit,
+		"/spire.api.server.agent.v1.Agent/ListAgents":                                    noLimit,
+		"/spire.api.server.agent.v1.Agent/GetAgent":                                      noLimit,
+		"/spire.api.server.agent.v1.Agent/DeleteAgent":                                   noLimit,
+		"/spire.api.server.agent.v1.Agent/BanAgent":                                      noLimit,
+		"/spire.api.server.agent.v1.Agent/AttestAgent":                                   attestLimit,
+		"/spire.api.server.agent.v1.Agent/RenewAgent":                                    csrLimit,
+		"/spire.api.server.agent.v1.Agent/CreateJoinToken":                               noLimit,
+		"/spire.api.server.agent.v1.Agent/ListJoinTokens":                                noLimit,
+		"/spire.api.server.agent.v1.Agent/RevokeJoinToken":                               noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/ListFederationRelationships":       noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/GetFederationRelationship":         noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/BatchCreateFederationRelationship": noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/BatchUpdateFederationRelationship": noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/BatchDeleteFederationRelationship": noLimit,
+		"/spire.api.server.trustdomain.v1.TrustDomain/RefreshBundle":                     noLimit,
+		"/grpc.reflection.v1alpha.ServerReflection/ServerReflectionInfo":                 noLimit,
 	}
 }
 
+func limitOrDefault(limit, defaultLimit int) int {
+	if limit > 0 {
+		return limit
+	}
+	return defaultLimit
+}
+
+// attestationLimit returns the per-ip node attestation limiter, combined with
+// the per attestation type limiter and the failure ban when configured.
+func attestationLimit(config RateLimitConfig) api.RateLimiter {
+	limiters := []api.RateLimiter{
+		middleware.PerIPLimit(limitOrDefault(config.AttestationLimit, node_pb.AttestLimit)),
+	}
+	if config.AttestationPerTypeLimit > 0 {
+		limiters = append(limiters, middleware.PerAttestationTypeLimit(config.AttestationPerTypeLimit))
+	}
+	if config.AttestationBanThreshold > 0 {
+		// The ban is checked first so that banned addresses don't consume
+		// the per-ip and per-type limits.
+		limiters = append([]api.RateLimiter{
+			middleware.PerIPFailureBan(config.AttestationBanThreshold, config.AttestationBanDuration),
+		}, limiters...)
+	}
+	if len(limiters) == 1 {
+		return limiters[0]
+	}
+	return middleware.AllLimits(limiters...)
+}
+
+// perIPAndCallerLimit returns a per-ip limiter that is combined with a
+// per-caller limiter when a per-caller limit is configured.
+func perIPAndCallerLimit(perIPLimit, perCallerLimit int) api.RateLimiter {
+	if perCallerLimit <= 0 {
+		return middleware.PerIPLimit(perIPLimit)
+	}
+	return middleware.AllLimits(
+		middleware.PerIPLimit(perIPLimit),
+		middleware.PerCallerLimit(perCallerLimit),
+	)
+}
+
 func unaryInterceptorMux(oldInterceptor, newInterceptor grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
 	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp interface{}, err error) {
 		if !isOldAPI(info.FullMethod) {
@@ -249,6 +264,26 @@ func streamInterceptorMux(oldInterceptor, newInterceptor grpc.StreamServerInterc
 	}
 }
 
+// chainUnaryInterceptors returns an interceptor that calls the outer
+// interceptor, which in turn calls the inner one before the handler
+func chainUnaryInterceptors(outer, inner grpc.UnaryServerInterceptor) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		return outer(ctx, req, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+			return inner(ctx, req, info, handler)
+		})
+	}
+}
+
+// chainStreamInterceptors returns an interceptor that calls the outer
+// interceptor, which in turn calls the inner one before the handler
+func chainStreamInterceptors(outer, inner grpc.StreamServerInterceptor) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return outer(srv, ss, info, func(srv interface{}, ss grpc.ServerStream) error {
+			return inner(srv, ss, info, handler)
+		})
+	}
+}
+
 func isOldAPI(fullMethod string) bool {
 	return strings.HasPrefix(fullMethod, "/spire.api.node.") ||
 		strings.HasPrefix(fullMethod, "/spire.api.registration.")
@@ -22,6 +22,7 @@ import (
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_common "github.com/spiffe/spire/pkg/common/telemetry/common"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
+	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/cache/dscache"
 	"github.com/spiffe/spire/pkg/server/cache/entrycache"
@@ -414,6 +415,12 @@ func (h *Handler) FetchJWTSVID(ctx context.Context, req *node.FetchJWTSVIDReques
 		log.Error("Request missing audience")
 		return nil, status.Error(codes.InvalidArgument, "request missing audience")
 	}
+	for _, audience := range req.Jsr.Audience {
+		if audience == "" {
+			log.Error("Request has an empty audience")
+			return nil, status.Error(codes.InvalidArgument, "request has an empty audience")
+		}
+	}
 
 	agentID, err := getSpiffeIDFromCert(peerCert)
 	log = log.WithFields(logrus.Fields{
@@ -434,16 +441,24 @@ func (h *Handler) FetchJWTSVID(ctx context.Context, req *node.FetchJWTSVIDReques
 	}
 
 	found := false
+	audienceAllowed := false
 	for _, candidateEntry := range regEntries {
 		if candidateEntry.SpiffeId == req.Jsr.SpiffeId {
 			found = true
-			break
+			if api.AudiencesAllowed(candidateEntry.AllowedAudiences, req.Jsr.Audience) {
+				audienceAllowed = true
+				break
+			}
 		}
 	}
 
-	if !found {
+	switch {
+	case !found:
 		log.Error("Caller is not authorized")
 		return nil, status.Error(codes.PermissionDenied, "caller is not authorized")
+	case !audienceAllowed:
+		log.WithField(telemetry.Audience, req.Jsr.Audience).Error("Audience is not allowed for the entry")
+		return nil, status.Error(codes.PermissionDenied, "audience is not allowed for the entry")
 	}
 
 	id, err := spiffeid.FromString(req.Jsr.SpiffeId)
@@ -997,6 +1012,7 @@ func (h *Handler) buildSVID(ctx context.Context, id string, csr *CSR, regEntries
 		PublicKey: csr.PublicKey,
 		TTL:       time.Duration(entry.Ttl) * time.Second,
 		DNSList:   entry.DnsNames,
+		EntryID:   entry.EntryId,
 	})
 	if err != nil {
 		return nil, err
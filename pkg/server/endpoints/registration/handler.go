@@ -46,6 +46,9 @@ type Handler struct {
 	Catalog     catalog.Catalog
 	TrustDomain spiffeid.TrustDomain
 	ServerCA    ca.ServerCA
+
+	// DNSNamePolicy, if set, restricts the DNS names of registration entries
+	DNSNamePolicy *api.DNSNamePolicy
 }
 
 //CreateEntry creates an entry in the Registration table,
@@ -833,6 +836,15 @@ func (h *Handler) prepareRegistrationEntry(entry *common.RegistrationEntry, forU
 			return nil, fmt.Errorf("dns name %v failed validation: %v", dns, err)
 		}
 	}
+	if err := h.DNSNamePolicy.Check(entry.DnsNames); err != nil {
+		return nil, err
+	}
+
+	for _, audience := range entry.AllowedAudiences {
+		if audience == "" {
+			return nil, errors.New("allowed audience cannot be empty")
+		}
+	}
 
 	entry.ParentId, err = idutil.NormalizeSpiffeID(entry.ParentId, idutil.AllowAnyInTrustDomain(h.TrustDomain))
 	if err != nil {
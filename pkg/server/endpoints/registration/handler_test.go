@@ -19,6 +19,7 @@ import (
 	"github.com/spiffe/spire/pkg/common/bundleutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/pkg/server/api"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/proto/spire/api/registration"
 	"github.com/spiffe/spire/proto/spire/common"
@@ -432,8 +433,9 @@ func (s *HandlerSuite) TestCreateEntry() {
 			})
 			require.NoError(t, err)
 			require.NotNil(t, entry)
-			// set ID (unknown before fetch) to do comparison
+			// set ID and creation time (unknown before fetch) to do comparison
 			testCase.Entry.EntryId = entry.Entry.EntryId
+			testCase.Entry.CreatedAt = entry.Entry.CreatedAt
 			t.Logf("actual=%+v expected=%+v", entry.Entry, testCase.Entry)
 			require.True(t, proto.Equal(entry.Entry, testCase.Entry))
 		})
@@ -1565,3 +1567,25 @@ func requireGRPCStatusCode(t *testing.T, err error, code codes.Code) {
 	s := status.Convert(err)
 	require.Equal(t, code, s.Code(), "GRPC status code should be %v", code)
 }
+
+func TestPrepareRegistrationEntryEnforcesDNSNamePolicy(t *testing.T) {
+	policy, err := api.NewDNSNamePolicy([]string{"team.example.org"}, nil)
+	require.NoError(t, err)
+	h := &Handler{
+		TrustDomain:   trustDomain,
+		DNSNamePolicy: policy,
+	}
+
+	entry := &common.RegistrationEntry{
+		ParentId:  "spiffe://example.org/parent",
+		SpiffeId:  "spiffe://example.org/child",
+		Selectors: []*common.Selector{{Type: "B", Value: "b"}},
+		DnsNames:  []string{"web.team.example.org"},
+	}
+	_, err = h.prepareRegistrationEntry(entry, false)
+	require.NoError(t, err)
+
+	entry.DnsNames = append(entry.DnsNames, "web.example.org")
+	_, err = h.prepareRegistrationEntry(entry, false)
+	require.EqualError(t, err, `DNS name "web.example.org" is not allowed by the DNS name policy`)
+}
@@ -0,0 +1,170 @@
+package awss3
+
+import (
+	"bytes"
+	"context"
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher/internal/bundleformat"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtIn(New())
+}
+
+func builtIn(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin("aws_s3",
+		bundlepublisher.PluginServer(p),
+	)
+}
+
+type s3Client interface {
+	PutObject(ctx context.Context, bucket, key, contentType string, data []byte) error
+}
+
+type pluginConfig struct {
+	Region          string `hcl:"region"`
+	Bucket          string `hcl:"bucket"`
+	ObjectKey       string `hcl:"object_key"`
+	Format          string `hcl:"format"`
+	AccessKeyID     string `hcl:"access_key_id"`
+	SecretAccessKey string `hcl:"secret_access_key"`
+
+	format bundleformat.Format
+	client s3Client
+}
+
+type Plugin struct {
+	bundlepublisher.UnsafeBundlePublisherServer
+
+	mu     sync.RWMutex
+	log    hclog.Logger
+	config *pluginConfig
+
+	hooks struct {
+		newS3Client func(c *pluginConfig) (s3Client, error)
+	}
+}
+
+func New() *Plugin {
+	p := &Plugin{}
+	p.hooks.newS3Client = newAWSS3Client
+	return p
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) PublishBundle(ctx context.Context, req *bundlepublisher.PublishBundleRequest) (*bundlepublisher.PublishBundleResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	if req.Bundle == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing bundle")
+	}
+
+	data, err := config.format.Format(req.Bundle)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to format bundle: %v", err)
+	}
+
+	if err := config.client.PutObject(ctx, config.Bucket, config.ObjectKey, config.format.ContentType(), data); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to upload bundle object %s/%s: %v", config.Bucket, config.ObjectKey, err)
+	}
+	p.log.Debug("Bundle object uploaded", "bucket", config.Bucket, "object_key", config.ObjectKey)
+	return &bundlepublisher.PublishBundleResponse{}, nil
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(pluginConfig)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if config.Region == "" {
+		return nil, status.Error(codes.InvalidArgument, "region must be set")
+	}
+	if config.Bucket == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket must be set")
+	}
+	if config.ObjectKey == "" {
+		return nil, status.Error(codes.InvalidArgument, "object_key must be set")
+	}
+
+	format, err := bundleformat.Parse(config.Format)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+	config.format = format
+
+	config.client, err = p.hooks.newS3Client(config)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to create S3 client: %v", err)
+	}
+
+	p.setConfig(config)
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(ctx context.Context, req *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*pluginConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.config == nil {
+		return nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, nil
+}
+
+func (p *Plugin) setConfig(config *pluginConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+}
+
+type awsS3Client struct {
+	client *s3.S3
+}
+
+func newAWSS3Client(c *pluginConfig) (s3Client, error) {
+	awsConfig := &aws.Config{
+		Region: aws.String(c.Region),
+	}
+	if c.AccessKeyID != "" && c.SecretAccessKey != "" {
+		awsConfig.Credentials = credentials.NewStaticCredentials(c.AccessKeyID, c.SecretAccessKey, "")
+	}
+
+	sess, err := session.NewSession(awsConfig)
+	if err != nil {
+		return nil, err
+	}
+	return &awsS3Client{
+		client: s3.New(sess),
+	}, nil
+}
+
+func (c *awsS3Client) PutObject(ctx context.Context, bucket, key, contentType string, data []byte) error {
+	_, err := c.client.PutObjectWithContext(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(bucket),
+		Key:         aws.String(key),
+		ContentType: aws.String(contentType),
+		Body:        bytes.NewReader(data),
+	})
+	return err
+}
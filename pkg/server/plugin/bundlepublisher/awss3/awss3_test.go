@@ -0,0 +1,195 @@
+package awss3
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+var testBundle = &common.Bundle{
+	TrustDomainId: "spiffe://example.org",
+	RootCas: []*common.Certificate{
+		{DerBytes: []byte("FOO")},
+	},
+}
+
+func TestConfigure(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		code   codes.Code
+		desc   string
+	}{
+		{
+			name:   "malformed",
+			config: "MALFORMED",
+			code:   codes.InvalidArgument,
+			desc:   "unable to decode configuration",
+		},
+		{
+			name: "missing region",
+			config: `
+				bucket = "the-bucket"
+				object_key = "bundle.pem"
+			`,
+			code: codes.InvalidArgument,
+			desc: "region must be set",
+		},
+		{
+			name: "missing bucket",
+			config: `
+				region = "us-east-1"
+				object_key = "bundle.pem"
+			`,
+			code: codes.InvalidArgument,
+			desc: "bucket must be set",
+		},
+		{
+			name: "missing object key",
+			config: `
+				region = "us-east-1"
+				bucket = "the-bucket"
+			`,
+			code: codes.InvalidArgument,
+			desc: "object_key must be set",
+		},
+		{
+			name: "invalid format",
+			config: `
+				region = "us-east-1"
+				bucket = "the-bucket"
+				object_key = "bundle.der"
+				format = "der"
+			`,
+			code: codes.InvalidArgument,
+			desc: `invalid format: unsupported format "der"`,
+		},
+		{
+			name: "success",
+			config: `
+				region = "us-east-1"
+				bucket = "the-bucket"
+				object_key = "bundle.json"
+				format = "spiffe"
+				access_key_id = "ACCESSKEYID"
+				secret_access_key = "SECRETACCESSKEY"
+			`,
+			code: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			raw := New()
+			raw.hooks.newS3Client = func(c *pluginConfig) (s3Client, error) {
+				return &fakeS3Client{}, nil
+			}
+			var plugin bundlepublisher.Plugin
+			spiretest.LoadPlugin(t, builtIn(raw), &plugin)
+
+			resp, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{Configuration: tt.config})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestPublishBundle(t *testing.T) {
+	client := &fakeS3Client{}
+	plugin := loadPlugin(t, client)
+
+	_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+		Bundle: testBundle,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "the-bucket", client.bucket)
+	require.Equal(t, "bundle.pem", client.key)
+	require.Equal(t, "application/x-pem-file", client.contentType)
+	require.Equal(t, "-----BEGIN CERTIFICATE-----\nRk9P\n-----END CERTIFICATE-----\n", string(client.data))
+}
+
+func TestPublishBundleFailures(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		var plugin bundlepublisher.Plugin
+		spiretest.LoadPlugin(t, BuiltIn(), &plugin)
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+			Bundle: testBundle,
+		})
+		spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "not configured")
+	})
+
+	t.Run("missing bundle", func(t *testing.T) {
+		plugin := loadPlugin(t, &fakeS3Client{})
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{})
+		spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, "missing bundle")
+	})
+
+	t.Run("upload fails", func(t *testing.T) {
+		plugin := loadPlugin(t, &fakeS3Client{err: errors.New("ohno")})
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+			Bundle: testBundle,
+		})
+		spiretest.RequireGRPCStatus(t, err, codes.Internal, "unable to upload bundle object the-bucket/bundle.pem: ohno")
+	})
+}
+
+func TestGetPluginInfo(t *testing.T) {
+	resp, err := New().GetPluginInfo(context.Background(), &spi.GetPluginInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &spi.GetPluginInfoResponse{}, resp)
+}
+
+func loadPlugin(t *testing.T, client *fakeS3Client) bundlepublisher.Plugin {
+	raw := New()
+	raw.hooks.newS3Client = func(c *pluginConfig) (s3Client, error) {
+		require.Equal(t, "us-east-1", c.Region)
+		return client, nil
+	}
+
+	var plugin bundlepublisher.Plugin
+	spiretest.LoadPlugin(t, builtIn(raw), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `
+			region = "us-east-1"
+			bucket = "the-bucket"
+			object_key = "bundle.pem"
+		`,
+	})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeS3Client struct {
+	bucket      string
+	key         string
+	contentType string
+	data        []byte
+	err         error
+}
+
+func (c *fakeS3Client) PutObject(ctx context.Context, bucket, key, contentType string, data []byte) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.bucket = bucket
+	c.key = key
+	c.contentType = contentType
+	c.data = data
+	return nil
+}
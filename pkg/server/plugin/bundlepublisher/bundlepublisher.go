@@ -0,0 +1,109 @@
+// Provides interfaces and adapters for the BundlePublisher service
+//
+// Generated code. Do not modify by hand.
+package bundlepublisher
+
+import (
+	"context"
+
+	"github.com/spiffe/spire/pkg/common/catalog"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/proto/spire/server/bundlepublisher"
+	"google.golang.org/grpc"
+)
+
+type BundlePublisherClient = bundlepublisher.BundlePublisherClient                           //nolint: golint
+type BundlePublisherServer = bundlepublisher.BundlePublisherServer                           //nolint: golint
+type PublishBundleRequest = bundlepublisher.PublishBundleRequest                             //nolint: golint
+type PublishBundleResponse = bundlepublisher.PublishBundleResponse                           //nolint: golint
+type UnimplementedBundlePublisherServer = bundlepublisher.UnimplementedBundlePublisherServer //nolint: golint
+type UnsafeBundlePublisherServer = bundlepublisher.UnsafeBundlePublisherServer               //nolint: golint
+
+const (
+	Type = "BundlePublisher"
+)
+
+// Version is the version of the BundlePublisher definition. Plugins built against another version are not loaded.
+const Version = 1
+
+// BundlePublisher is the client interface for the service type BundlePublisher interface.
+type BundlePublisher interface {
+	PublishBundle(context.Context, *PublishBundleRequest) (*PublishBundleResponse, error)
+}
+
+// Plugin is the client interface for the service with the plugin related methods used by the catalog to initialize the plugin.
+type Plugin interface {
+	Configure(context.Context, *spi.ConfigureRequest) (*spi.ConfigureResponse, error)
+	GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error)
+	PublishBundle(context.Context, *PublishBundleRequest) (*PublishBundleResponse, error)
+}
+
+// Serve serves the BundlePublisher plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server BundlePublisherServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
+// PluginServer returns a catalog PluginServer implementation for the BundlePublisher plugin.
+func PluginServer(server BundlePublisherServer) catalog.PluginServer {
+	return &pluginServer{
+		server: server,
+	}
+}
+
+type pluginServer struct {
+	server BundlePublisherServer
+}
+
+func (s pluginServer) PluginType() string {
+	return Type
+}
+
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
+func (s pluginServer) PluginClient() catalog.PluginClient {
+	return PluginClient
+}
+
+func (s pluginServer) RegisterPluginServer(server *grpc.Server) interface{} {
+	bundlepublisher.RegisterBundlePublisherServer(server, s.server)
+	return s.server
+}
+
+// PluginClient is a catalog PluginClient implementation for the BundlePublisher plugin.
+var PluginClient catalog.PluginClient = pluginClient{}
+
+type pluginClient struct{}
+
+func (pluginClient) PluginType() string {
+	return Type
+}
+
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
+func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
+	return AdaptPluginClient(bundlepublisher.NewBundlePublisherClient(conn))
+}
+
+func AdaptPluginClient(client BundlePublisherClient) BundlePublisher {
+	return pluginClientAdapter{client: client}
+}
+
+type pluginClientAdapter struct {
+	client BundlePublisherClient
+}
+
+func (a pluginClientAdapter) Configure(ctx context.Context, in *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	return a.client.Configure(ctx, in)
+}
+
+func (a pluginClientAdapter) GetPluginInfo(ctx context.Context, in *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return a.client.GetPluginInfo(ctx, in)
+}
+
+func (a pluginClientAdapter) PublishBundle(ctx context.Context, in *PublishBundleRequest) (*PublishBundleResponse, error) {
+	return a.client.PublishBundle(ctx, in)
+}
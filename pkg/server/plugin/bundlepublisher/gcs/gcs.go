@@ -0,0 +1,170 @@
+package gcs
+
+import (
+	"context"
+	"sync"
+
+	"cloud.google.com/go/storage"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher/internal/bundleformat"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/zeebo/errs"
+	"google.golang.org/api/option"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtIn(New())
+}
+
+func builtIn(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin("gcs",
+		bundlepublisher.PluginServer(p),
+	)
+}
+
+type bucketClient interface {
+	PutObject(ctx context.Context, bucket, object, contentType string, data []byte) error
+	Close() error
+}
+
+type pluginConfig struct {
+	Bucket             string `hcl:"bucket"`
+	ObjectPath         string `hcl:"object_path"`
+	Format             string `hcl:"format"`
+	ServiceAccountFile string `hcl:"service_account_file"`
+
+	format bundleformat.Format
+}
+
+type Plugin struct {
+	bundlepublisher.UnsafeBundlePublisherServer
+
+	mu     sync.RWMutex
+	log    hclog.Logger
+	config *pluginConfig
+
+	hooks struct {
+		newBucketClient func(ctx context.Context, serviceAccountFile string) (bucketClient, error)
+	}
+}
+
+func New() *Plugin {
+	p := &Plugin{}
+	p.hooks.newBucketClient = newGCSBucketClient
+	return p
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) PublishBundle(ctx context.Context, req *bundlepublisher.PublishBundleRequest) (*bundlepublisher.PublishBundleResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+	if req.Bundle == nil {
+		return nil, status.Error(codes.InvalidArgument, "missing bundle")
+	}
+
+	data, err := config.format.Format(req.Bundle)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to format bundle: %v", err)
+	}
+
+	client, err := p.hooks.newBucketClient(ctx, config.ServiceAccountFile)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to instantiate bucket client: %v", err)
+	}
+	defer client.Close()
+
+	if err := client.PutObject(ctx, config.Bucket, config.ObjectPath, config.format.ContentType(), data); err != nil {
+		return nil, status.Errorf(codes.Internal, "unable to upload bundle object %s/%s: %v", config.Bucket, config.ObjectPath, err)
+	}
+	p.log.Debug("Bundle object uploaded", "bucket", config.Bucket, "object_path", config.ObjectPath)
+	return &bundlepublisher.PublishBundleResponse{}, nil
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(pluginConfig)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if config.Bucket == "" {
+		return nil, status.Error(codes.InvalidArgument, "bucket must be set")
+	}
+	if config.ObjectPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "object_path must be set")
+	}
+
+	format, err := bundleformat.Parse(config.Format)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid format: %v", err)
+	}
+	config.format = format
+
+	p.setConfig(config)
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(ctx context.Context, req *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*pluginConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.config == nil {
+		return nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, nil
+}
+
+func (p *Plugin) setConfig(config *pluginConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+}
+
+type gcsBucketClient struct {
+	client *storage.Client
+}
+
+func newGCSBucketClient(ctx context.Context, serviceAccountFile string) (bucketClient, error) {
+	var opts []option.ClientOption
+	if serviceAccountFile != "" {
+		opts = append(opts, option.WithCredentialsFile(serviceAccountFile))
+	}
+	client, err := storage.NewClient(ctx, opts...)
+	if err != nil {
+		return nil, errs.Wrap(err)
+	}
+
+	return &gcsBucketClient{
+		client: client,
+	}, nil
+}
+
+func (c *gcsBucketClient) PutObject(ctx context.Context, bucket, object, contentType string, data []byte) error {
+	// If for whatever reason we don't make it to w.Close(), canceling the
+	// context will cleanly release resources held by the writer.
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	w := c.client.Bucket(bucket).Object(object).NewWriter(ctx)
+	w.ContentType = contentType
+	if _, err := w.Write(data); err != nil {
+		return err
+	}
+	return w.Close()
+}
+
+func (c *gcsBucketClient) Close() error {
+	return c.client.Close()
+}
@@ -0,0 +1,185 @@
+package gcs
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+var testBundle = &common.Bundle{
+	TrustDomainId: "spiffe://example.org",
+	RootCas: []*common.Certificate{
+		{DerBytes: []byte("FOO")},
+	},
+}
+
+func TestConfigure(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		code   codes.Code
+		desc   string
+	}{
+		{
+			name:   "malformed",
+			config: "MALFORMED",
+			code:   codes.InvalidArgument,
+			desc:   "unable to decode configuration",
+		},
+		{
+			name: "missing bucket",
+			config: `
+					object_path = "bundle.pem"
+			`,
+			code: codes.InvalidArgument,
+			desc: "bucket must be set",
+		},
+		{
+			name: "missing object path",
+			config: `
+					bucket = "the-bucket"
+			`,
+			code: codes.InvalidArgument,
+			desc: "object_path must be set",
+		},
+		{
+			name: "invalid format",
+			config: `
+					bucket = "the-bucket"
+				object_path = "bundle.der"
+				format = "der"
+			`,
+			code: codes.InvalidArgument,
+			desc: `invalid format: unsupported format "der"`,
+		},
+		{
+			name: "success",
+			config: `
+					bucket = "the-bucket"
+				object_path = "bundle.json"
+				format = "spiffe"
+				service_account_file = "the-service-account-file"
+			`,
+			code: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			raw := New()
+			raw.hooks.newBucketClient = func(ctx context.Context, serviceAccountFile string) (bucketClient, error) {
+				return &fakeBucketClient{}, nil
+			}
+			var plugin bundlepublisher.Plugin
+			spiretest.LoadPlugin(t, builtIn(raw), &plugin)
+
+			resp, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{Configuration: tt.config})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestPublishBundle(t *testing.T) {
+	client := &fakeBucketClient{}
+	plugin := loadPlugin(t, client)
+
+	_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+		Bundle: testBundle,
+	})
+	require.NoError(t, err)
+	require.Equal(t, "the-bucket", client.bucket)
+	require.Equal(t, "bundle.pem", client.object)
+	require.Equal(t, "application/x-pem-file", client.contentType)
+	require.Equal(t, "-----BEGIN CERTIFICATE-----\nRk9P\n-----END CERTIFICATE-----\n", string(client.data))
+}
+
+func TestPublishBundleFailures(t *testing.T) {
+	t.Run("not configured", func(t *testing.T) {
+		var plugin bundlepublisher.Plugin
+		spiretest.LoadPlugin(t, BuiltIn(), &plugin)
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+			Bundle: testBundle,
+		})
+		spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "not configured")
+	})
+
+	t.Run("missing bundle", func(t *testing.T) {
+		plugin := loadPlugin(t, &fakeBucketClient{})
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{})
+		spiretest.RequireGRPCStatus(t, err, codes.InvalidArgument, "missing bundle")
+	})
+
+	t.Run("upload fails", func(t *testing.T) {
+		plugin := loadPlugin(t, &fakeBucketClient{err: errors.New("ohno")})
+
+		_, err := plugin.PublishBundle(context.Background(), &bundlepublisher.PublishBundleRequest{
+			Bundle: testBundle,
+		})
+		spiretest.RequireGRPCStatus(t, err, codes.Internal, "unable to upload bundle object the-bucket/bundle.pem: ohno")
+	})
+}
+
+func TestGetPluginInfo(t *testing.T) {
+	resp, err := New().GetPluginInfo(context.Background(), &spi.GetPluginInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &spi.GetPluginInfoResponse{}, resp)
+}
+
+func loadPlugin(t *testing.T, client *fakeBucketClient) bundlepublisher.Plugin {
+	raw := New()
+	raw.hooks.newBucketClient = func(ctx context.Context, serviceAccountFile string) (bucketClient, error) {
+		require.Equal(t, "the-service-account-file", serviceAccountFile)
+		return client, nil
+	}
+
+	var plugin bundlepublisher.Plugin
+	spiretest.LoadPlugin(t, builtIn(raw), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `
+			bucket = "the-bucket"
+			object_path = "bundle.pem"
+			service_account_file = "the-service-account-file"
+		`,
+	})
+	require.NoError(t, err)
+	return plugin
+}
+
+type fakeBucketClient struct {
+	bucket      string
+	object      string
+	contentType string
+	data        []byte
+	err         error
+}
+
+func (c *fakeBucketClient) PutObject(ctx context.Context, bucket, object, contentType string, data []byte) error {
+	if c.err != nil {
+		return c.err
+	}
+	c.bucket = bucket
+	c.object = object
+	c.contentType = contentType
+	c.data = data
+	return nil
+}
+
+func (c *fakeBucketClient) Close() error {
+	return nil
+}
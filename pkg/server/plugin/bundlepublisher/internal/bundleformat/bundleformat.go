@@ -0,0 +1,67 @@
+// Package bundleformat formats the trust bundle for the BundlePublisher
+// plugins.
+package bundleformat
+
+import (
+	"bytes"
+	"encoding/pem"
+	"fmt"
+
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/proto/spire/common"
+)
+
+type Format string
+
+const (
+	// PEM is the PEM encoded X.509 authorities of the bundle.
+	PEM Format = "pem"
+
+	// SPIFFE is the SPIFFE bundle document (i.e. a JWKS containing both the
+	// X.509 and JWT authorities).
+	SPIFFE Format = "spiffe"
+)
+
+// Parse parses the format name. An empty name defaults to PEM.
+func Parse(name string) (Format, error) {
+	switch Format(name) {
+	case "", PEM:
+		return PEM, nil
+	case SPIFFE:
+		return SPIFFE, nil
+	default:
+		return "", fmt.Errorf("unsupported format %q", name)
+	}
+}
+
+// ContentType returns the media type of the formatted bundle.
+func (f Format) ContentType() string {
+	if f == SPIFFE {
+		return "application/json"
+	}
+	return "application/x-pem-file"
+}
+
+// Format formats the bundle.
+func (f Format) Format(bundle *common.Bundle) ([]byte, error) {
+	switch f {
+	case PEM:
+		data := new(bytes.Buffer)
+		for _, rootCA := range bundle.RootCas {
+			// no need to check the error since we're encoding into a memory buffer
+			_ = pem.Encode(data, &pem.Block{
+				Type:  "CERTIFICATE",
+				Bytes: rootCA.DerBytes,
+			})
+		}
+		return data.Bytes(), nil
+	case SPIFFE:
+		b, err := bundleutil.BundleFromProto(bundle)
+		if err != nil {
+			return nil, err
+		}
+		return bundleutil.Marshal(b)
+	default:
+		return nil, fmt.Errorf("unsupported format %q", f)
+	}
+}
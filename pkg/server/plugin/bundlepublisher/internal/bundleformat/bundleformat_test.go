@@ -0,0 +1,46 @@
+package bundleformat
+
+import (
+	"testing"
+
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParse(t *testing.T) {
+	format, err := Parse("")
+	require.NoError(t, err)
+	require.Equal(t, PEM, format)
+
+	format, err = Parse("pem")
+	require.NoError(t, err)
+	require.Equal(t, PEM, format)
+
+	format, err = Parse("spiffe")
+	require.NoError(t, err)
+	require.Equal(t, SPIFFE, format)
+
+	_, err = Parse("der")
+	require.EqualError(t, err, `unsupported format "der"`)
+}
+
+func TestFormat(t *testing.T) {
+	bundle := &common.Bundle{
+		TrustDomainId: "spiffe://example.org",
+		RootCas: []*common.Certificate{
+			{DerBytes: []byte("FOO")},
+			{DerBytes: []byte("BAR")},
+		},
+	}
+
+	data, err := PEM.Format(bundle)
+	require.NoError(t, err)
+	require.Equal(t, "-----BEGIN CERTIFICATE-----\nRk9P\n-----END CERTIFICATE-----\n-----BEGIN CERTIFICATE-----\nQkFS\n-----END CERTIFICATE-----\n", string(data))
+	require.Equal(t, "application/x-pem-file", PEM.ContentType())
+
+	// the root CAs must be valid certificates for the SPIFFE format
+	data, err = SPIFFE.Format(&common.Bundle{TrustDomainId: "spiffe://example.org"})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"keys":null}`, string(data))
+	require.Equal(t, "application/json", SPIFFE.ContentType())
+}
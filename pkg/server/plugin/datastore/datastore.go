@@ -12,88 +12,113 @@ import (
 	"google.golang.org/grpc"
 )
 
-type AppendBundleRequest = datastore.AppendBundleRequest                           //nolint: golint
-type AppendBundleResponse = datastore.AppendBundleResponse                         //nolint: golint
-type ByFederatesWith = datastore.ByFederatesWith                                   //nolint: golint
-type ByFederatesWith_MatchBehavior = datastore.ByFederatesWith_MatchBehavior       //nolint: golint
-type BySelectors = datastore.BySelectors                                           //nolint: golint
-type BySelectors_MatchBehavior = datastore.BySelectors_MatchBehavior               //nolint: golint
-type CountAttestedNodesRequest = datastore.CountAttestedNodesRequest               //nolint: golint
-type CountAttestedNodesResponse = datastore.CountAttestedNodesResponse             //nolint: golint
-type CountBundlesRequest = datastore.CountBundlesRequest                           //nolint: golint
-type CountBundlesResponse = datastore.CountBundlesResponse                         //nolint: golint
-type CountRegistrationEntriesRequest = datastore.CountRegistrationEntriesRequest   //nolint: golint
-type CountRegistrationEntriesResponse = datastore.CountRegistrationEntriesResponse //nolint: golint
-type CreateAttestedNodeRequest = datastore.CreateAttestedNodeRequest               //nolint: golint
-type CreateAttestedNodeResponse = datastore.CreateAttestedNodeResponse             //nolint: golint
-type CreateBundleRequest = datastore.CreateBundleRequest                           //nolint: golint
-type CreateBundleResponse = datastore.CreateBundleResponse                         //nolint: golint
-type CreateJoinTokenRequest = datastore.CreateJoinTokenRequest                     //nolint: golint
-type CreateJoinTokenResponse = datastore.CreateJoinTokenResponse                   //nolint: golint
-type CreateRegistrationEntryRequest = datastore.CreateRegistrationEntryRequest     //nolint: golint
-type CreateRegistrationEntryResponse = datastore.CreateRegistrationEntryResponse   //nolint: golint
-type DataStoreClient = datastore.DataStoreClient                                   //nolint: golint
-type DataStoreServer = datastore.DataStoreServer                                   //nolint: golint
-type DeleteAttestedNodeRequest = datastore.DeleteAttestedNodeRequest               //nolint: golint
-type DeleteAttestedNodeResponse = datastore.DeleteAttestedNodeResponse             //nolint: golint
-type DeleteBundleRequest = datastore.DeleteBundleRequest                           //nolint: golint
-type DeleteBundleRequest_Mode = datastore.DeleteBundleRequest_Mode                 //nolint: golint
-type DeleteBundleResponse = datastore.DeleteBundleResponse                         //nolint: golint
-type DeleteJoinTokenRequest = datastore.DeleteJoinTokenRequest                     //nolint: golint
-type DeleteJoinTokenResponse = datastore.DeleteJoinTokenResponse                   //nolint: golint
-type DeleteRegistrationEntryRequest = datastore.DeleteRegistrationEntryRequest     //nolint: golint
-type DeleteRegistrationEntryResponse = datastore.DeleteRegistrationEntryResponse   //nolint: golint
-type FetchAttestedNodeRequest = datastore.FetchAttestedNodeRequest                 //nolint: golint
-type FetchAttestedNodeResponse = datastore.FetchAttestedNodeResponse               //nolint: golint
-type FetchBundleRequest = datastore.FetchBundleRequest                             //nolint: golint
-type FetchBundleResponse = datastore.FetchBundleResponse                           //nolint: golint
-type FetchJoinTokenRequest = datastore.FetchJoinTokenRequest                       //nolint: golint
-type FetchJoinTokenResponse = datastore.FetchJoinTokenResponse                     //nolint: golint
-type FetchRegistrationEntryRequest = datastore.FetchRegistrationEntryRequest       //nolint: golint
-type FetchRegistrationEntryResponse = datastore.FetchRegistrationEntryResponse     //nolint: golint
-type GetNodeSelectorsRequest = datastore.GetNodeSelectorsRequest                   //nolint: golint
-type GetNodeSelectorsResponse = datastore.GetNodeSelectorsResponse                 //nolint: golint
-type JoinToken = datastore.JoinToken                                               //nolint: golint
-type ListAttestedNodesRequest = datastore.ListAttestedNodesRequest                 //nolint: golint
-type ListAttestedNodesResponse = datastore.ListAttestedNodesResponse               //nolint: golint
-type ListBundlesRequest = datastore.ListBundlesRequest                             //nolint: golint
-type ListBundlesResponse = datastore.ListBundlesResponse                           //nolint: golint
-type ListNodeSelectorsRequest = datastore.ListNodeSelectorsRequest                 //nolint: golint
-type ListNodeSelectorsResponse = datastore.ListNodeSelectorsResponse               //nolint: golint
-type ListRegistrationEntriesRequest = datastore.ListRegistrationEntriesRequest     //nolint: golint
-type ListRegistrationEntriesResponse = datastore.ListRegistrationEntriesResponse   //nolint: golint
-type NodeSelectors = datastore.NodeSelectors                                       //nolint: golint
-type Pagination = datastore.Pagination                                             //nolint: golint
-type PruneBundleRequest = datastore.PruneBundleRequest                             //nolint: golint
-type PruneBundleResponse = datastore.PruneBundleResponse                           //nolint: golint
-type PruneJoinTokensRequest = datastore.PruneJoinTokensRequest                     //nolint: golint
-type PruneJoinTokensResponse = datastore.PruneJoinTokensResponse                   //nolint: golint
-type PruneRegistrationEntriesRequest = datastore.PruneRegistrationEntriesRequest   //nolint: golint
-type PruneRegistrationEntriesResponse = datastore.PruneRegistrationEntriesResponse //nolint: golint
-type SetBundleRequest = datastore.SetBundleRequest                                 //nolint: golint
-type SetBundleResponse = datastore.SetBundleResponse                               //nolint: golint
-type SetNodeSelectorsRequest = datastore.SetNodeSelectorsRequest                   //nolint: golint
-type SetNodeSelectorsResponse = datastore.SetNodeSelectorsResponse                 //nolint: golint
-type UnimplementedDataStoreServer = datastore.UnimplementedDataStoreServer         //nolint: golint
-type UnsafeDataStoreServer = datastore.UnsafeDataStoreServer                       //nolint: golint
-type UpdateAttestedNodeRequest = datastore.UpdateAttestedNodeRequest               //nolint: golint
-type UpdateAttestedNodeResponse = datastore.UpdateAttestedNodeResponse             //nolint: golint
-type UpdateBundleRequest = datastore.UpdateBundleRequest                           //nolint: golint
-type UpdateBundleResponse = datastore.UpdateBundleResponse                         //nolint: golint
-type UpdateRegistrationEntryRequest = datastore.UpdateRegistrationEntryRequest     //nolint: golint
-type UpdateRegistrationEntryResponse = datastore.UpdateRegistrationEntryResponse   //nolint: golint
+type AppendBundleRequest = datastore.AppendBundleRequest                                                   //nolint: golint
+type AppendBundleResponse = datastore.AppendBundleResponse                                                 //nolint: golint
+type ByFederatesWith = datastore.ByFederatesWith                                                           //nolint: golint
+type ByFederatesWith_MatchBehavior = datastore.ByFederatesWith_MatchBehavior                               //nolint: golint
+type BySelectors = datastore.BySelectors                                                                   //nolint: golint
+type BySelectors_MatchBehavior = datastore.BySelectors_MatchBehavior                                       //nolint: golint
+type CountAttestedNodesRequest = datastore.CountAttestedNodesRequest                                       //nolint: golint
+type CountAttestedNodesResponse = datastore.CountAttestedNodesResponse                                     //nolint: golint
+type CountBundlesRequest = datastore.CountBundlesRequest                                                   //nolint: golint
+type CountBundlesResponse = datastore.CountBundlesResponse                                                 //nolint: golint
+type CountRegistrationEntriesRequest = datastore.CountRegistrationEntriesRequest                           //nolint: golint
+type CountRegistrationEntriesResponse = datastore.CountRegistrationEntriesResponse                         //nolint: golint
+type CreateAttestedNodeRequest = datastore.CreateAttestedNodeRequest                                       //nolint: golint
+type CreateAttestedNodeResponse = datastore.CreateAttestedNodeResponse                                     //nolint: golint
+type CreateBundleRequest = datastore.CreateBundleRequest                                                   //nolint: golint
+type CreateBundleResponse = datastore.CreateBundleResponse                                                 //nolint: golint
+type CreateFederationRelationshipRequest = datastore.CreateFederationRelationshipRequest                   //nolint: golint
+type CreateFederationRelationshipResponse = datastore.CreateFederationRelationshipResponse                 //nolint: golint
+type CreateJoinTokenRequest = datastore.CreateJoinTokenRequest                                             //nolint: golint
+type CreateJoinTokenResponse = datastore.CreateJoinTokenResponse                                           //nolint: golint
+type CreateRegistrationEntryRequest = datastore.CreateRegistrationEntryRequest                             //nolint: golint
+type CreateRegistrationEntryResponse = datastore.CreateRegistrationEntryResponse                           //nolint: golint
+type DataStoreClient = datastore.DataStoreClient                                                           //nolint: golint
+type DataStoreServer = datastore.DataStoreServer                                                           //nolint: golint
+type DeleteAttestedNodeRequest = datastore.DeleteAttestedNodeRequest                                       //nolint: golint
+type DeleteAttestedNodeResponse = datastore.DeleteAttestedNodeResponse                                     //nolint: golint
+type DeleteBundleRequest = datastore.DeleteBundleRequest                                                   //nolint: golint
+type DeleteBundleRequest_Mode = datastore.DeleteBundleRequest_Mode                                         //nolint: golint
+type DeleteBundleResponse = datastore.DeleteBundleResponse                                                 //nolint: golint
+type DeleteFederationRelationshipRequest = datastore.DeleteFederationRelationshipRequest                   //nolint: golint
+type DeleteFederationRelationshipResponse = datastore.DeleteFederationRelationshipResponse                 //nolint: golint
+type DeleteJoinTokenRequest = datastore.DeleteJoinTokenRequest                                             //nolint: golint
+type DeleteJoinTokenResponse = datastore.DeleteJoinTokenResponse                                           //nolint: golint
+type DeleteRegistrationEntryRequest = datastore.DeleteRegistrationEntryRequest                             //nolint: golint
+type DeleteRegistrationEntryResponse = datastore.DeleteRegistrationEntryResponse                           //nolint: golint
+type FederationRelationship = datastore.FederationRelationship                                             //nolint: golint
+type FederationRelationshipMask = datastore.FederationRelationshipMask                                     //nolint: golint
+type FederationRelationship_BundleEndpointProfile = datastore.FederationRelationship_BundleEndpointProfile //nolint: golint
+type FetchAttestedNodeRequest = datastore.FetchAttestedNodeRequest                                         //nolint: golint
+type FetchAttestedNodeResponse = datastore.FetchAttestedNodeResponse                                       //nolint: golint
+type FetchBundleRequest = datastore.FetchBundleRequest                                                     //nolint: golint
+type FetchBundleResponse = datastore.FetchBundleResponse                                                   //nolint: golint
+type FetchFederationRelationshipRequest = datastore.FetchFederationRelationshipRequest                     //nolint: golint
+type FetchFederationRelationshipResponse = datastore.FetchFederationRelationshipResponse                   //nolint: golint
+type FetchJoinTokenRequest = datastore.FetchJoinTokenRequest                                               //nolint: golint
+type FetchJoinTokenResponse = datastore.FetchJoinTokenResponse                                             //nolint: golint
+type FetchRegistrationEntryRequest = datastore.FetchRegistrationEntryRequest                               //nolint: golint
+type FetchRegistrationEntryResponse = datastore.FetchRegistrationEntryResponse                             //nolint: golint
+type GetNodeSelectorsRequest = datastore.GetNodeSelectorsRequest                                           //nolint: golint
+type GetNodeSelectorsResponse = datastore.GetNodeSelectorsResponse                                         //nolint: golint
+type JoinToken = datastore.JoinToken                                                                       //nolint: golint
+type ListAttestedNodesRequest = datastore.ListAttestedNodesRequest                                         //nolint: golint
+type ListAttestedNodesResponse = datastore.ListAttestedNodesResponse                                       //nolint: golint
+type ListBundlesRequest = datastore.ListBundlesRequest                                                     //nolint: golint
+type ListBundlesResponse = datastore.ListBundlesResponse                                                   //nolint: golint
+type ListFederationRelationshipsRequest = datastore.ListFederationRelationshipsRequest                     //nolint: golint
+type ListFederationRelationshipsResponse = datastore.ListFederationRelationshipsResponse                   //nolint: golint
+type ListJoinTokensRequest = datastore.ListJoinTokensRequest                                               //nolint: golint
+type ListJoinTokensResponse = datastore.ListJoinTokensResponse                                             //nolint: golint
+type ListNodeSelectorsRequest = datastore.ListNodeSelectorsRequest                                         //nolint: golint
+type ListNodeSelectorsResponse = datastore.ListNodeSelectorsResponse                                       //nolint: golint
+type ListRegistrationEntriesRequest = datastore.ListRegistrationEntriesRequest                             //nolint: golint
+type ListRegistrationEntriesResponse = datastore.ListRegistrationEntriesResponse                           //nolint: golint
+type ListRegistrationEntryTombstonesRequest = datastore.ListRegistrationEntryTombstonesRequest             //nolint: golint
+type ListRegistrationEntryTombstonesResponse = datastore.ListRegistrationEntryTombstonesResponse           //nolint: golint
+type NodeSelectors = datastore.NodeSelectors                                                               //nolint: golint
+type Pagination = datastore.Pagination                                                                     //nolint: golint
+type PruneBundleRequest = datastore.PruneBundleRequest                                                     //nolint: golint
+type PruneBundleResponse = datastore.PruneBundleResponse                                                   //nolint: golint
+type PruneJoinTokensRequest = datastore.PruneJoinTokensRequest                                             //nolint: golint
+type PruneJoinTokensResponse = datastore.PruneJoinTokensResponse                                           //nolint: golint
+type PruneRegistrationEntriesRequest = datastore.PruneRegistrationEntriesRequest                           //nolint: golint
+type PruneRegistrationEntriesResponse = datastore.PruneRegistrationEntriesResponse                         //nolint: golint
+type PruneRegistrationEntryTombstonesRequest = datastore.PruneRegistrationEntryTombstonesRequest           //nolint: golint
+type PruneRegistrationEntryTombstonesResponse = datastore.PruneRegistrationEntryTombstonesResponse         //nolint: golint
+type RegistrationEntryTombstone = datastore.RegistrationEntryTombstone                                     //nolint: golint
+type SetBundleRequest = datastore.SetBundleRequest                                                         //nolint: golint
+type SetBundleResponse = datastore.SetBundleResponse                                                       //nolint: golint
+type SetNodeSelectorsRequest = datastore.SetNodeSelectorsRequest                                           //nolint: golint
+type SetNodeSelectorsResponse = datastore.SetNodeSelectorsResponse                                         //nolint: golint
+type UnimplementedDataStoreServer = datastore.UnimplementedDataStoreServer                                 //nolint: golint
+type UnsafeDataStoreServer = datastore.UnsafeDataStoreServer                                               //nolint: golint
+type UpdateAttestedNodeRequest = datastore.UpdateAttestedNodeRequest                                       //nolint: golint
+type UpdateAttestedNodeResponse = datastore.UpdateAttestedNodeResponse                                     //nolint: golint
+type UpdateBundleRequest = datastore.UpdateBundleRequest                                                   //nolint: golint
+type UpdateBundleResponse = datastore.UpdateBundleResponse                                                 //nolint: golint
+type UpdateFederationRelationshipRequest = datastore.UpdateFederationRelationshipRequest                   //nolint: golint
+type UpdateFederationRelationshipResponse = datastore.UpdateFederationRelationshipResponse                 //nolint: golint
+type UpdateRegistrationEntryRequest = datastore.UpdateRegistrationEntryRequest                             //nolint: golint
+type UpdateRegistrationEntryResponse = datastore.UpdateRegistrationEntryResponse                           //nolint: golint
 
 const (
-	Type                           = "DataStore"
-	ByFederatesWith_MATCH_EXACT    = datastore.ByFederatesWith_MATCH_EXACT    //nolint: golint
-	ByFederatesWith_MATCH_SUBSET   = datastore.ByFederatesWith_MATCH_SUBSET   //nolint: golint
-	BySelectors_MATCH_EXACT        = datastore.BySelectors_MATCH_EXACT        //nolint: golint
-	BySelectors_MATCH_SUBSET       = datastore.BySelectors_MATCH_SUBSET       //nolint: golint
-	DeleteBundleRequest_DELETE     = datastore.DeleteBundleRequest_DELETE     //nolint: golint
-	DeleteBundleRequest_DISSOCIATE = datastore.DeleteBundleRequest_DISSOCIATE //nolint: golint
-	DeleteBundleRequest_RESTRICT   = datastore.DeleteBundleRequest_RESTRICT   //nolint: golint
+	Type                                = "DataStore"
+	ByFederatesWith_MATCH_EXACT         = datastore.ByFederatesWith_MATCH_EXACT         //nolint: golint
+	ByFederatesWith_MATCH_SUBSET        = datastore.ByFederatesWith_MATCH_SUBSET        //nolint: golint
+	BySelectors_MATCH_EXACT             = datastore.BySelectors_MATCH_EXACT             //nolint: golint
+	BySelectors_MATCH_SUBSET            = datastore.BySelectors_MATCH_SUBSET            //nolint: golint
+	DeleteBundleRequest_DELETE          = datastore.DeleteBundleRequest_DELETE          //nolint: golint
+	DeleteBundleRequest_DISSOCIATE      = datastore.DeleteBundleRequest_DISSOCIATE      //nolint: golint
+	DeleteBundleRequest_RESTRICT        = datastore.DeleteBundleRequest_RESTRICT        //nolint: golint
+	FederationRelationship_HTTPS_SPIFFE = datastore.FederationRelationship_HTTPS_SPIFFE //nolint: golint
+	FederationRelationship_HTTPS_WEB    = datastore.FederationRelationship_HTTPS_WEB    //nolint: golint
 )
 
+// Version is the version of the DataStore definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // DataStore is the client interface for the service type DataStore interface.
 type DataStore interface {
 	AppendBundle(context.Context, *AppendBundleRequest) (*AppendBundleResponse, error)
@@ -102,28 +127,36 @@ type DataStore interface {
 	CountRegistrationEntries(context.Context, *CountRegistrationEntriesRequest) (*CountRegistrationEntriesResponse, error)
 	CreateAttestedNode(context.Context, *CreateAttestedNodeRequest) (*CreateAttestedNodeResponse, error)
 	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+	CreateFederationRelationship(context.Context, *CreateFederationRelationshipRequest) (*CreateFederationRelationshipResponse, error)
 	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error)
 	CreateRegistrationEntry(context.Context, *CreateRegistrationEntryRequest) (*CreateRegistrationEntryResponse, error)
 	DeleteAttestedNode(context.Context, *DeleteAttestedNodeRequest) (*DeleteAttestedNodeResponse, error)
 	DeleteBundle(context.Context, *DeleteBundleRequest) (*DeleteBundleResponse, error)
+	DeleteFederationRelationship(context.Context, *DeleteFederationRelationshipRequest) (*DeleteFederationRelationshipResponse, error)
 	DeleteJoinToken(context.Context, *DeleteJoinTokenRequest) (*DeleteJoinTokenResponse, error)
 	DeleteRegistrationEntry(context.Context, *DeleteRegistrationEntryRequest) (*DeleteRegistrationEntryResponse, error)
 	FetchAttestedNode(context.Context, *FetchAttestedNodeRequest) (*FetchAttestedNodeResponse, error)
 	FetchBundle(context.Context, *FetchBundleRequest) (*FetchBundleResponse, error)
+	FetchFederationRelationship(context.Context, *FetchFederationRelationshipRequest) (*FetchFederationRelationshipResponse, error)
 	FetchJoinToken(context.Context, *FetchJoinTokenRequest) (*FetchJoinTokenResponse, error)
 	FetchRegistrationEntry(context.Context, *FetchRegistrationEntryRequest) (*FetchRegistrationEntryResponse, error)
 	GetNodeSelectors(context.Context, *GetNodeSelectorsRequest) (*GetNodeSelectorsResponse, error)
 	ListAttestedNodes(context.Context, *ListAttestedNodesRequest) (*ListAttestedNodesResponse, error)
 	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	ListFederationRelationships(context.Context, *ListFederationRelationshipsRequest) (*ListFederationRelationshipsResponse, error)
+	ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error)
 	ListNodeSelectors(context.Context, *ListNodeSelectorsRequest) (*ListNodeSelectorsResponse, error)
 	ListRegistrationEntries(context.Context, *ListRegistrationEntriesRequest) (*ListRegistrationEntriesResponse, error)
+	ListRegistrationEntryTombstones(context.Context, *ListRegistrationEntryTombstonesRequest) (*ListRegistrationEntryTombstonesResponse, error)
 	PruneBundle(context.Context, *PruneBundleRequest) (*PruneBundleResponse, error)
 	PruneJoinTokens(context.Context, *PruneJoinTokensRequest) (*PruneJoinTokensResponse, error)
 	PruneRegistrationEntries(context.Context, *PruneRegistrationEntriesRequest) (*PruneRegistrationEntriesResponse, error)
+	PruneRegistrationEntryTombstones(context.Context, *PruneRegistrationEntryTombstonesRequest) (*PruneRegistrationEntryTombstonesResponse, error)
 	SetBundle(context.Context, *SetBundleRequest) (*SetBundleResponse, error)
 	SetNodeSelectors(context.Context, *SetNodeSelectorsRequest) (*SetNodeSelectorsResponse, error)
 	UpdateAttestedNode(context.Context, *UpdateAttestedNodeRequest) (*UpdateAttestedNodeResponse, error)
 	UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error)
+	UpdateFederationRelationship(context.Context, *UpdateFederationRelationshipRequest) (*UpdateFederationRelationshipResponse, error)
 	UpdateRegistrationEntry(context.Context, *UpdateRegistrationEntryRequest) (*UpdateRegistrationEntryResponse, error)
 }
 
@@ -136,32 +169,45 @@ type Plugin interface {
 	CountRegistrationEntries(context.Context, *CountRegistrationEntriesRequest) (*CountRegistrationEntriesResponse, error)
 	CreateAttestedNode(context.Context, *CreateAttestedNodeRequest) (*CreateAttestedNodeResponse, error)
 	CreateBundle(context.Context, *CreateBundleRequest) (*CreateBundleResponse, error)
+	CreateFederationRelationship(context.Context, *CreateFederationRelationshipRequest) (*CreateFederationRelationshipResponse, error)
 	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error)
 	CreateRegistrationEntry(context.Context, *CreateRegistrationEntryRequest) (*CreateRegistrationEntryResponse, error)
 	DeleteAttestedNode(context.Context, *DeleteAttestedNodeRequest) (*DeleteAttestedNodeResponse, error)
 	DeleteBundle(context.Context, *DeleteBundleRequest) (*DeleteBundleResponse, error)
+	DeleteFederationRelationship(context.Context, *DeleteFederationRelationshipRequest) (*DeleteFederationRelationshipResponse, error)
 	DeleteJoinToken(context.Context, *DeleteJoinTokenRequest) (*DeleteJoinTokenResponse, error)
 	DeleteRegistrationEntry(context.Context, *DeleteRegistrationEntryRequest) (*DeleteRegistrationEntryResponse, error)
 	FetchAttestedNode(context.Context, *FetchAttestedNodeRequest) (*FetchAttestedNodeResponse, error)
 	FetchBundle(context.Context, *FetchBundleRequest) (*FetchBundleResponse, error)
+	FetchFederationRelationship(context.Context, *FetchFederationRelationshipRequest) (*FetchFederationRelationshipResponse, error)
 	FetchJoinToken(context.Context, *FetchJoinTokenRequest) (*FetchJoinTokenResponse, error)
 	FetchRegistrationEntry(context.Context, *FetchRegistrationEntryRequest) (*FetchRegistrationEntryResponse, error)
 	GetNodeSelectors(context.Context, *GetNodeSelectorsRequest) (*GetNodeSelectorsResponse, error)
 	GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error)
 	ListAttestedNodes(context.Context, *ListAttestedNodesRequest) (*ListAttestedNodesResponse, error)
 	ListBundles(context.Context, *ListBundlesRequest) (*ListBundlesResponse, error)
+	ListFederationRelationships(context.Context, *ListFederationRelationshipsRequest) (*ListFederationRelationshipsResponse, error)
+	ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error)
 	ListNodeSelectors(context.Context, *ListNodeSelectorsRequest) (*ListNodeSelectorsResponse, error)
 	ListRegistrationEntries(context.Context, *ListRegistrationEntriesRequest) (*ListRegistrationEntriesResponse, error)
+	ListRegistrationEntryTombstones(context.Context, *ListRegistrationEntryTombstonesRequest) (*ListRegistrationEntryTombstonesResponse, error)
 	PruneBundle(context.Context, *PruneBundleRequest) (*PruneBundleResponse, error)
 	PruneJoinTokens(context.Context, *PruneJoinTokensRequest) (*PruneJoinTokensResponse, error)
 	PruneRegistrationEntries(context.Context, *PruneRegistrationEntriesRequest) (*PruneRegistrationEntriesResponse, error)
+	PruneRegistrationEntryTombstones(context.Context, *PruneRegistrationEntryTombstonesRequest) (*PruneRegistrationEntryTombstonesResponse, error)
 	SetBundle(context.Context, *SetBundleRequest) (*SetBundleResponse, error)
 	SetNodeSelectors(context.Context, *SetNodeSelectorsRequest) (*SetNodeSelectorsResponse, error)
 	UpdateAttestedNode(context.Context, *UpdateAttestedNodeRequest) (*UpdateAttestedNodeResponse, error)
 	UpdateBundle(context.Context, *UpdateBundleRequest) (*UpdateBundleResponse, error)
+	UpdateFederationRelationship(context.Context, *UpdateFederationRelationshipRequest) (*UpdateFederationRelationshipResponse, error)
 	UpdateRegistrationEntry(context.Context, *UpdateRegistrationEntryRequest) (*UpdateRegistrationEntryResponse, error)
 }
 
+// Serve serves the DataStore plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server DataStoreServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the DataStore plugin.
 func PluginServer(server DataStoreServer) catalog.PluginServer {
 	return &pluginServer{
@@ -177,6 +223,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -195,6 +245,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(datastore.NewDataStoreClient(conn))
 }
@@ -235,6 +289,10 @@ func (a pluginClientAdapter) CreateBundle(ctx context.Context, in *CreateBundleR
 	return a.client.CreateBundle(ctx, in)
 }
 
+func (a pluginClientAdapter) CreateFederationRelationship(ctx context.Context, in *CreateFederationRelationshipRequest) (*CreateFederationRelationshipResponse, error) {
+	return a.client.CreateFederationRelationship(ctx, in)
+}
+
 func (a pluginClientAdapter) CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error) {
 	return a.client.CreateJoinToken(ctx, in)
 }
@@ -251,6 +309,10 @@ func (a pluginClientAdapter) DeleteBundle(ctx context.Context, in *DeleteBundleR
 	return a.client.DeleteBundle(ctx, in)
 }
 
+func (a pluginClientAdapter) DeleteFederationRelationship(ctx context.Context, in *DeleteFederationRelationshipRequest) (*DeleteFederationRelationshipResponse, error) {
+	return a.client.DeleteFederationRelationship(ctx, in)
+}
+
 func (a pluginClientAdapter) DeleteJoinToken(ctx context.Context, in *DeleteJoinTokenRequest) (*DeleteJoinTokenResponse, error) {
 	return a.client.DeleteJoinToken(ctx, in)
 }
@@ -267,6 +329,10 @@ func (a pluginClientAdapter) FetchBundle(ctx context.Context, in *FetchBundleReq
 	return a.client.FetchBundle(ctx, in)
 }
 
+func (a pluginClientAdapter) FetchFederationRelationship(ctx context.Context, in *FetchFederationRelationshipRequest) (*FetchFederationRelationshipResponse, error) {
+	return a.client.FetchFederationRelationship(ctx, in)
+}
+
 func (a pluginClientAdapter) FetchJoinToken(ctx context.Context, in *FetchJoinTokenRequest) (*FetchJoinTokenResponse, error) {
 	return a.client.FetchJoinToken(ctx, in)
 }
@@ -291,6 +357,14 @@ func (a pluginClientAdapter) ListBundles(ctx context.Context, in *ListBundlesReq
 	return a.client.ListBundles(ctx, in)
 }
 
+func (a pluginClientAdapter) ListFederationRelationships(ctx context.Context, in *ListFederationRelationshipsRequest) (*ListFederationRelationshipsResponse, error) {
+	return a.client.ListFederationRelationships(ctx, in)
+}
+
+func (a pluginClientAdapter) ListJoinTokens(ctx context.Context, in *ListJoinTokensRequest) (*ListJoinTokensResponse, error) {
+	return a.client.ListJoinTokens(ctx, in)
+}
+
 func (a pluginClientAdapter) ListNodeSelectors(ctx context.Context, in *ListNodeSelectorsRequest) (*ListNodeSelectorsResponse, error) {
 	return a.client.ListNodeSelectors(ctx, in)
 }
@@ -299,6 +373,10 @@ func (a pluginClientAdapter) ListRegistrationEntries(ctx context.Context, in *Li
 	return a.client.ListRegistrationEntries(ctx, in)
 }
 
+func (a pluginClientAdapter) ListRegistrationEntryTombstones(ctx context.Context, in *ListRegistrationEntryTombstonesRequest) (*ListRegistrationEntryTombstonesResponse, error) {
+	return a.client.ListRegistrationEntryTombstones(ctx, in)
+}
+
 func (a pluginClientAdapter) PruneBundle(ctx context.Context, in *PruneBundleRequest) (*PruneBundleResponse, error) {
 	return a.client.PruneBundle(ctx, in)
 }
@@ -311,6 +389,10 @@ func (a pluginClientAdapter) PruneRegistrationEntries(ctx context.Context, in *P
 	return a.client.PruneRegistrationEntries(ctx, in)
 }
 
+func (a pluginClientAdapter) PruneRegistrationEntryTombstones(ctx context.Context, in *PruneRegistrationEntryTombstonesRequest) (*PruneRegistrationEntryTombstonesResponse, error) {
+	return a.client.PruneRegistrationEntryTombstones(ctx, in)
+}
+
 func (a pluginClientAdapter) SetBundle(ctx context.Context, in *SetBundleRequest) (*SetBundleResponse, error) {
 	return a.client.SetBundle(ctx, in)
 }
@@ -327,6 +409,10 @@ func (a pluginClientAdapter) UpdateBundle(ctx context.Context, in *UpdateBundleR
 	return a.client.UpdateBundle(ctx, in)
 }
 
+func (a pluginClientAdapter) UpdateFederationRelationship(ctx context.Context, in *UpdateFederationRelationshipRequest) (*UpdateFederationRelationshipResponse, error) {
+	return a.client.UpdateFederationRelationship(ctx, in)
+}
+
 func (a pluginClientAdapter) UpdateRegistrationEntry(ctx context.Context, in *UpdateRegistrationEntryRequest) (*UpdateRegistrationEntryResponse, error) {
 	return a.client.UpdateRegistrationEntry(ctx, in)
 }
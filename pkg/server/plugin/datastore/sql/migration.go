@@ -19,7 +19,7 @@ import (
 
 const (
 	// the latest schema version of the database in the code
-	latestSchemaVersion = 15
+	latestSchemaVersion = 20
 )
 
 var (
@@ -190,6 +190,8 @@ func initDB(db *gorm.DB, dbType string, log hclog.Logger) (err error) {
 		&Selector{},
 		&Migration{},
 		&DNSName{},
+		&FederatedTrustDomain{},
+		&RegisteredEntryTombstone{},
 	}
 
 	if err := tableOptionsForDialect(tx, dbType).AutoMigrate(tables...).Error; err != nil {
@@ -249,6 +251,11 @@ func migrateVersion(tx *gorm.DB, currVersion int, log hclog.Logger) (versionOut
 		migrateToV13,
 		migrateToV14,
 		migrateToV15,
+		migrateToV16,
+		migrateToV17,
+		migrateToV18,
+		migrateToV19,
+		migrateToV20,
 	}
 
 	if currVersion >= len(migrations) {
@@ -490,6 +497,41 @@ func migrateToV15(tx *gorm.DB) error {
 	return addAttestedNodeEntriesExpiresAtIndex(tx)
 }
 
+func migrateToV16(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&FederatedTrustDomain{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+	return nil
+}
+
+func migrateToV17(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&JoinToken{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+	return nil
+}
+
+func migrateToV18(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&RegisteredEntryTombstone{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+	return nil
+}
+
+func migrateToV19(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&RegisteredEntry{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+	return nil
+}
+
+func migrateToV20(tx *gorm.DB) error {
+	if err := tx.AutoMigrate(&FederatedTrustDomain{}).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+	return nil
+}
+
 func addFederatedRegistrationEntriesRegisteredEntryIDIndex(tx *gorm.DB) error {
 	// GORM creates the federated_registration_entries implicitly with a primary
 	// key tuple (bundle_id, registered_entry_id). Unfortunately, MySQL5 does
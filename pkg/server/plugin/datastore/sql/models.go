@@ -89,6 +89,24 @@ type RegisteredEntry struct {
 	// RevisionNumber is a counter that is incremented when the entry is
 	// updated.
 	RevisionNumber int64
+
+	// (optional) JSON encoded list of the audiences JWT-SVIDs can be minted
+	// for
+	AllowedAudiences string `gorm:"type:text"`
+}
+
+// RegisteredEntryTombstone records the deletion of a registered entry. The
+// CreatedAt field holds the time of the deletion.
+type RegisteredEntryTombstone struct {
+	Model
+
+	EntryID  string `gorm:"index"`
+	SpiffeID string
+	ParentID string
+
+	// RevisionNumber is the revision of the deletion, one past the last
+	// revision of the entry.
+	RevisionNumber int64
 }
 
 // JoinToken holds a join token
@@ -97,6 +115,39 @@ type JoinToken struct {
 
 	Token  string `gorm:"unique_index"`
 	Expiry int64
+
+	// AgentID is the optional SPIFFE ID assigned to the agent attested with
+	// the token
+	AgentID string
+}
+
+// FederatedTrustDomain holds a federation relationship with a foreign trust
+// domain
+type FederatedTrustDomain struct {
+	Model
+
+	// TrustDomain is the trust domain ID of the federated trust domain
+	TrustDomain string `gorm:"not null;unique_index"`
+
+	// BundleEndpointURL is the URL of the bundle endpoint
+	BundleEndpointURL string
+
+	// BundleEndpointProfile is the profile used to authenticate the bundle
+	// endpoint (e.g. https_web or https_spiffe)
+	BundleEndpointProfile string
+
+	// EndpointSPIFFEID is the expected SPIFFE ID of the bundle endpoint
+	// server when using the https_spiffe profile
+	EndpointSPIFFEID string
+
+	// WebPKIRootCAs holds the concatenated ASN.1 DER encoded root CA
+	// certificates trusted to authenticate the bundle endpoint server when
+	// using the https_web profile
+	WebPKIRootCAs []byte `gorm:"column:web_pki_root_cas"`
+
+	// PinWebPKIRootCAs is true if the bundle endpoint server is
+	// authenticated with WebPKIRootCAs only
+	PinWebPKIRootCAs bool `gorm:"column:pin_web_pki_root_cas"`
 }
 
 type Selector struct {
@@ -3,7 +3,9 @@ package sql
 import (
 	"bytes"
 	"context"
+	"crypto/x509"
 	"database/sql"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"strconv"
@@ -52,6 +54,10 @@ const (
 	PostgreSQL = "postgres"
 	// SQLite database type
 	SQLite = "sqlite3"
+
+	// Bundle endpoint profiles as stored in the federated_trust_domains table
+	bundleEndpointProfileHTTPSWeb    = "https_web"
+	bundleEndpointProfileHTTPSSPIFFE = "https_spiffe"
 )
 
 func BuiltIn() catalog.Plugin {
@@ -417,6 +423,30 @@ func (ds *Plugin) PruneRegistrationEntries(ctx context.Context, req *datastore.P
 	return resp, nil
 }
 
+// ListRegistrationEntryTombstones lists the tombstones of deleted
+// registration entries
+func (ds *Plugin) ListRegistrationEntryTombstones(ctx context.Context, req *datastore.ListRegistrationEntryTombstonesRequest) (resp *datastore.ListRegistrationEntryTombstonesResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = listRegistrationEntryTombstones(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// PruneRegistrationEntryTombstones deletes all registration entry tombstones
+// for entries deleted before the date in the request
+func (ds *Plugin) PruneRegistrationEntryTombstones(ctx context.Context, req *datastore.PruneRegistrationEntryTombstonesRequest) (resp *datastore.PruneRegistrationEntryTombstonesResponse, err error) {
+	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = pruneRegistrationEntryTombstones(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // CreateJoinToken takes a Token message and stores it
 func (ds *Plugin) CreateJoinToken(ctx context.Context, req *datastore.CreateJoinTokenRequest) (resp *datastore.CreateJoinTokenResponse, err error) {
 	if req.JoinToken == nil || req.JoinToken.Token == "" || req.JoinToken.Expiry == 0 {
@@ -445,6 +475,17 @@ func (ds *Plugin) FetchJoinToken(ctx context.Context, req *datastore.FetchJoinTo
 	return resp, nil
 }
 
+// ListJoinTokens lists join tokens
+func (ds *Plugin) ListJoinTokens(ctx context.Context, req *datastore.ListJoinTokensRequest) (resp *datastore.ListJoinTokensResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = listJoinTokens(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // DeleteJoinToken deletes the given join token
 func (ds *Plugin) DeleteJoinToken(ctx context.Context, req *datastore.DeleteJoinTokenRequest) (resp *datastore.DeleteJoinTokenResponse, err error) {
 	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
@@ -468,6 +509,64 @@ func (ds *Plugin) PruneJoinTokens(ctx context.Context, req *datastore.PruneJoinT
 	return resp, nil
 }
 
+// CreateFederationRelationship stores the given federation relationship
+func (ds *Plugin) CreateFederationRelationship(ctx context.Context, req *datastore.CreateFederationRelationshipRequest) (resp *datastore.CreateFederationRelationshipResponse, err error) {
+	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = createFederationRelationship(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// FetchFederationRelationship returns the federation relationship with the
+// given trust domain, if any
+func (ds *Plugin) FetchFederationRelationship(ctx context.Context, req *datastore.FetchFederationRelationshipRequest) (resp *datastore.FetchFederationRelationshipResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = fetchFederationRelationship(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// ListFederationRelationships lists all federation relationships
+func (ds *Plugin) ListFederationRelationships(ctx context.Context, req *datastore.ListFederationRelationshipsRequest) (resp *datastore.ListFederationRelationshipsResponse, err error) {
+	if err = ds.withReadTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = listFederationRelationships(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// UpdateFederationRelationship updates an existing federation relationship
+// using the fields set in the input mask
+func (ds *Plugin) UpdateFederationRelationship(ctx context.Context, req *datastore.UpdateFederationRelationshipRequest) (resp *datastore.UpdateFederationRelationshipResponse, err error) {
+	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = updateFederationRelationship(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// DeleteFederationRelationship deletes the federation relationship with the
+// given trust domain
+func (ds *Plugin) DeleteFederationRelationship(ctx context.Context, req *datastore.DeleteFederationRelationshipRequest) (resp *datastore.DeleteFederationRelationshipResponse, err error) {
+	if err = ds.withWriteTx(ctx, func(tx *gorm.DB) (err error) {
+		resp, err = deleteFederationRelationship(tx, req)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
 // Configure parses HCL config payload into config struct, and opens new DB based on the result
 func (ds *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
 	config := &configuration{}
@@ -717,11 +816,16 @@ func updateBundle(tx *gorm.DB, req *datastore.UpdateBundleRequest) (*datastore.U
 	}, nil
 }
 
+// applyBundleMask applies the fields of the new bundle selected by the mask
+// to the stored bundle. The sequence number is owned by the datastore and is
+// incremented whenever the stored bundle changes, regardless of the value
+// provided by the caller.
 func applyBundleMask(model *Bundle, newBundle *common.Bundle, inputMask *common.BundleMask) ([]byte, *common.Bundle, error) {
 	bundle, err := modelToBundle(model)
 	if err != nil {
 		return nil, nil, err
 	}
+	current := proto.Clone(bundle)
 
 	if inputMask == nil {
 		inputMask = protoutil.AllTrueCommonBundleMask
@@ -739,6 +843,10 @@ func applyBundleMask(model *Bundle, newBundle *common.Bundle, inputMask *common.
 		bundle.JwtSigningKeys = newBundle.JwtSigningKeys
 	}
 
+	if !proto.Equal(current, bundle) {
+		bundle.SequenceNumber++
+	}
+
 	newModel, err := bundleToModel(bundle)
 	if err != nil {
 		return nil, nil, err
@@ -806,6 +914,7 @@ func appendBundle(tx *gorm.DB, req *datastore.AppendBundleRequest) (*datastore.A
 
 	bundle, changed := bundleutil.MergeBundles(bundle, req.Bundle)
 	if changed {
+		bundle.SequenceNumber++
 		newModel, err := bundleToModel(bundle)
 		if err != nil {
 			return nil, err
@@ -1700,14 +1809,20 @@ func createRegistrationEntry(tx *gorm.DB, req *datastore.CreateRegistrationEntry
 		return nil, err
 	}
 
+	allowedAudiences, err := marshalAllowedAudiences(req.Entry.AllowedAudiences)
+	if err != nil {
+		return nil, err
+	}
+
 	newRegisteredEntry := RegisteredEntry{
-		EntryID:    entryID,
-		SpiffeID:   req.Entry.SpiffeId,
-		ParentID:   req.Entry.ParentId,
-		TTL:        req.Entry.Ttl,
-		Admin:      req.Entry.Admin,
-		Downstream: req.Entry.Downstream,
-		Expiry:     req.Entry.EntryExpiry,
+		EntryID:          entryID,
+		SpiffeID:         req.Entry.SpiffeId,
+		ParentID:         req.Entry.ParentId,
+		TTL:              req.Entry.Ttl,
+		Admin:            req.Entry.Admin,
+		Downstream:       req.Entry.Downstream,
+		Expiry:           req.Entry.EntryExpiry,
+		AllowedAudiences: allowedAudiences,
 	}
 
 	if err := tx.Create(&newRegisteredEntry).Error; err != nil {
@@ -1831,7 +1946,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -1839,7 +1956,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -1852,7 +1969,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1860,7 +1977,7 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1890,7 +2007,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL ::integer AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -1898,7 +2017,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -1911,7 +2030,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1919,7 +2038,7 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -1946,7 +2065,9 @@ SELECT
 	B.trust_domain,
 	D.id AS dns_name_id,
 	D.value AS dns_name,
-	E.revision_number
+	E.revision_number,
+	E.allowed_audiences,
+	E.created_at
 FROM
 	registered_entries E
 LEFT JOIN
@@ -1983,7 +2104,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 WHERE id IN (SELECT id FROM listing)
@@ -1991,7 +2114,7 @@ WHERE id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2004,7 +2127,7 @@ WHERE
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -2012,7 +2135,7 @@ WHERE registered_entry_id IN (SELECT id FROM listing)
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 WHERE registered_entry_id IN (SELECT id FROM listing)
@@ -2213,7 +2336,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 `)
@@ -2224,7 +2349,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2239,7 +2364,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2250,7 +2375,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2291,7 +2416,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL ::integer AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 `)
@@ -2302,7 +2429,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2317,7 +2444,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2328,7 +2455,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2373,7 +2500,9 @@ SELECT
 	B.trust_domain,
 	D.id AS dns_name_id,
 	D.value AS dns_name,
-	E.revision_number
+	E.revision_number,
+	E.allowed_audiences,
+	E.created_at
 FROM
 	registered_entries E
 LEFT JOIN
@@ -2427,7 +2556,9 @@ SELECT
 	NULL AS trust_domain,
 	NULL AS dns_name_id,
 	NULL AS dns_name,
-	revision_number
+	revision_number,
+	allowed_audiences,
+	created_at
 FROM
 	registered_entries
 `)
@@ -2438,7 +2569,7 @@ FROM
 UNION
 
 SELECT
-	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL
+	F.registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, B.trust_domain, NULL, NULL, NULL, NULL, NULL
 FROM
 	bundles B
 INNER JOIN
@@ -2453,7 +2584,7 @@ ON
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, value, NULL, NULL, NULL
 FROM
 	dns_names
 `)
@@ -2464,7 +2595,7 @@ FROM
 UNION
 
 SELECT
-	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL
+	registered_entry_id, NULL, NULL, NULL, NULL, NULL, NULL, NULL, id, type, value, NULL, NULL, NULL, NULL, NULL, NULL
 FROM
 	selectors
 `)
@@ -2853,21 +2984,23 @@ func fillNodeSelectorFromRow(nodeSelector *common.Selector, r *nodeSelectorRow)
 }
 
 type entryRow struct {
-	EId            uint64
-	EntryID        sql.NullString
-	SpiffeID       sql.NullString
-	ParentID       sql.NullString
-	RegTTL         sql.NullInt64
-	Admin          sql.NullBool
-	Downstream     sql.NullBool
-	Expiry         sql.NullInt64
-	SelectorID     sql.NullInt64
-	SelectorType   sql.NullString
-	SelectorValue  sql.NullString
-	TrustDomain    sql.NullString
-	DNSNameID      sql.NullInt64
-	DNSName        sql.NullString
-	RevisionNumber sql.NullInt64
+	EId              uint64
+	EntryID          sql.NullString
+	SpiffeID         sql.NullString
+	ParentID         sql.NullString
+	RegTTL           sql.NullInt64
+	Admin            sql.NullBool
+	Downstream       sql.NullBool
+	Expiry           sql.NullInt64
+	SelectorID       sql.NullInt64
+	SelectorType     sql.NullString
+	SelectorValue    sql.NullString
+	TrustDomain      sql.NullString
+	DNSNameID        sql.NullInt64
+	DNSName          sql.NullString
+	RevisionNumber   sql.NullInt64
+	AllowedAudiences sql.NullString
+	CreatedAt        sql.NullTime
 }
 
 func scanEntryRow(rs *sql.Rows, r *entryRow) error {
@@ -2887,6 +3020,8 @@ func scanEntryRow(rs *sql.Rows, r *entryRow) error {
 		&r.DNSNameID,
 		&r.DNSName,
 		&r.RevisionNumber,
+		&r.AllowedAudiences,
+		&r.CreatedAt,
 	))
 }
 
@@ -2915,6 +3050,16 @@ func fillEntryFromRow(entry *common.RegistrationEntry, r *entryRow) error {
 	if r.RevisionNumber.Valid {
 		entry.RevisionNumber = r.RevisionNumber.Int64
 	}
+	if r.AllowedAudiences.Valid {
+		allowedAudiences, err := unmarshalAllowedAudiences(r.AllowedAudiences.String)
+		if err != nil {
+			return err
+		}
+		entry.AllowedAudiences = allowedAudiences
+	}
+	if r.CreatedAt.Valid {
+		entry.CreatedAt = r.CreatedAt.Time.Unix()
+	}
 
 	if r.SelectorType.Valid {
 		if !r.SelectorValue.Valid {
@@ -2964,6 +3109,15 @@ func updateRegistrationEntry(tx *gorm.DB,
 	if err := tx.Find(&entry, "entry_id = ?", req.Entry.EntryId).Error; err != nil {
 		return nil, sqlError.Wrap(err)
 	}
+
+	// A non-zero revision number is the revision the update is based on.
+	// The update is rejected if the entry was changed in the meantime.
+	if req.Entry.RevisionNumber != 0 {
+		if err := bumpEntryRevision(tx, entry, req.Entry.RevisionNumber); err != nil {
+			return nil, err
+		}
+	}
+
 	if req.Mask == nil || req.Mask.Selectors {
 		// Delete existing selectors - we will write new ones
 		if err := tx.Exec("DELETE FROM selectors WHERE registered_entry_id = ?", entry.ID).Error; err != nil {
@@ -3017,6 +3171,13 @@ func updateRegistrationEntry(tx *gorm.DB,
 	if req.Mask == nil || req.Mask.EntryExpiry {
 		entry.Expiry = req.Entry.EntryExpiry
 	}
+	if req.Mask == nil || req.Mask.AllowedAudiences {
+		allowedAudiences, err := marshalAllowedAudiences(req.Entry.AllowedAudiences)
+		if err != nil {
+			return nil, err
+		}
+		entry.AllowedAudiences = allowedAudiences
+	}
 
 	// Revision number is increased by 1 on every update call
 	entry.RevisionNumber++
@@ -3047,6 +3208,23 @@ func updateRegistrationEntry(tx *gorm.DB,
 	}, nil
 }
 
+// bumpEntryRevision increments the revision number of the entry only if it
+// still matches the expected revision. The conditional update locks the row
+// until the transaction ends, so two updates based on the same revision
+// cannot both succeed.
+func bumpEntryRevision(tx *gorm.DB, entry RegisteredEntry, expected int64) error {
+	result := tx.Model(&RegisteredEntry{}).
+		Where("id = ? AND revision_number = ?", entry.ID, expected).
+		UpdateColumn("revision_number", expected+1)
+	if result.Error != nil {
+		return sqlError.Wrap(result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return status.Errorf(codes.Aborted, "datastore-sql: entry has been updated since revision %d", expected)
+	}
+	return nil
+}
+
 func deleteRegistrationEntry(tx *gorm.DB, req *datastore.DeleteRegistrationEntryRequest) (*datastore.DeleteRegistrationEntryResponse, error) {
 	entry := RegisteredEntry{}
 	if err := tx.Find(&entry, "entry_id = ?", req.EntryId).Error; err != nil {
@@ -3082,6 +3260,18 @@ func deleteRegistrationEntrySupport(tx *gorm.DB, entry RegisteredEntry) error {
 		return sqlError.Wrap(err)
 	}
 
+	// Leave a tombstone behind so clients syncing entries incrementally
+	// learn about the deletion
+	tombstone := RegisteredEntryTombstone{
+		EntryID:        entry.EntryID,
+		SpiffeID:       entry.SpiffeID,
+		ParentID:       entry.ParentID,
+		RevisionNumber: entry.RevisionNumber + 1,
+	}
+	if err := tx.Create(&tombstone).Error; err != nil {
+		return sqlError.Wrap(err)
+	}
+
 	return nil
 }
 
@@ -3097,13 +3287,61 @@ func pruneRegistrationEntries(tx *gorm.DB, req *datastore.PruneRegistrationEntri
 		}
 	}
 
-	return &datastore.PruneRegistrationEntriesResponse{}, nil
+	return &datastore.PruneRegistrationEntriesResponse{
+		EntriesPruned: int32(len(registrationEntries)),
+	}, nil
+}
+
+func listRegistrationEntryTombstones(tx *gorm.DB, req *datastore.ListRegistrationEntryTombstonesRequest) (*datastore.ListRegistrationEntryTombstonesResponse, error) {
+	p := req.Pagination
+	var err error
+	if p != nil {
+		tx, err = applyPagination(p, tx)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		tx = tx.Order("id asc")
+	}
+
+	if req.DeletedSince != 0 {
+		tx = tx.Where("created_at >= ?", time.Unix(req.DeletedSince, 0))
+	}
+
+	var models []RegisteredEntryTombstone
+	if err := tx.Find(&models).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	if p != nil {
+		p.Token = ""
+		if len(models) > 0 {
+			p.Token = fmt.Sprint(models[len(models)-1].ID)
+		}
+	}
+
+	resp := &datastore.ListRegistrationEntryTombstonesResponse{
+		Pagination: p,
+	}
+	for _, model := range models {
+		resp.Tombstones = append(resp.Tombstones, modelToRegistrationEntryTombstone(model))
+	}
+	return resp, nil
+}
+
+func pruneRegistrationEntryTombstones(tx *gorm.DB, req *datastore.PruneRegistrationEntryTombstonesRequest) (*datastore.PruneRegistrationEntryTombstonesResponse, error) {
+	if err := tx.Where("created_at < ?", time.Unix(req.DeletedBefore, 0)).Delete(&RegisteredEntryTombstone{}).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	return &datastore.PruneRegistrationEntryTombstonesResponse{}, nil
 }
 
 func createJoinToken(tx *gorm.DB, req *datastore.CreateJoinTokenRequest) (*datastore.CreateJoinTokenResponse, error) {
 	t := JoinToken{
-		Token:  req.JoinToken.Token,
-		Expiry: req.JoinToken.Expiry,
+		Token:   req.JoinToken.Token,
+		Expiry:  req.JoinToken.Expiry,
+		AgentID: req.JoinToken.AgentId,
 	}
 
 	if err := tx.Create(&t).Error; err != nil {
@@ -3111,7 +3349,7 @@ func createJoinToken(tx *gorm.DB, req *datastore.CreateJoinTokenRequest) (*datas
 	}
 
 	return &datastore.CreateJoinTokenResponse{
-		JoinToken: req.JoinToken,
+		JoinToken: modelToJoinToken(t),
 	}, nil
 }
 
@@ -3129,6 +3367,37 @@ func fetchJoinToken(tx *gorm.DB, req *datastore.FetchJoinTokenRequest) (*datasto
 	}, nil
 }
 
+func listJoinTokens(tx *gorm.DB, req *datastore.ListJoinTokensRequest) (*datastore.ListJoinTokensResponse, error) {
+	p := req.Pagination
+	var err error
+	if p != nil {
+		tx, err = applyPagination(p, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var models []JoinToken
+	if err := tx.Find(&models).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	if p != nil {
+		p.Token = ""
+		if len(models) > 0 {
+			p.Token = fmt.Sprint(models[len(models)-1].ID)
+		}
+	}
+
+	resp := &datastore.ListJoinTokensResponse{
+		Pagination: p,
+	}
+	for _, model := range models {
+		resp.JoinTokens = append(resp.JoinTokens, modelToJoinToken(model))
+	}
+	return resp, nil
+}
+
 func deleteJoinToken(tx *gorm.DB, req *datastore.DeleteJoinTokenRequest) (*datastore.DeleteJoinTokenResponse, error) {
 	var model JoinToken
 	if err := tx.Find(&model, "token = ?", req.Token).Error; err != nil {
@@ -3145,11 +3414,134 @@ func deleteJoinToken(tx *gorm.DB, req *datastore.DeleteJoinTokenRequest) (*datas
 }
 
 func pruneJoinTokens(tx *gorm.DB, req *datastore.PruneJoinTokensRequest) (*datastore.PruneJoinTokensResponse, error) {
-	if err := tx.Where("expiry < ?", req.ExpiresBefore).Delete(&JoinToken{}).Error; err != nil {
+	result := tx.Where("expiry < ?", req.ExpiresBefore).Delete(&JoinToken{})
+	if err := result.Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	return &datastore.PruneJoinTokensResponse{
+		TokensPruned: int32(result.RowsAffected),
+	}, nil
+}
+
+func createFederationRelationship(tx *gorm.DB, req *datastore.CreateFederationRelationshipRequest) (*datastore.CreateFederationRelationshipResponse, error) {
+	model, err := federationRelationshipToModel(req.FederationRelationship)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Create(model).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	return &datastore.CreateFederationRelationshipResponse{
+		FederationRelationship: modelToFederationRelationship(model),
+	}, nil
+}
+
+func fetchFederationRelationship(tx *gorm.DB, req *datastore.FetchFederationRelationshipRequest) (*datastore.FetchFederationRelationshipResponse, error) {
+	trustDomainID, err := idutil.NormalizeSpiffeID(req.TrustDomainId, idutil.AllowAnyTrustDomain())
+	if err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	model := new(FederatedTrustDomain)
+	err = tx.Find(model, "trust_domain = ?", trustDomainID).Error
+	switch {
+	case err == gorm.ErrRecordNotFound:
+		return &datastore.FetchFederationRelationshipResponse{}, nil
+	case err != nil:
+		return nil, sqlError.Wrap(err)
+	}
+
+	return &datastore.FetchFederationRelationshipResponse{
+		FederationRelationship: modelToFederationRelationship(model),
+	}, nil
+}
+
+func listFederationRelationships(tx *gorm.DB, req *datastore.ListFederationRelationshipsRequest) (*datastore.ListFederationRelationshipsResponse, error) {
+	p := req.Pagination
+	var err error
+	if p != nil {
+		tx, err = applyPagination(p, tx)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var models []FederatedTrustDomain
+	if err := tx.Find(&models).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	if p != nil {
+		p.Token = ""
+		if len(models) > 0 {
+			p.Token = fmt.Sprint(models[len(models)-1].ID)
+		}
+	}
+
+	resp := &datastore.ListFederationRelationshipsResponse{
+		Pagination: p,
+	}
+	for i := range models {
+		resp.FederationRelationships = append(resp.FederationRelationships, modelToFederationRelationship(&models[i]))
+	}
+	return resp, nil
+}
+
+func updateFederationRelationship(tx *gorm.DB, req *datastore.UpdateFederationRelationshipRequest) (*datastore.UpdateFederationRelationshipResponse, error) {
+	newModel, err := federationRelationshipToModel(req.FederationRelationship)
+	if err != nil {
+		return nil, err
+	}
+
+	model := new(FederatedTrustDomain)
+	if err := tx.Find(model, "trust_domain = ?", newModel.TrustDomain).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	mask := req.InputMask
+	if mask == nil {
+		mask = protoutil.AllTrueDatastoreFederationRelationshipMask
+	}
+	if mask.BundleEndpointUrl {
+		model.BundleEndpointURL = newModel.BundleEndpointURL
+	}
+	if mask.BundleEndpointProfile {
+		model.BundleEndpointProfile = newModel.BundleEndpointProfile
+		model.EndpointSPIFFEID = newModel.EndpointSPIFFEID
+		model.WebPKIRootCAs = newModel.WebPKIRootCAs
+		model.PinWebPKIRootCAs = newModel.PinWebPKIRootCAs
+	}
+
+	if err := tx.Save(model).Error; err != nil {
 		return nil, sqlError.Wrap(err)
 	}
 
-	return &datastore.PruneJoinTokensResponse{}, nil
+	return &datastore.UpdateFederationRelationshipResponse{
+		FederationRelationship: modelToFederationRelationship(model),
+	}, nil
+}
+
+func deleteFederationRelationship(tx *gorm.DB, req *datastore.DeleteFederationRelationshipRequest) (*datastore.DeleteFederationRelationshipResponse, error) {
+	trustDomainID, err := idutil.NormalizeSpiffeID(req.TrustDomainId, idutil.AllowAnyTrustDomain())
+	if err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	model := new(FederatedTrustDomain)
+	if err := tx.Find(model, "trust_domain = ?", trustDomainID).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	if err := tx.Delete(model).Error; err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	return &datastore.DeleteFederationRelationshipResponse{
+		FederationRelationship: modelToFederationRelationship(model),
+	}, nil
 }
 
 // modelToBundle converts the given bundle model to a Protobuf bundle message. It will also
@@ -3265,21 +3657,52 @@ func modelToEntry(tx *gorm.DB, model RegisteredEntry) (*common.RegistrationEntry
 		federatesWith = append(federatesWith, bundle.TrustDomain)
 	}
 
+	allowedAudiences, err := unmarshalAllowedAudiences(model.AllowedAudiences)
+	if err != nil {
+		return nil, err
+	}
+
 	return &common.RegistrationEntry{
-		EntryId:        model.EntryID,
-		Selectors:      selectors,
-		SpiffeId:       model.SpiffeID,
-		ParentId:       model.ParentID,
-		Ttl:            model.TTL,
-		FederatesWith:  federatesWith,
-		Admin:          model.Admin,
-		Downstream:     model.Downstream,
-		EntryExpiry:    model.Expiry,
-		DnsNames:       dnsList,
-		RevisionNumber: model.RevisionNumber,
+		EntryId:          model.EntryID,
+		Selectors:        selectors,
+		SpiffeId:         model.SpiffeID,
+		ParentId:         model.ParentID,
+		Ttl:              model.TTL,
+		FederatesWith:    federatesWith,
+		Admin:            model.Admin,
+		Downstream:       model.Downstream,
+		EntryExpiry:      model.Expiry,
+		DnsNames:         dnsList,
+		RevisionNumber:   model.RevisionNumber,
+		AllowedAudiences: allowedAudiences,
+		CreatedAt:        model.CreatedAt.Unix(),
 	}, nil
 }
 
+// marshalAllowedAudiences encodes the allowed audiences of an entry for the
+// allowed_audiences column. No audiences are stored as an empty string.
+func marshalAllowedAudiences(audiences []string) (string, error) {
+	if len(audiences) == 0 {
+		return "", nil
+	}
+	data, err := json.Marshal(audiences)
+	if err != nil {
+		return "", sqlError.Wrap(err)
+	}
+	return string(data), nil
+}
+
+func unmarshalAllowedAudiences(data string) ([]string, error) {
+	if data == "" {
+		return nil, nil
+	}
+	var audiences []string
+	if err := json.Unmarshal([]byte(data), &audiences); err != nil {
+		return nil, sqlError.New("malformed allowed audiences: %v", err)
+	}
+	return audiences, nil
+}
+
 func newRegistrationEntryID() (string, error) {
 	u, err := uuid.NewV4()
 	if err != nil {
@@ -3299,11 +3722,81 @@ func modelToAttestedNode(model AttestedNode) *common.AttestedNode {
 	}
 }
 
+func modelToRegistrationEntryTombstone(model RegisteredEntryTombstone) *datastore.RegistrationEntryTombstone {
+	return &datastore.RegistrationEntryTombstone{
+		EntryId:        model.EntryID,
+		SpiffeId:       model.SpiffeID,
+		ParentId:       model.ParentID,
+		RevisionNumber: model.RevisionNumber,
+		DeletedAt:      model.CreatedAt.Unix(),
+	}
+}
+
 func modelToJoinToken(model JoinToken) *datastore.JoinToken {
 	return &datastore.JoinToken{
-		Token:  model.Token,
-		Expiry: model.Expiry,
+		Token:     model.Token,
+		Expiry:    model.Expiry,
+		CreatedAt: model.CreatedAt.Unix(),
+		AgentId:   model.AgentID,
+	}
+}
+
+func federationRelationshipToModel(fr *datastore.FederationRelationship) (*FederatedTrustDomain, error) {
+	if fr == nil {
+		return nil, sqlError.New("missing federation relationship in request")
+	}
+	trustDomainID, err := idutil.NormalizeSpiffeID(fr.TrustDomainId, idutil.AllowAnyTrustDomain())
+	if err != nil {
+		return nil, sqlError.Wrap(err)
+	}
+
+	model := &FederatedTrustDomain{
+		TrustDomain:       trustDomainID,
+		BundleEndpointURL: fr.BundleEndpointUrl,
+	}
+
+	switch fr.BundleEndpointProfile {
+	case datastore.FederationRelationship_HTTPS_WEB:
+		if fr.PinWebPkiRootCas && len(fr.WebPkiRootCas) == 0 {
+			return nil, sqlError.New("web PKI root CAs are required to pin them")
+		}
+		for _, rootCA := range fr.WebPkiRootCas {
+			if _, err := x509.ParseCertificate(rootCA); err != nil {
+				return nil, sqlError.New("invalid web PKI root CA: %v", err)
+			}
+		}
+		model.BundleEndpointProfile = bundleEndpointProfileHTTPSWeb
+		model.WebPKIRootCAs = bytes.Join(fr.WebPkiRootCas, nil)
+		model.PinWebPKIRootCAs = fr.PinWebPkiRootCas
+	case datastore.FederationRelationship_HTTPS_SPIFFE:
+		if fr.EndpointSpiffeId == "" {
+			return nil, sqlError.New("endpoint SPIFFE ID is required for the https_spiffe profile")
+		}
+		model.BundleEndpointProfile = bundleEndpointProfileHTTPSSPIFFE
+		model.EndpointSPIFFEID = fr.EndpointSpiffeId
+	default:
+		return nil, sqlError.New("unknown bundle endpoint profile %d", fr.BundleEndpointProfile)
+	}
+
+	return model, nil
+}
+
+func modelToFederationRelationship(model *FederatedTrustDomain) *datastore.FederationRelationship {
+	fr := &datastore.FederationRelationship{
+		TrustDomainId:     model.TrustDomain,
+		BundleEndpointUrl: model.BundleEndpointURL,
+		EndpointSpiffeId:  model.EndpointSPIFFEID,
+	}
+	if model.BundleEndpointProfile == bundleEndpointProfileHTTPSSPIFFE {
+		fr.BundleEndpointProfile = datastore.FederationRelationship_HTTPS_SPIFFE
+	}
+	// Root CAs are validated when stored, so they are known to parse
+	rootCAs, _ := x509.ParseCertificates(model.WebPKIRootCAs)
+	for _, rootCA := range rootCAs {
+		fr.WebPkiRootCas = append(fr.WebPkiRootCas, rootCA.Raw)
 	}
+	fr.PinWebPkiRootCas = model.PinWebPKIRootCAs
+	return fr
 }
 
 func makeFederatesWith(tx *gorm.DB, ids []string) ([]*Bundle, error) {
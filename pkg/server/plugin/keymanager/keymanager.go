@@ -52,6 +52,9 @@ const (
 	KeyType_UNSPECIFIED_KEY_TYPE             = keymanager.KeyType_UNSPECIFIED_KEY_TYPE             //nolint: golint
 )
 
+// Version is the version of the KeyManager definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // KeyManager is the client interface for the service type KeyManager interface.
 type KeyManager interface {
 	GenerateKey(context.Context, *GenerateKeyRequest) (*GenerateKeyResponse, error)
@@ -70,6 +73,11 @@ type Plugin interface {
 	SignData(context.Context, *SignDataRequest) (*SignDataResponse, error)
 }
 
+// Serve serves the KeyManager plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server KeyManagerServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the KeyManager plugin.
 func PluginServer(server KeyManagerServer) catalog.PluginServer {
 	return &pluginServer{
@@ -85,6 +93,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -103,6 +115,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(keymanager.NewKeyManagerClient(conn))
 }
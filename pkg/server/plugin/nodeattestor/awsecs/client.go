@@ -0,0 +1,74 @@
+package awsecs
+
+import (
+	"sync"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/ecs"
+)
+
+// ECSClient interface describing used aws ecs client functions, useful for mocking
+type ECSClient interface {
+	DescribeTasksWithContext(aws.Context, *ecs.DescribeTasksInput, ...request.Option) (*ecs.DescribeTasksOutput, error)
+	DescribeTaskDefinitionWithContext(aws.Context, *ecs.DescribeTaskDefinitionInput, ...request.Option) (*ecs.DescribeTaskDefinitionOutput, error)
+}
+
+type newClientCallback func(config *ECSAttestorConfig, region string) (ECSClient, error)
+
+// clientsCache holds an ECS client per region
+type clientsCache struct {
+	mu        sync.Mutex
+	config    *ECSAttestorConfig
+	clients   map[string]ECSClient
+	newClient newClientCallback
+}
+
+func newClientsCache(newClient newClientCallback) *clientsCache {
+	return &clientsCache{
+		clients:   make(map[string]ECSClient),
+		newClient: newClient,
+	}
+}
+
+func (cc *clientsCache) configure(config *ECSAttestorConfig) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+	cc.clients = make(map[string]ECSClient)
+	cc.config = config
+}
+
+func (cc *clientsCache) getClient(region string) (ECSClient, error) {
+	cc.mu.Lock()
+	defer cc.mu.Unlock()
+
+	if client, ok := cc.clients[region]; ok {
+		return client, nil
+	}
+
+	if cc.config == nil {
+		return nil, ecsError.New("not configured")
+	}
+
+	client, err := cc.newClient(cc.config, region)
+	if err != nil {
+		return nil, err
+	}
+
+	cc.clients[region] = client
+	return client, nil
+}
+
+func newClient(config *ECSAttestorConfig, region string) (ECSClient, error) {
+	awsConf := &aws.Config{Region: aws.String(region)}
+	if config.AccessKeyID != "" && config.SecretAccessKey != "" {
+		awsConf.Credentials = credentials.NewStaticCredentials(config.AccessKeyID, config.SecretAccessKey, "")
+	}
+	sess, err := session.NewSession(awsConf)
+	if err != nil {
+		return nil, ecsError.Wrap(err)
+	}
+	return ecs.New(sess), nil
+}
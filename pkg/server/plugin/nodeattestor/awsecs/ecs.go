@@ -0,0 +1,460 @@
+package awsecs
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/idutil"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
+	nodeattestorbase "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/base"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+)
+
+const (
+	// accessKeyIDVarName env var name for AWS access key ID
+	accessKeyIDVarName = "AWS_ACCESS_KEY_ID"
+	// secretAccessKeyVarName env car name for AWS secret access key
+	secretAccessKeyVarName = "AWS_SECRET_ACCESS_KEY" //nolint: gosec // false positive
+
+	// callerIdentityBody is the body of an STS GetCallerIdentity request
+	callerIdentityBody = "Action=GetCallerIdentity&Version=2011-06-15"
+
+	// maxCallerIdentityResponseSize bounds the STS response read
+	maxCallerIdentityResponseSize = 64 * 1024
+)
+
+var (
+	ecsError    = caws.ECSErrorClass
+	_awsTimeout = 5 * time.Second
+
+	// stsHostRE matches the hosts of the global and regional STS endpoints.
+	// Signed requests are only sent to these hosts, so that agents cannot
+	// have the server trust an identity vouched for by any other host.
+	stsHostRE = regexp.MustCompile(`^sts(\.[a-z0-9-]+)?\.amazonaws\.com(\.cn)?$`)
+)
+
+// BuiltIn creates a new built-in plugin
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *ECSAttestorPlugin) catalog.Plugin {
+	return catalog.MakePlugin(caws.ECSPluginName,
+		nodeattestor.PluginServer(p),
+	)
+}
+
+// ECSAttestorPlugin implements node attestation for agents running in AWS ECS
+// tasks, including tasks on Fargate.
+type ECSAttestorPlugin struct {
+	nodeattestorbase.Base
+
+	config  *ECSAttestorConfig
+	mtx     sync.RWMutex
+	clients *clientsCache
+
+	hooks struct {
+		// in test, this can be overridden to mock OS env
+		getenv func(string) string
+		// in test, these can be overridden to send the GetCallerIdentity
+		// request to a fake STS endpoint
+		stsURL     func(host string) string
+		httpClient *http.Client
+	}
+	log hclog.Logger
+}
+
+// ECSAttestorConfig holds hcl configuration for the ECS attestor plugin
+type ECSAttestorConfig struct {
+	AccessKeyID     string   `hcl:"access_key_id"`
+	SecretAccessKey string   `hcl:"secret_access_key"`
+	AccountIDs      []string `hcl:"account_ids"`
+	Clusters        []string `hcl:"clusters"`
+	trustDomain     string
+}
+
+// New creates a new ECSAttestorPlugin.
+func New() *ECSAttestorPlugin {
+	p := &ECSAttestorPlugin{}
+	p.clients = newClientsCache(newClient)
+	p.hooks.getenv = os.Getenv
+	p.hooks.stsURL = func(host string) string {
+		return "https://" + host + "/"
+	}
+	p.hooks.httpClient = &http.Client{Timeout: _awsTimeout}
+	return p
+}
+
+// Attest implements the server side logic for the aws ecs node attestation
+// plugin. The agent proves that it runs in the task by signing an STS
+// GetCallerIdentity request with the credentials of the task role, whose
+// session is named after the task ID.
+func (p *ECSAttestorPlugin) Attest(stream nodeattestor.NodeAttestor_AttestServer) error {
+	c, err := p.getConfig()
+	if err != nil {
+		return err
+	}
+
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	genAttestData := req.GetAttestationData()
+	if genAttestData == nil {
+		return ecsError.New("request missing attestation data")
+	}
+
+	if genAttestData.Type != caws.ECSPluginName {
+		return ecsError.New("unexpected attestation data type %q", genAttestData.Type)
+	}
+
+	attestationData := new(caws.ECSAttestationData)
+	if err := json.Unmarshal(genAttestData.Data, attestationData); err != nil {
+		return caws.ECSAttestationStepError("unmarshaling the attestation data", err)
+	}
+
+	taskARN, taskID, err := parseTaskARN(attestationData.TaskARN)
+	if err != nil {
+		return err
+	}
+
+	if len(c.AccountIDs) > 0 && !contains(c.AccountIDs, taskARN.AccountID) {
+		return ecsError.New("account %q is not allowed", taskARN.AccountID)
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), _awsTimeout)
+	defer cancel()
+
+	identity, err := p.getCallerIdentity(ctx, c.trustDomain, attestationData.CallerIdentityRequest)
+	if err != nil {
+		return caws.ECSAttestationStepError("verifying the caller identity", err)
+	}
+
+	roleName, err := verifyCallerIdentity(identity, taskARN.AccountID, taskID)
+	if err != nil {
+		return caws.ECSAttestationStepError("verifying the caller identity", err)
+	}
+
+	client, err := p.clients.getClient(taskARN.Region)
+	if err != nil {
+		return ecsError.New("failed to get client: %w", err)
+	}
+
+	task, err := describeTask(ctx, client, attestationData.Cluster, attestationData.TaskARN)
+	if err != nil {
+		return caws.ECSAttestationStepError("querying AWS via describe-tasks", err)
+	}
+
+	taskRoleARN, err := getTaskRoleARN(ctx, client, task)
+	if err != nil {
+		return caws.ECSAttestationStepError("querying AWS via describe-task-definition", err)
+	}
+	if taskRoleName, err := resourceName(taskRoleARN); err != nil || taskRoleName != roleName {
+		return ecsError.New("caller identity role %q is not the task role %q", roleName, taskRoleARN)
+	}
+
+	clusterName, err := resourceName(aws.StringValue(task.ClusterArn))
+	if err != nil {
+		return ecsError.New("invalid cluster ARN: %w", err)
+	}
+	if len(c.Clusters) > 0 && !contains(c.Clusters, clusterName) {
+		return ecsError.New("cluster %q is not allowed", clusterName)
+	}
+
+	agentID := idutil.AgentURI(c.trustDomain, path.Join(caws.ECSPluginName, taskARN.AccountID, taskARN.Region, clusterName, taskID))
+
+	attested, err := p.IsAttested(stream.Context(), agentID.String())
+	switch {
+	case err != nil:
+		return err
+	case attested:
+		return ecsError.New("task has already been used to attest an agent")
+	}
+
+	return stream.Send(&nodeattestor.AttestResponse{
+		AgentId:   agentID.String(),
+		Selectors: buildSelectors(clusterName, taskRoleARN, task),
+	})
+}
+
+// Configure configures the ECSAttestorPlugin.
+func (p *ECSAttestorPlugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := &ECSAttestorConfig{}
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, ecsError.New("error decoding AWS ECS Attestor configuration: %w", err)
+	}
+
+	if config.AccessKeyID == "" {
+		config.AccessKeyID = p.hooks.getenv(accessKeyIDVarName)
+	}
+	if config.SecretAccessKey == "" {
+		config.SecretAccessKey = p.hooks.getenv(secretAccessKeyVarName)
+	}
+	switch {
+	case config.AccessKeyID != "" && config.SecretAccessKey == "":
+		return nil, ecsError.New("configuration missing secret access key, but has access key id")
+	case config.AccessKeyID == "" && config.SecretAccessKey != "":
+		return nil, ecsError.New("configuration missing access key id, but has secret access key")
+	}
+
+	if req.GlobalConfig == nil {
+		return nil, ecsError.New("global configuration is required")
+	}
+	if req.GlobalConfig.TrustDomain == "" {
+		return nil, ecsError.New("trust_domain is required")
+	}
+	config.trustDomain = req.GlobalConfig.TrustDomain
+
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	p.config = config
+	p.clients.configure(config)
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+// GetPluginInfo returns the version and related metadata of the installed plugin.
+func (*ECSAttestorPlugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+// SetLogger sets this plugin's logger
+func (p *ECSAttestorPlugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *ECSAttestorPlugin) getConfig() (*ECSAttestorConfig, error) {
+	p.mtx.RLock()
+	defer p.mtx.RUnlock()
+
+	if p.config == nil {
+		return nil, ecsError.New("not configured")
+	}
+
+	return p.config, nil
+}
+
+type callerIdentity struct {
+	Arn     string `xml:"GetCallerIdentityResult>Arn"`
+	Account string `xml:"GetCallerIdentityResult>Account"`
+}
+
+// getCallerIdentity sends the signed GetCallerIdentity request to STS and
+// returns the identity of the credentials that signed it.
+func (p *ECSAttestorPlugin) getCallerIdentity(ctx context.Context, trustDomain string, signed caws.SignedRequest) (*callerIdentity, error) {
+	if !stsHostRE.MatchString(signed.Host) {
+		return nil, ecsError.New("unexpected STS host %q", signed.Host)
+	}
+	if string(signed.Body) != callerIdentityBody {
+		return nil, ecsError.New("request is not a GetCallerIdentity request")
+	}
+	if requestTrustDomain := signed.Headers.Get(caws.ECSTrustDomainHeader); requestTrustDomain != trustDomain {
+		return nil, ecsError.New("request is for trust domain %q", requestTrustDomain)
+	}
+	if !isHeaderSigned(signed.Headers, caws.ECSTrustDomainHeader) {
+		return nil, ecsError.New("request does not sign the %s header", caws.ECSTrustDomainHeader)
+	}
+
+	req, err := http.NewRequest("POST", p.hooks.stsURL(signed.Host), bytes.NewReader(signed.Body))
+	if err != nil {
+		return nil, err
+	}
+	for name, values := range signed.Headers {
+		req.Header[name] = values
+	}
+
+	resp, err := p.hooks.httpClient.Do(req.WithContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	body, err := ioutil.ReadAll(io.LimitReader(resp.Body, maxCallerIdentityResponseSize))
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, ecsError.New("GetCallerIdentity failed with status code %d: %s", resp.StatusCode, body)
+	}
+
+	identity := new(callerIdentity)
+	if err := xml.Unmarshal(body, identity); err != nil {
+		return nil, ecsError.New("unable to decode GetCallerIdentity response: %w", err)
+	}
+	return identity, nil
+}
+
+// isHeaderSigned returns whether the header is one of the signed headers
+// listed in the Authorization header of a Signature Version 4 request.
+func isHeaderSigned(headers http.Header, name string) bool {
+	authorization := headers.Get("Authorization")
+	i := strings.Index(authorization, "SignedHeaders=")
+	if i < 0 {
+		return false
+	}
+	signedHeaders := authorization[i+len("SignedHeaders="):]
+	if j := strings.IndexAny(signedHeaders, ", "); j >= 0 {
+		signedHeaders = signedHeaders[:j]
+	}
+	for _, signedHeader := range strings.Split(signedHeaders, ";") {
+		if strings.EqualFold(signedHeader, name) {
+			return true
+		}
+	}
+	return false
+}
+
+// verifyCallerIdentity checks that the caller identity is a session of an
+// assumed role named after the task ID, as the sessions ECS creates for
+// task roles are, and returns the name of the role.
+func verifyCallerIdentity(identity *callerIdentity, accountID, taskID string) (string, error) {
+	identityARN, err := arn.Parse(identity.Arn)
+	if err != nil {
+		return "", ecsError.New("invalid caller identity ARN: %w", err)
+	}
+	if identity.Account != accountID || identityARN.AccountID != accountID {
+		return "", ecsError.New("caller identity account %q does not match the task account %q", identity.Account, accountID)
+	}
+
+	parts := strings.Split(identityARN.Resource, "/")
+	if identityARN.Service != "sts" || len(parts) != 3 || parts[0] != "assumed-role" {
+		return "", ecsError.New("caller identity %q is not an assumed role", identity.Arn)
+	}
+	if parts[2] != taskID {
+		return "", ecsError.New("caller identity session %q does not match the task ID %q", parts[2], taskID)
+	}
+	return parts[1], nil
+}
+
+// parseTaskARN parses the task ARN and returns the task ID, which is the last
+// element of both the short and the long task ARN formats.
+func parseTaskARN(taskARN string) (arn.ARN, string, error) {
+	parsed, err := arn.Parse(taskARN)
+	if err != nil {
+		return arn.ARN{}, "", ecsError.New("invalid task ARN: %w", err)
+	}
+	parts := strings.Split(parsed.Resource, "/")
+	if parsed.Service != "ecs" || len(parts) < 2 || parts[0] != "task" || parts[len(parts)-1] == "" {
+		return arn.ARN{}, "", ecsError.New("invalid task ARN %q", taskARN)
+	}
+	return parsed, parts[len(parts)-1], nil
+}
+
+func describeTask(ctx context.Context, client ECSClient, cluster, taskARN string) (*ecs.Task, error) {
+	output, err := client.DescribeTasksWithContext(ctx, &ecs.DescribeTasksInput{
+		Cluster: aws.String(cluster),
+		Tasks:   []*string{aws.String(taskARN)},
+		Include: []*string{aws.String(ecs.TaskFieldTags)},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(output.Tasks) != 1 || aws.StringValue(output.Tasks[0].TaskArn) != taskARN {
+		return nil, ecsError.New("task not found")
+	}
+
+	task := output.Tasks[0]
+	if status := aws.StringValue(task.LastStatus); status != "RUNNING" {
+		return nil, ecsError.New("task is %s", status)
+	}
+	return task, nil
+}
+
+// getTaskRoleARN returns the task role of the task, which may be overridden
+// when the task is run.
+func getTaskRoleARN(ctx context.Context, client ECSClient, task *ecs.Task) (string, error) {
+	if task.Overrides != nil && aws.StringValue(task.Overrides.TaskRoleArn) != "" {
+		return aws.StringValue(task.Overrides.TaskRoleArn), nil
+	}
+
+	output, err := client.DescribeTaskDefinitionWithContext(ctx, &ecs.DescribeTaskDefinitionInput{
+		TaskDefinition: task.TaskDefinitionArn,
+	})
+	if err != nil {
+		return "", err
+	}
+	if output.TaskDefinition == nil || aws.StringValue(output.TaskDefinition.TaskRoleArn) == "" {
+		return "", ecsError.New("task definition has no task role")
+	}
+	return aws.StringValue(output.TaskDefinition.TaskRoleArn), nil
+}
+
+// resourceName returns the last element of the resource of the ARN, e.g. the
+// name of a role or cluster.
+func resourceName(s string) (string, error) {
+	parsed, err := arn.Parse(s)
+	if err != nil {
+		return "", err
+	}
+	return parsed.Resource[strings.LastIndex(parsed.Resource, "/")+1:], nil
+}
+
+func buildSelectors(clusterName, taskRoleARN string, task *ecs.Task) []*common.Selector {
+	values := []string{
+		fmt.Sprintf("cluster:%s", clusterName),
+		fmt.Sprintf("iamrole:%s", taskRoleARN),
+	}
+	if taskDefinition, err := arn.Parse(aws.StringValue(task.TaskDefinitionArn)); err == nil {
+		familyRevision := strings.TrimPrefix(taskDefinition.Resource, "task-definition/")
+		values = append(values,
+			fmt.Sprintf("task-definition:%s", familyRevision),
+			fmt.Sprintf("family:%s", strings.SplitN(familyRevision, ":", 2)[0]),
+		)
+	}
+	if launchType := aws.StringValue(task.LaunchType); launchType != "" {
+		values = append(values, fmt.Sprintf("launch-type:%s", launchType))
+	}
+	if group := aws.StringValue(task.Group); group != "" {
+		values = append(values, fmt.Sprintf("group:%s", group))
+	}
+	if zone := aws.StringValue(task.AvailabilityZone); zone != "" {
+		values = append(values, fmt.Sprintf("az:%s", zone))
+	}
+	for _, tag := range task.Tags {
+		if tag != nil {
+			values = append(values, fmt.Sprintf("tag:%s:%s", aws.StringValue(tag.Key), aws.StringValue(tag.Value)))
+		}
+	}
+
+	selectors := make([]*common.Selector, 0, len(values))
+	for _, value := range values {
+		selectors = append(selectors, &common.Selector{
+			Type:  caws.ECSPluginName,
+			Value: value,
+		})
+	}
+	util.SortSelectors(selectors)
+	return selectors
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
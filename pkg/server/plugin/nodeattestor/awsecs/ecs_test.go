@@ -0,0 +1,429 @@
+package awsecs
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/ecs"
+	caws "github.com/spiffe/spire/pkg/common/plugin/aws"
+	"github.com/spiffe/spire/pkg/server/plugin/hostservices"
+	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/fakes/fakeagentstore"
+	"github.com/spiffe/spire/test/spiretest"
+	"google.golang.org/grpc/codes"
+)
+
+const (
+	testAccount     = "123456789012"
+	testTaskID      = "0123456789abcdef"
+	testTaskARN     = "arn:aws:ecs:us-west-2:123456789012:task/web/0123456789abcdef"
+	testClusterARN  = "arn:aws:ecs:us-west-2:123456789012:cluster/web"
+	testTaskDefARN  = "arn:aws:ecs:us-west-2:123456789012:task-definition/frontend:7"
+	testTaskRoleARN = "arn:aws:iam::123456789012:role/frontend-task"
+	testCallerARN   = "arn:aws:sts::123456789012:assumed-role/frontend-task/0123456789abcdef"
+	testAgentID     = "spiffe://example.org/spire/agent/aws_ecs/123456789012/us-west-2/web/0123456789abcdef"
+)
+
+func TestECSAttestorPlugin(t *testing.T) {
+	spiretest.Run(t, new(ECSAttestorSuite))
+}
+
+type ECSAttestorSuite struct {
+	spiretest.Suite
+
+	plugin     *ECSAttestorPlugin
+	p          nodeattestor.Plugin
+	env        map[string]string
+	agentStore *fakeagentstore.AgentStore
+	client     *fakeECSClient
+	sts        *httptest.Server
+	stsStatus  int
+	callerARN  string
+	stsBody    string
+}
+
+func (s *ECSAttestorSuite) SetupTest() {
+	s.env = make(map[string]string)
+	s.agentStore = fakeagentstore.New()
+	s.client = newFakeECSClient()
+	s.stsStatus = http.StatusOK
+	s.callerARN = testCallerARN
+	s.stsBody = ""
+
+	s.sts = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		s.stsBody = string(body)
+		w.WriteHeader(s.stsStatus)
+		fmt.Fprintf(w, `<GetCallerIdentityResponse xmlns="https://sts.amazonaws.com/doc/2011-06-15/">
+  <GetCallerIdentityResult>
+    <Arn>%s</Arn>
+    <UserId>AROAEXAMPLE:%s</UserId>
+    <Account>%s</Account>
+  </GetCallerIdentityResult>
+</GetCallerIdentityResponse>`, s.callerARN, testTaskID, testAccount)
+	}))
+
+	p := New()
+	p.hooks.getenv = func(key string) string {
+		return s.env[key]
+	}
+	p.hooks.stsURL = func(string) string {
+		return s.sts.URL
+	}
+	p.clients = newClientsCache(func(*ECSAttestorConfig, string) (ECSClient, error) {
+		return s.client, nil
+	})
+	s.plugin = p
+	s.LoadPlugin(builtin(s.plugin), &s.p,
+		spiretest.HostService(hostservices.AgentStoreHostServiceServer(s.agentStore)),
+	)
+}
+
+func (s *ECSAttestorSuite) TearDownTest() {
+	s.sts.Close()
+}
+
+func (s *ECSAttestorSuite) TestErrorWhenNotConfigured() {
+	stream, err := s.p.Attest(context.Background())
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(stream.CloseSend())
+	}()
+
+	err = stream.Send(&nodeattestor.AttestRequest{})
+	if err != nil && err != io.EOF {
+		s.Require().NoError(err)
+	}
+
+	_, err = stream.Recv()
+	s.RequireGRPCStatus(err, codes.Unknown, "aws-ecs: not configured")
+}
+
+func (s *ECSAttestorSuite) TestErrorOnEmptyRequest() {
+	s.configure("")
+
+	_, err := s.attest(&nodeattestor.AttestRequest{})
+	s.RequireErrorContains(err, "request missing attestation data")
+}
+
+func (s *ECSAttestorSuite) TestErrorOnInvalidType() {
+	s.configure("")
+
+	_, err := s.attest(&nodeattestor.AttestRequest{
+		AttestationData: &common.AttestationData{
+			Type: "foo",
+		},
+	})
+	s.RequireErrorContains(err, `unexpected attestation data type "foo"`)
+}
+
+func (s *ECSAttestorSuite) TestErrorOnBadData() {
+	s.configure("")
+
+	_, err := s.attest(&nodeattestor.AttestRequest{
+		AttestationData: &common.AttestationData{
+			Type: caws.ECSPluginName,
+			Data: []byte("{"),
+		},
+	})
+	s.RequireErrorContains(err, "unexpected end of JSON input")
+}
+
+func (s *ECSAttestorSuite) TestAttestSuccess() {
+	s.configure("")
+
+	resp, err := s.attestData(s.attestationData())
+	s.Require().NoError(err)
+	s.Require().Equal(testAgentID, resp.AgentId)
+	s.Require().Equal(callerIdentityBody, s.stsBody)
+	s.RequireProtoListEqual([]*common.Selector{
+		{Type: caws.ECSPluginName, Value: "az:us-west-2a"},
+		{Type: caws.ECSPluginName, Value: "cluster:web"},
+		{Type: caws.ECSPluginName, Value: "family:frontend"},
+		{Type: caws.ECSPluginName, Value: "group:service:frontend"},
+		{Type: caws.ECSPluginName, Value: "iamrole:" + testTaskRoleARN},
+		{Type: caws.ECSPluginName, Value: "launch-type:FARGATE"},
+		{Type: caws.ECSPluginName, Value: "tag:env:prod"},
+		{Type: caws.ECSPluginName, Value: "task-definition:frontend:7"},
+	}, resp.Selectors)
+}
+
+func (s *ECSAttestorSuite) TestAttestWithTaskRoleOverride() {
+	s.configure("")
+	s.client.task.Overrides = &ecs.TaskOverride{TaskRoleArn: aws.String(testTaskRoleARN)}
+	s.client.taskDefinitionErr = fmt.Errorf("should not be called")
+
+	resp, err := s.attestData(s.attestationData())
+	s.Require().NoError(err)
+	s.Require().Equal(testAgentID, resp.AgentId)
+}
+
+func (s *ECSAttestorSuite) TestAttestFailures() {
+	for _, tt := range []struct {
+		name   string
+		config string
+		modify func(*caws.ECSAttestationData)
+		setup  func()
+		err    string
+	}{
+		{
+			name: "invalid task ARN",
+			modify: func(data *caws.ECSAttestationData) {
+				data.TaskARN = "arn:aws:ec2:us-west-2:123456789012:instance/i-0123"
+			},
+			err: "invalid task ARN",
+		},
+		{
+			name:   "account not allowed",
+			config: `account_ids = ["210987654321"]`,
+			err:    `account "123456789012" is not allowed`,
+		},
+		{
+			name: "unexpected STS host",
+			modify: func(data *caws.ECSAttestationData) {
+				data.CallerIdentityRequest.Host = "sts.example.org"
+			},
+			err: `unexpected STS host "sts.example.org"`,
+		},
+		{
+			name: "not a GetCallerIdentity request",
+			modify: func(data *caws.ECSAttestationData) {
+				data.CallerIdentityRequest.Body = []byte("Action=AssumeRole&Version=2011-06-15")
+			},
+			err: "request is not a GetCallerIdentity request",
+		},
+		{
+			name: "wrong trust domain",
+			modify: func(data *caws.ECSAttestationData) {
+				data.CallerIdentityRequest.Headers.Set(caws.ECSTrustDomainHeader, "other.org")
+			},
+			err: `request is for trust domain "other.org"`,
+		},
+		{
+			name: "trust domain header not signed",
+			modify: func(data *caws.ECSAttestationData) {
+				data.CallerIdentityRequest.Headers.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKID/20210101/us-west-2/sts/aws4_request, SignedHeaders=content-type;host;x-amz-date, Signature=abcd")
+			},
+			err: "request does not sign the X-Spire-Trust-Domain header",
+		},
+		{
+			name: "STS rejects signature",
+			setup: func() {
+				s.stsStatus = http.StatusForbidden
+			},
+			err: "GetCallerIdentity failed with status code 403",
+		},
+		{
+			name: "caller is not an assumed role",
+			setup: func() {
+				s.callerARN = "arn:aws:iam::123456789012:user/alice"
+			},
+			err: "is not an assumed role",
+		},
+		{
+			name: "caller session is not the task",
+			setup: func() {
+				s.callerARN = "arn:aws:sts::123456789012:assumed-role/frontend-task/other"
+			},
+			err: `caller identity session "other" does not match the task ID "0123456789abcdef"`,
+		},
+		{
+			name: "task not found",
+			setup: func() {
+				s.client.task.TaskArn = aws.String("arn:aws:ecs:us-west-2:123456789012:task/web/other")
+			},
+			err: "task not found",
+		},
+		{
+			name: "task not running",
+			setup: func() {
+				s.client.task.LastStatus = aws.String("STOPPED")
+			},
+			err: "task is STOPPED",
+		},
+		{
+			name: "task role mismatch",
+			setup: func() {
+				s.client.taskRoleARN = "arn:aws:iam::123456789012:role/other"
+			},
+			err: `caller identity role "frontend-task" is not the task role`,
+		},
+		{
+			name:   "cluster not allowed",
+			config: `clusters = ["backend"]`,
+			err:    `cluster "web" is not allowed`,
+		},
+		{
+			name: "already attested",
+			setup: func() {
+				s.agentStore.SetAgentInfo(&hostservices.AgentInfo{AgentId: testAgentID})
+			},
+			err: "task has already been used to attest an agent",
+		},
+	} {
+		tt := tt
+		s.Run(tt.name, func() {
+			s.SetupTest()
+			defer s.TearDownTest()
+			s.configure(tt.config)
+			if tt.setup != nil {
+				tt.setup()
+			}
+			data := s.attestationData()
+			if tt.modify != nil {
+				tt.modify(data)
+			}
+			_, err := s.attestData(data)
+			s.RequireErrorContains(err, tt.err)
+		})
+	}
+}
+
+func (s *ECSAttestorSuite) TestConfigure() {
+	require := s.Require()
+
+	// malformed
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: "blah",
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.RequireGRPCStatusContains(err, codes.Unknown, "aws-ecs: error decoding AWS ECS Attestor configuration")
+
+	// missing global configuration
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{})
+	s.RequireGRPCStatus(err, codes.Unknown, "aws-ecs: global configuration is required")
+
+	// missing trust domain
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig: &plugin.ConfigureRequest_GlobalConfig{},
+	})
+	s.RequireGRPCStatus(err, codes.Unknown, "aws-ecs: trust_domain is required")
+
+	// missing secret access key
+	s.env[accessKeyIDVarName] = "ACCESSKEYID"
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		GlobalConfig: &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.RequireGRPCStatus(err, codes.Unknown, "aws-ecs: configuration missing secret access key, but has access key id")
+
+	// credentials from the environment
+	s.env[secretAccessKeyVarName] = "SECRETACCESSKEY"
+	_, err = s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: `clusters = ["web"]`,
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	require.NoError(err)
+	require.Equal("ACCESSKEYID", s.plugin.config.AccessKeyID)
+	require.Equal("SECRETACCESSKEY", s.plugin.config.SecretAccessKey)
+	require.Equal([]string{"web"}, s.plugin.config.Clusters)
+}
+
+func (s *ECSAttestorSuite) TestGetPluginInfo() {
+	resp, err := s.p.GetPluginInfo(context.Background(), &plugin.GetPluginInfoRequest{})
+	s.Require().NoError(err)
+	s.RequireProtoEqual(&plugin.GetPluginInfoResponse{}, resp)
+}
+
+func (s *ECSAttestorSuite) configure(config string) {
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: config,
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *ECSAttestorSuite) attestationData() *caws.ECSAttestationData {
+	headers := make(http.Header)
+	headers.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	headers.Set("X-Amz-Date", "20210101T000000Z")
+	headers.Set(caws.ECSTrustDomainHeader, "example.org")
+	headers.Set("Authorization", "AWS4-HMAC-SHA256 Credential=AKID/20210101/us-west-2/sts/aws4_request, SignedHeaders=content-type;host;x-amz-date;x-spire-trust-domain, Signature=abcd")
+	return &caws.ECSAttestationData{
+		TaskARN: testTaskARN,
+		Cluster: testClusterARN,
+		CallerIdentityRequest: caws.SignedRequest{
+			Host:    "sts.us-west-2.amazonaws.com",
+			Headers: headers,
+			Body:    []byte(callerIdentityBody),
+		},
+	}
+}
+
+func (s *ECSAttestorSuite) attestData(data *caws.ECSAttestationData) (*nodeattestor.AttestResponse, error) {
+	dataBytes, err := json.Marshal(data)
+	s.Require().NoError(err)
+	return s.attest(&nodeattestor.AttestRequest{
+		AttestationData: &common.AttestationData{
+			Type: caws.ECSPluginName,
+			Data: dataBytes,
+		},
+	})
+}
+
+func (s *ECSAttestorSuite) attest(req *nodeattestor.AttestRequest) (*nodeattestor.AttestResponse, error) {
+	stream, err := s.p.Attest(context.Background())
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(stream.CloseSend())
+	}()
+	err = stream.Send(req)
+	s.Require().NoError(err)
+	return stream.Recv()
+}
+
+type fakeECSClient struct {
+	task              *ecs.Task
+	taskRoleARN       string
+	taskDefinitionErr error
+}
+
+func newFakeECSClient() *fakeECSClient {
+	return &fakeECSClient{
+		task: &ecs.Task{
+			TaskArn:           aws.String(testTaskARN),
+			ClusterArn:        aws.String(testClusterARN),
+			TaskDefinitionArn: aws.String(testTaskDefARN),
+			LastStatus:        aws.String("RUNNING"),
+			LaunchType:        aws.String(ecs.LaunchTypeFargate),
+			Group:             aws.String("service:frontend"),
+			AvailabilityZone:  aws.String("us-west-2a"),
+			Tags: []*ecs.Tag{
+				{Key: aws.String("env"), Value: aws.String("prod")},
+			},
+		},
+		taskRoleARN: testTaskRoleARN,
+	}
+}
+
+func (c *fakeECSClient) DescribeTasksWithContext(ctx aws.Context, input *ecs.DescribeTasksInput, opts ...request.Option) (*ecs.DescribeTasksOutput, error) {
+	if aws.StringValue(input.Cluster) != testClusterARN || len(input.Tasks) != 1 {
+		return nil, fmt.Errorf("unexpected input: %s", input)
+	}
+	return &ecs.DescribeTasksOutput{
+		Tasks: []*ecs.Task{c.task},
+	}, nil
+}
+
+func (c *fakeECSClient) DescribeTaskDefinitionWithContext(ctx aws.Context, input *ecs.DescribeTaskDefinitionInput, opts ...request.Option) (*ecs.DescribeTaskDefinitionOutput, error) {
+	if c.taskDefinitionErr != nil {
+		return nil, c.taskDefinitionErr
+	}
+	if aws.StringValue(input.TaskDefinition) != testTaskDefARN {
+		return nil, fmt.Errorf("unexpected task definition %q", aws.StringValue(input.TaskDefinition))
+	}
+	return &ecs.DescribeTaskDefinitionOutput{
+		TaskDefinition: &ecs.TaskDefinition{
+			TaskRoleArn: aws.String(c.taskRoleARN),
+		},
+	}, nil
+}
@@ -25,6 +25,9 @@ const (
 	Type = "NodeAttestor"
 )
 
+// Version is the version of the NodeAttestor definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // NodeAttestor is the client interface for the service type NodeAttestor interface.
 type NodeAttestor interface {
 	Attest(context.Context) (NodeAttestor_AttestClient, error)
@@ -37,6 +40,11 @@ type Plugin interface {
 	GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error)
 }
 
+// Serve serves the NodeAttestor plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server NodeAttestorServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the NodeAttestor plugin.
 func PluginServer(server NodeAttestorServer) catalog.PluginServer {
 	return &pluginServer{
@@ -52,6 +60,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -70,6 +82,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(nodeattestor.NewNodeAttestorClient(conn))
 }
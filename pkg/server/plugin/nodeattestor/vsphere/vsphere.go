@@ -0,0 +1,374 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/plugin/vsphere"
+	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
+	nodeattestorbase "github.com/spiffe/spire/pkg/server/plugin/nodeattestor/base"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/object"
+	"github.com/vmware/govmomi/vim25/mo"
+	"github.com/vmware/govmomi/vim25/types"
+)
+
+const (
+	pluginName = vsphere.PluginName
+)
+
+var (
+	// vcenterTimeout bounds the calls to vCenter made before and after the
+	// challenge, which each involve a few round trips and a reconfiguration
+	// task.
+	vcenterTimeout = 30 * time.Second
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtin(New())
+}
+
+func builtin(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin(pluginName,
+		nodeattestor.PluginServer(p),
+	)
+}
+
+type configuration struct {
+	trustDomain        string
+	vcenterURL         *url.URL
+	insecureSkipVerify bool
+	datacenters        map[string]bool
+}
+
+type Config struct {
+	VCenterURL         string   `hcl:"vcenter_url"`
+	Username           string   `hcl:"username"`
+	Password           string   `hcl:"password"`
+	InsecureSkipVerify bool     `hcl:"insecure_skip_verify"`
+	Datacenters        []string `hcl:"datacenters"`
+}
+
+// Plugin implements node attestation for VMs managed by VMware vCenter. The
+// agent claims the BIOS UUID of its VM and proves that it runs in the guest
+// by reading a nonce that the server delivers to the guestinfo of the VM.
+type Plugin struct {
+	nodeattestorbase.Base
+
+	log hclog.Logger
+
+	m sync.Mutex
+	c *configuration
+}
+
+func New() *Plugin {
+	return &Plugin{}
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Attest(stream nodeattestor.NodeAttestor_AttestServer) error {
+	req, err := stream.Recv()
+	if err != nil {
+		return err
+	}
+
+	c := p.getConfiguration()
+	if c == nil {
+		return newError("not configured")
+	}
+
+	if req.AttestationData == nil {
+		return newError("request missing attestation data")
+	}
+
+	if dataType := req.AttestationData.Type; dataType != pluginName {
+		return newError("unexpected attestation data type %q", dataType)
+	}
+
+	attestationData := new(vsphere.AttestationData)
+	if err := json.Unmarshal(req.AttestationData.Data, attestationData); err != nil {
+		return newError("failed to unmarshal data: %v", err)
+	}
+
+	uuid, err := vsphere.NormalizeUUID(attestationData.UUID)
+	if err != nil {
+		return newError("%v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(stream.Context(), vcenterTimeout)
+	defer cancel()
+
+	client, err := govmomi.NewClient(ctx, c.vcenterURL, c.insecureSkipVerify)
+	if err != nil {
+		return newError("unable to connect to vCenter: %v", err)
+	}
+	defer func() {
+		// The stream context may be done by now, so log out independently.
+		logoutCtx, logoutCancel := context.WithTimeout(context.Background(), vcenterTimeout)
+		defer logoutCancel()
+		if err := client.Logout(logoutCtx); err != nil && p.log != nil {
+			p.log.Warn("Failed to log out of vCenter", "error", err)
+		}
+	}()
+
+	vm, uuid, err := findVM(ctx, client, uuid)
+	if err != nil {
+		return err
+	}
+
+	// The agent ID is based on the UUID reported by vCenter so that it does
+	// not depend on the byte order the guest reads the UUID in.
+	agentID := vsphere.MakeSpiffeID(c.trustDomain, uuid)
+	attested, err := p.IsAttested(stream.Context(), agentID)
+	switch {
+	case err != nil:
+		return err
+	case attested:
+		return newError("VM has already been used to attest an agent")
+	}
+
+	selectors, err := resolveSelectors(ctx, client, vm)
+	if err != nil {
+		return err
+	}
+	if len(c.datacenters) > 0 && !c.datacenters[selectors.datacenter] {
+		return newError("datacenter %q is not allowed", selectors.datacenter)
+	}
+
+	nonce, response, err := p.challenge(ctx, stream, vm)
+	if err != nil {
+		return err
+	}
+
+	if err := vsphere.VerifyChallengeResponse(nonce, response); err != nil {
+		return newError("challenge response verification failed: %v", err)
+	}
+
+	return stream.Send(&nodeattestor.AttestResponse{
+		AgentId:   agentID,
+		Selectors: selectors.build(),
+	})
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(Config)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, newError("unable to decode configuration: %v", err)
+	}
+
+	if req.GlobalConfig == nil {
+		return nil, newError("global configuration is required")
+	}
+
+	if req.GlobalConfig.TrustDomain == "" {
+		return nil, newError("trust_domain is required")
+	}
+
+	if config.VCenterURL == "" {
+		return nil, newError("vcenter_url is required")
+	}
+	vcenterURL, err := url.Parse(config.VCenterURL)
+	if err != nil {
+		return nil, newError("unable to parse vcenter_url: %v", err)
+	}
+	if vcenterURL.Scheme != "https" && !config.InsecureSkipVerify {
+		return nil, newError("vcenter_url must use https")
+	}
+	if vcenterURL.Path == "" || vcenterURL.Path == "/" {
+		vcenterURL.Path = "/sdk"
+	}
+
+	if config.Username == "" {
+		return nil, newError("username is required")
+	}
+	if config.Password == "" {
+		return nil, newError("password is required")
+	}
+	vcenterURL.User = url.UserPassword(config.Username, config.Password)
+
+	var datacenters map[string]bool
+	if len(config.Datacenters) > 0 {
+		datacenters = make(map[string]bool)
+		for _, datacenter := range config.Datacenters {
+			datacenters[datacenter] = true
+		}
+	}
+
+	p.setConfiguration(&configuration{
+		trustDomain:        req.GlobalConfig.TrustDomain,
+		vcenterURL:         vcenterURL,
+		insecureSkipVerify: config.InsecureSkipVerify,
+		datacenters:        datacenters,
+	})
+
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (*Plugin) GetPluginInfo(context.Context, *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfiguration() *configuration {
+	p.m.Lock()
+	defer p.m.Unlock()
+	return p.c
+}
+
+func (p *Plugin) setConfiguration(c *configuration) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.c = c
+}
+
+// challenge delivers a nonce to the guestinfo of the VM, which the agent must
+// read to prove that it runs in the VM it claims, and returns the nonce along
+// with the response of the agent. The nonce is cleared from the guestinfo
+// before returning.
+func (p *Plugin) challenge(ctx context.Context, stream nodeattestor.NodeAttestor_AttestServer, vm *object.VirtualMachine) (string, *vsphere.Response, error) {
+	nonce, err := vsphere.GenerateNonce()
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to generate challenge: %v", err)
+	}
+	if err := setGuestInfo(ctx, vm, vsphere.ChallengeKey, nonce); err != nil {
+		return "", nil, newError("unable to deliver challenge: %v", err)
+	}
+	defer func() {
+		// The stream context may be done by now, so clear independently.
+		clearCtx, clearCancel := context.WithTimeout(context.Background(), vcenterTimeout)
+		defer clearCancel()
+		if err := setGuestInfo(clearCtx, vm, vsphere.ChallengeKey, ""); err != nil && p.log != nil {
+			p.log.Warn("Failed to clear challenge from guestinfo", "error", err)
+		}
+	}()
+
+	challengeBytes, err := json.Marshal(vsphere.Challenge{
+		Key: vsphere.ChallengeKey,
+	})
+	if err != nil {
+		return "", nil, fmt.Errorf("unable to marshal challenge: %v", err)
+	}
+
+	if err := stream.Send(&nodeattestor.AttestResponse{
+		Challenge: challengeBytes,
+	}); err != nil {
+		return "", nil, err
+	}
+
+	responseReq, err := stream.Recv()
+	if err != nil {
+		return "", nil, err
+	}
+
+	response := new(vsphere.Response)
+	if err := json.Unmarshal(responseReq.Response, response); err != nil {
+		return "", nil, newError("unable to unmarshal challenge response: %v", err)
+	}
+	return nonce, response, nil
+}
+
+func newError(format string, args ...interface{}) error {
+	return fmt.Errorf("vsphere: "+format, args...)
+}
+
+// findVM finds the VM with the given BIOS UUID, trying the UUID with the
+// byte order swapped if the VM is not found, and returns the VM along with
+// its UUID as reported by vCenter.
+func findVM(ctx context.Context, client *govmomi.Client, uuid string) (*object.VirtualMachine, string, error) {
+	searchIndex := object.NewSearchIndex(client.Client)
+	instanceUUID := false
+	for _, candidate := range []string{uuid, vsphere.SwapUUIDByteOrder(uuid)} {
+		ref, err := searchIndex.FindByUuid(ctx, nil, candidate, true, &instanceUUID)
+		if err != nil {
+			return nil, "", newError("unable to find VM: %v", err)
+		}
+		if vm, ok := ref.(*object.VirtualMachine); ok {
+			return vm, candidate, nil
+		}
+	}
+	return nil, "", newError("no VM found with UUID %q", uuid)
+}
+
+func setGuestInfo(ctx context.Context, vm *object.VirtualMachine, key, value string) error {
+	task, err := vm.Reconfigure(ctx, types.VirtualMachineConfigSpec{
+		ExtraConfig: []types.BaseOptionValue{
+			&types.OptionValue{Key: key, Value: value},
+		},
+	})
+	if err != nil {
+		return err
+	}
+	return task.Wait(ctx)
+}
+
+type vmSelectors struct {
+	datacenter   string
+	cluster      string
+	resourcePool string
+}
+
+// resolveSelectors resolves the datacenter, cluster and resource pool of the
+// VM. The cluster is the owner of the resource pool of the VM, unless the VM
+// runs on a standalone host, and the datacenter is the closest datacenter
+// among the ancestors of the owner.
+func resolveSelectors(ctx context.Context, client *govmomi.Client, vm *object.VirtualMachine) (*vmSelectors, error) {
+	var vmMo mo.VirtualMachine
+	if err := client.RetrieveOne(ctx, vm.Reference(), []string{"resourcePool"}, &vmMo); err != nil {
+		return nil, newError("unable to retrieve VM properties: %v", err)
+	}
+	if vmMo.ResourcePool == nil {
+		return nil, newError("VM has no resource pool")
+	}
+
+	var pool mo.ResourcePool
+	if err := client.RetrieveOne(ctx, *vmMo.ResourcePool, []string{"name", "owner"}, &pool); err != nil {
+		return nil, newError("unable to retrieve resource pool properties: %v", err)
+	}
+
+	selectors := &vmSelectors{
+		resourcePool: pool.Name,
+	}
+
+	ref := &pool.Owner
+	for ref != nil {
+		var entity mo.ManagedEntity
+		if err := client.RetrieveOne(ctx, *ref, []string{"name", "parent"}, &entity); err != nil {
+			return nil, newError("unable to retrieve %s properties: %v", ref.Type, err)
+		}
+		switch ref.Type {
+		case "ClusterComputeResource":
+			selectors.cluster = entity.Name
+		case "Datacenter":
+			selectors.datacenter = entity.Name
+			return selectors, nil
+		}
+		ref = entity.Parent
+	}
+	return nil, newError("VM has no datacenter")
+}
+
+func (s *vmSelectors) build() []*common.Selector {
+	selectors := []*common.Selector{
+		{Type: pluginName, Value: "datacenter:" + s.datacenter},
+	}
+	if s.cluster != "" {
+		selectors = append(selectors, &common.Selector{
+			Type: pluginName, Value: "cluster:" + s.cluster,
+		})
+	}
+	selectors = append(selectors, &common.Selector{
+		Type: pluginName, Value: "resource-pool:" + s.resourcePool,
+	})
+	return selectors
+}
@@ -0,0 +1,280 @@
+package vsphere
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/common/plugin/vsphere"
+	"github.com/spiffe/spire/pkg/server/plugin/hostservices"
+	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
+	"github.com/spiffe/spire/proto/spire/common"
+	"github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/fakes/fakeagentstore"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/vmware/govmomi"
+	"github.com/vmware/govmomi/simulator"
+	"github.com/vmware/govmomi/vim25/mo"
+	"google.golang.org/grpc/codes"
+)
+
+func TestVSphere(t *testing.T) {
+	spiretest.Run(t, new(Suite))
+}
+
+type Suite struct {
+	spiretest.Suite
+
+	p          nodeattestor.Plugin
+	agentStore *fakeagentstore.AgentStore
+
+	model  *simulator.Model
+	server *simulator.Server
+	client *govmomi.Client
+	vm     *simulator.VirtualMachine
+}
+
+func (s *Suite) SetupTest() {
+	s.model = simulator.VPX()
+	s.Require().NoError(s.model.Create())
+	s.model.Service.Listen = &url.URL{User: url.UserPassword("spire", "secret")}
+	s.server = s.model.Service.NewServer()
+
+	client, err := govmomi.NewClient(context.Background(), s.server.URL, true)
+	s.Require().NoError(err)
+	s.client = client
+
+	// a VM in a cluster of the datacenter
+	for _, obj := range simulator.Map.All("VirtualMachine") {
+		vm := obj.(*simulator.VirtualMachine)
+		if vm.Name == "DC0_C0_RP0_VM0" {
+			s.vm = vm
+		}
+	}
+	s.Require().NotNil(s.vm)
+
+	s.agentStore = fakeagentstore.New()
+	s.LoadPlugin(BuiltIn(), &s.p,
+		spiretest.HostService(hostservices.AgentStoreHostServiceServer(s.agentStore)),
+	)
+}
+
+func (s *Suite) TearDownTest() {
+	s.server.Close()
+	s.model.Remove()
+}
+
+func (s *Suite) TestAttestSuccess() {
+	s.configure("")
+
+	resp, err := s.attest(s.vm.Config.Uuid, s.readChallenge)
+	s.Require().NoError(err)
+	s.Require().Equal("spiffe://example.org/spire/agent/vsphere/"+s.vm.Config.Uuid, resp.AgentId)
+	s.RequireProtoListEqual([]*common.Selector{
+		{Type: "vsphere", Value: "datacenter:DC0"},
+		{Type: "vsphere", Value: "cluster:DC0_C0"},
+		{Type: "vsphere", Value: "resource-pool:Resources"},
+	}, resp.Selectors)
+
+	// the challenge is cleared once the attestation completes
+	s.Require().Equal("", s.guestInfo(vsphere.ChallengeKey))
+}
+
+func (s *Suite) TestAttestSuccessWithSwappedUUID() {
+	s.configure("")
+
+	resp, err := s.attest(vsphere.SwapUUIDByteOrder(s.vm.Config.Uuid), s.readChallenge)
+	s.Require().NoError(err)
+	s.Require().Equal("spiffe://example.org/spire/agent/vsphere/"+s.vm.Config.Uuid, resp.AgentId)
+}
+
+func (s *Suite) TestAttestFailsWithWrongNonce() {
+	s.configure("")
+
+	_, err := s.attest(s.vm.Config.Uuid, func(*vsphere.Challenge) string {
+		return "not the nonce"
+	})
+	s.RequireGRPCStatus(err, codes.Unknown, "vsphere: challenge response verification failed: nonce does not match")
+}
+
+func (s *Suite) TestAttestFailsWithUnknownVM() {
+	s.configure("")
+
+	_, err := s.attest("00000000-0000-0000-0000-000000000000", s.readChallenge)
+	s.RequireGRPCStatus(err, codes.Unknown, `vsphere: no VM found with UUID "00000000-0000-0000-0000-000000000000"`)
+}
+
+func (s *Suite) TestAttestFailsWithMalformedUUID() {
+	s.configure("")
+
+	_, err := s.attest("not-a-uuid", s.readChallenge)
+	s.RequireGRPCStatus(err, codes.Unknown, `vsphere: malformed UUID "not-a-uuid"`)
+}
+
+func (s *Suite) TestAttestFailsWhenDatacenterNotAllowed() {
+	s.configure(`datacenters = ["DC1"]`)
+
+	_, err := s.attest(s.vm.Config.Uuid, s.readChallenge)
+	s.RequireGRPCStatus(err, codes.Unknown, `vsphere: datacenter "DC0" is not allowed`)
+}
+
+func (s *Suite) TestAttestFailsWhenAlreadyAttested() {
+	s.configure("")
+	s.agentStore.SetAgentInfo(&hostservices.AgentInfo{
+		AgentId: "spiffe://example.org/spire/agent/vsphere/" + s.vm.Config.Uuid,
+	})
+
+	_, err := s.attest(s.vm.Config.Uuid, s.readChallenge)
+	s.RequireGRPCStatus(err, codes.Unknown, "vsphere: VM has already been used to attest an agent")
+}
+
+func (s *Suite) TestAttestFailsWithBadCredentials() {
+	s.configure(`password = "wrong"`)
+
+	_, err := s.attest(s.vm.Config.Uuid, s.readChallenge)
+	s.RequireGRPCStatusContains(err, codes.Unknown, "vsphere: unable to connect to vCenter")
+}
+
+func (s *Suite) TestAttestFailsWhenNotConfigured() {
+	_, err := s.attest(s.vm.Config.Uuid, s.readChallenge)
+	s.RequireGRPCStatus(err, codes.Unknown, "vsphere: not configured")
+}
+
+func (s *Suite) TestAttestFailsWithWrongType() {
+	s.configure("")
+
+	stream, err := s.p.Attest(context.Background())
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(stream.CloseSend())
+	}()
+
+	s.Require().NoError(stream.Send(&nodeattestor.AttestRequest{
+		AttestationData: &common.AttestationData{Type: "foo"},
+	}))
+	_, err = stream.Recv()
+	s.RequireGRPCStatus(err, codes.Unknown, `vsphere: unexpected attestation data type "foo"`)
+}
+
+func (s *Suite) TestConfigure() {
+	tests := []struct {
+		desc   string
+		config string
+		err    string
+	}{
+		{
+			desc: "missing vcenter_url",
+			err:  "vsphere: vcenter_url is required",
+		},
+		{
+			desc:   "http vcenter_url",
+			config: `vcenter_url = "http://vcenter.example.org"`,
+			err:    "vsphere: vcenter_url must use https",
+		},
+		{
+			desc:   "missing username",
+			config: `vcenter_url = "https://vcenter.example.org"`,
+			err:    "vsphere: username is required",
+		},
+		{
+			desc: "missing password",
+			config: `vcenter_url = "https://vcenter.example.org"
+				username = "spire"`,
+			err: "vsphere: password is required",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+		s.T().Run(tt.desc, func(t *testing.T) {
+			_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+				Configuration: tt.config,
+				GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+			})
+			spiretest.RequireGRPCStatus(t, err, codes.Unknown, tt.err)
+		})
+	}
+
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: `vcenter_url = "https://vcenter.example.org"`,
+	})
+	s.RequireGRPCStatus(err, codes.Unknown, "vsphere: global configuration is required")
+}
+
+func (s *Suite) TestGetPluginInfo() {
+	resp, err := s.p.GetPluginInfo(context.Background(), &plugin.GetPluginInfoRequest{})
+	s.Require().NoError(err)
+	s.RequireProtoEqual(&plugin.GetPluginInfoResponse{}, resp)
+}
+
+func (s *Suite) configure(extra string) {
+	password, _ := s.server.URL.User.Password()
+	u := &url.URL{Scheme: s.server.URL.Scheme, Host: s.server.URL.Host, Path: s.server.URL.Path}
+	config := fmt.Sprintf(`
+		vcenter_url = %q
+		username = %q
+		password = %q
+		insecure_skip_verify = true
+		%s`, u.String(), s.server.URL.User.Username(), password, extra)
+
+	_, err := s.p.Configure(context.Background(), &plugin.ConfigureRequest{
+		Configuration: config,
+		GlobalConfig:  &plugin.ConfigureRequest_GlobalConfig{TrustDomain: "example.org"},
+	})
+	s.Require().NoError(err)
+}
+
+func (s *Suite) attest(uuid string, respond func(*vsphere.Challenge) string) (*nodeattestor.AttestResponse, error) {
+	stream, err := s.p.Attest(context.Background())
+	s.Require().NoError(err)
+	defer func() {
+		s.Require().NoError(stream.CloseSend())
+	}()
+
+	data, err := json.Marshal(vsphere.AttestationData{UUID: uuid})
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&nodeattestor.AttestRequest{
+		AttestationData: &common.AttestationData{
+			Type: "vsphere",
+			Data: data,
+		},
+	}))
+
+	resp, err := stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	s.Require().NotEmpty(resp.Challenge)
+
+	challenge := new(vsphere.Challenge)
+	s.Require().NoError(json.Unmarshal(resp.Challenge, challenge))
+
+	response, err := json.Marshal(vsphere.Response{Nonce: respond(challenge)})
+	s.Require().NoError(err)
+	s.Require().NoError(stream.Send(&nodeattestor.AttestRequest{
+		Response: response,
+	}))
+	return stream.Recv()
+}
+
+// readChallenge reads the challenge from the guestinfo of the VM, like the
+// agent running in the guest does.
+func (s *Suite) readChallenge(challenge *vsphere.Challenge) string {
+	return s.guestInfo(challenge.Key)
+}
+
+func (s *Suite) guestInfo(key string) string {
+	var vm mo.VirtualMachine
+	s.Require().NoError(s.client.RetrieveOne(context.Background(), s.vm.Reference(), []string{"config.extraConfig"}, &vm))
+
+	// the simulator appends extraConfig options, so the last one wins
+	var value string
+	for _, option := range vm.Config.ExtraConfig {
+		if o := option.GetOptionValue(); o.Key == key {
+			value, _ = o.Value.(string)
+		}
+	}
+	return value
+}
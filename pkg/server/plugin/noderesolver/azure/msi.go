@@ -35,6 +35,7 @@ var (
 
 	reAgentIDPath            = regexp.MustCompile(`^/spire/agent/azure_msi/([^/]+)/([^/]+)`)
 	reVirtualMachineID       = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.Compute/virtualMachines/([^/]+)$`)
+	reVirtualMachineScaleSet = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.Compute/virtualMachineScaleSets/([^/]+)$`)
 	reNetworkSecurityGroupID = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.Network/networkSecurityGroups/([^/]+)$`)
 	reNetworkInterfaceID     = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.Network/networkInterfaces/([^/]+)$`)
 	reVirtualNetworkSubnetID = regexp.MustCompile(`^/subscriptions/[^/]+/resourceGroups/([^/]+)/providers/Microsoft.Network/virtualNetworks/([^/]+)/subnets/([^/]+)$`)
@@ -215,6 +216,7 @@ func (p *MSIResolverPlugin) resolveSpiffeID(ctx context.Context, spiffeID string
 	// individual selectors (e.g. the virtual network for each interface)
 	selectorMap := map[string]bool{
 		selectorValue("subscription-id", client.SubscriptionID()): true,
+		selectorValue("resource-id", vmResourceID):                true,
 		selectorValue("vm-name", vmResourceGroup, vmName):         true,
 	}
 	addSelectors := func(values []string) {
@@ -228,15 +230,31 @@ func (p *MSIResolverPlugin) resolveSpiffeID(ctx context.Context, spiffeID string
 	if err != nil {
 		return nil, msiError.New("unable to get virtual machine %q: %v", resourceGroupName(vmResourceGroup, vmName), err)
 	}
-	if vm.NetworkProfile != nil {
-		networkProfileSelectors, err := getNetworkProfileSelectors(ctx, client, vm.NetworkProfile)
-		if err != nil {
-			return nil, err
+	addSelectors(getTagSelectors(vm.Tags))
+	if props := vm.VirtualMachineProperties; props != nil {
+		// virtual machines in a scale set with flexible orchestration have
+		// their own identity and reference the scale set they belong to
+		if vmss := props.VirtualMachineScaleSet; vmss != nil && vmss.ID != nil {
+			vmssResourceGroup, vmssName, err := parseVirtualMachineScaleSetID(*vmss.ID)
+			if err != nil {
+				return nil, err
+			}
+			selectorMap[selectorValue("vmss-name", vmssResourceGroup, vmssName)] = true
+		}
+		if props.NetworkProfile != nil {
+			networkProfileSelectors, err := getNetworkProfileSelectors(ctx, client, props.NetworkProfile)
+			if err != nil {
+				return nil, err
+			}
+			addSelectors(networkProfileSelectors)
 		}
-		addSelectors(networkProfileSelectors)
 	}
 
-	// sort and return selectors
+	return buildSelectors(selectorMap), nil
+}
+
+// buildSelectors returns the sorted selectors for the unique selector values
+func buildSelectors(selectorMap map[string]bool) *common.Selectors {
 	selectorValues := make([]string, 0, len(selectorMap))
 	for selectorValue := range selectorMap {
 		selectorValues = append(selectorValues, selectorValue)
@@ -250,8 +268,19 @@ func (p *MSIResolverPlugin) resolveSpiffeID(ctx context.Context, spiffeID string
 			Value: selectorValue,
 		})
 	}
+	return selectors
+}
 
-	return selectors, nil
+func getTagSelectors(tags map[string]*string) []string {
+	var selectors []string
+	for name, value := range tags {
+		if value == nil {
+			selectors = append(selectors, selectorValue("tag", name, ""))
+			continue
+		}
+		selectors = append(selectors, selectorValue("tag", name, *value))
+	}
+	return selectors
 }
 
 func getNetworkProfileSelectors(ctx context.Context, client apiClient, networkProfile *compute.NetworkProfile) ([]string, error) {
@@ -272,6 +301,7 @@ func getNetworkProfileSelectors(ctx context.Context, client apiClient, networkPr
 		if err != nil {
 			return nil, msiError.New("unable to get network interface %q: %v", resourceGroupName(niResourceGroup, niName), err)
 		}
+		selectors = append(selectors, selectorValue("network-interface", niResourceGroup, niName))
 
 		networkInterfaceSelectors, err := getNetworkInterfaceSelectors(networkInterface)
 		if err != nil {
@@ -328,6 +358,14 @@ func parseVirtualMachineID(id string) (resourceGroup, name string, err error) {
 	return m[1], m[2], nil
 }
 
+func parseVirtualMachineScaleSetID(id string) (resourceGroup, name string, err error) {
+	m := reVirtualMachineScaleSet.FindStringSubmatch(id)
+	if m == nil {
+		return "", "", msiError.New("malformed virtual machine scale set ID %q", id)
+	}
+	return m[1], m[2], nil
+}
+
 func parseNetworkSecurityGroupID(id string) (resourceGroup, name string, err error) {
 	m := reNetworkSecurityGroupID.FindStringSubmatch(id)
 	if m == nil {
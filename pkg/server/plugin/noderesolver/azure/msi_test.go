@@ -27,13 +27,18 @@ var (
 	niResourceID        = "/subscriptions/SUBSCRIPTIONID/resourceGroups/RESOURCEGROUP/providers/Microsoft.Network/networkInterfaces/NETWORKINTERFACE"
 	nsgResourceID       = "/subscriptions/SUBSCRIPTIONID/resourceGroups/NSGRESOURCEGROUP/providers/Microsoft.Network/networkSecurityGroups/NETWORKSECURITYGROUP"
 	subnetResourceID    = "/subscriptions/SUBSCRIPTIONID/resourceGroups/NETRESOURCEGROUP/providers/Microsoft.Network/virtualNetworks/VIRTUALNETWORK/subnets/SUBNET"
+	vmssResourceID      = "/subscriptions/SUBSCRIPTIONID/resourceGroups/VMSSRESOURCEGROUP/providers/Microsoft.Compute/virtualMachineScaleSets/SCALESET"
 	malformedResourceID = "MALFORMEDRESOURCEID"
 
 	// these are expected selectors
 	vmSelectors = []string{
+		"resource-id:" + vmResourceID,
 		"subscription-id:SUBSCRIPTION",
 		"vm-name:RESOURCEGROUP:VIRTUALMACHINE",
 	}
+	niNameSelectors = []string{
+		"network-interface:RESOURCEGROUP:NETWORKINTERFACE",
+	}
 	niSelectors = []string{
 		"network-security-group:NSGRESOURCEGROUP:NETWORKSECURITYGROUP",
 		"virtual-network:NETRESOURCEGROUP:VIRTUALNETWORK",
@@ -149,7 +154,7 @@ func (s *MSIResolverSuite) TestResolveVirtualMachine() {
 		InterfacePropertiesFormat: &network.InterfacePropertiesFormat{},
 	}
 	s.setNetworkInterface(ni)
-	s.assertResolveSuccess(vmSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors)
 
 	// network interface with malformed security group
 	ni.NetworkSecurityGroup = &network.SecurityGroup{ID: &malformedResourceID}
@@ -159,20 +164,20 @@ func (s *MSIResolverSuite) TestResolveVirtualMachine() {
 
 	// network interface with no ip configuration
 	ni.IPConfigurations = &[]network.InterfaceIPConfiguration{}
-	s.assertResolveSuccess(vmSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors)
 
 	// network interface with empty ip configuration
 	ni.IPConfigurations = &[]network.InterfaceIPConfiguration{{}}
-	s.assertResolveSuccess(vmSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors)
 
 	// network interface with empty ip configuration properties
 	props := new(network.InterfaceIPConfigurationPropertiesFormat)
 	ni.IPConfigurations = &[]network.InterfaceIPConfiguration{{InterfaceIPConfigurationPropertiesFormat: props}}
-	s.assertResolveSuccess(vmSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors)
 
 	// network interface with subnet with no ID
 	props.Subnet = &network.Subnet{}
-	s.assertResolveSuccess(vmSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors)
 
 	// network interface with subnet with malformed ID
 	props.Subnet.ID = &malformedResourceID
@@ -182,7 +187,37 @@ func (s *MSIResolverSuite) TestResolveVirtualMachine() {
 	// network interface with good subnet and security group
 	ni.NetworkSecurityGroup = &network.SecurityGroup{ID: &nsgResourceID}
 	props.Subnet.ID = &subnetResourceID
-	s.assertResolveSuccess(vmSelectors, niSelectors)
+	s.assertResolveSuccess(vmSelectors, niNameSelectors, niSelectors)
+}
+
+func (s *MSIResolverSuite) TestResolveVirtualMachineTagsAndScaleSet() {
+	tagValue := "VALUE"
+	vm := &compute.VirtualMachine{
+		Tags: map[string]*string{
+			"TAG":   &tagValue,
+			"EMPTY": nil,
+		},
+		VirtualMachineProperties: &compute.VirtualMachineProperties{},
+	}
+	s.setVirtualMachine(vm)
+	tagSelectors := []string{
+		"tag:EMPTY:",
+		"tag:TAG:VALUE",
+	}
+	s.assertResolveSuccess(vmSelectors, tagSelectors)
+
+	// scale set with malformed ID
+	vm.VirtualMachineScaleSet = &compute.SubResource{ID: &malformedResourceID}
+	s.assertResolveFailure(azureAgentID,
+		`azure-msi: malformed virtual machine scale set ID "MALFORMEDRESOURCEID"`)
+
+	// virtual machine in a scale set
+	vm.VirtualMachineScaleSet = &compute.SubResource{ID: &vmssResourceID}
+	s.assertResolveSuccess(vmSelectors, tagSelectors, []string{"vmss-name:VMSSRESOURCEGROUP:SCALESET"})
+
+	// no virtual machine properties
+	vm.VirtualMachineProperties = nil
+	s.assertResolveSuccess(vmSelectors, tagSelectors)
 }
 
 func (s *MSIResolverSuite) TestConfigure() {
@@ -23,6 +23,9 @@ const (
 	Type = "NodeResolver"
 )
 
+// Version is the version of the NodeResolver definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // NodeResolver is the client interface for the service type NodeResolver interface.
 type NodeResolver interface {
 	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
@@ -35,6 +38,11 @@ type Plugin interface {
 	Resolve(context.Context, *ResolveRequest) (*ResolveResponse, error)
 }
 
+// Serve serves the NodeResolver plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server NodeResolverServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the NodeResolver plugin.
 func PluginServer(server NodeResolverServer) catalog.PluginServer {
 	return &pluginServer{
@@ -50,6 +58,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -68,6 +80,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(noderesolver.NewNodeResolverClient(conn))
 }
@@ -3,6 +3,7 @@ package k8sbundle
 import (
 	"bytes"
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"encoding/pem"
 	"errors"
@@ -18,10 +19,14 @@ import (
 	"github.com/spiffe/spire/proto/spire/common"
 	spi "github.com/spiffe/spire/proto/spire/common/plugin"
 	"github.com/zeebo/errs"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -35,8 +40,18 @@ const (
 	defaultNamespace    = "spire"
 	defaultConfigMap    = "spire-bundle"
 	defaultConfigMapKey = "bundle.crt"
+
+	mutatingWebhookKind   = "mutating webhook configuration"
+	validatingWebhookKind = "validating webhook configuration"
+	apiServiceKind        = "API service"
 )
 
+var apiServiceResource = schema.GroupVersionResource{
+	Group:    "apiregistration.k8s.io",
+	Version:  "v1",
+	Resource: "apiservices",
+}
+
 func BuiltIn() catalog.Plugin {
 	return builtIn(New())
 }
@@ -52,6 +67,8 @@ type pluginConfig struct {
 	ConfigMap          string `hcl:"config_map"`
 	ConfigMapKey       string `hcl:"config_map_key"`
 	KubeConfigFilePath string `hcl:"kube_config_file_path"`
+	WebhookLabel       string `hcl:"webhook_label"`
+	APIServiceLabel    string `hcl:"api_service_label"`
 }
 
 type Plugin struct {
@@ -96,7 +113,7 @@ func (p *Plugin) Notify(ctx context.Context, req *notifier.NotifyRequest) (*noti
 
 	if _, ok := req.Event.(*notifier.NotifyRequest_BundleUpdated); ok {
 		// ignore the bundle presented in the request. see updateBundleConfigMap for details on why.
-		if err := p.updateBundleConfigMap(ctx, config); err != nil {
+		if err := p.updateBundle(ctx, config); err != nil {
 			return nil, err
 		}
 	}
@@ -111,7 +128,7 @@ func (p *Plugin) NotifyAndAdvise(ctx context.Context, req *notifier.NotifyAndAdv
 
 	if _, ok := req.Event.(*notifier.NotifyAndAdviseRequest_BundleLoaded); ok {
 		// ignore the bundle presented in the request. see updateBundleConfigMap for details on why.
-		if err := p.updateBundleConfigMap(ctx, config); err != nil {
+		if err := p.updateBundle(ctx, config); err != nil {
 			return nil, err
 		}
 	}
@@ -161,12 +178,24 @@ func (p *Plugin) setConfig(config *pluginConfig) {
 	p.config = config
 }
 
-func (p *Plugin) updateBundleConfigMap(ctx context.Context, c *pluginConfig) (err error) {
+func (p *Plugin) updateBundle(ctx context.Context, c *pluginConfig) error {
 	client, err := p.hooks.newKubeClient(c.KubeConfigFilePath)
 	if err != nil {
 		return err
 	}
 
+	if err := p.updateBundleConfigMap(ctx, c, client); err != nil {
+		return err
+	}
+	if c.WebhookLabel != "" || c.APIServiceLabel != "" {
+		if err := p.updateCABundles(ctx, c, client); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *Plugin) updateBundleConfigMap(ctx context.Context, c *pluginConfig, client kubeClient) error {
 	for {
 		// Get the config map so we can use the version to resolve conflicts racing
 		// on updates from other servers.
@@ -202,7 +231,7 @@ func (p *Plugin) updateBundleConfigMap(ctx context.Context, c *pluginConfig) (er
 		if err := client.PatchConfigMap(ctx, c.Namespace, c.ConfigMap, patchBytes); err != nil {
 			// If there is a conflict then some other server won the race updating
 			// the ConfigMap. We need to retrieve the latest bundle and try again.
-			if s, ok := err.(k8serrors.APIStatus); ok && s.Status().Code == http.StatusConflict {
+			if isConflict(err) {
 				p.log.Debug("Conflict detected patching configmap; will retry", telemetry.VersionInfo, configMap.ResourceVersion)
 				continue
 			}
@@ -213,6 +242,206 @@ func (p *Plugin) updateBundleConfigMap(ctx context.Context, c *pluginConfig) (er
 	}
 }
 
+// updateCABundles sets the caBundle fields of the webhook configurations and
+// API services selected by the configured labels to the current bundle. Like
+// updateBundleConfigMap, the bundle is fetched after the objects so that
+// version conflicts with other servers can be detected and corrected.
+func (p *Plugin) updateCABundles(ctx context.Context, c *pluginConfig, client kubeClient) error {
+	for {
+		objects, err := listCABundleObjects(ctx, c, client)
+		if err != nil {
+			return err
+		}
+
+		resp, err := p.identityProvider.FetchX509Identity(ctx, &hostservices.FetchX509IdentityRequest{})
+		if err != nil {
+			return err
+		}
+		caBundle := []byte(bundleData(resp.Bundle))
+
+		conflict := false
+		for _, object := range objects {
+			if object.isUpToDate(caBundle) {
+				continue
+			}
+			patchBytes, err := object.patch(caBundle)
+			if err != nil {
+				return k8sErr.New("unable to marshal patch: %v", err)
+			}
+			if err := object.apply(ctx, patchBytes); err != nil {
+				if isConflict(err) {
+					p.log.Debug("Conflict detected patching caBundle; will retry", "kind", object.kind, "name", object.name, telemetry.VersionInfo, object.resourceVersion)
+					conflict = true
+					break
+				}
+				return k8sErr.New("unable to update %s %s: %v", object.kind, object.name, err)
+			}
+		}
+		if !conflict {
+			return nil
+		}
+	}
+}
+
+// caBundleObject is a Kubernetes object with one or more caBundle fields that
+// are kept in sync with the trust bundle.
+type caBundleObject struct {
+	kind            string
+	name            string
+	resourceVersion string
+
+	// webhooks are the names of the webhooks in a webhook configuration. It
+	// is empty for API services.
+	webhooks []string
+
+	// caBundles are the current values of the caBundle fields
+	caBundles [][]byte
+
+	apply func(ctx context.Context, patchBytes []byte) error
+}
+
+func (o caBundleObject) isUpToDate(caBundle []byte) bool {
+	for _, current := range o.caBundles {
+		if !bytes.Equal(current, caBundle) {
+			return false
+		}
+	}
+	return true
+}
+
+func (o caBundleObject) patch(caBundle []byte) ([]byte, error) {
+	metadata := metav1.ObjectMeta{
+		ResourceVersion: o.resourceVersion,
+	}
+
+	if o.kind == apiServiceKind {
+		return json.Marshal(struct {
+			Metadata metav1.ObjectMeta `json:"metadata"`
+			Spec     struct {
+				CABundle []byte `json:"caBundle"`
+			} `json:"spec"`
+		}{
+			Metadata: metadata,
+			Spec: struct {
+				CABundle []byte `json:"caBundle"`
+			}{CABundle: caBundle},
+		})
+	}
+
+	// Webhooks are merged by name so only the client configuration of each
+	// webhook is changed.
+	type webhookPatch struct {
+		Name         string                          `json:"name"`
+		ClientConfig admissionv1.WebhookClientConfig `json:"clientConfig"`
+	}
+	webhooks := make([]webhookPatch, 0, len(o.webhooks))
+	for _, name := range o.webhooks {
+		webhooks = append(webhooks, webhookPatch{
+			Name: name,
+			ClientConfig: admissionv1.WebhookClientConfig{
+				CABundle: caBundle,
+			},
+		})
+	}
+	return json.Marshal(struct {
+		Metadata metav1.ObjectMeta `json:"metadata"`
+		Webhooks []webhookPatch    `json:"webhooks"`
+	}{
+		Metadata: metadata,
+		Webhooks: webhooks,
+	})
+}
+
+func listCABundleObjects(ctx context.Context, c *pluginConfig, client kubeClient) ([]caBundleObject, error) {
+	var objects []caBundleObject
+
+	if c.WebhookLabel != "" {
+		selector := labelSelector(c.WebhookLabel)
+
+		mutating, err := client.ListMutatingWebhookConfigurations(ctx, selector)
+		if err != nil {
+			return nil, k8sErr.New("unable to list mutating webhook configurations: %v", err)
+		}
+		for _, config := range mutating {
+			config := config
+			object := caBundleObject{
+				kind:            mutatingWebhookKind,
+				name:            config.Name,
+				resourceVersion: config.ResourceVersion,
+				apply: func(ctx context.Context, patchBytes []byte) error {
+					return client.PatchMutatingWebhookConfiguration(ctx, config.Name, patchBytes)
+				},
+			}
+			for _, webhook := range config.Webhooks {
+				object.webhooks = append(object.webhooks, webhook.Name)
+				object.caBundles = append(object.caBundles, webhook.ClientConfig.CABundle)
+			}
+			objects = append(objects, object)
+		}
+
+		validating, err := client.ListValidatingWebhookConfigurations(ctx, selector)
+		if err != nil {
+			return nil, k8sErr.New("unable to list validating webhook configurations: %v", err)
+		}
+		for _, config := range validating {
+			config := config
+			object := caBundleObject{
+				kind:            validatingWebhookKind,
+				name:            config.Name,
+				resourceVersion: config.ResourceVersion,
+				apply: func(ctx context.Context, patchBytes []byte) error {
+					return client.PatchValidatingWebhookConfiguration(ctx, config.Name, patchBytes)
+				},
+			}
+			for _, webhook := range config.Webhooks {
+				object.webhooks = append(object.webhooks, webhook.Name)
+				object.caBundles = append(object.caBundles, webhook.ClientConfig.CABundle)
+			}
+			objects = append(objects, object)
+		}
+	}
+
+	if c.APIServiceLabel != "" {
+		apiServices, err := client.ListAPIServices(ctx, labelSelector(c.APIServiceLabel))
+		if err != nil {
+			return nil, k8sErr.New("unable to list API services: %v", err)
+		}
+		for _, apiService := range apiServices {
+			name := apiService.GetName()
+			rawCABundle, _, err := unstructured.NestedString(apiService.Object, "spec", "caBundle")
+			if err != nil {
+				return nil, k8sErr.New("unable to get caBundle of API service %s: %v", name, err)
+			}
+			caBundle, err := base64.StdEncoding.DecodeString(rawCABundle)
+			if err != nil {
+				return nil, k8sErr.New("unable to decode caBundle of API service %s: %v", name, err)
+			}
+			objects = append(objects, caBundleObject{
+				kind:            apiServiceKind,
+				name:            name,
+				resourceVersion: apiService.GetResourceVersion(),
+				caBundles:       [][]byte{caBundle},
+				apply: func(ctx context.Context, patchBytes []byte) error {
+					return client.PatchAPIService(ctx, name, patchBytes)
+				},
+			})
+		}
+	}
+
+	return objects, nil
+}
+
+// labelSelector returns the selector for objects that have opted in to having
+// their caBundle fields managed by the plugin.
+func labelSelector(label string) string {
+	return label + "=true"
+}
+
+func isConflict(err error) bool {
+	s, ok := err.(k8serrors.APIStatus)
+	return ok && s.Status().Code == http.StatusConflict
+}
+
 func newKubeClient(configPath string) (kubeClient, error) {
 	config, err := getKubeConfig(configPath)
 	if err != nil {
@@ -223,7 +452,11 @@ func newKubeClient(configPath string) (kubeClient, error) {
 	if err != nil {
 		return nil, k8sErr.Wrap(err)
 	}
-	return kubeClientset{Clientset: client}, nil
+	dynamicClient, err := dynamic.NewForConfig(config)
+	if err != nil {
+		return nil, k8sErr.Wrap(err)
+	}
+	return kubeClientset{Clientset: client, dynamic: dynamicClient}, nil
 }
 
 func getKubeConfig(configPath string) (*rest.Config, error) {
@@ -236,10 +469,20 @@ func getKubeConfig(configPath string) (*rest.Config, error) {
 type kubeClient interface {
 	GetConfigMap(ctx context.Context, namespace, configMap string) (*corev1.ConfigMap, error)
 	PatchConfigMap(ctx context.Context, namespace string, configMap string, patchBytes []byte) error
+	ListMutatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.MutatingWebhookConfiguration, error)
+	PatchMutatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error
+	ListValidatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.ValidatingWebhookConfiguration, error)
+	PatchValidatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error
+	ListAPIServices(ctx context.Context, selector string) ([]unstructured.Unstructured, error)
+	PatchAPIService(ctx context.Context, name string, patchBytes []byte) error
 }
 
 type kubeClientset struct {
 	*kubernetes.Clientset
+
+	// dynamic is used for API services since the aggregator clientset is
+	// not a dependency
+	dynamic dynamic.Interface
 }
 
 func (c kubeClientset) GetConfigMap(ctx context.Context, namespace, configMap string) (*corev1.ConfigMap, error) {
@@ -251,6 +494,47 @@ func (c kubeClientset) PatchConfigMap(ctx context.Context, namespace, configMap
 	return err
 }
 
+func (c kubeClientset) ListMutatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.MutatingWebhookConfiguration, error) {
+	list, err := c.AdmissionregistrationV1().MutatingWebhookConfigurations().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (c kubeClientset) PatchMutatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error {
+	_, err := c.AdmissionregistrationV1().MutatingWebhookConfigurations().Patch(ctx, name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (c kubeClientset) ListValidatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.ValidatingWebhookConfiguration, error) {
+	list, err := c.AdmissionregistrationV1().ValidatingWebhookConfigurations().List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (c kubeClientset) PatchValidatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error {
+	_, err := c.AdmissionregistrationV1().ValidatingWebhookConfigurations().Patch(ctx, name, types.StrategicMergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
+func (c kubeClientset) ListAPIServices(ctx context.Context, selector string) ([]unstructured.Unstructured, error) {
+	list, err := c.dynamic.Resource(apiServiceResource).List(ctx, metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (c kubeClientset) PatchAPIService(ctx context.Context, name string, patchBytes []byte) error {
+	// Strategic merge patches are not supported for custom resources served
+	// through the dynamic client, so a JSON merge patch is used.
+	_, err := c.dynamic.Resource(apiServiceResource).Patch(ctx, name, types.MergePatchType, patchBytes, metav1.PatchOptions{})
+	return err
+}
+
 // bundleData formats the bundle data for inclusion in the config map
 func bundleData(bundle *common.Bundle) string {
 	bundleData := new(bytes.Buffer)
@@ -2,6 +2,7 @@ package k8sbundle
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -20,9 +21,12 @@ import (
 	"github.com/spiffe/spire/test/fakes/fakeidentityprovider"
 	"github.com/spiffe/spire/test/spiretest"
 	"google.golang.org/grpc/codes"
+	admissionv1 "k8s.io/api/admissionregistration/v1"
 	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 var (
@@ -406,6 +410,115 @@ kube_config_file_path = "/some/file/path"
 	}, s.k.getConfigMap("NAMESPACE", "CONFIGMAP"))
 }
 
+func (s *Suite) TestBundleUpdatedPatchesCABundles() {
+	s.k.setConfigMap(newConfigMap())
+	s.k.setMutatingWebhook(newMutatingWebhook("mutating", "WEBHOOK"))
+	s.k.setMutatingWebhook(newMutatingWebhook("mutating-unlabeled", ""))
+	s.k.setValidatingWebhook(newValidatingWebhook("validating", "WEBHOOK"))
+	s.k.setAPIService(newAPIService("v1.example.org", "APISERVICE"))
+	s.k.setAPIService(newAPIService("v1.unlabeled.example.org", ""))
+	s.r.AppendBundle(testBundle)
+	s.r.AppendBundle(testBundle)
+
+	s.configure(`
+webhook_label = "WEBHOOK"
+api_service_label = "APISERVICE"
+`)
+
+	resp, err := s.p.Notify(context.Background(), &notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_BundleUpdated{
+			BundleUpdated: &notifier.BundleUpdated{
+				Bundle: testBundle,
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.RequireProtoEqual(&notifier.NotifyResponse{}, resp)
+
+	s.Equal(testBundleData, s.k.getConfigMap("spire", "spire-bundle").Data["bundle.crt"])
+	s.Equal([]string{testBundleData, testBundleData}, s.k.getMutatingWebhookCABundles("mutating"))
+	s.Equal([]string{"", ""}, s.k.getMutatingWebhookCABundles("mutating-unlabeled"))
+	s.Equal([]string{testBundleData, testBundleData}, s.k.getValidatingWebhookCABundles("validating"))
+	s.Equal(testBundleData, s.k.getAPIServiceCABundle("v1.example.org"))
+	s.Equal("", s.k.getAPIServiceCABundle("v1.unlabeled.example.org"))
+}
+
+func (s *Suite) TestBundleUpdatedSkipsUpToDateCABundles() {
+	s.k.setConfigMap(newConfigMap())
+	webhook := newMutatingWebhook("mutating", "WEBHOOK")
+	for i := range webhook.Webhooks {
+		webhook.Webhooks[i].ClientConfig.CABundle = []byte(testBundleData)
+	}
+	s.k.setMutatingWebhook(webhook)
+	s.k.setCABundlePatchErr(errors.New("should not be patched"))
+	s.r.AppendBundle(testBundle)
+	s.r.AppendBundle(testBundle)
+
+	s.configure(`webhook_label = "WEBHOOK"`)
+
+	_, err := s.p.Notify(context.Background(), &notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_BundleUpdated{
+			BundleUpdated: &notifier.BundleUpdated{
+				Bundle: testBundle,
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.Equal("1", s.k.getMutatingWebhook("mutating").ResourceVersion)
+}
+
+func (s *Suite) TestBundleUpdatedCABundlePatchFailure() {
+	s.k.setConfigMap(newConfigMap())
+	s.k.setValidatingWebhook(newValidatingWebhook("validating", "WEBHOOK"))
+	s.k.setCABundlePatchErr(errors.New("some error"))
+	s.r.AppendBundle(testBundle)
+	s.r.AppendBundle(testBundle)
+
+	s.configure(`webhook_label = "WEBHOOK"`)
+
+	resp, err := s.p.Notify(context.Background(), &notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_BundleUpdated{
+			BundleUpdated: &notifier.BundleUpdated{
+				Bundle: testBundle,
+			},
+		},
+	})
+	s.RequireGRPCStatus(err, codes.Unknown, "k8s-bundle: unable to update validating webhook configuration validating: some error")
+	s.Nil(resp)
+}
+
+func (s *Suite) TestBundleUpdatedCABundleUpdateConflict() {
+	s.k.setConfigMap(newConfigMap())
+	s.k.setAPIService(newAPIService("v1.example.org", "APISERVICE"))
+	s.k.setCABundlePatchErr(&k8serrors.StatusError{
+		ErrStatus: metav1.Status{
+			Code:    http.StatusConflict,
+			Message: "unexpected version",
+		},
+	})
+
+	// the config map update consumes the first bundle. return a different
+	// bundle when fetched the third time.
+	s.r.AppendBundle(testBundle)
+	s.r.AppendBundle(testBundle)
+	s.r.AppendBundle(testBundle2)
+
+	s.configure(`api_service_label = "APISERVICE"`)
+
+	resp, err := s.p.Notify(context.Background(), &notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_BundleUpdated{
+			BundleUpdated: &notifier.BundleUpdated{
+				Bundle: testBundle,
+			},
+		},
+	})
+	s.Require().NoError(err)
+	s.RequireProtoEqual(&notifier.NotifyResponse{}, resp)
+
+	// make sure the API service contains the third bundle data
+	s.Equal(testBundle2Data, s.k.getAPIServiceCABundle("v1.example.org"))
+}
+
 func (s *Suite) TestConfigureWithMalformedConfiguration() {
 	_, err := s.p.Configure(context.Background(), &spi.ConfigureRequest{
 		Configuration: "blah",
@@ -446,14 +559,21 @@ func (s *Suite) configure(configuration string) {
 }
 
 type fakeKubeClient struct {
-	mu         sync.RWMutex
-	configMaps map[string]*corev1.ConfigMap
-	patchErr   error
+	mu                 sync.RWMutex
+	configMaps         map[string]*corev1.ConfigMap
+	mutatingWebhooks   map[string]*admissionv1.MutatingWebhookConfiguration
+	validatingWebhooks map[string]*admissionv1.ValidatingWebhookConfiguration
+	apiServices        map[string]*unstructured.Unstructured
+	patchErr           error
+	caBundlePatchErr   error
 }
 
 func newFakeKubeClient(configMaps ...*corev1.ConfigMap) *fakeKubeClient {
 	c := &fakeKubeClient{
-		configMaps: make(map[string]*corev1.ConfigMap),
+		configMaps:         make(map[string]*corev1.ConfigMap),
+		mutatingWebhooks:   make(map[string]*admissionv1.MutatingWebhookConfiguration),
+		validatingWebhooks: make(map[string]*admissionv1.ValidatingWebhookConfiguration),
+		apiServices:        make(map[string]*unstructured.Unstructured),
 	}
 	for _, configMap := range configMaps {
 		c.setConfigMap(configMap)
@@ -503,6 +623,151 @@ func (c *fakeKubeClient) PatchConfigMap(ctx context.Context, namespace, configMa
 	return nil
 }
 
+func (c *fakeKubeClient) ListMutatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.MutatingWebhookConfiguration, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	var items []admissionv1.MutatingWebhookConfiguration
+	for _, config := range c.mutatingWebhooks {
+		if s.Matches(labels.Set(config.Labels)) {
+			items = append(items, *config.DeepCopy())
+		}
+	}
+	return items, nil
+}
+
+func (c *fakeKubeClient) PatchMutatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.mutatingWebhooks[name]
+	if !ok {
+		return errors.New("not found")
+	}
+	if err := c.takeCABundlePatchErr(); err != nil {
+		return err
+	}
+
+	patched := new(admissionv1.MutatingWebhookConfiguration)
+	if err := json.Unmarshal(patchBytes, patched); err != nil {
+		return err
+	}
+	if err := bumpResourceVersion(&entry.ObjectMeta, patched.ResourceVersion); err != nil {
+		return err
+	}
+	for _, patchedWebhook := range patched.Webhooks {
+		for i := range entry.Webhooks {
+			if entry.Webhooks[i].Name == patchedWebhook.Name {
+				entry.Webhooks[i].ClientConfig.CABundle = patchedWebhook.ClientConfig.CABundle
+			}
+		}
+	}
+	return nil
+}
+
+func (c *fakeKubeClient) ListValidatingWebhookConfigurations(ctx context.Context, selector string) ([]admissionv1.ValidatingWebhookConfiguration, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	var items []admissionv1.ValidatingWebhookConfiguration
+	for _, config := range c.validatingWebhooks {
+		if s.Matches(labels.Set(config.Labels)) {
+			items = append(items, *config.DeepCopy())
+		}
+	}
+	return items, nil
+}
+
+func (c *fakeKubeClient) PatchValidatingWebhookConfiguration(ctx context.Context, name string, patchBytes []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.validatingWebhooks[name]
+	if !ok {
+		return errors.New("not found")
+	}
+	if err := c.takeCABundlePatchErr(); err != nil {
+		return err
+	}
+
+	patched := new(admissionv1.ValidatingWebhookConfiguration)
+	if err := json.Unmarshal(patchBytes, patched); err != nil {
+		return err
+	}
+	if err := bumpResourceVersion(&entry.ObjectMeta, patched.ResourceVersion); err != nil {
+		return err
+	}
+	for _, patchedWebhook := range patched.Webhooks {
+		for i := range entry.Webhooks {
+			if entry.Webhooks[i].Name == patchedWebhook.Name {
+				entry.Webhooks[i].ClientConfig.CABundle = patchedWebhook.ClientConfig.CABundle
+			}
+		}
+	}
+	return nil
+}
+
+func (c *fakeKubeClient) ListAPIServices(ctx context.Context, selector string) ([]unstructured.Unstructured, error) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	s, err := labels.Parse(selector)
+	if err != nil {
+		return nil, err
+	}
+	var items []unstructured.Unstructured
+	for _, apiService := range c.apiServices {
+		if s.Matches(labels.Set(apiService.GetLabels())) {
+			items = append(items, *apiService.DeepCopy())
+		}
+	}
+	return items, nil
+}
+
+func (c *fakeKubeClient) PatchAPIService(ctx context.Context, name string, patchBytes []byte) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.apiServices[name]
+	if !ok {
+		return errors.New("not found")
+	}
+	if err := c.takeCABundlePatchErr(); err != nil {
+		return err
+	}
+
+	patched := new(unstructured.Unstructured)
+	if err := json.Unmarshal(patchBytes, &patched.Object); err != nil {
+		return err
+	}
+	resourceVersion, err := strconv.Atoi(patched.GetResourceVersion())
+	if err != nil {
+		return errors.New("patch does not have resource version")
+	}
+	entry.SetResourceVersion(fmt.Sprint(resourceVersion + 1))
+	caBundle, _, err := unstructured.NestedString(patched.Object, "spec", "caBundle")
+	if err != nil {
+		return err
+	}
+	return unstructured.SetNestedField(entry.Object, caBundle, "spec", "caBundle")
+}
+
+// takeCABundlePatchErr returns the configured caBundle patch error, if any,
+// and clears it. The caller must hold the lock.
+func (c *fakeKubeClient) takeCABundlePatchErr() error {
+	err := c.caBundlePatchErr
+	c.caBundlePatchErr = nil
+	return err
+}
+
 func (c *fakeKubeClient) getConfigMap(namespace, configMap string) *corev1.ConfigMap {
 	c.mu.RLock()
 	defer c.mu.RUnlock()
@@ -521,6 +786,71 @@ func (c *fakeKubeClient) setPatchErr(err error) {
 	c.patchErr = err
 }
 
+func (c *fakeKubeClient) setCABundlePatchErr(err error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.caBundlePatchErr = err
+}
+
+func (c *fakeKubeClient) getMutatingWebhook(name string) *admissionv1.MutatingWebhookConfiguration {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.mutatingWebhooks[name]
+}
+
+func (c *fakeKubeClient) getMutatingWebhookCABundles(name string) []string {
+	var caBundles []string
+	for _, webhook := range c.getMutatingWebhook(name).Webhooks {
+		caBundles = append(caBundles, string(webhook.ClientConfig.CABundle))
+	}
+	return caBundles
+}
+
+func (c *fakeKubeClient) setMutatingWebhook(config *admissionv1.MutatingWebhookConfiguration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.mutatingWebhooks[config.Name] = config
+}
+
+func (c *fakeKubeClient) getValidatingWebhookCABundles(name string) []string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	var caBundles []string
+	for _, webhook := range c.validatingWebhooks[name].Webhooks {
+		caBundles = append(caBundles, string(webhook.ClientConfig.CABundle))
+	}
+	return caBundles
+}
+
+func (c *fakeKubeClient) setValidatingWebhook(config *admissionv1.ValidatingWebhookConfiguration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.validatingWebhooks[config.Name] = config
+}
+
+func (c *fakeKubeClient) getAPIServiceCABundle(name string) string {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	rawCABundle, _, _ := unstructured.NestedString(c.apiServices[name].Object, "spec", "caBundle")
+	caBundle, _ := base64.StdEncoding.DecodeString(rawCABundle)
+	return string(caBundle)
+}
+
+func (c *fakeKubeClient) setAPIService(apiService *unstructured.Unstructured) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.apiServices[apiService.GetName()] = apiService
+}
+
+func bumpResourceVersion(meta *metav1.ObjectMeta, patchedVersion string) error {
+	resourceVersion, err := strconv.Atoi(patchedVersion)
+	if err != nil {
+		return errors.New("patch does not have resource version")
+	}
+	meta.ResourceVersion = fmt.Sprint(resourceVersion + 1)
+	return nil
+}
+
 func configMapKey(namespace, configMap string) string {
 	return fmt.Sprintf("%s|%s", namespace, configMap)
 }
@@ -534,3 +864,50 @@ func newConfigMap() *corev1.ConfigMap {
 		},
 	}
 }
+
+func newObjectMeta(name, label string) metav1.ObjectMeta {
+	meta := metav1.ObjectMeta{
+		Name:            name,
+		ResourceVersion: "1",
+	}
+	if label != "" {
+		meta.Labels = map[string]string{label: "true"}
+	}
+	return meta
+}
+
+func newMutatingWebhook(name, label string) *admissionv1.MutatingWebhookConfiguration {
+	return &admissionv1.MutatingWebhookConfiguration{
+		ObjectMeta: newObjectMeta(name, label),
+		Webhooks: []admissionv1.MutatingWebhook{
+			{Name: "one.example.org"},
+			{Name: "two.example.org"},
+		},
+	}
+}
+
+func newValidatingWebhook(name, label string) *admissionv1.ValidatingWebhookConfiguration {
+	return &admissionv1.ValidatingWebhookConfiguration{
+		ObjectMeta: newObjectMeta(name, label),
+		Webhooks: []admissionv1.ValidatingWebhook{
+			{Name: "one.example.org"},
+			{Name: "two.example.org"},
+		},
+	}
+}
+
+func newAPIService(name, label string) *unstructured.Unstructured {
+	apiService := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apiregistration.k8s.io/v1",
+			"kind":       "APIService",
+			"spec":       map[string]interface{}{},
+		},
+	}
+	apiService.SetName(name)
+	apiService.SetResourceVersion("1")
+	if label != "" {
+		apiService.SetLabels(map[string]string{label: "true"})
+	}
+	return apiService
+}
@@ -21,14 +21,24 @@ type NotifyAndAdviseRequest_BundleLoaded = notifier.NotifyAndAdviseRequest_Bundl
 type NotifyAndAdviseResponse = notifier.NotifyAndAdviseResponse                         //nolint: golint
 type NotifyRequest = notifier.NotifyRequest                                             //nolint: golint
 type NotifyRequest_BundleUpdated = notifier.NotifyRequest_BundleUpdated                 //nolint: golint
+type NotifyRequest_X509CaActivated = notifier.NotifyRequest_X509CaActivated             //nolint: golint
+type NotifyRequest_X509CaDeactivated = notifier.NotifyRequest_X509CaDeactivated         //nolint: golint
+type NotifyRequest_X509CaPrepared = notifier.NotifyRequest_X509CaPrepared               //nolint: golint
 type NotifyResponse = notifier.NotifyResponse                                           //nolint: golint
 type UnimplementedNotifierServer = notifier.UnimplementedNotifierServer                 //nolint: golint
 type UnsafeNotifierServer = notifier.UnsafeNotifierServer                               //nolint: golint
+type X509CA = notifier.X509CA                                                           //nolint: golint
+type X509CAActivated = notifier.X509CAActivated                                         //nolint: golint
+type X509CADeactivated = notifier.X509CADeactivated                                     //nolint: golint
+type X509CAPrepared = notifier.X509CAPrepared                                           //nolint: golint
 
 const (
 	Type = "Notifier"
 )
 
+// Version is the version of the Notifier definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // Notifier is the client interface for the service type Notifier interface.
 type Notifier interface {
 	Notify(context.Context, *NotifyRequest) (*NotifyResponse, error)
@@ -43,6 +53,11 @@ type Plugin interface {
 	NotifyAndAdvise(context.Context, *NotifyAndAdviseRequest) (*NotifyAndAdviseResponse, error)
 }
 
+// Serve serves the Notifier plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server NotifierServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the Notifier plugin.
 func PluginServer(server NotifierServer) catalog.PluginServer {
 	return &pluginServer{
@@ -58,6 +73,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -76,6 +95,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(notifier.NewNotifierClient(conn))
 }
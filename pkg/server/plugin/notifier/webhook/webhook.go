@@ -0,0 +1,323 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sync"
+	"time"
+
+	"github.com/andres-erbsen/clock"
+	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/hcl"
+	"github.com/spiffe/spire/pkg/common/bundleutil"
+	"github.com/spiffe/spire/pkg/common/catalog"
+	"github.com/spiffe/spire/pkg/common/pemutil"
+	"github.com/spiffe/spire/pkg/server/plugin/notifier"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/zeebo/errs"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+
+	// SignatureHeader holds the hex encoded HMAC-SHA256 of the request body,
+	// keyed with the configured secret and prefixed with "sha256=".
+	SignatureHeader = "X-Spire-Signature"
+
+	// EventHeader holds the type of the event in the request body.
+	EventHeader = "X-Spire-Event"
+
+	EventBundleUpdated     = "bundle_updated"
+	EventX509CAPrepared    = "x509_ca_prepared"
+	EventX509CAActivated   = "x509_ca_activated"
+	EventX509CADeactivated = "x509_ca_deactivated"
+)
+
+func BuiltIn() catalog.Plugin {
+	return builtIn(New())
+}
+
+func builtIn(p *Plugin) catalog.Plugin {
+	return catalog.MakePlugin("webhook",
+		notifier.PluginServer(p),
+	)
+}
+
+// Event is the JSON body POSTed to the configured endpoints.
+type Event struct {
+	// Type is the type of event (e.g. "bundle_updated").
+	Type string `json:"type"`
+
+	// Timestamp is when the event was sent (seconds since Unix epoch).
+	Timestamp int64 `json:"timestamp"`
+
+	// Bundle is the SPIFFE bundle document for the trust domain. Only set for
+	// bundle updated events.
+	Bundle json.RawMessage `json:"bundle,omitempty"`
+
+	// X509CA describes the X509 CA. Only set for X509 CA events.
+	X509CA *X509CA `json:"x509_ca,omitempty"`
+}
+
+type X509CA struct {
+	SlotID      string `json:"slot_id"`
+	Certificate string `json:"certificate"`
+	IssuedAt    int64  `json:"issued_at"`
+	ExpiresAt   int64  `json:"expires_at"`
+
+	// UpstreamChain holds the PEM encoded certificates that chain the CA back
+	// to the upstream trust bundle. Empty if the CA is self-signed.
+	UpstreamChain string `json:"upstream_chain,omitempty"`
+}
+
+type pluginConfig struct {
+	URLs         []string `hcl:"urls"`
+	Secret       string   `hcl:"secret"`
+	CABundlePath string   `hcl:"ca_bundle_path"`
+	Timeout      string   `hcl:"timeout"`
+
+	client *http.Client
+}
+
+type Plugin struct {
+	notifier.UnsafeNotifierServer
+
+	mu     sync.RWMutex
+	log    hclog.Logger
+	config *pluginConfig
+	clock  clock.Clock
+}
+
+func New() *Plugin {
+	return &Plugin{
+		clock: clock.New(),
+	}
+}
+
+func (p *Plugin) SetLogger(log hclog.Logger) {
+	p.log = log
+}
+
+func (p *Plugin) Notify(ctx context.Context, req *notifier.NotifyRequest) (*notifier.NotifyResponse, error) {
+	config, err := p.getConfig()
+	if err != nil {
+		return nil, err
+	}
+
+	var event *Event
+	switch e := req.Event.(type) {
+	case *notifier.NotifyRequest_BundleUpdated:
+		bundle, err := bundleutil.BundleFromProto(e.BundleUpdated.Bundle)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid bundle: %v", err)
+		}
+		bundleDoc, err := bundleutil.Marshal(bundle)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "unable to marshal bundle: %v", err)
+		}
+		event = &Event{
+			Type:   EventBundleUpdated,
+			Bundle: bundleDoc,
+		}
+	case *notifier.NotifyRequest_X509CaPrepared:
+		event = &Event{
+			Type:   EventX509CAPrepared,
+			X509CA: x509CAFromProto(e.X509CaPrepared.X509Ca),
+		}
+	case *notifier.NotifyRequest_X509CaActivated:
+		event = &Event{
+			Type:   EventX509CAActivated,
+			X509CA: x509CAFromProto(e.X509CaActivated.X509Ca),
+		}
+	case *notifier.NotifyRequest_X509CaDeactivated:
+		event = &Event{
+			Type:   EventX509CADeactivated,
+			X509CA: x509CAFromProto(e.X509CaDeactivated.X509Ca),
+		}
+	default:
+		return &notifier.NotifyResponse{}, nil
+	}
+	event.Timestamp = p.clock.Now().Unix()
+
+	if err := p.sendEvent(ctx, config, event); err != nil {
+		return nil, err
+	}
+	return &notifier.NotifyResponse{}, nil
+}
+
+func (p *Plugin) NotifyAndAdvise(ctx context.Context, req *notifier.NotifyAndAdviseRequest) (*notifier.NotifyAndAdviseResponse, error) {
+	if _, err := p.getConfig(); err != nil {
+		return nil, err
+	}
+
+	// Failing to deliver an event should not prevent the server from
+	// starting, so the advisory events are not sent.
+	return &notifier.NotifyAndAdviseResponse{}, nil
+}
+
+func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi.ConfigureResponse, error) {
+	config := new(pluginConfig)
+	if err := hcl.Decode(config, req.Configuration); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "unable to decode configuration: %v", err)
+	}
+
+	if len(config.URLs) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "urls must be set")
+	}
+	for _, rawURL := range config.URLs {
+		u, err := url.Parse(rawURL)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid URL %q: %v", rawURL, err)
+		}
+		if u.Scheme != "https" {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid URL %q: scheme must be https", rawURL)
+		}
+	}
+	if config.Secret == "" {
+		return nil, status.Error(codes.InvalidArgument, "secret must be set")
+	}
+
+	timeout := defaultTimeout
+	if config.Timeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(config.Timeout)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid timeout: %v", err)
+		}
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion: tls.VersionTLS12,
+	}
+	if config.CABundlePath != "" {
+		caCerts, err := pemutil.LoadCertificates(config.CABundlePath)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "unable to load CA bundle: %v", err)
+		}
+		tlsConfig.RootCAs = x509.NewCertPool()
+		for _, caCert := range caCerts {
+			tlsConfig.RootCAs.AddCert(caCert)
+		}
+	}
+	config.client = &http.Client{
+		Timeout: timeout,
+		Transport: &http.Transport{
+			Proxy:           http.ProxyFromEnvironment,
+			TLSClientConfig: tlsConfig,
+		},
+	}
+
+	p.setConfig(config)
+	return &spi.ConfigureResponse{}, nil
+}
+
+func (p *Plugin) GetPluginInfo(ctx context.Context, req *spi.GetPluginInfoRequest) (*spi.GetPluginInfoResponse, error) {
+	return &spi.GetPluginInfoResponse{}, nil
+}
+
+func (p *Plugin) getConfig() (*pluginConfig, error) {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	if p.config == nil {
+		return nil, status.Error(codes.FailedPrecondition, "not configured")
+	}
+	return p.config, nil
+}
+
+func (p *Plugin) setConfig(config *pluginConfig) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.config = config
+}
+
+// sendEvent POSTs the event to every configured URL. Delivery to each URL is
+// attempted even if delivery to another fails.
+func (p *Plugin) sendEvent(ctx context.Context, c *pluginConfig, event *Event) error {
+	body, err := json.Marshal(event)
+	if err != nil {
+		return status.Errorf(codes.Internal, "unable to marshal event: %v", err)
+	}
+	signature := Sign(c.Secret, body)
+
+	var group errs.Group
+	for _, u := range c.URLs {
+		if err := postEvent(ctx, c.client, u, event.Type, signature, body); err != nil {
+			group.Add(fmt.Errorf("%s: %v", u, err))
+			continue
+		}
+		p.log.Debug("Event delivered", "url", u, "event", event.Type)
+	}
+	if err := group.Err(); err != nil {
+		return status.Errorf(codes.Unavailable, "unable to deliver %s event: %v", event.Type, err)
+	}
+	return nil
+}
+
+func postEvent(ctx context.Context, client *http.Client, u, eventType, signature string, body []byte) error {
+	req, err := http.NewRequest(http.MethodPost, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(EventHeader, eventType)
+	req.Header.Set(SignatureHeader, signature)
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	// drain the body so the connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
+
+// Sign returns the value of the signature header for the given body.
+func Sign(secret string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	_, _ = mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+func x509CAFromProto(x509CA *notifier.X509CA) *X509CA {
+	if x509CA == nil {
+		return nil
+	}
+	var upstreamChain []byte
+	for _, der := range x509CA.UpstreamChain {
+		upstreamChain = append(upstreamChain, certificatePEM(der)...)
+	}
+	return &X509CA{
+		SlotID:        x509CA.SlotId,
+		Certificate:   string(certificatePEM(x509CA.Certificate)),
+		IssuedAt:      x509CA.IssuedAt,
+		ExpiresAt:     x509CA.ExpiresAt,
+		UpstreamChain: string(upstreamChain),
+	}
+}
+
+func certificatePEM(der []byte) []byte {
+	return pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: der,
+	})
+}
@@ -0,0 +1,365 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"encoding/pem"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"sync"
+	"testing"
+
+	"github.com/spiffe/spire/pkg/server/plugin/notifier"
+	"github.com/spiffe/spire/proto/spire/common"
+	spi "github.com/spiffe/spire/proto/spire/common/plugin"
+	"github.com/spiffe/spire/test/clock"
+	"github.com/spiffe/spire/test/spiretest"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+)
+
+func TestConfigure(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config string
+		code   codes.Code
+		desc   string
+	}{
+		{
+			name: "malformed",
+			config: `
+				MALFORMED
+			`,
+			code: codes.InvalidArgument,
+			desc: "unable to decode configuration",
+		},
+		{
+			name: "missing urls",
+			config: `
+				secret = "s3cr3t"
+			`,
+			code: codes.InvalidArgument,
+			desc: "urls must be set",
+		},
+		{
+			name: "URL is not https",
+			config: `
+				urls = ["http://example.org/hook"]
+				secret = "s3cr3t"
+			`,
+			code: codes.InvalidArgument,
+			desc: `invalid URL "http://example.org/hook": scheme must be https`,
+		},
+		{
+			name: "missing secret",
+			config: `
+				urls = ["https://example.org/hook"]
+			`,
+			code: codes.InvalidArgument,
+			desc: "secret must be set",
+		},
+		{
+			name: "invalid timeout",
+			config: `
+				urls = ["https://example.org/hook"]
+				secret = "s3cr3t"
+				timeout = "forever"
+			`,
+			code: codes.InvalidArgument,
+			desc: "invalid timeout",
+		},
+		{
+			name: "missing CA bundle",
+			config: `
+				urls = ["https://example.org/hook"]
+				secret = "s3cr3t"
+				ca_bundle_path = "/does/not/exist"
+			`,
+			code: codes.InvalidArgument,
+			desc: "unable to load CA bundle",
+		},
+		{
+			name: "success",
+			config: `
+				urls = ["https://example.org/hook", "https://example.com/hook"]
+				secret = "s3cr3t"
+				timeout = "5s"
+			`,
+			code: codes.OK,
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			var plugin notifier.Plugin
+			spiretest.LoadPlugin(t, BuiltIn(), &plugin)
+
+			resp, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{Configuration: tt.config})
+			if tt.code != codes.OK {
+				spiretest.RequireGRPCStatusContains(t, err, tt.code, tt.desc)
+				return
+			}
+			require.NoError(t, err)
+			require.NotNil(t, resp)
+		})
+	}
+}
+
+func TestNotifyFailsIfNotConfigured(t *testing.T) {
+	var plugin notifier.Plugin
+	spiretest.LoadPlugin(t, BuiltIn(), &plugin)
+
+	_, err := plugin.Notify(context.Background(), &notifier.NotifyRequest{})
+	spiretest.RequireGRPCStatus(t, err, codes.FailedPrecondition, "not configured")
+}
+
+func TestNotify(t *testing.T) {
+	certDER := []byte("CERT")
+	certPEM := "-----BEGIN CERTIFICATE-----\nQ0VSVA==\n-----END CERTIFICATE-----\n"
+
+	testCases := []struct {
+		name     string
+		req      *notifier.NotifyRequest
+		expected *Event
+	}{
+		{
+			name: "bundle updated",
+			req: &notifier.NotifyRequest{
+				Event: &notifier.NotifyRequest_BundleUpdated{
+					BundleUpdated: &notifier.BundleUpdated{
+						Bundle: &common.Bundle{
+							TrustDomainId: "spiffe://example.org",
+						},
+					},
+				},
+			},
+			expected: &Event{
+				Type:   EventBundleUpdated,
+				Bundle: json.RawMessage(`{"keys":null}`),
+			},
+		},
+		{
+			name: "X509 CA prepared",
+			req: &notifier.NotifyRequest{
+				Event: &notifier.NotifyRequest_X509CaPrepared{
+					X509CaPrepared: &notifier.X509CAPrepared{
+						X509Ca: &notifier.X509CA{
+							SlotId:      "A",
+							Certificate: certDER,
+							IssuedAt:    1,
+							ExpiresAt:   2,
+						},
+					},
+				},
+			},
+			expected: &Event{
+				Type: EventX509CAPrepared,
+				X509CA: &X509CA{
+					SlotID:      "A",
+					Certificate: certPEM,
+					IssuedAt:    1,
+					ExpiresAt:   2,
+				},
+			},
+		},
+		{
+			name: "X509 CA activated",
+			req: &notifier.NotifyRequest{
+				Event: &notifier.NotifyRequest_X509CaActivated{
+					X509CaActivated: &notifier.X509CAActivated{
+						X509Ca: &notifier.X509CA{
+							SlotId:      "B",
+							Certificate: certDER,
+							IssuedAt:    3,
+							ExpiresAt:   4,
+						},
+					},
+				},
+			},
+			expected: &Event{
+				Type: EventX509CAActivated,
+				X509CA: &X509CA{
+					SlotID:      "B",
+					Certificate: certPEM,
+					IssuedAt:    3,
+					ExpiresAt:   4,
+				},
+			},
+		},
+		{
+			name: "X509 CA deactivated",
+			req: &notifier.NotifyRequest{
+				Event: &notifier.NotifyRequest_X509CaDeactivated{
+					X509CaDeactivated: &notifier.X509CADeactivated{
+						X509Ca: &notifier.X509CA{
+							SlotId:        "A",
+							Certificate:   certDER,
+							IssuedAt:      1,
+							ExpiresAt:     2,
+							UpstreamChain: [][]byte{certDER, certDER},
+						},
+					},
+				},
+			},
+			expected: &Event{
+				Type: EventX509CADeactivated,
+				X509CA: &X509CA{
+					SlotID:        "A",
+					Certificate:   certPEM,
+					IssuedAt:      1,
+					ExpiresAt:     2,
+					UpstreamChain: certPEM + certPEM,
+				},
+			},
+		},
+	}
+
+	for _, tt := range testCases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			hook := newFakeHook(t, http.StatusOK)
+			plugin, clk := loadPlugin(t, hook)
+
+			_, err := plugin.Notify(context.Background(), tt.req)
+			require.NoError(t, err)
+
+			events := hook.Events()
+			require.Len(t, events, 1)
+			tt.expected.Timestamp = clk.Now().Unix()
+			assert.Equal(t, tt.expected, events[0])
+		})
+	}
+}
+
+func TestNotifyIgnoresUnknownEvents(t *testing.T) {
+	hook := newFakeHook(t, http.StatusOK)
+	plugin, _ := loadPlugin(t, hook)
+
+	_, err := plugin.Notify(context.Background(), &notifier.NotifyRequest{})
+	require.NoError(t, err)
+	require.Empty(t, hook.Events())
+}
+
+func TestNotifyDeliveryFailure(t *testing.T) {
+	hook := newFakeHook(t, http.StatusInternalServerError)
+	plugin, _ := loadPlugin(t, hook)
+
+	_, err := plugin.Notify(context.Background(), &notifier.NotifyRequest{
+		Event: &notifier.NotifyRequest_X509CaActivated{
+			X509CaActivated: &notifier.X509CAActivated{
+				X509Ca: &notifier.X509CA{SlotId: "A"},
+			},
+		},
+	})
+	spiretest.RequireGRPCStatusContains(t, err, codes.Unavailable, "unable to deliver x509_ca_activated event: "+hook.URL()+": unexpected status code 500")
+}
+
+func TestNotifyAndAdviseDoesNotSendEvents(t *testing.T) {
+	hook := newFakeHook(t, http.StatusOK)
+	plugin, _ := loadPlugin(t, hook)
+
+	_, err := plugin.NotifyAndAdvise(context.Background(), &notifier.NotifyAndAdviseRequest{
+		Event: &notifier.NotifyAndAdviseRequest_BundleLoaded{
+			BundleLoaded: &notifier.BundleLoaded{
+				Bundle: &common.Bundle{
+					TrustDomainId: "spiffe://example.org",
+				},
+			},
+		},
+	})
+	require.NoError(t, err)
+	require.Empty(t, hook.Events())
+}
+
+func TestGetPluginInfo(t *testing.T) {
+	resp, err := New().GetPluginInfo(context.Background(), &spi.GetPluginInfoRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &spi.GetPluginInfoResponse{}, resp)
+}
+
+func loadPlugin(t *testing.T, hook *fakeHook) (notifier.Plugin, *clock.Mock) {
+	clk := clock.NewMock(t)
+
+	raw := New()
+	raw.clock = clk
+
+	var plugin notifier.Plugin
+	spiretest.LoadPlugin(t, builtIn(raw), &plugin)
+
+	_, err := plugin.Configure(context.Background(), &spi.ConfigureRequest{
+		Configuration: `
+			urls = ["` + hook.URL() + `"]
+			secret = "s3cr3t"
+			ca_bundle_path = "` + hook.CABundlePath() + `"
+		`,
+	})
+	require.NoError(t, err)
+	return plugin, clk
+}
+
+type fakeHook struct {
+	t            *testing.T
+	server       *httptest.Server
+	statusCode   int
+	caBundlePath string
+
+	mu     sync.Mutex
+	events []*Event
+}
+
+func newFakeHook(t *testing.T, statusCode int) *fakeHook {
+	h := &fakeHook{
+		t:          t,
+		statusCode: statusCode,
+	}
+	h.server = httptest.NewTLSServer(http.HandlerFunc(h.serveHTTP))
+	t.Cleanup(h.server.Close)
+
+	h.caBundlePath = filepath.Join(spiretest.TempDir(t), "ca.pem")
+	caPEM := pem.EncodeToMemory(&pem.Block{
+		Type:  "CERTIFICATE",
+		Bytes: h.server.Certificate().Raw,
+	})
+	require.NoError(t, ioutil.WriteFile(h.caBundlePath, caPEM, 0600))
+	return h
+}
+
+func (h *fakeHook) URL() string {
+	return h.server.URL + "/hook"
+}
+
+func (h *fakeHook) CABundlePath() string {
+	return h.caBundlePath
+}
+
+func (h *fakeHook) Events() []*Event {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	return h.events
+}
+
+func (h *fakeHook) serveHTTP(w http.ResponseWriter, req *http.Request) {
+	body, err := ioutil.ReadAll(req.Body)
+	if !assert.NoError(h.t, err) {
+		return
+	}
+	assert.Equal(h.t, http.MethodPost, req.Method)
+	assert.Equal(h.t, "application/json", req.Header.Get("Content-Type"))
+	assert.Equal(h.t, Sign("s3cr3t", body), req.Header.Get(SignatureHeader))
+
+	event := new(Event)
+	if !assert.NoError(h.t, json.Unmarshal(body, event)) {
+		return
+	}
+	assert.Equal(h.t, event.Type, req.Header.Get(EventHeader))
+
+	h.mu.Lock()
+	h.events = append(h.events, event)
+	h.mu.Unlock()
+
+	w.WriteHeader(h.statusCode)
+}
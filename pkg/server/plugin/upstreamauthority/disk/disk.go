@@ -1,10 +1,12 @@
 package disk
 
 import (
+	"bytes"
 	"context"
 	"crypto/x509"
 	"errors"
 	"fmt"
+	"os"
 	"sync"
 	"time"
 
@@ -23,6 +25,10 @@ import (
 	spi "github.com/spiffe/spire/proto/spire/common/plugin"
 )
 
+// fileWatchInterval is how often the CA files are checked for changes while
+// a MintX509CA stream is open.
+const fileWatchInterval = 5 * time.Second
+
 func BuiltIn() catalog.Plugin {
 	return builtin(New())
 }
@@ -53,6 +59,10 @@ type Plugin struct {
 	config     *Configuration
 	certs      *caCerts
 	upstreamCA *x509svid.UpstreamCA
+
+	// modTimes holds the modification times of the CA files the last time
+	// they were loaded, whether or not loading succeeded.
+	modTimes map[string]time.Time
 }
 
 type caCerts struct {
@@ -92,6 +102,7 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi
 	}
 	config.trustDomain = trustDomain
 
+	modTimes := statCAFiles(config)
 	upstreamCA, certs, err := p.loadUpstreamCAAndCerts(config)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load upstream CA: %v", err)
@@ -104,6 +115,7 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi
 	p.config = config
 	p.certs = certs
 	p.upstreamCA = upstreamCA
+	p.modTimes = modTimes
 
 	return &spi.ConfigureResponse{}, nil
 }
@@ -125,10 +137,43 @@ func (p *Plugin) MintX509CA(request *upstreamauthority.MintX509CARequest, stream
 		return err
 	}
 
-	return stream.Send(&upstreamauthority.MintX509CAResponse{
+	if err := stream.Send(&upstreamauthority.MintX509CAResponse{
 		X509CaChain:       append([][]byte{cert.Raw}, upstreamCerts.certChain...),
 		UpstreamX509Roots: upstreamCerts.trustBundle,
-	})
+	}); err != nil {
+		return err
+	}
+
+	return p.watchCA(stream, upstreamCerts.trustBundle)
+}
+
+// watchCA reloads the CA files when they change on disk for as long as the
+// stream is open, sending the upstream roots to the server whenever they
+// change. This lets operators rotate the upstream CA without restarting the
+// server: the new roots are distributed before the next X509 CA is minted.
+func (p *Plugin) watchCA(stream upstreamauthority.UpstreamAuthority_MintX509CAServer, trustBundle [][]byte) error {
+	ticker := p.clock.Ticker(fileWatchInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ticker.C:
+		case <-stream.Context().Done():
+			return nil
+		}
+
+		upstreamCerts, ok := p.reloadCAIfChanged()
+		if !ok || rawCertsEqual(upstreamCerts.trustBundle, trustBundle) {
+			continue
+		}
+		trustBundle = upstreamCerts.trustBundle
+
+		if err := stream.Send(&upstreamauthority.MintX509CAResponse{
+			UpstreamX509Roots: trustBundle,
+		}); err != nil {
+			return err
+		}
+	}
 }
 
 func (*Plugin) PublishJWTKey(*upstreamauthority.PublishJWTKeyRequest, upstreamauthority.UpstreamAuthority_PublishJWTKeyServer) error {
@@ -139,7 +184,9 @@ func (p *Plugin) reloadCA() (*x509svid.UpstreamCA, *caCerts, error) {
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
+	modTimes := statCAFiles(p.config)
 	upstreamCA, upstreamCerts, err := p.loadUpstreamCAAndCerts(p.config)
+	p.modTimes = modTimes
 	switch {
 	case err == nil:
 		p.upstreamCA = upstreamCA
@@ -154,6 +201,31 @@ func (p *Plugin) reloadCA() (*x509svid.UpstreamCA, *caCerts, error) {
 	return upstreamCA, upstreamCerts, nil
 }
 
+// reloadCAIfChanged reloads the CA files if any of them changed since they
+// were last loaded. It returns the reloaded certificates and true if the
+// reload succeeded. On failure the previously loaded CA remains in use.
+func (p *Plugin) reloadCAIfChanged() (*caCerts, bool) {
+	p.mtx.Lock()
+	defer p.mtx.Unlock()
+
+	modTimes := statCAFiles(p.config)
+	if modTimesEqual(modTimes, p.modTimes) {
+		return nil, false
+	}
+	p.modTimes = modTimes
+
+	upstreamCA, upstreamCerts, err := p.loadUpstreamCAAndCerts(p.config)
+	if err != nil {
+		p.log.Warn("Failed to reload upstream CA after files changed; continuing with the previously loaded CA", "error", err)
+		return nil, false
+	}
+
+	p.log.Info("Reloaded upstream CA after files changed")
+	p.upstreamCA = upstreamCA
+	p.certs = upstreamCerts
+	return upstreamCerts, true
+}
+
 func (p *Plugin) loadUpstreamCAAndCerts(config *Configuration) (*x509svid.UpstreamCA, *caCerts, error) {
 	key, err := pemutil.LoadPrivateKey(config.KeyFilePath)
 	if err != nil {
@@ -231,6 +303,45 @@ func (p *Plugin) loadUpstreamCAAndCerts(config *Configuration) (*x509svid.Upstre
 	), caCerts, nil
 }
 
+// statCAFiles returns the modification times of the configured CA files.
+// Files that cannot be stat'd are omitted.
+func statCAFiles(config *Configuration) map[string]time.Time {
+	modTimes := make(map[string]time.Time)
+	for _, path := range []string{config.CertFilePath, config.KeyFilePath, config.BundleFilePath} {
+		if path == "" {
+			continue
+		}
+		if info, err := os.Stat(path); err == nil {
+			modTimes[path] = info.ModTime()
+		}
+	}
+	return modTimes
+}
+
+func modTimesEqual(a, b map[string]time.Time) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for path, modTime := range a {
+		if other, ok := b[path]; !ok || !modTime.Equal(other) {
+			return false
+		}
+	}
+	return true
+}
+
+func rawCertsEqual(a, b [][]byte) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !bytes.Equal(a[i], b[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func makeError(code codes.Code, format string, args ...interface{}) error {
 	return status.Errorf(code, "upstreamauthority-disk: "+format, args...)
 }
@@ -5,11 +5,14 @@ import (
 	"crypto"
 	"crypto/x509"
 	"encoding/json"
-	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
 	"github.com/spiffe/spire/pkg/common/cryptoutil"
+	"github.com/spiffe/spire/pkg/common/pemutil"
 	"github.com/spiffe/spire/pkg/common/x509svid"
 	"github.com/spiffe/spire/pkg/common/x509util"
 	"github.com/spiffe/spire/pkg/server/plugin/upstreamauthority"
@@ -226,6 +229,77 @@ func (s *DiskSuite) TestSubmitValidCSR() {
 	testCSR()
 }
 
+func (s *DiskSuite) TestMintX509CAStreamsRootsWhenFilesChange() {
+	dir := spiretest.TempDir(s.T())
+	keyFilePath := filepath.Join(dir, "key.pem")
+	certFilePath := filepath.Join(dir, "cert.pem")
+
+	modTime := time.Now()
+	writeFile := func(dst, src string) {
+		data, err := ioutil.ReadFile(src)
+		s.Require().NoError(err)
+		s.Require().NoError(ioutil.WriteFile(dst, data, 0600))
+		// Make sure the modification time changes even on file systems with
+		// coarse timestamps.
+		modTime = modTime.Add(time.Second)
+		s.Require().NoError(os.Chtimes(dst, modTime, modTime))
+	}
+	loadCert := func(path string) []byte {
+		cert, err := pemutil.LoadCertificate(path)
+		s.Require().NoError(err)
+		return cert.Raw
+	}
+
+	writeFile(keyFilePath, "_test_data/keys/EC/private_key.pem")
+	writeFile(certFilePath, "_test_data/keys/EC/cert.pem")
+	s.Require().NoError(s.configureWith(keyFilePath, certFilePath))
+
+	csr, pubKey, err := util.NewCSRTemplate("spiffe://localhost")
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	stream, err := s.p.MintX509CA(ctx, &upstreamauthority.MintX509CARequest{Csr: csr})
+	s.Require().NoError(err)
+
+	resp, err := stream.Recv()
+	s.Require().NoError(err)
+	testCSRResp(s.T(), resp, pubKey, []string{"spiffe://localhost"}, []string{"spiffe://local"})
+	s.clock.WaitForTicker(time.Minute, "waiting for the file watch ticker")
+
+	// Files that have not changed are not reloaded.
+	_, ok := s.rawPlugin.reloadCAIfChanged()
+	s.Require().False(ok)
+
+	// An unloadable CA is ignored and the previous CA remains in use.
+	writeFile(certFilePath, "_test_data/keys/empty/cert.pem")
+	_, ok = s.rawPlugin.reloadCAIfChanged()
+	s.Require().False(ok)
+
+	// Rotating the CA sends the new roots.
+	writeFile(keyFilePath, "_test_data/keys/PKCS8/private_key.pem")
+	writeFile(certFilePath, "_test_data/keys/PKCS8/cert.pem")
+	s.clock.Add(fileWatchInterval)
+
+	resp, err = stream.Recv()
+	s.Require().NoError(err)
+	s.Require().Empty(resp.X509CaChain)
+	s.Require().Equal([][]byte{loadCert(certFilePath)}, resp.UpstreamX509Roots)
+
+	// New X509 CAs are signed by the rotated CA.
+	resp, err = s.mintX509CA(&upstreamauthority.MintX509CARequest{Csr: csr})
+	s.Require().NoError(err)
+	certs, err := x509util.RawCertsToCertificates(resp.X509CaChain)
+	s.Require().NoError(err)
+	s.Require().NoError(certs[0].CheckSignatureFrom(mustParseCertificate(s.T(), loadCert(certFilePath))))
+}
+
+func mustParseCertificate(t *testing.T, der []byte) *x509.Certificate {
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert
+}
+
 func (s *DiskSuite) TestMintX509CAUsesPreferredTTLIfSet() {
 	err := s.configureWith("_test_data/keys/EC/private_key.pem", "_test_data/keys/EC/cert.pem")
 	s.Require().NoError(err)
@@ -376,13 +450,7 @@ func (s *DiskSuite) mintX509CA(req *upstreamauthority.MintX509CARequest) (*upstr
 	s.Require().NoError(err)
 	s.Require().NotNil(stream)
 
-	// Get response and error to be returned
-	response, err := stream.Recv()
-	if err == nil {
-		// Verify stream is closed
-		_, eofErr := stream.Recv()
-		s.Require().Equal(io.EOF, eofErr)
-	}
-
-	return response, err
+	// Get response and error to be returned. The stream is left open by the
+	// plugin to send root updates and is closed when the context is canceled.
+	return stream.Recv()
 }
@@ -29,6 +29,9 @@ const (
 	Type = "UpstreamAuthority"
 )
 
+// Version is the version of the UpstreamAuthority definition. Plugins built against another version are not loaded.
+const Version = 1
+
 // UpstreamAuthority is the client interface for the service type UpstreamAuthority interface.
 type UpstreamAuthority interface {
 	MintX509CA(context.Context, *MintX509CARequest) (UpstreamAuthority_MintX509CAClient, error)
@@ -43,6 +46,11 @@ type Plugin interface {
 	PublishJWTKey(context.Context, *PublishJWTKeyRequest) (UpstreamAuthority_PublishJWTKeyClient, error)
 }
 
+// Serve serves the UpstreamAuthority plugin, along with any additional services, as an external plugin. It does not return.
+func Serve(name string, server UpstreamAuthorityServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginServer(server), services...))
+}
+
 // PluginServer returns a catalog PluginServer implementation for the UpstreamAuthority plugin.
 func PluginServer(server UpstreamAuthorityServer) catalog.PluginServer {
 	return &pluginServer{
@@ -58,6 +66,10 @@ func (s pluginServer) PluginType() string {
 	return Type
 }
 
+func (s pluginServer) PluginVersion() uint32 {
+	return Version
+}
+
 func (s pluginServer) PluginClient() catalog.PluginClient {
 	return PluginClient
 }
@@ -76,6 +88,10 @@ func (pluginClient) PluginType() string {
 	return Type
 }
 
+func (pluginClient) PluginVersion() uint32 {
+	return Version
+}
+
 func (pluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginClient(upstreamauthority.NewUpstreamAuthorityClient(conn))
 }
@@ -10,6 +10,7 @@ import (
 type Renew struct {
 	Logger  hclog.Logger
 	renewer *vapi.Renewer
+	done    chan struct{}
 }
 
 func NewRenew(client *vapi.Client, secret *vapi.Secret, logger hclog.Logger) (*Renew, error) {
@@ -22,19 +23,29 @@ func NewRenew(client *vapi.Client, secret *vapi.Secret, logger hclog.Logger) (*R
 	return &Renew{
 		Logger:  logger,
 		renewer: renewer,
+		done:    make(chan struct{}),
 	}, nil
 }
 
+// Done returns a channel that is closed when the token is no longer renewed.
+func (r *Renew) Done() <-chan struct{} {
+	return r.done
+}
+
 func (r *Renew) Run() {
 	go r.renewer.Renew()
 	defer r.renewer.Stop()
+	defer close(r.done)
 
 	for {
 		select {
 		case err := <-r.renewer.DoneCh():
 			if err != nil {
 				r.Logger.Warn("Failed to renew auth token", "err", err.Error())
+				return
 			}
+			r.Logger.Debug("Auth token reached its max TTL; it will be replaced on the next signing request")
+			return
 		case renewal := <-r.renewer.RenewCh():
 			r.Logger.Debug("Successfully renew auth token", "request_id", renewal.Secret.RequestID)
 		}
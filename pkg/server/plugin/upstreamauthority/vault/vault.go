@@ -44,9 +44,17 @@ type PluginConfig struct {
 	CertAuth *CertAuthConfig `hcl:"cert_auth"`
 	// Configuration for the AppRole authentication method
 	AppRoleAuth *AppRoleAuthConfig `hcl:"approle_auth"`
+	// Configuration for the Kubernetes authentication method
+	K8sAuth *K8sAuthConfig `hcl:"k8s_auth"`
 	// Path to a CA certificate file that the client verifies the server certificate.
 	// Only PEM format is supported.
 	CACertPath string `hcl:"ca_cert_path"`
+	// Path to a client certificate file presented to the Vault server for
+	// mutual TLS, with any authentication method. Only PEM format is supported.
+	ClientCertPath string `hcl:"client_cert_path"`
+	// Path to the private key file of the client certificate. Only PEM format
+	// is supported.
+	ClientKeyPath string `hcl:"client_key_path"`
 	// If true, vault client accepts any server certificates.
 	// It should be used only test environment so on.
 	InsecureSkipVerify bool `hcl:"insecure_skip_verify"`
@@ -87,6 +95,18 @@ type AppRoleAuthConfig struct {
 	SecretID string `hcl:"approle_secret_id"`
 }
 
+// K8sAuthConfig represents parameters for Kubernetes auth method.
+type K8sAuthConfig struct {
+	// Name of the mount point where Kubernetes auth method is mounted. (e.g., /auth/<mount_point>/login)
+	// If the value is empty, use default mount point (/auth/kubernetes)
+	K8sAuthMountPoint string `hcl:"k8s_auth_mount_point"`
+	// Name of the Vault role.
+	// The plugin authenticates against the named role.
+	K8sAuthRoleName string `hcl:"k8s_auth_role_name"`
+	// Path to the Kubernetes Service Account Token to use authentication with the Vault.
+	TokenPath string `hcl:"token_path"`
+}
+
 type Plugin struct {
 	upstreamauthority.UnsafeUpstreamAuthorityServer
 
@@ -115,6 +135,10 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi
 		return nil, fmt.Errorf("failed to decode configuration file: %v", err)
 	}
 
+	if (config.ClientCertPath == "") != (config.ClientKeyPath == "") {
+		return nil, errors.New("both client_cert_path and client_key_path are required for mutual TLS")
+	}
+
 	p.mtx.Lock()
 	defer p.mtx.Unlock()
 
@@ -122,6 +146,14 @@ func (p *Plugin) Configure(ctx context.Context, req *spi.ConfigureRequest) (*spi
 	if err != nil {
 		return nil, err
 	}
+	if am == K8S {
+		if config.K8sAuth.K8sAuthRoleName == "" {
+			return nil, errors.New("k8s_auth_role_name is required")
+		}
+		if config.K8sAuth.TokenPath == "" {
+			return nil, errors.New("token_path is required")
+		}
+	}
 	cp := genClientParams(am, config)
 	vcConfig, err := NewClientConfig(cp, p.logger)
 	if err != nil {
@@ -140,8 +172,9 @@ func (p *Plugin) MintX509CA(req *upstreamauthority.MintX509CARequest, stream ups
 	}
 
 	// reuseToken=false means that the token cannot be renewed and may expire,
-	// authenticates to the Vault at each signing request.
-	if p.vc == nil || !p.reuseToken {
+	// authenticates to the Vault at each signing request. A token that could
+	// not be renewed any longer is replaced as well.
+	if p.vc == nil || !p.reuseToken || p.vc.RenewalStopped() {
 		vc, reusable, err := p.cc.NewAuthenticatedClient(p.authMethod)
 		if err != nil {
 			return fmt.Errorf("failed to prepare authenticated client: %v", err)
@@ -237,12 +270,18 @@ func parseAuthMethod(config *PluginConfig) (AuthMethod, error) {
 		}
 		authMethod = APPROLE
 	}
+	if config.K8sAuth != nil {
+		if err := checkForAuthMethodConfigured(authMethod); err != nil {
+			return 0, err
+		}
+		authMethod = K8S
+	}
 
 	if authMethod != 0 {
 		return authMethod, nil
 	}
 
-	return 0, errors.New("must be configured one of these authentication method 'Token or Cert or AppRole'")
+	return 0, errors.New("must be configured one of these authentication method 'Token or Cert or AppRole or K8s'")
 }
 
 func checkForAuthMethodConfigured(authMethod AuthMethod) error {
@@ -259,6 +298,10 @@ func genClientParams(method AuthMethod, config *PluginConfig) *ClientParams {
 		PKIMountPoint: config.PKIMountPoint,
 		TLSSKipVerify: config.InsecureSkipVerify,
 		Namespace:     getEnvOrDefault(envVaultNamespace, config.Namespace),
+		// The client certificate for mutual TLS, if any. The Client
+		// Certificate authentication method can use its own below.
+		ClientCertPath: config.ClientCertPath,
+		ClientKeyPath:  config.ClientKeyPath,
 	}
 
 	switch method {
@@ -267,12 +310,20 @@ func genClientParams(method AuthMethod, config *PluginConfig) *ClientParams {
 	case CERT:
 		cp.CertAuthMountPoint = config.CertAuth.CertAuthMountPoint
 		cp.CertAuthRoleName = config.CertAuth.CertAuthRoleName
-		cp.ClientCertPath = getEnvOrDefault(envVaultClientCert, config.CertAuth.ClientCertPath)
-		cp.ClientKeyPath = getEnvOrDefault(envVaultClientKey, config.CertAuth.ClientKeyPath)
+		if certPath := getEnvOrDefault(envVaultClientCert, config.CertAuth.ClientCertPath); certPath != "" {
+			cp.ClientCertPath = certPath
+		}
+		if keyPath := getEnvOrDefault(envVaultClientKey, config.CertAuth.ClientKeyPath); keyPath != "" {
+			cp.ClientKeyPath = keyPath
+		}
 	case APPROLE:
 		cp.AppRoleAuthMountPoint = config.AppRoleAuth.AppRoleMountPoint
 		cp.AppRoleID = getEnvOrDefault(envVaultAppRoleID, config.AppRoleAuth.RoleID)
 		cp.AppRoleSecretID = getEnvOrDefault(envVaultAppRoleSecretID, config.AppRoleAuth.SecretID)
+	case K8S:
+		cp.K8sAuthMountPoint = config.K8sAuth.K8sAuthMountPoint
+		cp.K8sAuthRoleName = config.K8sAuth.K8sAuthRoleName
+		cp.K8sAuthTokenPath = config.K8sAuth.TokenPath
 	}
 
 	return cp
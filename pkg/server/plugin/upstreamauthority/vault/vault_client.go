@@ -6,6 +6,7 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"io/ioutil"
 	"net/http"
 	"strings"
 
@@ -29,6 +30,7 @@ const (
 	defaultCertMountPoint    = "cert"
 	defaultPKIMountPoint     = "pki"
 	defaultAppRoleMountPoint = "approle"
+	defaultK8sMountPoint     = "kubernetes"
 )
 
 type AuthMethod int
@@ -38,6 +40,7 @@ const (
 	CERT
 	TOKEN
 	APPROLE
+	K8S
 )
 
 type TokenStatus int
@@ -80,6 +83,13 @@ type ClientParams struct {
 	AppRoleID string
 	// A credential set of AppRole
 	AppRoleSecretID string
+	// Name of the mount point where Kubernetes auth method is mounted. (e.g., /auth/<mount_point>/login)
+	K8sAuthMountPoint string
+	// Name of the Vault role.
+	// The plugin authenticates against the named role.
+	K8sAuthRoleName string
+	// Path to a K8s Service Account Token to be used when auth method is 'k8s'
+	K8sAuthTokenPath string
 	// If true, client accepts any certificates.
 	// It should be used only test environment so on.
 	TLSSKipVerify bool
@@ -94,6 +104,10 @@ type ClientParams struct {
 type Client struct {
 	vaultClient  *vapi.Client
 	clientParams *ClientParams
+
+	// renewDone is closed when the token is no longer renewed. It is nil if
+	// the token is not renewed at all.
+	renewDone <-chan struct{}
 }
 
 // SignCSRResponse includes certificates which are generates by Vault
@@ -114,6 +128,7 @@ func NewClientConfig(cp *ClientParams, logger hclog.Logger) (*ClientConfig, erro
 	defaultParams := &ClientParams{
 		CertAuthMountPoint:    defaultCertMountPoint,
 		AppRoleAuthMountPoint: defaultAppRoleMountPoint,
+		K8sAuthMountPoint:     defaultK8sMountPoint,
 		PKIMountPoint:         defaultPKIMountPoint,
 	}
 	if err := mergo.Merge(cp, defaultParams); err != nil {
@@ -187,9 +202,26 @@ func (c *ClientConfig) NewAuthenticatedClient(method AuthMethod) (client *Client
 		if sec == nil {
 			return nil, false, errors.New("approle authentication response is nil")
 		}
+	case K8S:
+		jwt, err := ioutil.ReadFile(c.clientParams.K8sAuthTokenPath)
+		if err != nil {
+			return nil, false, fmt.Errorf("failed to read k8s service account token: %v", err)
+		}
+		path := fmt.Sprintf("auth/%s/login", c.clientParams.K8sAuthMountPoint)
+		body := map[string]interface{}{
+			"role": c.clientParams.K8sAuthRoleName,
+			"jwt":  string(jwt),
+		}
+		sec, err = client.Auth(path, body)
+		if err != nil {
+			return nil, false, err
+		}
+		if sec == nil {
+			return nil, false, errors.New("k8s authentication response is nil")
+		}
 	}
 
-	ts, err = handleRenewToken(vc, sec, c.Logger)
+	ts, client.renewDone, err = handleRenewToken(vc, sec, c.Logger)
 	if err != nil {
 		return nil, false, err
 	}
@@ -213,28 +245,30 @@ func isTokenReusable(status TokenStatus) (bool, error) {
 	}
 }
 
-func handleRenewToken(vc *vapi.Client, sec *vapi.Secret, logger hclog.Logger) (TokenStatus, error) {
+// handleRenewToken starts renewing the token if it is renewable. The returned
+// channel is closed when the token is no longer renewed.
+func handleRenewToken(vc *vapi.Client, sec *vapi.Secret, logger hclog.Logger) (TokenStatus, <-chan struct{}, error) {
 	if sec == nil || sec.Auth == nil {
-		return 0, errors.New("secret is nil")
+		return 0, nil, errors.New("secret is nil")
 	}
 
 	if sec.Auth.LeaseDuration == 0 {
 		logger.Debug("Token will never expire")
-		return NeverExpire, nil
+		return NeverExpire, nil, nil
 	}
 	if !sec.Auth.Renewable {
 		logger.Debug("Token is not renewable")
-		return NotRenewable, nil
+		return NotRenewable, nil, nil
 	}
 	status := Renewable
 	renew, err := NewRenew(vc, sec, logger)
 	if err != nil {
-		return status, err
+		return status, nil, err
 	}
 	go renew.Run()
 	logger.Debug("Token will be renewed")
 
-	return status, nil
+	return status, renew.Done(), nil
 }
 
 // ConfigureTLS Configures TLS for Vault Client
@@ -284,6 +318,21 @@ func (c *ClientConfig) configureTLS(vc *vapi.Config) error {
 	return nil
 }
 
+// RenewalStopped returns true if the token was being renewed but is not
+// anymore, e.g. because it reached its max TTL. The client has to
+// authenticate again before the token expires.
+func (c *Client) RenewalStopped() bool {
+	if c.renewDone == nil {
+		return false
+	}
+	select {
+	case <-c.renewDone:
+		return true
+	default:
+		return false
+	}
+}
+
 // SetToken wraps vapi.Client.SetToken()
 func (c *Client) SetToken(v string) {
 	c.vaultClient.SetToken(v)
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"io/ioutil"
 	"net/http"
+	"path/filepath"
 	"testing"
 
 	"github.com/hashicorp/go-hclog"
@@ -78,6 +79,7 @@ func (vcs *VaultClientSuite) Test_NewClientConfig_WithDefaultMountPoint() {
 		Token:                 "test-token",
 		CertAuthMountPoint:    "", // Expect the default value to be used.
 		AppRoleAuthMountPoint: "", // Expect the default value to be used.
+		K8sAuthMountPoint:     "", // Expect the default value to be used.
 	}
 
 	cc, err := NewClientConfig(p, hclog.Default())
@@ -85,6 +87,7 @@ func (vcs *VaultClientSuite) Test_NewClientConfig_WithDefaultMountPoint() {
 	vcs.Require().Equal(defaultPKIMountPoint, cc.clientParams.PKIMountPoint)
 	vcs.Require().Equal(defaultCertMountPoint, cc.clientParams.CertAuthMountPoint)
 	vcs.Require().Equal(defaultAppRoleMountPoint, cc.clientParams.AppRoleAuthMountPoint)
+	vcs.Require().Equal(defaultK8sMountPoint, cc.clientParams.K8sAuthMountPoint)
 }
 
 func (vcs *VaultClientSuite) Test_NewClientConfig_WithGivenMontPoint() {
@@ -94,6 +97,7 @@ func (vcs *VaultClientSuite) Test_NewClientConfig_WithGivenMontPoint() {
 		Token:                 "test-token",
 		CertAuthMountPoint:    "test-tls-cert", // Expect the default value to be used.
 		AppRoleAuthMountPoint: "test-approle",
+		K8sAuthMountPoint:     "test-k8s",
 	}
 
 	cc, err := NewClientConfig(p, hclog.Default())
@@ -101,6 +105,7 @@ func (vcs *VaultClientSuite) Test_NewClientConfig_WithGivenMontPoint() {
 	vcs.Require().Equal("test-pki", cc.clientParams.PKIMountPoint)
 	vcs.Require().Equal("test-tls-cert", cc.clientParams.CertAuthMountPoint)
 	vcs.Require().Equal("test-approle", cc.clientParams.AppRoleAuthMountPoint)
+	vcs.Require().Equal("test-k8s", cc.clientParams.K8sAuthMountPoint)
 }
 
 func (vcs *VaultClientSuite) Test_NewAuthenticatedClient_CertAuth() {
@@ -275,6 +280,67 @@ func (vcs *VaultClientSuite) Test_NewAuthenticatedClient_AppRoleAuth() {
 	}
 }
 
+func (vcs *VaultClientSuite) Test_NewAuthenticatedClient_K8sAuth() {
+	vcs.fakeVaultServer.K8sAuthResponseCode = 200
+	tokenPath := filepath.Join(vcs.TempDir(), "token")
+	vcs.Require().NoError(ioutil.WriteFile(tokenPath, []byte("test-token"), 0600))
+
+	for _, c := range []struct {
+		name     string
+		response []byte
+		reusable bool
+	}{
+		{
+			name:     "K8s Authentication success / Token is renewable",
+			response: []byte(testK8sAuthResponse),
+			reusable: true,
+		},
+		{
+			name:     "K8s Authentication success / Token is not renewable",
+			response: []byte(testK8sAuthResponseNotRenewable),
+		},
+	} {
+		c := c
+		vcs.Run(c.name, func() {
+			vcs.fakeVaultServer.K8sAuthResponse = c.response
+
+			s, addr, err := vcs.fakeVaultServer.NewTLSServer()
+			vcs.Require().NoError(err)
+
+			s.Start()
+			defer s.Close()
+
+			cp := &ClientParams{
+				VaultAddr:        fmt.Sprintf("https://%v/", addr),
+				CACertPath:       testRootCert,
+				K8sAuthRoleName:  "my-role",
+				K8sAuthTokenPath: tokenPath,
+			}
+			cc, err := NewClientConfig(cp, hclog.Default())
+			vcs.Require().NoError(err)
+
+			client, reusable, err := cc.NewAuthenticatedClient(K8S)
+			vcs.Require().NoError(err)
+			vcs.Require().Equal(c.reusable, reusable)
+			vcs.Require().False(client.RenewalStopped())
+		})
+	}
+}
+
+func (vcs *VaultClientSuite) Test_NewAuthenticatedClient_K8sAuthInvalidTokenPath() {
+	cp := &ClientParams{
+		VaultAddr:        "https://example.org:8200/",
+		K8sAuthRoleName:  "my-role",
+		K8sAuthTokenPath: "invalid/path",
+	}
+	cc, err := NewClientConfig(cp, hclog.Default())
+	vcs.Require().NoError(err)
+
+	_, _, err = cc.NewAuthenticatedClient(K8S)
+	vcs.Require().Error(err)
+	vcs.Require().Contains(err.Error(), "failed to read k8s service account token")
+}
+
 func (vcs *VaultClientSuite) Test_NewAuthenticatedClient_CertAuthFailed() {
 	vcs.fakeVaultServer.CertAuthResponseCode = 500
 
@@ -10,6 +10,7 @@ import (
 const (
 	defaultTLSAuthEndpoint          = "/v1/auth/cert/login"
 	defaultAppRoleAuthEndpoint      = "/v1/auth/approle/login"
+	defaultK8sAuthEndpoint          = "/v1/auth/kubernetes/login"
 	defaultSignIntermediateEndpoint = "/v1/pki/root/sign-intermediate"
 	defaultRenewEndpoint            = "/v1/auth/token/renew-self"
 	defaultLookupSelfEndpoint       = "/v1/auth/token/lookup-self"
@@ -78,6 +79,53 @@ approle_auth {
    approle_auth_mount_point = "test-approle-auth"
 }`
 
+	testK8sAuthConfigTpl = `
+vault_addr  = "{{ .Addr }}"
+pki_mount_point = "test-pki"
+ca_cert_path = "_test_data/keys/EC/root_cert.pem"
+k8s_auth {
+   k8s_auth_mount_point = "test-k8s-auth"
+   k8s_auth_role_name = "my-role"
+   token_path = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+}`
+
+	testK8sAuthNoRoleNameConfigTpl = `
+vault_addr  = "{{ .Addr }}"
+pki_mount_point = "test-pki"
+ca_cert_path = "_test_data/keys/EC/root_cert.pem"
+k8s_auth {
+   token_path = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+}`
+
+	testK8sAuthNoTokenPathConfigTpl = `
+vault_addr  = "{{ .Addr }}"
+pki_mount_point = "test-pki"
+ca_cert_path = "_test_data/keys/EC/root_cert.pem"
+k8s_auth {
+   k8s_auth_role_name = "my-role"
+}`
+
+	/* #nosec G101 */
+	testTokenAuthWithClientCertConfigTpl = `
+vault_addr  = "{{ .Addr }}"
+pki_mount_point = "test-pki"
+ca_cert_path = "_test_data/keys/EC/root_cert.pem"
+client_cert_path = "_test_data/keys/EC/client_cert.pem"
+client_key_path  = "_test_data/keys/EC/client_key.pem"
+token_auth {
+   token  = "test-token"
+}`
+
+	/* #nosec G101 */
+	testTokenAuthWithClientCertNoKeyConfigTpl = `
+vault_addr  = "{{ .Addr }}"
+pki_mount_point = "test-pki"
+ca_cert_path = "_test_data/keys/EC/root_cert.pem"
+client_cert_path = "_test_data/keys/EC/client_cert.pem"
+token_auth {
+   token  = "test-token"
+}`
+
 	testMultipleAuthConfigsTpl = `
 vault_addr  = "{{ .Addr }}"
 pki_mount_point = "test-pki"
@@ -142,6 +190,40 @@ token_auth {
   "lease_id": ""
 }`
 
+	testK8sAuthResponse = `{
+  "auth": {
+    "client_token": "62b858f9-529c-6b26-e0b8-0457b6aacdb4",
+    "accessor": "afa306d0-be3d-c8d2-b0d7-2c9c8d5b1c51",
+    "policies": [
+      "default"
+    ],
+    "metadata": {
+      "role": "my-role",
+      "service_account_name": "spire-server",
+      "service_account_namespace": "spire"
+    },
+    "lease_duration": 2764800,
+    "renewable": true
+  }
+}`
+
+	testK8sAuthResponseNotRenewable = `{
+  "auth": {
+    "client_token": "62b858f9-529c-6b26-e0b8-0457b6aacdb4",
+    "accessor": "afa306d0-be3d-c8d2-b0d7-2c9c8d5b1c51",
+    "policies": [
+      "default"
+    ],
+    "metadata": {
+      "role": "my-role",
+      "service_account_name": "spire-server",
+      "service_account_namespace": "spire"
+    },
+    "lease_duration": 2764800,
+    "renewable": false
+  }
+}`
+
 	testAppRoleAuthResponseNotRenewable = `{
   "auth": {
     "renewable": false,
@@ -313,6 +395,10 @@ type FakeVaultServerConfig struct {
 	AppRoleAuthReqHandler        func(code int, resp []byte) func(w http.ResponseWriter, r *http.Request)
 	AppRoleAuthResponseCode      int
 	AppRoleAuthResponse          []byte
+	K8sAuthReqEndpoint           string
+	K8sAuthReqHandler            func(code int, resp []byte) func(w http.ResponseWriter, r *http.Request)
+	K8sAuthResponseCode          int
+	K8sAuthResponse              []byte
 	SignIntermediateReqEndpoint  string
 	SignIntermediateReqHandler   func(code int, resp []byte) func(http.ResponseWriter, *http.Request)
 	SignIntermediateResponseCode int
@@ -335,6 +421,8 @@ func NewFakeVaultServerConfig() *FakeVaultServerConfig {
 		CertAuthReqHandler:          defaultReqHandler,
 		AppRoleAuthReqEndpoint:      defaultAppRoleAuthEndpoint,
 		AppRoleAuthReqHandler:       defaultReqHandler,
+		K8sAuthReqEndpoint:          defaultK8sAuthEndpoint,
+		K8sAuthReqHandler:           defaultReqHandler,
 		SignIntermediateReqEndpoint: defaultSignIntermediateEndpoint,
 		SignIntermediateReqHandler:  defaultReqHandler,
 		RenewReqEndpoint:            defaultRenewEndpoint,
@@ -368,6 +456,7 @@ func (v *FakeVaultServerConfig) NewTLSServer() (srv *httptest.Server, addr strin
 	mux := http.NewServeMux()
 	mux.HandleFunc(v.CertAuthReqEndpoint, v.CertAuthReqHandler(v.CertAuthResponseCode, v.CertAuthResponse))
 	mux.HandleFunc(v.AppRoleAuthReqEndpoint, v.AppRoleAuthReqHandler(v.AppRoleAuthResponseCode, v.AppRoleAuthResponse))
+	mux.HandleFunc(v.K8sAuthReqEndpoint, v.K8sAuthReqHandler(v.K8sAuthResponseCode, v.K8sAuthResponse))
 	mux.HandleFunc(v.SignIntermediateReqEndpoint, v.SignIntermediateReqHandler(v.SignIntermediateResponseCode, v.SignIntermediateResponse))
 	mux.HandleFunc(v.RenewReqEndpoint, v.RenewReqHandler(v.RenewResponseCode, v.RenewResponse))
 	mux.HandleFunc(v.LookupSelfReqEndpoint, v.LookupSelfReqHandler(v.LookupSelfResponseCode, v.LookupSelfResponse))
@@ -69,6 +69,8 @@ func (vps *VaultPluginSuite) Test_Configure() {
 		err                   string
 		wantAuth              AuthMethod
 		wantNamespaceIsNotNil bool
+		wantClientCertPath    string
+		wantClientKeyPath     string
 		envKeyVal             map[string]string
 	}{
 		{
@@ -112,6 +114,33 @@ func (vps *VaultPluginSuite) Test_Configure() {
 			},
 			wantAuth: APPROLE,
 		},
+		{
+			name:       "Configure plugin with K8s authentication params given in config file",
+			configTmpl: testK8sAuthConfigTpl,
+			wantAuth:   K8S,
+		},
+		{
+			name:       "K8s authentication without role name",
+			configTmpl: testK8sAuthNoRoleNameConfigTpl,
+			err:        "k8s_auth_role_name is required",
+		},
+		{
+			name:       "K8s authentication without token path",
+			configTmpl: testK8sAuthNoTokenPathConfigTpl,
+			err:        "token_path is required",
+		},
+		{
+			name:               "Configure plugin with a client certificate for mutual TLS",
+			configTmpl:         testTokenAuthWithClientCertConfigTpl,
+			wantAuth:           TOKEN,
+			wantClientCertPath: "_test_data/keys/EC/client_cert.pem",
+			wantClientKeyPath:  "_test_data/keys/EC/client_key.pem",
+		},
+		{
+			name:       "Configure plugin with a client certificate but no key",
+			configTmpl: testTokenAuthWithClientCertNoKeyConfigTpl,
+			err:        "both client_cert_path and client_key_path are required for mutual TLS",
+		},
 		{
 			name:       "Multiple authentication methods configured",
 			configTmpl: testMultipleAuthConfigsTpl,
@@ -166,11 +195,19 @@ func (vps *VaultPluginSuite) Test_Configure() {
 				vps.Require().NotNil(p.cc.clientParams.AppRoleAuthMountPoint)
 				vps.Require().NotNil(p.cc.clientParams.AppRoleID)
 				vps.Require().NotNil(p.cc.clientParams.AppRoleSecretID)
+			case K8S:
+				vps.Require().Equal("test-k8s-auth", p.cc.clientParams.K8sAuthMountPoint)
+				vps.Require().Equal("my-role", p.cc.clientParams.K8sAuthRoleName)
+				vps.Require().Equal("/var/run/secrets/kubernetes.io/serviceaccount/token", p.cc.clientParams.K8sAuthTokenPath)
 			}
 
 			if c.wantNamespaceIsNotNil {
 				vps.Require().NotNil(p.cc.clientParams.Namespace)
 			}
+			if c.wantClientCertPath != "" {
+				vps.Require().Equal(c.wantClientCertPath, p.cc.clientParams.ClientCertPath)
+				vps.Require().Equal(c.wantClientKeyPath, p.cc.clientParams.ClientKeyPath)
+			}
 		})
 	}
 }
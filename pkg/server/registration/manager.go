@@ -6,13 +6,25 @@ import (
 
 	"github.com/andres-erbsen/clock"
 	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	"github.com/spiffe/spire/pkg/common/idutil"
 	"github.com/spiffe/spire/pkg/common/telemetry"
 	telemetry_server "github.com/spiffe/spire/pkg/common/telemetry/server"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 const (
 	_pruningCandence = 5 * time.Minute
+
+	// _tombstoneRetention is how long the tombstones of deleted entries are
+	// kept for clients syncing entries incrementally
+	_tombstoneRetention = 24 * time.Hour
+
+	// _orphanedEntriesPageSize is the page size used to list entries while
+	// looking for orphaned ones
+	_orphanedEntriesPageSize = 1000
 )
 
 // ManagerConfig is the config for the registration manager
@@ -23,6 +35,15 @@ type ManagerConfig struct {
 	Metrics telemetry.Metrics
 
 	Clock clock.Clock
+
+	// PruneInterval is how often expired and orphaned registration state is
+	// pruned. Defaults to 5 minutes.
+	PruneInterval time.Duration
+
+	// OrphanedEntryRetention, if set, is how long entries parented to an
+	// agent that is no longer attested are kept, counted from the creation
+	// of the entry. If zero, orphaned entries are not pruned.
+	OrphanedEntryRetention time.Duration
 }
 
 // Manager is the manager of registrations
@@ -37,10 +58,13 @@ func NewManager(c ManagerConfig) *Manager {
 	if c.Clock == nil {
 		c.Clock = clock.New()
 	}
+	if c.PruneInterval <= 0 {
+		c.PruneInterval = _pruningCandence
+	}
 
 	return &Manager{
 		c:       c,
-		log:     c.Log.WithField(telemetry.RetryInterval, _pruningCandence),
+		log:     c.Log.WithField(telemetry.RetryInterval, c.PruneInterval),
 		metrics: c.Metrics,
 	}
 }
@@ -51,7 +75,7 @@ func (m *Manager) Run(ctx context.Context) error {
 }
 
 func (m *Manager) pruneEvery(ctx context.Context) error {
-	ticker := m.c.Clock.Ticker(_pruningCandence)
+	ticker := m.c.Clock.Ticker(m.c.PruneInterval)
 	defer ticker.Stop()
 
 	for {
@@ -61,6 +85,15 @@ func (m *Manager) pruneEvery(ctx context.Context) error {
 			if err := m.prune(ctx); err != nil && ctx.Err() == nil {
 				m.log.WithError(err).Error("Failed pruning registration entries")
 			}
+			if err := m.pruneTombstones(ctx); err != nil && ctx.Err() == nil {
+				m.log.WithError(err).Error("Failed pruning registration entry tombstones")
+			}
+			if err := m.pruneOrphanedEntries(ctx); err != nil && ctx.Err() == nil {
+				m.log.WithError(err).Error("Failed pruning orphaned registration entries")
+			}
+			if err := m.pruneJoinTokens(ctx); err != nil && ctx.Err() == nil {
+				m.log.WithError(err).Error("Failed pruning join tokens")
+			}
 		case <-ctx.Done():
 			return nil
 		}
@@ -71,8 +104,123 @@ func (m *Manager) prune(ctx context.Context) (err error) {
 	counter := telemetry_server.StartRegistrationManagerPruneEntryCall(m.c.Metrics)
 	defer counter.Done(&err)
 
-	_, err = m.c.DataStore.PruneRegistrationEntries(ctx, &datastore.PruneRegistrationEntriesRequest{
+	resp, err := m.c.DataStore.PruneRegistrationEntries(ctx, &datastore.PruneRegistrationEntriesRequest{
 		ExpiresBefore: m.c.Clock.Now().Unix(),
 	})
+	if err != nil {
+		return err
+	}
+	if resp.EntriesPruned > 0 {
+		m.log.WithField(telemetry.Count, resp.EntriesPruned).Info("Pruned expired registration entries")
+		telemetry_server.AddRegistrationManagerPrunedEntryCounter(m.c.Metrics, float32(resp.EntriesPruned))
+	}
+	return nil
+}
+
+func (m *Manager) pruneTombstones(ctx context.Context) (err error) {
+	counter := telemetry_server.StartRegistrationManagerPruneTombstoneCall(m.c.Metrics)
+	defer counter.Done(&err)
+
+	_, err = m.c.DataStore.PruneRegistrationEntryTombstones(ctx, &datastore.PruneRegistrationEntryTombstonesRequest{
+		DeletedBefore: m.c.Clock.Now().Add(-_tombstoneRetention).Unix(),
+	})
 	return err
 }
+
+// pruneOrphanedEntries deletes the entries parented to an agent that is no
+// longer attested, e.g. because it was evicted, once they are older than
+// the retention. Agents are only known by their attested node, so entries
+// registered ahead of time for agents that have not attested yet are pruned
+// as well once older than the retention.
+func (m *Manager) pruneOrphanedEntries(ctx context.Context) (err error) {
+	if m.c.OrphanedEntryRetention <= 0 {
+		return nil
+	}
+
+	counter := telemetry_server.StartRegistrationManagerPruneOrphanedEntryCall(m.c.Metrics)
+	defer counter.Done(&err)
+
+	createdBefore := m.c.Clock.Now().Add(-m.c.OrphanedEntryRetention).Unix()
+	attested := make(map[string]bool)
+
+	// Collect the orphaned entries before deleting any of them so the
+	// deletions do not shift the pages being listed
+	var orphaned []string
+	pagination := &datastore.Pagination{PageSize: _orphanedEntriesPageSize}
+	for {
+		resp, err := m.c.DataStore.ListRegistrationEntries(ctx, &datastore.ListRegistrationEntriesRequest{
+			Pagination: pagination,
+		})
+		if err != nil {
+			return err
+		}
+
+		for _, entry := range resp.Entries {
+			if entry.CreatedAt >= createdBefore {
+				continue
+			}
+			parentID, err := spiffeid.FromString(entry.ParentId)
+			if err != nil || !idutil.IsAgentPath(parentID.Path()) {
+				continue
+			}
+
+			isAttested, ok := attested[entry.ParentId]
+			if !ok {
+				nodeResp, err := m.c.DataStore.FetchAttestedNode(ctx, &datastore.FetchAttestedNodeRequest{
+					SpiffeId: entry.ParentId,
+				})
+				if err != nil {
+					return err
+				}
+				isAttested = nodeResp.Node != nil
+				attested[entry.ParentId] = isAttested
+			}
+			if !isAttested {
+				orphaned = append(orphaned, entry.EntryId)
+			}
+		}
+
+		if resp.Pagination == nil || resp.Pagination.Token == "" || len(resp.Entries) == 0 {
+			break
+		}
+		pagination = resp.Pagination
+	}
+
+	pruned := 0
+	for _, entryID := range orphaned {
+		_, err := m.c.DataStore.DeleteRegistrationEntry(ctx, &datastore.DeleteRegistrationEntryRequest{
+			EntryId: entryID,
+		})
+		switch status.Code(err) {
+		case codes.OK:
+			pruned++
+		case codes.NotFound:
+			// Deleted since it was listed
+		default:
+			return err
+		}
+	}
+
+	if pruned > 0 {
+		m.log.WithField(telemetry.Count, pruned).Info("Pruned orphaned registration entries")
+		telemetry_server.AddRegistrationManagerPrunedOrphanedEntryCounter(m.c.Metrics, float32(pruned))
+	}
+	return nil
+}
+
+func (m *Manager) pruneJoinTokens(ctx context.Context) (err error) {
+	counter := telemetry_server.StartRegistrationManagerPruneJoinTokenCall(m.c.Metrics)
+	defer counter.Done(&err)
+
+	resp, err := m.c.DataStore.PruneJoinTokens(ctx, &datastore.PruneJoinTokensRequest{
+		ExpiresBefore: m.c.Clock.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+	if resp.TokensPruned > 0 {
+		m.log.WithField(telemetry.Count, resp.TokensPruned).Info("Pruned expired join tokens")
+		telemetry_server.AddRegistrationManagerPrunedJoinTokenCounter(m.c.Metrics, float32(resp.TokensPruned))
+	}
+	return nil
+}
@@ -28,6 +28,8 @@ type ManagerSuite struct {
 	ds      *fakedatastore.DataStore
 	metrics *fakemetrics.FakeMetrics
 
+	orphanedEntryRetention time.Duration
+
 	m *Manager
 }
 
@@ -36,6 +38,7 @@ func (s *ManagerSuite) SetupTest() {
 	s.log, s.logHook = test.NewNullLogger()
 	s.ds = fakedatastore.New(s.T())
 	s.metrics = fakemetrics.New()
+	s.orphanedEntryRetention = 0
 }
 
 func (s *ManagerSuite) TestPruning() {
@@ -132,12 +135,146 @@ func (s *ManagerSuite) TestPruning() {
 	s.Empty(listResp.Entries)
 }
 
+func (s *ManagerSuite) TestPruningTombstones() {
+	done := s.setupAndRunManager()
+	defer done()
+
+	createResp, err := s.ds.CreateRegistrationEntry(context.Background(), &datastore.CreateRegistrationEntryRequest{
+		Entry: &common.RegistrationEntry{
+			ParentId:  "spiffe://test.test/testA",
+			SpiffeId:  "spiffe://test.test/testA/test1",
+			Selectors: []*common.Selector{{Type: "type", Value: "value"}},
+		},
+	})
+	s.NoError(err)
+	_, err = s.ds.DeleteRegistrationEntry(context.Background(), &datastore.DeleteRegistrationEntryRequest{
+		EntryId: createResp.Entry.EntryId,
+	})
+	s.NoError(err)
+
+	// the tombstone is kept during the retention window
+	s.NoError(s.m.pruneTombstones(context.Background()))
+	listResp, err := s.ds.ListRegistrationEntryTombstones(context.Background(), &datastore.ListRegistrationEntryTombstonesRequest{})
+	s.NoError(err)
+	s.Len(listResp.Tombstones, 1)
+
+	// and pruned after it
+	s.clock.Add(_tombstoneRetention + time.Minute)
+	s.NoError(s.m.pruneTombstones(context.Background()))
+	listResp, err = s.ds.ListRegistrationEntryTombstones(context.Background(), &datastore.ListRegistrationEntryTombstonesRequest{})
+	s.NoError(err)
+	s.Empty(listResp.Tombstones)
+}
+
+func (s *ManagerSuite) TestPruningOrphanedEntries() {
+	s.orphanedEntryRetention = time.Hour
+	done := s.setupAndRunManager()
+	defer done()
+
+	_, err := s.ds.CreateAttestedNode(context.Background(), &datastore.CreateAttestedNodeRequest{
+		Node: &common.AttestedNode{
+			SpiffeId:            "spiffe://test.test/spire/agent/attested",
+			AttestationDataType: "test",
+			CertSerialNumber:    "1234",
+			CertNotAfter:        s.clock.Now().Add(24 * time.Hour).Unix(),
+		},
+	})
+	s.Require().NoError(err)
+
+	createEntry := func(parentID string) *common.RegistrationEntry {
+		resp, err := s.ds.CreateRegistrationEntry(context.Background(), &datastore.CreateRegistrationEntryRequest{
+			Entry: &common.RegistrationEntry{
+				ParentId:  parentID,
+				SpiffeId:  "spiffe://test.test/workload",
+				Selectors: []*common.Selector{{Type: "type", Value: "value"}},
+			},
+		})
+		s.Require().NoError(err)
+		return resp.Entry
+	}
+	attested := createEntry("spiffe://test.test/spire/agent/attested")
+	orphaned := createEntry("spiffe://test.test/spire/agent/evicted")
+	aliased := createEntry("spiffe://test.test/node-alias")
+
+	// orphaned entries are kept during the retention window
+	s.NoError(s.m.pruneOrphanedEntries(context.Background()))
+	listResp, err := s.ds.ListRegistrationEntries(context.Background(), &datastore.ListRegistrationEntriesRequest{})
+	s.Require().NoError(err)
+	s.Len(listResp.Entries, 3)
+
+	// and pruned after it; entries of attested agents and node aliases stay
+	s.clock.Add(time.Hour + time.Minute)
+	s.NoError(s.m.pruneOrphanedEntries(context.Background()))
+	listResp, err = s.ds.ListRegistrationEntries(context.Background(), &datastore.ListRegistrationEntriesRequest{})
+	s.Require().NoError(err)
+	var entryIDs []string
+	for _, entry := range listResp.Entries {
+		entryIDs = append(entryIDs, entry.EntryId)
+	}
+	s.ElementsMatch([]string{attested.EntryId, aliased.EntryId}, entryIDs)
+	s.NotContains(entryIDs, orphaned.EntryId)
+}
+
+func (s *ManagerSuite) TestPruningOrphanedEntriesDisabled() {
+	// The manager is not run so the clock can be moved far ahead without
+	// triggering the pruning loop at every interval
+	s.m = NewManager(ManagerConfig{
+		Clock:     s.clock,
+		DataStore: s.ds,
+		Log:       s.log,
+		Metrics:   s.metrics,
+	})
+
+	_, err := s.ds.CreateRegistrationEntry(context.Background(), &datastore.CreateRegistrationEntryRequest{
+		Entry: &common.RegistrationEntry{
+			ParentId:  "spiffe://test.test/spire/agent/evicted",
+			SpiffeId:  "spiffe://test.test/workload",
+			Selectors: []*common.Selector{{Type: "type", Value: "value"}},
+		},
+	})
+	s.Require().NoError(err)
+
+	s.clock.Add(365 * 24 * time.Hour)
+	s.NoError(s.m.pruneOrphanedEntries(context.Background()))
+	listResp, err := s.ds.ListRegistrationEntries(context.Background(), &datastore.ListRegistrationEntriesRequest{})
+	s.Require().NoError(err)
+	s.Len(listResp.Entries, 1)
+}
+
+func (s *ManagerSuite) TestPruningJoinTokens() {
+	done := s.setupAndRunManager()
+	defer done()
+
+	_, err := s.ds.CreateJoinToken(context.Background(), &datastore.CreateJoinTokenRequest{
+		JoinToken: &datastore.JoinToken{
+			Token:  "token",
+			Expiry: s.clock.Now().Add(time.Minute).Unix(),
+		},
+	})
+	s.Require().NoError(err)
+
+	// the token is kept until it expires
+	s.NoError(s.m.pruneJoinTokens(context.Background()))
+	fetchResp, err := s.ds.FetchJoinToken(context.Background(), &datastore.FetchJoinTokenRequest{Token: "token"})
+	s.Require().NoError(err)
+	s.NotNil(fetchResp.JoinToken)
+
+	// and pruned after it
+	s.clock.Add(2 * time.Minute)
+	s.NoError(s.m.pruneJoinTokens(context.Background()))
+	fetchResp, err = s.ds.FetchJoinToken(context.Background(), &datastore.FetchJoinTokenRequest{Token: "token"})
+	s.Require().NoError(err)
+	s.Nil(fetchResp.JoinToken)
+}
+
 func (s *ManagerSuite) setupAndRunManager() func() {
 	s.m = NewManager(ManagerConfig{
 		Clock:     s.clock,
 		DataStore: s.ds,
 		Log:       s.log,
 		Metrics:   s.metrics,
+
+		OrphanedEntryRetention: s.orphanedEntryRetention,
 	})
 
 	ctx, cancel := context.WithCancel(context.Background())
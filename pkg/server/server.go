@@ -2,6 +2,7 @@ package server
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	_ "net/http/pprof" //nolint: gosec // import registers routes on DefaultServeMux
@@ -12,13 +13,16 @@ import (
 	"time"
 
 	"github.com/andres-erbsen/clock"
+	"github.com/spiffe/spire/pkg/common/fips"
 	"github.com/spiffe/spire/pkg/common/health"
 	"github.com/spiffe/spire/pkg/common/hostservices/metricsservice"
 	common_services "github.com/spiffe/spire/pkg/common/plugin/hostservices"
 	"github.com/spiffe/spire/pkg/common/profiling"
 	"github.com/spiffe/spire/pkg/common/telemetry"
+	"github.com/spiffe/spire/pkg/common/tracing"
 	"github.com/spiffe/spire/pkg/common/uptime"
 	"github.com/spiffe/spire/pkg/common/util"
+	"github.com/spiffe/spire/pkg/server/authpolicy"
 	bundle_client "github.com/spiffe/spire/pkg/server/bundle/client"
 	"github.com/spiffe/spire/pkg/server/ca"
 	"github.com/spiffe/spire/pkg/server/catalog"
@@ -46,6 +50,17 @@ const (
 
 type Server struct {
 	config Config
+
+	// reloadable holds the components that can be reconfigured while the
+	// server is running. It is nil while the server is not running.
+	reloadMtx  sync.Mutex
+	reloadable *reloadableComponents
+}
+
+type reloadableComponents struct {
+	catalog       *catalog.Repository
+	endpoints     *endpoints.Endpoints
+	bundleManager *bundle_client.Manager
 }
 
 // Run the server
@@ -60,6 +75,11 @@ func (s *Server) Run(ctx context.Context) error {
 }
 
 func (s *Server) run(ctx context.Context) (err error) {
+	if err := fips.Require(s.config.RequireFIPS); err != nil {
+		return err
+	}
+	s.config.Log.WithField(telemetry.FIPSEnabled, fips.Enabled()).Info("FIPS 140 mode status")
+
 	// create the data directory if needed
 	s.config.Log.Infof("Data directory: %q", s.config.DataDir)
 	if err := os.MkdirAll(s.config.DataDir, 0755); err != nil {
@@ -85,6 +105,18 @@ func (s *Server) run(ctx context.Context) (err error) {
 
 	telemetry.EmitVersion(metrics)
 
+	tracingLog := s.config.Log.WithField(telemetry.SubsystemName, telemetry.Telemetry)
+	stopTracing, err := tracing.Setup(ctx, s.config.Telemetry.Tracing, telemetry.SpireServer, tracingLog)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// The run context is done by now, so flush with a fresh one
+		if err := stopTracing(context.Background()); err != nil {
+			tracingLog.WithError(err).Warn("Failed to stop tracing")
+		}
+	}()
+
 	// Create the identity provider host service. It will not be functional
 	// until the call to SetDeps() below. There is some tricky initialization
 	// stuff going on since the identity provider host service requires plugins
@@ -127,7 +159,9 @@ func (s *Server) run(ctx context.Context) (err error) {
 		return err
 	}
 
-	endpointsServer, err := s.newEndpointsServer(ctx, cat, svidRotator, serverCA, metrics, caManager)
+	bundleManager := s.newBundleManager(cat, metrics)
+
+	endpointsServer, err := s.newEndpointsServer(ctx, cat, svidRotator, serverCA, metrics, caManager, bundleManager, cat.EntriesChanged())
 	if err != nil {
 		return err
 	}
@@ -154,10 +188,15 @@ func (s *Server) run(ctx context.Context) (err error) {
 		return fmt.Errorf("failed setting AgentStore deps: %v", err)
 	}
 
-	bundleManager := s.newBundleManager(cat, metrics)
-
 	registrationManager := s.newRegistrationManager(cat, metrics)
 
+	s.setReloadable(&reloadableComponents{
+		catalog:       cat,
+		endpoints:     endpointsServer,
+		bundleManager: bundleManager,
+	})
+	defer s.setReloadable(nil)
+
 	if err := healthChecks.AddCheck("server", s, time.Minute); err != nil {
 		return fmt.Errorf("failed adding healthcheck: %v", err)
 	}
@@ -167,6 +206,9 @@ func (s *Server) run(ctx context.Context) (err error) {
 		svidRotator.Run,
 		endpointsServer.ListenAndServe,
 		metrics.ListenAndServe,
+		func(ctx context.Context) error {
+			return uptime.ReportMetrics(ctx, metrics)
+		},
 		bundleManager.Run,
 		registrationManager.Run,
 		healthChecks.ListenAndServe,
@@ -177,6 +219,32 @@ func (s *Server) run(ctx context.Context) (err error) {
 	return err
 }
 
+// Reload applies the settings of the given configuration that can be changed
+// while the server is running, without dropping agent connections: the rate
+// limits, the trust domains federated with and the plugin configuration.
+// Changes to the rest of the settings require a restart.
+func (s *Server) Reload(ctx context.Context, config Config) error {
+	s.reloadMtx.Lock()
+	defer s.reloadMtx.Unlock()
+
+	if s.reloadable == nil {
+		return errors.New("server is not running")
+	}
+
+	s.reloadable.endpoints.SetRateLimits(config.RateLimit)
+	s.reloadable.bundleManager.SetTrustDomains(config.Federation.FederatesWith)
+	if err := s.reloadable.catalog.Reconfigure(ctx, config.PluginConfigs); err != nil {
+		return fmt.Errorf("unable to reconfigure plugins: %w", err)
+	}
+	return nil
+}
+
+func (s *Server) setReloadable(reloadable *reloadableComponents) {
+	s.reloadMtx.Lock()
+	defer s.reloadMtx.Unlock()
+	s.reloadable = reloadable
+}
+
 func (s *Server) setupProfiling(ctx context.Context) (stop func()) {
 	ctx, cancel := context.WithCancel(ctx)
 	var wg sync.WaitGroup
@@ -217,6 +285,7 @@ func (s *Server) setupProfiling(ctx context.Context) (stop func()) {
 			DebugLevel:             0,
 			RunGCBeforeHeapProfile: true,
 			Profiles:               s.config.ProfilingNames,
+			Dir:                    s.config.ProfilingDir,
 		}
 		wg.Add(1)
 		go func() {
@@ -240,11 +309,13 @@ func (s *Server) loadCatalog(ctx context.Context, metrics telemetry.Metrics, ide
 		GlobalConfig: &catalog.GlobalConfig{
 			TrustDomain: s.config.TrustDomain.String(),
 		},
-		PluginConfig:     s.config.PluginConfigs,
-		Metrics:          metrics,
-		IdentityProvider: identityProvider,
-		AgentStore:       agentStore,
-		MetricsService:   metricsService,
+		PluginConfig:           s.config.PluginConfigs,
+		BuiltIns:               s.config.BuiltInPlugins,
+		RequirePluginChecksums: s.config.RequirePluginChecksums,
+		Metrics:                metrics,
+		IdentityProvider:       identityProvider,
+		AgentStore:             agentStore,
+		MetricsService:         metricsService,
 	})
 }
 
@@ -253,9 +324,13 @@ func (s *Server) newCA(metrics telemetry.Metrics) *ca.CA {
 		Log:         s.config.Log.WithField(telemetry.SubsystemName, telemetry.CA),
 		Metrics:     metrics,
 		X509SVIDTTL: s.config.SVIDTTL,
+		Backdate:    s.config.Backdate,
 		JWTIssuer:   s.config.JWTIssuer,
 		TrustDomain: s.config.TrustDomain,
 		CASubject:   s.config.CASubject,
+
+		MaxJWTSVIDTTL: s.config.MaxJWTSVIDTTL,
+		DNSNamePolicy: s.config.DNSNamePolicy,
 	})
 }
 
@@ -267,10 +342,12 @@ func (s *Server) newCAManager(ctx context.Context, cat catalog.Catalog, metrics
 		Log:           s.config.Log.WithField(telemetry.SubsystemName, telemetry.CAManager),
 		Metrics:       metrics,
 		CATTL:         s.config.CATTL,
+		Backdate:      s.config.Backdate,
 		CASubject:     s.config.CASubject,
 		Dir:           s.config.DataDir,
 		X509CAKeyType: s.config.CAKeyType,
 		JWTKeyType:    s.config.CAKeyType,
+		ServerID:      s.config.ServerID,
 	})
 	if err := caManager.Initialize(ctx); err != nil {
 		return nil, err
@@ -283,6 +360,9 @@ func (s *Server) newRegistrationManager(cat catalog.Catalog, metrics telemetry.M
 		DataStore: cat.GetDataStore(),
 		Log:       s.config.Log.WithField(telemetry.SubsystemName, telemetry.RegistrationManager),
 		Metrics:   metrics,
+
+		PruneInterval:          s.config.PruneInterval,
+		OrphanedEntryRetention: s.config.OrphanedEntryRetention,
 	})
 	return registrationManager
 }
@@ -300,25 +380,45 @@ func (s *Server) newSVIDRotator(ctx context.Context, serverCA ca.ServerCA, metri
 	return svidRotator, nil
 }
 
-func (s *Server) newEndpointsServer(ctx context.Context, catalog catalog.Catalog, svidObserver svid.Observer, serverCA ca.ServerCA, metrics telemetry.Metrics, caManager *ca.Manager) (endpoints.Server, error) {
+func (s *Server) newEndpointsServer(ctx context.Context, catalog catalog.Catalog, svidObserver svid.Observer, serverCA ca.ServerCA, metrics telemetry.Metrics, caManager *ca.Manager, bundleManager *bundle_client.Manager, entriesChanged <-chan struct{}) (*endpoints.Endpoints, error) {
+	policyEngine, err := authpolicy.NewEngineFromConfigOrDefault(ctx, s.config.Log, s.config.AuthOpaPolicyEngineConfig)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load authorization policy: %w", err)
+	}
+
 	config := endpoints.Config{
 		TCPAddr:                     s.config.BindAddress,
+		TLSPolicy:                   s.config.TLSPolicy,
+		AdditionalTCPListeners:      s.config.AdditionalBindAddresses,
+		GRPC:                        s.config.GRPC,
 		UDSAddr:                     s.config.BindUDSAddress,
+		UDSPermissions:              s.config.BindUDSPermissions,
+		AdminTCPAddr:                s.config.AdminBindAddress,
 		SVIDObserver:                svidObserver,
 		TrustDomain:                 s.config.TrustDomain,
+		AdminIDs:                    s.config.AdminIDs,
 		Catalog:                     catalog,
 		ServerCA:                    serverCA,
 		Log:                         s.config.Log.WithField(telemetry.SubsystemName, telemetry.Endpoints),
+		AuditLog:                    s.config.AuditLog,
 		Metrics:                     metrics,
 		Manager:                     caManager,
+		BundleRefresher:             bundleManager,
+		FederationReporter:          bundleManager,
 		AllowAgentlessNodeAttestors: s.config.Experimental.AllowAgentlessNodeAttestors,
 		RateLimit:                   s.config.RateLimit,
+		AuthPolicyEngine:            policyEngine,
 		Uptime:                      uptime.Uptime,
+		EntriesChanged:              entriesChanged,
 		Clock:                       clock.New(),
+		DNSNamePolicy:               s.config.DNSNamePolicy,
 	}
 	if s.config.Federation.BundleEndpoint != nil {
 		config.BundleEndpoint.Address = s.config.Federation.BundleEndpoint.Address
 		config.BundleEndpoint.ACME = s.config.Federation.BundleEndpoint.ACME
+		config.BundleEndpoint.RefreshHint = s.config.Federation.BundleEndpoint.RefreshHint
+		config.BundleEndpoint.TLSPolicy = s.config.Federation.BundleEndpoint.TLSPolicy
+		config.BundleEndpoint.AdditionalListeners = s.config.Federation.BundleEndpoint.AdditionalListeners
 	}
 	return endpoints.New(ctx, config)
 }
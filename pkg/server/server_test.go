@@ -152,3 +152,8 @@ func (suite *ServerTestSuite) TestValidateTrustDomain() {
 	suite.NoError(err)
 	suite.Require().Contains(suite.stdout.String(), invalidSpiffeIDAttestedNode)
 }
+
+func (suite *ServerTestSuite) TestReloadWhenNotRunning() {
+	err := suite.server.Reload(context.Background(), suite.server.config)
+	suite.EqualError(err, "server is not running")
+}
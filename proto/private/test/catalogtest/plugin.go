@@ -15,6 +15,9 @@ const (
 	PluginType = "Plugin"
 )
 
+// PluginVersion is the version of the Plugin definition. Plugins built against another version are not loaded.
+const PluginVersion = 1
+
 // Plugin is the client interface for the service type Plugin interface.
 type Plugin interface {
 	CallPlugin(context.Context, *Request) (*Response, error)
@@ -26,6 +29,11 @@ type PluginPlugin interface {
 	Configure(context.Context, *spi.ConfigureRequest) (*spi.ConfigureResponse, error)
 }
 
+// PluginServe serves the Plugin plugin, along with any additional services, as an external plugin. It does not return.
+func PluginServe(name string, server PluginServer, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, PluginPluginServer(server), services...))
+}
+
 // PluginPluginServer returns a catalog PluginServer implementation for the Plugin plugin.
 func PluginPluginServer(server PluginServer) catalog.PluginServer {
 	return &pluginPluginServer{
@@ -41,6 +49,10 @@ func (s pluginPluginServer) PluginType() string {
 	return PluginType
 }
 
+func (s pluginPluginServer) PluginVersion() uint32 {
+	return PluginVersion
+}
+
 func (s pluginPluginServer) PluginClient() catalog.PluginClient {
 	return PluginPluginClient
 }
@@ -59,6 +71,10 @@ func (pluginPluginClient) PluginType() string {
 	return PluginType
 }
 
+func (pluginPluginClient) PluginVersion() uint32 {
+	return PluginVersion
+}
+
 func (pluginPluginClient) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptPluginPluginClient(NewPluginClient(conn))
 }
@@ -14,6 +14,9 @@ const (
 	ServiceType = "Service"
 )
 
+// ServiceVersion is the version of the Service definition. Plugins built against another version are not loaded.
+const ServiceVersion = 1
+
 // Service is the client interface for the service type Service interface.
 type Service interface {
 	CallService(context.Context, *Request) (*Response, error)
@@ -34,6 +37,10 @@ func (s serviceServiceServer) ServiceType() string {
 	return ServiceType
 }
 
+func (s serviceServiceServer) ServiceVersion() uint32 {
+	return ServiceVersion
+}
+
 func (s serviceServiceServer) ServiceClient() catalog.ServiceClient {
 	return ServiceServiceClient
 }
@@ -52,6 +59,10 @@ func (serviceServiceClient) ServiceType() string {
 	return ServiceType
 }
 
+func (serviceServiceClient) ServiceVersion() uint32 {
+	return ServiceVersion
+}
+
 func (serviceServiceClient) NewServiceClient(conn grpc.ClientConnInterface) interface{} {
 	return AdaptServiceServiceClient(NewServiceClient(conn))
 }
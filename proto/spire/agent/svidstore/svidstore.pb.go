@@ -0,0 +1,514 @@
+// An SVIDStore plugin stores the X509-SVIDs of designated workloads in a
+// secret store so they can be consumed by workloads that are not able to
+// reach the Workload API (e.g. serverless functions).
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.25.0
+// 	protoc        v3.14.0
+// source: spire/agent/svidstore/svidstore.proto
+
+package svidstore
+
+import (
+	proto "github.com/golang/protobuf/proto"
+	plugin "github.com/spiffe/spire/proto/spire/common/plugin"
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// This is a compile-time assertion that a sufficiently up-to-date version
+// of the legacy proto package is being used.
+const _ = proto.ProtoPackageIsVersion4
+
+type X509SVID struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SPIFFE ID of the SVID
+	SpiffeId string `protobuf:"bytes,1,opt,name=spiffe_id,json=spiffeId,proto3" json:"spiffe_id,omitempty"`
+	// ASN.1 DER encoded certificate chain, leaf first
+	CertChain [][]byte `protobuf:"bytes,2,rep,name=cert_chain,json=certChain,proto3" json:"cert_chain,omitempty"`
+	// PKCS#8 ASN.1 DER encoded private key
+	PrivateKey []byte `protobuf:"bytes,3,opt,name=private_key,json=privateKey,proto3" json:"private_key,omitempty"`
+	// ASN.1 DER encoded X.509 authorities of the trust domain bundle
+	Bundle [][]byte `protobuf:"bytes,4,rep,name=bundle,proto3" json:"bundle,omitempty"`
+	// When the SVID expires (seconds since Unix epoch)
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *X509SVID) Reset() {
+	*x = X509SVID{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X509SVID) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509SVID) ProtoMessage() {}
+
+func (x *X509SVID) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509SVID.ProtoReflect.Descriptor instead.
+func (*X509SVID) Descriptor() ([]byte, []int) {
+	return file_spire_agent_svidstore_svidstore_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *X509SVID) GetSpiffeId() string {
+	if x != nil {
+		return x.SpiffeId
+	}
+	return ""
+}
+
+func (x *X509SVID) GetCertChain() [][]byte {
+	if x != nil {
+		return x.CertChain
+	}
+	return nil
+}
+
+func (x *X509SVID) GetPrivateKey() []byte {
+	if x != nil {
+		return x.PrivateKey
+	}
+	return nil
+}
+
+func (x *X509SVID) GetBundle() [][]byte {
+	if x != nil {
+		return x.Bundle
+	}
+	return nil
+}
+
+func (x *X509SVID) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+type PutX509SVIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The X509-SVID to store
+	Svid *X509SVID `protobuf:"bytes,1,opt,name=svid,proto3" json:"svid,omitempty"`
+	// Metadata describing where and how to store the SVID. Each item has the
+	// form "key:value" and comes from the selectors of the registration
+	// entry (e.g. "secretname:my-secret").
+	Metadata []string `protobuf:"bytes,2,rep,name=metadata,proto3" json:"metadata,omitempty"`
+	// ASN.1 DER encoded X.509 authorities of the federated bundles the
+	// registration entry federates with, keyed by trust domain ID
+	FederatedBundles map[string][]byte `protobuf:"bytes,3,rep,name=federated_bundles,json=federatedBundles,proto3" json:"federated_bundles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *PutX509SVIDRequest) Reset() {
+	*x = PutX509SVIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutX509SVIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutX509SVIDRequest) ProtoMessage() {}
+
+func (x *PutX509SVIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutX509SVIDRequest.ProtoReflect.Descriptor instead.
+func (*PutX509SVIDRequest) Descriptor() ([]byte, []int) {
+	return file_spire_agent_svidstore_svidstore_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *PutX509SVIDRequest) GetSvid() *X509SVID {
+	if x != nil {
+		return x.Svid
+	}
+	return nil
+}
+
+func (x *PutX509SVIDRequest) GetMetadata() []string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+func (x *PutX509SVIDRequest) GetFederatedBundles() map[string][]byte {
+	if x != nil {
+		return x.FederatedBundles
+	}
+	return nil
+}
+
+type PutX509SVIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *PutX509SVIDResponse) Reset() {
+	*x = PutX509SVIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PutX509SVIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PutX509SVIDResponse) ProtoMessage() {}
+
+func (x *PutX509SVIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PutX509SVIDResponse.ProtoReflect.Descriptor instead.
+func (*PutX509SVIDResponse) Descriptor() ([]byte, []int) {
+	return file_spire_agent_svidstore_svidstore_proto_rawDescGZIP(), []int{2}
+}
+
+type DeleteX509SVIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Metadata of the stored SVID to delete. See PutX509SVIDRequest.
+	Metadata []string `protobuf:"bytes,1,rep,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *DeleteX509SVIDRequest) Reset() {
+	*x = DeleteX509SVIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteX509SVIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteX509SVIDRequest) ProtoMessage() {}
+
+func (x *DeleteX509SVIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteX509SVIDRequest.ProtoReflect.Descriptor instead.
+func (*DeleteX509SVIDRequest) Descriptor() ([]byte, []int) {
+	return file_spire_agent_svidstore_svidstore_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *DeleteX509SVIDRequest) GetMetadata() []string {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+type DeleteX509SVIDResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *DeleteX509SVIDResponse) Reset() {
+	*x = DeleteX509SVIDResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteX509SVIDResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteX509SVIDResponse) ProtoMessage() {}
+
+func (x *DeleteX509SVIDResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_agent_svidstore_svidstore_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteX509SVIDResponse.ProtoReflect.Descriptor instead.
+func (*DeleteX509SVIDResponse) Descriptor() ([]byte, []int) {
+	return file_spire_agent_svidstore_svidstore_proto_rawDescGZIP(), []int{4}
+}
+
+var File_spire_agent_svidstore_svidstore_proto protoreflect.FileDescriptor
+
+var file_spire_agent_svidstore_svidstore_proto_rawDesc = []byte{
+	0x0a, 0x25, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x76,
+	0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x15, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x1a, 0x20,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x22, 0x9e, 0x01, 0x0a, 0x08, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x49, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x65,
+	0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x09,
+	0x63, 0x65, 0x72, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1f, 0x0a, 0x0b, 0x70, 0x72, 0x69,
+	0x76, 0x61, 0x74, 0x65, 0x5f, 0x6b, 0x65, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x0a,
+	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x4b, 0x65, 0x79, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41,
+	0x74, 0x22, 0x98, 0x02, 0x0a, 0x12, 0x50, 0x75, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49,
+	0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x33, 0x0a, 0x04, 0x73, 0x76, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x58,
+	0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x04, 0x73, 0x76, 0x69, 0x64, 0x12, 0x1a, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x6c, 0x0a, 0x11, 0x66, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x18, 0x03,
+	0x20, 0x03, 0x28, 0x0b, 0x32, 0x3f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x67, 0x65,
+	0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x75, 0x74,
+	0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x10, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x1a, 0x43, 0x0a, 0x15, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x65, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79,
+	0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b,
+	0x65, 0x79, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0c, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x15, 0x0a, 0x13,
+	0x50, 0x75, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x33, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x58, 0x35, 0x30,
+	0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1a, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x18, 0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x32, 0xa4, 0x03, 0x0a, 0x09, 0x53, 0x56, 0x49, 0x44, 0x53, 0x74, 0x6f, 0x72, 0x65,
+	0x12, 0x64, 0x0a, 0x0b, 0x50, 0x75, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x12,
+	0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76,
+	0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53,
+	0x56, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x50, 0x75, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6d, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x12, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61,
+	0x67, 0x65, 0x6e, 0x74, 0x2e, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e,
+	0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75,
+	0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74,
+	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x73, 0x76, 0x69, 0x64, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_spire_agent_svidstore_svidstore_proto_rawDescOnce sync.Once
+	file_spire_agent_svidstore_svidstore_proto_rawDescData = file_spire_agent_svidstore_svidstore_proto_rawDesc
+)
+
+func file_spire_agent_svidstore_svidstore_proto_rawDescGZIP() []byte {
+	file_spire_agent_svidstore_svidstore_proto_rawDescOnce.Do(func() {
+		file_spire_agent_svidstore_svidstore_proto_rawDescData = protoimpl.X.CompressGZIP(file_spire_agent_svidstore_svidstore_proto_rawDescData)
+	})
+	return file_spire_agent_svidstore_svidstore_proto_rawDescData
+}
+
+var file_spire_agent_svidstore_svidstore_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_spire_agent_svidstore_svidstore_proto_goTypes = []interface{}{
+	(*X509SVID)(nil),                     // 0: spire.agent.svidstore.X509SVID
+	(*PutX509SVIDRequest)(nil),           // 1: spire.agent.svidstore.PutX509SVIDRequest
+	(*PutX509SVIDResponse)(nil),          // 2: spire.agent.svidstore.PutX509SVIDResponse
+	(*DeleteX509SVIDRequest)(nil),        // 3: spire.agent.svidstore.DeleteX509SVIDRequest
+	(*DeleteX509SVIDResponse)(nil),       // 4: spire.agent.svidstore.DeleteX509SVIDResponse
+	nil,                                  // 5: spire.agent.svidstore.PutX509SVIDRequest.FederatedBundlesEntry
+	(*plugin.ConfigureRequest)(nil),      // 6: spire.common.plugin.ConfigureRequest
+	(*plugin.GetPluginInfoRequest)(nil),  // 7: spire.common.plugin.GetPluginInfoRequest
+	(*plugin.ConfigureResponse)(nil),     // 8: spire.common.plugin.ConfigureResponse
+	(*plugin.GetPluginInfoResponse)(nil), // 9: spire.common.plugin.GetPluginInfoResponse
+}
+var file_spire_agent_svidstore_svidstore_proto_depIdxs = []int32{
+	0, // 0: spire.agent.svidstore.PutX509SVIDRequest.svid:type_name -> spire.agent.svidstore.X509SVID
+	5, // 1: spire.agent.svidstore.PutX509SVIDRequest.federated_bundles:type_name -> spire.agent.svidstore.PutX509SVIDRequest.FederatedBundlesEntry
+	1, // 2: spire.agent.svidstore.SVIDStore.PutX509SVID:input_type -> spire.agent.svidstore.PutX509SVIDRequest
+	3, // 3: spire.agent.svidstore.SVIDStore.DeleteX509SVID:input_type -> spire.agent.svidstore.DeleteX509SVIDRequest
+	6, // 4: spire.agent.svidstore.SVIDStore.Configure:input_type -> spire.common.plugin.ConfigureRequest
+	7, // 5: spire.agent.svidstore.SVIDStore.GetPluginInfo:input_type -> spire.common.plugin.GetPluginInfoRequest
+	2, // 6: spire.agent.svidstore.SVIDStore.PutX509SVID:output_type -> spire.agent.svidstore.PutX509SVIDResponse
+	4, // 7: spire.agent.svidstore.SVIDStore.DeleteX509SVID:output_type -> spire.agent.svidstore.DeleteX509SVIDResponse
+	8, // 8: spire.agent.svidstore.SVIDStore.Configure:output_type -> spire.common.plugin.ConfigureResponse
+	9, // 9: spire.agent.svidstore.SVIDStore.GetPluginInfo:output_type -> spire.common.plugin.GetPluginInfoResponse
+	6, // [6:10] is the sub-list for method output_type
+	2, // [2:6] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
+}
+
+func init() { file_spire_agent_svidstore_svidstore_proto_init() }
+func file_spire_agent_svidstore_svidstore_proto_init() {
+	if File_spire_agent_svidstore_svidstore_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_spire_agent_svidstore_svidstore_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*X509SVID); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_agent_svidstore_svidstore_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutX509SVIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_agent_svidstore_svidstore_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PutX509SVIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_agent_svidstore_svidstore_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteX509SVIDRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_agent_svidstore_svidstore_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteX509SVIDResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_spire_agent_svidstore_svidstore_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   6,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_spire_agent_svidstore_svidstore_proto_goTypes,
+		DependencyIndexes: file_spire_agent_svidstore_svidstore_proto_depIdxs,
+		MessageInfos:      file_spire_agent_svidstore_svidstore_proto_msgTypes,
+	}.Build()
+	File_spire_agent_svidstore_svidstore_proto = out.File
+	file_spire_agent_svidstore_svidstore_proto_rawDesc = nil
+	file_spire_agent_svidstore_svidstore_proto_goTypes = nil
+	file_spire_agent_svidstore_svidstore_proto_depIdxs = nil
+}
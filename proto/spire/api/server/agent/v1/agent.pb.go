@@ -8,11 +8,11 @@ package agent
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	empty "github.com/golang/protobuf/ptypes/empty"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	types "github.com/spiffe/spire/proto/spire/types"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -649,6 +649,171 @@ func (x *CreateJoinTokenRequest) GetAgentId() *types.SPIFFEID {
 	return nil
 }
 
+type ListJoinTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The maximum number of results to return. The server may further
+	// constrain this value, or if zero, choose its own.
+	PageSize int32 `protobuf:"varint,1,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// The next_page_token value returned from a previous request, if any.
+	PageToken string `protobuf:"bytes,2,opt,name=page_token,json=pageToken,proto3" json:"page_token,omitempty"`
+}
+
+func (x *ListJoinTokensRequest) Reset() {
+	*x = ListJoinTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJoinTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinTokensRequest) ProtoMessage() {}
+
+func (x *ListJoinTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinTokensRequest) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_agent_v1_agent_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ListJoinTokensRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListJoinTokensRequest) GetPageToken() string {
+	if x != nil {
+		return x.PageToken
+	}
+	return ""
+}
+
+type ListJoinTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The join tokens.
+	JoinTokens []*types.JoinToken `protobuf:"bytes,1,rep,name=join_tokens,json=joinTokens,proto3" json:"join_tokens,omitempty"`
+	// The page token for the next request. Empty if there are no more results.
+	// This field should be checked by clients even when a page_size was not
+	// requested, since the server may choose its own (see page_size).
+	NextPageToken string `protobuf:"bytes,2,opt,name=next_page_token,json=nextPageToken,proto3" json:"next_page_token,omitempty"`
+}
+
+func (x *ListJoinTokensResponse) Reset() {
+	*x = ListJoinTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJoinTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinTokensResponse) ProtoMessage() {}
+
+func (x *ListJoinTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinTokensResponse) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_agent_v1_agent_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *ListJoinTokensResponse) GetJoinTokens() []*types.JoinToken {
+	if x != nil {
+		return x.JoinTokens
+	}
+	return nil
+}
+
+func (x *ListJoinTokensResponse) GetNextPageToken() string {
+	if x != nil {
+		return x.NextPageToken
+	}
+	return ""
+}
+
+type RevokeJoinTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Required. The value of the token.
+	Value string `protobuf:"bytes,1,opt,name=value,proto3" json:"value,omitempty"`
+}
+
+func (x *RevokeJoinTokenRequest) Reset() {
+	*x = RevokeJoinTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RevokeJoinTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RevokeJoinTokenRequest) ProtoMessage() {}
+
+func (x *RevokeJoinTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RevokeJoinTokenRequest.ProtoReflect.Descriptor instead.
+func (*RevokeJoinTokenRequest) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_agent_v1_agent_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *RevokeJoinTokenRequest) GetValue() string {
+	if x != nil {
+		return x.Value
+	}
+	return ""
+}
+
 type AgentX509SVIDParams struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -663,7 +828,7 @@ type AgentX509SVIDParams struct {
 func (x *AgentX509SVIDParams) Reset() {
 	*x = AgentX509SVIDParams{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[10]
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[13]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -676,7 +841,7 @@ func (x *AgentX509SVIDParams) String() string {
 func (*AgentX509SVIDParams) ProtoMessage() {}
 
 func (x *AgentX509SVIDParams) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[10]
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[13]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -689,7 +854,7 @@ func (x *AgentX509SVIDParams) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AgentX509SVIDParams.ProtoReflect.Descriptor instead.
 func (*AgentX509SVIDParams) Descriptor() ([]byte, []int) {
-	return file_spire_api_server_agent_v1_agent_proto_rawDescGZIP(), []int{10}
+	return file_spire_api_server_agent_v1_agent_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *AgentX509SVIDParams) GetCsr() []byte {
@@ -709,13 +874,13 @@ type ListAgentsRequest_Filter struct {
 	// Filters agents to those satisfying the selector match.
 	BySelectorMatch *types.SelectorMatch `protobuf:"bytes,2,opt,name=by_selector_match,json=bySelectorMatch,proto3" json:"by_selector_match,omitempty"`
 	// Filters agents to those that are banned.
-	ByBanned *wrapperspb.BoolValue `protobuf:"bytes,3,opt,name=by_banned,json=byBanned,proto3" json:"by_banned,omitempty"`
+	ByBanned *wrappers.BoolValue `protobuf:"bytes,3,opt,name=by_banned,json=byBanned,proto3" json:"by_banned,omitempty"`
 }
 
 func (x *ListAgentsRequest_Filter) Reset() {
 	*x = ListAgentsRequest_Filter{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[11]
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -728,7 +893,7 @@ func (x *ListAgentsRequest_Filter) String() string {
 func (*ListAgentsRequest_Filter) ProtoMessage() {}
 
 func (x *ListAgentsRequest_Filter) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[11]
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -758,7 +923,7 @@ func (x *ListAgentsRequest_Filter) GetBySelectorMatch() *types.SelectorMatch {
 	return nil
 }
 
-func (x *ListAgentsRequest_Filter) GetByBanned() *wrapperspb.BoolValue {
+func (x *ListAgentsRequest_Filter) GetByBanned() *wrappers.BoolValue {
 	if x != nil {
 		return x.ByBanned
 	}
@@ -779,7 +944,7 @@ type AttestAgentRequest_Params struct {
 func (x *AttestAgentRequest_Params) Reset() {
 	*x = AttestAgentRequest_Params{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[12]
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -792,7 +957,7 @@ func (x *AttestAgentRequest_Params) String() string {
 func (*AttestAgentRequest_Params) ProtoMessage() {}
 
 func (x *AttestAgentRequest_Params) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[12]
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -834,7 +999,7 @@ type AttestAgentResponse_Result struct {
 func (x *AttestAgentResponse_Result) Reset() {
 	*x = AttestAgentResponse_Result{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[13]
+		mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -847,7 +1012,7 @@ func (x *AttestAgentResponse_Result) String() string {
 func (*AttestAgentResponse_Result) ProtoMessage() {}
 
 func (x *AttestAgentResponse_Result) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[13]
+	mi := &file_spire_api_server_agent_v1_agent_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -985,10 +1150,26 @@ var file_spire_api_server_agent_v1_agent_proto_rawDesc = []byte{
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x30, 0x0a, 0x08, 0x61,
 	0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53, 0x50, 0x49, 0x46,
-	0x46, 0x45, 0x49, 0x44, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x27, 0x0a,
+	0x46, 0x45, 0x49, 0x44, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x53, 0x0a,
+	0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x70, 0x61, 0x67, 0x65, 0x53,
+	0x69, 0x7a, 0x65, 0x12, 0x1d, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x79, 0x0a, 0x16, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x0b,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x16, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0f, 0x6e, 0x65, 0x78, 0x74, 0x5f, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x6e, 0x65, 0x78, 0x74, 0x50, 0x61, 0x67, 0x65, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a,
+	0x16, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x22, 0x27, 0x0a,
 	0x13, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x58, 0x35, 0x30, 0x39, 0x53, 0x56, 0x49, 0x44, 0x50, 0x61,
 	0x72, 0x61, 0x6d, 0x73, 0x12, 0x10, 0x0a, 0x03, 0x63, 0x73, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x0c, 0x52, 0x03, 0x63, 0x73, 0x72, 0x32, 0x9f, 0x05, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74,
+	0x0c, 0x52, 0x03, 0x63, 0x73, 0x72, 0x32, 0xf4, 0x06, 0x0a, 0x05, 0x41, 0x67, 0x65, 0x6e, 0x74,
 	0x12, 0x69, 0x0a, 0x0a, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x67, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x2c,
 	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
 	0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41,
@@ -1030,12 +1211,25 @@ var file_spire_api_server_agent_v1_agent_proto_rawDesc = []byte{
 	0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
 	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x16, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x4a,
-	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68,
-	0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70,
-	0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f,
-	0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x61, 0x67, 0x65, 0x6e, 0x74,
-	0x2f, 0x76, 0x31, 0x3b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x33,
+	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x75, 0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x67,
+	0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x5c, 0x0a, 0x0f, 0x52, 0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x65, 0x76, 0x6f, 0x6b, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x42, 0x3f, 0x5a,
+	0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66,
+	0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x61, 0x67, 0x65, 0x6e, 0x74, 0x2f, 0x76, 0x31, 0x3b, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -1050,7 +1244,7 @@ func file_spire_api_server_agent_v1_agent_proto_rawDescGZIP() []byte {
 	return file_spire_api_server_agent_v1_agent_proto_rawDescData
 }
 
-var file_spire_api_server_agent_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 14)
+var file_spire_api_server_agent_v1_agent_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
 var file_spire_api_server_agent_v1_agent_proto_goTypes = []interface{}{
 	(*ListAgentsRequest)(nil),          // 0: spire.api.server.agent.v1.ListAgentsRequest
 	(*ListAgentsResponse)(nil),         // 1: spire.api.server.agent.v1.ListAgentsResponse
@@ -1062,57 +1256,65 @@ var file_spire_api_server_agent_v1_agent_proto_goTypes = []interface{}{
 	(*RenewAgentRequest)(nil),          // 7: spire.api.server.agent.v1.RenewAgentRequest
 	(*RenewAgentResponse)(nil),         // 8: spire.api.server.agent.v1.RenewAgentResponse
 	(*CreateJoinTokenRequest)(nil),     // 9: spire.api.server.agent.v1.CreateJoinTokenRequest
-	(*AgentX509SVIDParams)(nil),        // 10: spire.api.server.agent.v1.AgentX509SVIDParams
-	(*ListAgentsRequest_Filter)(nil),   // 11: spire.api.server.agent.v1.ListAgentsRequest.Filter
-	(*AttestAgentRequest_Params)(nil),  // 12: spire.api.server.agent.v1.AttestAgentRequest.Params
-	(*AttestAgentResponse_Result)(nil), // 13: spire.api.server.agent.v1.AttestAgentResponse.Result
-	(*types.AgentMask)(nil),            // 14: spire.types.AgentMask
-	(*types.Agent)(nil),                // 15: spire.types.Agent
-	(*types.SPIFFEID)(nil),             // 16: spire.types.SPIFFEID
-	(*types.X509SVID)(nil),             // 17: spire.types.X509SVID
-	(*types.SelectorMatch)(nil),        // 18: spire.types.SelectorMatch
-	(*wrapperspb.BoolValue)(nil),       // 19: google.protobuf.BoolValue
-	(*types.AttestationData)(nil),      // 20: spire.types.AttestationData
-	(*emptypb.Empty)(nil),              // 21: google.protobuf.Empty
-	(*types.JoinToken)(nil),            // 22: spire.types.JoinToken
+	(*ListJoinTokensRequest)(nil),      // 10: spire.api.server.agent.v1.ListJoinTokensRequest
+	(*ListJoinTokensResponse)(nil),     // 11: spire.api.server.agent.v1.ListJoinTokensResponse
+	(*RevokeJoinTokenRequest)(nil),     // 12: spire.api.server.agent.v1.RevokeJoinTokenRequest
+	(*AgentX509SVIDParams)(nil),        // 13: spire.api.server.agent.v1.AgentX509SVIDParams
+	(*ListAgentsRequest_Filter)(nil),   // 14: spire.api.server.agent.v1.ListAgentsRequest.Filter
+	(*AttestAgentRequest_Params)(nil),  // 15: spire.api.server.agent.v1.AttestAgentRequest.Params
+	(*AttestAgentResponse_Result)(nil), // 16: spire.api.server.agent.v1.AttestAgentResponse.Result
+	(*types.AgentMask)(nil),            // 17: spire.types.AgentMask
+	(*types.Agent)(nil),                // 18: spire.types.Agent
+	(*types.SPIFFEID)(nil),             // 19: spire.types.SPIFFEID
+	(*types.X509SVID)(nil),             // 20: spire.types.X509SVID
+	(*types.JoinToken)(nil),            // 21: spire.types.JoinToken
+	(*types.SelectorMatch)(nil),        // 22: spire.types.SelectorMatch
+	(*wrappers.BoolValue)(nil),         // 23: google.protobuf.BoolValue
+	(*types.AttestationData)(nil),      // 24: spire.types.AttestationData
+	(*empty.Empty)(nil),                // 25: google.protobuf.Empty
 }
 var file_spire_api_server_agent_v1_agent_proto_depIdxs = []int32{
-	11, // 0: spire.api.server.agent.v1.ListAgentsRequest.filter:type_name -> spire.api.server.agent.v1.ListAgentsRequest.Filter
-	14, // 1: spire.api.server.agent.v1.ListAgentsRequest.output_mask:type_name -> spire.types.AgentMask
-	15, // 2: spire.api.server.agent.v1.ListAgentsResponse.agents:type_name -> spire.types.Agent
-	16, // 3: spire.api.server.agent.v1.GetAgentRequest.id:type_name -> spire.types.SPIFFEID
-	14, // 4: spire.api.server.agent.v1.GetAgentRequest.output_mask:type_name -> spire.types.AgentMask
-	16, // 5: spire.api.server.agent.v1.DeleteAgentRequest.id:type_name -> spire.types.SPIFFEID
-	16, // 6: spire.api.server.agent.v1.BanAgentRequest.id:type_name -> spire.types.SPIFFEID
-	12, // 7: spire.api.server.agent.v1.AttestAgentRequest.params:type_name -> spire.api.server.agent.v1.AttestAgentRequest.Params
-	13, // 8: spire.api.server.agent.v1.AttestAgentResponse.result:type_name -> spire.api.server.agent.v1.AttestAgentResponse.Result
-	10, // 9: spire.api.server.agent.v1.RenewAgentRequest.params:type_name -> spire.api.server.agent.v1.AgentX509SVIDParams
-	17, // 10: spire.api.server.agent.v1.RenewAgentResponse.svid:type_name -> spire.types.X509SVID
-	16, // 11: spire.api.server.agent.v1.CreateJoinTokenRequest.agent_id:type_name -> spire.types.SPIFFEID
-	18, // 12: spire.api.server.agent.v1.ListAgentsRequest.Filter.by_selector_match:type_name -> spire.types.SelectorMatch
-	19, // 13: spire.api.server.agent.v1.ListAgentsRequest.Filter.by_banned:type_name -> google.protobuf.BoolValue
-	20, // 14: spire.api.server.agent.v1.AttestAgentRequest.Params.data:type_name -> spire.types.AttestationData
-	10, // 15: spire.api.server.agent.v1.AttestAgentRequest.Params.params:type_name -> spire.api.server.agent.v1.AgentX509SVIDParams
-	17, // 16: spire.api.server.agent.v1.AttestAgentResponse.Result.svid:type_name -> spire.types.X509SVID
-	0,  // 17: spire.api.server.agent.v1.Agent.ListAgents:input_type -> spire.api.server.agent.v1.ListAgentsRequest
-	2,  // 18: spire.api.server.agent.v1.Agent.GetAgent:input_type -> spire.api.server.agent.v1.GetAgentRequest
-	3,  // 19: spire.api.server.agent.v1.Agent.DeleteAgent:input_type -> spire.api.server.agent.v1.DeleteAgentRequest
-	4,  // 20: spire.api.server.agent.v1.Agent.BanAgent:input_type -> spire.api.server.agent.v1.BanAgentRequest
-	5,  // 21: spire.api.server.agent.v1.Agent.AttestAgent:input_type -> spire.api.server.agent.v1.AttestAgentRequest
-	7,  // 22: spire.api.server.agent.v1.Agent.RenewAgent:input_type -> spire.api.server.agent.v1.RenewAgentRequest
-	9,  // 23: spire.api.server.agent.v1.Agent.CreateJoinToken:input_type -> spire.api.server.agent.v1.CreateJoinTokenRequest
-	1,  // 24: spire.api.server.agent.v1.Agent.ListAgents:output_type -> spire.api.server.agent.v1.ListAgentsResponse
-	15, // 25: spire.api.server.agent.v1.Agent.GetAgent:output_type -> spire.types.Agent
-	21, // 26: spire.api.server.agent.v1.Agent.DeleteAgent:output_type -> google.protobuf.Empty
-	21, // 27: spire.api.server.agent.v1.Agent.BanAgent:output_type -> google.protobuf.Empty
-	6,  // 28: spire.api.server.agent.v1.Agent.AttestAgent:output_type -> spire.api.server.agent.v1.AttestAgentResponse
-	8,  // 29: spire.api.server.agent.v1.Agent.RenewAgent:output_type -> spire.api.server.agent.v1.RenewAgentResponse
-	22, // 30: spire.api.server.agent.v1.Agent.CreateJoinToken:output_type -> spire.types.JoinToken
-	24, // [24:31] is the sub-list for method output_type
-	17, // [17:24] is the sub-list for method input_type
-	17, // [17:17] is the sub-list for extension type_name
-	17, // [17:17] is the sub-list for extension extendee
-	0,  // [0:17] is the sub-list for field type_name
+	14, // 0: spire.api.server.agent.v1.ListAgentsRequest.filter:type_name -> spire.api.server.agent.v1.ListAgentsRequest.Filter
+	17, // 1: spire.api.server.agent.v1.ListAgentsRequest.output_mask:type_name -> spire.types.AgentMask
+	18, // 2: spire.api.server.agent.v1.ListAgentsResponse.agents:type_name -> spire.types.Agent
+	19, // 3: spire.api.server.agent.v1.GetAgentRequest.id:type_name -> spire.types.SPIFFEID
+	17, // 4: spire.api.server.agent.v1.GetAgentRequest.output_mask:type_name -> spire.types.AgentMask
+	19, // 5: spire.api.server.agent.v1.DeleteAgentRequest.id:type_name -> spire.types.SPIFFEID
+	19, // 6: spire.api.server.agent.v1.BanAgentRequest.id:type_name -> spire.types.SPIFFEID
+	15, // 7: spire.api.server.agent.v1.AttestAgentRequest.params:type_name -> spire.api.server.agent.v1.AttestAgentRequest.Params
+	16, // 8: spire.api.server.agent.v1.AttestAgentResponse.result:type_name -> spire.api.server.agent.v1.AttestAgentResponse.Result
+	13, // 9: spire.api.server.agent.v1.RenewAgentRequest.params:type_name -> spire.api.server.agent.v1.AgentX509SVIDParams
+	20, // 10: spire.api.server.agent.v1.RenewAgentResponse.svid:type_name -> spire.types.X509SVID
+	19, // 11: spire.api.server.agent.v1.CreateJoinTokenRequest.agent_id:type_name -> spire.types.SPIFFEID
+	21, // 12: spire.api.server.agent.v1.ListJoinTokensResponse.join_tokens:type_name -> spire.types.JoinToken
+	22, // 13: spire.api.server.agent.v1.ListAgentsRequest.Filter.by_selector_match:type_name -> spire.types.SelectorMatch
+	23, // 14: spire.api.server.agent.v1.ListAgentsRequest.Filter.by_banned:type_name -> google.protobuf.BoolValue
+	24, // 15: spire.api.server.agent.v1.AttestAgentRequest.Params.data:type_name -> spire.types.AttestationData
+	13, // 16: spire.api.server.agent.v1.AttestAgentRequest.Params.params:type_name -> spire.api.server.agent.v1.AgentX509SVIDParams
+	20, // 17: spire.api.server.agent.v1.AttestAgentResponse.Result.svid:type_name -> spire.types.X509SVID
+	0,  // 18: spire.api.server.agent.v1.Agent.ListAgents:input_type -> spire.api.server.agent.v1.ListAgentsRequest
+	2,  // 19: spire.api.server.agent.v1.Agent.GetAgent:input_type -> spire.api.server.agent.v1.GetAgentRequest
+	3,  // 20: spire.api.server.agent.v1.Agent.DeleteAgent:input_type -> spire.api.server.agent.v1.DeleteAgentRequest
+	4,  // 21: spire.api.server.agent.v1.Agent.BanAgent:input_type -> spire.api.server.agent.v1.BanAgentRequest
+	5,  // 22: spire.api.server.agent.v1.Agent.AttestAgent:input_type -> spire.api.server.agent.v1.AttestAgentRequest
+	7,  // 23: spire.api.server.agent.v1.Agent.RenewAgent:input_type -> spire.api.server.agent.v1.RenewAgentRequest
+	9,  // 24: spire.api.server.agent.v1.Agent.CreateJoinToken:input_type -> spire.api.server.agent.v1.CreateJoinTokenRequest
+	10, // 25: spire.api.server.agent.v1.Agent.ListJoinTokens:input_type -> spire.api.server.agent.v1.ListJoinTokensRequest
+	12, // 26: spire.api.server.agent.v1.Agent.RevokeJoinToken:input_type -> spire.api.server.agent.v1.RevokeJoinTokenRequest
+	1,  // 27: spire.api.server.agent.v1.Agent.ListAgents:output_type -> spire.api.server.agent.v1.ListAgentsResponse
+	18, // 28: spire.api.server.agent.v1.Agent.GetAgent:output_type -> spire.types.Agent
+	25, // 29: spire.api.server.agent.v1.Agent.DeleteAgent:output_type -> google.protobuf.Empty
+	25, // 30: spire.api.server.agent.v1.Agent.BanAgent:output_type -> google.protobuf.Empty
+	6,  // 31: spire.api.server.agent.v1.Agent.AttestAgent:output_type -> spire.api.server.agent.v1.AttestAgentResponse
+	8,  // 32: spire.api.server.agent.v1.Agent.RenewAgent:output_type -> spire.api.server.agent.v1.RenewAgentResponse
+	21, // 33: spire.api.server.agent.v1.Agent.CreateJoinToken:output_type -> spire.types.JoinToken
+	11, // 34: spire.api.server.agent.v1.Agent.ListJoinTokens:output_type -> spire.api.server.agent.v1.ListJoinTokensResponse
+	25, // 35: spire.api.server.agent.v1.Agent.RevokeJoinToken:output_type -> google.protobuf.Empty
+	27, // [27:36] is the sub-list for method output_type
+	18, // [18:27] is the sub-list for method input_type
+	18, // [18:18] is the sub-list for extension type_name
+	18, // [18:18] is the sub-list for extension extendee
+	0,  // [0:18] is the sub-list for field type_name
 }
 
 func init() { file_spire_api_server_agent_v1_agent_proto_init() }
@@ -1242,7 +1444,7 @@ func file_spire_api_server_agent_v1_agent_proto_init() {
 			}
 		}
 		file_spire_api_server_agent_v1_agent_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AgentX509SVIDParams); i {
+			switch v := v.(*ListJoinTokensRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1254,7 +1456,7 @@ func file_spire_api_server_agent_v1_agent_proto_init() {
 			}
 		}
 		file_spire_api_server_agent_v1_agent_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListAgentsRequest_Filter); i {
+			switch v := v.(*ListJoinTokensResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1266,7 +1468,7 @@ func file_spire_api_server_agent_v1_agent_proto_init() {
 			}
 		}
 		file_spire_api_server_agent_v1_agent_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AttestAgentRequest_Params); i {
+			switch v := v.(*RevokeJoinTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -1278,6 +1480,42 @@ func file_spire_api_server_agent_v1_agent_proto_init() {
 			}
 		}
 		file_spire_api_server_agent_v1_agent_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AgentX509SVIDParams); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_agent_v1_agent_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAgentsRequest_Filter); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_agent_v1_agent_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AttestAgentRequest_Params); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_agent_v1_agent_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*AttestAgentResponse_Result); i {
 			case 0:
 				return &v.state
@@ -1304,7 +1542,7 @@ func file_spire_api_server_agent_v1_agent_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spire_api_server_agent_v1_agent_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   14,
+			NumMessages:   17,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
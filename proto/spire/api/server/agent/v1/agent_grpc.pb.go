@@ -4,11 +4,11 @@ package agent
 
 import (
 	context "context"
+	empty "github.com/golang/protobuf/ptypes/empty"
 	types "github.com/spiffe/spire/proto/spire/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
-	emptypb "google.golang.org/protobuf/types/known/emptypb"
 )
 
 // This is a compile-time assertion to ensure that this generated file
@@ -31,13 +31,13 @@ type AgentClient interface {
 	// the Issuer AttestAgent RPC.
 	//
 	// The caller must be local or present an admin X509-SVID.
-	DeleteAgent(ctx context.Context, in *DeleteAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAgent(ctx context.Context, in *DeleteAgentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Bans an agent. This evicts the agent and prevents it from rejoining the
 	// trust domain through attestation until the ban is lifted via a call to
 	// DeleteAgent.
 	//
 	// The caller must be local or present an admin X509-SVID.
-	BanAgent(ctx context.Context, in *BanAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	BanAgent(ctx context.Context, in *BanAgentRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 	// Attests the agent via node attestation, using a bidirectional stream to
 	// faciliate attestation methods that require challenge/response.
 	//
@@ -54,6 +54,18 @@ type AgentClient interface {
 	//
 	// The caller must be local or present an admin X509-SVID.
 	CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*types.JoinToken, error)
+	// Lists the join tokens that have not been used yet. Expired tokens are
+	// listed until the server prunes them.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ListJoinTokens(ctx context.Context, in *ListJoinTokensRequest, opts ...grpc.CallOption) (*ListJoinTokensResponse, error)
+	// Revokes a join token that has not been used yet, so it can no longer
+	// be used for attestation. The entry created for the agent ID of the
+	// token, if any, is deleted as well. If the token does not exist,
+	// NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	RevokeJoinToken(ctx context.Context, in *RevokeJoinTokenRequest, opts ...grpc.CallOption) (*empty.Empty, error)
 }
 
 type agentClient struct {
@@ -82,8 +94,8 @@ func (c *agentClient) GetAgent(ctx context.Context, in *GetAgentRequest, opts ..
 	return out, nil
 }
 
-func (c *agentClient) DeleteAgent(ctx context.Context, in *DeleteAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *agentClient) DeleteAgent(ctx context.Context, in *DeleteAgentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/spire.api.server.agent.v1.Agent/DeleteAgent", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -91,8 +103,8 @@ func (c *agentClient) DeleteAgent(ctx context.Context, in *DeleteAgentRequest, o
 	return out, nil
 }
 
-func (c *agentClient) BanAgent(ctx context.Context, in *BanAgentRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
-	out := new(emptypb.Empty)
+func (c *agentClient) BanAgent(ctx context.Context, in *BanAgentRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
 	err := c.cc.Invoke(ctx, "/spire.api.server.agent.v1.Agent/BanAgent", in, out, opts...)
 	if err != nil {
 		return nil, err
@@ -149,6 +161,24 @@ func (c *agentClient) CreateJoinToken(ctx context.Context, in *CreateJoinTokenRe
 	return out, nil
 }
 
+func (c *agentClient) ListJoinTokens(ctx context.Context, in *ListJoinTokensRequest, opts ...grpc.CallOption) (*ListJoinTokensResponse, error) {
+	out := new(ListJoinTokensResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.agent.v1.Agent/ListJoinTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentClient) RevokeJoinToken(ctx context.Context, in *RevokeJoinTokenRequest, opts ...grpc.CallOption) (*empty.Empty, error) {
+	out := new(empty.Empty)
+	err := c.cc.Invoke(ctx, "/spire.api.server.agent.v1.Agent/RevokeJoinToken", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // AgentServer is the server API for Agent service.
 // All implementations must embed UnimplementedAgentServer
 // for forward compatibility
@@ -165,13 +195,13 @@ type AgentServer interface {
 	// the Issuer AttestAgent RPC.
 	//
 	// The caller must be local or present an admin X509-SVID.
-	DeleteAgent(context.Context, *DeleteAgentRequest) (*emptypb.Empty, error)
+	DeleteAgent(context.Context, *DeleteAgentRequest) (*empty.Empty, error)
 	// Bans an agent. This evicts the agent and prevents it from rejoining the
 	// trust domain through attestation until the ban is lifted via a call to
 	// DeleteAgent.
 	//
 	// The caller must be local or present an admin X509-SVID.
-	BanAgent(context.Context, *BanAgentRequest) (*emptypb.Empty, error)
+	BanAgent(context.Context, *BanAgentRequest) (*empty.Empty, error)
 	// Attests the agent via node attestation, using a bidirectional stream to
 	// faciliate attestation methods that require challenge/response.
 	//
@@ -188,6 +218,18 @@ type AgentServer interface {
 	//
 	// The caller must be local or present an admin X509-SVID.
 	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*types.JoinToken, error)
+	// Lists the join tokens that have not been used yet. Expired tokens are
+	// listed until the server prunes them.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error)
+	// Revokes a join token that has not been used yet, so it can no longer
+	// be used for attestation. The entry created for the agent ID of the
+	// token, if any, is deleted as well. If the token does not exist,
+	// NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	RevokeJoinToken(context.Context, *RevokeJoinTokenRequest) (*empty.Empty, error)
 	mustEmbedUnimplementedAgentServer()
 }
 
@@ -201,10 +243,10 @@ func (UnimplementedAgentServer) ListAgents(context.Context, *ListAgentsRequest)
 func (UnimplementedAgentServer) GetAgent(context.Context, *GetAgentRequest) (*types.Agent, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAgent not implemented")
 }
-func (UnimplementedAgentServer) DeleteAgent(context.Context, *DeleteAgentRequest) (*emptypb.Empty, error) {
+func (UnimplementedAgentServer) DeleteAgent(context.Context, *DeleteAgentRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteAgent not implemented")
 }
-func (UnimplementedAgentServer) BanAgent(context.Context, *BanAgentRequest) (*emptypb.Empty, error) {
+func (UnimplementedAgentServer) BanAgent(context.Context, *BanAgentRequest) (*empty.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BanAgent not implemented")
 }
 func (UnimplementedAgentServer) AttestAgent(Agent_AttestAgentServer) error {
@@ -216,6 +258,12 @@ func (UnimplementedAgentServer) RenewAgent(context.Context, *RenewAgentRequest)
 func (UnimplementedAgentServer) CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*types.JoinToken, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateJoinToken not implemented")
 }
+func (UnimplementedAgentServer) ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinTokens not implemented")
+}
+func (UnimplementedAgentServer) RevokeJoinToken(context.Context, *RevokeJoinTokenRequest) (*empty.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeJoinToken not implemented")
+}
 func (UnimplementedAgentServer) mustEmbedUnimplementedAgentServer() {}
 
 // UnsafeAgentServer may be embedded to opt out of forward compatibility for this service.
@@ -363,6 +411,42 @@ func _Agent_CreateJoinToken_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Agent_ListJoinTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).ListJoinTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.agent.v1.Agent/ListJoinTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).ListJoinTokens(ctx, req.(*ListJoinTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Agent_RevokeJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeJoinTokenRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServer).RevokeJoinToken(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.agent.v1.Agent/RevokeJoinToken",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServer).RevokeJoinToken(ctx, req.(*RevokeJoinTokenRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Agent_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "spire.api.server.agent.v1.Agent",
 	HandlerType: (*AgentServer)(nil),
@@ -391,6 +475,14 @@ var _Agent_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateJoinToken",
 			Handler:    _Agent_CreateJoinToken_Handler,
 		},
+		{
+			MethodName: "ListJoinTokens",
+			Handler:    _Agent_ListJoinTokens_Handler,
+		},
+		{
+			MethodName: "RevokeJoinToken",
+			Handler:    _Agent_RevokeJoinToken_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -24,11 +24,28 @@ type BundleClient interface {
 	GetBundle(ctx context.Context, in *GetBundleRequest, opts ...grpc.CallOption) (*types.Bundle, error)
 	// Append to the bundle. Items specified in the bundle in the request are
 	// appended to the existing bundle. If the bundle does not exist, NOT_FOUND
-	// is returned. This is the only RPC that can be used to update the
-	// bundle for the trust domain of the SPIRE server.
+	// is returned. Along with PruneBundle, this is the only RPC that can be
+	// used to update the bundle for the trust domain of the SPIRE server.
 	//
 	// The caller must be local or present an admin X509-SVID.
 	AppendBundle(ctx context.Context, in *AppendBundleRequest, opts ...grpc.CallOption) (*types.Bundle, error)
+	// Prunes the X.509 and JWT authorities that expired before the given time
+	// from the bundle for the trust domain of the server. The server already
+	// prunes authorities periodically; this RPC allows pruning them sooner.
+	// If pruning would remove all of the X.509 or all of the JWT authorities,
+	// the bundle is left untouched and FAILED_PRECONDITION is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	PruneBundle(ctx context.Context, in *PruneBundleRequest, opts ...grpc.CallOption) (*PruneBundleResponse, error)
+	// Watches the bundle for the trust domain of the server. The current
+	// bundle is sent when the stream is opened, and then again each time it
+	// changes, whether through the CA rotating its authorities, pruning, or
+	// any other server sharing the datastore. Changes are detected within a
+	// few seconds. If the bundle does not exist when the stream is opened,
+	// NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	WatchBundle(ctx context.Context, in *WatchBundleRequest, opts ...grpc.CallOption) (Bundle_WatchBundleClient, error)
 	// Publishes a downstream JWT authority to the SPIRE server. If the server
 	// is itself a downstream server (i.e. configured with an UpstreamAuthority
 	// plugin), the JWT authority is published further upstream using the
@@ -90,6 +107,47 @@ func (c *bundleClient) AppendBundle(ctx context.Context, in *AppendBundleRequest
 	return out, nil
 }
 
+func (c *bundleClient) PruneBundle(ctx context.Context, in *PruneBundleRequest, opts ...grpc.CallOption) (*PruneBundleResponse, error) {
+	out := new(PruneBundleResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.bundle.v1.Bundle/PruneBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundleClient) WatchBundle(ctx context.Context, in *WatchBundleRequest, opts ...grpc.CallOption) (Bundle_WatchBundleClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_Bundle_serviceDesc.Streams[0], "/spire.api.server.bundle.v1.Bundle/WatchBundle", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &bundleWatchBundleClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Bundle_WatchBundleClient interface {
+	Recv() (*WatchBundleResponse, error)
+	grpc.ClientStream
+}
+
+type bundleWatchBundleClient struct {
+	grpc.ClientStream
+}
+
+func (x *bundleWatchBundleClient) Recv() (*WatchBundleResponse, error) {
+	m := new(WatchBundleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *bundleClient) PublishJWTAuthority(ctx context.Context, in *PublishJWTAuthorityRequest, opts ...grpc.CallOption) (*PublishJWTAuthorityResponse, error) {
 	out := new(PublishJWTAuthorityResponse)
 	err := c.cc.Invoke(ctx, "/spire.api.server.bundle.v1.Bundle/PublishJWTAuthority", in, out, opts...)
@@ -163,11 +221,28 @@ type BundleServer interface {
 	GetBundle(context.Context, *GetBundleRequest) (*types.Bundle, error)
 	// Append to the bundle. Items specified in the bundle in the request are
 	// appended to the existing bundle. If the bundle does not exist, NOT_FOUND
-	// is returned. This is the only RPC that can be used to update the
-	// bundle for the trust domain of the SPIRE server.
+	// is returned. Along with PruneBundle, this is the only RPC that can be
+	// used to update the bundle for the trust domain of the SPIRE server.
 	//
 	// The caller must be local or present an admin X509-SVID.
 	AppendBundle(context.Context, *AppendBundleRequest) (*types.Bundle, error)
+	// Prunes the X.509 and JWT authorities that expired before the given time
+	// from the bundle for the trust domain of the server. The server already
+	// prunes authorities periodically; this RPC allows pruning them sooner.
+	// If pruning would remove all of the X.509 or all of the JWT authorities,
+	// the bundle is left untouched and FAILED_PRECONDITION is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	PruneBundle(context.Context, *PruneBundleRequest) (*PruneBundleResponse, error)
+	// Watches the bundle for the trust domain of the server. The current
+	// bundle is sent when the stream is opened, and then again each time it
+	// changes, whether through the CA rotating its authorities, pruning, or
+	// any other server sharing the datastore. Changes are detected within a
+	// few seconds. If the bundle does not exist when the stream is opened,
+	// NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	WatchBundle(*WatchBundleRequest, Bundle_WatchBundleServer) error
 	// Publishes a downstream JWT authority to the SPIRE server. If the server
 	// is itself a downstream server (i.e. configured with an UpstreamAuthority
 	// plugin), the JWT authority is published further upstream using the
@@ -214,6 +289,12 @@ func (UnimplementedBundleServer) GetBundle(context.Context, *GetBundleRequest) (
 func (UnimplementedBundleServer) AppendBundle(context.Context, *AppendBundleRequest) (*types.Bundle, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AppendBundle not implemented")
 }
+func (UnimplementedBundleServer) PruneBundle(context.Context, *PruneBundleRequest) (*PruneBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneBundle not implemented")
+}
+func (UnimplementedBundleServer) WatchBundle(*WatchBundleRequest, Bundle_WatchBundleServer) error {
+	return status.Errorf(codes.Unimplemented, "method WatchBundle not implemented")
+}
 func (UnimplementedBundleServer) PublishJWTAuthority(context.Context, *PublishJWTAuthorityRequest) (*PublishJWTAuthorityResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PublishJWTAuthority not implemented")
 }
@@ -284,6 +365,45 @@ func _Bundle_AppendBundle_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Bundle_PruneBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundleServer).PruneBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.bundle.v1.Bundle/PruneBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundleServer).PruneBundle(ctx, req.(*PruneBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Bundle_WatchBundle_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchBundleRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(BundleServer).WatchBundle(m, &bundleWatchBundleServer{stream})
+}
+
+type Bundle_WatchBundleServer interface {
+	Send(*WatchBundleResponse) error
+	grpc.ServerStream
+}
+
+type bundleWatchBundleServer struct {
+	grpc.ServerStream
+}
+
+func (x *bundleWatchBundleServer) Send(m *WatchBundleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 func _Bundle_PublishJWTAuthority_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PublishJWTAuthorityRequest)
 	if err := dec(in); err != nil {
@@ -422,6 +542,10 @@ var _Bundle_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AppendBundle",
 			Handler:    _Bundle_AppendBundle_Handler,
 		},
+		{
+			MethodName: "PruneBundle",
+			Handler:    _Bundle_PruneBundle_Handler,
+		},
 		{
 			MethodName: "PublishJWTAuthority",
 			Handler:    _Bundle_PublishJWTAuthority_Handler,
@@ -451,6 +575,12 @@ var _Bundle_serviceDesc = grpc.ServiceDesc{
 			Handler:    _Bundle_BatchDeleteFederatedBundle_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchBundle",
+			Handler:       _Bundle_WatchBundle_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "spire/api/server/bundle/v1/bundle.proto",
 }
@@ -26,29 +26,666 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
+type GetInfoResponse_CASlot_Kind int32
+
+const (
+	GetInfoResponse_CASlot_UNKNOWN_KIND GetInfoResponse_CASlot_Kind = 0
+	GetInfoResponse_CASlot_X509_CA      GetInfoResponse_CASlot_Kind = 1
+	GetInfoResponse_CASlot_JWT_KEY      GetInfoResponse_CASlot_Kind = 2
+)
+
+// Enum value maps for GetInfoResponse_CASlot_Kind.
+var (
+	GetInfoResponse_CASlot_Kind_name = map[int32]string{
+		0: "UNKNOWN_KIND",
+		1: "X509_CA",
+		2: "JWT_KEY",
+	}
+	GetInfoResponse_CASlot_Kind_value = map[string]int32{
+		"UNKNOWN_KIND": 0,
+		"X509_CA":      1,
+		"JWT_KEY":      2,
+	}
+)
+
+func (x GetInfoResponse_CASlot_Kind) Enum() *GetInfoResponse_CASlot_Kind {
+	p := new(GetInfoResponse_CASlot_Kind)
+	*p = x
+	return p
+}
+
+func (x GetInfoResponse_CASlot_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetInfoResponse_CASlot_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_spire_api_server_debug_v1_debug_proto_enumTypes[0].Descriptor()
+}
+
+func (GetInfoResponse_CASlot_Kind) Type() protoreflect.EnumType {
+	return &file_spire_api_server_debug_v1_debug_proto_enumTypes[0]
+}
+
+func (x GetInfoResponse_CASlot_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetInfoResponse_CASlot_Kind.Descriptor instead.
+func (GetInfoResponse_CASlot_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 2, 0}
+}
+
+type GetInfoResponse_CASlot_Status int32
+
+const (
+	GetInfoResponse_CASlot_UNKNOWN_STATUS GetInfoResponse_CASlot_Status = 0
+	// The slot is in use for signing
+	GetInfoResponse_CASlot_ACTIVE GetInfoResponse_CASlot_Status = 1
+	// The slot is prepared to be activated next
+	GetInfoResponse_CASlot_PREPARED GetInfoResponse_CASlot_Status = 2
+	// The slot has no key material
+	GetInfoResponse_CASlot_EMPTY GetInfoResponse_CASlot_Status = 3
+)
+
+// Enum value maps for GetInfoResponse_CASlot_Status.
+var (
+	GetInfoResponse_CASlot_Status_name = map[int32]string{
+		0: "UNKNOWN_STATUS",
+		1: "ACTIVE",
+		2: "PREPARED",
+		3: "EMPTY",
+	}
+	GetInfoResponse_CASlot_Status_value = map[string]int32{
+		"UNKNOWN_STATUS": 0,
+		"ACTIVE":         1,
+		"PREPARED":       2,
+		"EMPTY":          3,
+	}
+)
+
+func (x GetInfoResponse_CASlot_Status) Enum() *GetInfoResponse_CASlot_Status {
+	p := new(GetInfoResponse_CASlot_Status)
+	*p = x
+	return p
+}
+
+func (x GetInfoResponse_CASlot_Status) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (GetInfoResponse_CASlot_Status) Descriptor() protoreflect.EnumDescriptor {
+	return file_spire_api_server_debug_v1_debug_proto_enumTypes[1].Descriptor()
+}
+
+func (GetInfoResponse_CASlot_Status) Type() protoreflect.EnumType {
+	return &file_spire_api_server_debug_v1_debug_proto_enumTypes[1]
+}
+
+func (x GetInfoResponse_CASlot_Status) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use GetInfoResponse_CASlot_Status.Descriptor instead.
+func (GetInfoResponse_CASlot_Status) EnumDescriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 2, 1}
+}
+
+type CAJournalProblem_Kind int32
+
+const (
+	CAJournalProblem_UNKNOWN_KIND CAJournalProblem_Kind = 0
+	CAJournalProblem_X509_CA      CAJournalProblem_Kind = 1
+	CAJournalProblem_JWT_KEY      CAJournalProblem_Kind = 2
+)
+
+// Enum value maps for CAJournalProblem_Kind.
+var (
+	CAJournalProblem_Kind_name = map[int32]string{
+		0: "UNKNOWN_KIND",
+		1: "X509_CA",
+		2: "JWT_KEY",
+	}
+	CAJournalProblem_Kind_value = map[string]int32{
+		"UNKNOWN_KIND": 0,
+		"X509_CA":      1,
+		"JWT_KEY":      2,
+	}
+)
+
+func (x CAJournalProblem_Kind) Enum() *CAJournalProblem_Kind {
+	p := new(CAJournalProblem_Kind)
+	*p = x
+	return p
+}
+
+func (x CAJournalProblem_Kind) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CAJournalProblem_Kind) Descriptor() protoreflect.EnumDescriptor {
+	return file_spire_api_server_debug_v1_debug_proto_enumTypes[2].Descriptor()
+}
+
+func (CAJournalProblem_Kind) Type() protoreflect.EnumType {
+	return &file_spire_api_server_debug_v1_debug_proto_enumTypes[2]
+}
+
+func (x CAJournalProblem_Kind) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CAJournalProblem_Kind.Descriptor instead.
+func (CAJournalProblem_Kind) EnumDescriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{2, 0}
+}
+
+type CAJournalProblem_Repair int32
+
+const (
+	CAJournalProblem_UNKNOWN_REPAIR CAJournalProblem_Repair = 0
+	// The entry is dropped from the journal and the slot prepared again
+	CAJournalProblem_DROP_ENTRY CAJournalProblem_Repair = 1
+	// The key material of the entry is appended to the bundle
+	CAJournalProblem_APPEND_BUNDLE CAJournalProblem_Repair = 2
+)
+
+// Enum value maps for CAJournalProblem_Repair.
+var (
+	CAJournalProblem_Repair_name = map[int32]string{
+		0: "UNKNOWN_REPAIR",
+		1: "DROP_ENTRY",
+		2: "APPEND_BUNDLE",
+	}
+	CAJournalProblem_Repair_value = map[string]int32{
+		"UNKNOWN_REPAIR": 0,
+		"DROP_ENTRY":     1,
+		"APPEND_BUNDLE":  2,
+	}
+)
+
+func (x CAJournalProblem_Repair) Enum() *CAJournalProblem_Repair {
+	p := new(CAJournalProblem_Repair)
+	*p = x
+	return p
+}
+
+func (x CAJournalProblem_Repair) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (CAJournalProblem_Repair) Descriptor() protoreflect.EnumDescriptor {
+	return file_spire_api_server_debug_v1_debug_proto_enumTypes[3].Descriptor()
+}
+
+func (CAJournalProblem_Repair) Type() protoreflect.EnumType {
+	return &file_spire_api_server_debug_v1_debug_proto_enumTypes[3]
+}
+
+func (x CAJournalProblem_Repair) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use CAJournalProblem_Repair.Descriptor instead.
+func (CAJournalProblem_Repair) EnumDescriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{2, 1}
+}
+
 type GetInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 }
 
-func (x *GetInfoRequest) Reset() {
-	*x = GetInfoRequest{}
+func (x *GetInfoRequest) Reset() {
+	*x = GetInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoRequest) ProtoMessage() {}
+
+func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
+func (*GetInfoRequest) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+}
+
+type GetInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Server SVID chain
+	SvidChain []*GetInfoResponse_Cert `protobuf:"bytes,1,rep,name=svid_chain,json=svidChain,proto3" json:"svid_chain,omitempty"`
+	// Server uptime in seconds
+	Uptime int32 `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
+	// Amount of registered agents
+	AgentsCount int32 `protobuf:"varint,3,opt,name=agents_count,json=agentsCount,proto3" json:"agents_count,omitempty"`
+	// Amount of federated bundles
+	FederatedBundlesCount int32 `protobuf:"varint,4,opt,name=federated_bundles_count,json=federatedBundlesCount,proto3" json:"federated_bundles_count,omitempty"`
+	// Amount of registration entries on database
+	EntriesCount int32 `protobuf:"varint,5,opt,name=entries_count,json=entriesCount,proto3" json:"entries_count,omitempty"`
+	// Server build information
+	BuildInfo *GetInfoResponse_BuildInfo `protobuf:"bytes,6,opt,name=build_info,json=buildInfo,proto3" json:"build_info,omitempty"`
+	// Server CA slots
+	CaSlots []*GetInfoResponse_CASlot `protobuf:"bytes,7,rep,name=ca_slots,json=caSlots,proto3" json:"ca_slots,omitempty"`
+	// Federation relationships the server refreshes bundles for
+	FederationRelationships []*GetInfoResponse_FederationRelationship `protobuf:"bytes,8,rep,name=federation_relationships,json=federationRelationships,proto3" json:"federation_relationships,omitempty"`
+	// URL other trust domains reach the bundle endpoint at, empty if the
+	// bundle endpoint is not served
+	BundleEndpointUrl string `protobuf:"bytes,9,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+}
+
+func (x *GetInfoResponse) Reset() {
+	*x = GetInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse) ProtoMessage() {}
+
+func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *GetInfoResponse) GetSvidChain() []*GetInfoResponse_Cert {
+	if x != nil {
+		return x.SvidChain
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetUptime() int32 {
+	if x != nil {
+		return x.Uptime
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetAgentsCount() int32 {
+	if x != nil {
+		return x.AgentsCount
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetFederatedBundlesCount() int32 {
+	if x != nil {
+		return x.FederatedBundlesCount
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetEntriesCount() int32 {
+	if x != nil {
+		return x.EntriesCount
+	}
+	return 0
+}
+
+func (x *GetInfoResponse) GetBuildInfo() *GetInfoResponse_BuildInfo {
+	if x != nil {
+		return x.BuildInfo
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetCaSlots() []*GetInfoResponse_CASlot {
+	if x != nil {
+		return x.CaSlots
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetFederationRelationships() []*GetInfoResponse_FederationRelationship {
+	if x != nil {
+		return x.FederationRelationships
+	}
+	return nil
+}
+
+func (x *GetInfoResponse) GetBundleEndpointUrl() string {
+	if x != nil {
+		return x.BundleEndpointUrl
+	}
+	return ""
+}
+
+type CAJournalProblem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Kind of key material held by the journal entry
+	Kind CAJournalProblem_Kind `protobuf:"varint,1,opt,name=kind,proto3,enum=spire.api.server.debug.v1.CAJournalProblem_Kind" json:"kind,omitempty"`
+	// Slot ID of the journal entry
+	SlotId string `protobuf:"bytes,2,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// Issuance time of the journal entry
+	IssuedAt int64 `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// What is wrong with the journal entry
+	Problem string `protobuf:"bytes,4,opt,name=problem,proto3" json:"problem,omitempty"`
+	// How the problem is repaired
+	Repair CAJournalProblem_Repair `protobuf:"varint,5,opt,name=repair,proto3,enum=spire.api.server.debug.v1.CAJournalProblem_Repair" json:"repair,omitempty"`
+}
+
+func (x *CAJournalProblem) Reset() {
+	*x = CAJournalProblem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CAJournalProblem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CAJournalProblem) ProtoMessage() {}
+
+func (x *CAJournalProblem) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CAJournalProblem.ProtoReflect.Descriptor instead.
+func (*CAJournalProblem) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *CAJournalProblem) GetKind() CAJournalProblem_Kind {
+	if x != nil {
+		return x.Kind
+	}
+	return CAJournalProblem_UNKNOWN_KIND
+}
+
+func (x *CAJournalProblem) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *CAJournalProblem) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *CAJournalProblem) GetProblem() string {
+	if x != nil {
+		return x.Problem
+	}
+	return ""
+}
+
+func (x *CAJournalProblem) GetRepair() CAJournalProblem_Repair {
+	if x != nil {
+		return x.Repair
+	}
+	return CAJournalProblem_UNKNOWN_REPAIR
+}
+
+type VerifyCAJournalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *VerifyCAJournalRequest) Reset() {
+	*x = VerifyCAJournalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCAJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCAJournalRequest) ProtoMessage() {}
+
+func (x *VerifyCAJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCAJournalRequest.ProtoReflect.Descriptor instead.
+func (*VerifyCAJournalRequest) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{3}
+}
+
+type VerifyCAJournalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Problems found in the journal
+	Problems []*CAJournalProblem `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (x *VerifyCAJournalResponse) Reset() {
+	*x = VerifyCAJournalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *VerifyCAJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*VerifyCAJournalResponse) ProtoMessage() {}
+
+func (x *VerifyCAJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use VerifyCAJournalResponse.ProtoReflect.Descriptor instead.
+func (*VerifyCAJournalResponse) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *VerifyCAJournalResponse) GetProblems() []*CAJournalProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type RepairCAJournalRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Drop every journal entry and prepare new keys, instead of repairing
+	// the problems found
+	Reinitialize bool `protobuf:"varint,1,opt,name=reinitialize,proto3" json:"reinitialize,omitempty"`
+}
+
+func (x *RepairCAJournalRequest) Reset() {
+	*x = RepairCAJournalRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairCAJournalRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairCAJournalRequest) ProtoMessage() {}
+
+func (x *RepairCAJournalRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairCAJournalRequest.ProtoReflect.Descriptor instead.
+func (*RepairCAJournalRequest) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *RepairCAJournalRequest) GetReinitialize() bool {
+	if x != nil {
+		return x.Reinitialize
+	}
+	return false
+}
+
+type RepairCAJournalResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Problems repaired
+	Problems []*CAJournalProblem `protobuf:"bytes,1,rep,name=problems,proto3" json:"problems,omitempty"`
+}
+
+func (x *RepairCAJournalResponse) Reset() {
+	*x = RepairCAJournalResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RepairCAJournalResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RepairCAJournalResponse) ProtoMessage() {}
+
+func (x *RepairCAJournalResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RepairCAJournalResponse.ProtoReflect.Descriptor instead.
+func (*RepairCAJournalResponse) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *RepairCAJournalResponse) GetProblems() []*CAJournalProblem {
+	if x != nil {
+		return x.Problems
+	}
+	return nil
+}
+
+type GetInfoResponse_Cert struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Certificate SPIFFE ID
+	Id *types.SPIFFEID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Expiration time
+	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// Subject
+	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+}
+
+func (x *GetInfoResponse_Cert) Reset() {
+	*x = GetInfoResponse_Cert{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[0]
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoRequest) String() string {
+func (x *GetInfoResponse_Cert) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoRequest) ProtoMessage() {}
+func (*GetInfoResponse_Cert) ProtoMessage() {}
 
-func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[0]
+func (x *GetInfoResponse_Cert) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -59,45 +696,60 @@ func (x *GetInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoRequest.ProtoReflect.Descriptor instead.
-func (*GetInfoRequest) Descriptor() ([]byte, []int) {
-	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{0}
+// Deprecated: Use GetInfoResponse_Cert.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse_Cert) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 0}
 }
 
-type GetInfoResponse struct {
+func (x *GetInfoResponse_Cert) GetId() *types.SPIFFEID {
+	if x != nil {
+		return x.Id
+	}
+	return nil
+}
+
+func (x *GetInfoResponse_Cert) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *GetInfoResponse_Cert) GetSubject() string {
+	if x != nil {
+		return x.Subject
+	}
+	return ""
+}
+
+type GetInfoResponse_BuildInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Server SVID chain
-	SvidChain []*GetInfoResponse_Cert `protobuf:"bytes,1,rep,name=svid_chain,json=svidChain,proto3" json:"svid_chain,omitempty"`
-	// Server uptime in seconds
-	Uptime int32 `protobuf:"varint,2,opt,name=uptime,proto3" json:"uptime,omitempty"`
-	// Amount of registered agents
-	AgentsCount int32 `protobuf:"varint,3,opt,name=agents_count,json=agentsCount,proto3" json:"agents_count,omitempty"`
-	// Amount of federated bundles
-	FederatedBundlesCount int32 `protobuf:"varint,4,opt,name=federated_bundles_count,json=federatedBundlesCount,proto3" json:"federated_bundles_count,omitempty"`
-	// Amount of registration entries on database
-	EntriesCount int32 `protobuf:"varint,5,opt,name=entries_count,json=entriesCount,proto3" json:"entries_count,omitempty"`
+	// SPIRE version
+	Version string `protobuf:"bytes,1,opt,name=version,proto3" json:"version,omitempty"`
+	// Version of Go the server was built with
+	GoVersion string `protobuf:"bytes,2,opt,name=go_version,json=goVersion,proto3" json:"go_version,omitempty"`
 }
 
-func (x *GetInfoResponse) Reset() {
-	*x = GetInfoResponse{}
+func (x *GetInfoResponse_BuildInfo) Reset() {
+	*x = GetInfoResponse_BuildInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[1]
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse) String() string {
+func (x *GetInfoResponse_BuildInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse) ProtoMessage() {}
+func (*GetInfoResponse_BuildInfo) ProtoMessage() {}
 
-func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[1]
+func (x *GetInfoResponse_BuildInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -108,76 +760,145 @@ func (x *GetInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse) Descriptor() ([]byte, []int) {
-	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1}
+// Deprecated: Use GetInfoResponse_BuildInfo.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse_BuildInfo) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 1}
 }
 
-func (x *GetInfoResponse) GetSvidChain() []*GetInfoResponse_Cert {
+func (x *GetInfoResponse_BuildInfo) GetVersion() string {
 	if x != nil {
-		return x.SvidChain
+		return x.Version
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInfoResponse) GetUptime() int32 {
+func (x *GetInfoResponse_BuildInfo) GetGoVersion() string {
 	if x != nil {
-		return x.Uptime
+		return x.GoVersion
 	}
-	return 0
+	return ""
 }
 
-func (x *GetInfoResponse) GetAgentsCount() int32 {
+type GetInfoResponse_CASlot struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Slot ID
+	Id string `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	// Kind of key material held by the slot
+	Kind GetInfoResponse_CASlot_Kind `protobuf:"varint,2,opt,name=kind,proto3,enum=spire.api.server.debug.v1.GetInfoResponse_CASlot_Kind" json:"kind,omitempty"`
+	// Status of the slot
+	Status GetInfoResponse_CASlot_Status `protobuf:"varint,3,opt,name=status,proto3,enum=spire.api.server.debug.v1.GetInfoResponse_CASlot_Status" json:"status,omitempty"`
+	// Issuance time, zero for empty slots
+	IssuedAt int64 `protobuf:"varint,4,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// Expiration time, zero for empty slots
+	ExpiresAt int64 `protobuf:"varint,5,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (x *GetInfoResponse_CASlot) Reset() {
+	*x = GetInfoResponse_CASlot{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetInfoResponse_CASlot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetInfoResponse_CASlot) ProtoMessage() {}
+
+func (x *GetInfoResponse_CASlot) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetInfoResponse_CASlot.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse_CASlot) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 2}
+}
+
+func (x *GetInfoResponse_CASlot) GetId() string {
 	if x != nil {
-		return x.AgentsCount
+		return x.Id
 	}
-	return 0
+	return ""
 }
 
-func (x *GetInfoResponse) GetFederatedBundlesCount() int32 {
+func (x *GetInfoResponse_CASlot) GetKind() GetInfoResponse_CASlot_Kind {
 	if x != nil {
-		return x.FederatedBundlesCount
+		return x.Kind
+	}
+	return GetInfoResponse_CASlot_UNKNOWN_KIND
+}
+
+func (x *GetInfoResponse_CASlot) GetStatus() GetInfoResponse_CASlot_Status {
+	if x != nil {
+		return x.Status
+	}
+	return GetInfoResponse_CASlot_UNKNOWN_STATUS
+}
+
+func (x *GetInfoResponse_CASlot) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
 	}
 	return 0
 }
 
-func (x *GetInfoResponse) GetEntriesCount() int32 {
+func (x *GetInfoResponse_CASlot) GetExpiresAt() int64 {
 	if x != nil {
-		return x.EntriesCount
+		return x.ExpiresAt
 	}
 	return 0
 }
 
-type GetInfoResponse_Cert struct {
+type GetInfoResponse_FederationRelationship struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Certificate SPIFFE ID
-	Id *types.SPIFFEID `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	// Expiration time
-	ExpiresAt int64 `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
-	// Subject
-	Subject string `protobuf:"bytes,3,opt,name=subject,proto3" json:"subject,omitempty"`
+	// Trust domain federated with
+	TrustDomain string `protobuf:"bytes,1,opt,name=trust_domain,json=trustDomain,proto3" json:"trust_domain,omitempty"`
+	// URL of the bundle endpoint of the trust domain
+	BundleEndpointUrl string `protobuf:"bytes,2,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+	// Time of the last bundle refresh attempt, zero if none
+	LastAttemptAt int64 `protobuf:"varint,3,opt,name=last_attempt_at,json=lastAttemptAt,proto3" json:"last_attempt_at,omitempty"`
+	// Time of the last successful bundle refresh, zero if none
+	LastSuccessAt int64 `protobuf:"varint,4,opt,name=last_success_at,json=lastSuccessAt,proto3" json:"last_success_at,omitempty"`
+	// Amount of bundle refreshes that failed since the last successful one
+	ConsecutiveFailures int32 `protobuf:"varint,5,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Error of the last bundle refresh, if it failed
+	LastError string `protobuf:"bytes,6,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
 }
 
-func (x *GetInfoResponse_Cert) Reset() {
-	*x = GetInfoResponse_Cert{}
+func (x *GetInfoResponse_FederationRelationship) Reset() {
+	*x = GetInfoResponse_FederationRelationship{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[2]
+		mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetInfoResponse_Cert) String() string {
+func (x *GetInfoResponse_FederationRelationship) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetInfoResponse_Cert) ProtoMessage() {}
+func (*GetInfoResponse_FederationRelationship) ProtoMessage() {}
 
-func (x *GetInfoResponse_Cert) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[2]
+func (x *GetInfoResponse_FederationRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_api_server_debug_v1_debug_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -188,28 +909,49 @@ func (x *GetInfoResponse_Cert) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetInfoResponse_Cert.ProtoReflect.Descriptor instead.
-func (*GetInfoResponse_Cert) Descriptor() ([]byte, []int) {
-	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 0}
+// Deprecated: Use GetInfoResponse_FederationRelationship.ProtoReflect.Descriptor instead.
+func (*GetInfoResponse_FederationRelationship) Descriptor() ([]byte, []int) {
+	return file_spire_api_server_debug_v1_debug_proto_rawDescGZIP(), []int{1, 3}
 }
 
-func (x *GetInfoResponse_Cert) GetId() *types.SPIFFEID {
+func (x *GetInfoResponse_FederationRelationship) GetTrustDomain() string {
 	if x != nil {
-		return x.Id
+		return x.TrustDomain
 	}
-	return nil
+	return ""
 }
 
-func (x *GetInfoResponse_Cert) GetExpiresAt() int64 {
+func (x *GetInfoResponse_FederationRelationship) GetBundleEndpointUrl() string {
 	if x != nil {
-		return x.ExpiresAt
+		return x.BundleEndpointUrl
+	}
+	return ""
+}
+
+func (x *GetInfoResponse_FederationRelationship) GetLastAttemptAt() int64 {
+	if x != nil {
+		return x.LastAttemptAt
 	}
 	return 0
 }
 
-func (x *GetInfoResponse_Cert) GetSubject() string {
+func (x *GetInfoResponse_FederationRelationship) GetLastSuccessAt() int64 {
 	if x != nil {
-		return x.Subject
+		return x.LastSuccessAt
+	}
+	return 0
+}
+
+func (x *GetInfoResponse_FederationRelationship) GetConsecutiveFailures() int32 {
+	if x != nil {
+		return x.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (x *GetInfoResponse_FederationRelationship) GetLastError() string {
+	if x != nil {
+		return x.LastError
 	}
 	return ""
 }
@@ -224,7 +966,7 @@ var file_spire_api_server_debug_v1_debug_proto_rawDesc = []byte{
 	0x76, 0x31, 0x1a, 0x1a, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2f,
 	0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x69, 0x64, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x10,
 	0x0a, 0x0e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x22, 0xe1, 0x02, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
+	0x22, 0xf4, 0x0a, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
 	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x4e, 0x0a, 0x0a, 0x73, 0x76, 0x69, 0x64, 0x5f, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75,
@@ -239,25 +981,146 @@ var file_spire_api_server_debug_v1_debug_proto_rawDesc = []byte{
 	0x52, 0x15, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c,
 	0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x69,
 	0x65, 0x73, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
-	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x1a, 0x66, 0x0a, 0x04,
-	0x43, 0x65, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x15, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e, 0x53,
-	0x50, 0x49, 0x46, 0x46, 0x45, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x65,
-	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
-	0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75, 0x62,
-	0x6a, 0x65, 0x63, 0x74, 0x32, 0x69, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x60, 0x0a,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x53, 0x0a, 0x0a,
+	0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x34, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x42, 0x75, 0x69,
+	0x6c, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x09, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e, 0x66,
+	0x6f, 0x12, 0x4c, 0x0a, 0x08, 0x63, 0x61, 0x5f, 0x73, 0x6c, 0x6f, 0x74, 0x73, 0x18, 0x07, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e,
+	0x43, 0x41, 0x53, 0x6c, 0x6f, 0x74, 0x52, 0x07, 0x63, 0x61, 0x53, 0x6c, 0x6f, 0x74, 0x73, 0x12,
+	0x7c, 0x0a, 0x18, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x08, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x41, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x2e, 0x0a,
+	0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x1a, 0x66, 0x0a,
+	0x04, 0x43, 0x65, 0x72, 0x74, 0x12, 0x25, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x74, 0x79, 0x70, 0x65, 0x73, 0x2e,
+	0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x49, 0x44, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1d, 0x0a, 0x0a,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x73,
+	0x75, 0x62, 0x6a, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x73, 0x75,
+	0x62, 0x6a, 0x65, 0x63, 0x74, 0x1a, 0x44, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x49, 0x6e,
+	0x66, 0x6f, 0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1d, 0x0a, 0x0a,
+	0x67, 0x6f, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x67, 0x6f, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x1a, 0xe9, 0x02, 0x0a, 0x06,
+	0x43, 0x41, 0x53, 0x6c, 0x6f, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x4a, 0x0a, 0x04, 0x6b, 0x69, 0x6e, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31,
+	0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x2e, 0x43, 0x41, 0x53, 0x6c, 0x6f, 0x74, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x50, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x38, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x43,
+	0x41, 0x53, 0x6c, 0x6f, 0x74, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74,
+	0x61, 0x74, 0x75, 0x73, 0x12, 0x1b, 0x0a, 0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41,
+	0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74,
+	0x22, 0x32, 0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e,
+	0x4f, 0x57, 0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x58, 0x35,
+	0x30, 0x39, 0x5f, 0x43, 0x41, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x57, 0x54, 0x5f, 0x4b,
+	0x45, 0x59, 0x10, 0x02, 0x22, 0x41, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x12,
+	0x0a, 0x0e, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x53, 0x54, 0x41, 0x54, 0x55, 0x53,
+	0x10, 0x00, 0x12, 0x0a, 0x0a, 0x06, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x01, 0x12, 0x0c,
+	0x0a, 0x08, 0x50, 0x52, 0x45, 0x50, 0x41, 0x52, 0x45, 0x44, 0x10, 0x02, 0x12, 0x09, 0x0a, 0x05,
+	0x45, 0x4d, 0x50, 0x54, 0x59, 0x10, 0x03, 0x1a, 0x8d, 0x02, 0x0a, 0x16, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x21, 0x0a, 0x0c, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61,
+	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x12, 0x2e, 0x0a, 0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f,
+	0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x26, 0x0a, 0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x61, 0x74,
+	0x74, 0x65, 0x6d, 0x70, 0x74, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x6d, 0x70, 0x74, 0x41, 0x74, 0x12, 0x26, 0x0a,
+	0x0f, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x61, 0x74,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x53, 0x75, 0x63, 0x63,
+	0x65, 0x73, 0x73, 0x41, 0x74, 0x12, 0x31, 0x0a, 0x14, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75,
+	0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x13, 0x63, 0x6f, 0x6e, 0x73, 0x65, 0x63, 0x75, 0x74, 0x69, 0x76, 0x65,
+	0x46, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x6c, 0x61, 0x73, 0x74,
+	0x5f, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6c, 0x61,
+	0x73, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x22, 0xe9, 0x02, 0x0a, 0x10, 0x43, 0x41, 0x4a, 0x6f,
+	0x75, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x12, 0x44, 0x0a, 0x04,
+	0x6b, 0x69, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x30, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x2e, 0x4b, 0x69, 0x6e, 0x64, 0x52, 0x04, 0x6b, 0x69,
+	0x6e, 0x64, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x69,
+	0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08,
+	0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x12, 0x4a, 0x0a, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43,
+	0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x2e,
+	0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x52, 0x06, 0x72, 0x65, 0x70, 0x61, 0x69, 0x72, 0x22, 0x32,
+	0x0a, 0x04, 0x4b, 0x69, 0x6e, 0x64, 0x12, 0x10, 0x0a, 0x0c, 0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57,
+	0x4e, 0x5f, 0x4b, 0x49, 0x4e, 0x44, 0x10, 0x00, 0x12, 0x0b, 0x0a, 0x07, 0x58, 0x35, 0x30, 0x39,
+	0x5f, 0x43, 0x41, 0x10, 0x01, 0x12, 0x0b, 0x0a, 0x07, 0x4a, 0x57, 0x54, 0x5f, 0x4b, 0x45, 0x59,
+	0x10, 0x02, 0x22, 0x3f, 0x0a, 0x06, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x12, 0x12, 0x0a, 0x0e,
+	0x55, 0x4e, 0x4b, 0x4e, 0x4f, 0x57, 0x4e, 0x5f, 0x52, 0x45, 0x50, 0x41, 0x49, 0x52, 0x10, 0x00,
+	0x12, 0x0e, 0x0a, 0x0a, 0x44, 0x52, 0x4f, 0x50, 0x5f, 0x45, 0x4e, 0x54, 0x52, 0x59, 0x10, 0x01,
+	0x12, 0x11, 0x0a, 0x0d, 0x41, 0x50, 0x50, 0x45, 0x4e, 0x44, 0x5f, 0x42, 0x55, 0x4e, 0x44, 0x4c,
+	0x45, 0x10, 0x02, 0x22, 0x18, 0x0a, 0x16, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x41, 0x4a,
+	0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x62, 0x0a,
+	0x17, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x72, 0x6f, 0x62,
+	0x6c, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65,
+	0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c,
+	0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d,
+	0x73, 0x22, 0x3c, 0x0a, 0x16, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x41, 0x4a, 0x6f, 0x75,
+	0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x72,
+	0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x0c, 0x72, 0x65, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x61, 0x6c, 0x69, 0x7a, 0x65, 0x22,
+	0x62, 0x0a, 0x17, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x47, 0x0a, 0x08, 0x70, 0x72,
+	0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2b, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x50, 0x72, 0x6f, 0x62, 0x6c, 0x65, 0x6d, 0x52, 0x08, 0x70, 0x72, 0x6f, 0x62, 0x6c,
+	0x65, 0x6d, 0x73, 0x32, 0xdd, 0x02, 0x0a, 0x05, 0x44, 0x65, 0x62, 0x75, 0x67, 0x12, 0x60, 0x0a,
 	0x07, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
 	0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75,
 	0x67, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75,
 	0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e,
-	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42,
-	0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70,
-	0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x64, 0x65, 0x62, 0x75, 0x67,
-	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x47, 0x65, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x78, 0x0a, 0x0f, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e,
+	0x61, 0x6c, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x56,
+	0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70,
+	0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76,
+	0x31, 0x2e, 0x56, 0x65, 0x72, 0x69, 0x66, 0x79, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61,
+	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x0f, 0x52, 0x65, 0x70,
+	0x61, 0x69, 0x72, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x12, 0x31, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61, 0x69, 0x72, 0x43,
+	0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x61, 0x70, 0x69, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x65, 0x70, 0x61,
+	0x69, 0x72, 0x43, 0x41, 0x4a, 0x6f, 0x75, 0x72, 0x6e, 0x61, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x42, 0x3f, 0x5a, 0x3d, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+	0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x61, 0x70, 0x69, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x76, 0x31, 0x3b, 0x64,
+	0x65, 0x62, 0x75, 0x67, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -272,23 +1135,49 @@ func file_spire_api_server_debug_v1_debug_proto_rawDescGZIP() []byte {
 	return file_spire_api_server_debug_v1_debug_proto_rawDescData
 }
 
-var file_spire_api_server_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_spire_api_server_debug_v1_debug_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_spire_api_server_debug_v1_debug_proto_msgTypes = make([]protoimpl.MessageInfo, 11)
 var file_spire_api_server_debug_v1_debug_proto_goTypes = []interface{}{
-	(*GetInfoRequest)(nil),       // 0: spire.api.server.debug.v1.GetInfoRequest
-	(*GetInfoResponse)(nil),      // 1: spire.api.server.debug.v1.GetInfoResponse
-	(*GetInfoResponse_Cert)(nil), // 2: spire.api.server.debug.v1.GetInfoResponse.Cert
-	(*types.SPIFFEID)(nil),       // 3: spire.types.SPIFFEID
+	(GetInfoResponse_CASlot_Kind)(0),               // 0: spire.api.server.debug.v1.GetInfoResponse.CASlot.Kind
+	(GetInfoResponse_CASlot_Status)(0),             // 1: spire.api.server.debug.v1.GetInfoResponse.CASlot.Status
+	(CAJournalProblem_Kind)(0),                     // 2: spire.api.server.debug.v1.CAJournalProblem.Kind
+	(CAJournalProblem_Repair)(0),                   // 3: spire.api.server.debug.v1.CAJournalProblem.Repair
+	(*GetInfoRequest)(nil),                         // 4: spire.api.server.debug.v1.GetInfoRequest
+	(*GetInfoResponse)(nil),                        // 5: spire.api.server.debug.v1.GetInfoResponse
+	(*CAJournalProblem)(nil),                       // 6: spire.api.server.debug.v1.CAJournalProblem
+	(*VerifyCAJournalRequest)(nil),                 // 7: spire.api.server.debug.v1.VerifyCAJournalRequest
+	(*VerifyCAJournalResponse)(nil),                // 8: spire.api.server.debug.v1.VerifyCAJournalResponse
+	(*RepairCAJournalRequest)(nil),                 // 9: spire.api.server.debug.v1.RepairCAJournalRequest
+	(*RepairCAJournalResponse)(nil),                // 10: spire.api.server.debug.v1.RepairCAJournalResponse
+	(*GetInfoResponse_Cert)(nil),                   // 11: spire.api.server.debug.v1.GetInfoResponse.Cert
+	(*GetInfoResponse_BuildInfo)(nil),              // 12: spire.api.server.debug.v1.GetInfoResponse.BuildInfo
+	(*GetInfoResponse_CASlot)(nil),                 // 13: spire.api.server.debug.v1.GetInfoResponse.CASlot
+	(*GetInfoResponse_FederationRelationship)(nil), // 14: spire.api.server.debug.v1.GetInfoResponse.FederationRelationship
+	(*types.SPIFFEID)(nil),                         // 15: spire.types.SPIFFEID
 }
 var file_spire_api_server_debug_v1_debug_proto_depIdxs = []int32{
-	2, // 0: spire.api.server.debug.v1.GetInfoResponse.svid_chain:type_name -> spire.api.server.debug.v1.GetInfoResponse.Cert
-	3, // 1: spire.api.server.debug.v1.GetInfoResponse.Cert.id:type_name -> spire.types.SPIFFEID
-	0, // 2: spire.api.server.debug.v1.Debug.GetInfo:input_type -> spire.api.server.debug.v1.GetInfoRequest
-	1, // 3: spire.api.server.debug.v1.Debug.GetInfo:output_type -> spire.api.server.debug.v1.GetInfoResponse
-	3, // [3:4] is the sub-list for method output_type
-	2, // [2:3] is the sub-list for method input_type
-	2, // [2:2] is the sub-list for extension type_name
-	2, // [2:2] is the sub-list for extension extendee
-	0, // [0:2] is the sub-list for field type_name
+	11, // 0: spire.api.server.debug.v1.GetInfoResponse.svid_chain:type_name -> spire.api.server.debug.v1.GetInfoResponse.Cert
+	12, // 1: spire.api.server.debug.v1.GetInfoResponse.build_info:type_name -> spire.api.server.debug.v1.GetInfoResponse.BuildInfo
+	13, // 2: spire.api.server.debug.v1.GetInfoResponse.ca_slots:type_name -> spire.api.server.debug.v1.GetInfoResponse.CASlot
+	14, // 3: spire.api.server.debug.v1.GetInfoResponse.federation_relationships:type_name -> spire.api.server.debug.v1.GetInfoResponse.FederationRelationship
+	2,  // 4: spire.api.server.debug.v1.CAJournalProblem.kind:type_name -> spire.api.server.debug.v1.CAJournalProblem.Kind
+	3,  // 5: spire.api.server.debug.v1.CAJournalProblem.repair:type_name -> spire.api.server.debug.v1.CAJournalProblem.Repair
+	6,  // 6: spire.api.server.debug.v1.VerifyCAJournalResponse.problems:type_name -> spire.api.server.debug.v1.CAJournalProblem
+	6,  // 7: spire.api.server.debug.v1.RepairCAJournalResponse.problems:type_name -> spire.api.server.debug.v1.CAJournalProblem
+	15, // 8: spire.api.server.debug.v1.GetInfoResponse.Cert.id:type_name -> spire.types.SPIFFEID
+	0,  // 9: spire.api.server.debug.v1.GetInfoResponse.CASlot.kind:type_name -> spire.api.server.debug.v1.GetInfoResponse.CASlot.Kind
+	1,  // 10: spire.api.server.debug.v1.GetInfoResponse.CASlot.status:type_name -> spire.api.server.debug.v1.GetInfoResponse.CASlot.Status
+	4,  // 11: spire.api.server.debug.v1.Debug.GetInfo:input_type -> spire.api.server.debug.v1.GetInfoRequest
+	7,  // 12: spire.api.server.debug.v1.Debug.VerifyCAJournal:input_type -> spire.api.server.debug.v1.VerifyCAJournalRequest
+	9,  // 13: spire.api.server.debug.v1.Debug.RepairCAJournal:input_type -> spire.api.server.debug.v1.RepairCAJournalRequest
+	5,  // 14: spire.api.server.debug.v1.Debug.GetInfo:output_type -> spire.api.server.debug.v1.GetInfoResponse
+	8,  // 15: spire.api.server.debug.v1.Debug.VerifyCAJournal:output_type -> spire.api.server.debug.v1.VerifyCAJournalResponse
+	10, // 16: spire.api.server.debug.v1.Debug.RepairCAJournal:output_type -> spire.api.server.debug.v1.RepairCAJournalResponse
+	14, // [14:17] is the sub-list for method output_type
+	11, // [11:14] is the sub-list for method input_type
+	11, // [11:11] is the sub-list for extension type_name
+	11, // [11:11] is the sub-list for extension extendee
+	0,  // [0:11] is the sub-list for field type_name
 }
 
 func init() { file_spire_api_server_debug_v1_debug_proto_init() }
@@ -322,6 +1211,66 @@ func file_spire_api_server_debug_v1_debug_proto_init() {
 			}
 		}
 		file_spire_api_server_debug_v1_debug_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CAJournalProblem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCAJournalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VerifyCAJournalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairCAJournalRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RepairCAJournalResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetInfoResponse_Cert); i {
 			case 0:
 				return &v.state
@@ -333,19 +1282,56 @@ func file_spire_api_server_debug_v1_debug_proto_init() {
 				return nil
 			}
 		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse_BuildInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse_CASlot); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_api_server_debug_v1_debug_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetInfoResponse_FederationRelationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spire_api_server_debug_v1_debug_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   3,
+			NumEnums:      4,
+			NumMessages:   11,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_spire_api_server_debug_v1_debug_proto_goTypes,
 		DependencyIndexes: file_spire_api_server_debug_v1_debug_proto_depIdxs,
+		EnumInfos:         file_spire_api_server_debug_v1_debug_proto_enumTypes,
 		MessageInfos:      file_spire_api_server_debug_v1_debug_proto_msgTypes,
 	}.Build()
 	File_spire_api_server_debug_v1_debug_proto = out.File
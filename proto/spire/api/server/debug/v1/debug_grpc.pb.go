@@ -19,6 +19,10 @@ const _ = grpc.SupportPackageIsVersion7
 type DebugClient interface {
 	// Get information about SPIRE server
 	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	// Verify the CA journal against the KeyManager and the trust domain bundle
+	VerifyCAJournal(ctx context.Context, in *VerifyCAJournalRequest, opts ...grpc.CallOption) (*VerifyCAJournalResponse, error)
+	// Repair the problems found in the CA journal, or reinitialize it
+	RepairCAJournal(ctx context.Context, in *RepairCAJournalRequest, opts ...grpc.CallOption) (*RepairCAJournalResponse, error)
 }
 
 type debugClient struct {
@@ -38,12 +42,34 @@ func (c *debugClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...g
 	return out, nil
 }
 
+func (c *debugClient) VerifyCAJournal(ctx context.Context, in *VerifyCAJournalRequest, opts ...grpc.CallOption) (*VerifyCAJournalResponse, error) {
+	out := new(VerifyCAJournalResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.debug.v1.Debug/VerifyCAJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *debugClient) RepairCAJournal(ctx context.Context, in *RepairCAJournalRequest, opts ...grpc.CallOption) (*RepairCAJournalResponse, error) {
+	out := new(RepairCAJournalResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.debug.v1.Debug/RepairCAJournal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DebugServer is the server API for Debug service.
 // All implementations must embed UnimplementedDebugServer
 // for forward compatibility
 type DebugServer interface {
 	// Get information about SPIRE server
 	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	// Verify the CA journal against the KeyManager and the trust domain bundle
+	VerifyCAJournal(context.Context, *VerifyCAJournalRequest) (*VerifyCAJournalResponse, error)
+	// Repair the problems found in the CA journal, or reinitialize it
+	RepairCAJournal(context.Context, *RepairCAJournalRequest) (*RepairCAJournalResponse, error)
 	mustEmbedUnimplementedDebugServer()
 }
 
@@ -54,6 +80,12 @@ type UnimplementedDebugServer struct {
 func (UnimplementedDebugServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
 }
+func (UnimplementedDebugServer) VerifyCAJournal(context.Context, *VerifyCAJournalRequest) (*VerifyCAJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method VerifyCAJournal not implemented")
+}
+func (UnimplementedDebugServer) RepairCAJournal(context.Context, *RepairCAJournalRequest) (*RepairCAJournalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RepairCAJournal not implemented")
+}
 func (UnimplementedDebugServer) mustEmbedUnimplementedDebugServer() {}
 
 // UnsafeDebugServer may be embedded to opt out of forward compatibility for this service.
@@ -85,6 +117,42 @@ func _Debug_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Debug_VerifyCAJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(VerifyCAJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).VerifyCAJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.debug.v1.Debug/VerifyCAJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).VerifyCAJournal(ctx, req.(*VerifyCAJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Debug_RepairCAJournal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RepairCAJournalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DebugServer).RepairCAJournal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.debug.v1.Debug/RepairCAJournal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DebugServer).RepairCAJournal(ctx, req.(*RepairCAJournalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Debug_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "spire.api.server.debug.v1.Debug",
 	HandlerType: (*DebugServer)(nil),
@@ -93,6 +161,14 @@ var _Debug_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetInfo",
 			Handler:    _Debug_GetInfo_Handler,
 		},
+		{
+			MethodName: "VerifyCAJournal",
+			Handler:    _Debug_VerifyCAJournal_Handler,
+		},
+		{
+			MethodName: "RepairCAJournal",
+			Handler:    _Debug_RepairCAJournal_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spire/api/server/debug/v1/debug.proto",
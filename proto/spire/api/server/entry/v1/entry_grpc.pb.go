@@ -43,6 +43,19 @@ type EntryClient interface {
 	// The caller must present an active agent X509-SVID. See the Agent
 	// AttestAgent/RenewAgent RPCs.
 	GetAuthorizedEntries(ctx context.Context, in *GetAuthorizedEntriesRequest, opts ...grpc.CallOption) (*GetAuthorizedEntriesResponse, error)
+	// Gets the entries an agent is authorized for, including the entries
+	// obtained through node alias entries matching the agent selectors. These
+	// are the entries returned by GetAuthorizedEntries when called by the
+	// agent. If the agent does not exist, NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	GetAgentAuthorizedEntries(ctx context.Context, in *GetAgentAuthorizedEntriesRequest, opts ...grpc.CallOption) (*GetAgentAuthorizedEntriesResponse, error)
+	// Lists the tombstones of deleted entries, so clients syncing entries
+	// incrementally can learn about removals. Tombstones are kept for a
+	// limited time after the deletion.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ListEntryTombstones(ctx context.Context, in *ListEntryTombstonesRequest, opts ...grpc.CallOption) (*ListEntryTombstonesResponse, error)
 }
 
 type entryClient struct {
@@ -107,6 +120,24 @@ func (c *entryClient) GetAuthorizedEntries(ctx context.Context, in *GetAuthorize
 	return out, nil
 }
 
+func (c *entryClient) GetAgentAuthorizedEntries(ctx context.Context, in *GetAgentAuthorizedEntriesRequest, opts ...grpc.CallOption) (*GetAgentAuthorizedEntriesResponse, error) {
+	out := new(GetAgentAuthorizedEntriesResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.entry.v1.Entry/GetAgentAuthorizedEntries", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *entryClient) ListEntryTombstones(ctx context.Context, in *ListEntryTombstonesRequest, opts ...grpc.CallOption) (*ListEntryTombstonesResponse, error) {
+	out := new(ListEntryTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.entry.v1.Entry/ListEntryTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // EntryServer is the server API for Entry service.
 // All implementations must embed UnimplementedEntryServer
 // for forward compatibility
@@ -136,6 +167,19 @@ type EntryServer interface {
 	// The caller must present an active agent X509-SVID. See the Agent
 	// AttestAgent/RenewAgent RPCs.
 	GetAuthorizedEntries(context.Context, *GetAuthorizedEntriesRequest) (*GetAuthorizedEntriesResponse, error)
+	// Gets the entries an agent is authorized for, including the entries
+	// obtained through node alias entries matching the agent selectors. These
+	// are the entries returned by GetAuthorizedEntries when called by the
+	// agent. If the agent does not exist, NOT_FOUND is returned.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	GetAgentAuthorizedEntries(context.Context, *GetAgentAuthorizedEntriesRequest) (*GetAgentAuthorizedEntriesResponse, error)
+	// Lists the tombstones of deleted entries, so clients syncing entries
+	// incrementally can learn about removals. Tombstones are kept for a
+	// limited time after the deletion.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ListEntryTombstones(context.Context, *ListEntryTombstonesRequest) (*ListEntryTombstonesResponse, error)
 	mustEmbedUnimplementedEntryServer()
 }
 
@@ -161,6 +205,12 @@ func (UnimplementedEntryServer) BatchDeleteEntry(context.Context, *BatchDeleteEn
 func (UnimplementedEntryServer) GetAuthorizedEntries(context.Context, *GetAuthorizedEntriesRequest) (*GetAuthorizedEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAuthorizedEntries not implemented")
 }
+func (UnimplementedEntryServer) GetAgentAuthorizedEntries(context.Context, *GetAgentAuthorizedEntriesRequest) (*GetAgentAuthorizedEntriesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAgentAuthorizedEntries not implemented")
+}
+func (UnimplementedEntryServer) ListEntryTombstones(context.Context, *ListEntryTombstonesRequest) (*ListEntryTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListEntryTombstones not implemented")
+}
 func (UnimplementedEntryServer) mustEmbedUnimplementedEntryServer() {}
 
 // UnsafeEntryServer may be embedded to opt out of forward compatibility for this service.
@@ -282,6 +332,42 @@ func _Entry_GetAuthorizedEntries_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Entry_GetAgentAuthorizedEntries_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAgentAuthorizedEntriesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServer).GetAgentAuthorizedEntries(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.entry.v1.Entry/GetAgentAuthorizedEntries",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServer).GetAgentAuthorizedEntries(ctx, req.(*GetAgentAuthorizedEntriesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Entry_ListEntryTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListEntryTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(EntryServer).ListEntryTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.entry.v1.Entry/ListEntryTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(EntryServer).ListEntryTombstones(ctx, req.(*ListEntryTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Entry_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "spire.api.server.entry.v1.Entry",
 	HandlerType: (*EntryServer)(nil),
@@ -310,6 +396,14 @@ var _Entry_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetAuthorizedEntries",
 			Handler:    _Entry_GetAuthorizedEntries_Handler,
 		},
+		{
+			MethodName: "GetAgentAuthorizedEntries",
+			Handler:    _Entry_GetAgentAuthorizedEntries_Handler,
+		},
+		{
+			MethodName: "ListEntryTombstones",
+			Handler:    _Entry_ListEntryTombstones_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spire/api/server/entry/v1/entry.proto",
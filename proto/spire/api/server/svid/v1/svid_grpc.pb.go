@@ -42,6 +42,13 @@ type SVIDClient interface {
 	//
 	// The caller must present a downstream X509-SVID.
 	NewDownstreamX509CA(ctx context.Context, in *NewDownstreamX509CARequest, opts ...grpc.CallOption) (*NewDownstreamX509CAResponse, error)
+	// Validates a JWT-SVID against the JWT authorities of the bundle of the
+	// trust domain of its subject, which can be the server trust domain or
+	// a federated trust domain. The signature, expiry and audience of the
+	// JWT-SVID are verified.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ValidateJWTSVID(ctx context.Context, in *ValidateJWTSVIDRequest, opts ...grpc.CallOption) (*ValidateJWTSVIDResponse, error)
 }
 
 type sVIDClient struct {
@@ -97,6 +104,15 @@ func (c *sVIDClient) NewDownstreamX509CA(ctx context.Context, in *NewDownstreamX
 	return out, nil
 }
 
+func (c *sVIDClient) ValidateJWTSVID(ctx context.Context, in *ValidateJWTSVIDRequest, opts ...grpc.CallOption) (*ValidateJWTSVIDResponse, error) {
+	out := new(ValidateJWTSVIDResponse)
+	err := c.cc.Invoke(ctx, "/spire.api.server.svid.v1.SVID/ValidateJWTSVID", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SVIDServer is the server API for SVID service.
 // All implementations must embed UnimplementedSVIDServer
 // for forward compatibility
@@ -126,6 +142,13 @@ type SVIDServer interface {
 	//
 	// The caller must present a downstream X509-SVID.
 	NewDownstreamX509CA(context.Context, *NewDownstreamX509CARequest) (*NewDownstreamX509CAResponse, error)
+	// Validates a JWT-SVID against the JWT authorities of the bundle of the
+	// trust domain of its subject, which can be the server trust domain or
+	// a federated trust domain. The signature, expiry and audience of the
+	// JWT-SVID are verified.
+	//
+	// The caller must be local or present an admin X509-SVID.
+	ValidateJWTSVID(context.Context, *ValidateJWTSVIDRequest) (*ValidateJWTSVIDResponse, error)
 	mustEmbedUnimplementedSVIDServer()
 }
 
@@ -148,6 +171,9 @@ func (UnimplementedSVIDServer) NewJWTSVID(context.Context, *NewJWTSVIDRequest) (
 func (UnimplementedSVIDServer) NewDownstreamX509CA(context.Context, *NewDownstreamX509CARequest) (*NewDownstreamX509CAResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method NewDownstreamX509CA not implemented")
 }
+func (UnimplementedSVIDServer) ValidateJWTSVID(context.Context, *ValidateJWTSVIDRequest) (*ValidateJWTSVIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateJWTSVID not implemented")
+}
 func (UnimplementedSVIDServer) mustEmbedUnimplementedSVIDServer() {}
 
 // UnsafeSVIDServer may be embedded to opt out of forward compatibility for this service.
@@ -251,6 +277,24 @@ func _SVID_NewDownstreamX509CA_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SVID_ValidateJWTSVID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ValidateJWTSVIDRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SVIDServer).ValidateJWTSVID(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.api.server.svid.v1.SVID/ValidateJWTSVID",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SVIDServer).ValidateJWTSVID(ctx, req.(*ValidateJWTSVIDRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SVID_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "spire.api.server.svid.v1.SVID",
 	HandlerType: (*SVIDServer)(nil),
@@ -275,6 +319,10 @@ var _SVID_serviceDesc = grpc.ServiceDesc{
 			MethodName: "NewDownstreamX509CA",
 			Handler:    _SVID_NewDownstreamX509CA_Handler,
 		},
+		{
+			MethodName: "ValidateJWTSVID",
+			Handler:    _SVID_ValidateJWTSVID_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "spire/api/server/svid/v1/svid.proto",
@@ -25,15 +25,15 @@ const (
 // of the legacy proto package is being used.
 const _ = proto.ProtoPackageIsVersion4
 
-//* Represents the plugin-specific configuration string.
+// Represents the plugin-specific configuration string.
 type ConfigureRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The configuration for the plugin.
+	// The configuration for the plugin.
 	Configuration string `protobuf:"bytes,1,opt,name=configuration,proto3" json:"configuration,omitempty"`
-	//* Global configurations.
+	// Global configurations.
 	GlobalConfig *ConfigureRequest_GlobalConfig `protobuf:"bytes,2,opt,name=globalConfig,proto3" json:"globalConfig,omitempty"`
 }
 
@@ -83,14 +83,14 @@ func (x *ConfigureRequest) GetGlobalConfig() *ConfigureRequest_GlobalConfig {
 	return nil
 }
 
-//* Represents a list of configuration problems
-//found in the configuration string.
+// Represents a list of configuration problems
+// found in the configuration string.
 type ConfigureResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* A list of errors
+	// A list of errors
 	ErrorList []string `protobuf:"bytes,1,rep,name=errorList,proto3" json:"errorList,omitempty"`
 }
 
@@ -133,7 +133,7 @@ func (x *ConfigureResponse) GetErrorList() []string {
 	return nil
 }
 
-//* Represents an empty request.
+// Represents an empty request.
 type GetPluginInfoRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -172,7 +172,7 @@ func (*GetPluginInfoRequest) Descriptor() ([]byte, []int) {
 	return file_spire_common_plugin_plugin_proto_rawDescGZIP(), []int{2}
 }
 
-//* Represents the plugin metadata.
+// Represents the plugin metadata.
 type GetPluginInfoResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -337,6 +337,14 @@ type InitResponse struct {
 	unknownFields protoimpl.UnknownFields
 
 	PluginServices []string `protobuf:"bytes,1,rep,name=plugin_services,json=pluginServices,proto3" json:"plugin_services,omitempty"`
+	// The version of the service definition implemented by the plugin.
+	// Reported by plugins speaking version 2 or later of the plugin
+	// protocol.
+	PluginVersion uint32 `protobuf:"varint,2,opt,name=plugin_version,json=pluginVersion,proto3" json:"plugin_version,omitempty"`
+	// The versions of the service definitions implemented by the plugin
+	// services, keyed by service type. Reported by plugins speaking version 2
+	// or later of the plugin protocol.
+	PluginServiceVersions map[string]uint32 `protobuf:"bytes,3,rep,name=plugin_service_versions,json=pluginServiceVersions,proto3" json:"plugin_service_versions,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
 }
 
 func (x *InitResponse) Reset() {
@@ -378,7 +386,21 @@ func (x *InitResponse) GetPluginServices() []string {
 	return nil
 }
 
-//* Global configuration nested type.
+func (x *InitResponse) GetPluginVersion() uint32 {
+	if x != nil {
+		return x.PluginVersion
+	}
+	return 0
+}
+
+func (x *InitResponse) GetPluginServiceVersions() map[string]uint32 {
+	if x != nil {
+		return x.PluginServiceVersions
+	}
+	return nil
+}
+
+// Global configuration nested type.
 type ConfigureRequest_GlobalConfig struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -469,20 +491,34 @@ var file_spire_common_plugin_plugin_proto_rawDesc = []byte{
 	0x79, 0x22, 0x32, 0x0a, 0x0b, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x12, 0x23, 0x0a, 0x0d, 0x68, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0c, 0x68, 0x6f, 0x73, 0x74, 0x53, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x32, 0x59,
-	0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x4b, 0x0a, 0x04,
-	0x49, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d,
-	0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x69,
-	0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74,
-	0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73,
-	0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65,
-	0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x22, 0x9e, 0x02, 0x0a, 0x0c, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x0e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x12,
+	0x25, 0x0a, 0x0e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x56,
+	0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x74, 0x0a, 0x17, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x5f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x3c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x49, 0x6e,
+	0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x50, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x15, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x1a, 0x48, 0x0a, 0x1a,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x56, 0x65, 0x72,
+	0x73, 0x69, 0x6f, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x14, 0x0a, 0x05,
+	0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x76, 0x61, 0x6c,
+	0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x32, 0x59, 0x0a, 0x0a, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x49, 0x6e, 0x69, 0x74, 0x12, 0x4b, 0x0a, 0x04, 0x49, 0x6e, 0x69, 0x74, 0x12, 0x20, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67,
+	0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x21,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c,
+	0x75, 0x67, 0x69, 0x6e, 0x2e, 0x49, 0x6e, 0x69, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x33, 0x5a, 0x31, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -497,7 +533,7 @@ func file_spire_common_plugin_plugin_proto_rawDescGZIP() []byte {
 	return file_spire_common_plugin_plugin_proto_rawDescData
 }
 
-var file_spire_common_plugin_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_spire_common_plugin_plugin_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_spire_common_plugin_plugin_proto_goTypes = []interface{}{
 	(*ConfigureRequest)(nil),              // 0: spire.common.plugin.ConfigureRequest
 	(*ConfigureResponse)(nil),             // 1: spire.common.plugin.ConfigureResponse
@@ -506,16 +542,18 @@ var file_spire_common_plugin_plugin_proto_goTypes = []interface{}{
 	(*InitRequest)(nil),                   // 4: spire.common.plugin.InitRequest
 	(*InitResponse)(nil),                  // 5: spire.common.plugin.InitResponse
 	(*ConfigureRequest_GlobalConfig)(nil), // 6: spire.common.plugin.ConfigureRequest.GlobalConfig
+	nil,                                   // 7: spire.common.plugin.InitResponse.PluginServiceVersionsEntry
 }
 var file_spire_common_plugin_plugin_proto_depIdxs = []int32{
 	6, // 0: spire.common.plugin.ConfigureRequest.globalConfig:type_name -> spire.common.plugin.ConfigureRequest.GlobalConfig
-	4, // 1: spire.common.plugin.PluginInit.Init:input_type -> spire.common.plugin.InitRequest
-	5, // 2: spire.common.plugin.PluginInit.Init:output_type -> spire.common.plugin.InitResponse
-	2, // [2:3] is the sub-list for method output_type
-	1, // [1:2] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	7, // 1: spire.common.plugin.InitResponse.plugin_service_versions:type_name -> spire.common.plugin.InitResponse.PluginServiceVersionsEntry
+	4, // 2: spire.common.plugin.PluginInit.Init:input_type -> spire.common.plugin.InitRequest
+	5, // 3: spire.common.plugin.PluginInit.Init:output_type -> spire.common.plugin.InitResponse
+	3, // [3:4] is the sub-list for method output_type
+	2, // [2:3] is the sub-list for method input_type
+	2, // [2:2] is the sub-list for extension type_name
+	2, // [2:2] is the sub-list for extension extendee
+	0, // [0:2] is the sub-list for field type_name
 }
 
 func init() { file_spire_common_plugin_plugin_proto_init() }
@@ -615,7 +653,7 @@ func file_spire_common_plugin_plugin_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spire_common_plugin_plugin_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -0,0 +1,176 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+
+package bundlepublisher
+
+import (
+	context "context"
+	plugin "github.com/spiffe/spire/proto/spire/common/plugin"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion7
+
+// BundlePublisherClient is the client API for BundlePublisher service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type BundlePublisherClient interface {
+	// PublishBundle publishes the trust bundle. It is called on startup and
+	// whenever SPIRE server changes the trust bundle. Errors returned by the
+	// plugin are logged but otherwise ignored.
+	PublishBundle(ctx context.Context, in *PublishBundleRequest, opts ...grpc.CallOption) (*PublishBundleResponse, error)
+	Configure(ctx context.Context, in *plugin.ConfigureRequest, opts ...grpc.CallOption) (*plugin.ConfigureResponse, error)
+	GetPluginInfo(ctx context.Context, in *plugin.GetPluginInfoRequest, opts ...grpc.CallOption) (*plugin.GetPluginInfoResponse, error)
+}
+
+type bundlePublisherClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewBundlePublisherClient(cc grpc.ClientConnInterface) BundlePublisherClient {
+	return &bundlePublisherClient{cc}
+}
+
+func (c *bundlePublisherClient) PublishBundle(ctx context.Context, in *PublishBundleRequest, opts ...grpc.CallOption) (*PublishBundleResponse, error) {
+	out := new(PublishBundleResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.bundlepublisher.BundlePublisher/PublishBundle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlePublisherClient) Configure(ctx context.Context, in *plugin.ConfigureRequest, opts ...grpc.CallOption) (*plugin.ConfigureResponse, error) {
+	out := new(plugin.ConfigureResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.bundlepublisher.BundlePublisher/Configure", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *bundlePublisherClient) GetPluginInfo(ctx context.Context, in *plugin.GetPluginInfoRequest, opts ...grpc.CallOption) (*plugin.GetPluginInfoResponse, error) {
+	out := new(plugin.GetPluginInfoResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.bundlepublisher.BundlePublisher/GetPluginInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// BundlePublisherServer is the server API for BundlePublisher service.
+// All implementations must embed UnimplementedBundlePublisherServer
+// for forward compatibility
+type BundlePublisherServer interface {
+	// PublishBundle publishes the trust bundle. It is called on startup and
+	// whenever SPIRE server changes the trust bundle. Errors returned by the
+	// plugin are logged but otherwise ignored.
+	PublishBundle(context.Context, *PublishBundleRequest) (*PublishBundleResponse, error)
+	Configure(context.Context, *plugin.ConfigureRequest) (*plugin.ConfigureResponse, error)
+	GetPluginInfo(context.Context, *plugin.GetPluginInfoRequest) (*plugin.GetPluginInfoResponse, error)
+	mustEmbedUnimplementedBundlePublisherServer()
+}
+
+// UnimplementedBundlePublisherServer must be embedded to have forward compatible implementations.
+type UnimplementedBundlePublisherServer struct {
+}
+
+func (UnimplementedBundlePublisherServer) PublishBundle(context.Context, *PublishBundleRequest) (*PublishBundleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PublishBundle not implemented")
+}
+func (UnimplementedBundlePublisherServer) Configure(context.Context, *plugin.ConfigureRequest) (*plugin.ConfigureResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
+}
+func (UnimplementedBundlePublisherServer) GetPluginInfo(context.Context, *plugin.GetPluginInfoRequest) (*plugin.GetPluginInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPluginInfo not implemented")
+}
+func (UnimplementedBundlePublisherServer) mustEmbedUnimplementedBundlePublisherServer() {}
+
+// UnsafeBundlePublisherServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to BundlePublisherServer will
+// result in compilation errors.
+type UnsafeBundlePublisherServer interface {
+	mustEmbedUnimplementedBundlePublisherServer()
+}
+
+func RegisterBundlePublisherServer(s grpc.ServiceRegistrar, srv BundlePublisherServer) {
+	s.RegisterService(&_BundlePublisher_serviceDesc, srv)
+}
+
+func _BundlePublisher_PublishBundle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PublishBundleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlePublisherServer).PublishBundle(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.bundlepublisher.BundlePublisher/PublishBundle",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlePublisherServer).PublishBundle(ctx, req.(*PublishBundleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundlePublisher_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(plugin.ConfigureRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlePublisherServer).Configure(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.bundlepublisher.BundlePublisher/Configure",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlePublisherServer).Configure(ctx, req.(*plugin.ConfigureRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _BundlePublisher_GetPluginInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(plugin.GetPluginInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(BundlePublisherServer).GetPluginInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.bundlepublisher.BundlePublisher/GetPluginInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(BundlePublisherServer).GetPluginInfo(ctx, req.(*plugin.GetPluginInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _BundlePublisher_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "spire.server.bundlepublisher.BundlePublisher",
+	HandlerType: (*BundlePublisherServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PublishBundle",
+			Handler:    _BundlePublisher_PublishBundle_Handler,
+		},
+		{
+			MethodName: "Configure",
+			Handler:    _BundlePublisher_Configure_Handler,
+		},
+		{
+			MethodName: "GetPluginInfo",
+			Handler:    _BundlePublisher_GetPluginInfo_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "spire/server/bundlepublisher/bundlepublisher.proto",
+}
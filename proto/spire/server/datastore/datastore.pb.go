@@ -8,12 +8,12 @@ package datastore
 
 import (
 	proto "github.com/golang/protobuf/proto"
+	timestamp "github.com/golang/protobuf/ptypes/timestamp"
+	wrappers "github.com/golang/protobuf/ptypes/wrappers"
 	common "github.com/spiffe/spire/proto/spire/common"
 	plugin "github.com/spiffe/spire/proto/spire/common/plugin"
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
-	timestamppb "google.golang.org/protobuf/types/known/timestamppb"
-	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 )
@@ -175,6 +175,55 @@ func (ByFederatesWith_MatchBehavior) EnumDescriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{42, 0}
 }
 
+type FederationRelationship_BundleEndpointProfile int32
+
+const (
+	// HTTPS_WEB authenticates the bundle endpoint using Web PKI
+	FederationRelationship_HTTPS_WEB FederationRelationship_BundleEndpointProfile = 0
+	// HTTPS_SPIFFE authenticates the bundle endpoint using SPIFFE
+	// authentication
+	FederationRelationship_HTTPS_SPIFFE FederationRelationship_BundleEndpointProfile = 1
+)
+
+// Enum value maps for FederationRelationship_BundleEndpointProfile.
+var (
+	FederationRelationship_BundleEndpointProfile_name = map[int32]string{
+		0: "HTTPS_WEB",
+		1: "HTTPS_SPIFFE",
+	}
+	FederationRelationship_BundleEndpointProfile_value = map[string]int32{
+		"HTTPS_WEB":    0,
+		"HTTPS_SPIFFE": 1,
+	}
+)
+
+func (x FederationRelationship_BundleEndpointProfile) Enum() *FederationRelationship_BundleEndpointProfile {
+	p := new(FederationRelationship_BundleEndpointProfile)
+	*p = x
+	return p
+}
+
+func (x FederationRelationship_BundleEndpointProfile) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FederationRelationship_BundleEndpointProfile) Descriptor() protoreflect.EnumDescriptor {
+	return file_spire_server_datastore_datastore_proto_enumTypes[3].Descriptor()
+}
+
+func (FederationRelationship_BundleEndpointProfile) Type() protoreflect.EnumType {
+	return &file_spire_server_datastore_datastore_proto_enumTypes[3]
+}
+
+func (x FederationRelationship_BundleEndpointProfile) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FederationRelationship_BundleEndpointProfile.Descriptor instead.
+func (FederationRelationship_BundleEndpointProfile) EnumDescriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{70, 0}
+}
+
 type CreateBundleRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1297,8 +1346,8 @@ type ListNodeSelectorsRequest struct {
 	unknownFields protoimpl.UnknownFields
 
 	// When enabled, read-only connection will be used to connect to database read instances. Some staleness of data will be observed.
-	TolerateStale bool                   `protobuf:"varint,1,opt,name=tolerate_stale,json=tolerateStale,proto3" json:"tolerate_stale,omitempty"`
-	ValidAt       *timestamppb.Timestamp `protobuf:"bytes,3,opt,name=valid_at,json=validAt,proto3" json:"valid_at,omitempty"`
+	TolerateStale bool                 `protobuf:"varint,1,opt,name=tolerate_stale,json=tolerateStale,proto3" json:"tolerate_stale,omitempty"`
+	ValidAt       *timestamp.Timestamp `protobuf:"bytes,3,opt,name=valid_at,json=validAt,proto3" json:"valid_at,omitempty"`
 }
 
 func (x *ListNodeSelectorsRequest) Reset() {
@@ -1340,7 +1389,7 @@ func (x *ListNodeSelectorsRequest) GetTolerateStale() bool {
 	return false
 }
 
-func (x *ListNodeSelectorsRequest) GetValidAt() *timestamppb.Timestamp {
+func (x *ListNodeSelectorsRequest) GetValidAt() *timestamp.Timestamp {
 	if x != nil {
 		return x.ValidAt
 	}
@@ -1672,12 +1721,12 @@ type ListAttestedNodesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ByExpiresBefore   *wrapperspb.Int64Value `protobuf:"bytes,1,opt,name=by_expires_before,json=byExpiresBefore,proto3" json:"by_expires_before,omitempty"`
-	Pagination        *Pagination            `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
-	ByAttestationType string                 `protobuf:"bytes,3,opt,name=by_attestation_type,json=byAttestationType,proto3" json:"by_attestation_type,omitempty"`
-	BySelectorMatch   *BySelectors           `protobuf:"bytes,4,opt,name=by_selector_match,json=bySelectorMatch,proto3" json:"by_selector_match,omitempty"`
-	ByBanned          *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=by_banned,json=byBanned,proto3" json:"by_banned,omitempty"`
-	FetchSelectors    bool                   `protobuf:"varint,6,opt,name=fetch_selectors,json=fetchSelectors,proto3" json:"fetch_selectors,omitempty"`
+	ByExpiresBefore   *wrappers.Int64Value `protobuf:"bytes,1,opt,name=by_expires_before,json=byExpiresBefore,proto3" json:"by_expires_before,omitempty"`
+	Pagination        *Pagination          `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ByAttestationType string               `protobuf:"bytes,3,opt,name=by_attestation_type,json=byAttestationType,proto3" json:"by_attestation_type,omitempty"`
+	BySelectorMatch   *BySelectors         `protobuf:"bytes,4,opt,name=by_selector_match,json=bySelectorMatch,proto3" json:"by_selector_match,omitempty"`
+	ByBanned          *wrappers.BoolValue  `protobuf:"bytes,5,opt,name=by_banned,json=byBanned,proto3" json:"by_banned,omitempty"`
+	FetchSelectors    bool                 `protobuf:"varint,6,opt,name=fetch_selectors,json=fetchSelectors,proto3" json:"fetch_selectors,omitempty"`
 }
 
 func (x *ListAttestedNodesRequest) Reset() {
@@ -1712,7 +1761,7 @@ func (*ListAttestedNodesRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *ListAttestedNodesRequest) GetByExpiresBefore() *wrapperspb.Int64Value {
+func (x *ListAttestedNodesRequest) GetByExpiresBefore() *wrappers.Int64Value {
 	if x != nil {
 		return x.ByExpiresBefore
 	}
@@ -1740,7 +1789,7 @@ func (x *ListAttestedNodesRequest) GetBySelectorMatch() *BySelectors {
 	return nil
 }
 
-func (x *ListAttestedNodesRequest) GetByBanned() *wrapperspb.BoolValue {
+func (x *ListAttestedNodesRequest) GetByBanned() *wrappers.BoolValue {
 	if x != nil {
 		return x.ByBanned
 	}
@@ -2480,10 +2529,10 @@ type ListRegistrationEntriesRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ByParentId  *wrapperspb.StringValue `protobuf:"bytes,1,opt,name=by_parent_id,json=byParentId,proto3" json:"by_parent_id,omitempty"`
-	BySelectors *BySelectors            `protobuf:"bytes,2,opt,name=by_selectors,json=bySelectors,proto3" json:"by_selectors,omitempty"`
-	BySpiffeId  *wrapperspb.StringValue `protobuf:"bytes,3,opt,name=by_spiffe_id,json=bySpiffeId,proto3" json:"by_spiffe_id,omitempty"`
-	Pagination  *Pagination             `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	ByParentId  *wrappers.StringValue `protobuf:"bytes,1,opt,name=by_parent_id,json=byParentId,proto3" json:"by_parent_id,omitempty"`
+	BySelectors *BySelectors          `protobuf:"bytes,2,opt,name=by_selectors,json=bySelectors,proto3" json:"by_selectors,omitempty"`
+	BySpiffeId  *wrappers.StringValue `protobuf:"bytes,3,opt,name=by_spiffe_id,json=bySpiffeId,proto3" json:"by_spiffe_id,omitempty"`
+	Pagination  *Pagination           `protobuf:"bytes,4,opt,name=pagination,proto3" json:"pagination,omitempty"`
 	// When enabled, read-only connection will be used to connect to database read instances. Some staleness of data will be observed.
 	TolerateStale   bool             `protobuf:"varint,5,opt,name=tolerate_stale,json=tolerateStale,proto3" json:"tolerate_stale,omitempty"`
 	ByFederatesWith *ByFederatesWith `protobuf:"bytes,6,opt,name=by_federates_with,json=byFederatesWith,proto3" json:"by_federates_with,omitempty"`
@@ -2521,7 +2570,7 @@ func (*ListRegistrationEntriesRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *ListRegistrationEntriesRequest) GetByParentId() *wrapperspb.StringValue {
+func (x *ListRegistrationEntriesRequest) GetByParentId() *wrappers.StringValue {
 	if x != nil {
 		return x.ByParentId
 	}
@@ -2535,7 +2584,7 @@ func (x *ListRegistrationEntriesRequest) GetBySelectors() *BySelectors {
 	return nil
 }
 
-func (x *ListRegistrationEntriesRequest) GetBySpiffeId() *wrapperspb.StringValue {
+func (x *ListRegistrationEntriesRequest) GetBySpiffeId() *wrappers.StringValue {
 	if x != nil {
 		return x.BySpiffeId
 	}
@@ -2865,6 +2914,9 @@ type PruneRegistrationEntriesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	// Number of expired entries that were deleted
+	EntriesPruned int32 `protobuf:"varint,1,opt,name=entries_pruned,json=entriesPruned,proto3" json:"entries_pruned,omitempty"`
 }
 
 func (x *PruneRegistrationEntriesResponse) Reset() {
@@ -2899,19 +2951,35 @@ func (*PruneRegistrationEntriesResponse) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{53}
 }
 
-type JoinToken struct {
+func (x *PruneRegistrationEntriesResponse) GetEntriesPruned() int32 {
+	if x != nil {
+		return x.EntriesPruned
+	}
+	return 0
+}
+
+// A RegistrationEntryTombstone records the deletion of a registration entry,
+// so clients syncing entries incrementally can learn about removals.
+type RegistrationEntryTombstone struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Token value
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
-	// Expiration in seconds since unix epoch
-	Expiry int64 `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
-}
-
-func (x *JoinToken) Reset() {
-	*x = JoinToken{}
+	// ID of the deleted entry
+	EntryId string `protobuf:"bytes,1,opt,name=entry_id,json=entryId,proto3" json:"entry_id,omitempty"`
+	// SPIFFE ID of the deleted entry
+	SpiffeId string `protobuf:"bytes,2,opt,name=spiffe_id,json=spiffeId,proto3" json:"spiffe_id,omitempty"`
+	// Parent ID of the deleted entry
+	ParentId string `protobuf:"bytes,3,opt,name=parent_id,json=parentId,proto3" json:"parent_id,omitempty"`
+	// Revision number of the entry deletion, one past the last revision of
+	// the entry
+	RevisionNumber int64 `protobuf:"varint,4,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	// Deletion time in seconds since unix epoch. Set by the datastore.
+	DeletedAt int64 `protobuf:"varint,5,opt,name=deleted_at,json=deletedAt,proto3" json:"deleted_at,omitempty"`
+}
+
+func (x *RegistrationEntryTombstone) Reset() {
+	*x = RegistrationEntryTombstone{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2919,13 +2987,13 @@ func (x *JoinToken) Reset() {
 	}
 }
 
-func (x *JoinToken) String() string {
+func (x *RegistrationEntryTombstone) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*JoinToken) ProtoMessage() {}
+func (*RegistrationEntryTombstone) ProtoMessage() {}
 
-func (x *JoinToken) ProtoReflect() protoreflect.Message {
+func (x *RegistrationEntryTombstone) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2937,35 +3005,59 @@ func (x *JoinToken) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use JoinToken.ProtoReflect.Descriptor instead.
-func (*JoinToken) Descriptor() ([]byte, []int) {
+// Deprecated: Use RegistrationEntryTombstone.ProtoReflect.Descriptor instead.
+func (*RegistrationEntryTombstone) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *JoinToken) GetToken() string {
+func (x *RegistrationEntryTombstone) GetEntryId() string {
 	if x != nil {
-		return x.Token
+		return x.EntryId
 	}
 	return ""
 }
 
-func (x *JoinToken) GetExpiry() int64 {
+func (x *RegistrationEntryTombstone) GetSpiffeId() string {
 	if x != nil {
-		return x.Expiry
+		return x.SpiffeId
+	}
+	return ""
+}
+
+func (x *RegistrationEntryTombstone) GetParentId() string {
+	if x != nil {
+		return x.ParentId
+	}
+	return ""
+}
+
+func (x *RegistrationEntryTombstone) GetRevisionNumber() int64 {
+	if x != nil {
+		return x.RevisionNumber
 	}
 	return 0
 }
 
-type CreateJoinTokenRequest struct {
+func (x *RegistrationEntryTombstone) GetDeletedAt() int64 {
+	if x != nil {
+		return x.DeletedAt
+	}
+	return 0
+}
+
+type ListRegistrationEntryTombstonesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+	// Only list the tombstones of entries deleted at or after this time, in
+	// seconds since unix epoch
+	DeletedSince int64       `protobuf:"varint,1,opt,name=deleted_since,json=deletedSince,proto3" json:"deleted_since,omitempty"`
+	Pagination   *Pagination `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *CreateJoinTokenRequest) Reset() {
-	*x = CreateJoinTokenRequest{}
+func (x *ListRegistrationEntryTombstonesRequest) Reset() {
+	*x = ListRegistrationEntryTombstonesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2973,13 +3065,13 @@ func (x *CreateJoinTokenRequest) Reset() {
 	}
 }
 
-func (x *CreateJoinTokenRequest) String() string {
+func (x *ListRegistrationEntryTombstonesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJoinTokenRequest) ProtoMessage() {}
+func (*ListRegistrationEntryTombstonesRequest) ProtoMessage() {}
 
-func (x *CreateJoinTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *ListRegistrationEntryTombstonesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2991,28 +3083,36 @@ func (x *CreateJoinTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJoinTokenRequest.ProtoReflect.Descriptor instead.
-func (*CreateJoinTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRegistrationEntryTombstonesRequest.ProtoReflect.Descriptor instead.
+func (*ListRegistrationEntryTombstonesRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *CreateJoinTokenRequest) GetJoinToken() *JoinToken {
+func (x *ListRegistrationEntryTombstonesRequest) GetDeletedSince() int64 {
 	if x != nil {
-		return x.JoinToken
+		return x.DeletedSince
+	}
+	return 0
+}
+
+func (x *ListRegistrationEntryTombstonesRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
 	}
 	return nil
 }
 
-type CreateJoinTokenResponse struct {
+type ListRegistrationEntryTombstonesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+	Tombstones []*RegistrationEntryTombstone `protobuf:"bytes,1,rep,name=tombstones,proto3" json:"tombstones,omitempty"`
+	Pagination *Pagination                   `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (x *CreateJoinTokenResponse) Reset() {
-	*x = CreateJoinTokenResponse{}
+func (x *ListRegistrationEntryTombstonesResponse) Reset() {
+	*x = ListRegistrationEntryTombstonesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[56]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3020,13 +3120,13 @@ func (x *CreateJoinTokenResponse) Reset() {
 	}
 }
 
-func (x *CreateJoinTokenResponse) String() string {
+func (x *ListRegistrationEntryTombstonesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateJoinTokenResponse) ProtoMessage() {}
+func (*ListRegistrationEntryTombstonesResponse) ProtoMessage() {}
 
-func (x *CreateJoinTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *ListRegistrationEntryTombstonesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[56]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3038,28 +3138,35 @@ func (x *CreateJoinTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateJoinTokenResponse.ProtoReflect.Descriptor instead.
-func (*CreateJoinTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListRegistrationEntryTombstonesResponse.ProtoReflect.Descriptor instead.
+func (*ListRegistrationEntryTombstonesResponse) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{56}
 }
 
-func (x *CreateJoinTokenResponse) GetJoinToken() *JoinToken {
+func (x *ListRegistrationEntryTombstonesResponse) GetTombstones() []*RegistrationEntryTombstone {
 	if x != nil {
-		return x.JoinToken
+		return x.Tombstones
 	}
 	return nil
 }
 
-type FetchJoinTokenRequest struct {
+func (x *ListRegistrationEntryTombstonesResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type PruneRegistrationEntryTombstonesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	DeletedBefore int64 `protobuf:"varint,1,opt,name=deleted_before,json=deletedBefore,proto3" json:"deleted_before,omitempty"`
 }
 
-func (x *FetchJoinTokenRequest) Reset() {
-	*x = FetchJoinTokenRequest{}
+func (x *PruneRegistrationEntryTombstonesRequest) Reset() {
+	*x = PruneRegistrationEntryTombstonesRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3067,13 +3174,13 @@ func (x *FetchJoinTokenRequest) Reset() {
 	}
 }
 
-func (x *FetchJoinTokenRequest) String() string {
+func (x *PruneRegistrationEntryTombstonesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchJoinTokenRequest) ProtoMessage() {}
+func (*PruneRegistrationEntryTombstonesRequest) ProtoMessage() {}
 
-func (x *FetchJoinTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *PruneRegistrationEntryTombstonesRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3085,28 +3192,26 @@ func (x *FetchJoinTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchJoinTokenRequest.ProtoReflect.Descriptor instead.
-func (*FetchJoinTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use PruneRegistrationEntryTombstonesRequest.ProtoReflect.Descriptor instead.
+func (*PruneRegistrationEntryTombstonesRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *FetchJoinTokenRequest) GetToken() string {
+func (x *PruneRegistrationEntryTombstonesRequest) GetDeletedBefore() int64 {
 	if x != nil {
-		return x.Token
+		return x.DeletedBefore
 	}
-	return ""
+	return 0
 }
 
-type FetchJoinTokenResponse struct {
+type PruneRegistrationEntryTombstonesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
 }
 
-func (x *FetchJoinTokenResponse) Reset() {
-	*x = FetchJoinTokenResponse{}
+func (x *PruneRegistrationEntryTombstonesResponse) Reset() {
+	*x = PruneRegistrationEntryTombstonesResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3114,13 +3219,13 @@ func (x *FetchJoinTokenResponse) Reset() {
 	}
 }
 
-func (x *FetchJoinTokenResponse) String() string {
+func (x *PruneRegistrationEntryTombstonesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FetchJoinTokenResponse) ProtoMessage() {}
+func (*PruneRegistrationEntryTombstonesResponse) ProtoMessage() {}
 
-func (x *FetchJoinTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *PruneRegistrationEntryTombstonesResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3132,28 +3237,29 @@ func (x *FetchJoinTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FetchJoinTokenResponse.ProtoReflect.Descriptor instead.
-func (*FetchJoinTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use PruneRegistrationEntryTombstonesResponse.ProtoReflect.Descriptor instead.
+func (*PruneRegistrationEntryTombstonesResponse) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *FetchJoinTokenResponse) GetJoinToken() *JoinToken {
-	if x != nil {
-		return x.JoinToken
-	}
-	return nil
-}
-
-type DeleteJoinTokenRequest struct {
+type JoinToken struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
+	// Token value
 	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+	// Expiration in seconds since unix epoch
+	Expiry int64 `protobuf:"varint,2,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// Creation time in seconds since unix epoch. Set by the datastore.
+	CreatedAt int64 `protobuf:"varint,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	// Optional SPIFFE ID assigned to the agent attested with the token,
+	// beyond the one given by join token attestation
+	AgentId string `protobuf:"bytes,4,opt,name=agent_id,json=agentId,proto3" json:"agent_id,omitempty"`
 }
 
-func (x *DeleteJoinTokenRequest) Reset() {
-	*x = DeleteJoinTokenRequest{}
+func (x *JoinToken) Reset() {
+	*x = JoinToken{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3161,13 +3267,13 @@ func (x *DeleteJoinTokenRequest) Reset() {
 	}
 }
 
-func (x *DeleteJoinTokenRequest) String() string {
+func (x *JoinToken) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJoinTokenRequest) ProtoMessage() {}
+func (*JoinToken) ProtoMessage() {}
 
-func (x *DeleteJoinTokenRequest) ProtoReflect() protoreflect.Message {
+func (x *JoinToken) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3179,19 +3285,40 @@ func (x *DeleteJoinTokenRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJoinTokenRequest.ProtoReflect.Descriptor instead.
-func (*DeleteJoinTokenRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use JoinToken.ProtoReflect.Descriptor instead.
+func (*JoinToken) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *DeleteJoinTokenRequest) GetToken() string {
+func (x *JoinToken) GetToken() string {
 	if x != nil {
 		return x.Token
 	}
 	return ""
 }
 
-type DeleteJoinTokenResponse struct {
+func (x *JoinToken) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *JoinToken) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *JoinToken) GetAgentId() string {
+	if x != nil {
+		return x.AgentId
+	}
+	return ""
+}
+
+type CreateJoinTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
@@ -3199,8 +3326,8 @@ type DeleteJoinTokenResponse struct {
 	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
 }
 
-func (x *DeleteJoinTokenResponse) Reset() {
-	*x = DeleteJoinTokenResponse{}
+func (x *CreateJoinTokenRequest) Reset() {
+	*x = CreateJoinTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3208,13 +3335,13 @@ func (x *DeleteJoinTokenResponse) Reset() {
 	}
 }
 
-func (x *DeleteJoinTokenResponse) String() string {
+func (x *CreateJoinTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DeleteJoinTokenResponse) ProtoMessage() {}
+func (*CreateJoinTokenRequest) ProtoMessage() {}
 
-func (x *DeleteJoinTokenResponse) ProtoReflect() protoreflect.Message {
+func (x *CreateJoinTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3226,28 +3353,28 @@ func (x *DeleteJoinTokenResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DeleteJoinTokenResponse.ProtoReflect.Descriptor instead.
-func (*DeleteJoinTokenResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJoinTokenRequest.ProtoReflect.Descriptor instead.
+func (*CreateJoinTokenRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *DeleteJoinTokenResponse) GetJoinToken() *JoinToken {
+func (x *CreateJoinTokenRequest) GetJoinToken() *JoinToken {
 	if x != nil {
 		return x.JoinToken
 	}
 	return nil
 }
 
-type PruneJoinTokensRequest struct {
+type CreateJoinTokenResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ExpiresBefore int64 `protobuf:"varint,1,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
+	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
 }
 
-func (x *PruneJoinTokensRequest) Reset() {
-	*x = PruneJoinTokensRequest{}
+func (x *CreateJoinTokenResponse) Reset() {
+	*x = CreateJoinTokenResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3255,13 +3382,13 @@ func (x *PruneJoinTokensRequest) Reset() {
 	}
 }
 
-func (x *PruneJoinTokensRequest) String() string {
+func (x *CreateJoinTokenResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PruneJoinTokensRequest) ProtoMessage() {}
+func (*CreateJoinTokenResponse) ProtoMessage() {}
 
-func (x *PruneJoinTokensRequest) ProtoReflect() protoreflect.Message {
+func (x *CreateJoinTokenResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3273,26 +3400,28 @@ func (x *PruneJoinTokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PruneJoinTokensRequest.ProtoReflect.Descriptor instead.
-func (*PruneJoinTokensRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CreateJoinTokenResponse.ProtoReflect.Descriptor instead.
+func (*CreateJoinTokenResponse) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *PruneJoinTokensRequest) GetExpiresBefore() int64 {
+func (x *CreateJoinTokenResponse) GetJoinToken() *JoinToken {
 	if x != nil {
-		return x.ExpiresBefore
+		return x.JoinToken
 	}
-	return 0
+	return nil
 }
 
-type PruneJoinTokensResponse struct {
+type FetchJoinTokenRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
 }
 
-func (x *PruneJoinTokensResponse) Reset() {
-	*x = PruneJoinTokensResponse{}
+func (x *FetchJoinTokenRequest) Reset() {
+	*x = FetchJoinTokenRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_spire_server_datastore_datastore_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3300,13 +3429,13 @@ func (x *PruneJoinTokensResponse) Reset() {
 	}
 }
 
-func (x *PruneJoinTokensResponse) String() string {
+func (x *FetchJoinTokenRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PruneJoinTokensResponse) ProtoMessage() {}
+func (*FetchJoinTokenRequest) ProtoMessage() {}
 
-func (x *PruneJoinTokensResponse) ProtoReflect() protoreflect.Message {
+func (x *FetchJoinTokenRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_spire_server_datastore_datastore_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -3318,33 +3447,1016 @@ func (x *PruneJoinTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PruneJoinTokensResponse.ProtoReflect.Descriptor instead.
-func (*PruneJoinTokensResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use FetchJoinTokenRequest.ProtoReflect.Descriptor instead.
+func (*FetchJoinTokenRequest) Descriptor() ([]byte, []int) {
 	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{62}
 }
 
-var File_spire_server_datastore_datastore_proto protoreflect.FileDescriptor
+func (x *FetchJoinTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
 
-var file_spire_server_datastore_datastore_proto_rawDesc = []byte{
-	0x0a, 0x26, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
-	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x1a, 0x20, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
-	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43,
-	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f,
-	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x62, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x62,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70,
+type FetchJoinTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+}
+
+func (x *FetchJoinTokenResponse) Reset() {
+	*x = FetchJoinTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchJoinTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchJoinTokenResponse) ProtoMessage() {}
+
+func (x *FetchJoinTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchJoinTokenResponse.ProtoReflect.Descriptor instead.
+func (*FetchJoinTokenResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *FetchJoinTokenResponse) GetJoinToken() *JoinToken {
+	if x != nil {
+		return x.JoinToken
+	}
+	return nil
+}
+
+type ListJoinTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListJoinTokensRequest) Reset() {
+	*x = ListJoinTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJoinTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinTokensRequest) ProtoMessage() {}
+
+func (x *ListJoinTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinTokensRequest.ProtoReflect.Descriptor instead.
+func (*ListJoinTokensRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *ListJoinTokensRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListJoinTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinTokens []*JoinToken `protobuf:"bytes,1,rep,name=join_tokens,json=joinTokens,proto3" json:"join_tokens,omitempty"`
+	Pagination *Pagination  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListJoinTokensResponse) Reset() {
+	*x = ListJoinTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListJoinTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListJoinTokensResponse) ProtoMessage() {}
+
+func (x *ListJoinTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListJoinTokensResponse.ProtoReflect.Descriptor instead.
+func (*ListJoinTokensResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *ListJoinTokensResponse) GetJoinTokens() []*JoinToken {
+	if x != nil {
+		return x.JoinTokens
+	}
+	return nil
+}
+
+func (x *ListJoinTokensResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type DeleteJoinTokenRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Token string `protobuf:"bytes,1,opt,name=token,proto3" json:"token,omitempty"`
+}
+
+func (x *DeleteJoinTokenRequest) Reset() {
+	*x = DeleteJoinTokenRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJoinTokenRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJoinTokenRequest) ProtoMessage() {}
+
+func (x *DeleteJoinTokenRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJoinTokenRequest.ProtoReflect.Descriptor instead.
+func (*DeleteJoinTokenRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *DeleteJoinTokenRequest) GetToken() string {
+	if x != nil {
+		return x.Token
+	}
+	return ""
+}
+
+type DeleteJoinTokenResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	JoinToken *JoinToken `protobuf:"bytes,1,opt,name=join_token,json=joinToken,proto3" json:"join_token,omitempty"`
+}
+
+func (x *DeleteJoinTokenResponse) Reset() {
+	*x = DeleteJoinTokenResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteJoinTokenResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteJoinTokenResponse) ProtoMessage() {}
+
+func (x *DeleteJoinTokenResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteJoinTokenResponse.ProtoReflect.Descriptor instead.
+func (*DeleteJoinTokenResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *DeleteJoinTokenResponse) GetJoinToken() *JoinToken {
+	if x != nil {
+		return x.JoinToken
+	}
+	return nil
+}
+
+type PruneJoinTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ExpiresBefore int64 `protobuf:"varint,1,opt,name=expires_before,json=expiresBefore,proto3" json:"expires_before,omitempty"`
+}
+
+func (x *PruneJoinTokensRequest) Reset() {
+	*x = PruneJoinTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneJoinTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneJoinTokensRequest) ProtoMessage() {}
+
+func (x *PruneJoinTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneJoinTokensRequest.ProtoReflect.Descriptor instead.
+func (*PruneJoinTokensRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *PruneJoinTokensRequest) GetExpiresBefore() int64 {
+	if x != nil {
+		return x.ExpiresBefore
+	}
+	return 0
+}
+
+type PruneJoinTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Number of expired join tokens that were deleted
+	TokensPruned int32 `protobuf:"varint,1,opt,name=tokens_pruned,json=tokensPruned,proto3" json:"tokens_pruned,omitempty"`
+}
+
+func (x *PruneJoinTokensResponse) Reset() {
+	*x = PruneJoinTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PruneJoinTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PruneJoinTokensResponse) ProtoMessage() {}
+
+func (x *PruneJoinTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PruneJoinTokensResponse.ProtoReflect.Descriptor instead.
+func (*PruneJoinTokensResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *PruneJoinTokensResponse) GetTokensPruned() int32 {
+	if x != nil {
+		return x.TokensPruned
+	}
+	return 0
+}
+
+type FederationRelationship struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Trust domain ID of the federated trust domain (e.g. spiffe://domain.test)
+	TrustDomainId string `protobuf:"bytes,1,opt,name=trust_domain_id,json=trustDomainId,proto3" json:"trust_domain_id,omitempty"`
+	// URL of the bundle endpoint
+	BundleEndpointUrl string `protobuf:"bytes,2,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+	// Profile used to authenticate the bundle endpoint
+	BundleEndpointProfile FederationRelationship_BundleEndpointProfile `protobuf:"varint,3,opt,name=bundle_endpoint_profile,json=bundleEndpointProfile,proto3,enum=spire.server.datastore.FederationRelationship_BundleEndpointProfile" json:"bundle_endpoint_profile,omitempty"`
+	// Expected SPIFFE ID of the bundle endpoint server. Only used with the
+	// HTTPS_SPIFFE profile.
+	EndpointSpiffeId string `protobuf:"bytes,4,opt,name=endpoint_spiffe_id,json=endpointSpiffeId,proto3" json:"endpoint_spiffe_id,omitempty"`
+	// ASN.1 DER encoded root CA certificates trusted to authenticate the
+	// bundle endpoint server, in addition to the system roots. Only used
+	// with the HTTPS_WEB profile.
+	WebPkiRootCas [][]byte `protobuf:"bytes,5,rep,name=web_pki_root_cas,json=webPkiRootCas,proto3" json:"web_pki_root_cas,omitempty"`
+	// If true, the bundle endpoint server is authenticated with
+	// web_pki_root_cas only. Only used with the HTTPS_WEB profile.
+	PinWebPkiRootCas bool `protobuf:"varint,6,opt,name=pin_web_pki_root_cas,json=pinWebPkiRootCas,proto3" json:"pin_web_pki_root_cas,omitempty"`
+}
+
+func (x *FederationRelationship) Reset() {
+	*x = FederationRelationship{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationRelationship) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationRelationship) ProtoMessage() {}
+
+func (x *FederationRelationship) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationRelationship.ProtoReflect.Descriptor instead.
+func (*FederationRelationship) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *FederationRelationship) GetTrustDomainId() string {
+	if x != nil {
+		return x.TrustDomainId
+	}
+	return ""
+}
+
+func (x *FederationRelationship) GetBundleEndpointUrl() string {
+	if x != nil {
+		return x.BundleEndpointUrl
+	}
+	return ""
+}
+
+func (x *FederationRelationship) GetBundleEndpointProfile() FederationRelationship_BundleEndpointProfile {
+	if x != nil {
+		return x.BundleEndpointProfile
+	}
+	return FederationRelationship_HTTPS_WEB
+}
+
+func (x *FederationRelationship) GetEndpointSpiffeId() string {
+	if x != nil {
+		return x.EndpointSpiffeId
+	}
+	return ""
+}
+
+func (x *FederationRelationship) GetWebPkiRootCas() [][]byte {
+	if x != nil {
+		return x.WebPkiRootCas
+	}
+	return nil
+}
+
+func (x *FederationRelationship) GetPinWebPkiRootCas() bool {
+	if x != nil {
+		return x.PinWebPkiRootCas
+	}
+	return false
+}
+
+type FederationRelationshipMask struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	BundleEndpointUrl     bool `protobuf:"varint,1,opt,name=bundle_endpoint_url,json=bundleEndpointUrl,proto3" json:"bundle_endpoint_url,omitempty"`
+	BundleEndpointProfile bool `protobuf:"varint,2,opt,name=bundle_endpoint_profile,json=bundleEndpointProfile,proto3" json:"bundle_endpoint_profile,omitempty"`
+}
+
+func (x *FederationRelationshipMask) Reset() {
+	*x = FederationRelationshipMask{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FederationRelationshipMask) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FederationRelationshipMask) ProtoMessage() {}
+
+func (x *FederationRelationshipMask) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FederationRelationshipMask.ProtoReflect.Descriptor instead.
+func (*FederationRelationshipMask) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *FederationRelationshipMask) GetBundleEndpointUrl() bool {
+	if x != nil {
+		return x.BundleEndpointUrl
+	}
+	return false
+}
+
+func (x *FederationRelationshipMask) GetBundleEndpointProfile() bool {
+	if x != nil {
+		return x.BundleEndpointProfile
+	}
+	return false
+}
+
+type CreateFederationRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+}
+
+func (x *CreateFederationRelationshipRequest) Reset() {
+	*x = CreateFederationRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFederationRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFederationRelationshipRequest) ProtoMessage() {}
+
+func (x *CreateFederationRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFederationRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*CreateFederationRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *CreateFederationRelationshipRequest) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+type CreateFederationRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+}
+
+func (x *CreateFederationRelationshipResponse) Reset() {
+	*x = CreateFederationRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateFederationRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateFederationRelationshipResponse) ProtoMessage() {}
+
+func (x *CreateFederationRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateFederationRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*CreateFederationRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *CreateFederationRelationshipResponse) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+type FetchFederationRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrustDomainId string `protobuf:"bytes,1,opt,name=trust_domain_id,json=trustDomainId,proto3" json:"trust_domain_id,omitempty"`
+}
+
+func (x *FetchFederationRelationshipRequest) Reset() {
+	*x = FetchFederationRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchFederationRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchFederationRelationshipRequest) ProtoMessage() {}
+
+func (x *FetchFederationRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchFederationRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*FetchFederationRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *FetchFederationRelationshipRequest) GetTrustDomainId() string {
+	if x != nil {
+		return x.TrustDomainId
+	}
+	return ""
+}
+
+type FetchFederationRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+}
+
+func (x *FetchFederationRelationshipResponse) Reset() {
+	*x = FetchFederationRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *FetchFederationRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*FetchFederationRelationshipResponse) ProtoMessage() {}
+
+func (x *FetchFederationRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use FetchFederationRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*FetchFederationRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *FetchFederationRelationshipResponse) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+type ListFederationRelationshipsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pagination *Pagination `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListFederationRelationshipsRequest) Reset() {
+	*x = ListFederationRelationshipsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationRelationshipsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationRelationshipsRequest) ProtoMessage() {}
+
+func (x *ListFederationRelationshipsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationRelationshipsRequest.ProtoReflect.Descriptor instead.
+func (*ListFederationRelationshipsRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *ListFederationRelationshipsRequest) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type ListFederationRelationshipsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationships []*FederationRelationship `protobuf:"bytes,1,rep,name=federation_relationships,json=federationRelationships,proto3" json:"federation_relationships,omitempty"`
+	Pagination              *Pagination               `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (x *ListFederationRelationshipsResponse) Reset() {
+	*x = ListFederationRelationshipsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListFederationRelationshipsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListFederationRelationshipsResponse) ProtoMessage() {}
+
+func (x *ListFederationRelationshipsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListFederationRelationshipsResponse.ProtoReflect.Descriptor instead.
+func (*ListFederationRelationshipsResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListFederationRelationshipsResponse) GetFederationRelationships() []*FederationRelationship {
+	if x != nil {
+		return x.FederationRelationships
+	}
+	return nil
+}
+
+func (x *ListFederationRelationshipsResponse) GetPagination() *Pagination {
+	if x != nil {
+		return x.Pagination
+	}
+	return nil
+}
+
+type UpdateFederationRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship     `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+	InputMask              *FederationRelationshipMask `protobuf:"bytes,2,opt,name=input_mask,json=inputMask,proto3" json:"input_mask,omitempty"`
+}
+
+func (x *UpdateFederationRelationshipRequest) Reset() {
+	*x = UpdateFederationRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFederationRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFederationRelationshipRequest) ProtoMessage() {}
+
+func (x *UpdateFederationRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFederationRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*UpdateFederationRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *UpdateFederationRelationshipRequest) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+func (x *UpdateFederationRelationshipRequest) GetInputMask() *FederationRelationshipMask {
+	if x != nil {
+		return x.InputMask
+	}
+	return nil
+}
+
+type UpdateFederationRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+}
+
+func (x *UpdateFederationRelationshipResponse) Reset() {
+	*x = UpdateFederationRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateFederationRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateFederationRelationshipResponse) ProtoMessage() {}
+
+func (x *UpdateFederationRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateFederationRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*UpdateFederationRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *UpdateFederationRelationshipResponse) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+type DeleteFederationRelationshipRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TrustDomainId string `protobuf:"bytes,1,opt,name=trust_domain_id,json=trustDomainId,proto3" json:"trust_domain_id,omitempty"`
+}
+
+func (x *DeleteFederationRelationshipRequest) Reset() {
+	*x = DeleteFederationRelationshipRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFederationRelationshipRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFederationRelationshipRequest) ProtoMessage() {}
+
+func (x *DeleteFederationRelationshipRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFederationRelationshipRequest.ProtoReflect.Descriptor instead.
+func (*DeleteFederationRelationshipRequest) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *DeleteFederationRelationshipRequest) GetTrustDomainId() string {
+	if x != nil {
+		return x.TrustDomainId
+	}
+	return ""
+}
+
+type DeleteFederationRelationshipResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	FederationRelationship *FederationRelationship `protobuf:"bytes,1,opt,name=federation_relationship,json=federationRelationship,proto3" json:"federation_relationship,omitempty"`
+}
+
+func (x *DeleteFederationRelationshipResponse) Reset() {
+	*x = DeleteFederationRelationshipResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_datastore_datastore_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteFederationRelationshipResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteFederationRelationshipResponse) ProtoMessage() {}
+
+func (x *DeleteFederationRelationshipResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_datastore_datastore_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteFederationRelationshipResponse.ProtoReflect.Descriptor instead.
+func (*DeleteFederationRelationshipResponse) Descriptor() ([]byte, []int) {
+	return file_spire_server_datastore_datastore_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *DeleteFederationRelationshipResponse) GetFederationRelationship() *FederationRelationship {
+	if x != nil {
+		return x.FederationRelationship
+	}
+	return nil
+}
+
+var File_spire_server_datastore_datastore_proto protoreflect.FileDescriptor
+
+var file_spire_server_datastore_datastore_proto_rawDesc = []byte{
+	0x0a, 0x26, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x16, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
+	0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x1e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2f, 0x77, 0x72, 0x61, 0x70, 0x70, 0x65, 0x72, 0x73, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x1a, 0x20, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2f,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2f, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x19, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
+	0x6e, 0x2f, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x43,
+	0x0a, 0x13, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x06, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x22, 0x44, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2c, 0x0a, 0x06, 0x62,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70,
 	0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c,
 	0x65, 0x52, 0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x3c, 0x0a, 0x12, 0x46, 0x65, 0x74,
 	0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
@@ -3678,284 +4790,551 @@ var file_spire_server_datastore_datastore_proto_rawDesc = []byte{
 	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
 	0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65, 0x66,
 	0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72,
-	0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x22, 0x0a, 0x20, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x49, 0x0a, 0x20, 0x50, 0x72, 0x75, 0x6e,
 	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x39, 0x0a, 0x09,
-	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
-	0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x22, 0x5a, 0x0a, 0x16, 0x43, 0x72, 0x65, 0x61, 0x74,
-	0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25, 0x0a, 0x0e,
+	0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x65, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x64, 0x22, 0xb9, 0x01, 0x0a, 0x1a, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x5f, 0x69, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x65, 0x6e, 0x74, 0x72, 0x79, 0x49, 0x64, 0x12, 0x1b, 0x0a,
+	0x09, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x08, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x72, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70,
+	0x61, 0x72, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e, 0x75, 0x6d, 0x62, 0x65, 0x72,
+	0x12, 0x1d, 0x0a, 0x0a, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x41, 0x74, 0x22,
+	0x91, 0x01, 0x0a, 0x26, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x65,
+	0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x52, 0x0c, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x53, 0x69, 0x6e, 0x63, 0x65, 0x12,
+	0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x22, 0xc1, 0x01, 0x0a, 0x27, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d,
+	0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x52, 0x0a, 0x0a, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f,
+	0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x52, 0x0a, 0x74, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f,
+	0x6e, 0x65, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67,
+	0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x50, 0x0a, 0x27, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
+	0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x5f, 0x62, 0x65,
+	0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x64, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x64, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x2a, 0x0a, 0x28, 0x50, 0x72, 0x75,
+	0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x73, 0x0a, 0x09, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69,
+	0x72, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79,
+	0x12, 0x1d, 0x0a, 0x0a, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x09, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x41, 0x74, 0x12,
+	0x19, 0x0a, 0x08, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x67, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x22, 0x5a, 0x0a, 0x16, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x6a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
 	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
 	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4a,
 	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69,
-	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x22, 0x2d, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
-	0x5a, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6b, 0x65, 0x6e, 0x22, 0x2d, 0x0a, 0x15, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x22, 0x5a, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a,
+	0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x21, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f,
+	0x6b, 0x65, 0x6e, 0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b,
+	0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xa0, 0x01, 0x0a, 0x16,
+	0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x42, 0x0a, 0x0b, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x0a,
+	0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x2e,
+	0x0a, 0x16, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b,
+	0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65,
 	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x69,
 	0x6e, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e,
 	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
 	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x2e, 0x0a, 0x16, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x5b, 0x0a, 0x17, 0x44,
-	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x6a, 0x6f, 0x69, 0x6e, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x73, 0x70, 0x69,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x09, 0x6a,
-	0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x16, 0x50, 0x72, 0x75, 0x6e,
-	0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x62, 0x65,
-	0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65, 0x78, 0x70, 0x69,
-	0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x19, 0x0a, 0x17, 0x50, 0x72, 0x75,
-	0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x32, 0xfb, 0x1c, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f,
-	0x72, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a,
-	0x0b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x73,
-	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c,
-	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x75,
-	0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
-	0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x66, 0x0a, 0x0b, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12,
-	0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70,
+	0x52, 0x09, 0x6a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22, 0x3f, 0x0a, 0x16, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73,
+	0x5f, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x65,
+	0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x42, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x22, 0x3e, 0x0a, 0x17,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x5f, 0x70, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x64, 0x22, 0xaf, 0x03, 0x0a,
+	0x16, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74,
+	0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x2e, 0x0a, 0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x62, 0x75,
+	0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12,
+	0x7c, 0x0a, 0x17, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+	0x6e, 0x74, 0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x44, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50,
+	0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x52, 0x15, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x2c, 0x0a,
+	0x12, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x5f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65,
+	0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+	0x69, 0x6e, 0x74, 0x53, 0x70, 0x69, 0x66, 0x66, 0x65, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x77,
+	0x65, 0x62, 0x5f, 0x70, 0x6b, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x18,
+	0x05, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0d, 0x77, 0x65, 0x62, 0x50, 0x6b, 0x69, 0x52, 0x6f, 0x6f,
+	0x74, 0x43, 0x61, 0x73, 0x12, 0x2e, 0x0a, 0x14, 0x70, 0x69, 0x6e, 0x5f, 0x77, 0x65, 0x62, 0x5f,
+	0x70, 0x6b, 0x69, 0x5f, 0x72, 0x6f, 0x6f, 0x74, 0x5f, 0x63, 0x61, 0x73, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x10, 0x70, 0x69, 0x6e, 0x57, 0x65, 0x62, 0x50, 0x6b, 0x69, 0x52, 0x6f, 0x6f,
+	0x74, 0x43, 0x61, 0x73, 0x22, 0x38, 0x0a, 0x15, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e,
+	0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x12, 0x0d, 0x0a,
+	0x09, 0x48, 0x54, 0x54, 0x50, 0x53, 0x5f, 0x57, 0x45, 0x42, 0x10, 0x00, 0x12, 0x10, 0x0a, 0x0c,
+	0x48, 0x54, 0x54, 0x50, 0x53, 0x5f, 0x53, 0x50, 0x49, 0x46, 0x46, 0x45, 0x10, 0x01, 0x22, 0x84,
+	0x01, 0x0a, 0x1a, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x73, 0x6b, 0x12, 0x2e, 0x0a,
+	0x13, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x11, 0x62, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x55, 0x72, 0x6c, 0x12, 0x36, 0x0a,
+	0x17, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+	0x5f, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15,
+	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x50, 0x72,
+	0x6f, 0x66, 0x69, 0x6c, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x23, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x67, 0x0a,
+	0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x16,
+	0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x8f, 0x01, 0x0a, 0x24, 0x43, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x67, 0x0a, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65,
+	0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x52, 0x16, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x4c, 0x0a, 0x22, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26,
+	0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f,
+	0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x8e, 0x01, 0x0a, 0x23, 0x46, 0x65, 0x74, 0x63, 0x68,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67,
+	0x0a, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52,
+	0x16, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x68, 0x0a, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a,
+	0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0xd4, 0x01, 0x0a, 0x23, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x18, 0x66, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x70,
 	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x17, 0x66, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x73, 0x12, 0x42, 0x0a, 0x0a, 0x70, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x60, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x12, 0x28, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x70, 0x69,
+	0x65, 0x2e, 0x50, 0x61, 0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x70, 0x61,
+	0x67, 0x69, 0x6e, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xe1, 0x01, 0x0a, 0x23, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x67, 0x0a, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x52, 0x16, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x51, 0x0a, 0x0a, 0x69, 0x6e, 0x70,
+	0x75, 0x74, 0x5f, 0x6d, 0x61, 0x73, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x32, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x4d, 0x61, 0x73,
+	0x6b, 0x52, 0x09, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x4d, 0x61, 0x73, 0x6b, 0x22, 0x8f, 0x01, 0x0a,
+	0x24, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x16, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x22, 0x4d,
+	0x0a, 0x23, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x74, 0x72, 0x75, 0x73, 0x74, 0x5f, 0x64,
+	0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d,
+	0x74, 0x72, 0x75, 0x73, 0x74, 0x44, 0x6f, 0x6d, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0x8f, 0x01,
+	0x0a, 0x24, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x67, 0x0a, 0x17, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x72, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69,
+	0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x16, 0x66, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x32,
+	0xbf, 0x26, 0x0a, 0x09, 0x44, 0x61, 0x74, 0x61, 0x53, 0x74, 0x6f, 0x72, 0x65, 0x12, 0x69, 0x0a,
+	0x0c, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69,
 	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42,
-	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41,
-	0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x65,
-	0x6e, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x69, 0x0a, 0x0c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x69, 0x0a, 0x0c, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73,
 	0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
 	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x6e,
-	0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x50,
-	0x72, 0x75, 0x6e, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x69,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x75, 0x6e, 0x64,
+	0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x4c,
+	0x69, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x69,
 	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
+	0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52,
 	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x50, 0x72, 0x75, 0x6e, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74,
-	0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x4c, 0x69, 0x73, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x60,
+	0x0a, 0x09, 0x53, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x28, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53,
+	0x65, 0x74, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x69, 0x0a, 0x0c, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65,
+	0x12, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x41, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x69, 0x0a, 0x0c, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2b, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0b, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x42,
+	0x75, 0x6e, 0x64, 0x6c, 0x65, 0x12, 0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50,
+	0x72, 0x75, 0x6e, 0x65, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x2b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65,
+	0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b,
+	0x0a, 0x12, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64,
+	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x46,
+	0x65, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
+	0x12, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63,
+	0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74,
+	0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x31, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41, 0x74, 0x74,
+	0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
+	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f,
+	0x64, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12,
+	0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41,
+	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
+	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65,
+	0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a,
+	0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
+	0x73, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f,
+	0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
+	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63,
+	0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c,
+	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a,
+	0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x36, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
+	0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x87, 0x01, 0x0a, 0x16,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x35, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x36, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
+	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69,
+	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x12, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e,
+	0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65,
+	0x73, 0x12, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52,
+	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69,
+	0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
+	0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67,
+	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x36,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
+	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x8a, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x36, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
+	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a,
+	0x18, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72,
 	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73,
-	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x78, 0x0a, 0x11, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65,
-	0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46,
-	0x65, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e,
+	0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
+	0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0xa2, 0x01, 0x0a,
+	0x1f, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73,
+	0x12, 0x3e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x3f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65,
+	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54,
+	0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0xa5, 0x01, 0x0a, 0x20, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
+	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62,
+	0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73, 0x12, 0x3f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x40, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
 	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f,
-	0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b, 0x0a, 0x12, 0x43, 0x6f,
-	0x75, 0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73,
-	0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75,
-	0x6e, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73, 0x74, 0x41,
-	0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x30, 0x2e, 0x73,
+	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x54, 0x6f, 0x6d, 0x62, 0x73, 0x74, 0x6f, 0x6e, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x43, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x73,
 	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
-	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x31,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a,
+	0x0e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12,
+	0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e,
 	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x74, 0x74, 0x65,
-	0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x7b, 0x0a, 0x12, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73,
-	0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69,
-	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74,
-	0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74,
-	0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x7b,
-	0x0a, 0x12, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64,
-	0x4e, 0x6f, 0x64, 0x65, 0x12, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65,
-	0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e, 0x6f, 0x64, 0x65,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x32, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x74, 0x74, 0x65, 0x73, 0x74, 0x65, 0x64, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x53,
-	0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12,
-	0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x53, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x75, 0x0a, 0x10, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c,
-	0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f,
+	0x0a, 0x0e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x12, 0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f,
+	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4a, 0x6f, 0x69,
+	0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x72, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
+	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x30, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72,
-	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x78, 0x0a, 0x11, 0x4c, 0x69, 0x73,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x12, 0x30,
-	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f, 0x64, 0x65,
-	0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x31, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x53, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x6f, 0x72, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65,
-	0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12,
-	0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
-	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74,
-	0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x87, 0x01, 0x0a, 0x16, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x35, 0x2e, 0x73, 0x70,
-	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74,
-	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63,
-	0x68, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74,
-	0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x43,
-	0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x38, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69,
-	0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x4c,
-	0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37,
-	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x52, 0x65, 0x67, 0x69,
-	0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61,
-	0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x12, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64,
-	0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45,
-	0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70,
-	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73,
-	0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x8a, 0x01, 0x0a, 0x17, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52,
-	0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79,
-	0x12, 0x36, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x37, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x1c, 0x43, 0x72, 0x65, 0x61,
+	0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x3b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
 	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
-	0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61,
-	0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x8d, 0x01, 0x0a, 0x18, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73,
-	0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x12, 0x37,
-	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61,
-	0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67,
-	0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x38, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x72, 0x61, 0x74, 0x69,
-	0x6f, 0x6e, 0x45, 0x6e, 0x74, 0x72, 0x69, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x12, 0x72, 0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43, 0x72,
-	0x65, 0x61, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x6f, 0x0a, 0x0e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f,
-	0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65,
-	0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
+	0x65, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x43,
+	0x72, 0x65, 0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a, 0x1b, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x65, 0x64,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x68, 0x69, 0x70, 0x12, 0x3a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74,
+	0x63, 0x68, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x46, 0x65, 0x74, 0x63, 0x68, 0x46, 0x65,
+	0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x96, 0x01, 0x0a,
+	0x1b, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x12, 0x3a, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61,
+	0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x3b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72,
+	0x65, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x99, 0x01, 0x0a, 0x1c, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x12, 0x3b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73,
 	0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e,
-	0x46, 0x65, 0x74, 0x63, 0x68, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
-	0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72,
-	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e, 0x73, 0x70, 0x69, 0x72,
-	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f,
-	0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x72, 0x0a, 0x0f, 0x50, 0x72,
-	0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x2e, 0x2e,
-	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2f, 0x2e,
-	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64, 0x61, 0x74,
-	0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x50, 0x72, 0x75, 0x6e, 0x65, 0x4a, 0x6f, 0x69, 0x6e,
-	0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a,
-	0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x70,
-	0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f,
-	0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75,
-	0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65,
-	0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e, 0x73, 0x70,
-	0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
-	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74,
-	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
-	0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-	0x2f, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x33,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x3c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x55, 0x70, 0x64,
+	0x61, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x99, 0x01, 0x0a, 0x1c, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x65, 0x64, 0x65,
+	0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68,
+	0x69, 0x70, 0x12, 0x3b, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
+	0x72, 0x2e, 0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65,
+	0x74, 0x65, 0x46, 0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x3c, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x64,
+	0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46,
+	0x65, 0x64, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x6c, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x68, 0x69, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a,
+	0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12, 0x25, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e,
+	0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72,
+	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74,
+	0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x29, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f,
+	0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50,
+	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x42, 0x36, 0x5a, 0x34, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f,
+	0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2f,
+	0x64, 0x61, 0x74, 0x61, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -3970,212 +5349,265 @@ func file_spire_server_datastore_datastore_proto_rawDescGZIP() []byte {
 	return file_spire_server_datastore_datastore_proto_rawDescData
 }
 
-var file_spire_server_datastore_datastore_proto_enumTypes = make([]protoimpl.EnumInfo, 3)
-var file_spire_server_datastore_datastore_proto_msgTypes = make([]protoimpl.MessageInfo, 63)
+var file_spire_server_datastore_datastore_proto_enumTypes = make([]protoimpl.EnumInfo, 4)
+var file_spire_server_datastore_datastore_proto_msgTypes = make([]protoimpl.MessageInfo, 82)
 var file_spire_server_datastore_datastore_proto_goTypes = []interface{}{
-	(DeleteBundleRequest_Mode)(0),            // 0: spire.server.datastore.DeleteBundleRequest.Mode
-	(BySelectors_MatchBehavior)(0),           // 1: spire.server.datastore.BySelectors.MatchBehavior
-	(ByFederatesWith_MatchBehavior)(0),       // 2: spire.server.datastore.ByFederatesWith.MatchBehavior
-	(*CreateBundleRequest)(nil),              // 3: spire.server.datastore.CreateBundleRequest
-	(*CreateBundleResponse)(nil),             // 4: spire.server.datastore.CreateBundleResponse
-	(*FetchBundleRequest)(nil),               // 5: spire.server.datastore.FetchBundleRequest
-	(*FetchBundleResponse)(nil),              // 6: spire.server.datastore.FetchBundleResponse
-	(*CountBundlesRequest)(nil),              // 7: spire.server.datastore.CountBundlesRequest
-	(*CountBundlesResponse)(nil),             // 8: spire.server.datastore.CountBundlesResponse
-	(*ListBundlesRequest)(nil),               // 9: spire.server.datastore.ListBundlesRequest
-	(*ListBundlesResponse)(nil),              // 10: spire.server.datastore.ListBundlesResponse
-	(*UpdateBundleRequest)(nil),              // 11: spire.server.datastore.UpdateBundleRequest
-	(*UpdateBundleResponse)(nil),             // 12: spire.server.datastore.UpdateBundleResponse
-	(*SetBundleRequest)(nil),                 // 13: spire.server.datastore.SetBundleRequest
-	(*SetBundleResponse)(nil),                // 14: spire.server.datastore.SetBundleResponse
-	(*AppendBundleRequest)(nil),              // 15: spire.server.datastore.AppendBundleRequest
-	(*AppendBundleResponse)(nil),             // 16: spire.server.datastore.AppendBundleResponse
-	(*DeleteBundleRequest)(nil),              // 17: spire.server.datastore.DeleteBundleRequest
-	(*DeleteBundleResponse)(nil),             // 18: spire.server.datastore.DeleteBundleResponse
-	(*PruneBundleRequest)(nil),               // 19: spire.server.datastore.PruneBundleRequest
-	(*PruneBundleResponse)(nil),              // 20: spire.server.datastore.PruneBundleResponse
-	(*NodeSelectors)(nil),                    // 21: spire.server.datastore.NodeSelectors
-	(*SetNodeSelectorsRequest)(nil),          // 22: spire.server.datastore.SetNodeSelectorsRequest
-	(*SetNodeSelectorsResponse)(nil),         // 23: spire.server.datastore.SetNodeSelectorsResponse
-	(*GetNodeSelectorsRequest)(nil),          // 24: spire.server.datastore.GetNodeSelectorsRequest
-	(*GetNodeSelectorsResponse)(nil),         // 25: spire.server.datastore.GetNodeSelectorsResponse
-	(*ListNodeSelectorsRequest)(nil),         // 26: spire.server.datastore.ListNodeSelectorsRequest
-	(*ListNodeSelectorsResponse)(nil),        // 27: spire.server.datastore.ListNodeSelectorsResponse
-	(*CreateAttestedNodeResponse)(nil),       // 28: spire.server.datastore.CreateAttestedNodeResponse
-	(*FetchAttestedNodeRequest)(nil),         // 29: spire.server.datastore.FetchAttestedNodeRequest
-	(*FetchAttestedNodeResponse)(nil),        // 30: spire.server.datastore.FetchAttestedNodeResponse
-	(*CountAttestedNodesRequest)(nil),        // 31: spire.server.datastore.CountAttestedNodesRequest
-	(*CountAttestedNodesResponse)(nil),       // 32: spire.server.datastore.CountAttestedNodesResponse
-	(*CreateAttestedNodeRequest)(nil),        // 33: spire.server.datastore.CreateAttestedNodeRequest
-	(*ListAttestedNodesRequest)(nil),         // 34: spire.server.datastore.ListAttestedNodesRequest
-	(*ListAttestedNodesResponse)(nil),        // 35: spire.server.datastore.ListAttestedNodesResponse
-	(*UpdateAttestedNodeRequest)(nil),        // 36: spire.server.datastore.UpdateAttestedNodeRequest
-	(*UpdateAttestedNodeResponse)(nil),       // 37: spire.server.datastore.UpdateAttestedNodeResponse
-	(*DeleteAttestedNodeRequest)(nil),        // 38: spire.server.datastore.DeleteAttestedNodeRequest
-	(*DeleteAttestedNodeResponse)(nil),       // 39: spire.server.datastore.DeleteAttestedNodeResponse
-	(*CreateRegistrationEntryRequest)(nil),   // 40: spire.server.datastore.CreateRegistrationEntryRequest
-	(*CreateRegistrationEntryResponse)(nil),  // 41: spire.server.datastore.CreateRegistrationEntryResponse
-	(*FetchRegistrationEntryRequest)(nil),    // 42: spire.server.datastore.FetchRegistrationEntryRequest
-	(*FetchRegistrationEntryResponse)(nil),   // 43: spire.server.datastore.FetchRegistrationEntryResponse
-	(*BySelectors)(nil),                      // 44: spire.server.datastore.BySelectors
-	(*ByFederatesWith)(nil),                  // 45: spire.server.datastore.ByFederatesWith
-	(*Pagination)(nil),                       // 46: spire.server.datastore.Pagination
-	(*CountRegistrationEntriesRequest)(nil),  // 47: spire.server.datastore.CountRegistrationEntriesRequest
-	(*CountRegistrationEntriesResponse)(nil), // 48: spire.server.datastore.CountRegistrationEntriesResponse
-	(*ListRegistrationEntriesRequest)(nil),   // 49: spire.server.datastore.ListRegistrationEntriesRequest
-	(*ListRegistrationEntriesResponse)(nil),  // 50: spire.server.datastore.ListRegistrationEntriesResponse
-	(*UpdateRegistrationEntryRequest)(nil),   // 51: spire.server.datastore.UpdateRegistrationEntryRequest
-	(*UpdateRegistrationEntryResponse)(nil),  // 52: spire.server.datastore.UpdateRegistrationEntryResponse
-	(*DeleteRegistrationEntryRequest)(nil),   // 53: spire.server.datastore.DeleteRegistrationEntryRequest
-	(*DeleteRegistrationEntryResponse)(nil),  // 54: spire.server.datastore.DeleteRegistrationEntryResponse
-	(*PruneRegistrationEntriesRequest)(nil),  // 55: spire.server.datastore.PruneRegistrationEntriesRequest
-	(*PruneRegistrationEntriesResponse)(nil), // 56: spire.server.datastore.PruneRegistrationEntriesResponse
-	(*JoinToken)(nil),                        // 57: spire.server.datastore.JoinToken
-	(*CreateJoinTokenRequest)(nil),           // 58: spire.server.datastore.CreateJoinTokenRequest
-	(*CreateJoinTokenResponse)(nil),          // 59: spire.server.datastore.CreateJoinTokenResponse
-	(*FetchJoinTokenRequest)(nil),            // 60: spire.server.datastore.FetchJoinTokenRequest
-	(*FetchJoinTokenResponse)(nil),           // 61: spire.server.datastore.FetchJoinTokenResponse
-	(*DeleteJoinTokenRequest)(nil),           // 62: spire.server.datastore.DeleteJoinTokenRequest
-	(*DeleteJoinTokenResponse)(nil),          // 63: spire.server.datastore.DeleteJoinTokenResponse
-	(*PruneJoinTokensRequest)(nil),           // 64: spire.server.datastore.PruneJoinTokensRequest
-	(*PruneJoinTokensResponse)(nil),          // 65: spire.server.datastore.PruneJoinTokensResponse
-	(*common.Bundle)(nil),                    // 66: spire.common.Bundle
-	(*common.BundleMask)(nil),                // 67: spire.common.BundleMask
-	(*common.Selector)(nil),                  // 68: spire.common.Selector
-	(*timestamppb.Timestamp)(nil),            // 69: google.protobuf.Timestamp
-	(*common.AttestedNode)(nil),              // 70: spire.common.AttestedNode
-	(*wrapperspb.Int64Value)(nil),            // 71: google.protobuf.Int64Value
-	(*wrapperspb.BoolValue)(nil),             // 72: google.protobuf.BoolValue
-	(*common.AttestedNodeMask)(nil),          // 73: spire.common.AttestedNodeMask
-	(*common.RegistrationEntry)(nil),         // 74: spire.common.RegistrationEntry
-	(*wrapperspb.StringValue)(nil),           // 75: google.protobuf.StringValue
-	(*common.RegistrationEntryMask)(nil),     // 76: spire.common.RegistrationEntryMask
-	(*plugin.ConfigureRequest)(nil),          // 77: spire.common.plugin.ConfigureRequest
-	(*plugin.GetPluginInfoRequest)(nil),      // 78: spire.common.plugin.GetPluginInfoRequest
-	(*plugin.ConfigureResponse)(nil),         // 79: spire.common.plugin.ConfigureResponse
-	(*plugin.GetPluginInfoResponse)(nil),     // 80: spire.common.plugin.GetPluginInfoResponse
+	(DeleteBundleRequest_Mode)(0),                     // 0: spire.server.datastore.DeleteBundleRequest.Mode
+	(BySelectors_MatchBehavior)(0),                    // 1: spire.server.datastore.BySelectors.MatchBehavior
+	(ByFederatesWith_MatchBehavior)(0),                // 2: spire.server.datastore.ByFederatesWith.MatchBehavior
+	(FederationRelationship_BundleEndpointProfile)(0), // 3: spire.server.datastore.FederationRelationship.BundleEndpointProfile
+	(*CreateBundleRequest)(nil),                       // 4: spire.server.datastore.CreateBundleRequest
+	(*CreateBundleResponse)(nil),                      // 5: spire.server.datastore.CreateBundleResponse
+	(*FetchBundleRequest)(nil),                        // 6: spire.server.datastore.FetchBundleRequest
+	(*FetchBundleResponse)(nil),                       // 7: spire.server.datastore.FetchBundleResponse
+	(*CountBundlesRequest)(nil),                       // 8: spire.server.datastore.CountBundlesRequest
+	(*CountBundlesResponse)(nil),                      // 9: spire.server.datastore.CountBundlesResponse
+	(*ListBundlesRequest)(nil),                        // 10: spire.server.datastore.ListBundlesRequest
+	(*ListBundlesResponse)(nil),                       // 11: spire.server.datastore.ListBundlesResponse
+	(*UpdateBundleRequest)(nil),                       // 12: spire.server.datastore.UpdateBundleRequest
+	(*UpdateBundleResponse)(nil),                      // 13: spire.server.datastore.UpdateBundleResponse
+	(*SetBundleRequest)(nil),                          // 14: spire.server.datastore.SetBundleRequest
+	(*SetBundleResponse)(nil),                         // 15: spire.server.datastore.SetBundleResponse
+	(*AppendBundleRequest)(nil),                       // 16: spire.server.datastore.AppendBundleRequest
+	(*AppendBundleResponse)(nil),                      // 17: spire.server.datastore.AppendBundleResponse
+	(*DeleteBundleRequest)(nil),                       // 18: spire.server.datastore.DeleteBundleRequest
+	(*DeleteBundleResponse)(nil),                      // 19: spire.server.datastore.DeleteBundleResponse
+	(*PruneBundleRequest)(nil),                        // 20: spire.server.datastore.PruneBundleRequest
+	(*PruneBundleResponse)(nil),                       // 21: spire.server.datastore.PruneBundleResponse
+	(*NodeSelectors)(nil),                             // 22: spire.server.datastore.NodeSelectors
+	(*SetNodeSelectorsRequest)(nil),                   // 23: spire.server.datastore.SetNodeSelectorsRequest
+	(*SetNodeSelectorsResponse)(nil),                  // 24: spire.server.datastore.SetNodeSelectorsResponse
+	(*GetNodeSelectorsRequest)(nil),                   // 25: spire.server.datastore.GetNodeSelectorsRequest
+	(*GetNodeSelectorsResponse)(nil),                  // 26: spire.server.datastore.GetNodeSelectorsResponse
+	(*ListNodeSelectorsRequest)(nil),                  // 27: spire.server.datastore.ListNodeSelectorsRequest
+	(*ListNodeSelectorsResponse)(nil),                 // 28: spire.server.datastore.ListNodeSelectorsResponse
+	(*CreateAttestedNodeResponse)(nil),                // 29: spire.server.datastore.CreateAttestedNodeResponse
+	(*FetchAttestedNodeRequest)(nil),                  // 30: spire.server.datastore.FetchAttestedNodeRequest
+	(*FetchAttestedNodeResponse)(nil),                 // 31: spire.server.datastore.FetchAttestedNodeResponse
+	(*CountAttestedNodesRequest)(nil),                 // 32: spire.server.datastore.CountAttestedNodesRequest
+	(*CountAttestedNodesResponse)(nil),                // 33: spire.server.datastore.CountAttestedNodesResponse
+	(*CreateAttestedNodeRequest)(nil),                 // 34: spire.server.datastore.CreateAttestedNodeRequest
+	(*ListAttestedNodesRequest)(nil),                  // 35: spire.server.datastore.ListAttestedNodesRequest
+	(*ListAttestedNodesResponse)(nil),                 // 36: spire.server.datastore.ListAttestedNodesResponse
+	(*UpdateAttestedNodeRequest)(nil),                 // 37: spire.server.datastore.UpdateAttestedNodeRequest
+	(*UpdateAttestedNodeResponse)(nil),                // 38: spire.server.datastore.UpdateAttestedNodeResponse
+	(*DeleteAttestedNodeRequest)(nil),                 // 39: spire.server.datastore.DeleteAttestedNodeRequest
+	(*DeleteAttestedNodeResponse)(nil),                // 40: spire.server.datastore.DeleteAttestedNodeResponse
+	(*CreateRegistrationEntryRequest)(nil),            // 41: spire.server.datastore.CreateRegistrationEntryRequest
+	(*CreateRegistrationEntryResponse)(nil),           // 42: spire.server.datastore.CreateRegistrationEntryResponse
+	(*FetchRegistrationEntryRequest)(nil),             // 43: spire.server.datastore.FetchRegistrationEntryRequest
+	(*FetchRegistrationEntryResponse)(nil),            // 44: spire.server.datastore.FetchRegistrationEntryResponse
+	(*BySelectors)(nil),                               // 45: spire.server.datastore.BySelectors
+	(*ByFederatesWith)(nil),                           // 46: spire.server.datastore.ByFederatesWith
+	(*Pagination)(nil),                                // 47: spire.server.datastore.Pagination
+	(*CountRegistrationEntriesRequest)(nil),           // 48: spire.server.datastore.CountRegistrationEntriesRequest
+	(*CountRegistrationEntriesResponse)(nil),          // 49: spire.server.datastore.CountRegistrationEntriesResponse
+	(*ListRegistrationEntriesRequest)(nil),            // 50: spire.server.datastore.ListRegistrationEntriesRequest
+	(*ListRegistrationEntriesResponse)(nil),           // 51: spire.server.datastore.ListRegistrationEntriesResponse
+	(*UpdateRegistrationEntryRequest)(nil),            // 52: spire.server.datastore.UpdateRegistrationEntryRequest
+	(*UpdateRegistrationEntryResponse)(nil),           // 53: spire.server.datastore.UpdateRegistrationEntryResponse
+	(*DeleteRegistrationEntryRequest)(nil),            // 54: spire.server.datastore.DeleteRegistrationEntryRequest
+	(*DeleteRegistrationEntryResponse)(nil),           // 55: spire.server.datastore.DeleteRegistrationEntryResponse
+	(*PruneRegistrationEntriesRequest)(nil),           // 56: spire.server.datastore.PruneRegistrationEntriesRequest
+	(*PruneRegistrationEntriesResponse)(nil),          // 57: spire.server.datastore.PruneRegistrationEntriesResponse
+	(*RegistrationEntryTombstone)(nil),                // 58: spire.server.datastore.RegistrationEntryTombstone
+	(*ListRegistrationEntryTombstonesRequest)(nil),    // 59: spire.server.datastore.ListRegistrationEntryTombstonesRequest
+	(*ListRegistrationEntryTombstonesResponse)(nil),   // 60: spire.server.datastore.ListRegistrationEntryTombstonesResponse
+	(*PruneRegistrationEntryTombstonesRequest)(nil),   // 61: spire.server.datastore.PruneRegistrationEntryTombstonesRequest
+	(*PruneRegistrationEntryTombstonesResponse)(nil),  // 62: spire.server.datastore.PruneRegistrationEntryTombstonesResponse
+	(*JoinToken)(nil),                                 // 63: spire.server.datastore.JoinToken
+	(*CreateJoinTokenRequest)(nil),                    // 64: spire.server.datastore.CreateJoinTokenRequest
+	(*CreateJoinTokenResponse)(nil),                   // 65: spire.server.datastore.CreateJoinTokenResponse
+	(*FetchJoinTokenRequest)(nil),                     // 66: spire.server.datastore.FetchJoinTokenRequest
+	(*FetchJoinTokenResponse)(nil),                    // 67: spire.server.datastore.FetchJoinTokenResponse
+	(*ListJoinTokensRequest)(nil),                     // 68: spire.server.datastore.ListJoinTokensRequest
+	(*ListJoinTokensResponse)(nil),                    // 69: spire.server.datastore.ListJoinTokensResponse
+	(*DeleteJoinTokenRequest)(nil),                    // 70: spire.server.datastore.DeleteJoinTokenRequest
+	(*DeleteJoinTokenResponse)(nil),                   // 71: spire.server.datastore.DeleteJoinTokenResponse
+	(*PruneJoinTokensRequest)(nil),                    // 72: spire.server.datastore.PruneJoinTokensRequest
+	(*PruneJoinTokensResponse)(nil),                   // 73: spire.server.datastore.PruneJoinTokensResponse
+	(*FederationRelationship)(nil),                    // 74: spire.server.datastore.FederationRelationship
+	(*FederationRelationshipMask)(nil),                // 75: spire.server.datastore.FederationRelationshipMask
+	(*CreateFederationRelationshipRequest)(nil),       // 76: spire.server.datastore.CreateFederationRelationshipRequest
+	(*CreateFederationRelationshipResponse)(nil),      // 77: spire.server.datastore.CreateFederationRelationshipResponse
+	(*FetchFederationRelationshipRequest)(nil),        // 78: spire.server.datastore.FetchFederationRelationshipRequest
+	(*FetchFederationRelationshipResponse)(nil),       // 79: spire.server.datastore.FetchFederationRelationshipResponse
+	(*ListFederationRelationshipsRequest)(nil),        // 80: spire.server.datastore.ListFederationRelationshipsRequest
+	(*ListFederationRelationshipsResponse)(nil),       // 81: spire.server.datastore.ListFederationRelationshipsResponse
+	(*UpdateFederationRelationshipRequest)(nil),       // 82: spire.server.datastore.UpdateFederationRelationshipRequest
+	(*UpdateFederationRelationshipResponse)(nil),      // 83: spire.server.datastore.UpdateFederationRelationshipResponse
+	(*DeleteFederationRelationshipRequest)(nil),       // 84: spire.server.datastore.DeleteFederationRelationshipRequest
+	(*DeleteFederationRelationshipResponse)(nil),      // 85: spire.server.datastore.DeleteFederationRelationshipResponse
+	(*common.Bundle)(nil),                             // 86: spire.common.Bundle
+	(*common.BundleMask)(nil),                         // 87: spire.common.BundleMask
+	(*common.Selector)(nil),                           // 88: spire.common.Selector
+	(*timestamp.Timestamp)(nil),                       // 89: google.protobuf.Timestamp
+	(*common.AttestedNode)(nil),                       // 90: spire.common.AttestedNode
+	(*wrappers.Int64Value)(nil),                       // 91: google.protobuf.Int64Value
+	(*wrappers.BoolValue)(nil),                        // 92: google.protobuf.BoolValue
+	(*common.AttestedNodeMask)(nil),                   // 93: spire.common.AttestedNodeMask
+	(*common.RegistrationEntry)(nil),                  // 94: spire.common.RegistrationEntry
+	(*wrappers.StringValue)(nil),                      // 95: google.protobuf.StringValue
+	(*common.RegistrationEntryMask)(nil),              // 96: spire.common.RegistrationEntryMask
+	(*plugin.ConfigureRequest)(nil),                   // 97: spire.common.plugin.ConfigureRequest
+	(*plugin.GetPluginInfoRequest)(nil),               // 98: spire.common.plugin.GetPluginInfoRequest
+	(*plugin.ConfigureResponse)(nil),                  // 99: spire.common.plugin.ConfigureResponse
+	(*plugin.GetPluginInfoResponse)(nil),              // 100: spire.common.plugin.GetPluginInfoResponse
 }
 var file_spire_server_datastore_datastore_proto_depIdxs = []int32{
-	66, // 0: spire.server.datastore.CreateBundleRequest.bundle:type_name -> spire.common.Bundle
-	66, // 1: spire.server.datastore.CreateBundleResponse.bundle:type_name -> spire.common.Bundle
-	66, // 2: spire.server.datastore.FetchBundleResponse.bundle:type_name -> spire.common.Bundle
-	46, // 3: spire.server.datastore.ListBundlesRequest.pagination:type_name -> spire.server.datastore.Pagination
-	66, // 4: spire.server.datastore.ListBundlesResponse.bundles:type_name -> spire.common.Bundle
-	46, // 5: spire.server.datastore.ListBundlesResponse.pagination:type_name -> spire.server.datastore.Pagination
-	66, // 6: spire.server.datastore.UpdateBundleRequest.bundle:type_name -> spire.common.Bundle
-	67, // 7: spire.server.datastore.UpdateBundleRequest.input_mask:type_name -> spire.common.BundleMask
-	66, // 8: spire.server.datastore.UpdateBundleResponse.bundle:type_name -> spire.common.Bundle
-	66, // 9: spire.server.datastore.SetBundleRequest.bundle:type_name -> spire.common.Bundle
-	66, // 10: spire.server.datastore.SetBundleResponse.bundle:type_name -> spire.common.Bundle
-	66, // 11: spire.server.datastore.AppendBundleRequest.bundle:type_name -> spire.common.Bundle
-	66, // 12: spire.server.datastore.AppendBundleResponse.bundle:type_name -> spire.common.Bundle
-	0,  // 13: spire.server.datastore.DeleteBundleRequest.mode:type_name -> spire.server.datastore.DeleteBundleRequest.Mode
-	66, // 14: spire.server.datastore.DeleteBundleResponse.bundle:type_name -> spire.common.Bundle
-	68, // 15: spire.server.datastore.NodeSelectors.selectors:type_name -> spire.common.Selector
-	21, // 16: spire.server.datastore.SetNodeSelectorsRequest.selectors:type_name -> spire.server.datastore.NodeSelectors
-	21, // 17: spire.server.datastore.GetNodeSelectorsResponse.selectors:type_name -> spire.server.datastore.NodeSelectors
-	69, // 18: spire.server.datastore.ListNodeSelectorsRequest.valid_at:type_name -> google.protobuf.Timestamp
-	21, // 19: spire.server.datastore.ListNodeSelectorsResponse.selectors:type_name -> spire.server.datastore.NodeSelectors
-	70, // 20: spire.server.datastore.CreateAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
-	70, // 21: spire.server.datastore.FetchAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
-	70, // 22: spire.server.datastore.CreateAttestedNodeRequest.node:type_name -> spire.common.AttestedNode
-	71, // 23: spire.server.datastore.ListAttestedNodesRequest.by_expires_before:type_name -> google.protobuf.Int64Value
-	46, // 24: spire.server.datastore.ListAttestedNodesRequest.pagination:type_name -> spire.server.datastore.Pagination
-	44, // 25: spire.server.datastore.ListAttestedNodesRequest.by_selector_match:type_name -> spire.server.datastore.BySelectors
-	72, // 26: spire.server.datastore.ListAttestedNodesRequest.by_banned:type_name -> google.protobuf.BoolValue
-	70, // 27: spire.server.datastore.ListAttestedNodesResponse.nodes:type_name -> spire.common.AttestedNode
-	46, // 28: spire.server.datastore.ListAttestedNodesResponse.pagination:type_name -> spire.server.datastore.Pagination
-	73, // 29: spire.server.datastore.UpdateAttestedNodeRequest.input_mask:type_name -> spire.common.AttestedNodeMask
-	70, // 30: spire.server.datastore.UpdateAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
-	70, // 31: spire.server.datastore.DeleteAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
-	74, // 32: spire.server.datastore.CreateRegistrationEntryRequest.entry:type_name -> spire.common.RegistrationEntry
-	74, // 33: spire.server.datastore.CreateRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
-	74, // 34: spire.server.datastore.FetchRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
-	68, // 35: spire.server.datastore.BySelectors.selectors:type_name -> spire.common.Selector
-	1,  // 36: spire.server.datastore.BySelectors.match:type_name -> spire.server.datastore.BySelectors.MatchBehavior
-	2,  // 37: spire.server.datastore.ByFederatesWith.match:type_name -> spire.server.datastore.ByFederatesWith.MatchBehavior
-	75, // 38: spire.server.datastore.ListRegistrationEntriesRequest.by_parent_id:type_name -> google.protobuf.StringValue
-	44, // 39: spire.server.datastore.ListRegistrationEntriesRequest.by_selectors:type_name -> spire.server.datastore.BySelectors
-	75, // 40: spire.server.datastore.ListRegistrationEntriesRequest.by_spiffe_id:type_name -> google.protobuf.StringValue
-	46, // 41: spire.server.datastore.ListRegistrationEntriesRequest.pagination:type_name -> spire.server.datastore.Pagination
-	45, // 42: spire.server.datastore.ListRegistrationEntriesRequest.by_federates_with:type_name -> spire.server.datastore.ByFederatesWith
-	74, // 43: spire.server.datastore.ListRegistrationEntriesResponse.entries:type_name -> spire.common.RegistrationEntry
-	46, // 44: spire.server.datastore.ListRegistrationEntriesResponse.pagination:type_name -> spire.server.datastore.Pagination
-	74, // 45: spire.server.datastore.UpdateRegistrationEntryRequest.entry:type_name -> spire.common.RegistrationEntry
-	76, // 46: spire.server.datastore.UpdateRegistrationEntryRequest.mask:type_name -> spire.common.RegistrationEntryMask
-	74, // 47: spire.server.datastore.UpdateRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
-	74, // 48: spire.server.datastore.DeleteRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
-	57, // 49: spire.server.datastore.CreateJoinTokenRequest.join_token:type_name -> spire.server.datastore.JoinToken
-	57, // 50: spire.server.datastore.CreateJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
-	57, // 51: spire.server.datastore.FetchJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
-	57, // 52: spire.server.datastore.DeleteJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
-	3,  // 53: spire.server.datastore.DataStore.CreateBundle:input_type -> spire.server.datastore.CreateBundleRequest
-	5,  // 54: spire.server.datastore.DataStore.FetchBundle:input_type -> spire.server.datastore.FetchBundleRequest
-	7,  // 55: spire.server.datastore.DataStore.CountBundles:input_type -> spire.server.datastore.CountBundlesRequest
-	9,  // 56: spire.server.datastore.DataStore.ListBundles:input_type -> spire.server.datastore.ListBundlesRequest
-	11, // 57: spire.server.datastore.DataStore.UpdateBundle:input_type -> spire.server.datastore.UpdateBundleRequest
-	13, // 58: spire.server.datastore.DataStore.SetBundle:input_type -> spire.server.datastore.SetBundleRequest
-	15, // 59: spire.server.datastore.DataStore.AppendBundle:input_type -> spire.server.datastore.AppendBundleRequest
-	17, // 60: spire.server.datastore.DataStore.DeleteBundle:input_type -> spire.server.datastore.DeleteBundleRequest
-	19, // 61: spire.server.datastore.DataStore.PruneBundle:input_type -> spire.server.datastore.PruneBundleRequest
-	33, // 62: spire.server.datastore.DataStore.CreateAttestedNode:input_type -> spire.server.datastore.CreateAttestedNodeRequest
-	29, // 63: spire.server.datastore.DataStore.FetchAttestedNode:input_type -> spire.server.datastore.FetchAttestedNodeRequest
-	31, // 64: spire.server.datastore.DataStore.CountAttestedNodes:input_type -> spire.server.datastore.CountAttestedNodesRequest
-	34, // 65: spire.server.datastore.DataStore.ListAttestedNodes:input_type -> spire.server.datastore.ListAttestedNodesRequest
-	36, // 66: spire.server.datastore.DataStore.UpdateAttestedNode:input_type -> spire.server.datastore.UpdateAttestedNodeRequest
-	38, // 67: spire.server.datastore.DataStore.DeleteAttestedNode:input_type -> spire.server.datastore.DeleteAttestedNodeRequest
-	22, // 68: spire.server.datastore.DataStore.SetNodeSelectors:input_type -> spire.server.datastore.SetNodeSelectorsRequest
-	24, // 69: spire.server.datastore.DataStore.GetNodeSelectors:input_type -> spire.server.datastore.GetNodeSelectorsRequest
-	26, // 70: spire.server.datastore.DataStore.ListNodeSelectors:input_type -> spire.server.datastore.ListNodeSelectorsRequest
-	40, // 71: spire.server.datastore.DataStore.CreateRegistrationEntry:input_type -> spire.server.datastore.CreateRegistrationEntryRequest
-	42, // 72: spire.server.datastore.DataStore.FetchRegistrationEntry:input_type -> spire.server.datastore.FetchRegistrationEntryRequest
-	47, // 73: spire.server.datastore.DataStore.CountRegistrationEntries:input_type -> spire.server.datastore.CountRegistrationEntriesRequest
-	49, // 74: spire.server.datastore.DataStore.ListRegistrationEntries:input_type -> spire.server.datastore.ListRegistrationEntriesRequest
-	51, // 75: spire.server.datastore.DataStore.UpdateRegistrationEntry:input_type -> spire.server.datastore.UpdateRegistrationEntryRequest
-	53, // 76: spire.server.datastore.DataStore.DeleteRegistrationEntry:input_type -> spire.server.datastore.DeleteRegistrationEntryRequest
-	55, // 77: spire.server.datastore.DataStore.PruneRegistrationEntries:input_type -> spire.server.datastore.PruneRegistrationEntriesRequest
-	58, // 78: spire.server.datastore.DataStore.CreateJoinToken:input_type -> spire.server.datastore.CreateJoinTokenRequest
-	60, // 79: spire.server.datastore.DataStore.FetchJoinToken:input_type -> spire.server.datastore.FetchJoinTokenRequest
-	62, // 80: spire.server.datastore.DataStore.DeleteJoinToken:input_type -> spire.server.datastore.DeleteJoinTokenRequest
-	64, // 81: spire.server.datastore.DataStore.PruneJoinTokens:input_type -> spire.server.datastore.PruneJoinTokensRequest
-	77, // 82: spire.server.datastore.DataStore.Configure:input_type -> spire.common.plugin.ConfigureRequest
-	78, // 83: spire.server.datastore.DataStore.GetPluginInfo:input_type -> spire.common.plugin.GetPluginInfoRequest
-	4,  // 84: spire.server.datastore.DataStore.CreateBundle:output_type -> spire.server.datastore.CreateBundleResponse
-	6,  // 85: spire.server.datastore.DataStore.FetchBundle:output_type -> spire.server.datastore.FetchBundleResponse
-	8,  // 86: spire.server.datastore.DataStore.CountBundles:output_type -> spire.server.datastore.CountBundlesResponse
-	10, // 87: spire.server.datastore.DataStore.ListBundles:output_type -> spire.server.datastore.ListBundlesResponse
-	12, // 88: spire.server.datastore.DataStore.UpdateBundle:output_type -> spire.server.datastore.UpdateBundleResponse
-	14, // 89: spire.server.datastore.DataStore.SetBundle:output_type -> spire.server.datastore.SetBundleResponse
-	16, // 90: spire.server.datastore.DataStore.AppendBundle:output_type -> spire.server.datastore.AppendBundleResponse
-	18, // 91: spire.server.datastore.DataStore.DeleteBundle:output_type -> spire.server.datastore.DeleteBundleResponse
-	20, // 92: spire.server.datastore.DataStore.PruneBundle:output_type -> spire.server.datastore.PruneBundleResponse
-	28, // 93: spire.server.datastore.DataStore.CreateAttestedNode:output_type -> spire.server.datastore.CreateAttestedNodeResponse
-	30, // 94: spire.server.datastore.DataStore.FetchAttestedNode:output_type -> spire.server.datastore.FetchAttestedNodeResponse
-	32, // 95: spire.server.datastore.DataStore.CountAttestedNodes:output_type -> spire.server.datastore.CountAttestedNodesResponse
-	35, // 96: spire.server.datastore.DataStore.ListAttestedNodes:output_type -> spire.server.datastore.ListAttestedNodesResponse
-	37, // 97: spire.server.datastore.DataStore.UpdateAttestedNode:output_type -> spire.server.datastore.UpdateAttestedNodeResponse
-	39, // 98: spire.server.datastore.DataStore.DeleteAttestedNode:output_type -> spire.server.datastore.DeleteAttestedNodeResponse
-	23, // 99: spire.server.datastore.DataStore.SetNodeSelectors:output_type -> spire.server.datastore.SetNodeSelectorsResponse
-	25, // 100: spire.server.datastore.DataStore.GetNodeSelectors:output_type -> spire.server.datastore.GetNodeSelectorsResponse
-	27, // 101: spire.server.datastore.DataStore.ListNodeSelectors:output_type -> spire.server.datastore.ListNodeSelectorsResponse
-	41, // 102: spire.server.datastore.DataStore.CreateRegistrationEntry:output_type -> spire.server.datastore.CreateRegistrationEntryResponse
-	43, // 103: spire.server.datastore.DataStore.FetchRegistrationEntry:output_type -> spire.server.datastore.FetchRegistrationEntryResponse
-	48, // 104: spire.server.datastore.DataStore.CountRegistrationEntries:output_type -> spire.server.datastore.CountRegistrationEntriesResponse
-	50, // 105: spire.server.datastore.DataStore.ListRegistrationEntries:output_type -> spire.server.datastore.ListRegistrationEntriesResponse
-	52, // 106: spire.server.datastore.DataStore.UpdateRegistrationEntry:output_type -> spire.server.datastore.UpdateRegistrationEntryResponse
-	54, // 107: spire.server.datastore.DataStore.DeleteRegistrationEntry:output_type -> spire.server.datastore.DeleteRegistrationEntryResponse
-	56, // 108: spire.server.datastore.DataStore.PruneRegistrationEntries:output_type -> spire.server.datastore.PruneRegistrationEntriesResponse
-	59, // 109: spire.server.datastore.DataStore.CreateJoinToken:output_type -> spire.server.datastore.CreateJoinTokenResponse
-	61, // 110: spire.server.datastore.DataStore.FetchJoinToken:output_type -> spire.server.datastore.FetchJoinTokenResponse
-	63, // 111: spire.server.datastore.DataStore.DeleteJoinToken:output_type -> spire.server.datastore.DeleteJoinTokenResponse
-	65, // 112: spire.server.datastore.DataStore.PruneJoinTokens:output_type -> spire.server.datastore.PruneJoinTokensResponse
-	79, // 113: spire.server.datastore.DataStore.Configure:output_type -> spire.common.plugin.ConfigureResponse
-	80, // 114: spire.server.datastore.DataStore.GetPluginInfo:output_type -> spire.common.plugin.GetPluginInfoResponse
-	84, // [84:115] is the sub-list for method output_type
-	53, // [53:84] is the sub-list for method input_type
-	53, // [53:53] is the sub-list for extension type_name
-	53, // [53:53] is the sub-list for extension extendee
-	0,  // [0:53] is the sub-list for field type_name
+	86,  // 0: spire.server.datastore.CreateBundleRequest.bundle:type_name -> spire.common.Bundle
+	86,  // 1: spire.server.datastore.CreateBundleResponse.bundle:type_name -> spire.common.Bundle
+	86,  // 2: spire.server.datastore.FetchBundleResponse.bundle:type_name -> spire.common.Bundle
+	47,  // 3: spire.server.datastore.ListBundlesRequest.pagination:type_name -> spire.server.datastore.Pagination
+	86,  // 4: spire.server.datastore.ListBundlesResponse.bundles:type_name -> spire.common.Bundle
+	47,  // 5: spire.server.datastore.ListBundlesResponse.pagination:type_name -> spire.server.datastore.Pagination
+	86,  // 6: spire.server.datastore.UpdateBundleRequest.bundle:type_name -> spire.common.Bundle
+	87,  // 7: spire.server.datastore.UpdateBundleRequest.input_mask:type_name -> spire.common.BundleMask
+	86,  // 8: spire.server.datastore.UpdateBundleResponse.bundle:type_name -> spire.common.Bundle
+	86,  // 9: spire.server.datastore.SetBundleRequest.bundle:type_name -> spire.common.Bundle
+	86,  // 10: spire.server.datastore.SetBundleResponse.bundle:type_name -> spire.common.Bundle
+	86,  // 11: spire.server.datastore.AppendBundleRequest.bundle:type_name -> spire.common.Bundle
+	86,  // 12: spire.server.datastore.AppendBundleResponse.bundle:type_name -> spire.common.Bundle
+	0,   // 13: spire.server.datastore.DeleteBundleRequest.mode:type_name -> spire.server.datastore.DeleteBundleRequest.Mode
+	86,  // 14: spire.server.datastore.DeleteBundleResponse.bundle:type_name -> spire.common.Bundle
+	88,  // 15: spire.server.datastore.NodeSelectors.selectors:type_name -> spire.common.Selector
+	22,  // 16: spire.server.datastore.SetNodeSelectorsRequest.selectors:type_name -> spire.server.datastore.NodeSelectors
+	22,  // 17: spire.server.datastore.GetNodeSelectorsResponse.selectors:type_name -> spire.server.datastore.NodeSelectors
+	89,  // 18: spire.server.datastore.ListNodeSelectorsRequest.valid_at:type_name -> google.protobuf.Timestamp
+	22,  // 19: spire.server.datastore.ListNodeSelectorsResponse.selectors:type_name -> spire.server.datastore.NodeSelectors
+	90,  // 20: spire.server.datastore.CreateAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
+	90,  // 21: spire.server.datastore.FetchAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
+	90,  // 22: spire.server.datastore.CreateAttestedNodeRequest.node:type_name -> spire.common.AttestedNode
+	91,  // 23: spire.server.datastore.ListAttestedNodesRequest.by_expires_before:type_name -> google.protobuf.Int64Value
+	47,  // 24: spire.server.datastore.ListAttestedNodesRequest.pagination:type_name -> spire.server.datastore.Pagination
+	45,  // 25: spire.server.datastore.ListAttestedNodesRequest.by_selector_match:type_name -> spire.server.datastore.BySelectors
+	92,  // 26: spire.server.datastore.ListAttestedNodesRequest.by_banned:type_name -> google.protobuf.BoolValue
+	90,  // 27: spire.server.datastore.ListAttestedNodesResponse.nodes:type_name -> spire.common.AttestedNode
+	47,  // 28: spire.server.datastore.ListAttestedNodesResponse.pagination:type_name -> spire.server.datastore.Pagination
+	93,  // 29: spire.server.datastore.UpdateAttestedNodeRequest.input_mask:type_name -> spire.common.AttestedNodeMask
+	90,  // 30: spire.server.datastore.UpdateAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
+	90,  // 31: spire.server.datastore.DeleteAttestedNodeResponse.node:type_name -> spire.common.AttestedNode
+	94,  // 32: spire.server.datastore.CreateRegistrationEntryRequest.entry:type_name -> spire.common.RegistrationEntry
+	94,  // 33: spire.server.datastore.CreateRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
+	94,  // 34: spire.server.datastore.FetchRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
+	88,  // 35: spire.server.datastore.BySelectors.selectors:type_name -> spire.common.Selector
+	1,   // 36: spire.server.datastore.BySelectors.match:type_name -> spire.server.datastore.BySelectors.MatchBehavior
+	2,   // 37: spire.server.datastore.ByFederatesWith.match:type_name -> spire.server.datastore.ByFederatesWith.MatchBehavior
+	95,  // 38: spire.server.datastore.ListRegistrationEntriesRequest.by_parent_id:type_name -> google.protobuf.StringValue
+	45,  // 39: spire.server.datastore.ListRegistrationEntriesRequest.by_selectors:type_name -> spire.server.datastore.BySelectors
+	95,  // 40: spire.server.datastore.ListRegistrationEntriesRequest.by_spiffe_id:type_name -> google.protobuf.StringValue
+	47,  // 41: spire.server.datastore.ListRegistrationEntriesRequest.pagination:type_name -> spire.server.datastore.Pagination
+	46,  // 42: spire.server.datastore.ListRegistrationEntriesRequest.by_federates_with:type_name -> spire.server.datastore.ByFederatesWith
+	94,  // 43: spire.server.datastore.ListRegistrationEntriesResponse.entries:type_name -> spire.common.RegistrationEntry
+	47,  // 44: spire.server.datastore.ListRegistrationEntriesResponse.pagination:type_name -> spire.server.datastore.Pagination
+	94,  // 45: spire.server.datastore.UpdateRegistrationEntryRequest.entry:type_name -> spire.common.RegistrationEntry
+	96,  // 46: spire.server.datastore.UpdateRegistrationEntryRequest.mask:type_name -> spire.common.RegistrationEntryMask
+	94,  // 47: spire.server.datastore.UpdateRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
+	94,  // 48: spire.server.datastore.DeleteRegistrationEntryResponse.entry:type_name -> spire.common.RegistrationEntry
+	47,  // 49: spire.server.datastore.ListRegistrationEntryTombstonesRequest.pagination:type_name -> spire.server.datastore.Pagination
+	58,  // 50: spire.server.datastore.ListRegistrationEntryTombstonesResponse.tombstones:type_name -> spire.server.datastore.RegistrationEntryTombstone
+	47,  // 51: spire.server.datastore.ListRegistrationEntryTombstonesResponse.pagination:type_name -> spire.server.datastore.Pagination
+	63,  // 52: spire.server.datastore.CreateJoinTokenRequest.join_token:type_name -> spire.server.datastore.JoinToken
+	63,  // 53: spire.server.datastore.CreateJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
+	63,  // 54: spire.server.datastore.FetchJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
+	47,  // 55: spire.server.datastore.ListJoinTokensRequest.pagination:type_name -> spire.server.datastore.Pagination
+	63,  // 56: spire.server.datastore.ListJoinTokensResponse.join_tokens:type_name -> spire.server.datastore.JoinToken
+	47,  // 57: spire.server.datastore.ListJoinTokensResponse.pagination:type_name -> spire.server.datastore.Pagination
+	63,  // 58: spire.server.datastore.DeleteJoinTokenResponse.join_token:type_name -> spire.server.datastore.JoinToken
+	3,   // 59: spire.server.datastore.FederationRelationship.bundle_endpoint_profile:type_name -> spire.server.datastore.FederationRelationship.BundleEndpointProfile
+	74,  // 60: spire.server.datastore.CreateFederationRelationshipRequest.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	74,  // 61: spire.server.datastore.CreateFederationRelationshipResponse.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	74,  // 62: spire.server.datastore.FetchFederationRelationshipResponse.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	47,  // 63: spire.server.datastore.ListFederationRelationshipsRequest.pagination:type_name -> spire.server.datastore.Pagination
+	74,  // 64: spire.server.datastore.ListFederationRelationshipsResponse.federation_relationships:type_name -> spire.server.datastore.FederationRelationship
+	47,  // 65: spire.server.datastore.ListFederationRelationshipsResponse.pagination:type_name -> spire.server.datastore.Pagination
+	74,  // 66: spire.server.datastore.UpdateFederationRelationshipRequest.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	75,  // 67: spire.server.datastore.UpdateFederationRelationshipRequest.input_mask:type_name -> spire.server.datastore.FederationRelationshipMask
+	74,  // 68: spire.server.datastore.UpdateFederationRelationshipResponse.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	74,  // 69: spire.server.datastore.DeleteFederationRelationshipResponse.federation_relationship:type_name -> spire.server.datastore.FederationRelationship
+	4,   // 70: spire.server.datastore.DataStore.CreateBundle:input_type -> spire.server.datastore.CreateBundleRequest
+	6,   // 71: spire.server.datastore.DataStore.FetchBundle:input_type -> spire.server.datastore.FetchBundleRequest
+	8,   // 72: spire.server.datastore.DataStore.CountBundles:input_type -> spire.server.datastore.CountBundlesRequest
+	10,  // 73: spire.server.datastore.DataStore.ListBundles:input_type -> spire.server.datastore.ListBundlesRequest
+	12,  // 74: spire.server.datastore.DataStore.UpdateBundle:input_type -> spire.server.datastore.UpdateBundleRequest
+	14,  // 75: spire.server.datastore.DataStore.SetBundle:input_type -> spire.server.datastore.SetBundleRequest
+	16,  // 76: spire.server.datastore.DataStore.AppendBundle:input_type -> spire.server.datastore.AppendBundleRequest
+	18,  // 77: spire.server.datastore.DataStore.DeleteBundle:input_type -> spire.server.datastore.DeleteBundleRequest
+	20,  // 78: spire.server.datastore.DataStore.PruneBundle:input_type -> spire.server.datastore.PruneBundleRequest
+	34,  // 79: spire.server.datastore.DataStore.CreateAttestedNode:input_type -> spire.server.datastore.CreateAttestedNodeRequest
+	30,  // 80: spire.server.datastore.DataStore.FetchAttestedNode:input_type -> spire.server.datastore.FetchAttestedNodeRequest
+	32,  // 81: spire.server.datastore.DataStore.CountAttestedNodes:input_type -> spire.server.datastore.CountAttestedNodesRequest
+	35,  // 82: spire.server.datastore.DataStore.ListAttestedNodes:input_type -> spire.server.datastore.ListAttestedNodesRequest
+	37,  // 83: spire.server.datastore.DataStore.UpdateAttestedNode:input_type -> spire.server.datastore.UpdateAttestedNodeRequest
+	39,  // 84: spire.server.datastore.DataStore.DeleteAttestedNode:input_type -> spire.server.datastore.DeleteAttestedNodeRequest
+	23,  // 85: spire.server.datastore.DataStore.SetNodeSelectors:input_type -> spire.server.datastore.SetNodeSelectorsRequest
+	25,  // 86: spire.server.datastore.DataStore.GetNodeSelectors:input_type -> spire.server.datastore.GetNodeSelectorsRequest
+	27,  // 87: spire.server.datastore.DataStore.ListNodeSelectors:input_type -> spire.server.datastore.ListNodeSelectorsRequest
+	41,  // 88: spire.server.datastore.DataStore.CreateRegistrationEntry:input_type -> spire.server.datastore.CreateRegistrationEntryRequest
+	43,  // 89: spire.server.datastore.DataStore.FetchRegistrationEntry:input_type -> spire.server.datastore.FetchRegistrationEntryRequest
+	48,  // 90: spire.server.datastore.DataStore.CountRegistrationEntries:input_type -> spire.server.datastore.CountRegistrationEntriesRequest
+	50,  // 91: spire.server.datastore.DataStore.ListRegistrationEntries:input_type -> spire.server.datastore.ListRegistrationEntriesRequest
+	52,  // 92: spire.server.datastore.DataStore.UpdateRegistrationEntry:input_type -> spire.server.datastore.UpdateRegistrationEntryRequest
+	54,  // 93: spire.server.datastore.DataStore.DeleteRegistrationEntry:input_type -> spire.server.datastore.DeleteRegistrationEntryRequest
+	56,  // 94: spire.server.datastore.DataStore.PruneRegistrationEntries:input_type -> spire.server.datastore.PruneRegistrationEntriesRequest
+	59,  // 95: spire.server.datastore.DataStore.ListRegistrationEntryTombstones:input_type -> spire.server.datastore.ListRegistrationEntryTombstonesRequest
+	61,  // 96: spire.server.datastore.DataStore.PruneRegistrationEntryTombstones:input_type -> spire.server.datastore.PruneRegistrationEntryTombstonesRequest
+	64,  // 97: spire.server.datastore.DataStore.CreateJoinToken:input_type -> spire.server.datastore.CreateJoinTokenRequest
+	66,  // 98: spire.server.datastore.DataStore.FetchJoinToken:input_type -> spire.server.datastore.FetchJoinTokenRequest
+	68,  // 99: spire.server.datastore.DataStore.ListJoinTokens:input_type -> spire.server.datastore.ListJoinTokensRequest
+	70,  // 100: spire.server.datastore.DataStore.DeleteJoinToken:input_type -> spire.server.datastore.DeleteJoinTokenRequest
+	72,  // 101: spire.server.datastore.DataStore.PruneJoinTokens:input_type -> spire.server.datastore.PruneJoinTokensRequest
+	76,  // 102: spire.server.datastore.DataStore.CreateFederationRelationship:input_type -> spire.server.datastore.CreateFederationRelationshipRequest
+	78,  // 103: spire.server.datastore.DataStore.FetchFederationRelationship:input_type -> spire.server.datastore.FetchFederationRelationshipRequest
+	80,  // 104: spire.server.datastore.DataStore.ListFederationRelationships:input_type -> spire.server.datastore.ListFederationRelationshipsRequest
+	82,  // 105: spire.server.datastore.DataStore.UpdateFederationRelationship:input_type -> spire.server.datastore.UpdateFederationRelationshipRequest
+	84,  // 106: spire.server.datastore.DataStore.DeleteFederationRelationship:input_type -> spire.server.datastore.DeleteFederationRelationshipRequest
+	97,  // 107: spire.server.datastore.DataStore.Configure:input_type -> spire.common.plugin.ConfigureRequest
+	98,  // 108: spire.server.datastore.DataStore.GetPluginInfo:input_type -> spire.common.plugin.GetPluginInfoRequest
+	5,   // 109: spire.server.datastore.DataStore.CreateBundle:output_type -> spire.server.datastore.CreateBundleResponse
+	7,   // 110: spire.server.datastore.DataStore.FetchBundle:output_type -> spire.server.datastore.FetchBundleResponse
+	9,   // 111: spire.server.datastore.DataStore.CountBundles:output_type -> spire.server.datastore.CountBundlesResponse
+	11,  // 112: spire.server.datastore.DataStore.ListBundles:output_type -> spire.server.datastore.ListBundlesResponse
+	13,  // 113: spire.server.datastore.DataStore.UpdateBundle:output_type -> spire.server.datastore.UpdateBundleResponse
+	15,  // 114: spire.server.datastore.DataStore.SetBundle:output_type -> spire.server.datastore.SetBundleResponse
+	17,  // 115: spire.server.datastore.DataStore.AppendBundle:output_type -> spire.server.datastore.AppendBundleResponse
+	19,  // 116: spire.server.datastore.DataStore.DeleteBundle:output_type -> spire.server.datastore.DeleteBundleResponse
+	21,  // 117: spire.server.datastore.DataStore.PruneBundle:output_type -> spire.server.datastore.PruneBundleResponse
+	29,  // 118: spire.server.datastore.DataStore.CreateAttestedNode:output_type -> spire.server.datastore.CreateAttestedNodeResponse
+	31,  // 119: spire.server.datastore.DataStore.FetchAttestedNode:output_type -> spire.server.datastore.FetchAttestedNodeResponse
+	33,  // 120: spire.server.datastore.DataStore.CountAttestedNodes:output_type -> spire.server.datastore.CountAttestedNodesResponse
+	36,  // 121: spire.server.datastore.DataStore.ListAttestedNodes:output_type -> spire.server.datastore.ListAttestedNodesResponse
+	38,  // 122: spire.server.datastore.DataStore.UpdateAttestedNode:output_type -> spire.server.datastore.UpdateAttestedNodeResponse
+	40,  // 123: spire.server.datastore.DataStore.DeleteAttestedNode:output_type -> spire.server.datastore.DeleteAttestedNodeResponse
+	24,  // 124: spire.server.datastore.DataStore.SetNodeSelectors:output_type -> spire.server.datastore.SetNodeSelectorsResponse
+	26,  // 125: spire.server.datastore.DataStore.GetNodeSelectors:output_type -> spire.server.datastore.GetNodeSelectorsResponse
+	28,  // 126: spire.server.datastore.DataStore.ListNodeSelectors:output_type -> spire.server.datastore.ListNodeSelectorsResponse
+	42,  // 127: spire.server.datastore.DataStore.CreateRegistrationEntry:output_type -> spire.server.datastore.CreateRegistrationEntryResponse
+	44,  // 128: spire.server.datastore.DataStore.FetchRegistrationEntry:output_type -> spire.server.datastore.FetchRegistrationEntryResponse
+	49,  // 129: spire.server.datastore.DataStore.CountRegistrationEntries:output_type -> spire.server.datastore.CountRegistrationEntriesResponse
+	51,  // 130: spire.server.datastore.DataStore.ListRegistrationEntries:output_type -> spire.server.datastore.ListRegistrationEntriesResponse
+	53,  // 131: spire.server.datastore.DataStore.UpdateRegistrationEntry:output_type -> spire.server.datastore.UpdateRegistrationEntryResponse
+	55,  // 132: spire.server.datastore.DataStore.DeleteRegistrationEntry:output_type -> spire.server.datastore.DeleteRegistrationEntryResponse
+	57,  // 133: spire.server.datastore.DataStore.PruneRegistrationEntries:output_type -> spire.server.datastore.PruneRegistrationEntriesResponse
+	60,  // 134: spire.server.datastore.DataStore.ListRegistrationEntryTombstones:output_type -> spire.server.datastore.ListRegistrationEntryTombstonesResponse
+	62,  // 135: spire.server.datastore.DataStore.PruneRegistrationEntryTombstones:output_type -> spire.server.datastore.PruneRegistrationEntryTombstonesResponse
+	65,  // 136: spire.server.datastore.DataStore.CreateJoinToken:output_type -> spire.server.datastore.CreateJoinTokenResponse
+	67,  // 137: spire.server.datastore.DataStore.FetchJoinToken:output_type -> spire.server.datastore.FetchJoinTokenResponse
+	69,  // 138: spire.server.datastore.DataStore.ListJoinTokens:output_type -> spire.server.datastore.ListJoinTokensResponse
+	71,  // 139: spire.server.datastore.DataStore.DeleteJoinToken:output_type -> spire.server.datastore.DeleteJoinTokenResponse
+	73,  // 140: spire.server.datastore.DataStore.PruneJoinTokens:output_type -> spire.server.datastore.PruneJoinTokensResponse
+	77,  // 141: spire.server.datastore.DataStore.CreateFederationRelationship:output_type -> spire.server.datastore.CreateFederationRelationshipResponse
+	79,  // 142: spire.server.datastore.DataStore.FetchFederationRelationship:output_type -> spire.server.datastore.FetchFederationRelationshipResponse
+	81,  // 143: spire.server.datastore.DataStore.ListFederationRelationships:output_type -> spire.server.datastore.ListFederationRelationshipsResponse
+	83,  // 144: spire.server.datastore.DataStore.UpdateFederationRelationship:output_type -> spire.server.datastore.UpdateFederationRelationshipResponse
+	85,  // 145: spire.server.datastore.DataStore.DeleteFederationRelationship:output_type -> spire.server.datastore.DeleteFederationRelationshipResponse
+	99,  // 146: spire.server.datastore.DataStore.Configure:output_type -> spire.common.plugin.ConfigureResponse
+	100, // 147: spire.server.datastore.DataStore.GetPluginInfo:output_type -> spire.common.plugin.GetPluginInfoResponse
+	109, // [109:148] is the sub-list for method output_type
+	70,  // [70:109] is the sub-list for method input_type
+	70,  // [70:70] is the sub-list for extension type_name
+	70,  // [70:70] is the sub-list for extension extendee
+	0,   // [0:70] is the sub-list for field type_name
 }
 
 func init() { file_spire_server_datastore_datastore_proto_init() }
@@ -4833,7 +6265,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*JoinToken); i {
+			switch v := v.(*RegistrationEntryTombstone); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4845,7 +6277,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJoinTokenRequest); i {
+			switch v := v.(*ListRegistrationEntryTombstonesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4857,7 +6289,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CreateJoinTokenResponse); i {
+			switch v := v.(*ListRegistrationEntryTombstonesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4869,7 +6301,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchJoinTokenRequest); i {
+			switch v := v.(*PruneRegistrationEntryTombstonesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4881,7 +6313,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*FetchJoinTokenResponse); i {
+			switch v := v.(*PruneRegistrationEntryTombstonesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4893,7 +6325,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJoinTokenRequest); i {
+			switch v := v.(*JoinToken); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4905,7 +6337,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteJoinTokenResponse); i {
+			switch v := v.(*CreateJoinTokenRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4917,7 +6349,7 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PruneJoinTokensRequest); i {
+			switch v := v.(*CreateJoinTokenResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4929,6 +6361,90 @@ func file_spire_server_datastore_datastore_proto_init() {
 			}
 		}
 		file_spire_server_datastore_datastore_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchJoinTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchJoinTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJoinTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListJoinTokensResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJoinTokenRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteJoinTokenResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PruneJoinTokensRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PruneJoinTokensResponse); i {
 			case 0:
 				return &v.state
@@ -4940,14 +6456,158 @@ func file_spire_server_datastore_datastore_proto_init() {
 				return nil
 			}
 		}
+		file_spire_server_datastore_datastore_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationRelationship); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FederationRelationshipMask); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFederationRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateFederationRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchFederationRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*FetchFederationRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationRelationshipsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListFederationRelationshipsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFederationRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateFederationRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFederationRelationshipRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_datastore_datastore_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteFederationRelationshipResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spire_server_datastore_datastore_proto_rawDesc,
-			NumEnums:      3,
-			NumMessages:   63,
+			NumEnums:      4,
+			NumMessages:   82,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -68,14 +68,30 @@ type DataStoreClient interface {
 	DeleteRegistrationEntry(ctx context.Context, in *DeleteRegistrationEntryRequest, opts ...grpc.CallOption) (*DeleteRegistrationEntryResponse, error)
 	// Prunes all registration entries that expire before the specified timestamp
 	PruneRegistrationEntries(ctx context.Context, in *PruneRegistrationEntriesRequest, opts ...grpc.CallOption) (*PruneRegistrationEntriesResponse, error)
+	// Lists the tombstones of deleted registration entries
+	ListRegistrationEntryTombstones(ctx context.Context, in *ListRegistrationEntryTombstonesRequest, opts ...grpc.CallOption) (*ListRegistrationEntryTombstonesResponse, error)
+	// Prunes all registration entry tombstones for entries deleted before the specified timestamp
+	PruneRegistrationEntryTombstones(ctx context.Context, in *PruneRegistrationEntryTombstonesRequest, opts ...grpc.CallOption) (*PruneRegistrationEntryTombstonesResponse, error)
 	// Creates a join token
 	CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*CreateJoinTokenResponse, error)
 	// Fetches a specific join token
 	FetchJoinToken(ctx context.Context, in *FetchJoinTokenRequest, opts ...grpc.CallOption) (*FetchJoinTokenResponse, error)
+	// Lists join tokens
+	ListJoinTokens(ctx context.Context, in *ListJoinTokensRequest, opts ...grpc.CallOption) (*ListJoinTokensResponse, error)
 	// Delete a specific join token
 	DeleteJoinToken(ctx context.Context, in *DeleteJoinTokenRequest, opts ...grpc.CallOption) (*DeleteJoinTokenResponse, error)
 	// Prunes all join tokens that expire before the specified timestamp
 	PruneJoinTokens(ctx context.Context, in *PruneJoinTokensRequest, opts ...grpc.CallOption) (*PruneJoinTokensResponse, error)
+	// Creates a federation relationship
+	CreateFederationRelationship(ctx context.Context, in *CreateFederationRelationshipRequest, opts ...grpc.CallOption) (*CreateFederationRelationshipResponse, error)
+	// Fetches a specific federation relationship
+	FetchFederationRelationship(ctx context.Context, in *FetchFederationRelationshipRequest, opts ...grpc.CallOption) (*FetchFederationRelationshipResponse, error)
+	// Lists federation relationships
+	ListFederationRelationships(ctx context.Context, in *ListFederationRelationshipsRequest, opts ...grpc.CallOption) (*ListFederationRelationshipsResponse, error)
+	// Updates a specific federation relationship
+	UpdateFederationRelationship(ctx context.Context, in *UpdateFederationRelationshipRequest, opts ...grpc.CallOption) (*UpdateFederationRelationshipResponse, error)
+	// Deletes a specific federation relationship
+	DeleteFederationRelationship(ctx context.Context, in *DeleteFederationRelationshipRequest, opts ...grpc.CallOption) (*DeleteFederationRelationshipResponse, error)
 	// Applies the plugin configuration
 	Configure(ctx context.Context, in *plugin.ConfigureRequest, opts ...grpc.CallOption) (*plugin.ConfigureResponse, error)
 	// Returns the version and related metadata of the installed plugin
@@ -315,6 +331,24 @@ func (c *dataStoreClient) PruneRegistrationEntries(ctx context.Context, in *Prun
 	return out, nil
 }
 
+func (c *dataStoreClient) ListRegistrationEntryTombstones(ctx context.Context, in *ListRegistrationEntryTombstonesRequest, opts ...grpc.CallOption) (*ListRegistrationEntryTombstonesResponse, error) {
+	out := new(ListRegistrationEntryTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/ListRegistrationEntryTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataStoreClient) PruneRegistrationEntryTombstones(ctx context.Context, in *PruneRegistrationEntryTombstonesRequest, opts ...grpc.CallOption) (*PruneRegistrationEntryTombstonesResponse, error) {
+	out := new(PruneRegistrationEntryTombstonesResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/PruneRegistrationEntryTombstones", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataStoreClient) CreateJoinToken(ctx context.Context, in *CreateJoinTokenRequest, opts ...grpc.CallOption) (*CreateJoinTokenResponse, error) {
 	out := new(CreateJoinTokenResponse)
 	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/CreateJoinToken", in, out, opts...)
@@ -333,6 +367,15 @@ func (c *dataStoreClient) FetchJoinToken(ctx context.Context, in *FetchJoinToken
 	return out, nil
 }
 
+func (c *dataStoreClient) ListJoinTokens(ctx context.Context, in *ListJoinTokensRequest, opts ...grpc.CallOption) (*ListJoinTokensResponse, error) {
+	out := new(ListJoinTokensResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/ListJoinTokens", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataStoreClient) DeleteJoinToken(ctx context.Context, in *DeleteJoinTokenRequest, opts ...grpc.CallOption) (*DeleteJoinTokenResponse, error) {
 	out := new(DeleteJoinTokenResponse)
 	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/DeleteJoinToken", in, out, opts...)
@@ -351,6 +394,51 @@ func (c *dataStoreClient) PruneJoinTokens(ctx context.Context, in *PruneJoinToke
 	return out, nil
 }
 
+func (c *dataStoreClient) CreateFederationRelationship(ctx context.Context, in *CreateFederationRelationshipRequest, opts ...grpc.CallOption) (*CreateFederationRelationshipResponse, error) {
+	out := new(CreateFederationRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/CreateFederationRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataStoreClient) FetchFederationRelationship(ctx context.Context, in *FetchFederationRelationshipRequest, opts ...grpc.CallOption) (*FetchFederationRelationshipResponse, error) {
+	out := new(FetchFederationRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/FetchFederationRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataStoreClient) ListFederationRelationships(ctx context.Context, in *ListFederationRelationshipsRequest, opts ...grpc.CallOption) (*ListFederationRelationshipsResponse, error) {
+	out := new(ListFederationRelationshipsResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/ListFederationRelationships", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataStoreClient) UpdateFederationRelationship(ctx context.Context, in *UpdateFederationRelationshipRequest, opts ...grpc.CallOption) (*UpdateFederationRelationshipResponse, error) {
+	out := new(UpdateFederationRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/UpdateFederationRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *dataStoreClient) DeleteFederationRelationship(ctx context.Context, in *DeleteFederationRelationshipRequest, opts ...grpc.CallOption) (*DeleteFederationRelationshipResponse, error) {
+	out := new(DeleteFederationRelationshipResponse)
+	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/DeleteFederationRelationship", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *dataStoreClient) Configure(ctx context.Context, in *plugin.ConfigureRequest, opts ...grpc.CallOption) (*plugin.ConfigureResponse, error) {
 	out := new(plugin.ConfigureResponse)
 	err := c.cc.Invoke(ctx, "/spire.server.datastore.DataStore/Configure", in, out, opts...)
@@ -423,14 +511,30 @@ type DataStoreServer interface {
 	DeleteRegistrationEntry(context.Context, *DeleteRegistrationEntryRequest) (*DeleteRegistrationEntryResponse, error)
 	// Prunes all registration entries that expire before the specified timestamp
 	PruneRegistrationEntries(context.Context, *PruneRegistrationEntriesRequest) (*PruneRegistrationEntriesResponse, error)
+	// Lists the tombstones of deleted registration entries
+	ListRegistrationEntryTombstones(context.Context, *ListRegistrationEntryTombstonesRequest) (*ListRegistrationEntryTombstonesResponse, error)
+	// Prunes all registration entry tombstones for entries deleted before the specified timestamp
+	PruneRegistrationEntryTombstones(context.Context, *PruneRegistrationEntryTombstonesRequest) (*PruneRegistrationEntryTombstonesResponse, error)
 	// Creates a join token
 	CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error)
 	// Fetches a specific join token
 	FetchJoinToken(context.Context, *FetchJoinTokenRequest) (*FetchJoinTokenResponse, error)
+	// Lists join tokens
+	ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error)
 	// Delete a specific join token
 	DeleteJoinToken(context.Context, *DeleteJoinTokenRequest) (*DeleteJoinTokenResponse, error)
 	// Prunes all join tokens that expire before the specified timestamp
 	PruneJoinTokens(context.Context, *PruneJoinTokensRequest) (*PruneJoinTokensResponse, error)
+	// Creates a federation relationship
+	CreateFederationRelationship(context.Context, *CreateFederationRelationshipRequest) (*CreateFederationRelationshipResponse, error)
+	// Fetches a specific federation relationship
+	FetchFederationRelationship(context.Context, *FetchFederationRelationshipRequest) (*FetchFederationRelationshipResponse, error)
+	// Lists federation relationships
+	ListFederationRelationships(context.Context, *ListFederationRelationshipsRequest) (*ListFederationRelationshipsResponse, error)
+	// Updates a specific federation relationship
+	UpdateFederationRelationship(context.Context, *UpdateFederationRelationshipRequest) (*UpdateFederationRelationshipResponse, error)
+	// Deletes a specific federation relationship
+	DeleteFederationRelationship(context.Context, *DeleteFederationRelationshipRequest) (*DeleteFederationRelationshipResponse, error)
 	// Applies the plugin configuration
 	Configure(context.Context, *plugin.ConfigureRequest) (*plugin.ConfigureResponse, error)
 	// Returns the version and related metadata of the installed plugin
@@ -517,18 +621,42 @@ func (UnimplementedDataStoreServer) DeleteRegistrationEntry(context.Context, *De
 func (UnimplementedDataStoreServer) PruneRegistrationEntries(context.Context, *PruneRegistrationEntriesRequest) (*PruneRegistrationEntriesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneRegistrationEntries not implemented")
 }
+func (UnimplementedDataStoreServer) ListRegistrationEntryTombstones(context.Context, *ListRegistrationEntryTombstonesRequest) (*ListRegistrationEntryTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListRegistrationEntryTombstones not implemented")
+}
+func (UnimplementedDataStoreServer) PruneRegistrationEntryTombstones(context.Context, *PruneRegistrationEntryTombstonesRequest) (*PruneRegistrationEntryTombstonesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PruneRegistrationEntryTombstones not implemented")
+}
 func (UnimplementedDataStoreServer) CreateJoinToken(context.Context, *CreateJoinTokenRequest) (*CreateJoinTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateJoinToken not implemented")
 }
 func (UnimplementedDataStoreServer) FetchJoinToken(context.Context, *FetchJoinTokenRequest) (*FetchJoinTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FetchJoinToken not implemented")
 }
+func (UnimplementedDataStoreServer) ListJoinTokens(context.Context, *ListJoinTokensRequest) (*ListJoinTokensResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListJoinTokens not implemented")
+}
 func (UnimplementedDataStoreServer) DeleteJoinToken(context.Context, *DeleteJoinTokenRequest) (*DeleteJoinTokenResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteJoinToken not implemented")
 }
 func (UnimplementedDataStoreServer) PruneJoinTokens(context.Context, *PruneJoinTokensRequest) (*PruneJoinTokensResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PruneJoinTokens not implemented")
 }
+func (UnimplementedDataStoreServer) CreateFederationRelationship(context.Context, *CreateFederationRelationshipRequest) (*CreateFederationRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateFederationRelationship not implemented")
+}
+func (UnimplementedDataStoreServer) FetchFederationRelationship(context.Context, *FetchFederationRelationshipRequest) (*FetchFederationRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FetchFederationRelationship not implemented")
+}
+func (UnimplementedDataStoreServer) ListFederationRelationships(context.Context, *ListFederationRelationshipsRequest) (*ListFederationRelationshipsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListFederationRelationships not implemented")
+}
+func (UnimplementedDataStoreServer) UpdateFederationRelationship(context.Context, *UpdateFederationRelationshipRequest) (*UpdateFederationRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateFederationRelationship not implemented")
+}
+func (UnimplementedDataStoreServer) DeleteFederationRelationship(context.Context, *DeleteFederationRelationshipRequest) (*DeleteFederationRelationshipResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteFederationRelationship not implemented")
+}
 func (UnimplementedDataStoreServer) Configure(context.Context, *plugin.ConfigureRequest) (*plugin.ConfigureResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Configure not implemented")
 }
@@ -998,6 +1126,42 @@ func _DataStore_PruneRegistrationEntries_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataStore_ListRegistrationEntryTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListRegistrationEntryTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).ListRegistrationEntryTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/ListRegistrationEntryTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).ListRegistrationEntryTombstones(ctx, req.(*ListRegistrationEntryTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStore_PruneRegistrationEntryTombstones_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PruneRegistrationEntryTombstonesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).PruneRegistrationEntryTombstones(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/PruneRegistrationEntryTombstones",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).PruneRegistrationEntryTombstones(ctx, req.(*PruneRegistrationEntryTombstonesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataStore_CreateJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(CreateJoinTokenRequest)
 	if err := dec(in); err != nil {
@@ -1034,6 +1198,24 @@ func _DataStore_FetchJoinToken_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataStore_ListJoinTokens_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListJoinTokensRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).ListJoinTokens(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/ListJoinTokens",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).ListJoinTokens(ctx, req.(*ListJoinTokensRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataStore_DeleteJoinToken_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(DeleteJoinTokenRequest)
 	if err := dec(in); err != nil {
@@ -1070,6 +1252,96 @@ func _DataStore_PruneJoinTokens_Handler(srv interface{}, ctx context.Context, de
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DataStore_CreateFederationRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateFederationRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).CreateFederationRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/CreateFederationRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).CreateFederationRelationship(ctx, req.(*CreateFederationRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStore_FetchFederationRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FetchFederationRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).FetchFederationRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/FetchFederationRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).FetchFederationRelationship(ctx, req.(*FetchFederationRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStore_ListFederationRelationships_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListFederationRelationshipsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).ListFederationRelationships(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/ListFederationRelationships",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).ListFederationRelationships(ctx, req.(*ListFederationRelationshipsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStore_UpdateFederationRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateFederationRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).UpdateFederationRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/UpdateFederationRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).UpdateFederationRelationship(ctx, req.(*UpdateFederationRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DataStore_DeleteFederationRelationship_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteFederationRelationshipRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DataStoreServer).DeleteFederationRelationship(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/spire.server.datastore.DataStore/DeleteFederationRelationship",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DataStoreServer).DeleteFederationRelationship(ctx, req.(*DeleteFederationRelationshipRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DataStore_Configure_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(plugin.ConfigureRequest)
 	if err := dec(in); err != nil {
@@ -1210,6 +1482,14 @@ var _DataStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneRegistrationEntries",
 			Handler:    _DataStore_PruneRegistrationEntries_Handler,
 		},
+		{
+			MethodName: "ListRegistrationEntryTombstones",
+			Handler:    _DataStore_ListRegistrationEntryTombstones_Handler,
+		},
+		{
+			MethodName: "PruneRegistrationEntryTombstones",
+			Handler:    _DataStore_PruneRegistrationEntryTombstones_Handler,
+		},
 		{
 			MethodName: "CreateJoinToken",
 			Handler:    _DataStore_CreateJoinToken_Handler,
@@ -1218,6 +1498,10 @@ var _DataStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "FetchJoinToken",
 			Handler:    _DataStore_FetchJoinToken_Handler,
 		},
+		{
+			MethodName: "ListJoinTokens",
+			Handler:    _DataStore_ListJoinTokens_Handler,
+		},
 		{
 			MethodName: "DeleteJoinToken",
 			Handler:    _DataStore_DeleteJoinToken_Handler,
@@ -1226,6 +1510,26 @@ var _DataStore_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PruneJoinTokens",
 			Handler:    _DataStore_PruneJoinTokens_Handler,
 		},
+		{
+			MethodName: "CreateFederationRelationship",
+			Handler:    _DataStore_CreateFederationRelationship_Handler,
+		},
+		{
+			MethodName: "FetchFederationRelationship",
+			Handler:    _DataStore_FetchFederationRelationship_Handler,
+		},
+		{
+			MethodName: "ListFederationRelationships",
+			Handler:    _DataStore_ListFederationRelationships_Handler,
+		},
+		{
+			MethodName: "UpdateFederationRelationship",
+			Handler:    _DataStore_UpdateFederationRelationship_Handler,
+		},
+		{
+			MethodName: "DeleteFederationRelationship",
+			Handler:    _DataStore_DeleteFederationRelationship_Handler,
+		},
 		{
 			MethodName: "Configure",
 			Handler:    _DataStore_Configure_Handler,
@@ -123,6 +123,233 @@ func (x *BundleUpdated) GetBundle() *common.Bundle {
 	return nil
 }
 
+type X509CA struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Identifier of the CA slot (e.g. "A" or "B")
+	SlotId string `protobuf:"bytes,1,opt,name=slot_id,json=slotId,proto3" json:"slot_id,omitempty"`
+	// ASN.1 DER encoded CA certificate
+	Certificate []byte `protobuf:"bytes,2,opt,name=certificate,proto3" json:"certificate,omitempty"`
+	// When the CA was issued (seconds since Unix epoch)
+	IssuedAt int64 `protobuf:"varint,3,opt,name=issued_at,json=issuedAt,proto3" json:"issued_at,omitempty"`
+	// When the CA expires (seconds since Unix epoch)
+	ExpiresAt int64 `protobuf:"varint,4,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	// ASN.1 DER encoded certificates that chain the CA back to the upstream
+	// trust bundle, starting with the CA certificate. Empty if the CA is
+	// self-signed.
+	UpstreamChain [][]byte `protobuf:"bytes,5,rep,name=upstream_chain,json=upstreamChain,proto3" json:"upstream_chain,omitempty"`
+}
+
+func (x *X509CA) Reset() {
+	*x = X509CA{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X509CA) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509CA) ProtoMessage() {}
+
+func (x *X509CA) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509CA.ProtoReflect.Descriptor instead.
+func (*X509CA) Descriptor() ([]byte, []int) {
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *X509CA) GetSlotId() string {
+	if x != nil {
+		return x.SlotId
+	}
+	return ""
+}
+
+func (x *X509CA) GetCertificate() []byte {
+	if x != nil {
+		return x.Certificate
+	}
+	return nil
+}
+
+func (x *X509CA) GetIssuedAt() int64 {
+	if x != nil {
+		return x.IssuedAt
+	}
+	return 0
+}
+
+func (x *X509CA) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *X509CA) GetUpstreamChain() [][]byte {
+	if x != nil {
+		return x.UpstreamChain
+	}
+	return nil
+}
+
+type X509CAPrepared struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X509Ca *X509CA `protobuf:"bytes,1,opt,name=x509_ca,json=x509Ca,proto3" json:"x509_ca,omitempty"`
+}
+
+func (x *X509CAPrepared) Reset() {
+	*x = X509CAPrepared{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X509CAPrepared) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509CAPrepared) ProtoMessage() {}
+
+func (x *X509CAPrepared) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509CAPrepared.ProtoReflect.Descriptor instead.
+func (*X509CAPrepared) Descriptor() ([]byte, []int) {
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *X509CAPrepared) GetX509Ca() *X509CA {
+	if x != nil {
+		return x.X509Ca
+	}
+	return nil
+}
+
+type X509CAActivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X509Ca *X509CA `protobuf:"bytes,1,opt,name=x509_ca,json=x509Ca,proto3" json:"x509_ca,omitempty"`
+}
+
+func (x *X509CAActivated) Reset() {
+	*x = X509CAActivated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X509CAActivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509CAActivated) ProtoMessage() {}
+
+func (x *X509CAActivated) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509CAActivated.ProtoReflect.Descriptor instead.
+func (*X509CAActivated) Descriptor() ([]byte, []int) {
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *X509CAActivated) GetX509Ca() *X509CA {
+	if x != nil {
+		return x.X509Ca
+	}
+	return nil
+}
+
+type X509CADeactivated struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	X509Ca *X509CA `protobuf:"bytes,1,opt,name=x509_ca,json=x509Ca,proto3" json:"x509_ca,omitempty"`
+}
+
+func (x *X509CADeactivated) Reset() {
+	*x = X509CADeactivated{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *X509CADeactivated) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*X509CADeactivated) ProtoMessage() {}
+
+func (x *X509CADeactivated) ProtoReflect() protoreflect.Message {
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use X509CADeactivated.ProtoReflect.Descriptor instead.
+func (*X509CADeactivated) Descriptor() ([]byte, []int) {
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *X509CADeactivated) GetX509Ca() *X509CA {
+	if x != nil {
+		return x.X509Ca
+	}
+	return nil
+}
+
 type NotifyRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -130,13 +357,16 @@ type NotifyRequest struct {
 
 	// Types that are assignable to Event:
 	//	*NotifyRequest_BundleUpdated
+	//	*NotifyRequest_X509CaPrepared
+	//	*NotifyRequest_X509CaActivated
+	//	*NotifyRequest_X509CaDeactivated
 	Event isNotifyRequest_Event `protobuf_oneof:"event"`
 }
 
 func (x *NotifyRequest) Reset() {
 	*x = NotifyRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_server_notifier_notifier_proto_msgTypes[2]
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -149,7 +379,7 @@ func (x *NotifyRequest) String() string {
 func (*NotifyRequest) ProtoMessage() {}
 
 func (x *NotifyRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_server_notifier_notifier_proto_msgTypes[2]
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -162,7 +392,7 @@ func (x *NotifyRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyRequest.ProtoReflect.Descriptor instead.
 func (*NotifyRequest) Descriptor() ([]byte, []int) {
-	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{2}
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{6}
 }
 
 func (m *NotifyRequest) GetEvent() isNotifyRequest_Event {
@@ -179,6 +409,27 @@ func (x *NotifyRequest) GetBundleUpdated() *BundleUpdated {
 	return nil
 }
 
+func (x *NotifyRequest) GetX509CaPrepared() *X509CAPrepared {
+	if x, ok := x.GetEvent().(*NotifyRequest_X509CaPrepared); ok {
+		return x.X509CaPrepared
+	}
+	return nil
+}
+
+func (x *NotifyRequest) GetX509CaActivated() *X509CAActivated {
+	if x, ok := x.GetEvent().(*NotifyRequest_X509CaActivated); ok {
+		return x.X509CaActivated
+	}
+	return nil
+}
+
+func (x *NotifyRequest) GetX509CaDeactivated() *X509CADeactivated {
+	if x, ok := x.GetEvent().(*NotifyRequest_X509CaDeactivated); ok {
+		return x.X509CaDeactivated
+	}
+	return nil
+}
+
 type isNotifyRequest_Event interface {
 	isNotifyRequest_Event()
 }
@@ -189,8 +440,32 @@ type NotifyRequest_BundleUpdated struct {
 	BundleUpdated *BundleUpdated `protobuf:"bytes,1,opt,name=bundle_updated,json=bundleUpdated,proto3,oneof"`
 }
 
+type NotifyRequest_X509CaPrepared struct {
+	// X509CAPrepared is emitted whenever SPIRE server prepares a new
+	// X509 CA during rotation.
+	X509CaPrepared *X509CAPrepared `protobuf:"bytes,2,opt,name=x509_ca_prepared,json=x509CaPrepared,proto3,oneof"`
+}
+
+type NotifyRequest_X509CaActivated struct {
+	// X509CAActivated is emitted whenever SPIRE server activates a
+	// prepared X509 CA during rotation.
+	X509CaActivated *X509CAActivated `protobuf:"bytes,3,opt,name=x509_ca_activated,json=x509CaActivated,proto3,oneof"`
+}
+
+type NotifyRequest_X509CaDeactivated struct {
+	// X509CADeactivated is emitted whenever SPIRE server stops signing
+	// with an X509 CA because another one was activated in its place.
+	X509CaDeactivated *X509CADeactivated `protobuf:"bytes,4,opt,name=x509_ca_deactivated,json=x509CaDeactivated,proto3,oneof"`
+}
+
 func (*NotifyRequest_BundleUpdated) isNotifyRequest_Event() {}
 
+func (*NotifyRequest_X509CaPrepared) isNotifyRequest_Event() {}
+
+func (*NotifyRequest_X509CaActivated) isNotifyRequest_Event() {}
+
+func (*NotifyRequest_X509CaDeactivated) isNotifyRequest_Event() {}
+
 type NotifyResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -200,7 +475,7 @@ type NotifyResponse struct {
 func (x *NotifyResponse) Reset() {
 	*x = NotifyResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_server_notifier_notifier_proto_msgTypes[3]
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -213,7 +488,7 @@ func (x *NotifyResponse) String() string {
 func (*NotifyResponse) ProtoMessage() {}
 
 func (x *NotifyResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_server_notifier_notifier_proto_msgTypes[3]
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -226,7 +501,7 @@ func (x *NotifyResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyResponse.ProtoReflect.Descriptor instead.
 func (*NotifyResponse) Descriptor() ([]byte, []int) {
-	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{3}
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{7}
 }
 
 type NotifyAndAdviseRequest struct {
@@ -242,7 +517,7 @@ type NotifyAndAdviseRequest struct {
 func (x *NotifyAndAdviseRequest) Reset() {
 	*x = NotifyAndAdviseRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_server_notifier_notifier_proto_msgTypes[4]
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -255,7 +530,7 @@ func (x *NotifyAndAdviseRequest) String() string {
 func (*NotifyAndAdviseRequest) ProtoMessage() {}
 
 func (x *NotifyAndAdviseRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_server_notifier_notifier_proto_msgTypes[4]
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -268,7 +543,7 @@ func (x *NotifyAndAdviseRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyAndAdviseRequest.ProtoReflect.Descriptor instead.
 func (*NotifyAndAdviseRequest) Descriptor() ([]byte, []int) {
-	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{4}
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{8}
 }
 
 func (m *NotifyAndAdviseRequest) GetEvent() isNotifyAndAdviseRequest_Event {
@@ -306,7 +581,7 @@ type NotifyAndAdviseResponse struct {
 func (x *NotifyAndAdviseResponse) Reset() {
 	*x = NotifyAndAdviseResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_spire_server_notifier_notifier_proto_msgTypes[5]
+		mi := &file_spire_server_notifier_notifier_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -319,7 +594,7 @@ func (x *NotifyAndAdviseResponse) String() string {
 func (*NotifyAndAdviseResponse) ProtoMessage() {}
 
 func (x *NotifyAndAdviseResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_spire_server_notifier_notifier_proto_msgTypes[5]
+	mi := &file_spire_server_notifier_notifier_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -332,7 +607,7 @@ func (x *NotifyAndAdviseResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use NotifyAndAdviseResponse.ProtoReflect.Descriptor instead.
 func (*NotifyAndAdviseResponse) Descriptor() ([]byte, []int) {
-	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{5}
+	return file_spire_server_notifier_notifier_proto_rawDescGZIP(), []int{9}
 }
 
 var File_spire_server_notifier_notifier_proto protoreflect.FileDescriptor
@@ -353,53 +628,94 @@ var file_spire_server_notifier_notifier_proto_rawDesc = []byte{
 	0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x12, 0x2c, 0x0a, 0x06, 0x62, 0x75, 0x6e,
 	0x64, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x70, 0x69, 0x72,
 	0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x52,
-	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0x67, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x62, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x5f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x24, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e,
-	0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e, 0x74,
-	0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x6d, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41,
-	0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a, 0x0d,
-	0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x75, 0x6e, 0x64,
-	0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
-	0x74, 0x22, 0x19, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64,
-	0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x03, 0x0a,
-	0x08, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x06, 0x4e, 0x6f, 0x74,
-	0x69, 0x66, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x69, 0x72,
+	0x06, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x22, 0xa6, 0x01, 0x0a, 0x06, 0x58, 0x35, 0x30, 0x39,
+	0x43, 0x41, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x6c, 0x6f, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6c, 0x6f, 0x74, 0x49, 0x64, 0x12, 0x20, 0x0a, 0x0b, 0x63,
+	0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c,
+	0x52, 0x0b, 0x63, 0x65, 0x72, 0x74, 0x69, 0x66, 0x69, 0x63, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a,
+	0x09, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x5f, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x08, 0x69, 0x73, 0x73, 0x75, 0x65, 0x64, 0x41, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x65, 0x73, 0x5f, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x09,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x65, 0x73, 0x41, 0x74, 0x12, 0x25, 0x0a, 0x0e, 0x75, 0x70, 0x73,
+	0x74, 0x72, 0x65, 0x61, 0x6d, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28,
+	0x0c, 0x52, 0x0d, 0x75, 0x70, 0x73, 0x74, 0x72, 0x65, 0x61, 0x6d, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x22, 0x48, 0x0a, 0x0e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x50, 0x72, 0x65, 0x70, 0x61, 0x72,
+	0x65, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x78, 0x35, 0x30, 0x39, 0x5f, 0x63, 0x61, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x58, 0x35, 0x30, 0x39,
+	0x43, 0x41, 0x52, 0x06, 0x78, 0x35, 0x30, 0x39, 0x43, 0x61, 0x22, 0x49, 0x0a, 0x0f, 0x58, 0x35,
+	0x30, 0x39, 0x43, 0x41, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a,
+	0x07, 0x78, 0x35, 0x30, 0x39, 0x5f, 0x63, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d,
+	0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f,
+	0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x52, 0x06, 0x78,
+	0x35, 0x30, 0x39, 0x43, 0x61, 0x22, 0x4b, 0x0a, 0x11, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x44,
+	0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x36, 0x0a, 0x07, 0x78, 0x35,
+	0x30, 0x39, 0x5f, 0x63, 0x61, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66,
+	0x69, 0x65, 0x72, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x52, 0x06, 0x78, 0x35, 0x30, 0x39,
+	0x43, 0x61, 0x22, 0xec, 0x02, 0x0a, 0x0d, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x4d, 0x0a, 0x0e, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x75,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x73,
+	0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69,
+	0x66, 0x69, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x64, 0x48, 0x00, 0x52, 0x0d, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x64, 0x12, 0x51, 0x0a, 0x10, 0x78, 0x35, 0x30, 0x39, 0x5f, 0x63, 0x61, 0x5f, 0x70,
+	0x72, 0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x25, 0x2e,
+	0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74,
+	0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x50, 0x72, 0x65, 0x70,
+	0x61, 0x72, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0e, 0x78, 0x35, 0x30, 0x39, 0x43, 0x61, 0x50, 0x72,
+	0x65, 0x70, 0x61, 0x72, 0x65, 0x64, 0x12, 0x54, 0x0a, 0x11, 0x78, 0x35, 0x30, 0x39, 0x5f, 0x63,
+	0x61, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+	0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41,
+	0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0f, 0x78, 0x35, 0x30,
+	0x39, 0x43, 0x61, 0x41, 0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x12, 0x5a, 0x0a, 0x13,
+	0x78, 0x35, 0x30, 0x39, 0x5f, 0x63, 0x61, 0x5f, 0x64, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x28, 0x2e, 0x73, 0x70, 0x69, 0x72,
 	0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65,
-	0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x70, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64, 0x76,
-	0x69, 0x73, 0x65, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x72, 0x2e, 0x58, 0x35, 0x30, 0x39, 0x43, 0x41, 0x44, 0x65, 0x61, 0x63, 0x74, 0x69, 0x76, 0x61,
+	0x74, 0x65, 0x64, 0x48, 0x00, 0x52, 0x11, 0x78, 0x35, 0x30, 0x39, 0x43, 0x61, 0x44, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x61, 0x74, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65, 0x6e,
+	0x74, 0x22, 0x10, 0x0a, 0x0e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x6d, 0x0a, 0x16, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64,
+	0x41, 0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4a, 0x0a,
+	0x0d, 0x62, 0x75, 0x6e, 0x64, 0x6c, 0x65, 0x5f, 0x6c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x23, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x42, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x48, 0x00, 0x52, 0x0c, 0x62, 0x75, 0x6e,
+	0x64, 0x6c, 0x65, 0x4c, 0x6f, 0x61, 0x64, 0x65, 0x64, 0x42, 0x07, 0x0a, 0x05, 0x65, 0x76, 0x65,
+	0x6e, 0x74, 0x22, 0x19, 0x0a, 0x17, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41,
+	0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x32, 0x97, 0x03,
+	0x0a, 0x08, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x12, 0x55, 0x0a, 0x06, 0x4e, 0x6f,
+	0x74, 0x69, 0x66, 0x79, 0x12, 0x24, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69,
+	0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x70, 0x0a, 0x0f, 0x4e, 0x6f, 0x74, 0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64,
+	0x76, 0x69, 0x73, 0x65, 0x12, 0x2d, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74,
+	0x69, 0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76,
 	0x65, 0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69,
-	0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x1a, 0x2e, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-	0x72, 0x2e, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-	0x79, 0x41, 0x6e, 0x64, 0x41, 0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x12,
-	0x25, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63,
-	0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e,
-	0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x66,
-	0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
-	0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70,
-	0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70, 0x69,
-	0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e,
-	0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69, 0x72,
-	0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73, 0x65,
-	0x72, 0x76, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x62, 0x06, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x66, 0x79, 0x41, 0x6e, 0x64, 0x41, 0x64, 0x76, 0x69, 0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x5a, 0x0a, 0x09, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x12, 0x25, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e,
+	0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x67, 0x75, 0x72, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x66, 0x0a, 0x0d, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x12, 0x29, 0x2e, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e,
+	0x70, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x2a, 0x2e, 0x73, 0x70,
+	0x69, 0x72, 0x65, 0x2e, 0x63, 0x6f, 0x6d, 0x6d, 0x6f, 0x6e, 0x2e, 0x70, 0x6c, 0x75, 0x67, 0x69,
+	0x6e, 0x2e, 0x47, 0x65, 0x74, 0x50, 0x6c, 0x75, 0x67, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x42, 0x35, 0x5a, 0x33, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x73, 0x70, 0x69, 0x66, 0x66, 0x65, 0x2f, 0x73, 0x70, 0x69,
+	0x72, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x73, 0x70, 0x69, 0x72, 0x65, 0x2f, 0x73,
+	0x65, 0x72, 0x76, 0x65, 0x72, 0x2f, 0x6e, 0x6f, 0x74, 0x69, 0x66, 0x69, 0x65, 0x72, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -414,38 +730,48 @@ func file_spire_server_notifier_notifier_proto_rawDescGZIP() []byte {
 	return file_spire_server_notifier_notifier_proto_rawDescData
 }
 
-var file_spire_server_notifier_notifier_proto_msgTypes = make([]protoimpl.MessageInfo, 6)
+var file_spire_server_notifier_notifier_proto_msgTypes = make([]protoimpl.MessageInfo, 10)
 var file_spire_server_notifier_notifier_proto_goTypes = []interface{}{
 	(*BundleLoaded)(nil),                 // 0: spire.server.notifier.BundleLoaded
 	(*BundleUpdated)(nil),                // 1: spire.server.notifier.BundleUpdated
-	(*NotifyRequest)(nil),                // 2: spire.server.notifier.NotifyRequest
-	(*NotifyResponse)(nil),               // 3: spire.server.notifier.NotifyResponse
-	(*NotifyAndAdviseRequest)(nil),       // 4: spire.server.notifier.NotifyAndAdviseRequest
-	(*NotifyAndAdviseResponse)(nil),      // 5: spire.server.notifier.NotifyAndAdviseResponse
-	(*common.Bundle)(nil),                // 6: spire.common.Bundle
-	(*plugin.ConfigureRequest)(nil),      // 7: spire.common.plugin.ConfigureRequest
-	(*plugin.GetPluginInfoRequest)(nil),  // 8: spire.common.plugin.GetPluginInfoRequest
-	(*plugin.ConfigureResponse)(nil),     // 9: spire.common.plugin.ConfigureResponse
-	(*plugin.GetPluginInfoResponse)(nil), // 10: spire.common.plugin.GetPluginInfoResponse
+	(*X509CA)(nil),                       // 2: spire.server.notifier.X509CA
+	(*X509CAPrepared)(nil),               // 3: spire.server.notifier.X509CAPrepared
+	(*X509CAActivated)(nil),              // 4: spire.server.notifier.X509CAActivated
+	(*X509CADeactivated)(nil),            // 5: spire.server.notifier.X509CADeactivated
+	(*NotifyRequest)(nil),                // 6: spire.server.notifier.NotifyRequest
+	(*NotifyResponse)(nil),               // 7: spire.server.notifier.NotifyResponse
+	(*NotifyAndAdviseRequest)(nil),       // 8: spire.server.notifier.NotifyAndAdviseRequest
+	(*NotifyAndAdviseResponse)(nil),      // 9: spire.server.notifier.NotifyAndAdviseResponse
+	(*common.Bundle)(nil),                // 10: spire.common.Bundle
+	(*plugin.ConfigureRequest)(nil),      // 11: spire.common.plugin.ConfigureRequest
+	(*plugin.GetPluginInfoRequest)(nil),  // 12: spire.common.plugin.GetPluginInfoRequest
+	(*plugin.ConfigureResponse)(nil),     // 13: spire.common.plugin.ConfigureResponse
+	(*plugin.GetPluginInfoResponse)(nil), // 14: spire.common.plugin.GetPluginInfoResponse
 }
 var file_spire_server_notifier_notifier_proto_depIdxs = []int32{
-	6,  // 0: spire.server.notifier.BundleLoaded.bundle:type_name -> spire.common.Bundle
-	6,  // 1: spire.server.notifier.BundleUpdated.bundle:type_name -> spire.common.Bundle
-	1,  // 2: spire.server.notifier.NotifyRequest.bundle_updated:type_name -> spire.server.notifier.BundleUpdated
-	0,  // 3: spire.server.notifier.NotifyAndAdviseRequest.bundle_loaded:type_name -> spire.server.notifier.BundleLoaded
-	2,  // 4: spire.server.notifier.Notifier.Notify:input_type -> spire.server.notifier.NotifyRequest
-	4,  // 5: spire.server.notifier.Notifier.NotifyAndAdvise:input_type -> spire.server.notifier.NotifyAndAdviseRequest
-	7,  // 6: spire.server.notifier.Notifier.Configure:input_type -> spire.common.plugin.ConfigureRequest
-	8,  // 7: spire.server.notifier.Notifier.GetPluginInfo:input_type -> spire.common.plugin.GetPluginInfoRequest
-	3,  // 8: spire.server.notifier.Notifier.Notify:output_type -> spire.server.notifier.NotifyResponse
-	5,  // 9: spire.server.notifier.Notifier.NotifyAndAdvise:output_type -> spire.server.notifier.NotifyAndAdviseResponse
-	9,  // 10: spire.server.notifier.Notifier.Configure:output_type -> spire.common.plugin.ConfigureResponse
-	10, // 11: spire.server.notifier.Notifier.GetPluginInfo:output_type -> spire.common.plugin.GetPluginInfoResponse
-	8,  // [8:12] is the sub-list for method output_type
-	4,  // [4:8] is the sub-list for method input_type
-	4,  // [4:4] is the sub-list for extension type_name
-	4,  // [4:4] is the sub-list for extension extendee
-	0,  // [0:4] is the sub-list for field type_name
+	10, // 0: spire.server.notifier.BundleLoaded.bundle:type_name -> spire.common.Bundle
+	10, // 1: spire.server.notifier.BundleUpdated.bundle:type_name -> spire.common.Bundle
+	2,  // 2: spire.server.notifier.X509CAPrepared.x509_ca:type_name -> spire.server.notifier.X509CA
+	2,  // 3: spire.server.notifier.X509CAActivated.x509_ca:type_name -> spire.server.notifier.X509CA
+	2,  // 4: spire.server.notifier.X509CADeactivated.x509_ca:type_name -> spire.server.notifier.X509CA
+	1,  // 5: spire.server.notifier.NotifyRequest.bundle_updated:type_name -> spire.server.notifier.BundleUpdated
+	3,  // 6: spire.server.notifier.NotifyRequest.x509_ca_prepared:type_name -> spire.server.notifier.X509CAPrepared
+	4,  // 7: spire.server.notifier.NotifyRequest.x509_ca_activated:type_name -> spire.server.notifier.X509CAActivated
+	5,  // 8: spire.server.notifier.NotifyRequest.x509_ca_deactivated:type_name -> spire.server.notifier.X509CADeactivated
+	0,  // 9: spire.server.notifier.NotifyAndAdviseRequest.bundle_loaded:type_name -> spire.server.notifier.BundleLoaded
+	6,  // 10: spire.server.notifier.Notifier.Notify:input_type -> spire.server.notifier.NotifyRequest
+	8,  // 11: spire.server.notifier.Notifier.NotifyAndAdvise:input_type -> spire.server.notifier.NotifyAndAdviseRequest
+	11, // 12: spire.server.notifier.Notifier.Configure:input_type -> spire.common.plugin.ConfigureRequest
+	12, // 13: spire.server.notifier.Notifier.GetPluginInfo:input_type -> spire.common.plugin.GetPluginInfoRequest
+	7,  // 14: spire.server.notifier.Notifier.Notify:output_type -> spire.server.notifier.NotifyResponse
+	9,  // 15: spire.server.notifier.Notifier.NotifyAndAdvise:output_type -> spire.server.notifier.NotifyAndAdviseResponse
+	13, // 16: spire.server.notifier.Notifier.Configure:output_type -> spire.common.plugin.ConfigureResponse
+	14, // 17: spire.server.notifier.Notifier.GetPluginInfo:output_type -> spire.common.plugin.GetPluginInfoResponse
+	14, // [14:18] is the sub-list for method output_type
+	10, // [10:14] is the sub-list for method input_type
+	10, // [10:10] is the sub-list for extension type_name
+	10, // [10:10] is the sub-list for extension extendee
+	0,  // [0:10] is the sub-list for field type_name
 }
 
 func init() { file_spire_server_notifier_notifier_proto_init() }
@@ -479,7 +805,7 @@ func file_spire_server_notifier_notifier_proto_init() {
 			}
 		}
 		file_spire_server_notifier_notifier_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyRequest); i {
+			switch v := v.(*X509CA); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -491,7 +817,7 @@ func file_spire_server_notifier_notifier_proto_init() {
 			}
 		}
 		file_spire_server_notifier_notifier_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyResponse); i {
+			switch v := v.(*X509CAPrepared); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -503,7 +829,7 @@ func file_spire_server_notifier_notifier_proto_init() {
 			}
 		}
 		file_spire_server_notifier_notifier_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NotifyAndAdviseRequest); i {
+			switch v := v.(*X509CAActivated); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -515,6 +841,54 @@ func file_spire_server_notifier_notifier_proto_init() {
 			}
 		}
 		file_spire_server_notifier_notifier_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*X509CADeactivated); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_notifier_notifier_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_notifier_notifier_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_notifier_notifier_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NotifyAndAdviseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_spire_server_notifier_notifier_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*NotifyAndAdviseResponse); i {
 			case 0:
 				return &v.state
@@ -527,10 +901,13 @@ func file_spire_server_notifier_notifier_proto_init() {
 			}
 		}
 	}
-	file_spire_server_notifier_notifier_proto_msgTypes[2].OneofWrappers = []interface{}{
+	file_spire_server_notifier_notifier_proto_msgTypes[6].OneofWrappers = []interface{}{
 		(*NotifyRequest_BundleUpdated)(nil),
+		(*NotifyRequest_X509CaPrepared)(nil),
+		(*NotifyRequest_X509CaActivated)(nil),
+		(*NotifyRequest_X509CaDeactivated)(nil),
 	}
-	file_spire_server_notifier_notifier_proto_msgTypes[4].OneofWrappers = []interface{}{
+	file_spire_server_notifier_notifier_proto_msgTypes[8].OneofWrappers = []interface{}{
 		(*NotifyAndAdviseRequest_BundleLoaded)(nil),
 	}
 	type x struct{}
@@ -539,7 +916,7 @@ func file_spire_server_notifier_notifier_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_spire_server_notifier_notifier_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   6,
+			NumMessages:   10,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -24,6 +24,7 @@ const (
 type CRDMode struct {
 	CommonMode
 	AddSvcDNSName   bool   `hcl:"add_svc_dns_name"`
+	ClusterSPIFFEID bool   `hcl:"cluster_spiffeid_controller"`
 	LeaderElection  bool   `hcl:"leader_election"`
 	MetricsBindAddr string `hcl:"metrics_bind_addr"`
 	PodController   bool   `hcl:"pod_controller"`
@@ -103,7 +104,9 @@ func (c *CRDMode) Run(ctx context.Context) error {
 		}
 	}
 
-	if c.PodController {
+	// Pod entries are parented by the node entries created by the node
+	// controller
+	if c.PodController || c.ClusterSPIFFEID {
 		err = controllers.NewNodeReconciler(controllers.NodeReconcilerConfig{
 			Client:      mgr.GetClient(),
 			Cluster:     c.Cluster,
@@ -116,6 +119,9 @@ func (c *CRDMode) Run(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+	}
+
+	if c.PodController {
 		err = controllers.NewPodReconciler(controllers.PodReconcilerConfig{
 			Client:             mgr.GetClient(),
 			Cluster:            c.Cluster,
@@ -132,6 +138,21 @@ func (c *CRDMode) Run(ctx context.Context) error {
 		}
 	}
 
+	if c.ClusterSPIFFEID {
+		err = controllers.NewClusterSPIFFEIDReconciler(controllers.ClusterSPIFFEIDReconcilerConfig{
+			Client:             mgr.GetClient(),
+			Cluster:            c.Cluster,
+			Ctx:                ctx,
+			DisabledNamespaces: c.DisabledNamespaces,
+			Log:                log,
+			Scheme:             mgr.GetScheme(),
+			TrustDomain:        c.TrustDomain,
+		}).SetupWithManager(mgr)
+		if err != nil {
+			return err
+		}
+	}
+
 	if c.AddSvcDNSName {
 		err := controllers.NewEndpointReconciler(controllers.EndpointReconcilerConfig{
 			Client:             mgr.GetClient(),
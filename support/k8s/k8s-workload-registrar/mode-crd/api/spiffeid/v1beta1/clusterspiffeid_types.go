@@ -0,0 +1,74 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ClusterSPIFFEIDSpec defines the desired state of ClusterSPIFFEID
+type ClusterSPIFFEIDSpec struct {
+	// SPIFFEIDTemplate is a Go text template rendered for each selected pod
+	// to produce its SPIFFE ID (e.g.
+	// "spiffe://{{ .TrustDomain }}/ns/{{ .PodMeta.Namespace }}/sa/{{ .PodSpec.ServiceAccountName }}")
+	SPIFFEIDTemplate string `json:"spiffeIDTemplate"`
+	// PodSelector selects the pods to register. All pods are selected if
+	// not set.
+	PodSelector *metav1.LabelSelector `json:"podSelector,omitempty"`
+	// NamespaceSelector selects the namespaces of the pods to register. All
+	// namespaces are selected if not set.
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+	// DNSNameTemplates are Go text templates rendered for each selected pod
+	// to produce the DNS names of its registration entry
+	DNSNameTemplates []string `json:"dnsNameTemplates,omitempty"`
+}
+
+// ClusterSPIFFEIDStats holds statistics about the last reconciliation
+type ClusterSPIFFEIDStats struct {
+	// NamespacesSelected is the number of namespaces selected
+	NamespacesSelected int `json:"namespacesSelected"`
+	// PodsSelected is the number of pods selected
+	PodsSelected int `json:"podsSelected"`
+	// PodEntryRenderFailures is the number of pods whose templates failed
+	// to render
+	PodEntryRenderFailures int `json:"podEntryRenderFailures"`
+}
+
+// ClusterSPIFFEIDStatus defines the observed state of ClusterSPIFFEID
+type ClusterSPIFFEIDStatus struct {
+	Stats ClusterSPIFFEIDStats `json:"stats"`
+}
+
+// ClusterSPIFFEID is the Schema for the ClusterSPIFFEIDs API. It is cluster
+// scoped and describes the SPIFFE IDs of the pods it selects.
+type ClusterSPIFFEID struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ClusterSPIFFEIDSpec   `json:"spec,omitempty"`
+	Status ClusterSPIFFEIDStatus `json:"status,omitempty"`
+}
+
+// ClusterSPIFFEIDList contains a list of ClusterSPIFFEID
+type ClusterSPIFFEIDList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterSPIFFEID `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ClusterSPIFFEID{}, &ClusterSPIFFEIDList{})
+}
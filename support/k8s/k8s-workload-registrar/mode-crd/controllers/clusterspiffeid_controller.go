@@ -0,0 +1,349 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"text/template"
+
+	"github.com/sirupsen/logrus"
+	"github.com/spiffe/go-spiffe/v2/spiffeid"
+	spiffeidv1beta1 "github.com/spiffe/spire/support/k8s/k8s-workload-registrar/mode-crd/api/spiffeid/v1beta1"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+)
+
+const (
+	// clusterSPIFFEIDLabel is set on the SpiffeID resources created for a
+	// ClusterSPIFFEID to the name of the ClusterSPIFFEID
+	clusterSPIFFEIDLabel = "clusterSpiffeId"
+	// clusterSPIFFEIDPodUIDLabel is set on the SpiffeID resources created for
+	// a ClusterSPIFFEID to the UID of the pod. It is distinct from the
+	// "podUid" label so the endpoint controller leaves the DNS names alone.
+	clusterSPIFFEIDPodUIDLabel = "clusterSpiffeIdPodUid"
+)
+
+// ClusterSPIFFEIDReconcilerConfig holds the config passed in when creating the reconciler
+type ClusterSPIFFEIDReconcilerConfig struct {
+	Client             client.Client
+	Cluster            string
+	Ctx                context.Context
+	DisabledNamespaces []string
+	Log                logrus.FieldLogger
+	Scheme             *runtime.Scheme
+	TrustDomain        string
+}
+
+// ClusterSPIFFEIDReconciler holds the runtime configuration and state of this controller
+type ClusterSPIFFEIDReconciler struct {
+	client.Client
+	c ClusterSPIFFEIDReconcilerConfig
+}
+
+// NewClusterSPIFFEIDReconciler creates a new ClusterSPIFFEIDReconciler object
+func NewClusterSPIFFEIDReconciler(config ClusterSPIFFEIDReconcilerConfig) *ClusterSPIFFEIDReconciler {
+	return &ClusterSPIFFEIDReconciler{
+		Client: config.Client,
+		c:      config,
+	}
+}
+
+// SetupWithManager adds a controller manager to manage this reconciler. Every
+// ClusterSPIFFEID is reconciled when a pod or namespace changes, since any of
+// them could select it.
+func (r *ClusterSPIFFEIDReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	reconcileAll := &handler.EnqueueRequestsFromMapFunc{
+		ToRequests: handler.ToRequestsFunc(r.allClusterSPIFFEIDs),
+	}
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&spiffeidv1beta1.ClusterSPIFFEID{}).
+		Watches(&source.Kind{Type: &corev1.Pod{}}, reconcileAll).
+		Watches(&source.Kind{Type: &corev1.Namespace{}}, reconcileAll).
+		Complete(r)
+}
+
+// Reconcile creates, updates and deletes the SpiffeID resources of the pods
+// selected by a ClusterSPIFFEID
+func (r *ClusterSPIFFEIDReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
+	ctx := r.c.Ctx
+	log := r.c.Log.WithField("clusterSpiffeId", req.Name)
+
+	clusterSPIFFEID := spiffeidv1beta1.ClusterSPIFFEID{}
+	if err := r.Get(ctx, req.NamespacedName, &clusterSPIFFEID); err != nil {
+		if !errors.IsNotFound(err) {
+			log.WithError(err).Error("Unable to get ClusterSPIFFEID")
+			return ctrl.Result{}, err
+		}
+
+		// The ClusterSPIFFEID was deleted. Delete the SpiffeIDs created for it.
+		return ctrl.Result{}, r.deleteStaleSpiffeIDs(ctx, req.Name, nil)
+	}
+
+	spiffeIDTemplate, err := template.New("spiffeIDTemplate").Option("missingkey=error").Parse(clusterSPIFFEID.Spec.SPIFFEIDTemplate)
+	if err != nil {
+		// Nothing to do until the ClusterSPIFFEID is fixed
+		log.WithError(err).Error("Invalid SPIFFE ID template")
+		return ctrl.Result{}, nil
+	}
+	var dnsNameTemplates []*template.Template
+	for _, dnsNameTemplate := range clusterSPIFFEID.Spec.DNSNameTemplates {
+		t, err := template.New("dnsNameTemplate").Option("missingkey=error").Parse(dnsNameTemplate)
+		if err != nil {
+			log.WithError(err).Error("Invalid DNS name template")
+			return ctrl.Result{}, nil
+		}
+		dnsNameTemplates = append(dnsNameTemplates, t)
+	}
+
+	namespaces, err := r.selectedNamespaces(ctx, clusterSPIFFEID.Spec.NamespaceSelector)
+	if err != nil {
+		return ctrl.Result{}, err
+	}
+
+	podSelector := labels.Everything()
+	if clusterSPIFFEID.Spec.PodSelector != nil {
+		podSelector, err = metav1.LabelSelectorAsSelector(clusterSPIFFEID.Spec.PodSelector)
+		if err != nil {
+			log.WithError(err).Error("Invalid pod selector")
+			return ctrl.Result{}, nil
+		}
+	}
+
+	stats := spiffeidv1beta1.ClusterSPIFFEIDStats{
+		NamespacesSelected: len(namespaces),
+	}
+	desired := make(map[types.NamespacedName]bool)
+	for _, namespace := range namespaces {
+		pods := corev1.PodList{}
+		if err := r.List(ctx, &pods, client.InNamespace(namespace), client.MatchingLabelsSelector{Selector: podSelector}); err != nil {
+			return ctrl.Result{}, err
+		}
+
+		for i := range pods.Items {
+			pod := &pods.Items[i]
+			// Pod needs to be assigned a node before it can get a SPIFFE ID
+			if pod.Spec.NodeName == "" || pod.DeletionTimestamp != nil {
+				continue
+			}
+			stats.PodsSelected++
+
+			spiffeID, err := r.renderSpiffeID(&clusterSPIFFEID, pod, spiffeIDTemplate, dnsNameTemplates)
+			if err != nil {
+				log.WithError(err).WithField("pod", pod.Namespace+"/"+pod.Name).Warn("Unable to render pod entry")
+				stats.PodEntryRenderFailures++
+				continue
+			}
+
+			if err := r.updateOrCreateSpiffeID(ctx, spiffeID); err != nil {
+				return ctrl.Result{}, err
+			}
+			desired[types.NamespacedName{Namespace: spiffeID.Namespace, Name: spiffeID.Name}] = true
+		}
+	}
+
+	if err := r.deleteStaleSpiffeIDs(ctx, clusterSPIFFEID.Name, desired); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	if clusterSPIFFEID.Status.Stats != stats {
+		clusterSPIFFEID.Status.Stats = stats
+		if err := r.Status().Update(ctx, &clusterSPIFFEID); err != nil {
+			return ctrl.Result{}, err
+		}
+	}
+
+	return ctrl.Result{}, nil
+}
+
+// templateData is the data available to the ClusterSPIFFEID templates
+type templateData struct {
+	TrustDomain string
+	ClusterName string
+	PodMeta     *metav1.ObjectMeta
+	PodSpec     *corev1.PodSpec
+}
+
+// renderSpiffeID renders the SpiffeID resource for a pod selected by the
+// ClusterSPIFFEID
+func (r *ClusterSPIFFEIDReconciler) renderSpiffeID(clusterSPIFFEID *spiffeidv1beta1.ClusterSPIFFEID, pod *corev1.Pod, spiffeIDTemplate *template.Template, dnsNameTemplates []*template.Template) (*spiffeidv1beta1.SpiffeID, error) {
+	data := &templateData{
+		TrustDomain: r.c.TrustDomain,
+		ClusterName: r.c.Cluster,
+		PodMeta:     &pod.ObjectMeta,
+		PodSpec:     &pod.Spec,
+	}
+
+	rawID, err := renderTemplate(spiffeIDTemplate, data)
+	if err != nil {
+		return nil, err
+	}
+	id, err := spiffeid.FromString(rawID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid SPIFFE ID %q: %v", rawID, err)
+	}
+	if id.TrustDomain().String() != r.c.TrustDomain {
+		return nil, fmt.Errorf("SPIFFE ID %q is not a member of trust domain %q", rawID, r.c.TrustDomain)
+	}
+
+	var dnsNames []string
+	for _, dnsNameTemplate := range dnsNameTemplates {
+		dnsName, err := renderTemplate(dnsNameTemplate, data)
+		if err != nil {
+			return nil, err
+		}
+		dnsNames = append(dnsNames, dnsName)
+	}
+
+	spiffeID := &spiffeidv1beta1.SpiffeID{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s", pod.Name, clusterSPIFFEID.Name),
+			Namespace: pod.Namespace,
+			Labels: map[string]string{
+				clusterSPIFFEIDLabel:       clusterSPIFFEID.Name,
+				clusterSPIFFEIDPodUIDLabel: string(pod.UID),
+			},
+		},
+		Spec: spiffeidv1beta1.SpiffeIDSpec{
+			SpiffeId: id.String(),
+			ParentId: podParentID(r.c.TrustDomain, r.c.Cluster, pod.Spec.NodeName),
+			DnsNames: dnsNames,
+			Selector: spiffeidv1beta1.Selector{
+				PodUid:    pod.UID,
+				Namespace: pod.Namespace,
+				NodeName:  pod.Spec.NodeName,
+			},
+		},
+	}
+	// The pod owns the SpiffeID so it is garbage collected with the pod
+	if err := setOwnerRef(pod, spiffeID, r.c.Scheme); err != nil {
+		return nil, err
+	}
+	return spiffeID, nil
+}
+
+func (r *ClusterSPIFFEIDReconciler) updateOrCreateSpiffeID(ctx context.Context, spiffeID *spiffeidv1beta1.SpiffeID) error {
+	existing := spiffeidv1beta1.SpiffeID{}
+	err := r.Get(ctx, types.NamespacedName{
+		Name:      spiffeID.Name,
+		Namespace: spiffeID.Namespace,
+	}, &existing)
+	switch {
+	case errors.IsNotFound(err):
+		return r.Create(ctx, spiffeID)
+	case err != nil:
+		return err
+	}
+
+	if existing.Spec.SpiffeId == spiffeID.Spec.SpiffeId &&
+		existing.Spec.ParentId == spiffeID.Spec.ParentId &&
+		existing.Spec.Selector.PodUid == spiffeID.Spec.Selector.PodUid &&
+		equalStringSlice(existing.Spec.DnsNames, spiffeID.Spec.DnsNames) {
+		return nil
+	}
+
+	existing.Labels = spiffeID.Labels
+	existing.OwnerReferences = spiffeID.OwnerReferences
+	existing.Spec.SpiffeId = spiffeID.Spec.SpiffeId
+	existing.Spec.ParentId = spiffeID.Spec.ParentId
+	existing.Spec.DnsNames = spiffeID.Spec.DnsNames
+	existing.Spec.Selector = spiffeID.Spec.Selector
+	return r.Update(ctx, &existing)
+}
+
+// deleteStaleSpiffeIDs deletes the SpiffeIDs created for the ClusterSPIFFEID
+// that are not desired anymore
+func (r *ClusterSPIFFEIDReconciler) deleteStaleSpiffeIDs(ctx context.Context, name string, desired map[types.NamespacedName]bool) error {
+	spiffeIDs := spiffeidv1beta1.SpiffeIDList{}
+	if err := r.List(ctx, &spiffeIDs, client.MatchingLabels{clusterSPIFFEIDLabel: name}); err != nil {
+		return err
+	}
+
+	for i := range spiffeIDs.Items {
+		spiffeID := &spiffeIDs.Items[i]
+		if desired[types.NamespacedName{Namespace: spiffeID.Namespace, Name: spiffeID.Name}] {
+			continue
+		}
+		if err := r.Delete(ctx, spiffeID); client.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// selectedNamespaces returns the names of the namespaces matching the
+// selector, excluding the disabled namespaces
+func (r *ClusterSPIFFEIDReconciler) selectedNamespaces(ctx context.Context, namespaceSelector *metav1.LabelSelector) ([]string, error) {
+	selector := labels.Everything()
+	if namespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(namespaceSelector)
+		if err != nil {
+			r.c.Log.WithError(err).Error("Invalid namespace selector")
+			return nil, nil
+		}
+	}
+
+	namespaces := corev1.NamespaceList{}
+	if err := r.List(ctx, &namespaces, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		return nil, err
+	}
+
+	var names []string
+	for _, namespace := range namespaces.Items {
+		if containsString(r.c.DisabledNamespaces, namespace.Name) {
+			continue
+		}
+		names = append(names, namespace.Name)
+	}
+	return names, nil
+}
+
+// allClusterSPIFFEIDs returns a request for each ClusterSPIFFEID
+func (r *ClusterSPIFFEIDReconciler) allClusterSPIFFEIDs(handler.MapObject) []reconcile.Request {
+	clusterSPIFFEIDs := spiffeidv1beta1.ClusterSPIFFEIDList{}
+	if err := r.List(r.c.Ctx, &clusterSPIFFEIDs); err != nil {
+		r.c.Log.WithError(err).Error("Unable to list ClusterSPIFFEIDs")
+		return nil
+	}
+
+	requests := make([]reconcile.Request, 0, len(clusterSPIFFEIDs.Items))
+	for _, clusterSPIFFEID := range clusterSPIFFEIDs.Items {
+		requests = append(requests, reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: clusterSPIFFEID.Name},
+		})
+	}
+	return requests
+}
+
+func renderTemplate(t *template.Template, data *templateData) (string, error) {
+	var buf bytes.Buffer
+	if err := t.Execute(&buf, data); err != nil {
+		return "", err
+	}
+	return buf.String(), nil
+}
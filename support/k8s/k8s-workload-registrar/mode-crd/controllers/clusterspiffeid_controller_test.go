@@ -0,0 +1,223 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"testing"
+
+	spiffeidv1beta1 "github.com/spiffe/spire/support/k8s/k8s-workload-registrar/mode-crd/api/spiffeid/v1beta1"
+	"github.com/stretchr/testify/suite"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	ClusterSPIFFEIDName string = "test-cluster-spiffeid"
+)
+
+func TestClusterSPIFFEIDController(t *testing.T) {
+	suite.Run(t, new(ClusterSPIFFEIDControllerTestSuite))
+}
+
+type ClusterSPIFFEIDControllerTestSuite struct {
+	suite.Suite
+	CommonControllerTestSuite
+
+	c *ClusterSPIFFEIDReconciler
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) SetupTest() {
+	s.CommonControllerTestSuite = NewCommonControllerTestSuite(s.T())
+	s.c = NewClusterSPIFFEIDReconciler(ClusterSPIFFEIDReconcilerConfig{
+		Client:             s.k8sClient,
+		Cluster:            s.cluster,
+		Ctx:                s.ctx,
+		DisabledNamespaces: []string{"kube-system"},
+		Log:                s.log,
+		Scheme:             s.scheme,
+		TrustDomain:        s.trustDomain,
+	})
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) TestRegistersSelectedPods() {
+	s.createNamespace("prod", map[string]string{"env": "prod"})
+	s.createNamespace("dev", map[string]string{"env": "dev"})
+	s.createNamespace("kube-system", map[string]string{"env": "prod"})
+
+	api := s.createPod("prod", "api", "api-sa", map[string]string{"app": "api"}, "node-1")
+	s.createPod("prod", "db", "db-sa", map[string]string{"app": "db"}, "node-1")
+	s.createPod("prod", "pending", "api-sa", map[string]string{"app": "api"}, "")
+	s.createPod("dev", "api", "api-sa", map[string]string{"app": "api"}, "node-2")
+	s.createPod("kube-system", "api", "api-sa", map[string]string{"app": "api"}, "node-2")
+
+	clusterSPIFFEID := &spiffeidv1beta1.ClusterSPIFFEID{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ClusterSPIFFEIDName,
+		},
+		Spec: spiffeidv1beta1.ClusterSPIFFEIDSpec{
+			SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/ns/{{ .PodMeta.Namespace }}/sa/{{ .PodSpec.ServiceAccountName }}",
+			PodSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"app": "api"},
+			},
+			NamespaceSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+			},
+			DNSNameTemplates: []string{"{{ .PodMeta.Name }}.{{ .PodMeta.Namespace }}.svc"},
+		},
+	}
+	s.Require().NoError(s.k8sClient.Create(s.ctx, clusterSPIFFEID))
+	s.reconcile()
+
+	spiffeIDs := s.listSpiffeIDs()
+	s.Require().Len(spiffeIDs, 1)
+	spiffeID := spiffeIDs[0]
+	s.Require().Equal("api-"+ClusterSPIFFEIDName, spiffeID.Name)
+	s.Require().Equal("prod", spiffeID.Namespace)
+	s.Require().Equal(spiffeidv1beta1.SpiffeIDSpec{
+		SpiffeId: "spiffe://example.org/ns/prod/sa/api-sa",
+		ParentId: "spiffe://example.org/k8s-workload-registrar/test-cluster/node/node-1",
+		DnsNames: []string{"api.prod.svc"},
+		Selector: spiffeidv1beta1.Selector{
+			PodUid:    api.UID,
+			Namespace: "prod",
+			NodeName:  "node-1",
+		},
+	}, spiffeID.Spec)
+	s.Require().Equal(string(api.UID), spiffeID.Labels[clusterSPIFFEIDPodUIDLabel])
+	s.Require().Equal("api", spiffeID.OwnerReferences[0].Name)
+	s.Require().Equal(spiffeidv1beta1.ClusterSPIFFEIDStats{
+		NamespacesSelected: 1,
+		PodsSelected:       1,
+	}, s.getClusterSPIFFEID().Status.Stats)
+
+	// Changing the template updates the SpiffeID
+	clusterSPIFFEID = s.getClusterSPIFFEID()
+	clusterSPIFFEID.Spec.SPIFFEIDTemplate = "spiffe://{{ .TrustDomain }}/{{ .ClusterName }}/{{ index .PodMeta.Labels \"app\" }}"
+	s.Require().NoError(s.k8sClient.Update(s.ctx, clusterSPIFFEID))
+	s.reconcile()
+
+	spiffeIDs = s.listSpiffeIDs()
+	s.Require().Len(spiffeIDs, 1)
+	s.Require().Equal("spiffe://example.org/test-cluster/api", spiffeIDs[0].Spec.SpiffeId)
+
+	// Pods that stop being selected are unregistered
+	api.Labels["app"] = "other"
+	s.Require().NoError(s.k8sClient.Update(s.ctx, api))
+	s.reconcile()
+	s.Require().Empty(s.listSpiffeIDs())
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) TestRenderFailures() {
+	s.createNamespace("prod", nil)
+	s.createPod("prod", "api", "api-sa", nil, "node-1")
+
+	clusterSPIFFEID := &spiffeidv1beta1.ClusterSPIFFEID{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ClusterSPIFFEIDName,
+		},
+		Spec: spiffeidv1beta1.ClusterSPIFFEIDSpec{
+			SPIFFEIDTemplate: "spiffe://other.org/{{ .PodMeta.Name }}",
+		},
+	}
+	s.Require().NoError(s.k8sClient.Create(s.ctx, clusterSPIFFEID))
+	s.reconcile()
+
+	s.Require().Empty(s.listSpiffeIDs())
+	s.Require().Equal(spiffeidv1beta1.ClusterSPIFFEIDStats{
+		NamespacesSelected:     1,
+		PodsSelected:           1,
+		PodEntryRenderFailures: 1,
+	}, s.getClusterSPIFFEID().Status.Stats)
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) TestDeletingClusterSPIFFEIDDeletesSpiffeIDs() {
+	s.createNamespace("prod", nil)
+	s.createPod("prod", "api", "api-sa", nil, "node-1")
+
+	clusterSPIFFEID := &spiffeidv1beta1.ClusterSPIFFEID{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: ClusterSPIFFEIDName,
+		},
+		Spec: spiffeidv1beta1.ClusterSPIFFEIDSpec{
+			SPIFFEIDTemplate: "spiffe://{{ .TrustDomain }}/{{ .PodMeta.Name }}",
+		},
+	}
+	s.Require().NoError(s.k8sClient.Create(s.ctx, clusterSPIFFEID))
+	s.reconcile()
+	s.Require().Len(s.listSpiffeIDs(), 1)
+
+	s.Require().NoError(s.k8sClient.Delete(s.ctx, clusterSPIFFEID))
+	s.reconcile()
+	s.Require().Empty(s.listSpiffeIDs())
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) createNamespace(name string, labels map[string]string) {
+	namespace := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+	}
+	s.Require().NoError(s.k8sClient.Create(s.ctx, namespace))
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) createPod(namespace, name, serviceAccount string, labels map[string]string, nodeName string) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels:    labels,
+			UID:       types.UID(namespace + "-" + name),
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{{
+				Name:  name,
+				Image: name,
+			}},
+			ServiceAccountName: serviceAccount,
+			NodeName:           nodeName,
+		},
+	}
+	s.Require().NoError(s.k8sClient.Create(s.ctx, pod))
+	return pod
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) getClusterSPIFFEID() *spiffeidv1beta1.ClusterSPIFFEID {
+	clusterSPIFFEID := &spiffeidv1beta1.ClusterSPIFFEID{}
+	err := s.k8sClient.Get(s.ctx, types.NamespacedName{Name: ClusterSPIFFEIDName}, clusterSPIFFEID)
+	s.Require().NoError(err)
+	return clusterSPIFFEID
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) listSpiffeIDs() []spiffeidv1beta1.SpiffeID {
+	spiffeIDList := spiffeidv1beta1.SpiffeIDList{}
+	err := s.k8sClient.List(s.ctx, &spiffeIDList, client.MatchingLabels{clusterSPIFFEIDLabel: ClusterSPIFFEIDName})
+	s.Require().NoError(err)
+	return spiffeIDList.Items
+}
+
+func (s *ClusterSPIFFEIDControllerTestSuite) reconcile() {
+	_, err := s.c.Reconcile(ctrl.Request{
+		NamespacedName: types.NamespacedName{
+			Name: ClusterSPIFFEIDName,
+		},
+	})
+	s.Require().NoError(err)
+}
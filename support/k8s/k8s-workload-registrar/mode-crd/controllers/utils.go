@@ -105,6 +105,12 @@ func makeID(trustDomain, pathFmt string, pathArgs ...interface{}) string {
 	return id.String()
 }
 
+// podParentID returns the SPIFFE ID of the node entry created by the node
+// controller, used to parent the entries of the pods running on the node
+func podParentID(trustDomain, cluster, nodeName string) string {
+	return makeID(trustDomain, "k8s-workload-registrar/%s/node/%s", cluster, nodeName)
+}
+
 // Helper functions for string operations.
 func equalStringSlice(x, y []string) bool {
 	if len(x) != len(y) {
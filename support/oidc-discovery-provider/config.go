@@ -23,6 +23,9 @@ type Config struct {
 	// LogRequests is a debug option that logs all incoming requests
 	LogRequests bool `hcl:"log_requests"`
 
+	// SetKeyUse, if true, sets the "use" parameter of the JWKs to "sig".
+	SetKeyUse bool `hcl:"set_key_use"`
+
 	// Domain is the domain this provider will be hosted under. It is used
 	// as the domain when building the JWKS URI. It is also used when obtaining
 	// obtaining certs via ACME (unless InsecureAddr is specified).
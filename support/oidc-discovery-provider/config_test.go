@@ -207,6 +207,27 @@ func TestParseConfig(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "with set_key_use",
+			in: `
+				domain = "domain.test"
+				insecure_addr = ":8080"
+				set_key_use = true
+				server_api {
+					address = "unix:///some/socket/path"
+				}
+			`,
+			out: &Config{
+				LogLevel:     defaultLogLevel,
+				Domain:       "domain.test",
+				InsecureAddr: ":8080",
+				SetKeyUse:    true,
+				ServerAPI: &ServerAPIConfig{
+					Address:      "unix:///some/socket/path",
+					PollInterval: defaultPollInterval,
+				},
+			},
+		},
 		{
 			name: "with listen_socket_path",
 			in: `
@@ -13,3 +13,13 @@ type JWKSSource interface {
 	// Close closes the source.
 	Close() error
 }
+
+// keyUse returns the value of the "use" parameter for the JWKs served by the
+// provider. Some relying parties (e.g. cloud provider identity federation)
+// require the parameter to be set to "sig".
+func keyUse(setKeyUse bool) string {
+	if setKeyUse {
+		return "sig"
+	}
+	return ""
+}
@@ -86,12 +86,14 @@ func newSource(log logrus.FieldLogger, config *Config) (JWKSSource, error) {
 			Log:          log,
 			Address:      address,
 			PollInterval: config.RegistrationAPI.PollInterval,
+			SetKeyUse:    config.SetKeyUse,
 		})
 	case config.ServerAPI != nil:
 		return NewServerAPISource(ServerAPISourceConfig{
 			Log:          log,
 			Address:      config.ServerAPI.Address,
 			PollInterval: config.ServerAPI.PollInterval,
+			SetKeyUse:    config.SetKeyUse,
 		})
 	case config.WorkloadAPI != nil:
 		return NewWorkloadAPISource(WorkloadAPISourceConfig{
@@ -99,6 +101,7 @@ func newSource(log logrus.FieldLogger, config *Config) (JWKSSource, error) {
 			SocketPath:   config.WorkloadAPI.SocketPath,
 			PollInterval: config.WorkloadAPI.PollInterval,
 			TrustDomain:  config.WorkloadAPI.TrustDomain,
+			SetKeyUse:    config.SetKeyUse,
 		})
 	default:
 		// This is defensive; LoadConfig should prevent this from happening.
@@ -25,12 +25,16 @@ type ServerAPISourceConfig struct {
 	Address      string
 	PollInterval time.Duration
 	Clock        clock.Clock
+
+	// SetKeyUse, if true, sets the "use" parameter of the JWKs to "sig"
+	SetKeyUse bool
 }
 
 type ServerAPISource struct {
-	log    logrus.FieldLogger
-	clock  clock.Clock
-	cancel context.CancelFunc
+	log       logrus.FieldLogger
+	clock     clock.Clock
+	cancel    context.CancelFunc
+	setKeyUse bool
 
 	mu      sync.RWMutex
 	wg      sync.WaitGroup
@@ -54,9 +58,10 @@ func NewServerAPISource(config ServerAPISourceConfig) (*ServerAPISource, error)
 
 	ctx, cancel := context.WithCancel(context.Background())
 	s := &ServerAPISource{
-		log:    config.Log,
-		clock:  config.Clock,
-		cancel: cancel,
+		log:       config.Log,
+		clock:     config.Clock,
+		cancel:    cancel,
+		setKeyUse: config.SetKeyUse,
 	}
 
 	go s.pollEvery(ctx, conn, config.PollInterval)
@@ -135,6 +140,7 @@ func (s *ServerAPISource) parseBundle(bundle *types.Bundle) {
 		jwks.Keys = append(jwks.Keys, jose.JSONWebKey{
 			Key:   publicKey,
 			KeyID: key.KeyId,
+			Use:   keyUse(s.setKeyUse),
 		})
 	}
 
@@ -97,6 +97,43 @@ func TestServerAPISource(t *testing.T) {
 	require.Equal(t, ec256Pubkey, keySet3.Keys[0].Key)
 }
 
+func TestServerAPISourceSetKeyUse(t *testing.T) {
+	const pollInterval = time.Second
+
+	api := &fakeServerAPIServer{}
+	api.SetBundle(&types.Bundle{
+		JwtAuthorities: []*types.JWTKey{
+			{
+				KeyId:     "KID",
+				PublicKey: ec256PubkeyPKIX,
+			},
+		},
+	})
+
+	socketPath := spiretest.StartGRPCSocketServerOnTempSocket(t, func(s *grpc.Server) {
+		bundle.RegisterBundleServer(s, api)
+	})
+
+	log, _ := test.NewNullLogger()
+	clock := clock.NewMock(t)
+
+	source, err := NewServerAPISource(ServerAPISourceConfig{
+		Log:          log,
+		Address:      "unix://" + socketPath,
+		PollInterval: pollInterval,
+		Clock:        clock,
+		SetKeyUse:    true,
+	})
+	require.NoError(t, err)
+	defer source.Close()
+
+	clock.WaitForAfter(time.Minute, "failed to wait for the poll timer")
+	keySet, _, ok := source.FetchKeySet()
+	require.True(t, ok)
+	require.Len(t, keySet.Keys, 1)
+	require.Equal(t, "sig", keySet.Keys[0].Use)
+}
+
 type fakeServerAPIServer struct {
 	bundle.BundleServer
 
@@ -27,6 +27,9 @@ type WorkloadAPISourceConfig struct {
 	TrustDomain  string
 	PollInterval time.Duration
 	Clock        clock.Clock
+
+	// SetKeyUse, if true, sets the "use" parameter of the JWKs to "sig"
+	SetKeyUse bool
 }
 
 type WorkloadAPISource struct {
@@ -34,6 +37,7 @@ type WorkloadAPISource struct {
 	clock       clock.Clock
 	trustDomain spiffeid.TrustDomain
 	cancel      context.CancelFunc
+	setKeyUse   bool
 
 	mu        sync.RWMutex
 	wg        sync.WaitGroup
@@ -70,6 +74,7 @@ func NewWorkloadAPISource(config WorkloadAPISourceConfig) (*WorkloadAPISource, e
 		clock:       config.Clock,
 		cancel:      cancel,
 		trustDomain: trustDomain,
+		setKeyUse:   config.SetKeyUse,
 	}
 
 	go s.pollEvery(ctx, client, config.PollInterval)
@@ -147,7 +152,7 @@ func (s *WorkloadAPISource) setJWKS(bundle *jwtbundle.Bundle) {
 		return
 	}
 	for i, key := range jwks.Keys {
-		key.Use = ""
+		key.Use = keyUse(s.setKeyUse)
 		jwks.Keys[i] = key
 	}
 
@@ -109,6 +109,45 @@ func TestWorkloadAPISource(t *testing.T) {
 	require.Equal(t, ec256Pubkey, keySet3.Keys[0].Key)
 }
 
+func TestWorkloadAPISourceSetKeyUse(t *testing.T) {
+	const pollInterval = time.Second
+
+	api := &fakeWorkloadAPIServer{}
+	api.SetJWTBundles(map[string][]byte{
+		"spiffe://domain.test": makeJWKS(t, &jose.JSONWebKeySet{
+			Keys: []jose.JSONWebKey{
+				{
+					KeyID: "KID",
+					Key:   ec256Pubkey,
+					Use:   "jwt-svid",
+				},
+			},
+		}),
+	})
+
+	socketPath := spiretest.StartWorkloadAPIOnTempSocket(t, api)
+
+	log, _ := test.NewNullLogger()
+	clock := clock.NewMock(t)
+
+	source, err := NewWorkloadAPISource(WorkloadAPISourceConfig{
+		Log:          log,
+		SocketPath:   socketPath,
+		TrustDomain:  "domain.test",
+		PollInterval: pollInterval,
+		Clock:        clock,
+		SetKeyUse:    true,
+	})
+	require.NoError(t, err)
+	defer source.Close()
+
+	clock.WaitForAfter(time.Minute, "failed to wait for the poll timer")
+	keySet, _, ok := source.FetchKeySet()
+	require.True(t, ok)
+	require.Len(t, keySet.Keys, 1)
+	require.Equal(t, "sig", keySet.Keys[0].Use)
+}
+
 type fakeWorkloadAPIServer struct {
 	workload.SpiffeWorkloadAPIServer
 
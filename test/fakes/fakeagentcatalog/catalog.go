@@ -4,6 +4,7 @@ import (
 	"github.com/spiffe/spire/pkg/agent/catalog"
 	"github.com/spiffe/spire/pkg/agent/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/agent/plugin/nodeattestor"
+	"github.com/spiffe/spire/pkg/agent/plugin/svidstore"
 	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
 )
 
@@ -23,6 +24,10 @@ func (c *Catalog) SetNodeAttestor(nodeAttestor catalog.NodeAttestor) {
 	c.NodeAttestor = nodeAttestor
 }
 
+func (c *Catalog) SetSVIDStores(svidStores ...catalog.SVIDStore) {
+	c.SVIDStores = svidStores
+}
+
 func (c *Catalog) SetWorkloadAttestors(workloadAttestors ...catalog.WorkloadAttestor) {
 	c.WorkloadAttestors = workloadAttestors
 }
@@ -40,6 +45,13 @@ func NodeAttestor(name string, nodeAttestor nodeattestor.NodeAttestor) catalog.N
 	}
 }
 
+func SVIDStore(name string, svidStore svidstore.SVIDStore) catalog.SVIDStore {
+	return catalog.SVIDStore{
+		PluginInfo: pluginInfo{name: name, typ: svidstore.Type},
+		SVIDStore:  svidStore,
+	}
+}
+
 func WorkloadAttestor(name string, workloadAttestor workloadattestor.WorkloadAttestor) catalog.WorkloadAttestor {
 	return catalog.WorkloadAttestor{
 		PluginInfo:       pluginInfo{name: name, typ: workloadattestor.Type},
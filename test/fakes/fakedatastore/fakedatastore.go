@@ -239,6 +239,20 @@ func (s *DataStore) PruneRegistrationEntries(ctx context.Context, req *datastore
 	return s.ds.PruneRegistrationEntries(ctx, req)
 }
 
+func (s *DataStore) ListRegistrationEntryTombstones(ctx context.Context, req *datastore.ListRegistrationEntryTombstonesRequest) (*datastore.ListRegistrationEntryTombstonesResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.ListRegistrationEntryTombstones(ctx, req)
+}
+
+func (s *DataStore) PruneRegistrationEntryTombstones(ctx context.Context, req *datastore.PruneRegistrationEntryTombstonesRequest) (*datastore.PruneRegistrationEntryTombstonesResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.PruneRegistrationEntryTombstones(ctx, req)
+}
+
 func (s *DataStore) CreateJoinToken(ctx context.Context, req *datastore.CreateJoinTokenRequest) (*datastore.CreateJoinTokenResponse, error) {
 	if err := s.getNextError(); err != nil {
 		return nil, err
@@ -253,6 +267,13 @@ func (s *DataStore) FetchJoinToken(ctx context.Context, req *datastore.FetchJoin
 	return s.ds.FetchJoinToken(ctx, req)
 }
 
+func (s *DataStore) ListJoinTokens(ctx context.Context, req *datastore.ListJoinTokensRequest) (*datastore.ListJoinTokensResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.ListJoinTokens(ctx, req)
+}
+
 func (s *DataStore) DeleteJoinToken(ctx context.Context, req *datastore.DeleteJoinTokenRequest) (*datastore.DeleteJoinTokenResponse, error) {
 	if err := s.getNextError(); err != nil {
 		return nil, err
@@ -267,6 +288,41 @@ func (s *DataStore) PruneJoinTokens(ctx context.Context, req *datastore.PruneJoi
 	return s.ds.PruneJoinTokens(ctx, req)
 }
 
+func (s *DataStore) CreateFederationRelationship(ctx context.Context, req *datastore.CreateFederationRelationshipRequest) (*datastore.CreateFederationRelationshipResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.CreateFederationRelationship(ctx, req)
+}
+
+func (s *DataStore) FetchFederationRelationship(ctx context.Context, req *datastore.FetchFederationRelationshipRequest) (*datastore.FetchFederationRelationshipResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.FetchFederationRelationship(ctx, req)
+}
+
+func (s *DataStore) ListFederationRelationships(ctx context.Context, req *datastore.ListFederationRelationshipsRequest) (*datastore.ListFederationRelationshipsResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.ListFederationRelationships(ctx, req)
+}
+
+func (s *DataStore) UpdateFederationRelationship(ctx context.Context, req *datastore.UpdateFederationRelationshipRequest) (*datastore.UpdateFederationRelationshipResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.UpdateFederationRelationship(ctx, req)
+}
+
+func (s *DataStore) DeleteFederationRelationship(ctx context.Context, req *datastore.DeleteFederationRelationshipRequest) (*datastore.DeleteFederationRelationshipResponse, error) {
+	if err := s.getNextError(); err != nil {
+		return nil, err
+	}
+	return s.ds.DeleteFederationRelationship(ctx, req)
+}
+
 func (s *DataStore) SetNextError(err error) {
 	s.errs = []error{err}
 }
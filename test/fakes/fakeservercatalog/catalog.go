@@ -3,6 +3,7 @@ package fakeservercatalog
 import (
 	"github.com/spiffe/spire/pkg/agent/plugin/workloadattestor"
 	"github.com/spiffe/spire/pkg/server/catalog"
+	"github.com/spiffe/spire/pkg/server/plugin/bundlepublisher"
 	"github.com/spiffe/spire/pkg/server/plugin/datastore"
 	"github.com/spiffe/spire/pkg/server/plugin/keymanager"
 	"github.com/spiffe/spire/pkg/server/plugin/nodeattestor"
@@ -58,6 +59,17 @@ func Notifier(name string, notifier notifier.Notifier) catalog.Notifier {
 	}
 }
 
+func (c *Catalog) AddBundlePublisher(bundlePublisher catalog.BundlePublisher) {
+	c.BundlePublishers = append(c.BundlePublishers, bundlePublisher)
+}
+
+func BundlePublisher(name string, bundlePublisher bundlepublisher.BundlePublisher) catalog.BundlePublisher {
+	return catalog.BundlePublisher{
+		PluginInfo:      pluginInfo{name: name, typ: bundlepublisher.Type},
+		BundlePublisher: bundlePublisher,
+	}
+}
+
 func UpstreamAuthority(name string, ua upstreamauthority.UpstreamAuthority) *catalog.UpstreamAuthority {
 	return &catalog.UpstreamAuthority{
 		PluginInfo:        pluginInfo{name: name},
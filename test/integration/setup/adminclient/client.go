@@ -79,6 +79,7 @@ func main() {
 	// SVID Client tests
 	testRPC("MintX509SVID", mintX509SVID)
 	testRPC("MintJWTSVID", mintJWTSVID)
+	testRPC("ValidateJWTSVID", validateJWTSVID)
 	// Bundle Client tests
 	testRPC("AppendBundle", appendBundle)
 	testRPC("BatchCreateFederatedBundle", batchCreateFederatedBundle)
@@ -209,6 +210,37 @@ func mintJWTSVID(ctx context.Context, c *itclient.Client) error {
 	return nil
 }
 
+func validateJWTSVID(ctx context.Context, c *itclient.Client) error {
+	id := &types.SPIFFEID{TrustDomain: c.Td.String(), Path: "/new_workload"}
+	mintResp, err := c.SVIDClient().MintJWTSVID(ctx, &svid.MintJWTSVIDRequest{
+		Id:       id,
+		Audience: []string{"myAud"},
+	})
+	if err != nil && !c.ExpectErrors {
+		return err
+	}
+
+	var token string
+	if mintResp != nil {
+		token = mintResp.Svid.Token
+	}
+	resp, err := c.SVIDClient().ValidateJWTSVID(ctx, &svid.ValidateJWTSVIDRequest{
+		Token:    token,
+		Audience: "myAud",
+	})
+	switch {
+	case c.ExpectErrors:
+		return validatePermissionError(err)
+	case err != nil:
+		return err
+	case !proto.Equal(resp.Id, id):
+		return fmt.Errorf("unexpected Id: %v", resp.Id.String())
+	case resp.Claims.Fields["sub"].GetStringValue() != "spiffe://"+c.Td.String()+"/new_workload":
+		return fmt.Errorf("unexpected sub %v", resp.Claims.Fields["sub"])
+	}
+	return nil
+}
+
 func appendBundle(ctx context.Context, c *itclient.Client) error {
 	jwtKey := &types.JWTKey{
 		PublicKey: pkixBytes,
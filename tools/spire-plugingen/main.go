@@ -40,6 +40,7 @@ func main() {
 	outFlag := fs.String("out", "", "directory to write output, defaults to current directory if blank")
 	modeFlag := fs.String("mode", "plugin", `generation mode (one of "plugin", "service", "hostservice")`)
 	sharedFlag := fs.Bool("shared", false, `output package is a shared package (forces prefix on generated code)`)
+	versionFlag := fs.Uint("version", 1, `version of the plugin/service definition (ignored for "hostservice")`)
 	if err := fs.Parse(os.Args[1:]); err != nil {
 		fs.Usage()
 		os.Exit(1)
@@ -62,6 +63,7 @@ func main() {
 		OutDir:       *outFlag,
 		Mode:         mode,
 		Shared:       *sharedFlag,
+		Version:      uint32(*versionFlag),
 	}
 
 	if err := g.generate(); err != nil {
@@ -127,6 +129,10 @@ type generator struct {
 	// each generated declaration.
 	Shared bool
 
+	// Version is the version of the plugin/service definition. It must be
+	// bumped on every breaking change to the definition.
+	Version uint32
+
 	pkg      *types.Package
 	needQual bool
 }
@@ -327,6 +333,7 @@ func (g *generator) generate() (err error) {
 			"Aliases": aliases,
 			"Client":  client,
 			"Mode":    g.Mode,
+			"Version": g.Version,
 		}); err != nil {
 			return errs.Wrap(err)
 		}
@@ -4,6 +4,8 @@ var codeTmpl = `
 {{- $c := .Client }}
 
 {{ $typeConst := mkexpname $c.Prefix "Type" }}
+{{ $versionConst := mkexpname $c.Prefix "Version" }}
+{{ $serveFunc := mkexpname $c.Prefix "Serve" }}
 {{ $pluginIntf := mkexpname $c.Prefix "Plugin" }}
 {{ $pluginServerFunc := mkexpname $c.Prefix "PluginServer" }}
 {{ $pluginServerImpl := mkname $c.Prefix "PluginServer" }}
@@ -46,6 +48,12 @@ const (
 {{- end }}
 )
 
+{{- if ne .Mode "hostservice" }}
+
+// {{ $versionConst }} is the version of the {{ $c.Name }} definition. Plugins built against another version are not loaded.
+const {{ $versionConst }} = {{ .Version }}
+{{- end }}
+
 // {{ $c.Name }} is the client interface for the service type {{ $c.Name }} interface.
 type {{ $c.Name }} interface {
 	{{- range $c.Methods }}
@@ -64,6 +72,11 @@ type {{ $pluginIntf }} interface {
 	{{- end }}
 }
 
+// {{ $serveFunc }} serves the {{ $c.Name }} plugin, along with any additional services, as an external plugin. It does not return.
+func {{ $serveFunc }}(name string, server {{ $c.ServerType }}, services ...catalog.ServiceServer) {
+	catalog.PluginMain(catalog.MakePlugin(name, {{ $pluginServerFunc }}(server), services...))
+}
+
 // {{ $pluginServerFunc }} returns a catalog PluginServer implementation for the {{ $c.Name }} plugin.
 func {{ $pluginServerFunc }}(server {{ $c.ServerType }}) catalog.PluginServer {
 	return &{{ $pluginServerImpl }}{
@@ -79,6 +92,10 @@ func (s  {{ $pluginServerImpl }}) PluginType() string {
 	return {{ $typeConst }}
 }
 
+func (s  {{ $pluginServerImpl }}) PluginVersion() uint32 {
+	return {{ $versionConst }}
+}
+
 func (s  {{ $pluginServerImpl }}) PluginClient() catalog.PluginClient {
 	return {{ $pluginClientVar }}
 }
@@ -97,6 +114,10 @@ func ({{ $pluginClientImpl }}) PluginType() string {
 	return {{ $typeConst }}
 }
 
+func ({{ $pluginClientImpl }}) PluginVersion() uint32 {
+	return {{ $versionConst }}
+}
+
 func ({{ $pluginClientImpl }}) NewPluginClient(conn grpc.ClientConnInterface) interface{} {
 	return {{ $adaptPluginClientFunc }}({{ $c.PkgQual }}New{{ $c.Name }}Client(conn))
 }
@@ -133,6 +154,10 @@ func (s  {{ $serviceServerImpl }}) ServiceType() string {
 	return {{ $typeConst }}
 }
 
+func (s  {{ $serviceServerImpl }}) ServiceVersion() uint32 {
+	return {{ $versionConst }}
+}
+
 func (s  {{ $serviceServerImpl }}) ServiceClient() catalog.ServiceClient {
 	return {{ $serviceClientVar }}
 }
@@ -151,6 +176,10 @@ func ({{ $serviceClientImpl }}) ServiceType() string {
 	return {{ $typeConst }}
 }
 
+func ({{ $serviceClientImpl }}) ServiceVersion() uint32 {
+	return {{ $versionConst }}
+}
+
 func ({{ $serviceClientImpl }}) NewServiceClient(conn grpc.ClientConnInterface) interface{} {
 	return {{ $adaptServiceClientFunc }}({{ $c.PkgQual }}New{{ $c.Name }}Client(conn))
 }